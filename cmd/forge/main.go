@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
@@ -11,10 +12,12 @@ import (
 	"path/filepath"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/adapter"
 	_ "github.com/wilbur182/forge/internal/adapter/amp"
+	"github.com/wilbur182/forge/internal/adapter/cache"
 	_ "github.com/wilbur182/forge/internal/adapter/claudecode"
 	_ "github.com/wilbur182/forge/internal/adapter/codex"
 	_ "github.com/wilbur182/forge/internal/adapter/cursor"
@@ -28,7 +31,10 @@ import (
 	"github.com/wilbur182/forge/internal/config"
 	"github.com/wilbur182/forge/internal/event"
 	"github.com/wilbur182/forge/internal/features"
+	"github.com/wilbur182/forge/internal/format"
+	"github.com/wilbur182/forge/internal/icons"
 	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/notify"
 	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/plugins/chat"
 	"github.com/wilbur182/forge/internal/plugins/conversations"
@@ -37,6 +43,7 @@ import (
 	"github.com/wilbur182/forge/internal/plugins/notes"
 	"github.com/wilbur182/forge/internal/plugins/tdmonitor"
 	"github.com/wilbur182/forge/internal/plugins/workspace"
+	"github.com/wilbur182/forge/internal/recorder"
 	"github.com/wilbur182/forge/internal/state"
 	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/theme"
@@ -54,6 +61,15 @@ var (
 	shortVersion   = flag.Bool("v", false, "print version and exit (short)")
 	enableFeature  = flag.String("enable-feature", "", "enable a feature flag (comma-separated)")
 	disableFeature = flag.String("disable-feature", "", "disable a feature flag (comma-separated)")
+	setFeature     = flag.String("set-feature", "", "set a variant feature flag as name=value (comma-separated)")
+	printFeatures  = flag.Bool("print-features", false, "on exit, print the feature flags read during the run")
+	redetectFlag   = flag.Bool("redetect", false, "ignore cached adapter detection results")
+	openLink       = flag.String("open", "", "open a forge://conversations/... permalink on startup")
+	recordPath     = flag.String("record", "", "record key and navigation events (no content) to a file")
+	replayPath     = flag.String("replay", "", "replay a recorded script headlessly against the --project fixture and print the final screen")
+	exportTheme    = flag.String("export-theme", "", "write the resolved theme to a JSON theme file and exit")
+	exportKeymap   = flag.String("export-keymap", "", "write the effective keymap to a YAML file and exit")
+	importKeymap   = flag.String("import-keymap", "", "replace the keymap settings in the config with a YAML keymap file and exit")
 )
 
 func main() {
@@ -84,6 +100,24 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Validate --open before doing any setup work
+	var permalink *conversations.Permalink
+	if *openLink != "" {
+		link, err := conversations.ParsePermalink(*openLink)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --open link: %v\n", err)
+			os.Exit(1)
+		}
+		permalink = &link
+	}
+
+	// Replays drive the UI like a user would, so never against the working
+	// directory by default
+	if *replayPath != "" && !flagPassed("project") {
+		fmt.Fprintln(os.Stderr, "--replay needs --project pointing at fixture data")
+		os.Exit(1)
+	}
+
 	// Setup logging to file (never to stderr - it leaks through TUI)
 	logLevel := slog.LevelInfo
 	if *debugFlag {
@@ -117,14 +151,32 @@ func main() {
 
 	// Initialize feature flags
 	features.Init(cfg)
+	for _, err := range features.InvalidEnv() {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
 	applyFeatureOverrides()
 
-	// Load persistent state (ignore errors - state is optional)
-	_ = state.Init()
+	// Load persistent state (ignore errors - state is optional). Replays
+	// start from empty state and caches and leave none behind.
+	dataDir := filepath.Dir(config.ConfigPath())
+	if *replayPath != "" {
+		dir, err := os.MkdirTemp("", "forge-replay")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create replay directory: %v\n", err)
+			os.Exit(1)
+		}
+		defer os.RemoveAll(dir)
+		dataDir = dir
+		_ = state.InitWithDir(dir)
+	} else {
+		_ = state.Init()
+	}
 
 	// Create event dispatcher
 	dispatcher := event.NewWithLogger(logger)
 	defer dispatcher.Close()
+	scheduler := event.NewScheduler(dispatcher)
+	defer scheduler.Close()
 
 	// Convert project root to absolute path
 	workDir, err := filepath.Abs(*projectRoot)
@@ -139,12 +191,93 @@ func main() {
 		projectRootPath = workDir
 	}
 
+	// Project flags override the config file for this repo only
+	if err := features.LoadProject(projectRootPath); err != nil {
+		logger.Warn("project feature flags", "err", err)
+	}
+
+	// "features list|enable|disable" manages flags without starting the UI
+	if flag.NArg() > 0 && flag.Arg(0) == "features" {
+		if err := features.RunCommand(flag.Args()[1:], os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Replays post no webhooks and share no events
+	if *replayPath == "" {
+		// Share events with other instances; an instance connected to a hub
+		// leaves webhooks to it, so each event is posted once however many
+		// instances are open
+		bridge, err := notify.StartBridge(dispatcher, cfg.Events, filepath.Base(projectRootPath), state.Dir(), logger)
+		if err != nil {
+			logger.Warn("event bridge disabled", "err", err)
+		}
+		var webhookOpts []event.SubscribeOption
+		if bridge != nil {
+			defer bridge.Close()
+			webhookOpts = append(webhookOpts, event.WithFilter(func(event.Event) bool { return !bridge.Forwarding() }))
+		}
+		if err := notify.StartWebhooks(dispatcher, cfg.Events.Webhooks, logger, webhookOpts...); err != nil {
+			logger.Warn("skipping invalid webhooks", "err", err)
+		}
+	}
+
+	// Remote flags override the project file and config once fetched
+	if cfg.Features.RemoteURL != "" {
+		var interval time.Duration
+		if cfg.Features.RemoteInterval != "" {
+			d, err := time.ParseDuration(cfg.Features.RemoteInterval)
+			if err != nil {
+				logger.Warn("invalid features.remoteInterval, using default", "err", err)
+			}
+			interval = d
+		}
+		if err := features.StartRemote(context.Background(), cfg.Features.RemoteURL, interval); err != nil {
+			logger.Warn("remote feature flags", "err", err)
+		}
+	}
+
+	// Register custom themes from ~/.config/forge/themes so config can name them
+	_, themeErrs := theme.LoadCustomThemes(theme.CustomThemesDir())
+	for _, err := range themeErrs {
+		logger.Warn("custom theme not loaded", "err", err)
+	}
+
+	// Colorblind mode remaps status colors, so it must be set before the theme is applied
+	if !styles.IsValidColorblindMode(cfg.Accessibility.ColorblindMode) {
+		logger.Warn("unknown accessibility.colorblindMode", "mode", cfg.Accessibility.ColorblindMode)
+	}
+	styles.SetColorblindMode(cfg.Accessibility.ColorblindMode)
+
 	// Apply theme from config (after workDir is known for per-project themes)
 	resolved := theme.ResolveTheme(cfg, workDir)
 	theme.ApplyResolved(resolved)
 
-	// Apply UI settings (Nerd Font features)
+	// Export the resolved theme, including overrides, and exit
+	if *exportTheme != "" {
+		if err := theme.ExportFile(*exportTheme); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Exported theme to %s\n", *exportTheme)
+		os.Exit(0)
+	}
+
+	// Apply UI settings (Nerd Font features, icon set, focus indicators, pane dimming)
 	styles.PillTabsEnabled = cfg.UI.NerdFontsEnabled
+	if !icons.IsValidSet(cfg.UI.Icons) {
+		logger.Warn("unknown ui.icons", "set", cfg.UI.Icons)
+	}
+	icons.Use(icons.Detect(cfg.UI.Icons, cfg.UI.NerdFontsEnabled))
+	styles.DimInactivePanes = cfg.UI.DimInactivePanes
+	if cfg.UI.HighContrastFocus {
+		styles.SetHighContrastFocus(true)
+	}
+
+	// Apply number/currency locale for cost and token formatting
+	format.SetLocale(format.FromConfig(cfg.UI.Locale))
 
 	// Create keymap registry first (plugins may register bindings during Init)
 	km := keymap.NewRegistry()
@@ -158,10 +291,21 @@ func main() {
 		Config:      cfg,
 		Adapters:    make(map[string]adapter.Adapter),
 		EventBus:    dispatcher,
+		Scheduler:   scheduler,
 		Logger:      logger,
 		Keymap:      km,
 	}
 
+	// Load cached adapter detection results so warm startups skip redundant IO.
+	adapter.InitDetectCache(dataDir, *redetectFlag)
+
+	// Persist parsed session metadata so warm startups skip reparsing.
+	if err := cache.OpenStore(dataDir); err != nil {
+		logger.Warn("session cache unavailable", "err", err)
+	}
+	cache.SetBudget(int64(cfg.Plugins.Conversations.CacheBudgetMB) << 20)
+	cache.SetMaxLineSize(cfg.Plugins.Conversations.MaxLineMB << 20)
+
 	// Create all adapter instances upfront so they survive project switches.
 	// Per-project filtering happens in each plugin's Init() via Detect().
 	pluginCtx.Adapters = adapter.AllAdapters()
@@ -170,13 +314,17 @@ func main() {
 	registry := plugin.NewRegistry(pluginCtx)
 
 	// Register plugins (order determines tab order)
-	// Chat plugin is the primary view — registered first
-	if err := registry.Register(chat.New()); err != nil {
-		logger.Warn("failed to register chat plugin", "err", err)
-	}
-	// TD plugin registers its bindings dynamically via p.ctx.Keymap
-	if err := registry.Register(tdmonitor.New()); err != nil {
-		logger.Warn("failed to register tdmonitor plugin", "err", err)
+	// Replays leave out plugins that start agents, tmux sessions, or td
+	// outside the fixture project
+	if *replayPath == "" {
+		// Chat plugin is the primary view — registered first
+		if err := registry.Register(chat.New()); err != nil {
+			logger.Warn("failed to register chat plugin", "err", err)
+		}
+		// TD plugin registers its bindings dynamically via p.ctx.Keymap
+		if err := registry.Register(tdmonitor.New()); err != nil {
+			logger.Warn("failed to register tdmonitor plugin", "err", err)
+		}
 	}
 	if err := registry.Register(gitstatus.New()); err != nil {
 		logger.Warn("failed to register gitstatus plugin", "err", err)
@@ -184,11 +332,17 @@ func main() {
 	if err := registry.Register(filebrowser.New()); err != nil {
 		logger.Warn("failed to register filebrowser plugin", "err", err)
 	}
-	if err := registry.Register(conversations.New()); err != nil {
+	convPlugin := conversations.New()
+	if permalink != nil {
+		convPlugin.OpenPermalink(*permalink)
+	}
+	if err := registry.Register(convPlugin); err != nil {
 		logger.Warn("failed to register conversations plugin", "err", err)
 	}
-	if err := registry.Register(workspace.New()); err != nil {
-		logger.Warn("failed to register workspace plugin", "err", err)
+	if *replayPath == "" {
+		if err := registry.Register(workspace.New()); err != nil {
+			logger.Warn("failed to register workspace plugin", "err", err)
+		}
 	}
 	if features.IsEnabled("notes_plugin") {
 		if err := registry.Register(notes.New()); err != nil {
@@ -196,29 +350,126 @@ func main() {
 		}
 	}
 
-	// Apply user keymap overrides
+	// Apply the keymap profile, then user overrides on top
+	if err := km.SetProfile(cfg.Keymap.Profile); err != nil {
+		logger.Warn("invalid keymap.profile, using default", "err", err)
+	}
+	km.SetLeader(cfg.Keymap.Leader)
+	if cfg.Keymap.ChordTimeout != "" {
+		d, err := time.ParseDuration(cfg.Keymap.ChordTimeout)
+		if err != nil {
+			logger.Warn("invalid keymap.chordTimeout, using default", "err", err)
+		}
+		km.SetSequenceTimeout(d)
+	}
+	if cfg.Keymap.WhichKeyDelay != "" {
+		d, err := time.ParseDuration(cfg.Keymap.WhichKeyDelay)
+		if err != nil {
+			logger.Warn("invalid keymap.whichKeyDelay, ignoring", "err", err)
+		}
+		km.SetWhichKeyDelay(d)
+	}
 	for key, cmdID := range cfg.Keymap.Overrides {
 		km.SetUserOverride(key, cmdID)
 	}
+	for _, c := range km.Conflicts() {
+		logger.Warn("keymap override shadows a binding", "key", c.Key, "context", c.Context, "command", c.Command, "shadowed", c.Shadowed)
+	}
+
+	// Share the keymap, including overrides, and exit
+	if *exportKeymap != "" {
+		if err := km.ExportFile(*exportKeymap); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Exported keymap to %s\n", *exportKeymap)
+		os.Exit(0)
+	}
+	if *importKeymap != "" {
+		if err := importKeymapFile(km, *importKeymap); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 
 	// Create and run application
 	currentVersion := effectiveVersion(Version)
+	for _, f := range features.Expired(currentVersion) {
+		logger.Warn("feature flag is past its expiry and deprecated", "flag", f.Name, "expiresIn", f.ExpiresIn, "owner", f.Owner)
+	}
 	initialPluginID := state.GetActivePlugin(projectRootPath)
-	model := app.New(registry, km, cfg, currentVersion, workDir, projectRootPath, initialPluginID)
+	if permalink != nil {
+		initialPluginID = convPlugin.ID()
+	}
+	cfgPath := *configPath
+	if cfgPath == "" {
+		cfgPath = config.ConfigPath()
+	}
+	model := app.New(registry, km, cfg, cfgPath, currentVersion, workDir, projectRootPath, initialPluginID)
+
+	// Replay a recorded script against the project (no terminal needed)
+	if *replayPath != "" {
+		entries, err := recorder.Load(*replayPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading recording: %v\n", err)
+			os.Exit(1)
+		}
+		final, err := recorder.Replay(context.Background(), model, entries, recorder.ReplayOptions{Settle: time.Second})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error replaying recording: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(final.View())
+		_ = cache.CloseStore()
+		printFeatureUsage()
+		return
+	}
 
 	// Guard against non-interactive terminal (e.g. piped stdout)
 	if !term.IsTerminal(int(os.Stdout.Fd())) {
 		fmt.Fprintln(os.Stderr, "forge requires an interactive terminal")
 		os.Exit(1)
 	}
-	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseAllMotion())
 
-	if _, err := p.Run(); err != nil {
+	var root tea.Model = model
+	var rec *recorder.Recorder
+	if *recordPath != "" {
+		if rec, err = recorder.Create(*recordPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		root = recorder.Wrap(model, rec)
+	}
+	p := tea.NewProgram(root, tea.WithAltScreen(), tea.WithMouseAllMotion())
+
+	_, err = p.Run()
+	if cerr := cache.CloseStore(); cerr != nil {
+		logger.Warn("session cache not saved", "err", cerr)
+	}
+	if rec != nil {
+		if cerr := rec.Close(); cerr != nil {
+			fmt.Fprintf(os.Stderr, "Error writing recording: %v\n", cerr)
+		}
+	}
+	printFeatureUsage()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// flagPassed reports whether the named flag was set on the command line.
+func flagPassed(name string) bool {
+	passed := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			passed = true
+		}
+	})
+	return passed
+}
+
 func loadConfig(path string) (*config.Config, error) {
 	if path != "" {
 		return config.LoadFrom(path)
@@ -226,6 +477,33 @@ func loadConfig(path string) (*config.Config, error) {
 	return config.Load()
 }
 
+// importKeymapFile saves the profile, leader, and overrides that give km's
+// default bindings the keys of a keymap file to the global config,
+// replacing the keymap settings there.
+func importKeymapFile(km *keymap.Registry, path string) error {
+	e, err := keymap.ReadExportFile(path)
+	if err != nil {
+		return err
+	}
+	overrides, skipped := km.ImportOverrides(e)
+	for _, s := range skipped {
+		fmt.Fprintf(os.Stderr, "warning: skipped %s\n", s)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("import keymap: %w", err)
+	}
+	cfg.Keymap.Profile = e.Profile
+	cfg.Keymap.Leader = e.Leader
+	cfg.Keymap.Overrides = overrides
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("import keymap: %w", err)
+	}
+	fmt.Printf("Imported keymap from %s (%d overrides) to %s\n", path, len(overrides), config.ConfigPath())
+	return nil
+}
+
 // effectiveVersion returns the version string, with fallback to build info.
 func effectiveVersion(v string) string {
 	if v != "" {
@@ -286,6 +564,17 @@ func openLogFile() (*os.File, error) {
 	return os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 }
 
+// printFeatureUsage prints which feature flags were read and how they
+// resolved when --print-features is set.
+func printFeatureUsage() {
+	if !*printFeatures {
+		return
+	}
+	if err := features.WriteUsage(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error printing feature usage: %v\n", err)
+	}
+}
+
 // applyFeatureOverrides applies CLI feature flag overrides.
 func applyFeatureOverrides() {
 	if *enableFeature != "" {
@@ -312,4 +601,20 @@ func applyFeatureOverrides() {
 			features.SetOverride(name, false)
 		}
 	}
+	if *setFeature != "" {
+		for _, pair := range strings.Split(*setFeature, ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			name, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				fmt.Fprintf(os.Stderr, "warning: -set-feature wants name=value, got '%s'\n", pair)
+				continue
+			}
+			if err := features.SetValueOverride(strings.TrimSpace(name), strings.TrimSpace(value)); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+			}
+		}
+	}
 }
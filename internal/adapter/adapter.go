@@ -27,6 +27,13 @@ type ProjectDiscoverer interface {
 	DiscoverRelatedProjectDirs(mainWorktreePath string) ([]string, error)
 }
 
+// WorktreeObserver is an optional interface for adapters that cache
+// worktree-derived project data and should drop it when a worktree is
+// created or deleted, instead of waiting for the next full session scan.
+type WorktreeObserver interface {
+	WorktreesChanged(worktreePath string, removed bool)
+}
+
 // TargetedRefresher is an optional interface for adapters that support refreshing
 // a single session by ID without scanning the full directory (td-2b8ebe).
 type TargetedRefresher interface {
@@ -75,6 +82,11 @@ const (
 // CapabilitySet tracks which features an adapter supports.
 type CapabilitySet map[Capability]bool
 
+// Has reports whether the set includes the given capability.
+func (c CapabilitySet) Has(capability Capability) bool {
+	return c[capability]
+}
+
 // Session file size thresholds for performance warnings
 const (
 	LargeSessionThreshold = 100 * 1024 * 1024 // 100MB - show warning
@@ -144,14 +156,22 @@ type ThinkingBlock struct {
 
 // ContentBlock represents a single block in structured message content.
 type ContentBlock struct {
-	Type       string // "text", "tool_use", "tool_result", "thinking"
-	Text       string // For text/thinking blocks
-	ToolUseID  string // For tool_use and tool_result linking
-	ToolName   string // For tool_use
-	ToolInput  string // For tool_use (JSON string)
-	ToolOutput string // For tool_result
-	IsError    bool   // For tool_result errors
-	TokenCount int    // For thinking blocks
+	Type        string // "text", "tool_use", "tool_result", "thinking", "image"
+	Text        string // For text/thinking blocks
+	ToolUseID   string // For tool_use and tool_result linking
+	ToolName    string // For tool_use
+	ToolInput   string // For tool_use (JSON string)
+	ToolOutput  string // For tool_result
+	IsError     bool   // For tool_result errors
+	TokenCount  int    // For thinking blocks
+	MediaType   string // For image blocks (e.g., "image/png")
+	ImageData   string // For image blocks (base64-encoded)
+	ImageWidth  int    // For image blocks (0 if unknown)
+	ImageHeight int    // For image blocks (0 if unknown)
+
+	// ToolOutputRef is the blob key of the full output when ToolOutput
+	// holds a preview (see CompactToolOutputs).
+	ToolOutputRef string
 }
 
 // Message represents a message in a session.
@@ -166,8 +186,19 @@ type Message struct {
 	ThinkingBlocks []ThinkingBlock
 	ContentBlocks  []ContentBlock // Structured content for rich display
 	SourceLabel    string         // Channel badge, e.g. "[TG] Marcus Vorwaller", "[WA]", "[cron] job-name"
+	Source         SourceRef      // Location in the session file (zero if the adapter doesn't track it)
 }
 
+// SourceRef locates a message in its session file (Session.Path).
+// JSONL-backed adapters populate it from the line the message was parsed from.
+type SourceRef struct {
+	Line   int   // 1-based line number (0 = unknown)
+	Offset int64 // byte offset of the start of the line
+}
+
+// Known reports whether the adapter supplied a source location.
+func (r SourceRef) Known() bool { return r.Line > 0 }
+
 // TokenUsage tracks token counts for a message or session.
 type TokenUsage struct {
 	InputTokens  int
@@ -182,6 +213,9 @@ type ToolUse struct {
 	Name   string
 	Input  string
 	Output string
+	// OutputRef is the blob key of the full output when Output holds a
+	// preview (see CompactToolOutputs).
+	OutputRef string
 }
 
 // UsageStats provides aggregate usage statistics.
@@ -207,4 +241,10 @@ const (
 	EventSessionCreated EventType = "session_created"
 	EventSessionUpdated EventType = "session_updated"
 	EventMessageAdded   EventType = "message_added"
+	// EventResync reports that events were dropped because the consumer
+	// fell behind; it carries no session ID and calls for a full reload.
+	EventResync EventType = "resync"
+	// EventWarning carries a message for the user in Data, such as advice
+	// on a system limit that degraded watching.
+	EventWarning EventType = "warning"
 )
@@ -15,6 +15,7 @@ import (
 
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 const (
@@ -55,7 +56,7 @@ func New() *Adapter {
 		threadsDir:   threadsDir,
 		sessionIndex: make(map[string]string),
 		metaCache:    make(map[string]metaCacheEntry),
-		msgCache:     cache.New[msgCacheEntry](msgCacheMaxEntries),
+		msgCache:     cache.New[msgCacheEntry](msgCacheMaxEntries).Named(adapterID + ".messages"),
 	}
 }
 
@@ -131,7 +132,7 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 		}
 
 		path := filepath.Join(a.threadsDir, e.Name())
-		info, err := e.Info()
+		info, err := ingest.Check(adapterID, a.threadsDir, path)
 		if err != nil {
 			continue
 		}
@@ -150,6 +151,12 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the Amp threads directory whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.threadsDir}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -191,7 +198,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		path := filepath.Join(a.threadsDir, e.Name())
 		seenPaths[path] = struct{}{}
 
-		info, err := e.Info()
+		info, err := ingest.Check(adapterID, a.threadsDir, path)
 		if err != nil {
 			continue
 		}
@@ -257,7 +264,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 		return nil, nil
 	}
 
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.threadsDir, path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -280,6 +287,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	}
 
 	if a.msgCache != nil {
+		a.msgCache.Metrics().Full()
 		a.msgCache.Set(path, msgCacheEntry{messages: copyMessages(messages)}, info.Size(), info.ModTime(), 0)
 	}
 
@@ -323,7 +331,7 @@ func (a *Adapter) SessionByID(sessionID string) (*adapter.Session, error) {
 		return nil, fmt.Errorf("session %s not found", sessionID)
 	}
 
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.threadsDir, path)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +372,7 @@ func (a *Adapter) SessionByID(sessionID string) (*adapter.Session, error) {
 
 // threadMatchesProject checks if a thread file is associated with the given project root.
 func (a *Adapter) threadMatchesProject(path, absRoot string) bool {
-	data, err := os.ReadFile(path)
+	data, err := ingest.ReadJSON(adapterID, path)
 	if err != nil {
 		return false
 	}
@@ -433,7 +441,7 @@ func (a *Adapter) threadMetadata(path string, info os.FileInfo) (*threadMeta, er
 
 // parseThreadMeta extracts metadata from a thread JSON file.
 func (a *Adapter) parseThreadMeta(path string) (*threadMeta, error) {
-	data, err := os.ReadFile(path)
+	data, err := ingest.ReadJSON(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -513,7 +521,7 @@ func (a *Adapter) parseThreadMeta(path string) (*threadMeta, error) {
 
 // parseMessages fully parses all messages from a thread file.
 func (a *Adapter) parseMessages(path string) ([]adapter.Message, error) {
-	data, err := os.ReadFile(path)
+	data, err := ingest.ReadJSON(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+const (
+	// BlobThreshold is the size at or above which tool outputs are moved
+	// to the blob store, leaving a preview in the parsed message.
+	BlobThreshold = 16 * 1024
+
+	// blobResidentBytes bounds the blobs kept in memory. Persisted blobs
+	// beyond it are reloaded from disk on demand; others are dropped, and
+	// the sessions referencing them are re-parsed (see BlobsLost).
+	blobResidentBytes = 8 * 1024 * 1024
+)
+
+// blobStore is the content-addressed store for large tool outputs.
+// Identical outputs share one copy however many messages, sessions, or
+// reparses reference them.
+type blobStore struct {
+	mu       sync.Mutex
+	resident map[string]string   // key -> content held in memory
+	order    []string            // resident keys, oldest first
+	bytes    int                 // size of resident content
+	known    map[string]struct{} // keys queued for or written to disk
+	lost     uint64              // evicted blobs that were not on disk
+}
+
+var blobs = &blobStore{
+	resident: make(map[string]string),
+	known:    make(map[string]struct{}),
+}
+
+// BlobKey returns the content address of data.
+func BlobKey(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+// PutBlob stores data and returns its key. With the persistent store open
+// the content is written to disk and may leave memory, to be reloaded by
+// Blob; otherwise it is lost once evicted from memory.
+func PutBlob(data string) string {
+	key := BlobKey(data)
+
+	blobs.mu.Lock()
+	_, known := blobs.known[key]
+	blobs.mu.Unlock()
+
+	persistent := false
+	shared.mu.Lock()
+	if shared.db != nil {
+		persistent = true
+		if !known {
+			shared.pendingBlobs[key] = data
+			if len(shared.pendingBlobs) >= storeFlushThreshold {
+				_ = shared.flushLocked()
+			}
+		}
+	}
+	shared.mu.Unlock()
+
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	if persistent {
+		blobs.known[key] = struct{}{}
+	}
+	blobs.keepLocked(key, data)
+	return key
+}
+
+// Blob returns the content stored under key, loading it from disk if it
+// is no longer in memory.
+func Blob(key string) (string, bool) {
+	blobs.mu.Lock()
+	data, ok := blobs.resident[key]
+	blobs.mu.Unlock()
+	if ok {
+		return data, true
+	}
+
+	shared.mu.Lock()
+	data, ok = shared.pendingBlobs[key]
+	if !ok && shared.db != nil {
+		var b []byte
+		if shared.db.QueryRow(`SELECT data FROM blobs WHERE hash = ?`, key).Scan(&b) == nil {
+			data, ok = string(b), true
+		}
+	}
+	shared.mu.Unlock()
+	if !ok {
+		return "", false
+	}
+
+	blobs.mu.Lock()
+	blobs.keepLocked(key, data)
+	blobs.mu.Unlock()
+	return data, true
+}
+
+// HasBlob reports whether Blob can return the content stored under key,
+// from memory or disk.
+func HasBlob(key string) bool {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	if _, ok := blobs.resident[key]; ok {
+		return true
+	}
+	_, ok := blobs.known[key]
+	return ok
+}
+
+// BlobsLost returns how many blobs have been evicted without a copy on
+// disk. While it is zero, every key PutBlob returned is still available.
+func BlobsLost() uint64 {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	return blobs.lost
+}
+
+// resetKnownLocked forgets which blobs are on disk, e.g. when another
+// database is opened. Must be called with blobs.mu held.
+func (b *blobStore) resetKnownLocked() {
+	b.known = make(map[string]struct{})
+}
+
+// keepLocked makes data resident. Blobs beyond the resident budget are
+// dropped oldest first. Must be called with blobs.mu held.
+func (b *blobStore) keepLocked(key, data string) {
+	if _, ok := b.resident[key]; ok {
+		return
+	}
+	b.resident[key] = data
+	b.order = append(b.order, key)
+	b.bytes += len(data)
+	for b.bytes > blobResidentBytes && len(b.order) > 1 {
+		old := b.order[0]
+		b.order = b.order[1:]
+		b.bytes -= len(b.resident[old])
+		delete(b.resident, old)
+		if _, onDisk := b.known[old]; !onDisk {
+			b.lost++
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBlob_InMemory(t *testing.T) {
+	data := strings.Repeat("in memory ", 4096)
+	key := PutBlob(data)
+	if key != BlobKey(data) {
+		t.Errorf("key = %q, want content address %q", key, BlobKey(data))
+	}
+	if PutBlob(data) != key {
+		t.Error("identical content should share a key")
+	}
+	if got, ok := Blob(key); !ok || got != data {
+		t.Errorf("Blob = %d bytes, %v; want %d bytes", len(got), ok, len(data))
+	}
+	if _, ok := Blob(BlobKey("missing")); ok {
+		t.Error("unknown key should miss")
+	}
+}
+
+func TestBlob_Persisted(t *testing.T) {
+	dir := t.TempDir()
+	if err := OpenStore(dir); err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	t.Cleanup(func() { _ = CloseStore() })
+
+	data := strings.Repeat("persisted ", 4096)
+	key := PutBlob(data)
+	if got, ok := Blob(key); !ok || got != data {
+		t.Error("blob should be readable before flush")
+	}
+	if err := FlushStore(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Drop the resident copy so Blob has to read it back from disk
+	blobs.mu.Lock()
+	delete(blobs.resident, key)
+	blobs.mu.Unlock()
+	if got, ok := Blob(key); !ok || got != data {
+		t.Errorf("Blob after flush = %d bytes, %v; want %d bytes", len(got), ok, len(data))
+	}
+}
+
+func TestBlob_ResidentBound(t *testing.T) {
+	dir := t.TempDir()
+	if err := OpenStore(dir); err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	t.Cleanup(func() { _ = CloseStore() })
+
+	chunk := blobResidentBytes / 4
+	var keys []string
+	for i := range 8 {
+		keys = append(keys, PutBlob(strings.Repeat(string(rune('a'+i)), chunk)))
+	}
+	blobs.mu.Lock()
+	bytes := blobs.bytes
+	_, oldest := blobs.resident[keys[0]]
+	blobs.mu.Unlock()
+	if bytes > blobResidentBytes {
+		t.Errorf("resident bytes = %d, want <= %d", bytes, blobResidentBytes)
+	}
+	if oldest {
+		t.Error("oldest persisted blob should have left memory")
+	}
+	if got, ok := Blob(keys[0]); !ok || len(got) != chunk {
+		t.Error("evicted blob should reload from disk")
+	}
+}
+
+func TestBlob_InMemoryBound(t *testing.T) {
+	chunk := blobResidentBytes / 4
+	lost := BlobsLost()
+	var keys []string
+	for i := range 8 {
+		keys = append(keys, PutBlob(strings.Repeat(string(rune('A'+i)), chunk)))
+	}
+	blobs.mu.Lock()
+	bytes := blobs.bytes
+	blobs.mu.Unlock()
+	if bytes > blobResidentBytes {
+		t.Errorf("resident bytes = %d, want <= %d", bytes, blobResidentBytes)
+	}
+	if HasBlob(keys[0]) || BlobsLost() == lost {
+		t.Error("oldest in-memory blob should be evicted and counted as lost")
+	}
+	if !HasBlob(keys[7]) {
+		t.Error("newest blob should stay resident")
+	}
+}
@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"weak"
+)
+
+// DefaultBudget is the global byte budget used until SetBudget is called.
+const DefaultBudget int64 = 256 << 20
+
+// budgetLowWater is the fraction of the budget eviction shrinks usage to,
+// so a cache at the limit doesn't evict on every Set.
+const budgetLowWater = 0.8
+
+// budgeted is a cache that counts toward the global byte budget.
+type budgeted interface {
+	// snapshot appends the cache's entries, or reports false once the
+	// cache has been garbage collected.
+	snapshot(dst []victim) ([]victim, bool)
+	// alive reports whether the cache has not been garbage collected.
+	alive() bool
+}
+
+// victim is an eviction candidate.
+type victim struct {
+	cache      evictor
+	key        string
+	lastAccess time.Time
+	weight     int64
+}
+
+type evictor interface {
+	evictIfUnused(key string, lastAccess time.Time) int64
+}
+
+// budget tracks bytes held by all caches created with New. Caches register
+// through weak pointers so dropping an adapter releases its cache.
+var budget = struct {
+	mu     sync.Mutex // serializes eviction passes and registration
+	limit  atomic.Int64
+	used   atomic.Int64
+	caches []budgeted
+}{}
+
+func init() {
+	budget.limit.Store(DefaultBudget)
+}
+
+// SetBudget sets the global byte budget shared by all caches. Entries are
+// weighed by the size of the file they were parsed from, and when the total
+// exceeds the budget the least recently used entries across every cache are
+// evicted. A budget of 0 or less restores DefaultBudget.
+func SetBudget(bytes int64) {
+	if bytes <= 0 {
+		bytes = DefaultBudget
+	}
+	budget.limit.Store(bytes)
+	relievePressure()
+}
+
+// Budget returns the global byte budget.
+func Budget() int64 {
+	return budget.limit.Load()
+}
+
+// BudgetUsed returns the bytes currently held by all caches.
+func BudgetUsed() int64 {
+	return budget.used.Load()
+}
+
+// weakCache lets the budget reach a cache without keeping it alive.
+type weakCache[T any] struct {
+	p weak.Pointer[Cache[T]]
+}
+
+func (w weakCache[T]) snapshot(dst []victim) ([]victim, bool) {
+	c := w.p.Value()
+	if c == nil {
+		return dst, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for key, entry := range c.entries {
+		dst = append(dst, victim{c, key, entry.LastAccess, entry.Size})
+	}
+	return dst, true
+}
+
+func (w weakCache[T]) alive() bool {
+	return w.p.Value() != nil
+}
+
+// registerBudget adds c to the budget, dropping caches that were collected.
+func registerBudget[T any](c *Cache[T]) {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	live := budget.caches[:0]
+	for _, b := range budget.caches {
+		if b.alive() {
+			live = append(live, b)
+		}
+	}
+	clear(budget.caches[len(live):])
+	budget.caches = append(live, weakCache[T]{weak.Make(c)})
+}
+
+// chargeBudget adjusts the global usage by delta bytes and evicts if the
+// budget is exceeded. Must be called without any cache lock held.
+func chargeBudget(delta int64) {
+	if budget.used.Add(delta) > budget.limit.Load() {
+		relievePressure()
+	}
+}
+
+// relievePressure evicts the least recently used entries across all caches
+// until usage drops to the low-water mark.
+func relievePressure() {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	limit := budget.limit.Load()
+	if budget.used.Load() <= limit {
+		return
+	}
+
+	var victims []victim
+	live := budget.caches[:0]
+	for _, c := range budget.caches {
+		var ok bool
+		if victims, ok = c.snapshot(victims); ok {
+			live = append(live, c)
+		}
+	}
+	clear(budget.caches[len(live):])
+	budget.caches = live
+
+	// Recount from the live caches so collected caches stop counting.
+	var total int64
+	for _, v := range victims {
+		total += v.weight
+	}
+	budget.used.Store(total)
+
+	target := int64(float64(limit) * budgetLowWater)
+	if total <= limit {
+		return
+	}
+	sort.Slice(victims, func(i, j int) bool {
+		return victims[i].lastAccess.Before(victims[j].lastAccess)
+	})
+	for _, v := range victims {
+		if budget.used.Load() <= target {
+			break
+		}
+		// An entry touched since the snapshot is no longer least recent.
+		if freed := v.cache.evictIfUnused(v.key, v.lastAccess); freed > 0 {
+			budget.used.Add(-freed)
+		}
+	}
+}
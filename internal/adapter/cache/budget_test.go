@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestBudget_EvictsLeastRecentAcrossCaches(t *testing.T) {
+	// Drop caches left over from other tests so they don't count.
+	runtime.GC()
+	SetBudget(1000)
+	t.Cleanup(func() { SetBudget(0) })
+
+	c1 := New[string](10)
+	c2 := New[string](10)
+	now := time.Now()
+
+	c1.Set("a", "a", 400, now, 0)
+	time.Sleep(time.Millisecond)
+	c2.Set("b", "b", 400, now, 0)
+	time.Sleep(time.Millisecond)
+	c1.Set("c", "c", 400, now, 0)
+
+	if _, ok := c1.Get("a", 400, now); ok {
+		t.Error("least recently used entry should be evicted over budget")
+	}
+	if _, ok := c2.Get("b", 400, now); !ok {
+		t.Error("entry in other cache should survive once under the low-water mark")
+	}
+	if _, ok := c1.Get("c", 400, now); !ok {
+		t.Error("newest entry should survive")
+	}
+	if got := BudgetUsed(); got != 800 {
+		t.Errorf("BudgetUsed = %d; want 800", got)
+	}
+	if got := c1.Bytes(); got != 400 {
+		t.Errorf("c1.Bytes = %d; want 400", got)
+	}
+
+	// Replacing and deleting entries adjust the usage
+	c2.Set("b", "b", 100, now, 0)
+	c1.Delete("c")
+	if got := BudgetUsed(); got != 100 {
+		t.Errorf("BudgetUsed after delete = %d; want 100", got)
+	}
+	runtime.KeepAlive(c1)
+	runtime.KeepAlive(c2)
+}
+
+func TestSetBudget_Default(t *testing.T) {
+	SetBudget(-1)
+	if got := Budget(); got != DefaultBudget {
+		t.Errorf("Budget = %d; want %d", got, DefaultBudget)
+	}
+}
@@ -16,19 +16,39 @@ type Entry[T any] struct {
 	ByteOffset int64 // for incremental parsing
 }
 
-// Cache is a thread-safe generic cache with LRU eviction.
+// Cache is a thread-safe generic cache with LRU eviction. Besides its own
+// entry limit, every cache counts toward the global byte budget (see
+// SetBudget).
 type Cache[T any] struct {
 	entries map[string]Entry[T]
 	mu      sync.RWMutex
 	maxSize int
+	metrics *Counters
 }
 
 // New creates a new cache with the specified maximum number of entries.
 func New[T any](maxSize int) *Cache[T] {
-	return &Cache[T]{
+	c := &Cache[T]{
 		entries: make(map[string]Entry[T]),
 		maxSize: maxSize,
+		metrics: Metrics("cache"),
 	}
+	registerBudget(c)
+	return c
+}
+
+// Named reports the cache's counters under name, e.g. "codex.messages",
+// and returns the cache for chaining after New.
+func (c *Cache[T]) Named(name string) *Cache[T] {
+	c.metrics = Metrics(name)
+	return c
+}
+
+// Metrics returns the cache's counters. Get and GetWithOffset record hits
+// and misses themselves; callers that parse on a miss or growth record
+// Incremental or Full, and callers of GetWithOffset record their own hits.
+func (c *Cache[T]) Metrics() *Counters {
+	return c.metrics
 }
 
 // Get returns cached data if the file hasn't changed.
@@ -39,6 +59,7 @@ func (c *Cache[T]) Get(key string, size int64, modTime time.Time) (T, bool) {
 
 	entry, ok := c.entries[key]
 	if !ok {
+		c.metrics.Miss()
 		var zero T
 		return zero, false
 	}
@@ -48,6 +69,7 @@ func (c *Cache[T]) Get(key string, size int64, modTime time.Time) (T, bool) {
 		return zero, false
 	}
 
+	c.metrics.Hit()
 	entry.LastAccess = time.Now()
 	c.entries[key] = entry
 	return entry.Data, true
@@ -63,6 +85,7 @@ func (c *Cache[T]) GetWithOffset(key string) (T, int64, int64, time.Time, bool)
 
 	entry, ok := c.entries[key]
 	if !ok {
+		c.metrics.Miss()
 		var zero T
 		return zero, 0, 0, time.Time{}, false
 	}
@@ -73,8 +96,10 @@ func (c *Cache[T]) GetWithOffset(key string) (T, int64, int64, time.Time, bool)
 // Set stores data in the cache with file metadata.
 func (c *Cache[T]) Set(key string, data T, size int64, modTime time.Time, offset int64) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	delta := size
+	if old, ok := c.entries[key]; ok {
+		delta -= old.Size
+	}
 	c.entries[key] = Entry[T]{
 		Data:       data,
 		ModTime:    modTime,
@@ -82,39 +107,78 @@ func (c *Cache[T]) Set(key string, data T, size int64, modTime time.Time, offset
 		LastAccess: time.Now(),
 		ByteOffset: offset,
 	}
+	delta -= c.evictOldestLocked()
+	c.mu.Unlock()
 
-	c.evictOldestLocked()
+	chargeBudget(delta)
 }
 
 // Delete removes an entry from the cache.
 func (c *Cache[T]) Delete(key string) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	delete(c.entries, key)
+	freed := c.deleteLocked(key)
+	c.mu.Unlock()
+	chargeBudget(-freed)
 }
 
 // DeleteIf removes entries matching the predicate.
 func (c *Cache[T]) DeleteIf(pred func(key string, entry Entry[T]) bool) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	var freed int64
 	for key, entry := range c.entries {
 		if pred(key, entry) {
-			delete(c.entries, key)
+			freed += c.deleteLocked(key)
 		}
 	}
+	c.mu.Unlock()
+	chargeBudget(-freed)
 }
 
 // InvalidateIfChanged removes entry if file metadata has changed.
 func (c *Cache[T]) InvalidateIfChanged(key string, size int64, modTime time.Time) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	var freed int64
 	if entry, ok := c.entries[key]; ok {
 		if entry.Size != size || !entry.ModTime.Equal(modTime) {
-			delete(c.entries, key)
+			freed = c.deleteLocked(key)
 		}
 	}
+	c.mu.Unlock()
+	chargeBudget(-freed)
+}
+
+// Bytes returns the bytes this cache counts toward the global budget.
+func (c *Cache[T]) Bytes() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var total int64
+	for _, entry := range c.entries {
+		total += entry.Size
+	}
+	return total
+}
+
+// deleteLocked removes key and returns the bytes it freed.
+// Must be called with lock held.
+func (c *Cache[T]) deleteLocked(key string) int64 {
+	entry, ok := c.entries[key]
+	if !ok {
+		return 0
+	}
+	delete(c.entries, key)
+	return entry.Size
+}
+
+// evictIfUnused removes key for budget pressure unless it was accessed
+// after lastAccess, returning the bytes freed.
+func (c *Cache[T]) evictIfUnused(key string, lastAccess time.Time) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok && entry.LastAccess.Equal(lastAccess) {
+		c.metrics.Evicted(1)
+		return c.deleteLocked(key)
+	}
+	return 0
 }
 
 // Len returns the number of entries in the cache.
@@ -124,12 +188,12 @@ func (c *Cache[T]) Len() int {
 	return len(c.entries)
 }
 
-// evictOldestLocked removes oldest entries when over capacity.
-// Must be called with lock held.
-func (c *Cache[T]) evictOldestLocked() {
+// evictOldestLocked removes oldest entries when over capacity and returns
+// the bytes freed. Must be called with lock held.
+func (c *Cache[T]) evictOldestLocked() int64 {
 	excess := len(c.entries) - c.maxSize
 	if excess <= 0 {
-		return
+		return 0
 	}
 
 	type keyAccess struct {
@@ -145,9 +209,12 @@ func (c *Cache[T]) evictOldestLocked() {
 		return entries[i].lastAccess.Before(entries[j].lastAccess)
 	})
 
+	var freed int64
 	for i := range excess {
-		delete(c.entries, entries[i].key)
+		freed += c.deleteLocked(entries[i].key)
 	}
+	c.metrics.Evicted(excess)
+	return freed
 }
 
 // FileChanged checks if a file has changed since a cached entry.
@@ -1,4 +1,14 @@
 // Package cache provides a generic thread-safe LRU cache with file-metadata
-// invalidation, along with incremental, tail, and head JSONL readers that
-// share pooled scanner buffers.
+// invalidation and a global byte budget shared by all caches, along with
+// incremental, tail, and head JSONL readers that share pooled scanner
+// buffers; tail and head readers memory-map idle files above MmapThreshold.
+// LineScanner skips lines over MaxLineSize, recording them for diagnostics,
+// rather than stopping at the first one.
+// Persistent caches keep per-file records in a shared SQLite database so
+// warm startups skip reparsing unchanged files. SessionIndex combines these
+// into the per-file metadata cache and session ID index that adapters share.
+// Large tool outputs live once in a content-addressed blob store, persisted
+// alongside the records when the database is open.
+// Named caches report hit, miss, parse, and eviction counts through Snapshot
+// for the diagnostics overlay.
 package cache
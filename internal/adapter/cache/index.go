@@ -0,0 +1,222 @@
+package cache
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultIndexEntries is the SessionIndex size used when MaxEntries is zero.
+const DefaultIndexEntries = 2048
+
+// Parser parses one session file for a SessionIndex.
+type Parser[T any] struct {
+	// Full parses the file from the start. It returns the state and the
+	// offset to resume from when the file grows, or 0 if it can't resume.
+	Full func(path string, info os.FileInfo) (T, int64, error)
+	// Incremental resumes from offset with the state parsed for the file's
+	// previous size. Optional; an error falls back to Full.
+	Incremental func(path string, info os.FileInfo, prev T, offset int64) (T, int64, error)
+}
+
+// SessionIndex caches parsed per-file session state, such as metadata,
+// keyed by path and validated by size and modification time. Files that
+// grew are re-parsed incrementally from the saved byte offset. It also maps
+// session IDs to file paths. The zero value is ready to use.
+type SessionIndex[T any] struct {
+	// MaxEntries caps cached files; the least recently used are evicted.
+	// Zero means DefaultIndexEntries.
+	MaxEntries int
+	// Store, when set, backs the cache with the persistent store so
+	// entries survive restarts. T must then be JSON-encodable.
+	Store *Persistent[T]
+	// Name reports the index's counters under Metrics(Name). Default: "index".
+	Name string
+
+	mu      sync.Mutex
+	entries map[string]Entry[T]
+
+	idMu  sync.RWMutex
+	paths map[string]string // session ID -> file path
+}
+
+// Get returns the state for path, parsing the file with p only when the
+// cached entry is missing or stale.
+func (x *SessionIndex[T]) Get(path string, info os.FileInfo, p Parser[T]) (T, error) {
+	now := time.Now()
+
+	x.mu.Lock()
+	entry, cached := x.entries[path]
+	if !cached && x.Store != nil {
+		if rec, ok := x.Store.Get(path); ok {
+			entry = Entry[T]{Data: rec.Data, ModTime: rec.ModTime, Size: rec.Size, ByteOffset: rec.ByteOffset}
+			cached = true
+		}
+	}
+	if cached && entry.Size == info.Size() && entry.ModTime.Equal(info.ModTime()) {
+		// Exact cache hit (unchanged file)
+		entry.LastAccess = now
+		x.setLocked(path, entry)
+		x.mu.Unlock()
+		x.metrics().Hit()
+		return entry.Data, nil
+	}
+	x.mu.Unlock()
+	if !cached {
+		x.metrics().Miss()
+	}
+
+	if cached && p.Incremental != nil && info.Size() > entry.Size && entry.ByteOffset > 0 {
+		// File grew - resume from the saved offset, unless the line before
+		// it was torn
+		if err := verifyCheckpointFile(path, entry.ByteOffset); errors.Is(err, ErrBadCheckpoint) {
+			x.metrics().Recovered()
+		} else {
+			data, offset, err := p.Incremental(path, info, entry.Data, entry.ByteOffset)
+			if err == nil {
+				x.metrics().Incremental()
+				x.put(path, info, data, offset, now)
+				return data, nil
+			}
+		}
+		// Fall through to full parse on error
+	}
+
+	x.metrics().Full()
+	data, offset, err := p.Full(path, info)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	x.put(path, info, data, offset, now)
+	return data, nil
+}
+
+// Cached returns the cached entry for path without validating it.
+func (x *SessionIndex[T]) Cached(path string) (Entry[T], bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	entry, ok := x.entries[path]
+	return entry, ok
+}
+
+// put caches freshly parsed state and writes it through to the Store.
+func (x *SessionIndex[T]) put(path string, info os.FileInfo, data T, offset int64, now time.Time) {
+	entry := Entry[T]{Data: data, ModTime: info.ModTime(), Size: info.Size(), LastAccess: now, ByteOffset: offset}
+	if x.Store != nil {
+		x.Store.Put(path, Record[T]{Data: data, Size: entry.Size, ModTime: entry.ModTime, ByteOffset: offset})
+	}
+	x.mu.Lock()
+	x.setLocked(path, entry)
+	x.enforceLimitLocked()
+	x.mu.Unlock()
+}
+
+func (x *SessionIndex[T]) setLocked(path string, entry Entry[T]) {
+	if x.entries == nil {
+		x.entries = make(map[string]Entry[T])
+	}
+	x.entries[path] = entry
+}
+
+// Retain drops cached entries whose path keep rejects, e.g. files that
+// vanished from a scanned directory.
+func (x *SessionIndex[T]) Retain(keep func(path string) bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for path := range x.entries {
+		if !keep(path) {
+			delete(x.entries, path)
+			if x.Store != nil {
+				x.Store.Delete(path)
+			}
+		}
+	}
+	x.enforceLimitLocked()
+}
+
+// InvalidateIfChanged drops the entry for path if the file changed.
+func (x *SessionIndex[T]) InvalidateIfChanged(path string, info os.FileInfo) {
+	if info == nil {
+		return
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if entry, ok := x.entries[path]; ok {
+		if entry.Size != info.Size() || !entry.ModTime.Equal(info.ModTime()) {
+			delete(x.entries, path)
+		}
+	}
+}
+
+// Len returns the number of cached files.
+func (x *SessionIndex[T]) Len() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return len(x.entries)
+}
+
+// enforceLimitLocked evicts the least recently used entries over capacity.
+// Must be called with x.mu held.
+func (x *SessionIndex[T]) enforceLimitLocked() {
+	limit := x.MaxEntries
+	if limit <= 0 {
+		limit = DefaultIndexEntries
+	}
+	excess := len(x.entries) - limit
+	if excess <= 0 {
+		return
+	}
+
+	type pathAccess struct {
+		path       string
+		lastAccess time.Time
+	}
+	entries := make([]pathAccess, 0, len(x.entries))
+	for path, entry := range x.entries {
+		entries = append(entries, pathAccess{path, entry.LastAccess})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastAccess.Before(entries[j].lastAccess)
+	})
+	for i := range excess {
+		delete(x.entries, entries[i].path)
+	}
+	x.metrics().Evicted(excess)
+}
+
+func (x *SessionIndex[T]) metrics() *Counters {
+	name := x.Name
+	if name == "" {
+		name = "index"
+	}
+	return Metrics(name)
+}
+
+// Path returns the file path indexed for a session ID.
+func (x *SessionIndex[T]) Path(sessionID string) (string, bool) {
+	x.idMu.RLock()
+	defer x.idMu.RUnlock()
+	path, ok := x.paths[sessionID]
+	return path, ok && path != ""
+}
+
+// SetPath records the file path for a session ID.
+func (x *SessionIndex[T]) SetPath(sessionID, path string) {
+	x.idMu.Lock()
+	defer x.idMu.Unlock()
+	if x.paths == nil {
+		x.paths = make(map[string]string)
+	}
+	x.paths[sessionID] = path
+}
+
+// ReplacePaths swaps in a freshly built session ID to path map, typically
+// after a full directory scan.
+func (x *SessionIndex[T]) ReplacePaths(paths map[string]string) {
+	x.idMu.Lock()
+	defer x.idMu.Unlock()
+	x.paths = paths
+}
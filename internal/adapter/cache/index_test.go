@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// countingParser parses a file as its length and counts calls.
+type countingParser struct {
+	full, incremental int
+}
+
+func (c *countingParser) parser() Parser[int64] {
+	return Parser[int64]{
+		Full: func(_ string, info os.FileInfo) (int64, int64, error) {
+			c.full++
+			return info.Size(), info.Size(), nil
+		},
+		Incremental: func(_ string, info os.FileInfo, prev int64, offset int64) (int64, int64, error) {
+			c.incremental++
+			return prev + info.Size() - offset, info.Size(), nil
+		},
+	}
+}
+
+func writeIndexFile(t *testing.T, path, content string, modTime time.Time) os.FileInfo {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func TestSessionIndex_Get(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "s.jsonl")
+	base := time.Unix(1700000000, 0)
+	var idx SessionIndex[int64]
+	var c countingParser
+
+	info := writeIndexFile(t, path, "abc\n", base)
+	if got, err := idx.Get(path, info, c.parser()); err != nil || got != 4 {
+		t.Fatalf("first Get = %d, %v; want 4", got, err)
+	}
+	if _, err := idx.Get(path, info, c.parser()); err != nil {
+		t.Fatal(err)
+	}
+	if c.full != 1 || c.incremental != 0 {
+		t.Errorf("unchanged file: full=%d incremental=%d; want 1, 0", c.full, c.incremental)
+	}
+
+	// Growth resumes from the saved offset
+	info = writeIndexFile(t, path, "abc\ne\n", base.Add(time.Second))
+	if got, _ := idx.Get(path, info, c.parser()); got != 6 {
+		t.Errorf("grown Get = %d; want 6", got)
+	}
+	if c.full != 1 || c.incremental != 1 {
+		t.Errorf("grown file: full=%d incremental=%d; want 1, 1", c.full, c.incremental)
+	}
+
+	// Shrinking forces a full parse
+	info = writeIndexFile(t, path, "a\n", base.Add(2*time.Second))
+	if got, _ := idx.Get(path, info, c.parser()); got != 2 {
+		t.Errorf("shrunk Get = %d; want 2", got)
+	}
+	if c.full != 2 {
+		t.Errorf("shrunk file: full=%d; want 2", c.full)
+	}
+
+	// InvalidateIfChanged drops stale entries only
+	idx.InvalidateIfChanged(path, info)
+	if idx.Len() != 1 {
+		t.Error("unchanged entry should survive InvalidateIfChanged")
+	}
+	info = writeIndexFile(t, path, "abc", base.Add(3*time.Second))
+	idx.InvalidateIfChanged(path, info)
+	if _, ok := idx.Cached(path); ok {
+		t.Error("changed entry should be invalidated")
+	}
+}
+
+func TestSessionIndex_TornCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "s.jsonl")
+	base := time.Unix(1700000000, 0)
+	idx := SessionIndex[int64]{Name: "test.index.torn"}
+	var c countingParser
+
+	// The writer crashed mid-line; the parser still resumes after it
+	info := writeIndexFile(t, path, "a\nbc", base)
+	_, _ = idx.Get(path, info, c.parser())
+
+	// Completing the line leaves the saved offset mid-line
+	info = writeIndexFile(t, path, "a\nbcd\n", base.Add(time.Second))
+	if got, _ := idx.Get(path, info, c.parser()); got != 6 {
+		t.Errorf("Get after torn line = %d; want 6", got)
+	}
+	if c.full != 2 || c.incremental != 0 {
+		t.Errorf("torn checkpoint: full=%d incremental=%d; want 2, 0", c.full, c.incremental)
+	}
+	if st := statsFor(t, "test.index.torn"); st.Recoveries != 1 {
+		t.Errorf("Recoveries = %d; want 1", st.Recoveries)
+	}
+}
+
+func TestSessionIndex_RetainAndLimit(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Unix(1700000000, 0)
+	idx := SessionIndex[int64]{MaxEntries: 2}
+	var c countingParser
+
+	paths := []string{"a", "b", "c"}
+	for i, name := range paths {
+		paths[i] = filepath.Join(dir, name)
+		info := writeIndexFile(t, paths[i], name, base)
+		if _, err := idx.Get(paths[i], info, c.parser()); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if idx.Len() != 2 {
+		t.Fatalf("Len = %d; want 2", idx.Len())
+	}
+	if _, ok := idx.Cached(paths[0]); ok {
+		t.Error("least recently used entry should be evicted")
+	}
+
+	idx.Retain(func(path string) bool { return path != paths[1] })
+	if _, ok := idx.Cached(paths[1]); ok {
+		t.Error("Retain should drop rejected paths")
+	}
+	if _, ok := idx.Cached(paths[2]); !ok {
+		t.Error("Retain should keep accepted paths")
+	}
+}
+
+func TestSessionIndex_Paths(t *testing.T) {
+	var idx SessionIndex[int64]
+	if _, ok := idx.Path("s1"); ok {
+		t.Error("empty index should miss")
+	}
+	idx.SetPath("s1", "/a")
+	idx.SetPath("s2", "")
+	if p, ok := idx.Path("s1"); !ok || p != "/a" {
+		t.Errorf("Path(s1) = %q, %v", p, ok)
+	}
+	if _, ok := idx.Path("s2"); ok {
+		t.Error("empty paths should miss")
+	}
+	idx.ReplacePaths(map[string]string{"s3": "/c"})
+	if _, ok := idx.Path("s1"); ok {
+		t.Error("ReplacePaths should drop old IDs")
+	}
+	if p, _ := idx.Path("s3"); p != "/c" {
+		t.Errorf("Path(s3) = %q", p)
+	}
+}
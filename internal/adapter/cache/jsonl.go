@@ -1,7 +1,7 @@
 package cache
 
 import (
-	"bufio"
+	"errors"
 	"io"
 	"os"
 	"sync"
@@ -10,7 +10,7 @@ import (
 const (
 	// DefaultScannerBufSize is the initial buffer size for JSONL scanning (1MB).
 	DefaultScannerBufSize = 1024 * 1024
-	// DefaultScannerMaxSize is the maximum buffer size for JSONL scanning (10MB).
+	// DefaultScannerMaxSize is the default MaxLineSize for JSONL scanning (10MB).
 	DefaultScannerMaxSize = 10 * 1024 * 1024
 )
 
@@ -31,31 +31,63 @@ func PutScannerBuffer(buf []byte) {
 	ScannerPool.Put(buf) //nolint:staticcheck // SA6002: sync.Pool requires interface{}, slice is efficient for this use
 }
 
-// NewScanner creates a buffered scanner configured for JSONL files.
+// NewScanner creates a LineScanner with a pooled buffer for JSONL files.
 // The caller must call PutScannerBuffer(buf) when done with the scanner.
-func NewScanner(r io.Reader) (*bufio.Scanner, []byte) {
-	scanner := bufio.NewScanner(r)
+func NewScanner(r io.Reader) (*LineScanner, []byte) {
 	buf := GetScannerBuffer()
-	scanner.Buffer(buf, DefaultScannerMaxSize)
-	return scanner, buf
+	return NewLineScanner(r, buf), buf
+}
+
+// ErrBadCheckpoint is returned when a saved resume offset no longer follows
+// a complete line, e.g. because the agent crashed mid-write and the torn
+// last line was counted as parsed. Callers fall back to a full parse.
+var ErrBadCheckpoint = errors.New("cache: resume offset does not follow a complete line")
+
+// VerifyCheckpoint re-verifies that the line parsed just before offset was
+// complete, i.e. that offset follows a newline. An offset of 0 is always
+// valid.
+func VerifyCheckpoint(r io.ReaderAt, offset int64) error {
+	if offset <= 0 {
+		return nil
+	}
+	var b [1]byte
+	if _, err := r.ReadAt(b[:], offset-1); err != nil || b[0] != '\n' {
+		return ErrBadCheckpoint
+	}
+	return nil
+}
+
+// verifyCheckpointFile is VerifyCheckpoint for a file path.
+func verifyCheckpointFile(path string, offset int64) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	return VerifyCheckpoint(file, offset)
 }
 
 // IncrementalReader wraps a file for incremental JSONL reading from an offset.
 type IncrementalReader struct {
 	file    *os.File
-	scanner *bufio.Scanner
+	scanner *LineScanner
 	buf     []byte
-	offset  int64
+	start   int64
 }
 
 // NewIncrementalReader opens a file and seeks to the given offset for reading.
-// Returns an IncrementalReader that tracks bytes read for caching.
+// Returns an IncrementalReader that tracks bytes read for caching, or
+// ErrBadCheckpoint if the offset doesn't follow a complete line.
 func NewIncrementalReader(path string, startOffset int64) (*IncrementalReader, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := VerifyCheckpoint(file, startOffset); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
 	if startOffset > 0 {
 		if _, err := file.Seek(startOffset, io.SeekStart); err != nil {
 			_ = file.Close()
@@ -64,13 +96,11 @@ func NewIncrementalReader(path string, startOffset int64) (*IncrementalReader, e
 	}
 
 	r := &IncrementalReader{
-		file:   file,
-		offset: startOffset,
+		file:  file,
+		start: startOffset,
 	}
 
-	r.scanner = bufio.NewScanner(file)
-	r.buf = GetScannerBuffer()
-	r.scanner.Buffer(r.buf, DefaultScannerMaxSize)
+	r.scanner, r.buf = NewScanner(file)
 
 	return r, nil
 }
@@ -86,14 +116,12 @@ func (r *IncrementalReader) Next() ([]byte, error) {
 		return nil, io.EOF
 	}
 
-	line := r.scanner.Bytes()
-	r.offset += int64(len(line)) + 1 // +1 for newline
-	return line, nil
+	return r.scanner.Bytes(), nil
 }
 
 // Offset returns the current byte offset in the file.
 func (r *IncrementalReader) Offset() int64 {
-	return r.offset
+	return r.start + r.scanner.Offset()
 }
 
 // Close releases resources associated with the reader.
@@ -106,10 +134,14 @@ func (r *IncrementalReader) Close() error {
 }
 
 // TailReader reads the last N bytes of a file for efficient tail parsing.
+// Files of at least MmapThreshold bytes, idle for MmapQuietPeriod, are
+// memory-mapped.
 type TailReader struct {
 	file    *os.File
-	scanner *bufio.Scanner
+	scanner *LineScanner
 	buf     []byte
+	mapped  []byte // whole-file mapping, nil when scanning
+	lines   mappedLines
 	skipped bool // whether we've skipped the first partial line
 }
 
@@ -128,6 +160,16 @@ func NewTailReader(path string, tailSize int64) (*TailReader, error) {
 	}
 
 	offset := stat.Size() - tailSize
+	r := &TailReader{
+		file:    file,
+		skipped: offset <= 0, // no need to skip if reading from start
+	}
+
+	if r.mapped = mapForRead(file, stat); r.mapped != nil {
+		r.lines = newMappedLines(r.mapped, int(max(offset, 0)), path)
+		return r, nil
+	}
+
 	if offset > 0 {
 		if _, err := file.Seek(offset, io.SeekStart); err != nil {
 			_ = file.Close()
@@ -135,14 +177,7 @@ func NewTailReader(path string, tailSize int64) (*TailReader, error) {
 		}
 	}
 
-	r := &TailReader{
-		file:    file,
-		skipped: offset <= 0, // no need to skip if reading from start
-	}
-
-	r.scanner = bufio.NewScanner(file)
-	r.buf = GetScannerBuffer()
-	r.scanner.Buffer(r.buf, DefaultScannerMaxSize)
+	r.scanner, r.buf = NewScanner(file)
 
 	return r, nil
 }
@@ -150,6 +185,23 @@ func NewTailReader(path string, tailSize int64) (*TailReader, error) {
 // Next returns the next line from the tail.
 // The first call skips the partial line after seeking.
 func (r *TailReader) Next() ([]byte, error) {
+	if r.mapped != nil {
+		if !r.skipped {
+			r.skipped = true
+			if _, _, err := r.lines.next(); err != nil {
+				return nil, err
+			}
+		}
+		line, ok, err := r.lines.next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, io.EOF
+		}
+		return line, nil
+	}
+
 	// Skip first partial line after seek
 	if !r.skipped {
 		r.skipped = true
@@ -178,14 +230,22 @@ func (r *TailReader) Close() error {
 		PutScannerBuffer(r.buf)
 		r.buf = nil
 	}
+	if r.mapped != nil {
+		_ = unmapFile(r.mapped)
+		r.mapped = nil
+	}
 	return r.file.Close()
 }
 
 // HeadReader reads the first N lines of a file for efficient head parsing.
+// Files of at least MmapThreshold bytes, idle for MmapQuietPeriod, are
+// memory-mapped.
 type HeadReader struct {
 	file      *os.File
-	scanner   *bufio.Scanner
+	scanner   *LineScanner
 	buf       []byte
+	mapped    []byte // whole-file mapping, nil when scanning
+	lines     mappedLines
 	maxLines  int
 	lineCount int
 	offset    int64
@@ -203,9 +263,14 @@ func NewHeadReader(path string, maxLines int) (*HeadReader, error) {
 		maxLines: maxLines,
 	}
 
-	r.scanner = bufio.NewScanner(file)
-	r.buf = GetScannerBuffer()
-	r.scanner.Buffer(r.buf, DefaultScannerMaxSize)
+	if stat, err := file.Stat(); err == nil {
+		if r.mapped = mapForRead(file, stat); r.mapped != nil {
+			r.lines = newMappedLines(r.mapped, 0, path)
+			return r, nil
+		}
+	}
+
+	r.scanner, r.buf = NewScanner(file)
 
 	return r, nil
 }
@@ -217,6 +282,19 @@ func (r *HeadReader) Next() ([]byte, error) {
 		return nil, io.EOF
 	}
 
+	if r.mapped != nil {
+		line, ok, err := r.lines.next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, io.EOF
+		}
+		r.lineCount++
+		r.offset = int64(r.lines.pos)
+		return line, nil
+	}
+
 	if !r.scanner.Scan() {
 		if err := r.scanner.Err(); err != nil {
 			return nil, err
@@ -225,9 +303,8 @@ func (r *HeadReader) Next() ([]byte, error) {
 	}
 
 	r.lineCount++
-	line := r.scanner.Bytes()
-	r.offset += int64(len(line)) + 1
-	return line, nil
+	r.offset = r.scanner.Offset()
+	return r.scanner.Bytes(), nil
 }
 
 // Offset returns the current byte offset in the file.
@@ -246,5 +323,9 @@ func (r *HeadReader) Close() error {
 		PutScannerBuffer(r.buf)
 		r.buf = nil
 	}
+	if r.mapped != nil {
+		_ = unmapFile(r.mapped)
+		r.mapped = nil
+	}
 	return r.file.Close()
 }
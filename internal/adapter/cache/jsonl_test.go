@@ -1,11 +1,15 @@
 package cache
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestScannerPool(t *testing.T) {
@@ -118,6 +122,26 @@ func TestIncrementalReader_FromOffset(t *testing.T) {
 	}
 }
 
+func TestIncrementalReader_BadCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.jsonl")
+
+	// A torn "line2" was counted as parsed, then completed by the writer
+	content := "line1\nline2-completed\nline3\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewIncrementalReader(path, 12); !errors.Is(err, ErrBadCheckpoint) {
+		t.Errorf("mid-line offset: err = %v; want ErrBadCheckpoint", err)
+	}
+	r, err := NewIncrementalReader(path, 22)
+	if err != nil {
+		t.Fatalf("line-boundary offset: %v", err)
+	}
+	_ = r.Close()
+}
+
 func TestIncrementalReader_EOF(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "test.jsonl")
@@ -338,3 +362,149 @@ func TestHeadReader_FewerLinesThanMax(t *testing.T) {
 		t.Errorf("expected 2 lines, got %d", count)
 	}
 }
+
+func readAllLines(t *testing.T, next func() ([]byte, error)) []string {
+	t.Helper()
+	var lines []string
+	for {
+		line, err := next()
+		if err == io.EOF {
+			return lines
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(line))
+	}
+}
+
+func TestMappedReaders_MatchScanner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.jsonl")
+	content := "line1\nline2\n\nline4\nline5"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	idle := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, idle, idle); err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(threshold int64) (tails [][]string, head []string, headOffset int64) {
+		old := MmapThreshold
+		MmapThreshold = threshold
+		defer func() { MmapThreshold = old }()
+
+		for _, size := range []int64{3, 12, 100} {
+			r, err := NewTailReader(path, size)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mapped := r.mapped != nil; mapped != (threshold == 1) {
+				t.Errorf("threshold %d: mapped = %v", threshold, mapped)
+			}
+			tails = append(tails, readAllLines(t, r.Next))
+			_ = r.Close()
+		}
+
+		h, err := NewHeadReader(path, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = h.Close() }()
+		return tails, readAllLines(t, h.Next), h.Offset()
+	}
+
+	scanTails, scanHead, scanOffset := read(1 << 40)
+	mapTails, mapHead, mapOffset := read(1)
+	if fmt.Sprint(scanTails) != fmt.Sprint(mapTails) {
+		t.Errorf("tail lines differ: scanner %q, mapped %q", scanTails, mapTails)
+	}
+	if fmt.Sprint(scanHead) != fmt.Sprint(mapHead) || scanOffset != mapOffset {
+		t.Errorf("head differs: scanner %q@%d, mapped %q@%d", scanHead, scanOffset, mapHead, mapOffset)
+	}
+}
+
+func TestMappedLines_CRLF(t *testing.T) {
+	m := mappedLines{data: []byte("a\r\nb")}
+	var got []string
+	for line, ok, _ := m.next(); ok; line, ok, _ = m.next() {
+		got = append(got, string(line))
+	}
+	if fmt.Sprint(got) != "[a b]" {
+		t.Errorf("lines = %q, want [a b]", got)
+	}
+}
+
+func TestMappedLines_SkipsOversizedLines(t *testing.T) {
+	m := mappedLines{data: []byte("ok\n" + strings.Repeat("x", 10) + "\nfine\n" + strings.Repeat("y", 10)), path: "big.jsonl", limit: 8}
+	var got []string
+	for line, ok, _ := m.next(); ok; line, ok, _ = m.next() {
+		got = append(got, string(line))
+	}
+	if fmt.Sprint(got) != "[ok fine]" {
+		t.Errorf("lines = %q, want [ok fine]", got)
+	}
+	var offsets []int64
+	for _, s := range SkippedLines() {
+		if s.Path == "big.jsonl" {
+			offsets = append(offsets, s.Offset)
+		}
+	}
+	if fmt.Sprint(offsets) != "[3 19]" {
+		t.Errorf("skipped offsets = %v, want [3 19]", offsets)
+	}
+}
+
+func TestMapForRead_SkipsFilesBeingWritten(t *testing.T) {
+	old := MmapThreshold
+	MmapThreshold = 1
+	defer func() { MmapThreshold = old }()
+
+	path := filepath.Join(t.TempDir(), "live.jsonl")
+	if err := os.WriteFile(path, []byte("a\nb\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewHeadReader(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = r.Close() }()
+	if r.mapped != nil {
+		t.Error("recently modified file should be scanned, not mapped")
+	}
+}
+
+func TestMappedLines_TruncatedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shrink.jsonl")
+	size := int64(4 * os.Getpagesize())
+	if err := os.WriteFile(path, bytes.Repeat([]byte("x\n"), int(size/2)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+	data, err := mapFile(f, size)
+	if err != nil {
+		t.Skip("mmap unavailable")
+	}
+	defer func() { _ = unmapFile(data) }()
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	m := mappedLines{data: data}
+	for {
+		_, ok, err := m.next()
+		if err != nil {
+			if !errors.Is(err, errMappingFault) {
+				t.Errorf("err = %v, want errMappingFault", err)
+			}
+			return
+		}
+		if !ok {
+			t.Fatal("read past a truncation without faulting")
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Counters tracks how a named cache is performing. Caches with the same
+// name share counters. Safe for concurrent use.
+type Counters struct {
+	name        string
+	hits        atomic.Int64
+	misses      atomic.Int64
+	incremental atomic.Int64
+	full        atomic.Int64
+	evictions   atomic.Int64
+	recoveries  atomic.Int64
+}
+
+// Hit records a lookup served from an unchanged entry.
+func (c *Counters) Hit() { c.hits.Add(1) }
+
+// Miss records a lookup with no cached entry.
+func (c *Counters) Miss() { c.misses.Add(1) }
+
+// Incremental records a parse resumed from a saved offset.
+func (c *Counters) Incremental() { c.incremental.Add(1) }
+
+// Full records a parse from the start of the file.
+func (c *Counters) Full() { c.full.Add(1) }
+
+// Recovered records a resume abandoned for a full parse because its saved
+// offset failed checkpoint validation.
+func (c *Counters) Recovered() { c.recoveries.Add(1) }
+
+// Evicted records n entries evicted for capacity or the byte budget.
+func (c *Counters) Evicted(n int) { c.evictions.Add(int64(n)) }
+
+// Stats is a snapshot of a cache's counters.
+type Stats struct {
+	Name        string
+	Hits        int64
+	Misses      int64
+	Incremental int64 // parses resumed from a saved offset
+	Full        int64 // parses from the start, including after misses
+	Evictions   int64
+	Recoveries  int64 // resumes abandoned after a bad checkpoint
+}
+
+// HitRate returns hits as a fraction of lookups, or 0 with no lookups.
+func (s Stats) HitRate() float64 {
+	lookups := s.Hits + s.Incremental + s.Full
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(lookups)
+}
+
+var (
+	metricsMu sync.Mutex
+	metrics   = make(map[string]*Counters)
+)
+
+// Metrics returns the counters for name, creating them on first use.
+func Metrics(name string) *Counters {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	c, ok := metrics[name]
+	if !ok {
+		c = &Counters{name: name}
+		metrics[name] = c
+	}
+	return c
+}
+
+// Snapshot returns the counters of every named cache, sorted by name.
+func Snapshot() []Stats {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	stats := make([]Stats, 0, len(metrics))
+	for _, c := range metrics {
+		stats = append(stats, Stats{
+			Name:        c.name,
+			Hits:        c.hits.Load(),
+			Misses:      c.misses.Load(),
+			Incremental: c.incremental.Load(),
+			Full:        c.full.Load(),
+			Evictions:   c.evictions.Load(),
+			Recoveries:  c.recoveries.Load(),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func statsFor(t *testing.T, name string) Stats {
+	t.Helper()
+	for _, st := range Snapshot() {
+		if st.Name == name {
+			return st
+		}
+	}
+	t.Fatalf("no stats for %q", name)
+	return Stats{}
+}
+
+func TestMetrics_Cache(t *testing.T) {
+	c := New[string](1).Named("test.metrics.cache")
+	now := time.Now()
+
+	c.Get("a", 1, now) // miss
+	c.Set("a", "a", 1, now, 0)
+	c.Get("a", 1, now) // hit
+	c.Set("b", "b", 1, now, 0)
+
+	st := statsFor(t, "test.metrics.cache")
+	if st.Hits != 1 || st.Misses != 1 || st.Evictions != 1 {
+		t.Errorf("stats = %+v; want 1 hit, 1 miss, 1 eviction", st)
+	}
+}
+
+func TestMetrics_SessionIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "s.jsonl")
+	base := time.Unix(1700000000, 0)
+	idx := SessionIndex[int64]{Name: "test.metrics.index"}
+	var c countingParser
+
+	info := writeIndexFile(t, path, "a\n", base)
+	_, _ = idx.Get(path, info, c.parser())
+	_, _ = idx.Get(path, info, c.parser())
+	info = writeIndexFile(t, path, "a\nb\n", base.Add(time.Second))
+	_, _ = idx.Get(path, info, c.parser())
+
+	st := statsFor(t, "test.metrics.index")
+	want := Stats{Name: "test.metrics.index", Hits: 1, Misses: 1, Incremental: 1, Full: 1}
+	if st != want {
+		t.Errorf("stats = %+v; want %+v", st, want)
+	}
+	if got := st.HitRate(); got < 0.33 || got > 0.34 {
+		t.Errorf("HitRate = %v; want 1/3", got)
+	}
+}
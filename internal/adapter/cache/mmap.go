@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"runtime/debug"
+	"time"
+)
+
+// MmapThreshold is the file size at or above which TailReader and
+// HeadReader memory-map the file instead of copying it through a scanner
+// buffer. Lines they return then point into the mapping, so they must not
+// be used after Close.
+var MmapThreshold int64 = 64 * 1024 * 1024
+
+// MmapQuietPeriod is how long a file must go unmodified before it is
+// mapped. Files still being written are read through a scanner instead,
+// since truncating a mapped file faults on the pages past its new end.
+var MmapQuietPeriod = 30 * time.Second
+
+// errMappingFault reports that a mapped file shrank while being read.
+var errMappingFault = errors.New("mapped file changed while reading")
+
+// mappedLines iterates the lines of a memory-mapped region, splitting like
+// bufio.ScanLines without copying. Like LineScanner, it skips lines of
+// limit bytes or more, recording them against path.
+type mappedLines struct {
+	data  []byte
+	pos   int
+	path  string
+	limit int // 0 means no limit
+}
+
+// newMappedLines iterates data from pos with the current MaxLineSize.
+func newMappedLines(data []byte, pos int, path string) mappedLines {
+	return mappedLines{data: data, pos: pos, path: path, limit: MaxLineSize()}
+}
+
+// mapForRead maps f when it is large enough to benefit and has not been
+// modified within MmapQuietPeriod. It returns nil otherwise, or if mapping
+// fails, so callers fall back to scanning.
+func mapForRead(f *os.File, info os.FileInfo) []byte {
+	size := info.Size()
+	if size < MmapThreshold || size <= 0 || time.Since(info.ModTime()) < MmapQuietPeriod {
+		return nil
+	}
+	data, err := mapFile(f, size)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// next returns the next line without its line ending, or false at the end.
+// A fault from the file being truncated underneath the mapping ends the
+// iteration with errMappingFault instead of crashing.
+func (m *mappedLines) next() (line []byte, ok bool, err error) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, fault := r.(interface{ Addr() uintptr }); !fault {
+				panic(r)
+			}
+			m.pos = len(m.data)
+			line, ok, err = nil, false, errMappingFault
+		}
+	}()
+
+	for m.pos < len(m.data) {
+		start := m.pos
+		rest := m.data[m.pos:]
+		line = rest
+		if i := bytes.IndexByte(rest, '\n'); i >= 0 {
+			line = rest[:i]
+			m.pos += i + 1
+		} else {
+			m.pos = len(m.data)
+		}
+		if m.limit > 0 && len(line) >= m.limit {
+			recordSkippedLine(m.path, int64(start), int64(m.pos-start))
+			continue
+		}
+		return dropCR(line), true, nil
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,251 @@
+package cache
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+const (
+	storeFile = "session-cache.db"
+
+	// storeFlushThreshold is how many pending writes trigger a flush.
+	storeFlushThreshold = 256
+
+	// storeRetention drops rows not rewritten for this long when the store
+	// opens, so entries for deleted session files don't pile up.
+	storeRetention = 30 * 24 * time.Hour
+)
+
+// Record is a persisted value with the file state it was built from.
+type Record[T any] struct {
+	Data       T
+	Size       int64
+	ModTime    time.Time
+	ByteOffset int64 // for incremental parsing
+}
+
+// Matches reports whether the record was built from a file with this size
+// and modification time.
+func (r Record[T]) Matches(size int64, modTime time.Time) bool {
+	return r.Size == size && r.ModTime.Equal(modTime)
+}
+
+// storeKey identifies a row.
+type storeKey struct {
+	namespace string
+	path      string
+}
+
+// storeRow is a pending write; data is nil for deletes.
+type storeRow struct {
+	size       int64
+	modTime    int64
+	byteOffset int64
+	data       []byte
+}
+
+// store is the SQLite database shared by all Persistent caches. Writes are
+// buffered and flushed in one transaction.
+type store struct {
+	mu           sync.Mutex
+	db           *sql.DB
+	pending      map[storeKey]*storeRow
+	pendingBlobs map[string]string // blob key -> content
+}
+
+var shared = &store{pending: make(map[storeKey]*storeRow), pendingBlobs: make(map[string]string)}
+
+// OpenStore opens the persistent cache database in dir, creating it if
+// needed. Until it is called, Persistent caches miss on every Get and drop
+// writes, so adapters behave as if the cache were cold.
+func OpenStore(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite", filepath.Join(dir, storeFile)+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)")
+	if err != nil {
+		return fmt.Errorf("open session cache: %w", err)
+	}
+	schema := `
+CREATE TABLE IF NOT EXISTS entries (
+    namespace TEXT NOT NULL,
+    path TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    mod_time INTEGER NOT NULL,
+    byte_offset INTEGER NOT NULL DEFAULT 0,
+    data BLOB NOT NULL,
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (namespace, path)
+);
+CREATE TABLE IF NOT EXISTS blobs (
+    hash TEXT PRIMARY KEY,
+    data BLOB NOT NULL,
+    updated_at INTEGER NOT NULL
+);`
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return fmt.Errorf("init session cache: %w", err)
+	}
+	cutoff := time.Now().Add(-storeRetention).Unix()
+	_, _ = db.Exec(`DELETE FROM entries WHERE updated_at < ?`, cutoff)
+	_, _ = db.Exec(`DELETE FROM blobs WHERE updated_at < ?`, cutoff)
+
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	if shared.db != nil {
+		_ = shared.db.Close()
+	}
+	shared.db = db
+	shared.pending = make(map[storeKey]*storeRow)
+	shared.pendingBlobs = make(map[string]string)
+
+	blobs.mu.Lock()
+	blobs.resetKnownLocked()
+	blobs.mu.Unlock()
+	return nil
+}
+
+// FlushStore writes buffered cache entries to disk.
+func FlushStore() error {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	return shared.flushLocked()
+}
+
+// CloseStore flushes buffered entries and closes the database.
+func CloseStore() error {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	if shared.db == nil {
+		return nil
+	}
+	err := shared.flushLocked()
+	if cerr := shared.db.Close(); err == nil {
+		err = cerr
+	}
+	shared.db = nil
+	return err
+}
+
+func (s *store) flushLocked() error {
+	if s.db == nil || len(s.pending)+len(s.pendingBlobs) == 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	for key, row := range s.pending {
+		if row.data == nil {
+			_, err = tx.Exec(`DELETE FROM entries WHERE namespace = ? AND path = ?`, key.namespace, key.path)
+		} else {
+			_, err = tx.Exec(`INSERT OR REPLACE INTO entries (namespace, path, size, mod_time, byte_offset, data, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)`, key.namespace, key.path, row.size, row.modTime, row.byteOffset, row.data, now)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	for key, data := range s.pendingBlobs {
+		// Rewriting a known blob only refreshes its retention.
+		_, err = tx.Exec(`INSERT INTO blobs (hash, data, updated_at) VALUES (?, ?, ?)
+ON CONFLICT(hash) DO UPDATE SET updated_at = excluded.updated_at`, key, []byte(data), now)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.pending = make(map[storeKey]*storeRow)
+	s.pendingBlobs = make(map[string]string)
+	return nil
+}
+
+// Persistent is a typed view of the persistent store for one namespace.
+// Values are stored as JSON, so T's fields must be exported. Bump the
+// namespace version when T changes shape to drop old entries.
+type Persistent[T any] struct {
+	namespace string
+}
+
+// NewPersistent returns a persistent cache for namespace, e.g. "claudecode.meta.v1".
+func NewPersistent[T any](namespace string) *Persistent[T] {
+	return &Persistent[T]{namespace: namespace}
+}
+
+// Get returns the record stored for path, including writes not yet flushed.
+// Callers compare Size and ModTime with the file to decide whether the
+// record is current or can seed an incremental parse.
+func (p *Persistent[T]) Get(path string) (Record[T], bool) {
+	var rec Record[T]
+	var data []byte
+
+	shared.mu.Lock()
+	if row, ok := shared.pending[storeKey{p.namespace, path}]; ok {
+		if row.data == nil {
+			shared.mu.Unlock()
+			return rec, false
+		}
+		rec.Size, rec.ByteOffset = row.size, row.byteOffset
+		rec.ModTime = time.Unix(0, row.modTime)
+		data = row.data
+	} else if shared.db != nil {
+		var modTime int64
+		err := shared.db.QueryRow(`SELECT size, mod_time, byte_offset, data FROM entries WHERE namespace = ? AND path = ?`,
+			p.namespace, path).Scan(&rec.Size, &modTime, &rec.ByteOffset, &data)
+		if err != nil {
+			shared.mu.Unlock()
+			return rec, false
+		}
+		rec.ModTime = time.Unix(0, modTime)
+	}
+	shared.mu.Unlock()
+
+	if data == nil || json.Unmarshal(data, &rec.Data) != nil {
+		return rec, false
+	}
+	return rec, true
+}
+
+// Put stores a record for path. It is written on the next flush.
+func (p *Persistent[T]) Put(path string, rec Record[T]) {
+	data, err := json.Marshal(rec.Data)
+	if err != nil {
+		return
+	}
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	if shared.db == nil {
+		return
+	}
+	shared.pending[storeKey{p.namespace, path}] = &storeRow{
+		size:       rec.Size,
+		modTime:    rec.ModTime.UnixNano(),
+		byteOffset: rec.ByteOffset,
+		data:       data,
+	}
+	if len(shared.pending) >= storeFlushThreshold {
+		_ = shared.flushLocked()
+	}
+}
+
+// Delete removes the record for path on the next flush.
+func (p *Persistent[T]) Delete(path string) {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	if shared.db == nil {
+		return
+	}
+	shared.pending[storeKey{p.namespace, path}] = &storeRow{}
+}
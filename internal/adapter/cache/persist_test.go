@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+type persistTestData struct {
+	Title string
+	Count int
+}
+
+func TestPersistent_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	if err := OpenStore(dir); err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	t.Cleanup(func() { _ = CloseStore() })
+
+	p := NewPersistent[persistTestData]("test.v1")
+	other := NewPersistent[persistTestData]("test.v2")
+	modTime := time.Unix(1700000000, 123456789)
+	rec := Record[persistTestData]{Data: persistTestData{"hello", 3}, Size: 42, ModTime: modTime, ByteOffset: 40}
+
+	p.Put("/s/a.jsonl", rec)
+	if got, ok := p.Get("/s/a.jsonl"); !ok || got != rec {
+		t.Errorf("Get before flush = %+v, %v; want %+v", got, ok, rec)
+	}
+
+	// Reopen to read back from disk
+	if err := CloseStore(); err != nil {
+		t.Fatalf("CloseStore: %v", err)
+	}
+	if err := OpenStore(dir); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	got, ok := p.Get("/s/a.jsonl")
+	if !ok || got != rec {
+		t.Errorf("Get after reopen = %+v, %v; want %+v", got, ok, rec)
+	}
+	if !got.Matches(42, modTime) || got.Matches(43, modTime) {
+		t.Error("Matches should compare size and mod time")
+	}
+	if _, ok := other.Get("/s/a.jsonl"); ok {
+		t.Error("namespaces should not share entries")
+	}
+
+	p.Delete("/s/a.jsonl")
+	if _, ok := p.Get("/s/a.jsonl"); ok {
+		t.Error("deleted entry should miss before flush")
+	}
+	if err := FlushStore(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.Get("/s/a.jsonl"); ok {
+		t.Error("deleted entry should miss after flush")
+	}
+}
+
+func TestPersistent_Closed(t *testing.T) {
+	p := NewPersistent[persistTestData]("test.v1")
+	p.Put("/s/a.jsonl", Record[persistTestData]{Size: 1})
+	if _, ok := p.Get("/s/a.jsonl"); ok {
+		t.Error("Get without an open store should miss")
+	}
+}
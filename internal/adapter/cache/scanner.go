@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxSkippedLines caps the number of recorded skipped lines.
+const maxSkippedLines = 100
+
+var maxLineSize atomic.Int64
+
+func init() {
+	maxLineSize.Store(DefaultScannerMaxSize)
+}
+
+// SetMaxLineSize sets the longest JSONL line the scanners return, in bytes.
+// Longer lines are skipped and recorded instead of stopping the scan. A size
+// of 0 or less restores DefaultScannerMaxSize.
+func SetMaxLineSize(n int) {
+	if n <= 0 {
+		n = DefaultScannerMaxSize
+	}
+	maxLineSize.Store(int64(n))
+}
+
+// MaxLineSize returns the longest JSONL line the scanners return.
+func MaxLineSize() int {
+	return int(maxLineSize.Load())
+}
+
+// SkippedLine records a line dropped for exceeding MaxLineSize.
+type SkippedLine struct {
+	Path   string // file name, empty for readers that aren't files
+	Offset int64  // byte offset of the line in the file
+	Size   int64  // line length, including its line ending
+	At     time.Time
+}
+
+var (
+	skippedMu   sync.Mutex
+	skipped     []SkippedLine
+	skippedSeen = make(map[SkippedLine]bool) // path and offset already recorded
+)
+
+// SkippedLines returns recorded oversized lines, oldest first.
+func SkippedLines() []SkippedLine {
+	skippedMu.Lock()
+	defer skippedMu.Unlock()
+	out := make([]SkippedLine, len(skipped))
+	copy(out, skipped)
+	return out
+}
+
+// recordSkippedLine records a skipped line once per path and offset, since
+// reparsing a file skips the same line again.
+func recordSkippedLine(path string, offset, size int64) {
+	key := SkippedLine{Path: path, Offset: offset}
+	skippedMu.Lock()
+	defer skippedMu.Unlock()
+	if skippedSeen[key] {
+		return
+	}
+	skippedSeen[key] = true
+	skipped = append(skipped, SkippedLine{Path: path, Offset: offset, Size: size, At: time.Now()})
+	if len(skipped) > maxSkippedLines {
+		delete(skippedSeen, SkippedLine{Path: skipped[0].Path, Offset: skipped[0].Offset})
+		skipped = skipped[1:]
+	}
+}
+
+// LineScanner is a bufio.Scanner for JSONL that splits like bufio.ScanLines
+// but skips lines of MaxLineSize bytes or more instead of failing with
+// bufio.ErrTooLong, recording them for diagnostics. It also tracks exact
+// byte offsets, counting line endings and skipped lines.
+type LineScanner struct {
+	*bufio.Scanner
+	path     string
+	base     int64 // reader position when scanning started
+	limit    int
+	consumed int64 // bytes consumed through the current line
+	start    int64 // offset of the current line
+	skipping bool
+	skipFrom int64 // offset of the line being skipped
+}
+
+// NewLineScanner returns a LineScanner reading r into buf, which it may
+// outgrow up to MaxLineSize. When r is a file, skipped lines are recorded
+// with its name and absolute offset.
+func NewLineScanner(r io.Reader, buf []byte) *LineScanner {
+	s := &LineScanner{Scanner: bufio.NewScanner(r), limit: MaxLineSize()}
+	if f, ok := r.(*os.File); ok {
+		s.path = f.Name()
+		s.base, _ = f.Seek(0, io.SeekCurrent)
+	}
+	s.Buffer(buf, s.limit)
+	s.Split(s.split)
+	return s
+}
+
+// Offset returns the number of bytes consumed through the end of the line
+// returned by the last Scan, including its line ending.
+func (s *LineScanner) Offset() int64 {
+	return s.consumed
+}
+
+// LineStart returns the offset of the line returned by the last Scan.
+func (s *LineScanner) LineStart() int64 {
+	return s.start
+}
+
+func (s *LineScanner) split(data []byte, atEOF bool) (int, []byte, error) {
+	if s.skipping {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 && !atEOF {
+			s.consumed += int64(len(data))
+			return len(data), nil, nil
+		}
+		advance := len(data)
+		if i >= 0 {
+			advance = i + 1
+		}
+		s.consumed += int64(advance)
+		s.skipping = false
+		recordSkippedLine(s.path, s.base+s.skipFrom, s.consumed-s.skipFrom)
+		return advance, nil, nil
+	}
+
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	i := bytes.IndexByte(data, '\n')
+	switch {
+	case i >= s.limit || (i < 0 && len(data) >= s.limit):
+		// Too long: drop what's buffered and skip to the next newline
+		s.skipping = true
+		s.skipFrom = s.consumed
+		return s.split(data, atEOF)
+	case i >= 0:
+		s.start = s.consumed
+		s.consumed += int64(i) + 1
+		return i + 1, dropCR(data[:i]), nil
+	case atEOF:
+		// Final line without a newline
+		s.start = s.consumed
+		s.consumed += int64(len(data))
+		return len(data), dropCR(data), nil
+	}
+	return 0, nil, nil
+}
+
+// dropCR drops a terminal \r from data.
+func dropCR(data []byte) []byte {
+	if n := len(data); n > 0 && data[n-1] == '\r' {
+		return data[:n-1]
+	}
+	return data
+}
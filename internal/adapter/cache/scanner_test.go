@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLineScanner_SkipsLongLines(t *testing.T) {
+	SetMaxLineSize(64)
+	t.Cleanup(func() { SetMaxLineSize(0) })
+
+	long := strings.Repeat("x", 200)
+	content := "first\n" + long + "\nsecond\r\n" + long
+	path := filepath.Join(t.TempDir(), "s.jsonl")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	// A buffer smaller than the limit exercises growth up to it
+	s := NewLineScanner(f, make([]byte, 16))
+	type scanned struct {
+		line       string
+		start, end int64
+	}
+	var got []scanned
+	for s.Scan() {
+		got = append(got, scanned{s.Text(), s.LineStart(), s.Offset()})
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("Err = %v; long lines should be skipped, not fail the scan", err)
+	}
+	want := []scanned{{"first", 0, 6}, {"second", 207, 215}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("scanned %+v; want %+v", got, want)
+	}
+	if s.Offset() != int64(len(content)) {
+		t.Errorf("Offset = %d; want %d", s.Offset(), len(content))
+	}
+
+	var lines []SkippedLine
+	for _, l := range SkippedLines() {
+		if l.Path == path {
+			lines = append(lines, l)
+		}
+	}
+	if len(lines) != 2 || lines[0].Offset != 6 || lines[0].Size != 201 || lines[1].Offset != 215 || lines[1].Size != 200 {
+		t.Errorf("skipped lines = %+v; want offsets 6 and 215", lines)
+	}
+}
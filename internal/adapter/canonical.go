@@ -0,0 +1,303 @@
+package adapter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Canonical session JSON identifies documents produced by MarshalSession.
+// Bump CanonicalVersion on breaking schema changes; additive fields keep it.
+const (
+	CanonicalSchema  = "forge.session"
+	CanonicalVersion = 1
+)
+
+// ErrCanonicalSchema is returned when a document is not a canonical session
+// or uses a newer schema version than this build understands.
+var ErrCanonicalSchema = errors.New("not a supported forge session document")
+
+// CanonicalDocument is the versioned, adapter-independent session format
+// shared by every interop surface (exports, imports, fixtures).
+type CanonicalDocument struct {
+	Schema     string             `json:"schema"`
+	Version    int                `json:"version"`
+	ExportedAt time.Time          `json:"exportedAt"`
+	Session    CanonicalSession   `json:"session"`
+	Messages   []CanonicalMessage `json:"messages"`
+	Usage      *CanonicalUsage    `json:"usage,omitempty"`
+}
+
+// CanonicalSession mirrors Session. Machine-local fields (Path, FileSize,
+// WorktreePath) and live state (IsActive) are not part of the format.
+type CanonicalSession struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name,omitempty"`
+	Slug            string    `json:"slug,omitempty"`
+	AdapterID       string    `json:"adapterId"`
+	AdapterName     string    `json:"adapterName,omitempty"`
+	AdapterIcon     string    `json:"adapterIcon,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+	DurationMs      int64     `json:"durationMs,omitempty"`
+	TotalTokens     int       `json:"totalTokens,omitempty"`
+	EstCost         float64   `json:"estCost,omitempty"`
+	IsSubAgent      bool      `json:"isSubAgent,omitempty"`
+	MessageCount    int       `json:"messageCount"`
+	SessionCategory string    `json:"sessionCategory,omitempty"`
+	CronJobName     string    `json:"cronJobName,omitempty"`
+	SourceChannel   string    `json:"sourceChannel,omitempty"`
+	WorktreeName    string    `json:"worktreeName,omitempty"`
+}
+
+// CanonicalMessage mirrors Message. Source locations are file-specific and
+// are not exported.
+type CanonicalMessage struct {
+	ID          string              `json:"id"`
+	Role        string              `json:"role"`
+	Content     string              `json:"content,omitempty"`
+	Timestamp   time.Time           `json:"timestamp"`
+	Model       string              `json:"model,omitempty"`
+	Usage       *CanonicalTokens    `json:"usage,omitempty"`
+	Blocks      []CanonicalBlock    `json:"blocks,omitempty"`
+	ToolUses    []CanonicalToolUse  `json:"toolUses,omitempty"`
+	Thinking    []CanonicalThinking `json:"thinking,omitempty"`
+	SourceLabel string              `json:"sourceLabel,omitempty"`
+}
+
+// CanonicalTokens mirrors TokenUsage.
+type CanonicalTokens struct {
+	Input      int `json:"input,omitempty"`
+	Output     int `json:"output,omitempty"`
+	CacheRead  int `json:"cacheRead,omitempty"`
+	CacheWrite int `json:"cacheWrite,omitempty"`
+}
+
+// CanonicalBlock mirrors ContentBlock.
+type CanonicalBlock struct {
+	Type        string `json:"type"`
+	Text        string `json:"text,omitempty"`
+	ToolUseID   string `json:"toolUseId,omitempty"`
+	ToolName    string `json:"toolName,omitempty"`
+	ToolInput   string `json:"toolInput,omitempty"`
+	ToolOutput  string `json:"toolOutput,omitempty"`
+	IsError     bool   `json:"isError,omitempty"`
+	TokenCount  int    `json:"tokenCount,omitempty"`
+	MediaType   string `json:"mediaType,omitempty"`
+	ImageData   string `json:"imageData,omitempty"`
+	ImageWidth  int    `json:"imageWidth,omitempty"`
+	ImageHeight int    `json:"imageHeight,omitempty"`
+}
+
+// CanonicalToolUse mirrors ToolUse.
+type CanonicalToolUse struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Input  string `json:"input,omitempty"`
+	Output string `json:"output,omitempty"`
+}
+
+// CanonicalThinking mirrors ThinkingBlock.
+type CanonicalThinking struct {
+	Content    string `json:"content"`
+	TokenCount int    `json:"tokenCount,omitempty"`
+}
+
+// CanonicalUsage mirrors UsageStats.
+type CanonicalUsage struct {
+	InputTokens  int `json:"inputTokens"`
+	OutputTokens int `json:"outputTokens"`
+	CacheRead    int `json:"cacheRead,omitempty"`
+	CacheWrite   int `json:"cacheWrite,omitempty"`
+	MessageCount int `json:"messageCount"`
+}
+
+// MarshalSession encodes a session, its messages, and optional usage as an
+// indented canonical JSON document.
+func MarshalSession(s *Session, messages []Message, usage *UsageStats) ([]byte, error) {
+	if s == nil {
+		return nil, errors.New("marshal session: nil session")
+	}
+	doc := NewCanonicalDocument(s, messages, usage)
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// UnmarshalSession decodes a canonical JSON document. Documents with another
+// schema or a newer version fail with ErrCanonicalSchema.
+func UnmarshalSession(data []byte) (*Session, []Message, *UsageStats, error) {
+	var doc CanonicalDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, nil, nil, fmt.Errorf("unmarshal session: %w", err)
+	}
+	if doc.Schema != CanonicalSchema || doc.Version < 1 || doc.Version > CanonicalVersion {
+		return nil, nil, nil, fmt.Errorf("%w (schema %q version %d)", ErrCanonicalSchema, doc.Schema, doc.Version)
+	}
+	s, msgs, usage := doc.Decode()
+	return s, msgs, usage, nil
+}
+
+// NewCanonicalDocument converts a session to its canonical form.
+func NewCanonicalDocument(s *Session, messages []Message, usage *UsageStats) CanonicalDocument {
+	doc := CanonicalDocument{
+		Schema:     CanonicalSchema,
+		Version:    CanonicalVersion,
+		ExportedAt: time.Now().UTC(),
+		Session: CanonicalSession{
+			ID:              s.ID,
+			Name:            s.Name,
+			Slug:            s.Slug,
+			AdapterID:       s.AdapterID,
+			AdapterName:     s.AdapterName,
+			AdapterIcon:     s.AdapterIcon,
+			CreatedAt:       s.CreatedAt,
+			UpdatedAt:       s.UpdatedAt,
+			DurationMs:      s.Duration.Milliseconds(),
+			TotalTokens:     s.TotalTokens,
+			EstCost:         s.EstCost,
+			IsSubAgent:      s.IsSubAgent,
+			MessageCount:    s.MessageCount,
+			SessionCategory: s.SessionCategory,
+			CronJobName:     s.CronJobName,
+			SourceChannel:   s.SourceChannel,
+			WorktreeName:    s.WorktreeName,
+		},
+		Messages: make([]CanonicalMessage, 0, len(messages)),
+	}
+	for i := range messages {
+		doc.Messages = append(doc.Messages, canonicalMessage(&messages[i]))
+	}
+	if usage != nil {
+		doc.Usage = &CanonicalUsage{
+			InputTokens:  usage.TotalInputTokens,
+			OutputTokens: usage.TotalOutputTokens,
+			CacheRead:    usage.TotalCacheRead,
+			CacheWrite:   usage.TotalCacheWrite,
+			MessageCount: usage.MessageCount,
+		}
+	}
+	return doc
+}
+
+// Decode converts a canonical document back to adapter types.
+func (d *CanonicalDocument) Decode() (*Session, []Message, *UsageStats) {
+	cs := d.Session
+	s := &Session{
+		ID:              cs.ID,
+		Name:            cs.Name,
+		Slug:            cs.Slug,
+		AdapterID:       cs.AdapterID,
+		AdapterName:     cs.AdapterName,
+		AdapterIcon:     cs.AdapterIcon,
+		CreatedAt:       cs.CreatedAt,
+		UpdatedAt:       cs.UpdatedAt,
+		Duration:        time.Duration(cs.DurationMs) * time.Millisecond,
+		TotalTokens:     cs.TotalTokens,
+		EstCost:         cs.EstCost,
+		IsSubAgent:      cs.IsSubAgent,
+		MessageCount:    cs.MessageCount,
+		SessionCategory: cs.SessionCategory,
+		CronJobName:     cs.CronJobName,
+		SourceChannel:   cs.SourceChannel,
+		WorktreeName:    cs.WorktreeName,
+	}
+	msgs := make([]Message, 0, len(d.Messages))
+	for i := range d.Messages {
+		msgs = append(msgs, d.Messages[i].decode())
+	}
+	var usage *UsageStats
+	if d.Usage != nil {
+		usage = &UsageStats{
+			TotalInputTokens:  d.Usage.InputTokens,
+			TotalOutputTokens: d.Usage.OutputTokens,
+			TotalCacheRead:    d.Usage.CacheRead,
+			TotalCacheWrite:   d.Usage.CacheWrite,
+			MessageCount:      d.Usage.MessageCount,
+		}
+	}
+	return s, msgs, usage
+}
+
+func canonicalMessage(m *Message) CanonicalMessage {
+	cm := CanonicalMessage{
+		ID:          m.ID,
+		Role:        m.Role,
+		Content:     m.Content,
+		Timestamp:   m.Timestamp,
+		Model:       m.Model,
+		SourceLabel: m.SourceLabel,
+	}
+	if m.TokenUsage != (TokenUsage{}) {
+		cm.Usage = &CanonicalTokens{
+			Input:      m.InputTokens,
+			Output:     m.OutputTokens,
+			CacheRead:  m.CacheRead,
+			CacheWrite: m.CacheWrite,
+		}
+	}
+	for _, b := range m.ContentBlocks {
+		cm.Blocks = append(cm.Blocks, CanonicalBlock{
+			Type:        b.Type,
+			Text:        b.Text,
+			ToolUseID:   b.ToolUseID,
+			ToolName:    b.ToolName,
+			ToolInput:   b.ToolInput,
+			ToolOutput:  b.FullToolOutput(),
+			IsError:     b.IsError,
+			TokenCount:  b.TokenCount,
+			MediaType:   b.MediaType,
+			ImageData:   b.ImageData,
+			ImageWidth:  b.ImageWidth,
+			ImageHeight: b.ImageHeight,
+		})
+	}
+	for _, tu := range m.ToolUses {
+		cm.ToolUses = append(cm.ToolUses, CanonicalToolUse{ID: tu.ID, Name: tu.Name, Input: tu.Input, Output: tu.FullOutput()})
+	}
+	for _, tb := range m.ThinkingBlocks {
+		cm.Thinking = append(cm.Thinking, CanonicalThinking(tb))
+	}
+	return cm
+}
+
+func (cm *CanonicalMessage) decode() Message {
+	m := Message{
+		ID:          cm.ID,
+		Role:        cm.Role,
+		Content:     cm.Content,
+		Timestamp:   cm.Timestamp,
+		Model:       cm.Model,
+		SourceLabel: cm.SourceLabel,
+	}
+	if cm.Usage != nil {
+		m.TokenUsage = TokenUsage{
+			InputTokens:  cm.Usage.Input,
+			OutputTokens: cm.Usage.Output,
+			CacheRead:    cm.Usage.CacheRead,
+			CacheWrite:   cm.Usage.CacheWrite,
+		}
+	}
+	for _, b := range cm.Blocks {
+		m.ContentBlocks = append(m.ContentBlocks, ContentBlock{
+			Type:        b.Type,
+			Text:        b.Text,
+			ToolUseID:   b.ToolUseID,
+			ToolName:    b.ToolName,
+			ToolInput:   b.ToolInput,
+			ToolOutput:  b.ToolOutput,
+			IsError:     b.IsError,
+			TokenCount:  b.TokenCount,
+			MediaType:   b.MediaType,
+			ImageData:   b.ImageData,
+			ImageWidth:  b.ImageWidth,
+			ImageHeight: b.ImageHeight,
+		})
+	}
+	for _, tu := range cm.ToolUses {
+		m.ToolUses = append(m.ToolUses, ToolUse{ID: tu.ID, Name: tu.Name, Input: tu.Input, Output: tu.Output})
+	}
+	for _, tb := range cm.Thinking {
+		m.ThinkingBlocks = append(m.ThinkingBlocks, ThinkingBlock(tb))
+	}
+	return m
+}
@@ -0,0 +1,104 @@
+package adapter
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCanonicalSession_RoundTrip(t *testing.T) {
+	ts := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	s := &Session{
+		ID:              "ses-1",
+		Name:            "Fix login",
+		AdapterID:       "claude-code",
+		AdapterName:     "Claude Code",
+		CreatedAt:       ts,
+		UpdatedAt:       ts.Add(time.Hour),
+		Duration:        90 * time.Second,
+		TotalTokens:     1500,
+		EstCost:         0.42,
+		MessageCount:    2,
+		SessionCategory: SessionCategoryInteractive,
+		WorktreeName:    "feature-x",
+	}
+	msgs := []Message{
+		{ID: "m1", Role: "user", Content: "fix it", Timestamp: ts},
+		{
+			ID:         "m2",
+			Role:       "assistant",
+			Timestamp:  ts.Add(time.Minute),
+			Model:      "claude-opus-4-5",
+			TokenUsage: TokenUsage{InputTokens: 1000, OutputTokens: 500, CacheRead: 20},
+			ContentBlocks: []ContentBlock{
+				{Type: "text", Text: "done"},
+				{Type: "tool_use", ToolUseID: "t1", ToolName: "Edit", ToolInput: `{"file_path":"a.go"}`},
+				{Type: "tool_result", ToolUseID: "t1", ToolOutput: "boom", IsError: true},
+			},
+			ToolUses:       []ToolUse{{ID: "t1", Name: "Edit", Input: `{"file_path":"a.go"}`, Output: "boom"}},
+			ThinkingBlocks: []ThinkingBlock{{Content: "hmm", TokenCount: 1}},
+		},
+	}
+	usage := &UsageStats{TotalInputTokens: 1000, TotalOutputTokens: 500, MessageCount: 2}
+
+	data, err := MarshalSession(s, msgs, usage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"schema": "forge.session"`) || !strings.Contains(string(data), `"version": 1`) {
+		t.Errorf("missing schema header:\n%s", data)
+	}
+
+	gotS, gotMsgs, gotUsage, err := UnmarshalSession(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotS, s) {
+		t.Errorf("session mismatch:\n got %+v\nwant %+v", gotS, s)
+	}
+	if !reflect.DeepEqual(gotMsgs, msgs) {
+		t.Errorf("messages mismatch:\n got %+v\nwant %+v", gotMsgs, msgs)
+	}
+	if !reflect.DeepEqual(gotUsage, usage) {
+		t.Errorf("usage = %+v, want %+v", gotUsage, usage)
+	}
+}
+
+func TestCanonicalSession_DropsMachineLocalFields(t *testing.T) {
+	s := &Session{ID: "x", Path: "/home/me/.claude/x.jsonl", FileSize: 10, WorktreePath: "/tmp/wt", IsActive: true}
+	data, err := MarshalSession(s, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "/home/me") || strings.Contains(string(data), "/tmp/wt") {
+		t.Errorf("machine-local paths leaked into export:\n%s", data)
+	}
+	got, msgs, usage, err := UnmarshalSession(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Path != "" || got.IsActive || msgs == nil || len(msgs) != 0 || usage != nil {
+		t.Errorf("unexpected decode: %+v msgs=%v usage=%v", got, msgs, usage)
+	}
+}
+
+func TestUnmarshalSession_RejectsUnknownSchema(t *testing.T) {
+	tests := []string{
+		`{"schema":"other","version":1}`,
+		`{"schema":"forge.session","version":99}`,
+		`{"schema":"forge.session"}`,
+	}
+	for _, doc := range tests {
+		if _, _, _, err := UnmarshalSession([]byte(doc)); !errors.Is(err, ErrCanonicalSchema) {
+			t.Errorf("UnmarshalSession(%s) err = %v, want ErrCanonicalSchema", doc, err)
+		}
+	}
+	if _, _, _, err := UnmarshalSession([]byte("{")); err == nil || errors.Is(err, ErrCanonicalSchema) {
+		t.Errorf("expected JSON syntax error, got %v", err)
+	}
+	if _, err := MarshalSession(nil, nil, nil); err == nil {
+		t.Error("expected error for nil session")
+	}
+}
@@ -1,20 +1,25 @@
 package claudecode
 
 import (
-	"bufio"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"image"
+	_ "image/gif"  // register decoders for imageDimensions
+	_ "image/jpeg" // register decoders for imageDimensions
+	_ "image/png"  // register decoders for imageDimensions
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 	"github.com/wilbur182/forge/internal/adapter/pricing"
 )
 
@@ -22,20 +27,16 @@ import (
 var xmlTagRegex = regexp.MustCompile(`<[^>]+>`)
 
 const (
-	adapterID           = "claude-code"
-	adapterName         = "Claude Code"
-	metaCacheMaxEntries = 2048
-	msgCacheMaxEntries  = 128 // fewer entries since messages are larger
+	adapterID          = "claude-code"
+	adapterName        = "Claude Code"
+	msgCacheMaxEntries = 128 // fewer entries since messages are larger
 )
 
 // Adapter implements the adapter.Adapter interface for Claude Code sessions.
 type Adapter struct {
-	projectsDir  string
-	sessionIndex map[string]string // sessionID -> file path cache
-	metaCache    map[string]sessionMetaCacheEntry
-	msgCache     *cache.Cache[messageCacheEntry] // session path -> cached messages
-	mu           sync.RWMutex                    // guards sessionIndex
-	metaMu       sync.RWMutex                    // guards metaCache
+	projectsDir string
+	index       cache.SessionIndex[sessionMeta] // session metadata and sessionID -> file path
+	msgCache    *cache.Cache[messageCacheEntry] // session path -> cached messages
 }
 
 // messageCacheEntry holds cached messages with incremental parsing state.
@@ -44,6 +45,7 @@ type messageCacheEntry struct {
 	toolUseRefs  map[string]toolUseRef // unresolved tool uses for linking
 	pendingRefs  map[string]toolUseRef // tool uses awaiting results (for incremental)
 	byteOffset   int64                 // resume point for incremental parse
+	lineCount    int                   // lines consumed up to byteOffset (for SourceRef)
 	messageCount int                   // for validation
 }
 
@@ -52,10 +54,9 @@ func New() *Adapter {
 	home, _ := os.UserHomeDir()
 	projectsDir := findClaudeCodeProjectsDir(home)
 	return &Adapter{
-		projectsDir:  projectsDir,
-		sessionIndex: make(map[string]string),
-		metaCache:    make(map[string]sessionMetaCacheEntry),
-		msgCache:     cache.New[messageCacheEntry](msgCacheMaxEntries),
+		projectsDir: projectsDir,
+		index:       cache.SessionIndex[sessionMeta]{Store: metaStore, Name: adapterID + ".meta"},
+		msgCache:    cache.New[messageCacheEntry](msgCacheMaxEntries).Named(adapterID + ".messages"),
 	}
 }
 
@@ -116,6 +117,12 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the Claude Code project directory whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.projectDirPath(projectRoot)}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -147,7 +154,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		}
 
 		path := filepath.Join(dir, e.Name())
-		info, err := e.Info()
+		info, err := ingest.Check(adapterID, a.projectsDir, path)
 		if err != nil {
 			continue
 		}
@@ -201,9 +208,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 	}
 
 	// Atomically swap in the new index
-	a.mu.Lock()
-	a.sessionIndex = newIndex
-	a.mu.Unlock()
+	a.index.ReplacePaths(newIndex)
 
 	// Sort by UpdatedAt descending (newest first)
 	sort.Slice(sessions, func(i, j int) bool {
@@ -222,7 +227,7 @@ func (a *Adapter) SessionByID(sessionID string) (*adapter.Session, error) {
 	if path == "" {
 		return nil, fmt.Errorf("session %s not found", sessionID)
 	}
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.projectsDir, path)
 	if err != nil {
 		return nil, err
 	}
@@ -274,7 +279,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 		return nil, nil
 	}
 
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.projectsDir, path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -285,9 +290,11 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	// Check cache for existing entry (if cache is initialized)
 	if a.msgCache != nil {
 		cached, offset, cachedSize, cachedModTime, ok := a.msgCache.GetWithOffset(path)
-		if ok {
+		// Tool outputs evicted from memory are recovered by a full parse
+		if ok && adapter.ToolOutputsAvailable(cached.messages) {
 			// Exact cache hit: file unchanged
 			if info.Size() == cachedSize && info.ModTime().Equal(cachedModTime) {
+				a.msgCache.Metrics().Hit()
 				// Return a copy to avoid mutation
 				return copyMessages(cached.messages), nil
 			}
@@ -296,9 +303,13 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 			if info.Size() > cachedSize && offset > 0 {
 				messages, entry, err := a.parseMessagesIncremental(path, cached, offset, info)
 				if err == nil {
+					a.msgCache.Metrics().Incremental()
 					a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 					return messages, nil
 				}
+				if errors.Is(err, cache.ErrBadCheckpoint) {
+					a.msgCache.Metrics().Recovered()
+				}
 				// Fall through to full parse on error
 			}
 			// File shrank or other change: full re-parse
@@ -312,6 +323,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	}
 
 	if a.msgCache != nil {
+		a.msgCache.Metrics().Full()
 		a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 	}
 	return messages, nil
@@ -319,7 +331,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 
 // parseMessagesFull parses all messages from a session file.
 func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Message, messageCacheEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, messageCacheEntry{}, err
 	}
@@ -329,20 +341,22 @@ func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Me
 	toolUseRefs := make(map[string]toolUseRef)
 	pendingRefs := make(map[string]toolUseRef)
 	var bytesRead int64
+	lineNo := 0
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1 // +1 for newline
+		lineStart := scanner.LineStart()
+		bytesRead = scanner.Offset()
+		lineNo++
 
 		msg, msgType, ok := a.parseMessageLine(line)
 		if !ok {
 			continue
 		}
+		msg.Source = adapter.SourceRef{Line: lineNo, Offset: lineStart}
 
 		msgIdx := len(messages)
 		messages = append(messages, msg)
@@ -367,11 +381,13 @@ func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Me
 		return messages, messageCacheEntry{}, err
 	}
 
+	adapter.CompactToolOutputs(messages)
 	entry := messageCacheEntry{
 		messages:     copyMessages(messages),
 		toolUseRefs:  toolUseRefs,
 		pendingRefs:  pendingRefs,
 		byteOffset:   bytesRead,
+		lineCount:    lineNo,
 		messageCount: len(messages),
 	}
 
@@ -391,8 +407,10 @@ func (a *Adapter) parseMessagesIncremental(path string, cached messageCacheEntry
 	messages := copyMessages(cached.messages)
 	toolUseRefs := copyToolUseRefs(cached.toolUseRefs)
 	pendingRefs := copyToolUseRefs(cached.pendingRefs)
+	lineNo := cached.lineCount
 
 	for {
+		lineStart := reader.Offset()
 		line, err := reader.Next()
 		if err != nil {
 			if err == io.EOF {
@@ -400,11 +418,13 @@ func (a *Adapter) parseMessagesIncremental(path string, cached messageCacheEntry
 			}
 			return nil, messageCacheEntry{}, err
 		}
+		lineNo++
 
 		msg, msgType, ok := a.parseMessageLine(line)
 		if !ok {
 			continue
 		}
+		msg.Source = adapter.SourceRef{Line: lineNo, Offset: lineStart}
 
 		msgIdx := len(messages)
 		messages = append(messages, msg)
@@ -422,11 +442,13 @@ func (a *Adapter) parseMessagesIncremental(path string, cached messageCacheEntry
 		}
 	}
 
+	adapter.CompactToolOutputs(messages)
 	entry := messageCacheEntry{
 		messages:     copyMessages(messages),
 		toolUseRefs:  toolUseRefs,
 		pendingRefs:  pendingRefs,
 		byteOffset:   reader.Offset(),
+		lineCount:    lineNo,
 		messageCount: len(messages),
 	}
 
@@ -623,6 +645,38 @@ func (a *Adapter) Usage(sessionID string) (*adapter.UsageStats, error) {
 	return stats, nil
 }
 
+// UsageSnapshot implements adapter.UsageSnapshotter. It goes through the
+// metadata cache, so a grown file is parsed only from its last offset.
+func (a *Adapter) UsageSnapshot(sessionID string) (adapter.UsageSnapshot, bool) {
+	path := a.sessionFilePath(sessionID)
+	if path == "" {
+		return adapter.UsageSnapshot{}, false
+	}
+	info, err := ingest.Check(adapterID, a.projectsDir, path)
+	if err != nil {
+		return adapter.UsageSnapshot{}, false
+	}
+	meta, err := a.sessionMetadata(path, info)
+	if err != nil {
+		return adapter.UsageSnapshot{}, false
+	}
+
+	models := make(map[string]int)
+	if entry, ok := a.index.Cached(path); ok {
+		for model, mt := range entry.Data.ModelTokens {
+			models[model] = mt.In + mt.Out
+		}
+	}
+
+	return adapter.UsageSnapshot{
+		Tokens:      meta.TotalTokens,
+		Cost:        meta.EstCost,
+		Messages:    meta.MsgCount,
+		ModelTokens: models,
+		UpdatedAt:   meta.LastMsg,
+	}, true
+}
+
 // Watch returns a channel that emits events when session data changes.
 func (a *Adapter) Watch(projectRoot string) (<-chan adapter.Event, io.Closer, error) {
 	return NewWatcher(a.projectDirPath(projectRoot))
@@ -703,12 +757,9 @@ func (a *Adapter) DiscoverRelatedProjectDirs(mainWorktreePath string) ([]string,
 // sessionFilePath finds the JSONL file for a given session ID.
 func (a *Adapter) sessionFilePath(sessionID string) string {
 	// Check cache first
-	a.mu.RLock()
-	if path, ok := a.sessionIndex[sessionID]; ok {
-		a.mu.RUnlock()
+	if path, ok := a.index.Path(sessionID); ok {
 		return path
 	}
-	a.mu.RUnlock()
 
 	// Fallback: scan all project directories
 	entries, err := os.ReadDir(a.projectsDir)
@@ -723,9 +774,7 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 		path := filepath.Join(a.projectsDir, projDir.Name(), sessionID+".jsonl")
 		if _, err := os.Stat(path); err == nil {
 			// Cache for future lookups
-			a.mu.Lock()
-			a.sessionIndex[sessionID] = path
-			a.mu.Unlock()
+			a.index.SetPath(sessionID, path)
 			return path
 		}
 	}
@@ -741,7 +790,7 @@ func (a *Adapter) parseSessionMetadata(path string) (*SessionMetadata, error) {
 // parseSessionMetadataFull extracts metadata from a session file, scanning all lines.
 // Returns metadata, final byte offset, and per-model tracking for incremental use.
 func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64, map[string]int, map[string]modelTokenEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, 0, nil, nil, err
 	}
@@ -752,10 +801,8 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64
 		SessionID: strings.TrimSuffix(filepath.Base(path), ".jsonl"),
 	}
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	modelCounts := make(map[string]int)
 	modelTokens := make(map[string]modelTokenEntry)
@@ -763,7 +810,7 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1 // +1 for newline
+		bytesRead = scanner.Offset()
 
 		a.processMetadataLine(line, meta, modelCounts, modelTokens)
 	}
@@ -781,7 +828,7 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64
 // parseSessionMetadataIncremental resumes parsing from a byte offset (td-1b774e).
 // Reuses cached head metadata (FirstMsg, CWD, etc.) and accumulates new tail data.
 func (a *Adapter) parseSessionMetadataIncremental(path string, base *SessionMetadata, offset int64, baseModelCounts map[string]int, baseModelTokens map[string]modelTokenEntry) (*SessionMetadata, int64, map[string]int, map[string]modelTokenEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, 0, nil, nil, err
 	}
@@ -816,15 +863,13 @@ func (a *Adapter) parseSessionMetadataIncremental(path string, base *SessionMeta
 		modelTokens[k] = v
 	}
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	bytesRead := offset
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = offset + scanner.Offset()
 
 		a.processMetadataLine(line, meta, modelCounts, modelTokens)
 	}
@@ -875,10 +920,10 @@ func (a *Adapter) processMetadataLine(line []byte, meta *SessionMetadata, modelC
 		if model != "" {
 			modelCounts[model]++
 			mt := modelTokens[model]
-			mt.in += usage.InputTokens
-			mt.out += usage.OutputTokens
-			mt.cache += usage.CacheReadInputTokens
-			mt.cacheWrite += usage.CacheCreationInputTokens
+			mt.In += usage.InputTokens
+			mt.Out += usage.OutputTokens
+			mt.Cache += usage.CacheReadInputTokens
+			mt.CacheWrite += usage.CacheCreationInputTokens
 			modelTokens[model] = mt
 		}
 	}
@@ -899,149 +944,65 @@ func (a *Adapter) finalizeMetadataCost(meta *SessionMetadata, modelCounts map[st
 
 	for model, mt := range modelTokens {
 		meta.EstCost += pricing.ModelCost(model, pricing.Usage{
-			InputTokens:  mt.in,
-			OutputTokens: mt.out,
-			CacheRead:    mt.cache,
-			CacheWrite:   mt.cacheWrite,
+			InputTokens:  mt.In,
+			OutputTokens: mt.Out,
+			CacheRead:    mt.Cache,
+			CacheWrite:   mt.CacheWrite,
 		})
 	}
 }
 
 // modelTokenEntry tracks per-model token accumulation for incremental cost calculation.
 type modelTokenEntry struct {
-	in, out, cache, cacheWrite int
+	In, Out, Cache, CacheWrite int
 }
 
-type sessionMetaCacheEntry struct {
-	meta        *SessionMetadata
-	modTime     time.Time
-	size        int64
-	lastAccess  time.Time
-	byteOffset  int64                      // position after last parsed line (for incremental)
-	modelCounts map[string]int             // per-model message counts
-	modelTokens map[string]modelTokenEntry // per-model token accumulation
+// sessionMeta is the cached metadata state for one session file, with the
+// per-model tracking needed to resume parsing when the file grows.
+type sessionMeta struct {
+	Meta        *SessionMetadata
+	ModelCounts map[string]int
+	ModelTokens map[string]modelTokenEntry
 }
 
+// metaStore persists session metadata across launches so warm startups
+// skip reparsing unchanged files.
+var metaStore = cache.NewPersistent[sessionMeta]("claudecode.meta.v2")
+
 // sessionMetadata returns cached metadata if valid, otherwise parses the file.
 // Supports incremental parsing when a file grows (td-1b774e): reuses cached
 // metadata and resumes parsing from the last byte offset.
-// Uses write lock for cache hits to safely update lastAccess (td-02e326f7).
 func (a *Adapter) sessionMetadata(path string, info os.FileInfo) (*SessionMetadata, error) {
-	now := time.Now()
-
-	a.metaMu.Lock()
-	entry, cached := a.metaCache[path]
-	if cached {
-		if entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
-			// Exact cache hit (unchanged file)
-			entry.lastAccess = now
-			a.metaCache[path] = entry
-			metaCopy := *entry.meta
-			a.metaMu.Unlock()
-			return &metaCopy, nil
-		}
-		if info.Size() > entry.size && entry.byteOffset > 0 {
-			// File grew - try incremental parse from saved offset (td-1b774e)
-			a.metaMu.Unlock()
-			meta, newOffset, mc, mt, err := a.parseSessionMetadataIncremental(path, entry.meta, entry.byteOffset, entry.modelCounts, entry.modelTokens)
-			if err == nil {
-				a.metaMu.Lock()
-				a.metaCache[path] = sessionMetaCacheEntry{
-					meta:        meta,
-					modTime:     info.ModTime(),
-					size:        info.Size(),
-					lastAccess:  now,
-					byteOffset:  newOffset,
-					modelCounts: mc,
-					modelTokens: mt,
-				}
-				a.enforceSessionMetaCacheLimitLocked()
-				a.metaMu.Unlock()
-				return meta, nil
-			}
-			// Fall through to full parse on error
-		} else {
-			a.metaMu.Unlock()
-		}
-	} else {
-		a.metaMu.Unlock()
-	}
-
-	meta, offset, mc, mt, err := a.parseSessionMetadataFull(path)
+	state, err := a.index.Get(path, info, cache.Parser[sessionMeta]{
+		Full: func(path string, _ os.FileInfo) (sessionMeta, int64, error) {
+			meta, offset, mc, mt, err := a.parseSessionMetadataFull(path)
+			return sessionMeta{Meta: meta, ModelCounts: mc, ModelTokens: mt}, offset, err
+		},
+		Incremental: func(path string, _ os.FileInfo, prev sessionMeta, offset int64) (sessionMeta, int64, error) {
+			meta, offset, mc, mt, err := a.parseSessionMetadataIncremental(path, prev.Meta, offset, prev.ModelCounts, prev.ModelTokens)
+			return sessionMeta{Meta: meta, ModelCounts: mc, ModelTokens: mt}, offset, err
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	a.metaMu.Lock()
-	a.metaCache[path] = sessionMetaCacheEntry{
-		meta:        meta,
-		modTime:     info.ModTime(),
-		size:        info.Size(),
-		lastAccess:  now,
-		byteOffset:  offset,
-		modelCounts: mc,
-		modelTokens: mt,
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-	return meta, nil
+	metaCopy := *state.Meta
+	return &metaCopy, nil
 }
 
 func (a *Adapter) pruneSessionMetaCache(dir string, seenPaths map[string]struct{}) {
-	dir = filepath.Clean(dir)
-	dirPrefix := dir + string(os.PathSeparator)
-
-	a.metaMu.Lock()
-	for path := range a.metaCache {
+	dirPrefix := filepath.Clean(dir) + string(os.PathSeparator)
+	a.index.Retain(func(path string) bool {
 		if !strings.HasPrefix(path, dirPrefix) {
-			continue
-		}
-		if _, ok := seenPaths[path]; !ok {
-			delete(a.metaCache, path)
+			return true
 		}
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-}
-
-func (a *Adapter) enforceSessionMetaCacheLimitLocked() {
-	excess := len(a.metaCache) - metaCacheMaxEntries
-	if excess <= 0 {
-		return
-	}
-
-	// Collect entries for sorting
-	type pathAccess struct {
-		path       string
-		lastAccess time.Time
-	}
-	entries := make([]pathAccess, 0, len(a.metaCache))
-	for path, entry := range a.metaCache {
-		entries = append(entries, pathAccess{path, entry.lastAccess})
-	}
-
-	// Sort by lastAccess (oldest first)
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].lastAccess.Before(entries[j].lastAccess)
+		_, ok := seenPaths[path]
+		return ok
 	})
-
-	// Delete oldest entries
-	for i := 0; i < excess; i++ {
-		delete(a.metaCache, entries[i].path)
-	}
 }
 
 func (a *Adapter) invalidateSessionMetaCacheIfChanged(path string, info os.FileInfo) {
-	if info == nil {
-		return
-	}
-	a.metaMu.Lock()
-	if entry, ok := a.metaCache[path]; ok {
-		if entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
-			delete(a.metaCache, path)
-		}
-	}
-	a.metaMu.Unlock()
+	a.index.InvalidateIfChanged(path, info)
 }
 
 // shortID returns the first 8 characters of an ID, or the full ID if shorter.
@@ -1262,6 +1223,18 @@ func (a *Adapter) parseContentWithResults(rawContent json.RawMessage, toolResult
 				ToolOutput: output,
 				IsError:    isError,
 			})
+		case "image":
+			if block.Source == nil || block.Source.Type != "base64" {
+				continue
+			}
+			w, h := imageDimensions(block.Source.Data)
+			contentBlocks = append(contentBlocks, adapter.ContentBlock{
+				Type:        "image",
+				MediaType:   block.Source.MediaType,
+				ImageData:   block.Source.Data,
+				ImageWidth:  w,
+				ImageHeight: h,
+			})
 		case "tool_result":
 			toolResultCount++
 			// Add tool_result to content blocks for user messages
@@ -1290,3 +1263,13 @@ func (a *Adapter) parseContentWithResults(rawContent json.RawMessage, toolResult
 
 	return content, toolUses, thinkingBlocks, contentBlocks
 }
+
+// imageDimensions decodes just enough of a base64 image to read its size.
+// Returns zeros for formats without a registered decoder (e.g. webp).
+func imageDimensions(data string) (int, int) {
+	cfg, _, err := image.DecodeConfig(base64.NewDecoder(base64.StdEncoding, strings.NewReader(data)))
+	if err != nil {
+		return 0, 0
+	}
+	return cfg.Width, cfg.Height
+}
@@ -30,7 +30,7 @@ func BenchmarkMessages_FullParse_1MB(b *testing.B) {
 	b.Logf("Generated file size: %d bytes (%d messages)", info.Size(), messageCount*2)
 
 	a := New()
-	a.sessionIndex["bench-session-001"] = sessionFile
+	a.index.SetPath("bench-session-001", sessionFile)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -63,7 +63,7 @@ func BenchmarkMessages_FullParse_10MB(b *testing.B) {
 	b.Logf("Generated file size: %d bytes (%d messages)", info.Size(), messageCount*2)
 
 	a := New()
-	a.sessionIndex["bench-session-001"] = sessionFile
+	a.index.SetPath("bench-session-001", sessionFile)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -88,7 +88,7 @@ func BenchmarkMessages_CacheHit(b *testing.B) {
 	}
 
 	a := New()
-	a.sessionIndex["bench-session-001"] = sessionFile
+	a.index.SetPath("bench-session-001", sessionFile)
 
 	// Warm the cache
 	_, err := a.Messages("bench-session-001")
@@ -118,7 +118,7 @@ func BenchmarkMessages_IncrementalParse(b *testing.B) {
 	}
 
 	a := New()
-	a.sessionIndex["bench-session-001"] = sessionFile
+	a.index.SetPath("bench-session-001", sessionFile)
 
 	// Warm the cache
 	_, err := a.Messages("bench-session-001")
@@ -178,8 +178,7 @@ func BenchmarkSessions_50Files(b *testing.B) {
 
 	for i := 0; i < b.N; i++ {
 		// Clear caches
-		a.sessionIndex = make(map[string]string)
-		a.metaCache = make(map[string]sessionMetaCacheEntry)
+		a.index = cache.SessionIndex[sessionMeta]{}
 		_, err := a.Sessions(projectRoot)
 		if err != nil {
 			b.Fatalf("Sessions failed: %v", err)
@@ -208,7 +207,7 @@ func BenchmarkMessages_Allocs(b *testing.B) {
 	}
 
 	a := New()
-	a.sessionIndex["bench-session-001"] = sessionFile
+	a.index.SetPath("bench-session-001", sessionFile)
 
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -1,10 +1,18 @@
 package claudecode
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/cache"
 )
 
 func TestDetect(t *testing.T) {
@@ -349,7 +357,7 @@ func TestSlugExtraction(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	// Create adapter with custom projects dir
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	// Create project hash dir (simulates -home-user-project)
 	projectHash := "-test-project"
@@ -395,7 +403,7 @@ func TestSlugExtraction(t *testing.T) {
 func TestSlugExtraction_NoSlug(t *testing.T) {
 	// Create temp dir
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -428,7 +436,7 @@ func TestSlugExtraction_NoSlug(t *testing.T) {
 func TestSlugExtraction_SessionsIntegration(t *testing.T) {
 	// Create temp dir with project structure
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	// Create project hash dir that matches what projectDirPath would generate
 	// For path "/test/project", the hash is "-test-project"
@@ -488,7 +496,7 @@ func TestSlugExtraction_SessionsIntegration(t *testing.T) {
 func TestSlugExtraction_SlugOnLaterMessage(t *testing.T) {
 	// Test that slug is extracted even if it appears on a later message
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -802,7 +810,7 @@ func TestSessionMetadataCacheIncremental(t *testing.T) {
 	}
 
 	a := New()
-	a.metaCache = make(map[string]sessionMetaCacheEntry)
+	a.index = cache.SessionIndex[sessionMeta]{}
 
 	// First call: full parse, populates cache
 	info1, _ := os.Stat(sessionPath)
@@ -853,10 +861,9 @@ func TestSessionByID(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  tmpDir,
-		sessionIndex: map[string]string{sessionID: projDir + "/" + sessionID + ".jsonl"},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: tmpDir,
 	}
+	a.index.SetPath(sessionID, projDir+"/"+sessionID+".jsonl")
 
 	session, err := a.SessionByID(sessionID)
 	if err != nil {
@@ -905,7 +912,7 @@ func TestMessagesCaching_CacheHit(t *testing.T) {
 
 	a := New()
 	a.projectsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.ReplacePaths(map[string]string{sessionID: sessionPath})
 
 	// First call: populates cache
 	msgs1, err := a.Messages(sessionID)
@@ -949,7 +956,7 @@ func TestMessagesCaching_IncrementalParse(t *testing.T) {
 
 	a := New()
 	a.projectsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.ReplacePaths(map[string]string{sessionID: sessionPath})
 
 	// First call: full parse
 	msgs1, err := a.Messages(sessionID)
@@ -978,6 +985,54 @@ func TestMessagesCaching_IncrementalParse(t *testing.T) {
 	}
 }
 
+func TestMessages_SourceRef(t *testing.T) {
+	tmpDir := t.TempDir()
+	projDir := tmpDir + "/-tmp-project"
+	if err := os.MkdirAll(projDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	line1 := `{"type":"summary","summary":"not a message"}`
+	line2 := `{"type":"user","timestamp":"2024-01-01T10:00:00Z","uuid":"msg1","message":{"role":"user","content":"hello"}}`
+	line3 := `{"type":"assistant","timestamp":"2024-01-01T10:01:00Z","uuid":"msg2","message":{"role":"assistant","content":"hi"}}`
+	sessionID := "source-test-001"
+	sessionPath := projDir + "/" + sessionID + ".jsonl"
+	if err := os.WriteFile(sessionPath, []byte(line1+"\n"+line2+"\n"+line3+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	a := New()
+	a.projectsDir = tmpDir
+	a.index.ReplacePaths(map[string]string{sessionID: sessionPath})
+
+	msgs, err := a.Messages(sessionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 msgs, got %d", len(msgs))
+	}
+	want := adapter.SourceRef{Line: 3, Offset: int64(len(line1) + len(line2) + 2)}
+	if msgs[1].Source != want {
+		t.Errorf("msg2 source = %+v, want %+v", msgs[1].Source, want)
+	}
+
+	// Appended messages continue the line/offset count via incremental parse
+	line4 := `{"type":"user","timestamp":"2024-01-01T10:02:00Z","uuid":"msg3","message":{"role":"user","content":"more"}}`
+	f, _ := os.OpenFile(sessionPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	_, _ = f.WriteString(line4 + "\n")
+	_ = f.Close()
+
+	msgs, err = a.Messages(sessionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = adapter.SourceRef{Line: 4, Offset: want.Offset + int64(len(line3)+1)}
+	if len(msgs) != 3 || msgs[2].Source != want {
+		t.Errorf("msg3 source = %+v, want %+v", msgs[len(msgs)-1].Source, want)
+	}
+}
+
 func TestMessagesCaching_FileShrink(t *testing.T) {
 	tmpDir := t.TempDir()
 	projDir := tmpDir + "/-tmp-project"
@@ -997,7 +1052,7 @@ func TestMessagesCaching_FileShrink(t *testing.T) {
 
 	a := New()
 	a.projectsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.ReplacePaths(map[string]string{sessionID: sessionPath})
 
 	// First call: cache 3 messages
 	msgs1, err := a.Messages(sessionID)
@@ -1025,6 +1080,44 @@ func TestMessagesCaching_FileShrink(t *testing.T) {
 	}
 }
 
+func TestMessagesCaching_ReparsesEvictedToolOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	projDir := tmpDir + "/-tmp-project"
+	if err := os.MkdirAll(projDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	output := strings.Repeat("line of output\n", cache.BlobThreshold/8)
+	sessionData := `{"type":"assistant","timestamp":"2024-01-01T10:01:00Z","uuid":"msg1","message":{"role":"assistant","content":[{"type":"tool_use","id":"tool-1","name":"Bash","input":{"command":"cat big"}}]}}
+{"type":"user","timestamp":"2024-01-01T10:02:00Z","uuid":"msg2","message":{"role":"user","content":[{"type":"tool_result","tool_use_id":"tool-1","content":"` + strings.ReplaceAll(output, "\n", `\n`) + `"}]}}
+`
+	sessionID := "evicted-output"
+	sessionPath := projDir + "/" + sessionID + ".jsonl"
+	if err := os.WriteFile(sessionPath, []byte(sessionData), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	a := New()
+	a.projectsDir = tmpDir
+	a.index.ReplacePaths(map[string]string{sessionID: sessionPath})
+	if _, err := a.Messages(sessionID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a persistent store, other outputs evict this one from memory
+	for i := range 8 {
+		cache.PutBlob(strings.Repeat(string(rune('a'+i)), 2*1024*1024))
+	}
+
+	msgs, err := a.Messages(sessionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msgs[0].ToolUses[0].FullOutput(); got != output {
+		t.Errorf("full output after eviction = %d bytes, want %d", len(got), len(output))
+	}
+}
+
 func TestMessagesCaching_ToolLinkingAcrossBoundary(t *testing.T) {
 	tmpDir := t.TempDir()
 	projDir := tmpDir + "/-tmp-project"
@@ -1044,7 +1137,7 @@ func TestMessagesCaching_ToolLinkingAcrossBoundary(t *testing.T) {
 
 	a := New()
 	a.projectsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.ReplacePaths(map[string]string{sessionID: sessionPath})
 
 	// First call: tool use without result
 	msgs1, err := a.Messages(sessionID)
@@ -1093,7 +1186,7 @@ func TestSessionMetadataCacheHit(t *testing.T) {
 	}
 
 	a := New()
-	a.metaCache = make(map[string]sessionMetaCacheEntry)
+	a.index = cache.SessionIndex[sessionMeta]{}
 
 	info, _ := os.Stat(sessionPath)
 
@@ -1128,7 +1221,7 @@ func TestSessionMetadataCacheInvalidation(t *testing.T) {
 	}
 
 	a := New()
-	a.metaCache = make(map[string]sessionMetaCacheEntry)
+	a.index = cache.SessionIndex[sessionMeta]{}
 
 	info1, _ := os.Stat(sessionPath)
 
@@ -1160,3 +1253,79 @@ func TestSessionMetadataCacheInvalidation(t *testing.T) {
 		t.Errorf("expected 3 msgs after invalidation, got %d", meta2.MsgCount)
 	}
 }
+
+func TestParseContent_ImageBlock(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 64, 32))); err != nil {
+		t.Fatal(err)
+	}
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+	raw := fmt.Sprintf(`[{"type":"text","text":"see this"},{"type":"image","source":{"type":"base64","media_type":"image/png","data":%q}}]`, data)
+
+	a := New()
+	content, _, _, blocks := a.parseContentWithResults([]byte(raw), nil)
+	if content != "see this" {
+		t.Errorf("content = %q, want text only", content)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("expected 2 blocks, got %d", len(blocks))
+	}
+	img := blocks[1]
+	if img.Type != "image" || img.MediaType != "image/png" || img.ImageData != data {
+		t.Errorf("unexpected image block %+v", img)
+	}
+	if img.ImageWidth != 64 || img.ImageHeight != 32 {
+		t.Errorf("dimensions = %dx%d, want 64x32", img.ImageWidth, img.ImageHeight)
+	}
+
+	if w, h := imageDimensions("not base64 image"); w != 0 || h != 0 {
+		t.Errorf("invalid data should give 0x0, got %dx%d", w, h)
+	}
+}
+
+func TestSessionMetadata_PersistentCache(t *testing.T) {
+	if err := cache.OpenStore(t.TempDir()); err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	t.Cleanup(func() { _ = cache.CloseStore() })
+
+	path := filepath.Join(t.TempDir(), "test-session-slug.jsonl")
+	copyTestdataFile(t, "testdata/valid_session_with_slug.jsonl", path)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := &Adapter{index: cache.SessionIndex[sessionMeta]{Store: metaStore}}
+	want, err := first.sessionMetadata(path, info)
+	if err != nil {
+		t.Fatalf("sessionMetadata: %v", err)
+	}
+
+	// Corrupt the file without changing size or mtime: a new adapter must
+	// answer from the persistent cache instead of reparsing.
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range data {
+		if data[i] != '\n' {
+			data[i] = ' '
+		}
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+
+	second := &Adapter{index: cache.SessionIndex[sessionMeta]{Store: metaStore}}
+	got, err := second.sessionMetadata(path, info)
+	if err != nil {
+		t.Fatalf("sessionMetadata from persistent cache: %v", err)
+	}
+	if got.Slug != want.Slug || got.MsgCount != want.MsgCount {
+		t.Errorf("persisted metadata = %+v, want %+v", got, want)
+	}
+}
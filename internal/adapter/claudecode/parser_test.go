@@ -579,7 +579,7 @@ func parseMessagesFromFile(t *testing.T, a *Adapter, path string) []testMessage
 func TestTwoPassToolLinking_MultipleToolUses(t *testing.T) {
 	// Create temp dir with test session
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -590,9 +590,7 @@ func TestTwoPassToolLinking_MultipleToolUses(t *testing.T) {
 	copyTestdataFile(t, "testdata/tool_linking.jsonl", projectDir+"/tool-linking-session.jsonl")
 
 	// Populate session index
-	a.mu.Lock()
-	a.sessionIndex["tool-linking-session"] = projectDir + "/tool-linking-session.jsonl"
-	a.mu.Unlock()
+	a.index.SetPath("tool-linking-session", projectDir+"/tool-linking-session.jsonl")
 
 	// Get messages
 	messages, err := a.Messages("tool-linking-session")
@@ -660,7 +658,7 @@ func TestTwoPassToolLinking_MultipleToolUses(t *testing.T) {
 // is correctly linked and the error flag is preserved.
 func TestTwoPassToolLinking_ErrorResult(t *testing.T) {
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -669,9 +667,7 @@ func TestTwoPassToolLinking_ErrorResult(t *testing.T) {
 
 	copyTestdataFile(t, "testdata/tool_linking.jsonl", projectDir+"/tool-linking-session.jsonl")
 
-	a.mu.Lock()
-	a.sessionIndex["tool-linking-session"] = projectDir + "/tool-linking-session.jsonl"
-	a.mu.Unlock()
+	a.index.SetPath("tool-linking-session", projectDir+"/tool-linking-session.jsonl")
 
 	messages, err := a.Messages("tool-linking-session")
 	if err != nil {
@@ -719,7 +715,7 @@ func TestTwoPassToolLinking_ErrorResult(t *testing.T) {
 // with no matching tool_use are gracefully ignored (no crash).
 func TestTwoPassToolLinking_OrphanToolResult(t *testing.T) {
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -728,9 +724,7 @@ func TestTwoPassToolLinking_OrphanToolResult(t *testing.T) {
 
 	copyTestdataFile(t, "testdata/tool_linking_edge_cases.jsonl", projectDir+"/edge-cases-session.jsonl")
 
-	a.mu.Lock()
-	a.sessionIndex["edge-cases-session"] = projectDir + "/edge-cases-session.jsonl"
-	a.mu.Unlock()
+	a.index.SetPath("edge-cases-session", projectDir+"/edge-cases-session.jsonl")
 
 	// Should not panic or error
 	messages, err := a.Messages("edge-cases-session")
@@ -771,7 +765,7 @@ func TestTwoPassToolLinking_OrphanToolResult(t *testing.T) {
 // can appear in a different order than their corresponding tool_use blocks.
 func TestTwoPassToolLinking_OutOfOrderResults(t *testing.T) {
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -780,9 +774,7 @@ func TestTwoPassToolLinking_OutOfOrderResults(t *testing.T) {
 
 	copyTestdataFile(t, "testdata/tool_linking_edge_cases.jsonl", projectDir+"/edge-cases-session.jsonl")
 
-	a.mu.Lock()
-	a.sessionIndex["edge-cases-session"] = projectDir + "/edge-cases-session.jsonl"
-	a.mu.Unlock()
+	a.index.SetPath("edge-cases-session", projectDir+"/edge-cases-session.jsonl")
 
 	messages, err := a.Messages("edge-cases-session")
 	if err != nil {
@@ -829,7 +821,7 @@ func TestTwoPassToolLinking_OutOfOrderResults(t *testing.T) {
 // with array content (nested content blocks) instead of simple string.
 func TestTwoPassToolLinking_ComplexResultContent(t *testing.T) {
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -838,9 +830,7 @@ func TestTwoPassToolLinking_ComplexResultContent(t *testing.T) {
 
 	copyTestdataFile(t, "testdata/tool_linking_edge_cases.jsonl", projectDir+"/edge-cases-session.jsonl")
 
-	a.mu.Lock()
-	a.sessionIndex["edge-cases-session"] = projectDir + "/edge-cases-session.jsonl"
-	a.mu.Unlock()
+	a.index.SetPath("edge-cases-session", projectDir+"/edge-cases-session.jsonl")
 
 	messages, err := a.Messages("edge-cases-session")
 	if err != nil {
@@ -877,7 +867,7 @@ func TestTwoPassToolLinking_ComplexResultContent(t *testing.T) {
 // text blocks are interleaved with tool_use blocks.
 func TestTwoPassToolLinking_InterleavedTextAndTools(t *testing.T) {
 	tmpDir := t.TempDir()
-	a := &Adapter{projectsDir: tmpDir, sessionIndex: make(map[string]string), metaCache: make(map[string]sessionMetaCacheEntry)}
+	a := &Adapter{projectsDir: tmpDir}
 
 	projectDir := tmpDir + "/-test-project"
 	if err := os.MkdirAll(projectDir, 0755); err != nil {
@@ -886,9 +876,7 @@ func TestTwoPassToolLinking_InterleavedTextAndTools(t *testing.T) {
 
 	copyTestdataFile(t, "testdata/tool_linking_edge_cases.jsonl", projectDir+"/edge-cases-session.jsonl")
 
-	a.mu.Lock()
-	a.sessionIndex["edge-cases-session"] = projectDir + "/edge-cases-session.jsonl"
-	a.mu.Unlock()
+	a.index.SetPath("edge-cases-session", projectDir+"/edge-cases-session.jsonl")
 
 	messages, err := a.Messages("edge-cases-session")
 	if err != nil {
@@ -929,4 +917,3 @@ func TestTwoPassToolLinking_InterleavedTextAndTools(t *testing.T) {
 		t.Error("blocks[2].ToolOutput should be non-empty")
 	}
 }
-
@@ -30,10 +30,9 @@ func TestSearchMessages_EmptySession(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  filepath.Join(tmpDir),
-		sessionIndex: map[string]string{"empty-session": sessionFile},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: filepath.Join(tmpDir),
 	}
+	a.index.SetPath("empty-session", sessionFile)
 
 	results, err := a.SearchMessages("empty-session", "test", adapter.DefaultSearchOptions())
 	if err != nil {
@@ -61,10 +60,9 @@ func TestSearchMessages_WithMessages(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  tmpDir,
-		sessionIndex: map[string]string{"test-session": sessionFile},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: tmpDir,
 	}
+	a.index.SetPath("test-session", sessionFile)
 
 	results, err := a.SearchMessages("test-session", "hello", adapter.DefaultSearchOptions())
 	if err != nil {
@@ -98,10 +96,9 @@ func TestSearchMessages_RegexSearch(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  tmpDir,
-		sessionIndex: map[string]string{"regex-session": sessionFile},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: tmpDir,
 	}
+	a.index.SetPath("regex-session", sessionFile)
 
 	opts := adapter.SearchOptions{UseRegex: true, MaxResults: 50}
 	results, err := a.SearchMessages("regex-session", "test\\d+", opts)
@@ -131,10 +128,9 @@ func TestSearchMessages_ToolUseSearch(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  tmpDir,
-		sessionIndex: map[string]string{"tool-session": sessionFile},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: tmpDir,
 	}
+	a.index.SetPath("tool-session", sessionFile)
 
 	results, err := a.SearchMessages("tool-session", "Bash", adapter.DefaultSearchOptions())
 	if err != nil {
@@ -170,10 +166,9 @@ func TestSearchMessages_CaseSensitive(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  tmpDir,
-		sessionIndex: map[string]string{"case-session": sessionFile},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: tmpDir,
 	}
+	a.index.SetPath("case-session", sessionFile)
 
 	// Case insensitive should find all 3
 	opts := adapter.SearchOptions{CaseSensitive: false, MaxResults: 50}
@@ -211,10 +206,9 @@ func TestSearchMessages_TimestampPreserved(t *testing.T) {
 	}
 
 	a := &Adapter{
-		projectsDir:  tmpDir,
-		sessionIndex: map[string]string{"ts-session": sessionFile},
-		metaCache:    make(map[string]sessionMetaCacheEntry),
+		projectsDir: tmpDir,
 	}
+	a.index.SetPath("ts-session", sessionFile)
 
 	results, err := a.SearchMessages("ts-session", "searchable", adapter.DefaultSearchOptions())
 	if err != nil {
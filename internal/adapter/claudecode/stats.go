@@ -7,6 +7,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 	"github.com/wilbur182/forge/internal/adapter/pricing"
 )
 
@@ -61,8 +62,12 @@ func LoadStatsCache() (*StatsCache, error) {
 		return nil, err
 	}
 
-	path := filepath.Join(home, ".claude", "stats-cache.json")
-	data, err := os.ReadFile(path)
+	dir := filepath.Join(home, ".claude")
+	path := filepath.Join(dir, "stats-cache.json")
+	if _, err := ingest.Check(adapterID, dir, path); err != nil {
+		return nil, err
+	}
+	data, err := ingest.ReadJSON(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -45,15 +45,23 @@ type CacheCreation struct {
 
 // ContentBlock represents a single block in the content array.
 type ContentBlock struct {
-	Type      string `json:"type"`
-	Text      string `json:"text,omitempty"`
-	Thinking  string `json:"thinking,omitempty"`
-	ID        string `json:"id,omitempty"`        // tool_use ID
-	Name      string `json:"name,omitempty"`      // tool name
-	Input     any    `json:"input,omitempty"`     // tool input
-	ToolUseID string `json:"tool_use_id,omitempty"` // for tool_result linking
-	Content   any    `json:"content,omitempty"`     // tool_result content (string or array)
-	IsError   bool   `json:"is_error,omitempty"`    // tool_result error flag
+	Type      string       `json:"type"`
+	Text      string       `json:"text,omitempty"`
+	Thinking  string       `json:"thinking,omitempty"`
+	ID        string       `json:"id,omitempty"`          // tool_use ID
+	Name      string       `json:"name,omitempty"`        // tool name
+	Input     any          `json:"input,omitempty"`       // tool input
+	ToolUseID string       `json:"tool_use_id,omitempty"` // for tool_result linking
+	Content   any          `json:"content,omitempty"`     // tool_result content (string or array)
+	IsError   bool         `json:"is_error,omitempty"`    // tool_result error flag
+	Source    *ImageSource `json:"source,omitempty"`      // image data
+}
+
+// ImageSource holds an inline image attached to a message.
+type ImageSource struct {
+	Type      string `json:"type"` // "base64"
+	MediaType string `json:"media_type"`
+	Data      string `json:"data"`
 }
 
 // ToolResult represents the result of a tool call.
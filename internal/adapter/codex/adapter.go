@@ -1,8 +1,8 @@
 package codex
 
 import (
-	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -14,14 +14,14 @@ import (
 
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 const (
-	adapterID           = "codex"
-	adapterName         = "Codex"
-	metaCacheMaxEntries = 2048
-	msgCacheMaxEntries  = 128 // fewer entries since messages are larger
-	dirCacheTTL         = 500 * time.Millisecond // TTL for directory listing cache (td-c9ff3aac)
+	adapterID          = "codex"
+	adapterName        = "Codex"
+	msgCacheMaxEntries = 128                    // fewer entries since messages are larger
+	dirCacheTTL        = 500 * time.Millisecond // TTL for directory listing cache (td-c9ff3aac)
 	// Two-pass parsing thresholds (td-a2c1dd41)
 	metaParseSmallFileThreshold = 16 * 1024 // Files smaller than 16KB use full scan
 	metaParseHeadLines          = 100       // Read first N lines for session_meta
@@ -37,12 +37,10 @@ type dirCacheEntry struct {
 // Adapter implements the adapter.Adapter interface for Codex CLI sessions.
 type Adapter struct {
 	sessionsDir     string
-	sessionIndex    map[string]string                // sessionID -> file path cache
-	totalUsageCache map[string]*TokenUsage           // sessionID -> total usage (populated by Messages)
-	mu              sync.RWMutex                     // guards sessionIndex and totalUsageCache
-	metaCache       map[string]sessionMetaCacheEntry
-	metaMu          sync.RWMutex                        // guards metaCache
-	msgCache        *cache.Cache[messageCacheEntry]     // path -> cached messages
+	index           cache.SessionIndex[*SessionMetadata] // session metadata and sessionID -> file path
+	totalUsageCache map[string]*TokenUsage               // sessionID -> total usage (populated by Messages)
+	mu              sync.RWMutex                         // guards totalUsageCache
+	msgCache        *cache.Cache[messageCacheEntry]      // path -> cached messages
 	dirCache        *dirCacheEntry
 	dirCacheMu      sync.RWMutex // guards dirCache
 }
@@ -58,6 +56,7 @@ type messageCacheEntry struct {
 	totalUsage      *TokenUsage
 	lastTimestamp   time.Time
 	byteOffset      int64
+	lineCount       int // lines consumed up to byteOffset (for SourceRef)
 }
 
 // New creates a new Codex adapter.
@@ -65,10 +64,9 @@ func New() *Adapter {
 	home, _ := os.UserHomeDir()
 	return &Adapter{
 		sessionsDir:     filepath.Join(home, ".codex", "sessions"),
-		sessionIndex:    make(map[string]string),
+		index:           cache.SessionIndex[*SessionMetadata]{Name: adapterID + ".meta"},
 		totalUsageCache: make(map[string]*TokenUsage),
-		metaCache:       make(map[string]sessionMetaCacheEntry),
-		msgCache:        cache.New[messageCacheEntry](msgCacheMaxEntries),
+		msgCache:        cache.New[messageCacheEntry](msgCacheMaxEntries).Named(adapterID + ".messages"),
 	}
 }
 
@@ -99,6 +97,14 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the Codex sessions directory and today's session
+// directory, whose mtimes invalidate cached detection results (implements
+// adapter.DetectPathProvider). Sessions are stored by date, so a new one
+// changes only its day's directory.
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.sessionsDir, filepath.Join(a.sessionsDir, time.Now().Format("2006/01/02"))}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -162,9 +168,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 	}
 
 	// Atomically swap in the new index
-	a.mu.Lock()
-	a.sessionIndex = newIndex
-	a.mu.Unlock()
+	a.index.ReplacePaths(newIndex)
 
 	sort.Slice(sessions, func(i, j int) bool {
 		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
@@ -183,7 +187,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 		return nil, nil
 	}
 
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.sessionsDir, path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -194,9 +198,11 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	// Check cache for existing entry (if cache is initialized)
 	if a.msgCache != nil {
 		cached, offset, cachedSize, cachedModTime, ok := a.msgCache.GetWithOffset(path)
-		if ok {
+		// Tool outputs evicted from memory are recovered by a full parse
+		if ok && adapter.ToolOutputsAvailable(cached.messages) {
 			// Exact cache hit: file unchanged
 			if info.Size() == cachedSize && info.ModTime().Equal(cachedModTime) {
+				a.msgCache.Metrics().Hit()
 				return copyMessages(cached.messages), nil
 			}
 
@@ -204,6 +210,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 			if info.Size() > cachedSize && offset > 0 {
 				messages, entry, err := a.parseMessagesIncremental(path, sessionID, cached, offset, info)
 				if err == nil {
+					a.msgCache.Metrics().Incremental()
 					a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 					// Update total usage cache
 					if entry.totalUsage != nil {
@@ -213,6 +220,9 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 					}
 					return messages, nil
 				}
+				if errors.Is(err, cache.ErrBadCheckpoint) {
+					a.msgCache.Metrics().Recovered()
+				}
 				// Fall through to full parse on error
 			}
 			// File shrank or other change: full re-parse
@@ -226,6 +236,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	}
 
 	if a.msgCache != nil {
+		a.msgCache.Metrics().Full()
 		a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 	}
 
@@ -241,7 +252,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 
 // parseMessagesFull parses all messages from a session file.
 func (a *Adapter) parseMessagesFull(path, sessionID string, info os.FileInfo) ([]adapter.Message, messageCacheEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, messageCacheEntry{}, err
 	}
@@ -252,14 +263,13 @@ func (a *Adapter) parseMessagesFull(path, sessionID string, info os.FileInfo) ([
 	state := newParseState(sessionID)
 	var bytesRead int64
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		state.source = adapter.SourceRef{Line: state.source.Line + 1, Offset: scanner.LineStart()}
+		bytesRead = scanner.Offset()
 		a.processMessageRecord(line, state)
 	}
 
@@ -272,6 +282,7 @@ func (a *Adapter) parseMessagesFull(path, sessionID string, info os.FileInfo) ([
 	// Flush any remaining pending state
 	state.flushPending()
 
+	adapter.CompactToolOutputs(state.messages)
 	entry := messageCacheEntry{
 		messages:        copyMessages(state.messages),
 		pendingTools:    copyToolUses(state.pendingTools),
@@ -282,6 +293,7 @@ func (a *Adapter) parseMessagesFull(path, sessionID string, info os.FileInfo) ([
 		totalUsage:      state.totalUsage,
 		lastTimestamp:   state.lastTimestamp,
 		byteOffset:      bytesRead,
+		lineCount:       state.source.Line,
 	}
 
 	return state.messages, entry, nil
@@ -308,9 +320,11 @@ func (a *Adapter) parseMessagesIncremental(path, sessionID string, cached messag
 		currentModel:    cached.currentModel,
 		totalUsage:      cached.totalUsage,
 		lastTimestamp:   cached.lastTimestamp,
+		source:          adapter.SourceRef{Line: cached.lineCount, Offset: startOffset},
 	}
 
 	for {
+		lineStart := reader.Offset()
 		line, err := reader.Next()
 		if err != nil {
 			if err == io.EOF {
@@ -318,6 +332,7 @@ func (a *Adapter) parseMessagesIncremental(path, sessionID string, cached messag
 			}
 			return nil, messageCacheEntry{}, err
 		}
+		state.source = adapter.SourceRef{Line: state.source.Line + 1, Offset: lineStart}
 		a.processMessageRecord(line, state)
 	}
 
@@ -326,6 +341,7 @@ func (a *Adapter) parseMessagesIncremental(path, sessionID string, cached messag
 	// Flush any remaining pending state
 	state.flushPending()
 
+	adapter.CompactToolOutputs(state.messages)
 	entry := messageCacheEntry{
 		messages:        copyMessages(state.messages),
 		pendingTools:    copyToolUses(state.pendingTools),
@@ -336,6 +352,7 @@ func (a *Adapter) parseMessagesIncremental(path, sessionID string, cached messag
 		totalUsage:      state.totalUsage,
 		lastTimestamp:   state.lastTimestamp,
 		byteOffset:      reader.Offset(),
+		lineCount:       state.source.Line,
 	}
 
 	return state.messages, entry, nil
@@ -352,6 +369,7 @@ type parseState struct {
 	totalUsage      *TokenUsage
 	currentModel    string
 	lastTimestamp   time.Time
+	source          adapter.SourceRef // location of the record being processed
 }
 
 func newParseState(sessionID string) *parseState {
@@ -427,6 +445,7 @@ func (a *Adapter) processMessageRecord(line []byte, state *parseState) {
 				Content:   content,
 				Timestamp: record.Timestamp,
 				Model:     state.currentModel,
+				Source:    state.source,
 			}
 			if msg.Role == "assistant" {
 				message.ToolUses = append(message.ToolUses, state.pendingTools...)
@@ -643,8 +662,8 @@ func (a *Adapter) sessionFiles() ([]sessionFileEntry, error) {
 			return nil
 		}
 		if strings.HasSuffix(d.Name(), ".jsonl") {
-			// Get FileInfo from DirEntry to avoid duplicate stat (td-5a1e8104)
-			info, err := d.Info()
+			// Check returns the FileInfo, so no separate stat (td-5a1e8104)
+			info, err := ingest.Check(adapterID, a.sessionsDir, path)
 			if err != nil {
 				return nil
 			}
@@ -667,131 +686,47 @@ func (a *Adapter) sessionFiles() ([]sessionFileEntry, error) {
 	return files, nil
 }
 
-type sessionMetaCacheEntry struct {
-	meta       *SessionMetadata
-	modTime    time.Time
-	size       int64
-	lastAccess time.Time
-	headParsed bool // true = head data is immutable, can skip Pass 1 on growth (td-56c153)
-}
-
 // sessionMetadata returns cached metadata if valid, otherwise parses the file.
 // Supports tail-only re-parse when a file grows and head data was previously parsed (td-56c153).
 // Accepts FileInfo to avoid duplicate stat calls (td-5a1e8104).
-// Uses write lock for cache hits to safely update lastAccess (td-02e326f7).
 func (a *Adapter) sessionMetadata(path string, info os.FileInfo) (*SessionMetadata, error) {
-	now := time.Now()
-
-	a.metaMu.Lock()
-	entry, cached := a.metaCache[path]
-	if cached {
-		if entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
-			// Exact cache hit (unchanged file)
-			entry.lastAccess = now
-			a.metaCache[path] = entry
-			metaCopy := *entry.meta
-			a.metaMu.Unlock()
-			return &metaCopy, nil
-		}
-		if entry.headParsed && info.Size() > entry.size {
-			// File grew - only re-read tail, reuse head data (td-56c153)
-			a.metaMu.Unlock()
-			meta, err := a.parseSessionMetadataTailOnly(path, entry.meta, info.Size())
-			if err == nil {
-				a.metaMu.Lock()
-				a.metaCache[path] = sessionMetaCacheEntry{
-					meta:       meta,
-					modTime:    info.ModTime(),
-					size:       info.Size(),
-					lastAccess: now,
-					headParsed: true,
-				}
-				a.enforceSessionMetaCacheLimitLocked()
-				a.metaMu.Unlock()
-				return meta, nil
+	meta, err := a.index.Get(path, info, cache.Parser[*SessionMetadata]{
+		Full: func(path string, info os.FileInfo) (*SessionMetadata, int64, error) {
+			meta, err := a.parseSessionMetadata(path)
+			// Files large enough to use two-pass parsing have immutable head
+			// data, so growth only needs the tail re-read.
+			var offset int64
+			if info.Size() >= metaParseSmallFileThreshold {
+				offset = info.Size()
 			}
-			// Fall through to full parse on error
-		} else {
-			a.metaMu.Unlock()
-		}
-	} else {
-		a.metaMu.Unlock()
-	}
-
-	meta, err := a.parseSessionMetadata(path)
+			return meta, offset, err
+		},
+		Incremental: func(path string, info os.FileInfo, prev *SessionMetadata, _ int64) (*SessionMetadata, int64, error) {
+			meta, err := a.parseSessionMetadataTailOnly(path, prev, info.Size())
+			return meta, info.Size(), err
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// Mark headParsed for files large enough to use two-pass
-	isLargeFile := info.Size() >= metaParseSmallFileThreshold
-	a.metaMu.Lock()
-	a.metaCache[path] = sessionMetaCacheEntry{
-		meta:       meta,
-		modTime:    info.ModTime(),
-		size:       info.Size(),
-		lastAccess: now,
-		headParsed: isLargeFile,
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-	return meta, nil
+	metaCopy := *meta
+	return &metaCopy, nil
 }
 
 func (a *Adapter) pruneSessionMetaCache(seenPaths map[string]struct{}) {
-	a.metaMu.Lock()
-	for path := range a.metaCache {
-		if _, ok := seenPaths[path]; !ok {
-			delete(a.metaCache, path)
-		}
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-}
-
-func (a *Adapter) enforceSessionMetaCacheLimitLocked() {
-	excess := len(a.metaCache) - metaCacheMaxEntries
-	if excess <= 0 {
-		return
-	}
-
-	// Collect entries for sorting
-	type pathAccess struct {
-		path       string
-		lastAccess time.Time
-	}
-	entries := make([]pathAccess, 0, len(a.metaCache))
-	for path, entry := range a.metaCache {
-		entries = append(entries, pathAccess{path, entry.lastAccess})
-	}
-
-	// Sort by lastAccess (oldest first)
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].lastAccess.Before(entries[j].lastAccess)
+	a.index.Retain(func(path string) bool {
+		_, ok := seenPaths[path]
+		return ok
 	})
-
-	// Delete oldest entries
-	for i := 0; i < excess; i++ {
-		delete(a.metaCache, entries[i].path)
-	}
 }
 
 func (a *Adapter) invalidateSessionMetaCacheIfChanged(path string, info os.FileInfo) {
-	if info == nil {
-		return
-	}
-	a.metaMu.Lock()
-	if entry, ok := a.metaCache[path]; ok {
-		if entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
-			delete(a.metaCache, path)
-		}
-	}
-	a.metaMu.Unlock()
+	a.index.InvalidateIfChanged(path, info)
 }
 
 // parseSessionMetadata extracts metadata using two-pass parsing for large files (td-a2c1dd41).
 func (a *Adapter) parseSessionMetadata(path string) (*SessionMetadata, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -820,10 +755,8 @@ func (a *Adapter) parseSessionMetadataFull(file *os.File, path string) (*Session
 	var lastRecord time.Time
 	var totalTokens int
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	for scanner.Scan() {
 		a.processMetadataRecord(scanner.Bytes(), meta, &sessionTimestamp, &lastRecord, &totalTokens)
@@ -848,8 +781,7 @@ func (a *Adapter) parseSessionMetadataTwoPass(file *os.File, path string, fileSi
 	defer cache.PutScannerBuffer(buf)
 
 	// Pass 1: Read first N lines for session_meta, FirstMsg, FirstUserMessage
-	scanner := bufio.NewScanner(file)
-	scanner.Buffer(buf, 10*1024*1024)
+	scanner := cache.NewLineScanner(file, buf)
 
 	headComplete := false
 	for i := 0; i < metaParseHeadLines && scanner.Scan(); i++ {
@@ -870,8 +802,7 @@ func (a *Adapter) parseSessionMetadataTwoPass(file *os.File, path string, fileSi
 			return meta, nil
 		}
 
-		scanner = bufio.NewScanner(file)
-		scanner.Buffer(buf, 10*1024*1024)
+		scanner = cache.NewLineScanner(file, buf)
 
 		// Skip first partial line after seek
 		scanner.Scan()
@@ -894,7 +825,7 @@ func (a *Adapter) parseSessionMetadataTwoPass(file *os.File, path string, fileSi
 // parseSessionMetadataTailOnly re-reads only the tail of a file, reusing cached head data (td-56c153).
 // Skips Pass 1 entirely since head fields (SessionID, CWD, FirstMsg, FirstUserMessage) are immutable.
 func (a *Adapter) parseSessionMetadataTailOnly(path string, headMeta *SessionMetadata, fileSize int64) (*SessionMetadata, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -925,8 +856,7 @@ func (a *Adapter) parseSessionMetadataTailOnly(path string, headMeta *SessionMet
 		buf := cache.GetScannerBuffer()
 		defer cache.PutScannerBuffer(buf)
 
-		scanner := bufio.NewScanner(file)
-		scanner.Buffer(buf, 10*1024*1024)
+		scanner := cache.NewLineScanner(file, buf)
 
 		// Skip first partial line after seek
 		scanner.Scan()
@@ -935,7 +865,7 @@ func (a *Adapter) parseSessionMetadataTailOnly(path string, headMeta *SessionMet
 			a.processMetadataRecord(scanner.Bytes(), meta, &sessionTimestamp, &lastRecord, &totalTokens)
 		}
 	} else {
-		// File smaller than tail size - shouldn't happen since headParsed is only set for large files
+		// File smaller than tail size - shouldn't happen since tail-only parsing is only used for large files
 		return nil, fmt.Errorf("file too small for tail-only parse")
 	}
 
@@ -1051,12 +981,9 @@ func (a *Adapter) finalizeMetadata(meta *SessionMetadata, path string, sessionTi
 }
 
 func (a *Adapter) sessionFilePath(sessionID string) string {
-	a.mu.RLock()
-	if path, ok := a.sessionIndex[sessionID]; ok && path != "" {
-		a.mu.RUnlock()
+	if path, ok := a.index.Path(sessionID); ok {
 		return path
 	}
-	a.mu.RUnlock()
 
 	files, err := a.sessionFiles()
 	if err != nil {
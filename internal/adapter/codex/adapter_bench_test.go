@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter/cache"
 )
 
 // BenchmarkSessionFiles measures directory walking performance.
@@ -88,7 +90,7 @@ func BenchmarkSessionMetadataSmall(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _ = a.sessionMetadata(path, info)
 		// Clear cache between runs
-		a.metaCache = make(map[string]sessionMetaCacheEntry)
+		a.index = cache.SessionIndex[*SessionMetadata]{}
 	}
 }
 
@@ -114,7 +116,7 @@ func BenchmarkSessionMetadataLarge(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _ = a.sessionMetadata(path, info)
 		// Clear cache between runs
-		a.metaCache = make(map[string]sessionMetaCacheEntry)
+		a.index = cache.SessionIndex[*SessionMetadata]{}
 	}
 }
 
@@ -180,7 +182,7 @@ func BenchmarkSessions(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				// Clear caches between runs
 				a.dirCache = nil
-				a.metaCache = make(map[string]sessionMetaCacheEntry)
+				a.index = cache.SessionIndex[*SessionMetadata]{}
 				_, _ = a.Sessions(projectDir)
 			}
 		})
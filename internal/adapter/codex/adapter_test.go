@@ -283,12 +283,9 @@ func TestSessionMetadataTailOnlyOnGrowth(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	a := &Adapter{
-		sessionsDir: root,
-		metaCache:   make(map[string]sessionMetaCacheEntry),
-	}
+	a := &Adapter{sessionsDir: root}
 
-	// First parse: full two-pass, should set headParsed=true
+	// First parse: full two-pass, should save a tail offset
 	info1, _ := os.Stat(sessionPath)
 	meta1, err := a.sessionMetadata(sessionPath, info1)
 	if err != nil {
@@ -302,12 +299,10 @@ func TestSessionMetadataTailOnlyOnGrowth(t *testing.T) {
 		t.Errorf("expected at least 1 msg, got %d", meta1.MsgCount)
 	}
 
-	// Verify cache has headParsed set
-	a.metaMu.RLock()
-	entry := a.metaCache[sessionPath]
-	a.metaMu.RUnlock()
-	if !entry.headParsed {
-		t.Error("expected headParsed=true for large file")
+	// Verify cache saved an offset so growth re-reads only the tail
+	entry, _ := a.index.Cached(sessionPath)
+	if entry.ByteOffset == 0 {
+		t.Error("expected byte offset set for large file")
 	}
 
 	// Append new token usage event (simulating file growth)
@@ -359,7 +354,7 @@ func TestMessagesCaching_CacheHit(t *testing.T) {
 
 	a := New()
 	a.sessionsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.SetPath(sessionID, sessionPath)
 
 	// First call: populates cache
 	msgs1, err := a.Messages(sessionID)
@@ -400,7 +395,7 @@ func TestMessagesCaching_IncrementalParse(t *testing.T) {
 
 	a := New()
 	a.sessionsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.SetPath(sessionID, sessionPath)
 
 	// First call: full parse
 	msgs1, err := a.Messages(sessionID)
@@ -452,7 +447,7 @@ func TestMessagesCaching_ToolCallAcrossBoundary(t *testing.T) {
 
 	a := New()
 	a.sessionsDir = tmpDir
-	a.sessionIndex = map[string]string{sessionID: sessionPath}
+	a.index.SetPath(sessionID, sessionPath)
 
 	// First call: tool call without output
 	msgs1, err := a.Messages(sessionID)
@@ -18,6 +18,7 @@ import (
 	_ "modernc.org/sqlite"
 
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 // sqlitePoolSettings configures connection pool to prevent FD leaks (td-649ba4).
@@ -73,6 +74,16 @@ func (a *Adapter) Name() string { return adapterName }
 // Icon returns the adapter icon for badge display.
 func (a *Adapter) Icon() string { return "▌" }
 
+// DetectPaths returns the Cursor workspace directory whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	absPath, err := filepath.Abs(projectRoot)
+	if err != nil {
+		return nil
+	}
+	return []string{a.workspacePath(absPath)}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -135,6 +146,9 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		}
 
 		dbPath := filepath.Join(workspaceDir, e.Name(), "store.db")
+		if err := ingest.CheckDB(adapterID, a.chatsDir, dbPath); err != nil {
+			continue
+		}
 		meta, err := a.readSessionMeta(dbPath)
 		if err != nil {
 			continue
@@ -324,7 +338,7 @@ func (a *Adapter) findSessionDB(sessionID string) string {
 			continue
 		}
 		dbPath := filepath.Join(a.chatsDir, wsDir.Name(), sessionID, "store.db")
-		if _, err := os.Stat(dbPath); err == nil {
+		if err := ingest.CheckDB(adapterID, a.chatsDir, dbPath); err == nil {
 			return dbPath
 		}
 	}
@@ -13,7 +13,10 @@ func DetectAdapters(projectRoot string) (map[string]Adapter, error) {
 	adapters := make(map[string]Adapter)
 	for _, factory := range adapterFactories {
 		instance := factory()
-		detected, err := instance.Detect(projectRoot)
+		if !compatible(instance) {
+			continue
+		}
+		detected, err := DetectCached(instance, projectRoot)
 		if err != nil || !detected {
 			continue
 		}
@@ -25,10 +28,14 @@ func DetectAdapters(projectRoot string) (map[string]Adapter, error) {
 // AllAdapters creates all registered adapter instances without filtering by Detect.
 // Use this at startup so that all adapters are available across project switches;
 // consumers (e.g. conversations plugin) call Detect() per-adapter to filter by project.
+// Adapters built for an unsupported API version are skipped (see Incompatible).
 func AllAdapters() map[string]Adapter {
 	adapters := make(map[string]Adapter, len(adapterFactories))
 	for _, factory := range adapterFactories {
 		instance := factory()
+		if !compatible(instance) {
+			continue
+		}
 		adapters[instance.ID()] = instance
 	}
 	return adapters
@@ -0,0 +1,183 @@
+package adapter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DetectCacheTTL is how long a cached detection result is trusted before
+// the adapter's Detect is called again.
+const DetectCacheTTL = 10 * time.Minute
+
+const detectCacheFile = "detect-cache.json"
+
+// DetectPathProvider is an optional interface for adapters whose Detect
+// result depends only on a few directories or files. Cached results for
+// these adapters are revalidated by comparing the paths' mtimes, so new
+// session directories are picked up before the TTL expires.
+type DetectPathProvider interface {
+	DetectPaths(projectRoot string) []string
+}
+
+// detectCacheEntry is a cached Detect result for one adapter and project.
+type detectCacheEntry struct {
+	Detected  bool             `json:"detected"`
+	CheckedAt time.Time        `json:"checkedAt"`
+	Mtimes    map[string]int64 `json:"mtimes,omitempty"` // path -> mtime (UnixNano, 0 = missing)
+}
+
+// detectCache persists detection results keyed by project root, then adapter ID.
+type detectCache struct {
+	mu       sync.Mutex
+	path     string
+	projects map[string]map[string]detectCacheEntry
+	bypass   bool // --redetect: ignore cached entries but still record fresh ones
+	dirty    bool
+	now      func() time.Time
+}
+
+var globalDetectCache = &detectCache{
+	projects: make(map[string]map[string]detectCacheEntry),
+	now:      time.Now,
+}
+
+// InitDetectCache loads the detection cache from dir. When redetect is true,
+// existing entries are ignored and overwritten by fresh Detect calls.
+// Errors are non-fatal: a missing or corrupt cache just means cold detection.
+func InitDetectCache(dir string, redetect bool) {
+	c := globalDetectCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.path = filepath.Join(dir, detectCacheFile)
+	c.bypass = redetect
+	c.dirty = false
+	c.projects = make(map[string]map[string]detectCacheEntry)
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &c.projects); err != nil || c.projects == nil {
+		c.projects = make(map[string]map[string]detectCacheEntry)
+	}
+}
+
+// SaveDetectCache writes the detection cache to disk if it changed.
+func SaveDetectCache() error {
+	c := globalDetectCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.path == "" || !c.dirty {
+		return nil
+	}
+	c.pruneLocked()
+	data, err := json.Marshal(c.projects)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.path, data, 0644); err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
+}
+
+// DetectCached returns a's Detect result for projectRoot, serving it from
+// the cache when the entry is within DetectCacheTTL and none of the
+// adapter's detect paths have changed. Errors are never cached. Before
+// InitDetectCache is called this is equivalent to a.Detect.
+func DetectCached(a Adapter, projectRoot string) (bool, error) {
+	c := globalDetectCache
+	c.mu.Lock()
+	enabled := c.path != ""
+	c.mu.Unlock()
+	if !enabled {
+		return a.Detect(projectRoot)
+	}
+
+	var paths []string
+	if dp, ok := a.(DetectPathProvider); ok {
+		paths = dp.DetectPaths(projectRoot)
+	}
+
+	c.mu.Lock()
+	if !c.bypass {
+		if entry, ok := c.projects[projectRoot][a.ID()]; ok && c.validLocked(entry, paths) {
+			c.mu.Unlock()
+			return entry.Detected, nil
+		}
+	}
+	c.mu.Unlock()
+
+	detected, err := a.Detect(projectRoot)
+	if err != nil {
+		return detected, err
+	}
+
+	entry := detectCacheEntry{
+		Detected:  detected,
+		CheckedAt: c.now(),
+		Mtimes:    make(map[string]int64, len(paths)),
+	}
+	for _, p := range paths {
+		entry.Mtimes[p] = pathMtime(p)
+	}
+
+	c.mu.Lock()
+	if c.projects[projectRoot] == nil {
+		c.projects[projectRoot] = make(map[string]detectCacheEntry)
+	}
+	c.projects[projectRoot][a.ID()] = entry
+	c.dirty = true
+	c.mu.Unlock()
+	return detected, nil
+}
+
+// validLocked reports whether entry is fresh and its paths are unchanged.
+func (c *detectCache) validLocked(entry detectCacheEntry, paths []string) bool {
+	if c.now().Sub(entry.CheckedAt) > DetectCacheTTL {
+		return false
+	}
+	if len(paths) != len(entry.Mtimes) {
+		return false
+	}
+	for _, p := range paths {
+		mt, ok := entry.Mtimes[p]
+		if !ok || mt != pathMtime(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// pruneLocked drops expired entries so the cache file doesn't grow forever.
+func (c *detectCache) pruneLocked() {
+	now := c.now()
+	for root, entries := range c.projects {
+		for id, e := range entries {
+			if now.Sub(e.CheckedAt) > DetectCacheTTL {
+				delete(entries, id)
+			}
+		}
+		if len(entries) == 0 {
+			delete(c.projects, root)
+		}
+	}
+}
+
+// pathMtime returns the mtime of path in UnixNano, or 0 if it can't be stat'd.
+func pathMtime(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.ModTime().UnixNano()
+}
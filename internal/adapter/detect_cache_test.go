@@ -0,0 +1,162 @@
+package adapter
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// detectStub is a minimal Adapter that counts Detect calls.
+type detectStub struct {
+	id       string
+	detected bool
+	calls    int
+	paths    []string
+}
+
+func (s *detectStub) ID() string   { return s.id }
+func (s *detectStub) Name() string { return s.id }
+func (s *detectStub) Icon() string { return "" }
+func (s *detectStub) Detect(string) (bool, error) {
+	s.calls++
+	return s.detected, nil
+}
+func (s *detectStub) Capabilities() CapabilitySet                   { return CapabilitySet{} }
+func (s *detectStub) Sessions(string) ([]Session, error)            { return nil, nil }
+func (s *detectStub) Messages(string) ([]Message, error)            { return nil, nil }
+func (s *detectStub) Usage(string) (*UsageStats, error)             { return nil, nil }
+func (s *detectStub) Watch(string) (<-chan Event, io.Closer, error) { return nil, nil, nil }
+func (s *detectStub) DetectPaths(string) []string                   { return s.paths }
+
+var _ DetectPathProvider = (*detectStub)(nil)
+
+// withDetectCache initializes the global cache in a temp dir and restores it after the test.
+func withDetectCache(t *testing.T, redetect bool) (string, *time.Time) {
+	t.Helper()
+	dir := t.TempDir()
+	clock := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNow := globalDetectCache.now
+	globalDetectCache.now = func() time.Time { return clock }
+	InitDetectCache(dir, redetect)
+	t.Cleanup(func() {
+		globalDetectCache.mu.Lock()
+		globalDetectCache.path = ""
+		globalDetectCache.projects = make(map[string]map[string]detectCacheEntry)
+		globalDetectCache.bypass = false
+		globalDetectCache.dirty = false
+		globalDetectCache.now = origNow
+		globalDetectCache.mu.Unlock()
+	})
+	return dir, &clock
+}
+
+func TestDetectCached_HitSkipsDetect(t *testing.T) {
+	withDetectCache(t, false)
+	a := &detectStub{id: "stub", detected: true}
+
+	for i := 0; i < 3; i++ {
+		got, err := DetectCached(a, "/proj")
+		if err != nil || !got {
+			t.Fatalf("DetectCached = %v, %v; want true, nil", got, err)
+		}
+	}
+	if a.calls != 1 {
+		t.Errorf("Detect called %d times, want 1", a.calls)
+	}
+}
+
+func TestDetectCached_UninitializedPassesThrough(t *testing.T) {
+	a := &detectStub{id: "stub", detected: true}
+	_, _ = DetectCached(a, "/proj")
+	_, _ = DetectCached(a, "/proj")
+	if a.calls != 2 {
+		t.Errorf("Detect called %d times, want 2", a.calls)
+	}
+}
+
+func TestDetectCached_TTLExpiry(t *testing.T) {
+	_, clock := withDetectCache(t, false)
+	a := &detectStub{id: "stub"}
+
+	_, _ = DetectCached(a, "/proj")
+	*clock = clock.Add(DetectCacheTTL + time.Second)
+	_, _ = DetectCached(a, "/proj")
+	if a.calls != 2 {
+		t.Errorf("Detect called %d times after TTL, want 2", a.calls)
+	}
+}
+
+func TestDetectCached_MtimeInvalidates(t *testing.T) {
+	dir, _ := withDetectCache(t, false)
+	watched := filepath.Join(dir, "sessions")
+	a := &detectStub{id: "stub", paths: []string{watched}}
+
+	got, _ := DetectCached(a, "/proj")
+	if got {
+		t.Fatal("expected not detected before directory exists")
+	}
+
+	// Creating the directory changes its mtime from "missing" to a real value.
+	if err := os.Mkdir(watched, 0755); err != nil {
+		t.Fatal(err)
+	}
+	a.detected = true
+	got, _ = DetectCached(a, "/proj")
+	if !got || a.calls != 2 {
+		t.Errorf("got detected=%v calls=%d, want true and 2", got, a.calls)
+	}
+
+	// Unchanged directory is served from cache.
+	_, _ = DetectCached(a, "/proj")
+	if a.calls != 2 {
+		t.Errorf("Detect called %d times, want 2", a.calls)
+	}
+}
+
+func TestDetectCached_Redetect(t *testing.T) {
+	dir, _ := withDetectCache(t, false)
+	a := &detectStub{id: "stub", detected: true}
+	_, _ = DetectCached(a, "/proj")
+	if err := SaveDetectCache(); err != nil {
+		t.Fatalf("SaveDetectCache: %v", err)
+	}
+
+	InitDetectCache(dir, true)
+	_, _ = DetectCached(a, "/proj")
+	if a.calls != 2 {
+		t.Errorf("Detect called %d times with redetect, want 2", a.calls)
+	}
+}
+
+func TestDetectCache_SaveLoadRoundTrip(t *testing.T) {
+	dir, _ := withDetectCache(t, false)
+	a := &detectStub{id: "stub", detected: true}
+	_, _ = DetectCached(a, "/proj")
+	if err := SaveDetectCache(); err != nil {
+		t.Fatalf("SaveDetectCache: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, detectCacheFile)); err != nil {
+		t.Fatalf("cache file not written: %v", err)
+	}
+
+	InitDetectCache(dir, false)
+	got, _ := DetectCached(a, "/proj")
+	if !got || a.calls != 1 {
+		t.Errorf("got detected=%v calls=%d after reload, want true and 1", got, a.calls)
+	}
+}
+
+func TestDetectCache_CorruptFileIgnored(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, detectCacheFile), []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	withDetectCache(t, false)
+	InitDetectCache(dir, false)
+	a := &detectStub{id: "stub", detected: true}
+	if got, err := DetectCached(a, "/proj"); err != nil || !got {
+		t.Errorf("DetectCached = %v, %v; want true, nil", got, err)
+	}
+}
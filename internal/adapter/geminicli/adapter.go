@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 const (
@@ -77,6 +78,12 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the Gemini CLI chats directory whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.chatsDir(projectRoot)}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -112,7 +119,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		path := filepath.Join(chatsDir, e.Name())
 		seenPaths[path] = struct{}{}
 
-		info, err := e.Info()
+		info, err := ingest.Check(adapterID, a.tmpDir, path)
 		if err != nil {
 			continue
 		}
@@ -317,6 +324,9 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 				continue
 			}
 			path := filepath.Join(chatsDir, f.Name())
+			if _, err := ingest.Check(adapterID, a.tmpDir, path); err != nil {
+				continue
+			}
 			session, err := a.parseSessionFile(path)
 			if err != nil {
 				continue
@@ -411,7 +421,7 @@ func (a *Adapter) enforceSessionMetaCacheLimitLocked() {
 
 // parseSessionFile reads and parses a session JSON file.
 func (a *Adapter) parseSessionFile(path string) (*Session, error) {
-	data, err := os.ReadFile(path)
+	data, err := ingest.ReadJSON(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,6 @@ package geminicli
 
 import (
 	"io"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 // sessionIDPattern extracts sessionId field from partial JSON
@@ -121,7 +121,7 @@ func NewWatcher(chatsDir string) (<-chan adapter.Event, io.Closer, error) {
 // First attempts with a 2048-byte buffer, falling back to full file read
 // if the buffer was full but no match was found (td-8d9c18c2).
 func extractSessionID(path string) string {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return ""
 	}
@@ -144,7 +144,7 @@ func extractSessionID(path string) string {
 	if n == bufSize {
 		// Read entire file as fallback
 		_, _ = file.Seek(0, 0)
-		data, err := os.ReadFile(path)
+		data, err := ingest.ReadFile(adapterID, path)
 		if err != nil {
 			return ""
 		}
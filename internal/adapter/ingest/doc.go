@@ -0,0 +1,6 @@
+// Package ingest guards adapter file reads against hostile session data.
+// Every session file is checked against its adapter's root directory
+// (after resolving symlinks), a size limit, a directory depth limit, and
+// for whole-file JSON documents a nesting depth limit. Rejected files are
+// recorded as violations for diagnostics instead of being parsed.
+package ingest
@@ -0,0 +1,229 @@
+package ingest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limits bounds what an adapter will read.
+type Limits struct {
+	MaxFileSize  int64 // Largest session file read, in bytes
+	MaxDirDepth  int   // Deepest path below the adapter root
+	MaxJSONDepth int   // Deepest object/array nesting in JSON documents
+}
+
+// DefaultLimits are generous for real agent sessions (large Claude Code
+// transcripts run to tens of megabytes) while refusing pathological input.
+var DefaultLimits = Limits{
+	MaxFileSize:  512 << 20,
+	MaxDirDepth:  16,
+	MaxJSONDepth: 128,
+}
+
+// maxViolations caps the number of recorded violations.
+const maxViolations = 100
+
+// ErrRejected is wrapped by every error returned for a guarded path.
+var ErrRejected = errors.New("ingest: rejected")
+
+// Violation records a file an adapter refused to parse.
+type Violation struct {
+	Adapter string
+	Path    string
+	Reason  string
+	At      time.Time
+}
+
+var (
+	mu         sync.Mutex
+	violations []Violation
+	seen       = make(map[string]bool) // adapter+path+reason already recorded
+)
+
+// Violations returns recorded violations, oldest first.
+func Violations() []Violation {
+	mu.Lock()
+	defer mu.Unlock()
+	out := make([]Violation, len(violations))
+	copy(out, violations)
+	return out
+}
+
+// Reset clears recorded violations.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	violations = nil
+	seen = make(map[string]bool)
+}
+
+// reject records a violation once per adapter, path, and reason, and
+// returns an error wrapping ErrRejected.
+func reject(adapterID, path, reason string) error {
+	key := adapterID + "\x00" + path + "\x00" + reason
+	mu.Lock()
+	if !seen[key] {
+		seen[key] = true
+		violations = append(violations, Violation{Adapter: adapterID, Path: path, Reason: reason, At: time.Now()})
+		if len(violations) > maxViolations {
+			violations = violations[len(violations)-maxViolations:]
+		}
+	}
+	mu.Unlock()
+	return fmt.Errorf("%w: %s: %s", ErrRejected, path, reason)
+}
+
+// Check validates that path is a regular file inside root, after resolving
+// symlinks on both, and within DefaultLimits. Adapters call it wherever a
+// session path is derived from their data directory, before parsing.
+// Missing files are returned as the underlying os error without recording
+// a violation.
+func Check(adapterID, root, path string) (os.FileInfo, error) {
+	info, err := check(adapterID, root, path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > DefaultLimits.MaxFileSize {
+		return nil, reject(adapterID, path, fmt.Sprintf("file is %d bytes (limit %d)", info.Size(), DefaultLimits.MaxFileSize))
+	}
+	return info, nil
+}
+
+// CheckDB is Check for SQLite databases, which are queried rather than
+// read whole, so no size limit applies.
+func CheckDB(adapterID, root, path string) error {
+	_, err := check(adapterID, root, path)
+	return err
+}
+
+// check applies the allowlist and regular-file checks shared by Check and
+// CheckDB.
+func check(adapterID, root, path string) (os.FileInfo, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+	if reason := outside(root, resolved); reason != "" {
+		return nil, reject(adapterID, path, reason)
+	}
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, reject(adapterID, path, "not a regular file")
+	}
+	return info, nil
+}
+
+// outside returns why resolved is not allowed under root, or "" if it is.
+func outside(root, resolved string) string {
+	if root == "" {
+		return "adapter has no root directory"
+	}
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		resolvedRoot = filepath.Clean(root)
+	}
+	rel, err := filepath.Rel(resolvedRoot, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+		return "resolves outside " + root
+	}
+	if rel != "." && strings.Count(rel, string(filepath.Separator))+1 > DefaultLimits.MaxDirDepth {
+		return fmt.Sprintf("nested deeper than %d directories", DefaultLimits.MaxDirDepth)
+	}
+	return ""
+}
+
+// Open opens path for reading, rejecting anything other than a regular
+// file within MaxFileSize. Unlike Check it does not apply the allowlist,
+// so callers pass paths that already passed Check.
+func Open(adapterID, path string) (*os.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		_ = f.Close()
+		return nil, reject(adapterID, path, "not a regular file")
+	}
+	if info.Size() > DefaultLimits.MaxFileSize {
+		_ = f.Close()
+		return nil, reject(adapterID, path, fmt.Sprintf("file is %d bytes (limit %d)", info.Size(), DefaultLimits.MaxFileSize))
+	}
+	return f, nil
+}
+
+// ReadFile reads path like os.ReadFile, never returning more than
+// MaxFileSize bytes even if the file grows while being read.
+func ReadFile(adapterID, path string) ([]byte, error) {
+	f, err := Open(adapterID, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	data, err := io.ReadAll(io.LimitReader(f, DefaultLimits.MaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > DefaultLimits.MaxFileSize {
+		return nil, reject(adapterID, path, fmt.Sprintf("file grew past %d bytes while reading", DefaultLimits.MaxFileSize))
+	}
+	return data, nil
+}
+
+// ReadJSON reads a whole-file JSON document like ReadFile and also rejects
+// documents nested deeper than MaxJSONDepth.
+func ReadJSON(adapterID, path string) ([]byte, error) {
+	data, err := ReadFile(adapterID, path)
+	if err != nil {
+		return nil, err
+	}
+	if depth := jsonDepth(data); depth > DefaultLimits.MaxJSONDepth {
+		return nil, reject(adapterID, path, fmt.Sprintf("JSON nested %d levels (limit %d)", depth, DefaultLimits.MaxJSONDepth))
+	}
+	return data, nil
+}
+
+// jsonDepth returns the maximum object/array nesting in data, ignoring
+// brackets inside strings. It does not validate the JSON.
+func jsonDepth(data []byte) int {
+	depth, maxDepth := 0, 0
+	inString, escaped := false, false
+	for _, c := range data {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+	return maxDepth
+}
@@ -0,0 +1,96 @@
+package ingest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheck_Allowlist(t *testing.T) {
+	Reset()
+	root := t.TempDir()
+	outsideDir := t.TempDir()
+
+	inside := filepath.Join(root, "session.jsonl")
+	secret := filepath.Join(outsideDir, "secret")
+	for _, p := range []string{inside, secret} {
+		if err := os.WriteFile(p, []byte("{}\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := filepath.Join(root, "escape.jsonl")
+	if err := os.Symlink(secret, link); err != nil {
+		t.Skip("symlinks unsupported:", err)
+	}
+
+	if _, err := Check("test", root, inside); err != nil {
+		t.Errorf("inside root: %v", err)
+	}
+	if _, err := Check("test", root, link); !errors.Is(err, ErrRejected) {
+		t.Errorf("symlink escaping root: err = %v, want ErrRejected", err)
+	}
+	if _, err := Check("test", root, secret); !errors.Is(err, ErrRejected) {
+		t.Errorf("path outside root: err = %v, want ErrRejected", err)
+	}
+	if _, err := Check("test", root, root); !errors.Is(err, ErrRejected) {
+		t.Errorf("directory: err = %v, want ErrRejected", err)
+	}
+	if _, err := Check("test", root, filepath.Join(root, "missing.jsonl")); !os.IsNotExist(err) || errors.Is(err, ErrRejected) {
+		t.Errorf("missing file: err = %v, want not-exist without violation", err)
+	}
+
+	// Repeated checks of the same file record one violation
+	_, _ = Check("test", root, link)
+	v := Violations()
+	if len(v) != 3 || v[0].Path != link || v[0].Adapter != "test" {
+		t.Errorf("violations = %+v", v)
+	}
+}
+
+func TestCheck_DirDepth(t *testing.T) {
+	Reset()
+	root := t.TempDir()
+	deep := filepath.Join(append([]string{root}, strings.Split(strings.Repeat("d/", DefaultLimits.MaxDirDepth), "/")...)...)
+	if err := os.MkdirAll(deep, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(deep, "session.jsonl")
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Check("test", root, path); !errors.Is(err, ErrRejected) {
+		t.Errorf("err = %v, want ErrRejected", err)
+	}
+}
+
+func TestReadJSON_Limits(t *testing.T) {
+	Reset()
+	saved := DefaultLimits
+	defer func() { DefaultLimits = saved }()
+	DefaultLimits.MaxFileSize = 64
+	DefaultLimits.MaxJSONDepth = 3
+
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	if _, err := ReadJSON("test", write("ok.json", `{"a":[{"b":"[[[[["}]}`)); err != nil {
+		t.Errorf("brackets in strings should not count: %v", err)
+	}
+	if _, err := ReadJSON("test", write("deep.json", `[[[[1]]]]`)); !errors.Is(err, ErrRejected) {
+		t.Errorf("deep: err = %v, want ErrRejected", err)
+	}
+	if _, err := ReadFile("test", write("big.json", strings.Repeat("x", 65))); !errors.Is(err, ErrRejected) {
+		t.Errorf("big: err = %v, want ErrRejected", err)
+	}
+	if n := len(Violations()); n != 2 {
+		t.Errorf("violations = %d, want 2", n)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -102,6 +103,12 @@ func (a *Adapter) Name() string { return adapterName }
 // Icon returns the adapter icon for badge display.
 func (a *Adapter) Icon() string { return "\u03ba" } // Greek kappa
 
+// DetectPaths returns the Kiro database file whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.dbPath}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -368,6 +375,9 @@ func (a *Adapter) getDB() (*sql.DB, error) {
 		a.db = nil
 	}
 
+	if err := ingest.CheckDB(adapterID, filepath.Dir(a.dbPath), a.dbPath); err != nil {
+		return nil, err
+	}
 	connStr := a.dbPath + "?mode=ro&_journal_mode=WAL"
 	db, err := sql.Open("sqlite3", connStr)
 	if err != nil {
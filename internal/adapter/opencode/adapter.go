@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 const (
@@ -135,6 +136,15 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the OpenCode project and session directories whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{
+		filepath.Join(a.storageDir, "project"),
+		filepath.Join(a.storageDir, "session"),
+	}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -176,7 +186,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		path := filepath.Join(sessionDir, e.Name())
 		seenPaths[path] = struct{}{}
 
-		info, err := e.Info()
+		info, err := ingest.Check(adapterID, a.storageDir, path)
 		if err != nil {
 			continue
 		}
@@ -384,7 +394,7 @@ func (a *Adapter) loadProjects() error {
 		}
 
 		path := filepath.Join(projectDir, e.Name())
-		data, err := os.ReadFile(path)
+		data, err := a.readStorageJSON(path)
 		if err != nil {
 			continue
 		}
@@ -522,12 +532,21 @@ func (a *Adapter) enforceSessionMetaCacheLimitLocked() {
 	}
 }
 
+// readStorageJSON reads a project, message, or part file after checking
+// that it resolves inside the storage directory.
+func (a *Adapter) readStorageJSON(path string) ([]byte, error) {
+	if _, err := ingest.Check(adapterID, a.storageDir, path); err != nil {
+		return nil, err
+	}
+	return ingest.ReadJSON(adapterID, path)
+}
+
 // parseSessionFile parses a session JSON file and returns metadata.
 // For performance, this only reads the session file and counts message files
 // without reading their contents. Token counts and costs are populated
 // when Messages() is called.
 func (a *Adapter) parseSessionFile(path, projectID string) (*SessionMetadata, error) {
-	data, err := os.ReadFile(path)
+	data, err := ingest.ReadJSON(adapterID, path)
 	if err != nil {
 		return nil, err
 	}
@@ -600,7 +619,7 @@ func (a *Adapter) batchReadMessages(messageDir string) (map[string]*Message, err
 		}
 
 		path := filepath.Join(messageDir, e.Name())
-		data, err := os.ReadFile(path)
+		data, err := a.readStorageJSON(path)
 		if err != nil {
 			continue
 		}
@@ -645,7 +664,7 @@ func (a *Adapter) batchLoadAllParts(messageIDs []string) map[string]parsedParts
 			}
 
 			path := filepath.Join(partDir, e.Name())
-			data, err := os.ReadFile(path)
+			data, err := a.readStorageJSON(path)
 			if err != nil {
 				continue
 			}
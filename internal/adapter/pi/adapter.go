@@ -1,13 +1,13 @@
 package pi
 
 import (
-	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
-	"maps"
 	"sort"
 	"strings"
 	"sync"
@@ -15,15 +15,15 @@ import (
 
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 )
 
 const (
-	adapterID           = "pi"
-	adapterName         = "Pi"
-	adapterIcon         = "\U0001F43E" // 🐾
-	metaCacheMaxEntries = 2048
-	msgCacheMaxEntries  = 128
-	dirCacheTTL         = 500 * time.Millisecond
+	adapterID          = "pi"
+	adapterName        = "Pi"
+	adapterIcon        = "\U0001F43E" // 🐾
+	msgCacheMaxEntries = 128
+	dirCacheTTL        = 500 * time.Millisecond
 )
 
 // cwdCacheEntry caches the CWD from a session file's first line.
@@ -45,13 +45,10 @@ type sessionFileEntry struct {
 	info os.FileInfo
 }
 
-// sessionMetaCacheEntry caches parsed session metadata with file stats.
-type sessionMetaCacheEntry struct {
+// sessionMeta is the cached metadata state for one session file, with the
+// per-model tracking needed to resume parsing when the file grows.
+type sessionMeta struct {
 	meta        *SessionMetadata
-	modTime     time.Time
-	size        int64
-	lastAccess  time.Time
-	byteOffset  int64                      // position after last parsed line (for incremental)
 	modelCounts map[string]int             // per-model message counts
 	modelTokens map[string]modelTokenEntry // per-model token accumulation
 }
@@ -79,27 +76,23 @@ type toolUseRef struct {
 
 // Adapter implements the adapter.Adapter interface for Pi sessions.
 type Adapter struct {
-	sessionsDir  string
-	sessionIndex map[string]string // sessionID -> file path
-	cwdCache     map[string]cwdCacheEntry
-	metaCache    map[string]sessionMetaCacheEntry
-	msgCache     *cache.Cache[messageCacheEntry]
-	dirCache     *dirCacheEntry
-	mu           sync.RWMutex // guards sessionIndex
-	cwdMu        sync.RWMutex // guards cwdCache
-	metaMu       sync.RWMutex // guards metaCache
-	dirCacheMu   sync.RWMutex // guards dirCache
+	sessionsDir string
+	index       cache.SessionIndex[sessionMeta] // session metadata and sessionID -> file path
+	cwdCache    map[string]cwdCacheEntry
+	msgCache    *cache.Cache[messageCacheEntry]
+	dirCache    *dirCacheEntry
+	cwdMu       sync.RWMutex // guards cwdCache
+	dirCacheMu  sync.RWMutex // guards dirCache
 }
 
 // New creates a new Pi adapter.
 func New() *Adapter {
 	home, _ := os.UserHomeDir()
 	return &Adapter{
-		sessionsDir:  filepath.Join(home, ".openclaw", "agents", "main", "sessions"),
-		sessionIndex: make(map[string]string),
-		cwdCache:     make(map[string]cwdCacheEntry),
-		metaCache:    make(map[string]sessionMetaCacheEntry),
-		msgCache:     cache.New[messageCacheEntry](msgCacheMaxEntries),
+		sessionsDir: filepath.Join(home, ".openclaw", "agents", "main", "sessions"),
+		cwdCache:    make(map[string]cwdCacheEntry),
+		index:       cache.SessionIndex[sessionMeta]{Name: adapterID + ".meta"},
+		msgCache:    cache.New[messageCacheEntry](msgCacheMaxEntries).Named(adapterID + ".messages"),
 	}
 }
 
@@ -134,6 +127,12 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the Pi sessions directory whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.sessionsDir}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -218,9 +217,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 	}
 
 	// Atomically swap in the new index
-	a.mu.Lock()
-	a.sessionIndex = newIndex
-	a.mu.Unlock()
+	a.index.ReplacePaths(newIndex)
 
 	sort.Slice(sessions, func(i, j int) bool {
 		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
@@ -239,7 +236,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 		return nil, nil
 	}
 
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.sessionsDir, path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -249,16 +246,22 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 
 	if a.msgCache != nil {
 		cached, offset, cachedSize, cachedModTime, ok := a.msgCache.GetWithOffset(path)
-		if ok {
+		// Tool outputs evicted from memory are recovered by a full parse
+		if ok && adapter.ToolOutputsAvailable(cached.messages) {
 			if info.Size() == cachedSize && info.ModTime().Equal(cachedModTime) {
+				a.msgCache.Metrics().Hit()
 				return copyMessages(cached.messages), nil
 			}
 			if info.Size() > cachedSize && offset > 0 {
 				messages, entry, err := a.parseMessagesIncremental(path, cached, offset, info)
 				if err == nil {
+					a.msgCache.Metrics().Incremental()
 					a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 					return messages, nil
 				}
+				if errors.Is(err, cache.ErrBadCheckpoint) {
+					a.msgCache.Metrics().Recovered()
+				}
 				// Fall through to full parse on error
 			}
 		}
@@ -270,6 +273,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	}
 
 	if a.msgCache != nil {
+		a.msgCache.Metrics().Full()
 		a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 	}
 	return messages, nil
@@ -329,16 +333,14 @@ func (a *Adapter) sessionCWD(path string, info os.FileInfo) (string, error) {
 
 // readSessionCWD reads the first line of a JSONL session file to extract CWD.
 func readSessionCWD(path string) (string, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return "", err
 	}
 	defer func() { _ = file.Close() }()
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	if !scanner.Scan() {
 		if err := scanner.Err(); err != nil {
@@ -362,6 +364,14 @@ func readSessionCWD(path string) (string, error) {
 
 // --- Directory listing cache ---
 
+// WorktreesChanged drops the cached sessions directory listing so sessions
+// started in a new worktree are matched on the next scan.
+func (a *Adapter) WorktreesChanged(worktreePath string, removed bool) {
+	a.dirCacheMu.Lock()
+	a.dirCache = nil
+	a.dirCacheMu.Unlock()
+}
+
 func (a *Adapter) sessionFiles() ([]sessionFileEntry, error) {
 	a.dirCacheMu.RLock()
 	if a.dirCache != nil && time.Now().Before(a.dirCache.expiresAt) {
@@ -388,12 +398,13 @@ func (a *Adapter) sessionFiles() ([]sessionFileEntry, error) {
 		if e.IsDir() || !strings.HasSuffix(e.Name(), ".jsonl") {
 			continue
 		}
-		info, err := e.Info()
+		path := filepath.Join(a.sessionsDir, e.Name())
+		info, err := ingest.Check(adapterID, a.sessionsDir, path)
 		if err != nil {
 			continue
 		}
 		files = append(files, sessionFileEntry{
-			path: filepath.Join(a.sessionsDir, e.Name()),
+			path: path,
 			info: info,
 		})
 	}
@@ -413,67 +424,26 @@ func (a *Adapter) sessionFiles() ([]sessionFileEntry, error) {
 // sessionMetadata returns cached metadata if valid, otherwise parses the file.
 // Supports incremental parsing when a file grows.
 func (a *Adapter) sessionMetadata(path string, info os.FileInfo) (*SessionMetadata, error) {
-	now := time.Now()
-
-	a.metaMu.Lock()
-	entry, cached := a.metaCache[path]
-	if cached {
-		if entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
-			entry.lastAccess = now
-			a.metaCache[path] = entry
-			metaCopy := *entry.meta
-			a.metaMu.Unlock()
-			return &metaCopy, nil
-		}
-		if info.Size() > entry.size && entry.byteOffset > 0 {
-			a.metaMu.Unlock()
-			meta, newOffset, mc, mt, err := a.parseSessionMetadataIncremental(path, entry.meta, entry.byteOffset, entry.modelCounts, entry.modelTokens)
-			if err == nil {
-				a.metaMu.Lock()
-				a.metaCache[path] = sessionMetaCacheEntry{
-					meta:        meta,
-					modTime:     info.ModTime(),
-					size:        info.Size(),
-					lastAccess:  now,
-					byteOffset:  newOffset,
-					modelCounts: mc,
-					modelTokens: mt,
-				}
-				a.enforceSessionMetaCacheLimitLocked()
-				a.metaMu.Unlock()
-				return meta, nil
-			}
-			// Fall through to full parse on error
-		} else {
-			a.metaMu.Unlock()
-		}
-	} else {
-		a.metaMu.Unlock()
-	}
-
-	meta, offset, mc, mt, err := a.parseSessionMetadataFull(path)
+	state, err := a.index.Get(path, info, cache.Parser[sessionMeta]{
+		Full: func(path string, _ os.FileInfo) (sessionMeta, int64, error) {
+			meta, offset, mc, mt, err := a.parseSessionMetadataFull(path)
+			return sessionMeta{meta: meta, modelCounts: mc, modelTokens: mt}, offset, err
+		},
+		Incremental: func(path string, _ os.FileInfo, prev sessionMeta, offset int64) (sessionMeta, int64, error) {
+			meta, offset, mc, mt, err := a.parseSessionMetadataIncremental(path, prev.meta, offset, prev.modelCounts, prev.modelTokens)
+			return sessionMeta{meta: meta, modelCounts: mc, modelTokens: mt}, offset, err
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	a.metaMu.Lock()
-	a.metaCache[path] = sessionMetaCacheEntry{
-		meta:        meta,
-		modTime:     info.ModTime(),
-		size:        info.Size(),
-		lastAccess:  now,
-		byteOffset:  offset,
-		modelCounts: mc,
-		modelTokens: mt,
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-	return meta, nil
+	metaCopy := *state.meta
+	return &metaCopy, nil
 }
 
 // parseSessionMetadataFull scans the entire session file for metadata.
 func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64, map[string]int, map[string]modelTokenEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, 0, nil, nil, err
 	}
@@ -484,10 +454,8 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64
 		SessionID: strings.TrimSuffix(filepath.Base(path), ".jsonl"),
 	}
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	modelCounts := make(map[string]int)
 	modelTokens := make(map[string]modelTokenEntry)
@@ -496,7 +464,7 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = scanner.Offset()
 
 		a.processMetadataLine(line, meta, modelCounts, modelTokens, &currentModel)
 	}
@@ -513,7 +481,7 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*SessionMetadata, int64
 
 // parseSessionMetadataIncremental resumes parsing from a byte offset.
 func (a *Adapter) parseSessionMetadataIncremental(path string, base *SessionMetadata, offset int64, baseModelCounts map[string]int, baseModelTokens map[string]modelTokenEntry) (*SessionMetadata, int64, map[string]int, map[string]modelTokenEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, 0, nil, nil, err
 	}
@@ -545,10 +513,8 @@ func (a *Adapter) parseSessionMetadataIncremental(path string, base *SessionMeta
 	modelTokens := make(map[string]modelTokenEntry, len(baseModelTokens))
 	maps.Copy(modelTokens, baseModelTokens)
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	bytesRead := offset
 	var currentModel string
@@ -557,7 +523,7 @@ func (a *Adapter) parseSessionMetadataIncremental(path string, base *SessionMeta
 	}
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = offset + scanner.Offset()
 
 		a.processMetadataLine(line, meta, modelCounts, modelTokens, &currentModel)
 	}
@@ -657,62 +623,22 @@ func (a *Adapter) finalizeMetadata(meta *SessionMetadata, modelCounts map[string
 }
 
 func (a *Adapter) pruneSessionMetaCache(seenPaths map[string]struct{}) {
-	a.metaMu.Lock()
-	for path := range a.metaCache {
-		if _, ok := seenPaths[path]; !ok {
-			delete(a.metaCache, path)
-		}
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-}
-
-func (a *Adapter) enforceSessionMetaCacheLimitLocked() {
-	excess := len(a.metaCache) - metaCacheMaxEntries
-	if excess <= 0 {
-		return
-	}
-
-	type pathAccess struct {
-		path       string
-		lastAccess time.Time
-	}
-	entries := make([]pathAccess, 0, len(a.metaCache))
-	for path, entry := range a.metaCache {
-		entries = append(entries, pathAccess{path, entry.lastAccess})
-	}
-
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].lastAccess.Before(entries[j].lastAccess)
+	a.index.Retain(func(path string) bool {
+		_, ok := seenPaths[path]
+		return ok
 	})
-
-	for i := range excess {
-		delete(a.metaCache, entries[i].path)
-	}
 }
 
 func (a *Adapter) invalidateSessionMetaCacheIfChanged(path string, info os.FileInfo) {
-	if info == nil {
-		return
-	}
-	a.metaMu.Lock()
-	if entry, ok := a.metaCache[path]; ok {
-		if entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
-			delete(a.metaCache, path)
-		}
-	}
-	a.metaMu.Unlock()
+	a.index.InvalidateIfChanged(path, info)
 }
 
 // --- Session file path lookup ---
 
 func (a *Adapter) sessionFilePath(sessionID string) string {
-	a.mu.RLock()
-	if path, ok := a.sessionIndex[sessionID]; ok && path != "" {
-		a.mu.RUnlock()
+	if path, ok := a.index.Path(sessionID); ok {
 		return path
 	}
-	a.mu.RUnlock()
 
 	// Fallback: scan session files
 	files, err := a.sessionFiles()
@@ -724,9 +650,7 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 	for _, f := range files {
 		base := strings.TrimSuffix(filepath.Base(f.path), ".jsonl")
 		if base == sessionID {
-			a.mu.Lock()
-			a.sessionIndex[sessionID] = f.path
-			a.mu.Unlock()
+			a.index.SetPath(sessionID, f.path)
 			return f.path
 		}
 	}
@@ -740,9 +664,7 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 			continue
 		}
 		if meta.SessionID == sessionID {
-			a.mu.Lock()
-			a.sessionIndex[sessionID] = f.path
-			a.mu.Unlock()
+			a.index.SetPath(sessionID, f.path)
 			return f.path
 		}
 	}
@@ -754,7 +676,7 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 
 // parseMessagesFull parses all messages from a session file.
 func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Message, messageCacheEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, messageCacheEntry{}, err
 	}
@@ -765,14 +687,12 @@ func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Me
 	pendingRefs := make(map[string]toolUseRef)
 	var bytesRead int64
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = scanner.Offset()
 
 		a.processMessageLine(line, &messages, toolUseRefs, pendingRefs)
 	}
@@ -781,6 +701,7 @@ func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Me
 		return messages, messageCacheEntry{}, err
 	}
 
+	adapter.CompactToolOutputs(messages)
 	entry := messageCacheEntry{
 		messages:     copyMessages(messages),
 		toolUseRefs:  toolUseRefs,
@@ -817,6 +738,7 @@ func (a *Adapter) parseMessagesIncremental(path string, cached messageCacheEntry
 		a.processMessageLine(line, &messages, toolUseRefs, pendingRefs)
 	}
 
+	adapter.CompactToolOutputs(messages)
 	entry := messageCacheEntry{
 		messages:     copyMessages(messages),
 		toolUseRefs:  toolUseRefs,
@@ -1,8 +1,8 @@
 package piagent
 
 import (
-	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"maps"
@@ -10,29 +10,25 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 	"github.com/wilbur182/forge/internal/adapter/pi"
 )
 
 const (
-	adapterID           = "pi-agent"
-	adapterName         = "Pi Agent"
-	adapterIcon         = "π"
-	metaCacheMaxEntries = 2048
-	msgCacheMaxEntries  = 128
+	adapterID          = "pi-agent"
+	adapterName        = "Pi Agent"
+	adapterIcon        = "π"
+	msgCacheMaxEntries = 128
 )
 
-// sessionMetaCacheEntry caches parsed session metadata with file stats.
-type sessionMetaCacheEntry struct {
+// sessionMeta is the cached metadata state for one session file, with the
+// per-model tracking needed to resume parsing when the file grows.
+type sessionMeta struct {
 	meta        *pi.SessionMetadata
-	modTime     time.Time
-	size        int64
-	lastAccess  time.Time
-	byteOffset  int64
 	modelCounts map[string]int
 	modelTokens map[string]modelTokenEntry
 }
@@ -60,22 +56,18 @@ type toolUseRef struct {
 
 // Adapter implements the adapter.Adapter interface for standalone Pi Agent sessions.
 type Adapter struct {
-	sessionsDir  string
-	sessionIndex map[string]string // sessionID -> file path
-	metaCache    map[string]sessionMetaCacheEntry
-	msgCache     *cache.Cache[messageCacheEntry]
-	mu           sync.RWMutex
-	metaMu       sync.RWMutex
+	sessionsDir string
+	index       cache.SessionIndex[sessionMeta] // session metadata and sessionID -> file path
+	msgCache    *cache.Cache[messageCacheEntry]
 }
 
 // New creates a new Pi Agent adapter.
 func New() *Adapter {
 	home, _ := os.UserHomeDir()
 	return &Adapter{
-		sessionsDir:  filepath.Join(home, ".pi", "agent", "sessions"),
-		sessionIndex: make(map[string]string),
-		metaCache:    make(map[string]sessionMetaCacheEntry),
-		msgCache:     cache.New[messageCacheEntry](msgCacheMaxEntries),
+		sessionsDir: filepath.Join(home, ".pi", "agent", "sessions"),
+		index:       cache.SessionIndex[sessionMeta]{Name: adapterID + ".meta"},
+		msgCache:    cache.New[messageCacheEntry](msgCacheMaxEntries).Named(adapterID + ".messages"),
 	}
 }
 
@@ -107,6 +99,12 @@ func (a *Adapter) Detect(projectRoot string) (bool, error) {
 	return false, nil
 }
 
+// DetectPaths returns the Pi Agent project directory whose mtime invalidates cached
+// detection results (implements adapter.DetectPathProvider).
+func (a *Adapter) DetectPaths(projectRoot string) []string {
+	return []string{a.projectDirPath(projectRoot)}
+}
+
 // Capabilities returns the supported features.
 func (a *Adapter) Capabilities() adapter.CapabilitySet {
 	return adapter.CapabilitySet{
@@ -138,7 +136,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		}
 
 		path := filepath.Join(dir, e.Name())
-		info, err := e.Info()
+		info, err := ingest.Check(adapterID, a.sessionsDir, path)
 		if err != nil {
 			continue
 		}
@@ -186,9 +184,7 @@ func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
 		})
 	}
 
-	a.mu.Lock()
-	a.sessionIndex = newIndex
-	a.mu.Unlock()
+	a.index.ReplacePaths(newIndex)
 
 	sort.Slice(sessions, func(i, j int) bool {
 		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
@@ -206,7 +202,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 		return nil, nil
 	}
 
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.sessionsDir, path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -216,16 +212,22 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 
 	if a.msgCache != nil {
 		cached, offset, cachedSize, cachedModTime, ok := a.msgCache.GetWithOffset(path)
-		if ok {
+		// Tool outputs evicted from memory are recovered by a full parse
+		if ok && adapter.ToolOutputsAvailable(cached.messages) {
 			if info.Size() == cachedSize && info.ModTime().Equal(cachedModTime) {
+				a.msgCache.Metrics().Hit()
 				return copyMessages(cached.messages), nil
 			}
 			if info.Size() > cachedSize && offset > 0 {
 				messages, entry, err := a.parseMessagesIncremental(path, cached, offset, info)
 				if err == nil {
+					a.msgCache.Metrics().Incremental()
 					a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 					return messages, nil
 				}
+				if errors.Is(err, cache.ErrBadCheckpoint) {
+					a.msgCache.Metrics().Recovered()
+				}
 			}
 		}
 	}
@@ -236,6 +238,7 @@ func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
 	}
 
 	if a.msgCache != nil {
+		a.msgCache.Metrics().Full()
 		a.msgCache.Set(path, entry, info.Size(), info.ModTime(), entry.byteOffset)
 	}
 	return messages, nil
@@ -281,12 +284,9 @@ func (a *Adapter) projectDirPath(projectRoot string) string {
 
 // sessionFilePath finds the JSONL file for a given session ID.
 func (a *Adapter) sessionFilePath(sessionID string) string {
-	a.mu.RLock()
-	if path, ok := a.sessionIndex[sessionID]; ok {
-		a.mu.RUnlock()
+	if path, ok := a.index.Path(sessionID); ok {
 		return path
 	}
-	a.mu.RUnlock()
 
 	// Fallback: scan all project directories
 	entries, err := os.ReadDir(a.sessionsDir)
@@ -310,9 +310,7 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 			// Check if filename contains the session ID
 			if strings.Contains(f.Name(), sessionID) {
 				path := filepath.Join(projPath, f.Name())
-				a.mu.Lock()
-				a.sessionIndex[sessionID] = path
-				a.mu.Unlock()
+				a.index.SetPath(sessionID, path)
 				return path
 			}
 		}
@@ -323,65 +321,25 @@ func (a *Adapter) sessionFilePath(sessionID string) string {
 // --- Session metadata cache ---
 
 func (a *Adapter) sessionMetadata(path string, info os.FileInfo) (*pi.SessionMetadata, error) {
-	now := time.Now()
-
-	a.metaMu.Lock()
-	entry, cached := a.metaCache[path]
-	if cached {
-		if entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
-			entry.lastAccess = now
-			a.metaCache[path] = entry
-			metaCopy := *entry.meta
-			a.metaMu.Unlock()
-			return &metaCopy, nil
-		}
-		if info.Size() > entry.size && entry.byteOffset > 0 {
-			a.metaMu.Unlock()
-			meta, newOffset, mc, mt, err := a.parseSessionMetadataIncremental(path, entry.meta, entry.byteOffset, entry.modelCounts, entry.modelTokens)
-			if err == nil {
-				a.metaMu.Lock()
-				a.metaCache[path] = sessionMetaCacheEntry{
-					meta:        meta,
-					modTime:     info.ModTime(),
-					size:        info.Size(),
-					lastAccess:  now,
-					byteOffset:  newOffset,
-					modelCounts: mc,
-					modelTokens: mt,
-				}
-				a.enforceSessionMetaCacheLimitLocked()
-				a.metaMu.Unlock()
-				return meta, nil
-			}
-		} else {
-			a.metaMu.Unlock()
-		}
-	} else {
-		a.metaMu.Unlock()
-	}
-
-	meta, offset, mc, mt, err := a.parseSessionMetadataFull(path)
+	state, err := a.index.Get(path, info, cache.Parser[sessionMeta]{
+		Full: func(path string, _ os.FileInfo) (sessionMeta, int64, error) {
+			meta, offset, mc, mt, err := a.parseSessionMetadataFull(path)
+			return sessionMeta{meta: meta, modelCounts: mc, modelTokens: mt}, offset, err
+		},
+		Incremental: func(path string, _ os.FileInfo, prev sessionMeta, offset int64) (sessionMeta, int64, error) {
+			meta, offset, mc, mt, err := a.parseSessionMetadataIncremental(path, prev.meta, offset, prev.modelCounts, prev.modelTokens)
+			return sessionMeta{meta: meta, modelCounts: mc, modelTokens: mt}, offset, err
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	a.metaMu.Lock()
-	a.metaCache[path] = sessionMetaCacheEntry{
-		meta:        meta,
-		modTime:     info.ModTime(),
-		size:        info.Size(),
-		lastAccess:  now,
-		byteOffset:  offset,
-		modelCounts: mc,
-		modelTokens: mt,
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-	return meta, nil
+	metaCopy := *state.meta
+	return &metaCopy, nil
 }
 
 func (a *Adapter) parseSessionMetadataFull(path string) (*pi.SessionMetadata, int64, map[string]int, map[string]modelTokenEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, 0, nil, nil, err
 	}
@@ -392,10 +350,8 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*pi.SessionMetadata, in
 		SessionID: strings.TrimSuffix(filepath.Base(path), ".jsonl"),
 	}
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	modelCounts := make(map[string]int)
 	modelTokens := make(map[string]modelTokenEntry)
@@ -404,7 +360,7 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*pi.SessionMetadata, in
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = scanner.Offset()
 
 		a.processMetadataLine(line, meta, modelCounts, modelTokens, &currentModel)
 	}
@@ -420,7 +376,7 @@ func (a *Adapter) parseSessionMetadataFull(path string) (*pi.SessionMetadata, in
 }
 
 func (a *Adapter) parseSessionMetadataIncremental(path string, base *pi.SessionMetadata, offset int64, baseModelCounts map[string]int, baseModelTokens map[string]modelTokenEntry) (*pi.SessionMetadata, int64, map[string]int, map[string]modelTokenEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, 0, nil, nil, err
 	}
@@ -452,10 +408,8 @@ func (a *Adapter) parseSessionMetadataIncremental(path string, base *pi.SessionM
 	modelTokens := make(map[string]modelTokenEntry, len(baseModelTokens))
 	maps.Copy(modelTokens, baseModelTokens)
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	bytesRead := offset
 	var currentModel string
@@ -464,7 +418,7 @@ func (a *Adapter) parseSessionMetadataIncremental(path string, base *pi.SessionM
 	}
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = offset + scanner.Offset()
 
 		a.processMetadataLine(line, meta, modelCounts, modelTokens, &currentModel)
 	}
@@ -558,63 +512,24 @@ func (a *Adapter) finalizeMetadata(meta *pi.SessionMetadata, modelCounts map[str
 }
 
 func (a *Adapter) pruneSessionMetaCache(dir string, seenPaths map[string]struct{}) {
-	dir = filepath.Clean(dir)
-	dirPrefix := dir + string(os.PathSeparator)
-
-	a.metaMu.Lock()
-	for path := range a.metaCache {
+	dirPrefix := filepath.Clean(dir) + string(os.PathSeparator)
+	a.index.Retain(func(path string) bool {
 		if !strings.HasPrefix(path, dirPrefix) {
-			continue
-		}
-		if _, ok := seenPaths[path]; !ok {
-			delete(a.metaCache, path)
+			return true
 		}
-	}
-	a.enforceSessionMetaCacheLimitLocked()
-	a.metaMu.Unlock()
-}
-
-func (a *Adapter) enforceSessionMetaCacheLimitLocked() {
-	excess := len(a.metaCache) - metaCacheMaxEntries
-	if excess <= 0 {
-		return
-	}
-
-	type pathAccess struct {
-		path       string
-		lastAccess time.Time
-	}
-	entries := make([]pathAccess, 0, len(a.metaCache))
-	for path, entry := range a.metaCache {
-		entries = append(entries, pathAccess{path, entry.lastAccess})
-	}
-
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].lastAccess.Before(entries[j].lastAccess)
+		_, ok := seenPaths[path]
+		return ok
 	})
-
-	for i := range excess {
-		delete(a.metaCache, entries[i].path)
-	}
 }
 
 func (a *Adapter) invalidateSessionMetaCacheIfChanged(path string, info os.FileInfo) {
-	if info == nil {
-		return
-	}
-	a.metaMu.Lock()
-	if entry, ok := a.metaCache[path]; ok {
-		if entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
-			delete(a.metaCache, path)
-		}
-	}
-	a.metaMu.Unlock()
+	a.index.InvalidateIfChanged(path, info)
 }
 
 // --- Message parsing ---
 
 func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Message, messageCacheEntry, error) {
-	file, err := os.Open(path)
+	file, err := ingest.Open(adapterID, path)
 	if err != nil {
 		return nil, messageCacheEntry{}, err
 	}
@@ -625,14 +540,12 @@ func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Me
 	pendingRefs := make(map[string]toolUseRef)
 	var bytesRead int64
 
-	scanner := bufio.NewScanner(file)
-	buf := cache.GetScannerBuffer()
+	scanner, buf := cache.NewScanner(file)
 	defer cache.PutScannerBuffer(buf)
-	scanner.Buffer(buf, 10*1024*1024)
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
-		bytesRead += int64(len(line)) + 1
+		bytesRead = scanner.Offset()
 
 		a.processMessageLine(line, &messages, toolUseRefs, pendingRefs)
 	}
@@ -641,6 +554,7 @@ func (a *Adapter) parseMessagesFull(path string, info os.FileInfo) ([]adapter.Me
 		return messages, messageCacheEntry{}, err
 	}
 
+	adapter.CompactToolOutputs(messages)
 	entry := messageCacheEntry{
 		messages:     copyMessages(messages),
 		toolUseRefs:  toolUseRefs,
@@ -676,6 +590,7 @@ func (a *Adapter) parseMessagesIncremental(path string, cached messageCacheEntry
 		a.processMessageLine(line, &messages, toolUseRefs, pendingRefs)
 	}
 
+	adapter.CompactToolOutputs(messages)
 	entry := messageCacheEntry{
 		messages:     copyMessages(messages),
 		toolUseRefs:  toolUseRefs,
@@ -1041,7 +956,7 @@ func (a *Adapter) SessionByID(sessionID string) (*adapter.Session, error) {
 	if path == "" {
 		return nil, fmt.Errorf("session %s not found", sessionID)
 	}
-	info, err := os.Stat(path)
+	info, err := ingest.Check(adapterID, a.sessionsDir, path)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +75,7 @@ func SearchMessage(msg *Message, msgIdx int, re *regexp.Regexp, maxResults int,
 			}
 		case "tool_result":
 			if cb.ToolOutput != "" {
-				allMatches = append(allMatches, SearchContent(cb.ToolOutput, "tool_result", re)...)
+				allMatches = append(allMatches, SearchContent(cb.FullToolOutput(), "tool_result", re)...)
 			}
 		}
 	}
@@ -89,7 +89,7 @@ func SearchMessage(msg *Message, msgIdx int, re *regexp.Regexp, maxResults int,
 			allMatches = append(allMatches, SearchContent(tu.Input, "tool_use", re)...)
 		}
 		if tu.Output != "" {
-			allMatches = append(allMatches, SearchContent(tu.Output, "tool_result", re)...)
+			allMatches = append(allMatches, SearchContent(tu.FullOutput(), "tool_result", re)...)
 		}
 	}
 
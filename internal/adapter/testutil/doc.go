@@ -1,4 +1,4 @@
 // Package testutil provides fixture generators for adapter testing and
 // benchmarking, producing realistic JSONL session files in Claude Code and
-// Codex formats.
+// Codex formats, and a fake adapter backed by canonical session JSON.
 package testutil
@@ -0,0 +1,167 @@
+package testutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+// FakeAdapter serves sessions decoded from canonical session JSON
+// (adapter.MarshalSession), so tests and fixtures exercise the same format
+// that exports produce.
+type FakeAdapter struct {
+	id string
+
+	mu       sync.RWMutex
+	sessions map[string]adapter.Session
+	messages map[string][]adapter.Message
+	usage    map[string]*adapter.UsageStats
+	watchers []chan adapter.Event
+}
+
+// NewFakeAdapter creates an empty fake adapter with the given ID.
+func NewFakeAdapter(id string) *FakeAdapter {
+	return &FakeAdapter{
+		id:       id,
+		sessions: make(map[string]adapter.Session),
+		messages: make(map[string][]adapter.Message),
+		usage:    make(map[string]*adapter.UsageStats),
+	}
+}
+
+// Load adds or replaces a session from a canonical JSON document and
+// notifies watchers. The session is re-homed to this adapter's ID.
+func (f *FakeAdapter) Load(data []byte) error {
+	s, msgs, usage, err := adapter.UnmarshalSession(data)
+	if err != nil {
+		return err
+	}
+	s.AdapterID = f.id
+	if s.MessageCount == 0 {
+		s.MessageCount = len(msgs)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ev := adapter.Event{Type: adapter.EventSessionCreated, SessionID: s.ID}
+	if _, ok := f.sessions[s.ID]; ok {
+		ev.Type = adapter.EventSessionUpdated
+	}
+	f.sessions[s.ID] = *s
+	f.messages[s.ID] = msgs
+	f.usage[s.ID] = usage
+	// Sent under the lock so Close cannot close a channel mid-send
+	for _, ch := range f.watchers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+	return nil
+}
+
+// LoadDir loads every *.json canonical document in dir.
+func (f *FakeAdapter) LoadDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if err := f.Load(data); err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(p), err)
+		}
+	}
+	return nil
+}
+
+// Export encodes a loaded session back to canonical JSON.
+func (f *FakeAdapter) Export(sessionID string) ([]byte, error) {
+	f.mu.RLock()
+	s, ok := f.sessions[sessionID]
+	msgs, usage := f.messages[sessionID], f.usage[sessionID]
+	f.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("session %q not found", sessionID)
+	}
+	return adapter.MarshalSession(&s, msgs, usage)
+}
+
+func (f *FakeAdapter) ID() string   { return f.id }
+func (f *FakeAdapter) Name() string { return "Fake" }
+func (f *FakeAdapter) Icon() string { return "◇" }
+
+// Detect reports whether any sessions are loaded.
+func (f *FakeAdapter) Detect(string) (bool, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.sessions) > 0, nil
+}
+
+func (f *FakeAdapter) Capabilities() adapter.CapabilitySet {
+	return adapter.CapabilitySet{
+		adapter.CapSessions: true,
+		adapter.CapMessages: true,
+		adapter.CapUsage:    true,
+		adapter.CapWatch:    true,
+	}
+}
+
+// Sessions returns loaded sessions, most recently updated first.
+func (f *FakeAdapter) Sessions(string) ([]adapter.Session, error) {
+	f.mu.RLock()
+	out := make([]adapter.Session, 0, len(f.sessions))
+	for _, s := range f.sessions {
+		out = append(out, s)
+	}
+	f.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].UpdatedAt.After(out[j].UpdatedAt) })
+	return out, nil
+}
+
+func (f *FakeAdapter) Messages(sessionID string) ([]adapter.Message, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return append([]adapter.Message(nil), f.messages[sessionID]...), nil
+}
+
+func (f *FakeAdapter) Usage(sessionID string) (*adapter.UsageStats, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.usage[sessionID], nil
+}
+
+// Watch returns a channel that receives an event for every Load.
+func (f *FakeAdapter) Watch(string) (<-chan adapter.Event, io.Closer, error) {
+	ch := make(chan adapter.Event, 16)
+	f.mu.Lock()
+	f.watchers = append(f.watchers, ch)
+	f.mu.Unlock()
+	return ch, fakeWatchCloser{f: f, ch: ch}, nil
+}
+
+type fakeWatchCloser struct {
+	f  *FakeAdapter
+	ch chan adapter.Event
+}
+
+func (c fakeWatchCloser) Close() error {
+	c.f.mu.Lock()
+	defer c.f.mu.Unlock()
+	for i, ch := range c.f.watchers {
+		if ch == c.ch {
+			c.f.watchers = append(c.f.watchers[:i], c.f.watchers[i+1:]...)
+			close(ch)
+			break
+		}
+	}
+	return nil
+}
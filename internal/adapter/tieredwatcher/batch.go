@@ -0,0 +1,185 @@
+package tieredwatcher
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+const (
+	// DefaultBatchWindow is how long a path must go without changes before
+	// its batched change is emitted.
+	DefaultBatchWindow = 100 * time.Millisecond
+	// DefaultBatchMaxDelay bounds how long a path that keeps changing waits
+	// before its change is emitted anyway.
+	DefaultBatchMaxDelay = time.Second
+)
+
+// Change is the coalesced result of one or more notifications for a path.
+type Change struct {
+	Path  string
+	Type  adapter.EventType // strongest type seen; see mergeEventType
+	Count int               // notifications coalesced into this change
+}
+
+// pendingChange is a Change waiting for its path to go quiet.
+type pendingChange struct {
+	Change
+	first time.Time
+	last  time.Time
+}
+
+// Batcher coalesces change notifications per path. A path's change is
+// emitted once the path has been quiet for the window, or once it has been
+// pending for the max delay, so a burst of writes to one session file
+// yields a single change and writes to different files don't starve each
+// other. Changes that become due together are delivered in one batch.
+// Safe for concurrent use.
+type Batcher struct {
+	window   time.Duration
+	maxDelay time.Duration
+	flush    func([]Change)
+
+	mu      sync.Mutex
+	pending map[string]*pendingChange
+	timer   *time.Timer
+	closed  bool
+
+	flushMu sync.Mutex // serializes flush calls
+}
+
+// NewBatcher returns a Batcher that passes due changes to flush, oldest
+// first. A window or max delay of zero or less uses the default.
+func NewBatcher(window, maxDelay time.Duration, flush func([]Change)) *Batcher {
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultBatchMaxDelay
+	}
+	return &Batcher{
+		window:   window,
+		maxDelay: max(maxDelay, window),
+		flush:    flush,
+		pending:  make(map[string]*pendingChange),
+	}
+}
+
+// Add records a change of the given type to path.
+func (b *Batcher) Add(path string, typ adapter.EventType) {
+	now := time.Now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	p := b.pending[path]
+	if p == nil {
+		p = &pendingChange{Change: Change{Path: path, Type: typ}, first: now}
+		b.pending[path] = p
+	} else {
+		p.Type = mergeEventType(p.Type, typ)
+	}
+	p.Count++
+	p.last = now
+	b.scheduleLocked(now)
+}
+
+// Pending returns the number of paths with changes not yet emitted.
+func (b *Batcher) Pending() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+// Close drops pending changes and stops further flushes.
+func (b *Batcher) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.pending = nil
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+}
+
+// due returns when p's change should be emitted.
+func (b *Batcher) due(p *pendingChange) time.Time {
+	quiet := p.last.Add(b.window)
+	if deadline := p.first.Add(b.maxDelay); deadline.Before(quiet) {
+		return deadline
+	}
+	return quiet
+}
+
+// scheduleLocked arms the timer for the earliest pending change. Must be
+// called with b.mu held.
+func (b *Batcher) scheduleLocked(now time.Time) {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	if len(b.pending) == 0 {
+		return
+	}
+	var next time.Time
+	for _, p := range b.pending {
+		if d := b.due(p); next.IsZero() || d.Before(next) {
+			next = d
+		}
+	}
+	b.timer = time.AfterFunc(max(next.Sub(now), 0), b.fire)
+}
+
+// fire emits the changes that are due and reschedules the rest.
+func (b *Batcher) fire() {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	now := time.Now()
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	var ready []*pendingChange
+	for path, p := range b.pending {
+		if !b.due(p).After(now) {
+			ready = append(ready, p)
+			delete(b.pending, path)
+		}
+	}
+	b.scheduleLocked(now)
+	b.mu.Unlock()
+
+	if len(ready) == 0 {
+		return
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i].first.Before(ready[j].first) })
+	changes := make([]Change, len(ready))
+	for i, p := range ready {
+		changes[i] = p.Change
+	}
+	b.flush(changes)
+}
+
+// mergeEventType combines two notifications for the same path. A creation
+// outranks an append, which outranks a generic update, so a file created
+// and then written within one window is still reported as created.
+func mergeEventType(a, b adapter.EventType) adapter.EventType {
+	rank := func(t adapter.EventType) int {
+		switch t {
+		case adapter.EventSessionCreated:
+			return 2
+		case adapter.EventMessageAdded:
+			return 1
+		}
+		return 0
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,140 @@
+package tieredwatcher
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+// batchRecorder collects flushed batches.
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]Change
+}
+
+func (r *batchRecorder) flush(changes []Change) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, changes)
+}
+
+func (r *batchRecorder) wait(t *testing.T, n int) [][]Change {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		got := len(r.batches)
+		r.mu.Unlock()
+		if got >= n {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([][]Change(nil), r.batches...)
+}
+
+func TestBatcher_CoalescesPerPath(t *testing.T) {
+	var rec batchRecorder
+	b := NewBatcher(20*time.Millisecond, time.Second, rec.flush)
+	defer b.Close()
+
+	for range 10 {
+		b.Add("a.jsonl", adapter.EventMessageAdded)
+	}
+	b.Add("b.jsonl", adapter.EventSessionUpdated)
+	b.Add("b.jsonl", adapter.EventSessionCreated)
+	b.Add("b.jsonl", adapter.EventMessageAdded)
+
+	batches := rec.wait(t, 1)
+	if len(batches) != 1 || len(batches[0]) != 2 {
+		t.Fatalf("batches = %+v, want one batch of 2 changes", batches)
+	}
+	a, bc := batches[0][0], batches[0][1]
+	if a.Path != "a.jsonl" || a.Count != 10 || a.Type != adapter.EventMessageAdded {
+		t.Errorf("first change = %+v, want a.jsonl x10 message_added", a)
+	}
+	if bc.Path != "b.jsonl" || bc.Count != 3 || bc.Type != adapter.EventSessionCreated {
+		t.Errorf("second change = %+v, want b.jsonl x3 session_created", bc)
+	}
+	if b.Pending() != 0 {
+		t.Errorf("Pending() = %d after flush, want 0", b.Pending())
+	}
+}
+
+func TestBatcher_MaxDelay(t *testing.T) {
+	var rec batchRecorder
+	b := NewBatcher(50*time.Millisecond, 100*time.Millisecond, rec.flush)
+	defer b.Close()
+
+	// Keep writing faster than the window; the max delay must still flush
+	stop := time.Now().Add(300 * time.Millisecond)
+	for time.Now().Before(stop) {
+		b.Add("busy.jsonl", adapter.EventMessageAdded)
+		time.Sleep(10 * time.Millisecond)
+	}
+	if batches := rec.wait(t, 1); len(batches) == 0 {
+		t.Fatal("no batch emitted while the path kept changing")
+	}
+}
+
+func TestBatcher_Close(t *testing.T) {
+	var rec batchRecorder
+	b := NewBatcher(10*time.Millisecond, 0, rec.flush)
+	b.Add("a.jsonl", adapter.EventMessageAdded)
+	b.Close()
+	b.Add("b.jsonl", adapter.EventMessageAdded)
+
+	time.Sleep(50 * time.Millisecond)
+	if batches := rec.wait(t, 0); len(batches) != 0 {
+		t.Errorf("batches after Close = %+v, want none", batches)
+	}
+}
+
+func TestWatcher_BurstEmitsOneEventPerSession(t *testing.T) {
+	tmpDir := t.TempDir()
+	tw, ch, err := New(Config{RootDir: tmpDir, FilePattern: ".jsonl", BatchWindow: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	defer func() { _ = tw.Close() }()
+
+	paths := map[string]string{}
+	for _, id := range []string{"a", "b"} {
+		paths[id] = filepath.Join(tmpDir, id+".jsonl")
+		if err := os.WriteFile(paths[id], nil, 0644); err != nil {
+			t.Fatalf("WriteFile error: %v", err)
+		}
+		tw.RegisterSession(id, paths[id])
+	}
+	tw.SetHotTarget(2)
+
+	for i := range 20 {
+		f, err := os.OpenFile(paths[[]string{"a", "b"}[i%2]], os.O_APPEND|os.O_WRONLY, 0)
+		if err != nil {
+			t.Fatalf("OpenFile error: %v", err)
+		}
+		_, _ = f.WriteString("{}\n")
+		_ = f.Close()
+	}
+
+	counts := map[string]int{}
+	timeout := time.After(500 * time.Millisecond)
+loop:
+	for {
+		select {
+		case evt := <-ch:
+			counts[evt.SessionID]++
+		case <-timeout:
+			break loop
+		}
+	}
+	if counts["a"] != 1 || counts["b"] != 1 {
+		t.Errorf("events per session = %v, want one each for a and b", counts)
+	}
+}
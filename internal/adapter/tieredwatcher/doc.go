@@ -1,4 +1,19 @@
 // Package tieredwatcher implements tiered file watching with a HOT tier using
 // real-time fsnotify and a COLD tier using periodic polling, reducing file
 // descriptor usage while keeping recently active sessions responsive.
+//
+// A Policy controls how sessions move between tiers: a change promotes a
+// COLD session to HOT until it has been idle for the policy's timeout, and
+// the HOT set is capped below the process's open file limit.
+// Sessions on network filesystems, where fsnotify misses remote changes,
+// are never HOT and are polled at a shorter interval instead.
+//
+// All watchers share one fsnotify instance through SharedMux, so each
+// directory costs one watch however many adapters watch it. When Linux's
+// inotify limits are exhausted, affected directories fall back to polling
+// and the first watcher to hit a limit emits a one-time warning event.
+//
+// Change notifications from both tiers pass through a Batcher, which
+// coalesces them per path so a burst of agent writes yields one event per
+// session rather than one per notification.
 package tieredwatcher
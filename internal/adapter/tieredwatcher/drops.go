@@ -0,0 +1,32 @@
+package tieredwatcher
+
+import "time"
+
+const (
+	// DefaultEventQueue is a TieredWatcher's event buffer when
+	// Config.EventQueue is zero.
+	DefaultEventQueue = 32
+	// ResyncInterval is how often a watcher that dropped events offers an
+	// adapter.EventResync until one is delivered.
+	ResyncInterval = 2 * time.Second
+)
+
+// DropStats counts events dropped because a consumer fell behind.
+type DropStats struct {
+	Notifications int64 // fsnotify events dropped before batching
+	Events        int64 // session events dropped from full queues
+	Resyncs       int64 // resync events delivered after drops
+}
+
+// Dropped returns the total number of events dropped.
+func (d DropStats) Dropped() int64 {
+	return d.Notifications + d.Events
+}
+
+func (d DropStats) add(o DropStats) DropStats {
+	return DropStats{
+		Notifications: d.Notifications + o.Notifications,
+		Events:        d.Events + o.Events,
+		Resyncs:       d.Resyncs + o.Resyncs,
+	}
+}
@@ -0,0 +1,70 @@
+package tieredwatcher
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+func TestDrops_ResyncAfterFullQueue(t *testing.T) {
+	tw, ch, err := New(Config{FilePattern: ".jsonl", EventQueue: 2})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	defer func() { _ = tw.Close() }()
+
+	tw.emit([]Change{
+		{Path: "/x/a.jsonl", Type: adapter.EventMessageAdded},
+		{Path: "/x/b.jsonl", Type: adapter.EventMessageAdded},
+		{Path: "/x/c.jsonl", Type: adapter.EventMessageAdded},
+		{Path: "/x/d.jsonl", Type: adapter.EventMessageAdded},
+	})
+	if d := tw.DropStats(); d.Events != 2 || d.Resyncs != 0 {
+		t.Fatalf("DropStats() = %+v, want 2 events dropped", d)
+	}
+
+	// Queue still full: the resync waits
+	tw.offerResync()
+	if d := tw.DropStats(); d.Resyncs != 0 {
+		t.Errorf("resync delivered into a full queue: %+v", d)
+	}
+
+	<-ch
+	<-ch
+	tw.offerResync()
+	if evt := <-ch; evt.Type != adapter.EventResync || evt.SessionID != "" {
+		t.Errorf("event = %+v, want a resync with no session", evt)
+	}
+	if d := tw.DropStats(); d.Resyncs != 1 {
+		t.Errorf("Resyncs = %d, want 1", d.Resyncs)
+	}
+
+	// Nothing dropped since: no further resync
+	tw.offerResync()
+	select {
+	case evt := <-ch:
+		t.Errorf("unexpected event %+v without new drops", evt)
+	default:
+	}
+}
+
+func TestManager_ReportDropped(t *testing.T) {
+	m := NewManager()
+	defer func() { _ = m.Close() }()
+
+	m.offerResync()
+	select {
+	case evt := <-m.Events():
+		t.Fatalf("unexpected event %+v without drops", evt)
+	default:
+	}
+
+	m.ReportDropped()
+	m.offerResync()
+	if evt := <-m.Events(); evt.Type != adapter.EventResync {
+		t.Errorf("event = %+v, want resync", evt)
+	}
+	if d := m.DropStats(); d.Events != 1 || d.Resyncs != 1 || d.Dropped() != 1 {
+		t.Errorf("DropStats() = %+v, want 1 dropped and 1 resync", d)
+	}
+}
@@ -0,0 +1,19 @@
+package tieredwatcher
+
+import "syscall"
+
+// mountFSType returns the type of the filesystem holding path.
+func mountFSType(path string) string {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return ""
+	}
+	name := make([]byte, 0, len(st.Fstypename))
+	for _, c := range st.Fstypename {
+		if c == 0 {
+			break
+		}
+		name = append(name, byte(c))
+	}
+	return string(name)
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+
+package tieredwatcher
+
+// mountFSType is unsupported here; use Config.PollPaths for network mounts.
+func mountFSType(path string) string {
+	return ""
+}
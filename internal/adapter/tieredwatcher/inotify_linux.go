@@ -0,0 +1,32 @@
+package tieredwatcher
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// inotifyLimitHit returns the inotify limit that err shows was exhausted,
+// or "" if err is not a limit error.
+func inotifyLimitHit(err error) string {
+	switch {
+	case errors.Is(err, syscall.ENOSPC):
+		return limitWatches
+	case errors.Is(err, syscall.EMFILE):
+		return limitInstances
+	}
+	return ""
+}
+
+// inotifyLimitValue returns the current value of an inotify limit, or 0 if
+// it can't be read.
+func inotifyLimitValue(limit string) int {
+	data, err := os.ReadFile("/proc/sys/fs/inotify/" + limit)
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(string(data)))
+	return n
+}
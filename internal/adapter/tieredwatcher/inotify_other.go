@@ -0,0 +1,12 @@
+//go:build !linux
+
+package tieredwatcher
+
+// inotifyLimitHit reports no limit errors; only Linux has inotify.
+func inotifyLimitHit(err error) string {
+	return ""
+}
+
+func inotifyLimitValue(limit string) int {
+	return 0
+}
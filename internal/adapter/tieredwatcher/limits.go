@@ -0,0 +1,62 @@
+package tieredwatcher
+
+import (
+	"fmt"
+	"sync"
+)
+
+const (
+	// limitWatches and limitInstances name the inotify limits under
+	// /proc/sys/fs/inotify.
+	limitWatches   = "max_user_watches"
+	limitInstances = "max_user_instances"
+
+	// pollReasonLimit marks directories polled because a watch couldn't
+	// be added.
+	pollReasonLimit = "inotify limit"
+)
+
+// suggestedLimits are the values recommended when a limit is exhausted.
+var suggestedLimits = map[string]int{
+	limitWatches:   524288,
+	limitInstances: 1024,
+}
+
+// limitState records the first exhausted inotify limit, process-wide, so
+// the user is warned once however many watchers hit it.
+var limitState struct {
+	mu      sync.Mutex
+	warning string
+}
+
+// InotifyWarning returns guidance for the inotify limit that forced
+// polling, or "" if none was hit.
+func InotifyWarning() string {
+	limitState.mu.Lock()
+	defer limitState.mu.Unlock()
+	return limitState.warning
+}
+
+// claimLimitWarning records that limit was exhausted. It returns the
+// warning to show if this is the first limit hit, or "" otherwise.
+func claimLimitWarning(limit string) string {
+	limitState.mu.Lock()
+	defer limitState.mu.Unlock()
+	if limitState.warning != "" {
+		return ""
+	}
+	limitState.warning = limitWarning(limit)
+	return limitState.warning
+}
+
+// limitWarning returns an actionable message for an exhausted limit.
+func limitWarning(limit string) string {
+	current := inotifyLimitValue(limit)
+	suggested := max(suggestedLimits[limit], current*2)
+	reached := "reached"
+	if current > 0 {
+		reached = fmt.Sprintf("(%d) reached", current)
+	}
+	return fmt.Sprintf("inotify %s %s; polling sessions instead. Raise it with: sudo sysctl fs.inotify.%s=%d",
+		limit, reached, limit, suggested)
+}
@@ -0,0 +1,102 @@
+package tieredwatcher
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+func resetLimitState(t *testing.T) {
+	t.Helper()
+	clear := func() {
+		limitState.mu.Lock()
+		limitState.warning = ""
+		limitState.mu.Unlock()
+	}
+	clear()
+	t.Cleanup(clear)
+}
+
+func TestInotifyLimitHit(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("inotify is Linux-only")
+	}
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("add /x: %w", syscall.ENOSPC), limitWatches},
+		{syscall.EMFILE, limitInstances},
+		{syscall.ENOENT, ""},
+	}
+	for _, tt := range tests {
+		if got := inotifyLimitHit(tt.err); got != tt.want {
+			t.Errorf("inotifyLimitHit(%v) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestLimitHit_PollsAndWarnsOnce(t *testing.T) {
+	resetLimitState(t)
+	dir := t.TempDir()
+
+	newWatcher := func() (*TieredWatcher, <-chan adapter.Event) {
+		tw, ch, err := New(Config{FilePattern: ".jsonl"})
+		if err != nil {
+			t.Fatalf("New() error: %v", err)
+		}
+		t.Cleanup(func() { _ = tw.Close() })
+		path := filepath.Join(dir, "s.jsonl")
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatalf("WriteFile error: %v", err)
+		}
+		tw.RegisterSession("s", path)
+		tw.SetHotTarget(1)
+		return tw, ch
+	}
+
+	first, firstCh := newWatcher()
+	if !hotTiers(first)["s"] {
+		t.Fatal("session should start HOT")
+	}
+	first.mu.Lock()
+	first.limitHitLocked(limitWatches, dir)
+	first.rebuildHotSetLocked()
+	first.mu.Unlock()
+
+	if hotTiers(first)["s"] {
+		t.Error("session in a limited directory should be polled, not HOT")
+	}
+	if got := first.PollOnlyDirs()[dir]; got != pollReasonLimit {
+		t.Errorf("PollOnlyDirs()[dir] = %q, want %q", got, pollReasonLimit)
+	}
+	evt := <-firstCh
+	msg, _ := evt.Data.(string)
+	if evt.Type != adapter.EventWarning || !strings.Contains(msg, "sysctl fs.inotify.max_user_watches=") {
+		t.Errorf("event = %+v, want a warning with sysctl guidance", evt)
+	}
+	if InotifyWarning() != msg {
+		t.Errorf("InotifyWarning() = %q, want %q", InotifyWarning(), msg)
+	}
+
+	// A second watcher hitting a limit doesn't warn again
+	second, secondCh := newWatcher()
+	second.mu.Lock()
+	second.limitHitLocked(limitInstances, "")
+	second.rebuildHotSetLocked()
+	second.mu.Unlock()
+	select {
+	case evt := <-secondCh:
+		t.Errorf("unexpected second warning %+v", evt)
+	default:
+	}
+	if hotTiers(second)["s"] {
+		t.Error("a watcher without fsnotify should poll every session")
+	}
+}
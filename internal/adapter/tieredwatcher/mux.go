@@ -0,0 +1,206 @@
+package tieredwatcher
+
+import (
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// subscriptionBuffer is the event buffer of each Subscription. Events for
+// a subscriber that falls this far behind are dropped rather than
+// stalling the others.
+const subscriptionBuffer = 64
+
+// Mux shares one fsnotify watcher among subscribers. A directory added by
+// several subscribers is watched once, and each subscriber receives the
+// events for the directories it added. The fsnotify watcher is opened with
+// the first subscription and closed with the last. Safe for concurrent use.
+type Mux struct {
+	mu      sync.Mutex
+	watcher *fsnotify.Watcher
+	dirs    map[string]map[*Subscription]struct{} // dir -> subscribers
+	subs    map[*Subscription]struct{}
+}
+
+var shared = &Mux{}
+
+// SharedMux returns the process-wide Mux used by every TieredWatcher.
+func SharedMux() *Mux {
+	return shared
+}
+
+// Subscription is one subscriber's view of a Mux, used like an
+// fsnotify.Watcher: Add and Remove directories, read Events and Errors.
+type Subscription struct {
+	Events chan fsnotify.Event
+	Errors chan error
+
+	mux     *Mux
+	dirs    map[string]bool
+	closed  bool
+	dropped atomic.Int64 // events dropped while Events was full
+}
+
+// Dropped returns the number of events dropped because Events was full.
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// detachedSubscription returns a closed subscription that watches nothing,
+// for a watcher that couldn't get an fsnotify instance.
+func detachedSubscription() *Subscription {
+	s := &Subscription{
+		Events: make(chan fsnotify.Event),
+		Errors: make(chan error),
+		mux:    &Mux{},
+		closed: true,
+	}
+	close(s.Events)
+	close(s.Errors)
+	return s
+}
+
+// Subscribe returns a new subscription, opening the fsnotify watcher if
+// this is the only one.
+func (m *Mux) Subscribe() (*Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.watcher == nil {
+		w, err := fsnotify.NewWatcher()
+		if err != nil {
+			return nil, err
+		}
+		m.watcher = w
+		m.dirs = make(map[string]map[*Subscription]struct{})
+		m.subs = make(map[*Subscription]struct{})
+		go m.dispatch(w)
+	}
+	s := &Subscription{
+		Events: make(chan fsnotify.Event, subscriptionBuffer),
+		Errors: make(chan error, 1),
+		mux:    m,
+		dirs:   make(map[string]bool),
+	}
+	m.subs[s] = struct{}{}
+	return s, nil
+}
+
+// Stats returns the number of directories watched and of subscribers.
+func (m *Mux) Stats() (watchedDirs, subscribers int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.dirs), len(m.subs)
+}
+
+// dispatch delivers w's events to the subscribers of the affected
+// directory until w is closed.
+func (m *Mux) dispatch(w *fsnotify.Watcher) {
+	for {
+		select {
+		case event, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			m.mu.Lock()
+			// Events name the changed entry; changes to a watched
+			// directory itself go to its subscribers too
+			for _, dir := range []string{filepath.Dir(event.Name), event.Name} {
+				for s := range m.dirs[dir] {
+					select {
+					case s.Events <- event:
+					default:
+						s.dropped.Add(1)
+					}
+				}
+			}
+			m.mu.Unlock()
+
+		case err, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+			m.mu.Lock()
+			for s := range m.subs {
+				select {
+				case s.Errors <- err:
+				default:
+				}
+			}
+			m.mu.Unlock()
+		}
+	}
+}
+
+// Add subscribes to changes in dir, watching it if no other subscriber
+// already does.
+func (s *Subscription) Add(dir string) error {
+	m := s.mux
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s.closed {
+		return fsnotify.ErrClosed
+	}
+	if s.dirs[dir] {
+		return nil
+	}
+	if len(m.dirs[dir]) == 0 {
+		if err := m.watcher.Add(dir); err != nil {
+			return err
+		}
+		m.dirs[dir] = make(map[*Subscription]struct{})
+	}
+	m.dirs[dir][s] = struct{}{}
+	s.dirs[dir] = true
+	return nil
+}
+
+// Remove unsubscribes from dir, unwatching it once no subscriber is left.
+func (s *Subscription) Remove(dir string) error {
+	m := s.mux
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !s.dirs[dir] {
+		return nil
+	}
+	return s.removeLocked(dir)
+}
+
+// removeLocked drops dir from s. Must be called with s.mux.mu held.
+func (s *Subscription) removeLocked(dir string) error {
+	m := s.mux
+	delete(s.dirs, dir)
+	delete(m.dirs[dir], s)
+	if len(m.dirs[dir]) > 0 {
+		return nil
+	}
+	delete(m.dirs, dir)
+	return m.watcher.Remove(dir)
+}
+
+// Close removes the subscription's directories and closes its channels.
+// Closing the last subscription closes the fsnotify watcher.
+func (s *Subscription) Close() error {
+	m := s.mux
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	for dir := range s.dirs {
+		_ = s.removeLocked(dir)
+	}
+	delete(m.subs, s)
+	close(s.Events)
+	close(s.Errors)
+
+	if len(m.subs) > 0 {
+		return nil
+	}
+	err := m.watcher.Close()
+	m.watcher = nil
+	m.dirs = nil
+	return err
+}
@@ -0,0 +1,125 @@
+package tieredwatcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func waitForEvent(t *testing.T, ch <-chan fsnotify.Event, name string) {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Name == name {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("no event for %s", name)
+		}
+	}
+}
+
+func TestMux_SharesWatches(t *testing.T) {
+	dir := t.TempDir()
+	other := t.TempDir()
+	m := &Mux{}
+
+	a, err := m.Subscribe()
+	if err != nil {
+		t.Fatalf("Subscribe() error: %v", err)
+	}
+	b, err := m.Subscribe()
+	if err != nil {
+		t.Fatalf("Subscribe() error: %v", err)
+	}
+	for _, s := range []*Subscription{a, b} {
+		if err := s.Add(dir); err != nil {
+			t.Fatalf("Add() error: %v", err)
+		}
+	}
+	if err := b.Add(other); err != nil {
+		t.Fatalf("Add() error: %v", err)
+	}
+	if dirs, subs := m.Stats(); dirs != 2 || subs != 2 {
+		t.Fatalf("Stats() = %d dirs, %d subscribers, want 2, 2", dirs, subs)
+	}
+
+	path := filepath.Join(dir, "s.jsonl")
+	if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	waitForEvent(t, a.Events, path)
+	waitForEvent(t, b.Events, path)
+
+	// Only b subscribed to other
+	otherPath := filepath.Join(other, "o.jsonl")
+	if err := os.WriteFile(otherPath, []byte("{}"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	waitForEvent(t, b.Events, otherPath)
+	select {
+	case ev := <-a.Events:
+		if ev.Name == otherPath {
+			t.Errorf("subscriber a got event for a directory it didn't add")
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Closing one subscriber keeps the shared watch for the other
+	if err := a.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+	if dirs, subs := m.Stats(); dirs != 2 || subs != 1 {
+		t.Errorf("Stats() after close = %d dirs, %d subscribers, want 2, 1", dirs, subs)
+	}
+	path2 := filepath.Join(dir, "s2.jsonl")
+	if err := os.WriteFile(path2, []byte("{}"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	waitForEvent(t, b.Events, path2)
+
+	if err := b.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+	if dirs, subs := m.Stats(); dirs != 0 || subs != 0 {
+		t.Errorf("Stats() after closing all = %d dirs, %d subscribers, want 0, 0", dirs, subs)
+	}
+
+	// A new subscription reopens the watcher
+	c, err := m.Subscribe()
+	if err != nil {
+		t.Fatalf("Subscribe() after close error: %v", err)
+	}
+	defer func() { _ = c.Close() }()
+	if err := c.Add(dir); err != nil {
+		t.Errorf("Add() after reopen error: %v", err)
+	}
+}
+
+func TestMux_RemoveKeepsSharedDir(t *testing.T) {
+	dir := t.TempDir()
+	m := &Mux{}
+	a, _ := m.Subscribe()
+	b, _ := m.Subscribe()
+	defer func() { _ = a.Close(); _ = b.Close() }()
+	_ = a.Add(dir)
+	_ = b.Add(dir)
+
+	if err := a.Remove(dir); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if dirs, _ := m.Stats(); dirs != 1 {
+		t.Errorf("watched dirs = %d after one subscriber removed, want 1", dirs)
+	}
+	if err := b.Remove(dir); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if dirs, _ := m.Stats(); dirs != 0 {
+		t.Errorf("watched dirs = %d after all removed, want 0", dirs)
+	}
+}
@@ -0,0 +1,58 @@
+package tieredwatcher
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultNetworkPollInterval is how often sessions on network filesystems
+// are polled when Config.NetworkPollInterval is zero.
+const DefaultNetworkPollInterval = 5 * time.Second
+
+// networkFSTypes are filesystem types where fsnotify misses changes made by
+// other hosts or by the hypervisor, so their sessions are polled instead.
+var networkFSTypes = map[string]bool{
+	"nfs":            true,
+	"nfs4":           true,
+	"cifs":           true,
+	"smb":            true,
+	"smb2":           true,
+	"smb3":           true,
+	"smbfs":          true,
+	"afpfs":          true,
+	"webdav":         true,
+	"virtiofs":       true,
+	"9p":             true,
+	"afs":            true,
+	"ceph":           true,
+	"glusterfs":      true,
+	"fuse.glusterfs": true,
+	"fuse.sshfs":     true,
+	"lustre":         true,
+}
+
+// fsTypeOf returns the type of the filesystem holding path, or "" if it
+// can't be determined. Replaced in tests.
+var fsTypeOf = mountFSType
+
+// pollReason reports why dir must be polled rather than watched: "config"
+// when it is under one of pollPaths, the filesystem type when it is a
+// network mount, or "" when fsnotify can watch it.
+func pollReason(dir string, pollPaths []string) string {
+	for _, p := range pollPaths {
+		if within(dir, p) {
+			return "config"
+		}
+	}
+	if fstype := fsTypeOf(dir); networkFSTypes[fstype] {
+		return fstype
+	}
+	return ""
+}
+
+// within reports whether path is dir or below it.
+func within(path, dir string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
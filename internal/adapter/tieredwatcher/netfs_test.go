@@ -0,0 +1,78 @@
+package tieredwatcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPollReason(t *testing.T) {
+	orig := fsTypeOf
+	t.Cleanup(func() { fsTypeOf = orig })
+	fsTypeOf = func(path string) string {
+		if within(path, "/mnt/share") {
+			return "nfs4"
+		}
+		return "ext4"
+	}
+
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{"/home/me/.claude/projects/x", ""},
+		{"/mnt/share/sessions", "nfs4"},
+		{"/mnt/shared", ""},
+		{"/srv/sessions", "config"},
+		{"/srv/sessions/sub", "config"},
+	}
+	for _, tt := range tests {
+		if got := pollReason(tt.dir, []string{"/srv/sessions"}); got != tt.want {
+			t.Errorf("pollReason(%q) = %q, want %q", tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestNetworkSessionsStayCold(t *testing.T) {
+	local := t.TempDir()
+	remote := t.TempDir()
+	orig := fsTypeOf
+	t.Cleanup(func() { fsTypeOf = orig })
+	fsTypeOf = func(path string) string {
+		if within(path, remote) {
+			return "virtiofs"
+		}
+		return ""
+	}
+
+	tw, _, err := New(Config{FilePattern: ".jsonl"})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	defer func() { _ = tw.Close() }()
+
+	for id, dir := range map[string]string{"local": local, "remote": remote} {
+		path := filepath.Join(dir, id+".jsonl")
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatalf("WriteFile error: %v", err)
+		}
+		tw.RegisterSession(id, path)
+	}
+	tw.SetHotTarget(2)
+	tw.PromoteToHot("remote")
+	tw.noteActivity("remote")
+
+	hot := hotTiers(tw)
+	if !hot["local"] || hot["remote"] {
+		t.Errorf("HOT = %v, want only the local session", hot)
+	}
+	if got := tw.PollOnlyDirs(); got[remote] != "virtiofs" || len(got) != 1 {
+		t.Errorf("PollOnlyDirs() = %v, want %s: virtiofs", got, remote)
+	}
+	tw.mu.Lock()
+	watched := tw.watchDirs[remote]
+	tw.mu.Unlock()
+	if watched {
+		t.Error("network directory should not be watched with fsnotify")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package tieredwatcher
+
+// openFileLimit returns -1; the platform has no open-file rlimit.
+func openFileLimit() int {
+	return -1
+}
@@ -0,0 +1,20 @@
+//go:build unix
+
+package tieredwatcher
+
+import (
+	"math"
+	"syscall"
+)
+
+// openFileLimit returns the soft limit on open files, or -1 if unknown.
+func openFileLimit() int {
+	var rl syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rl); err != nil {
+		return -1
+	}
+	if rl.Cur > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(rl.Cur)
+}
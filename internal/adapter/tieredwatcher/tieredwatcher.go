@@ -1,7 +1,9 @@
 package tieredwatcher
 
 import (
+	"cmp"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"sort"
@@ -19,8 +21,69 @@ const (
 	HotInactivityTimeout = 5 * time.Minute
 	// FrozenThreshold is the duration after which unchanged COLD sessions stop being polled.
 	FrozenThreshold = 24 * time.Hour
+
+	// hotFDShare limits HOT watches to this fraction (1/n) of the open-file
+	// limit, leaving the rest to the process and other adapters.
+	hotFDShare = 8
+	// minMaxHot and defaultMaxHot bound the HOT cap derived from the limit.
+	minMaxHot     = 4
+	defaultMaxHot = 256
+)
+
+// Policy tunes how sessions move between tiers. Any change event promotes
+// a session to HOT, beyond the HOT target if need be, until it has been
+// idle for IdleTimeout.
+type Policy struct {
+	// IdleTimeout demotes HOT sessions after this long without activity.
+	// Zero means HotInactivityTimeout.
+	IdleTimeout time.Duration
+	// MaxHot caps the HOT tier however many sessions are active. Zero
+	// derives the cap from the open-file limit.
+	MaxHot int
+	// PollOnly keeps sessions COLD even when they change.
+	PollOnly bool
+}
+
+func (p Policy) idleTimeout() time.Duration {
+	if p.IdleTimeout > 0 {
+		return p.IdleTimeout
+	}
+	return HotInactivityTimeout
+}
+
+func (p Policy) maxHot() int {
+	if p.MaxHot > 0 {
+		return p.MaxHot
+	}
+	return fdBudgetMaxHot()
+}
+
+// fdBudgetMaxHot derives a HOT cap from the open-file limit.
+func fdBudgetMaxHot() int {
+	limit := openFileLimit()
+	if limit <= 0 {
+		return defaultMaxHot
+	}
+	return min(max(limit/hotFDShare, minMaxHot), defaultMaxHot)
+}
+
+// Tier is a session's watch tier.
+type Tier string
+
+const (
+	TierHot    Tier = "hot"    // watched with fsnotify
+	TierCold   Tier = "cold"   // polled every ColdPollInterval
+	TierFrozen Tier = "frozen" // unchanged past FrozenThreshold, not polled
 )
 
+// Assignment is a session's current tier, for diagnostics.
+type Assignment struct {
+	SessionID  string
+	Path       string
+	Tier       Tier
+	LastActive time.Time
+}
+
 // SessionInfo tracks a watched session's path and modification time.
 type SessionInfo struct {
 	ID       string    // Session ID (e.g., filename without extension)
@@ -29,6 +92,8 @@ type SessionInfo struct {
 	LastHot  time.Time // When this session was last in HOT tier or accessed
 	FileSize int64     // Last known file size
 	Frozen   bool      // true = unchanged >24h, skip in pollColdSessions
+
+	lastEvent time.Time // when a change last promoted this session
 }
 
 // TieredWatcher manages tiered watching for a single adapter's sessions.
@@ -40,20 +105,33 @@ type TieredWatcher struct {
 	pathIndex map[string]string       // path -> session ID (for fast lookups)
 	hotIDs    []string                // session IDs currently in HOT tier
 	hotTarget int                     // desired HOT session count
+	policy    Policy
+	maxHot    int // resolved policy.MaxHot
 
-	// fsnotify watcher for HOT tier (watches directory, not individual files)
-	watcher   *fsnotify.Watcher
+	// fsnotify watches for HOT tier (directories, not individual files),
+	// shared with other watchers through SharedMux
+	watcher   *Subscription
 	watchDirs map[string]bool // directories being watched
 	rootDirs  map[string]bool // directories that should stay watched
 	knownDirs map[string]bool // directories with registered sessions
 
+	// Network filesystems, polled instead of watched
+	pollPaths   []string          // directories configured as poll-only
+	pollDirs    map[string]string // dir -> pollReason, cached per directory
+	networkPoll time.Duration
+	limited     bool   // no fsnotify instance; every directory is polled
+	warning     string // one-time limit warning awaiting delivery
+
 	// Polling for COLD tier
 	pollTicker *time.Ticker
 	pollDone   chan struct{}
 
-	// Output channel
-	events chan adapter.Event
-	closed bool
+	// Output channel, fed by the batcher
+	events   chan adapter.Event
+	batcher  *Batcher
+	closed   bool
+	drops    DropStats // Events and Resyncs; Notifications come from watcher
+	resynced int64     // drop total covered by the last resync event
 
 	// Configuration
 	rootDir     string                                  // Root directory to watch
@@ -75,13 +153,34 @@ type Config struct {
 	ScanDir func(dir string) ([]SessionInfo, error)
 	// Filter optionally filters watched paths (overrides FilePattern if set)
 	Filter func(path string) bool
+	// Policy tunes promotion and demotion between tiers
+	Policy Policy
+	// PollPaths lists directories to poll instead of watch, in addition
+	// to detected network filesystems
+	PollPaths []string
+	// NetworkPollInterval is how often sessions that can't be watched are
+	// polled. Zero uses DefaultNetworkPollInterval.
+	NetworkPollInterval time.Duration
+	// EventQueue is the event buffer size. Events that don't fit are
+	// dropped, counted, and followed by an adapter.EventResync. Zero uses
+	// DefaultEventQueue.
+	EventQueue int
+	// BatchWindow and BatchMaxDelay tune event coalescing; see Batcher.
+	// Zero uses DefaultBatchWindow and DefaultBatchMaxDelay.
+	BatchWindow   time.Duration
+	BatchMaxDelay time.Duration
 }
 
 // New creates a new TieredWatcher.
 func New(cfg Config) (*TieredWatcher, <-chan adapter.Event, error) {
-	watcher, err := fsnotify.NewWatcher()
+	// Without an fsnotify instance, degrade to polling everything
+	watcher, err := SharedMux().Subscribe()
+	limit := ""
 	if err != nil {
-		return nil, nil, err
+		if limit = inotifyLimitHit(err); limit == "" {
+			return nil, nil, err
+		}
+		watcher = detachedSubscription()
 	}
 
 	tw := &TieredWatcher{
@@ -89,11 +188,16 @@ func New(cfg Config) (*TieredWatcher, <-chan adapter.Event, error) {
 		pathIndex:   make(map[string]string),
 		hotIDs:      make([]string, 0),
 		hotTarget:   0,
+		policy:      cfg.Policy,
+		maxHot:      cfg.Policy.maxHot(),
 		watcher:     watcher,
 		watchDirs:   make(map[string]bool),
 		rootDirs:    make(map[string]bool),
 		knownDirs:   make(map[string]bool),
-		events:      make(chan adapter.Event, 32),
+		pollPaths:   cfg.PollPaths,
+		pollDirs:    make(map[string]string),
+		networkPoll: cfg.NetworkPollInterval,
+		events:      make(chan adapter.Event, cmp.Or(max(cfg.EventQueue, 0), DefaultEventQueue)),
 		rootDir:     cfg.RootDir,
 		filePattern: cfg.FilePattern,
 		extractID:   cfg.ExtractID,
@@ -101,17 +205,31 @@ func New(cfg Config) (*TieredWatcher, <-chan adapter.Event, error) {
 		filter:      cfg.Filter,
 	}
 
-	// Watch the root directory if provided
+	if tw.networkPoll <= 0 {
+		tw.networkPoll = DefaultNetworkPollInterval
+	}
+	if limit != "" {
+		tw.limitHitLocked(limit, "")
+	}
+
+	// Watch the root directory if provided and watchable
 	if cfg.RootDir != "" {
-		if err := watcher.Add(cfg.RootDir); err != nil {
+		tw.knownDirs[cfg.RootDir] = true
+	}
+	if cfg.RootDir != "" && tw.pollReasonLocked(cfg.RootDir) == "" {
+		if err := watcher.Add(cfg.RootDir); err == nil {
+			tw.watchDirs[cfg.RootDir] = true
+			tw.rootDirs[cfg.RootDir] = true
+		} else if limit := inotifyLimitHit(err); limit != "" {
+			tw.limitHitLocked(limit, cfg.RootDir)
+		} else {
 			_ = watcher.Close()
 			return nil, nil, err
 		}
-		tw.watchDirs[cfg.RootDir] = true
-		tw.rootDirs[cfg.RootDir] = true
-		tw.knownDirs[cfg.RootDir] = true
 	}
 
+	tw.batcher = NewBatcher(cfg.BatchWindow, cfg.BatchMaxDelay, tw.emit)
+
 	// Start background goroutines
 	tw.pollDone = make(chan struct{})
 	tw.pollTicker = time.NewTicker(ColdPollInterval)
@@ -139,7 +257,7 @@ func (tw *TieredWatcher) PromoteToHot(sessionID string) {
 	}
 
 	// Check if already in HOT tier
-	if !tw.isHotLocked(sessionID) {
+	if !tw.isHotLocked(sessionID) && tw.watchableLocked(info) {
 		// Add to HOT tier
 		tw.hotIDs = append(tw.hotIDs, sessionID)
 	}
@@ -148,6 +266,18 @@ func (tw *TieredWatcher) PromoteToHot(sessionID string) {
 	tw.syncHotDirsLocked()
 }
 
+// SetPolicy replaces the tier policy and reapplies it to the HOT set.
+func (tw *TieredWatcher) SetPolicy(p Policy) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if p == tw.policy {
+		return
+	}
+	tw.policy = p
+	tw.maxHot = p.maxHot()
+	tw.rebuildHotSetLocked()
+}
+
 // RegisterSession adds a session to tracking (starts in COLD tier).
 func (tw *TieredWatcher) RegisterSession(id, path string) {
 	tw.mu.Lock()
@@ -214,20 +344,16 @@ func (tw *TieredWatcher) SetHotTarget(target int) {
 	tw.rebuildHotSetLocked()
 }
 
-// rebuildHotSetLocked rebuilds the HOT set based on recent activity.
-// Must be called with tw.mu held.
+// rebuildHotSetLocked rebuilds the HOT set based on recent activity: the
+// hotTarget most recent sessions plus any promoted by a change within the
+// idle timeout, up to the HOT cap. Must be called with tw.mu held.
 func (tw *TieredWatcher) rebuildHotSetLocked() {
-	if len(tw.sessions) == 0 || tw.hotTarget <= 0 {
+	if len(tw.sessions) == 0 || tw.policy.PollOnly {
 		tw.hotIDs = nil
 		tw.syncHotDirsLocked()
 		return
 	}
 
-	target := tw.hotTarget
-	if target > len(tw.sessions) {
-		target = len(tw.sessions)
-	}
-
 	type sessionActivity struct {
 		id   string
 		when time.Time
@@ -240,10 +366,14 @@ func (tw *TieredWatcher) rebuildHotSetLocked() {
 		return sorted[i].when.After(sorted[j].when)
 	})
 
+	cutoff := time.Now().Add(-tw.policy.idleTimeout())
 	tw.hotIDs = tw.hotIDs[:0]
-	for i := 0; i < target && i < len(sorted); i++ {
+	for i := 0; i < len(sorted) && len(tw.hotIDs) < tw.maxHot; i++ {
 		id := sorted[i].id
 		info := tw.sessions[id]
+		if i >= tw.hotTarget && !info.lastEvent.After(cutoff) || !tw.watchableLocked(info) {
+			continue
+		}
 		if info.LastHot.IsZero() {
 			info.LastHot = info.ModTime
 		}
@@ -253,35 +383,71 @@ func (tw *TieredWatcher) rebuildHotSetLocked() {
 	tw.syncHotDirsLocked()
 }
 
-// demoteOldestLocked removes the oldest session from HOT tier.
-// Must be called with tw.mu held.
-func (tw *TieredWatcher) demoteOldestLocked() {
-	if len(tw.hotIDs) == 0 {
-		return
-	}
-
+// demoteOldestLocked removes the oldest session from HOT tier, skipping
+// sessions promoted by a change after keepSince unless it is zero. It
+// reports whether one was removed. Must be called with tw.mu held.
+func (tw *TieredWatcher) demoteOldestLocked(keepSince time.Time) bool {
 	// Find oldest by activity time
-	oldestIdx := 0
-	oldestTime := time.Now()
+	oldestIdx := -1
+	var oldestTime time.Time
 	for i, id := range tw.hotIDs {
-		if info, ok := tw.sessions[id]; ok && tw.activityTime(info).Before(oldestTime) {
-			oldestTime = tw.activityTime(info)
-			oldestIdx = i
+		var when time.Time
+		if info, ok := tw.sessions[id]; ok {
+			if !keepSince.IsZero() && info.lastEvent.After(keepSince) {
+				continue
+			}
+			when = tw.activityTime(info)
+		}
+		if oldestIdx < 0 || when.Before(oldestTime) {
+			oldestIdx, oldestTime = i, when
 		}
 	}
+	if oldestIdx < 0 {
+		return false
+	}
 
 	// Remove from HOT tier
 	tw.hotIDs = append(tw.hotIDs[:oldestIdx], tw.hotIDs[oldestIdx+1:]...)
+	return true
 }
 
+// trimToHotTargetLocked demotes the oldest sessions beyond the HOT target,
+// keeping those recently promoted by a change, then enforces the HOT cap.
 func (tw *TieredWatcher) trimToHotTargetLocked() {
-	if tw.hotTarget <= 0 {
+	if tw.policy.PollOnly {
 		tw.hotIDs = nil
 		return
 	}
-	for len(tw.hotIDs) > tw.hotTarget {
-		tw.demoteOldestLocked()
+	cutoff := time.Now().Add(-tw.policy.idleTimeout())
+	for len(tw.hotIDs) > max(tw.hotTarget, 0) {
+		if !tw.demoteOldestLocked(cutoff) {
+			break
+		}
 	}
+	for len(tw.hotIDs) > tw.maxHot {
+		tw.demoteOldestLocked(time.Time{})
+	}
+}
+
+// watchableLocked reports whether fsnotify can watch a session's file.
+// Sessions on network filesystems stay COLD and are polled every
+// networkPoll instead. Must be called with tw.mu held.
+func (tw *TieredWatcher) watchableLocked(info *SessionInfo) bool {
+	return tw.pollReasonLocked(filepath.Dir(info.Path)) == ""
+}
+
+// pollReasonLocked returns pollReason for dir, detecting it once per
+// directory. Must be called with tw.mu held.
+func (tw *TieredWatcher) pollReasonLocked(dir string) string {
+	if tw.limited {
+		return pollReasonLimit
+	}
+	reason, ok := tw.pollDirs[dir]
+	if !ok {
+		reason = pollReason(dir, tw.pollPaths)
+		tw.pollDirs[dir] = reason
+	}
+	return reason
 }
 
 func (tw *TieredWatcher) isHotLocked(sessionID string) bool {
@@ -306,7 +472,8 @@ func (tw *TieredWatcher) noteActivityLocked(sessionID string) {
 		return
 	}
 	info.LastHot = time.Now()
-	if tw.hotTarget <= 0 {
+	info.lastEvent = info.LastHot
+	if tw.policy.PollOnly || !tw.watchableLocked(info) {
 		return
 	}
 	if !tw.isHotLocked(sessionID) {
@@ -316,24 +483,9 @@ func (tw *TieredWatcher) noteActivityLocked(sessionID string) {
 	tw.syncHotDirsLocked()
 }
 
-// watchLoop handles fsnotify events for HOT tier sessions.
+// watchLoop handles fsnotify events for HOT tier sessions, handing them to
+// the batcher so bursts of writes are emitted once per path.
 func (tw *TieredWatcher) watchLoop() {
-	var debounceTimer *time.Timer
-	var lastPath string
-	debounceDelay := 100 * time.Millisecond
-
-	var closed bool
-	var mu sync.Mutex
-
-	defer func() {
-		mu.Lock()
-		closed = true
-		if debounceTimer != nil {
-			debounceTimer.Stop()
-		}
-		mu.Unlock()
-	}()
-
 	for {
 		select {
 		case event, ok := <-tw.watcher.Events:
@@ -350,58 +502,18 @@ func (tw *TieredWatcher) watchLoop() {
 				continue
 			}
 
-			mu.Lock()
-			lastPath = event.Name
-			if debounceTimer != nil {
-				debounceTimer.Stop()
+			var eventType adapter.EventType
+			switch {
+			case event.Op&fsnotify.Create != 0:
+				eventType = adapter.EventSessionCreated
+			case event.Op&fsnotify.Write != 0:
+				eventType = adapter.EventMessageAdded
+			case event.Op&fsnotify.Remove != 0:
+				continue // Skip delete events
+			default:
+				eventType = adapter.EventSessionUpdated
 			}
-			capturedEvent := event
-			debounceTimer = time.AfterFunc(debounceDelay, func() {
-				mu.Lock()
-				defer mu.Unlock()
-				if closed {
-					return
-				}
-
-				tw.mu.Lock()
-				sessionID := tw.pathIndex[lastPath]
-				if sessionID == "" && tw.extractID != nil {
-					sessionID = tw.extractID(lastPath)
-				}
-				info := tw.sessions[sessionID]
-
-				// Update mod time if this is a known session
-				if info != nil {
-					if stat, err := os.Stat(lastPath); err == nil {
-						info.ModTime = stat.ModTime()
-						info.FileSize = stat.Size()
-					}
-					tw.noteActivityLocked(sessionID)
-				}
-				tw.mu.Unlock()
-
-				var eventType adapter.EventType
-				switch {
-				case capturedEvent.Op&fsnotify.Create != 0:
-					eventType = adapter.EventSessionCreated
-				case capturedEvent.Op&fsnotify.Write != 0:
-					eventType = adapter.EventMessageAdded
-				case capturedEvent.Op&fsnotify.Remove != 0:
-					return // Skip delete events
-				default:
-					eventType = adapter.EventSessionUpdated
-				}
-
-				select {
-				case tw.events <- adapter.Event{
-					Type:      eventType,
-					SessionID: sessionID,
-				}:
-				default:
-					// Channel full
-				}
-			})
-			mu.Unlock()
+			tw.batcher.Add(event.Name, eventType)
 
 		case _, ok := <-tw.watcher.Errors:
 			if !ok {
@@ -411,12 +523,57 @@ func (tw *TieredWatcher) watchLoop() {
 	}
 }
 
-// pollLoop periodically checks COLD tier sessions for changes.
+// emit sends one event per session for a batch of changes. Known sessions
+// whose files changed since last seen count as active.
+func (tw *TieredWatcher) emit(changes []Change) {
+	stats := make([]os.FileInfo, len(changes))
+	for i, c := range changes {
+		stats[i], _ = os.Stat(c.Path)
+	}
+
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.closed {
+		return
+	}
+	for i, c := range changes {
+		sessionID := tw.pathIndex[c.Path]
+		if sessionID == "" && tw.extractID != nil {
+			sessionID = tw.extractID(c.Path)
+		}
+		if info := tw.sessions[sessionID]; info != nil && stats[i] != nil {
+			if !stats[i].ModTime().Equal(info.ModTime) || stats[i].Size() != info.FileSize {
+				info.ModTime = stats[i].ModTime()
+				info.FileSize = stats[i].Size()
+				info.Frozen = false
+				tw.noteActivityLocked(sessionID)
+			}
+		}
+
+		select {
+		case tw.events <- adapter.Event{Type: c.Type, SessionID: sessionID}:
+		default:
+			// Channel full; offerResync covers the loss
+			tw.drops.Events++
+		}
+	}
+}
+
+// pollLoop periodically checks COLD tier sessions for changes, and those
+// on network filesystems more often.
 func (tw *TieredWatcher) pollLoop() {
+	networkTicker := time.NewTicker(tw.networkPoll)
+	defer networkTicker.Stop()
+	resyncTicker := time.NewTicker(ResyncInterval)
+	defer resyncTicker.Stop()
 	for {
 		select {
 		case <-tw.pollTicker.C:
 			tw.pollColdSessions()
+		case <-networkTicker.C:
+			tw.pollNetworkSessions()
+		case <-resyncTicker.C:
+			tw.offerResync()
 		case <-tw.pollDone:
 			return
 		}
@@ -425,6 +582,19 @@ func (tw *TieredWatcher) pollLoop() {
 
 // pollColdSessions checks non-frozen COLD tier sessions for changes using batch ReadDir.
 func (tw *TieredWatcher) pollColdSessions() {
+	tw.pollSessions(func(string) bool { return true })
+}
+
+// pollNetworkSessions checks sessions in directories that can't be
+// watched, which are always COLD.
+func (tw *TieredWatcher) pollNetworkSessions() {
+	tw.pollSessions(func(dir string) bool { return tw.pollReasonLocked(dir) != "" })
+}
+
+// pollSessions checks non-frozen COLD sessions in directories accepted by
+// include, then looks for new sessions there. include is called with
+// tw.mu held.
+func (tw *TieredWatcher) pollSessions(include func(dir string) bool) {
 	tw.mu.Lock()
 	hotSet := make(map[string]bool, len(tw.hotIDs))
 	for _, id := range tw.hotIDs {
@@ -440,7 +610,7 @@ func (tw *TieredWatcher) pollColdSessions() {
 	}
 	dirSessions := make(map[string][]checkInfo) // dir -> sessions in that dir
 	for id, info := range tw.sessions {
-		if !hotSet[id] && !info.Frozen {
+		if !hotSet[id] && !info.Frozen && include(filepath.Dir(info.Path)) {
 			dir := filepath.Dir(info.Path)
 			dirSessions[dir] = append(dirSessions[dir], checkInfo{
 				id:   id,
@@ -491,14 +661,7 @@ func (tw *TieredWatcher) pollColdSessions() {
 					tw.noteActivityLocked(c.id)
 				}
 				tw.mu.Unlock()
-
-				select {
-				case tw.events <- adapter.Event{
-					Type:      adapter.EventSessionUpdated,
-					SessionID: c.id,
-				}:
-				default:
-				}
+				tw.batcher.Add(c.path, adapter.EventSessionUpdated)
 			} else {
 				// Unchanged — freeze check uses current info under lock
 				tw.mu.Lock()
@@ -513,12 +676,12 @@ func (tw *TieredWatcher) pollColdSessions() {
 	}
 
 	// Look for new sessions in known directories (optional)
-	tw.scanForNewSessions()
+	tw.scanForNewSessions(include)
 }
 
-// scanForNewSessions discovers new sessions in known directories.
-// It only runs when a scanDir function is provided.
-func (tw *TieredWatcher) scanForNewSessions() {
+// scanForNewSessions discovers new sessions in known directories accepted
+// by include. It only runs when a scanDir function is provided.
+func (tw *TieredWatcher) scanForNewSessions(include func(dir string) bool) {
 	if tw.scanDir == nil {
 		return
 	}
@@ -526,7 +689,9 @@ func (tw *TieredWatcher) scanForNewSessions() {
 	tw.mu.Lock()
 	dirs := make([]string, 0, len(tw.knownDirs))
 	for dir := range tw.knownDirs {
-		dirs = append(dirs, dir)
+		if include(dir) {
+			dirs = append(dirs, dir)
+		}
 	}
 	tw.mu.Unlock()
 
@@ -536,7 +701,7 @@ func (tw *TieredWatcher) scanForNewSessions() {
 			continue
 		}
 
-		var newIDs []string
+		var newPaths []string
 		needsRebuild := false
 		tw.mu.Lock()
 		for _, s := range sessions {
@@ -556,7 +721,7 @@ func (tw *TieredWatcher) scanForNewSessions() {
 			tw.sessions[s.ID] = info
 			tw.pathIndex[s.Path] = s.ID
 			tw.knownDirs[filepath.Dir(s.Path)] = true
-			newIDs = append(newIDs, s.ID)
+			newPaths = append(newPaths, s.Path)
 			needsRebuild = true
 		}
 		if needsRebuild {
@@ -564,21 +729,18 @@ func (tw *TieredWatcher) scanForNewSessions() {
 		}
 		tw.mu.Unlock()
 
-		for _, id := range newIDs {
-			select {
-			case tw.events <- adapter.Event{
-				Type:      adapter.EventSessionCreated,
-				SessionID: id,
-			}:
-			default:
-			}
+		for _, path := range newPaths {
+			tw.batcher.Add(path, adapter.EventSessionCreated)
 		}
 	}
 }
 
 // demotionLoop periodically demotes inactive HOT sessions to COLD.
 func (tw *TieredWatcher) demotionLoop() {
-	ticker := time.NewTicker(1 * time.Minute)
+	tw.mu.Lock()
+	interval := min(time.Minute, max(tw.policy.idleTimeout()/2, time.Second))
+	tw.mu.Unlock()
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
@@ -596,7 +758,7 @@ func (tw *TieredWatcher) demoteInactive() {
 	tw.mu.Lock()
 	defer tw.mu.Unlock()
 
-	cutoff := time.Now().Add(-HotInactivityTimeout)
+	cutoff := time.Now().Add(-tw.policy.idleTimeout())
 	var remaining []string
 	for _, id := range tw.hotIDs {
 		if info, ok := tw.sessions[id]; ok && info.LastHot.After(cutoff) {
@@ -618,11 +780,16 @@ func (tw *TieredWatcher) syncHotDirsLocked() {
 		}
 	}
 
-	// Add missing watches
+	// Add missing watches; directories over the inotify limit are polled
+	limited := false
 	for dir := range desired {
 		if !tw.watchDirs[dir] {
-			if err := tw.watcher.Add(dir); err == nil {
+			err := tw.watcher.Add(dir)
+			if err == nil {
 				tw.watchDirs[dir] = true
+			} else if limit := inotifyLimitHit(err); limit != "" {
+				tw.limitHitLocked(limit, dir)
+				limited = true
 			}
 		}
 	}
@@ -638,6 +805,40 @@ func (tw *TieredWatcher) syncHotDirsLocked() {
 			}
 		}
 	}
+
+	// Move sessions in newly limited directories out of the HOT tier
+	if limited {
+		tw.rebuildHotSetLocked()
+	}
+}
+
+// limitHitLocked degrades dir, or every directory if dir is empty, to
+// polling after an inotify limit was exhausted, and queues the one-time
+// warning if no watcher has shown it yet. Must be called with tw.mu held.
+func (tw *TieredWatcher) limitHitLocked(limit, dir string) {
+	if dir == "" {
+		tw.limited = true
+	} else {
+		tw.pollDirs[dir] = pollReasonLimit
+	}
+	if warning := claimLimitWarning(limit); warning != "" {
+		tw.warning = warning
+		tw.offerWarningLocked()
+	}
+}
+
+// offerWarningLocked delivers a pending limit warning as an
+// adapter.EventWarning. Must be called with tw.mu held.
+func (tw *TieredWatcher) offerWarningLocked() {
+	if tw.warning == "" || tw.closed {
+		return
+	}
+	select {
+	case tw.events <- adapter.Event{Type: adapter.EventWarning, Data: tw.warning}:
+		tw.warning = ""
+	default:
+		// Queue full; offerResync retries
+	}
 }
 
 // Close shuts down the watcher.
@@ -655,6 +856,7 @@ func (tw *TieredWatcher) Close() error {
 		tw.pollTicker.Stop()
 	}
 	close(tw.pollDone)
+	tw.batcher.Close()
 
 	// Close fsnotify watcher
 	if tw.watcher != nil {
@@ -696,6 +898,84 @@ func (tw *TieredWatcher) Stats() (hotCount, coldCount, frozenCount, watchedDirs
 	return
 }
 
+// offerResync sends an adapter.EventResync if events were dropped since
+// the last one was delivered, so the consumer can reload what it missed.
+func (tw *TieredWatcher) offerResync() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.offerWarningLocked()
+	dropped := tw.dropStatsLocked().Dropped()
+	if tw.closed || dropped == tw.resynced {
+		return
+	}
+	select {
+	case tw.events <- adapter.Event{Type: adapter.EventResync}:
+		tw.resynced = dropped
+		tw.drops.Resyncs++
+	default:
+		// Still full; try again next interval
+	}
+}
+
+// DropStats returns the watcher's dropped-event counters.
+func (tw *TieredWatcher) DropStats() DropStats {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.dropStatsLocked()
+}
+
+func (tw *TieredWatcher) dropStatsLocked() DropStats {
+	d := tw.drops
+	d.Notifications = tw.watcher.Dropped()
+	return d
+}
+
+// PollOnlyDirs returns the directories polled instead of watched, mapped
+// to why: "config" for Config.PollPaths, otherwise the filesystem type.
+func (tw *TieredWatcher) PollOnlyDirs() map[string]string {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	dirs := make(map[string]string)
+	for dir, reason := range tw.pollDirs {
+		if reason != "" {
+			dirs[dir] = reason
+		}
+	}
+	return dirs
+}
+
+// MaxHot returns the cap on HOT sessions.
+func (tw *TieredWatcher) MaxHot() int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.maxHot
+}
+
+// Assignments returns every session's tier, HOT first, then most recently
+// active first.
+func (tw *TieredWatcher) Assignments() []Assignment {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	out := make([]Assignment, 0, len(tw.sessions))
+	for id, info := range tw.sessions {
+		tier := TierCold
+		switch {
+		case tw.isHotLocked(id):
+			tier = TierHot
+		case info.Frozen:
+			tier = TierFrozen
+		}
+		out = append(out, Assignment{SessionID: id, Path: info.Path, Tier: tier, LastActive: tw.activityTime(info)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if hi, hj := out[i].Tier == TierHot, out[j].Tier == TierHot; hi != hj {
+			return hi
+		}
+		return out[i].LastActive.After(out[j].LastActive)
+	})
+	return out
+}
+
 // TieredCloser wraps TieredWatcher to implement io.Closer.
 type TieredCloser struct {
 	tw *TieredWatcher
@@ -719,14 +999,20 @@ type Manager struct {
 	events   chan adapter.Event
 	closers  []io.Closer
 	closed   bool
+	done     chan struct{}
+	drops    DropStats // events dropped forwarding to or from the merged channel
+	resynced int64     // drops covered by the last resync event
 }
 
 // NewManager creates a new tiered watcher manager.
 func NewManager() *Manager {
-	return &Manager{
+	m := &Manager{
 		watchers: make(map[string]*TieredWatcher),
 		events:   make(chan adapter.Event, 64),
+		done:     make(chan struct{}),
 	}
+	go m.resyncLoop()
+	return m
 }
 
 // AddWatcher adds a tiered watcher for an adapter and starts forwarding its events.
@@ -745,19 +1031,71 @@ func (m *Manager) AddWatcher(adapterID string, tw *TieredWatcher, ch <-chan adap
 	go func() {
 		for evt := range ch {
 			m.mu.Lock()
-			closed := m.closed
-			m.mu.Unlock()
-			if closed {
+			if m.closed {
+				m.mu.Unlock()
 				return
 			}
 			select {
 			case m.events <- evt:
 			default:
+				m.drops.Events++
 			}
+			m.mu.Unlock()
 		}
 	}()
 }
 
+// ReportDropped records an event the consumer dropped after reading it
+// from Events, so a resync event follows.
+func (m *Manager) ReportDropped() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.drops.Events++
+}
+
+// resyncLoop offers a resync event on the merged channel after drops.
+func (m *Manager) resyncLoop() {
+	ticker := time.NewTicker(ResyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.offerResync()
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// offerResync sends a resync event if forwarding dropped events since the
+// last one was delivered.
+func (m *Manager) offerResync() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed || m.drops.Events == m.resynced {
+		return
+	}
+	select {
+	case m.events <- adapter.Event{Type: adapter.EventResync}:
+		m.resynced = m.drops.Events
+		m.drops.Resyncs++
+	default:
+	}
+}
+
+// DropStats returns dropped-event counters across the manager and all
+// its watchers.
+func (m *Manager) DropStats() DropStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.drops
+	for _, tw := range m.watchers {
+		stats = stats.add(tw.DropStats())
+	}
+	return stats
+}
+
 // Events returns the merged event channel.
 func (m *Manager) Events() <-chan adapter.Event {
 	return m.events
@@ -791,6 +1129,16 @@ func (m *Manager) SetHotTarget(adapterID string, target int) {
 	}
 }
 
+// SetPolicy sets the tier policy for a specific adapter.
+func (m *Manager) SetPolicy(adapterID string, p Policy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if tw, ok := m.watchers[adapterID]; ok {
+		tw.SetPolicy(p)
+	}
+}
+
 // RegisterSession registers a session with the appropriate watcher.
 func (m *Manager) RegisterSession(adapterID, sessionID, path string) {
 	m.mu.Lock()
@@ -834,6 +1182,31 @@ func (m *Manager) Stats() (hotCount, coldCount, frozenCount, watchedDirs int) {
 	return
 }
 
+// Assignments returns each adapter's session tiers.
+func (m *Manager) Assignments() map[string][]Assignment {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string][]Assignment, len(m.watchers))
+	for adapterID, tw := range m.watchers {
+		out[adapterID] = tw.Assignments()
+	}
+	return out
+}
+
+// PollOnlyDirs returns the directories polled instead of watched across
+// all watchers; see TieredWatcher.PollOnlyDirs.
+func (m *Manager) PollOnlyDirs() map[string]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dirs := make(map[string]string)
+	for _, tw := range m.watchers {
+		maps.Copy(dirs, tw.PollOnlyDirs())
+	}
+	return dirs
+}
+
 // Close shuts down all watchers.
 func (m *Manager) Close() error {
 	m.mu.Lock()
@@ -843,6 +1216,7 @@ func (m *Manager) Close() error {
 	}
 	m.closed = true
 	closers := m.closers
+	close(m.done)
 	m.mu.Unlock()
 
 	for _, c := range closers {
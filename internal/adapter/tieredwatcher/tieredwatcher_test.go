@@ -620,10 +620,11 @@ func TestBatchReadDirPollDetectsChanges(t *testing.T) {
 
 	tw.pollColdSessions()
 
-	// Drain events and check for session-b update
+	// Drain events and check for session-b update, which arrives after
+	// the batch window
 	found := false
-	timeout := time.After(100 * time.Millisecond)
-	for {
+	timeout := time.After(time.Second)
+	for !found {
 		select {
 		case evt := <-ch:
 			if evt.SessionID == "session-b" {
@@ -638,3 +639,95 @@ done:
 		t.Error("expected update event for session-b after modification")
 	}
 }
+
+func newPolicyWatcher(t *testing.T, p Policy, ids ...string) *TieredWatcher {
+	t.Helper()
+	tmpDir := t.TempDir()
+	tw, _, err := New(Config{RootDir: tmpDir, FilePattern: ".jsonl", Policy: p})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	t.Cleanup(func() { _ = tw.Close() })
+	for _, id := range ids {
+		path := filepath.Join(tmpDir, id+".jsonl")
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatalf("WriteFile error: %v", err)
+		}
+		tw.RegisterSession(id, path)
+	}
+	return tw
+}
+
+func (tw *TieredWatcher) noteActivity(ids ...string) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	for _, id := range ids {
+		tw.noteActivityLocked(id)
+	}
+}
+
+func hotTiers(tw *TieredWatcher) map[string]bool {
+	hot := make(map[string]bool)
+	for _, a := range tw.Assignments() {
+		if a.Tier == TierHot {
+			hot[a.SessionID] = true
+		}
+	}
+	return hot
+}
+
+func TestPolicy_EventsPromoteBeyondTarget(t *testing.T) {
+	tw := newPolicyWatcher(t, Policy{}, "a", "b", "c", "d")
+	tw.SetHotTarget(1)
+
+	tw.noteActivity("b", "c")
+	if hot := hotTiers(tw); !hot["b"] || !hot["c"] {
+		t.Errorf("hot = %v; sessions with changes should be promoted", hot)
+	}
+
+	// Recomputing the target keeps recently changed sessions HOT
+	tw.SetHotTarget(1)
+	if hot := hotTiers(tw); len(hot) != 2 || !hot["b"] || !hot["c"] {
+		t.Errorf("hot after rebuild = %v; want b and c", hot)
+	}
+}
+
+func TestPolicy_IdleDemotion(t *testing.T) {
+	tw := newPolicyWatcher(t, Policy{IdleTimeout: 20 * time.Millisecond}, "a", "b")
+	tw.noteActivity("a")
+	if !hotTiers(tw)["a"] {
+		t.Fatal("a should be HOT after a change")
+	}
+
+	time.Sleep(40 * time.Millisecond)
+	tw.demoteInactive()
+	if hot := hotTiers(tw); len(hot) != 0 {
+		t.Errorf("hot = %v; idle sessions should be demoted", hot)
+	}
+}
+
+func TestPolicy_MaxHotAndPollOnly(t *testing.T) {
+	tw := newPolicyWatcher(t, Policy{MaxHot: 2}, "a", "b", "c")
+	tw.noteActivity("a", "b", "c")
+	if hot := hotTiers(tw); len(hot) != 2 {
+		t.Errorf("hot = %v; want 2 (MaxHot)", hot)
+	}
+	if tw.MaxHot() != 2 {
+		t.Errorf("MaxHot = %d; want 2", tw.MaxHot())
+	}
+
+	tw.SetPolicy(Policy{PollOnly: true})
+	tw.noteActivity("a")
+	if hot := hotTiers(tw); len(hot) != 0 {
+		t.Errorf("hot = %v; PollOnly should keep sessions COLD", hot)
+	}
+}
+
+func TestFDBudgetMaxHot(t *testing.T) {
+	if got := fdBudgetMaxHot(); got < minMaxHot || got > defaultMaxHot {
+		t.Errorf("fdBudgetMaxHot = %d; want within [%d, %d]", got, minMaxHot, defaultMaxHot)
+	}
+	if got := (Policy{}).maxHot(); got != fdBudgetMaxHot() {
+		t.Errorf("default MaxHot = %d; want the fd budget %d", got, fdBudgetMaxHot())
+	}
+}
@@ -0,0 +1,86 @@
+package adapter
+
+import (
+	"unicode/utf8"
+
+	"github.com/wilbur182/forge/internal/adapter/cache"
+)
+
+// toolOutputPreview is how much of a stored tool output stays inline.
+const toolOutputPreview = 2 * 1024
+
+// FullOutput returns the complete tool output. When OutputRef is set,
+// Output holds only a preview and the rest is loaded from the blob store.
+func (t ToolUse) FullOutput() string {
+	return fullOutput(t.Output, t.OutputRef)
+}
+
+// FullToolOutput returns the complete tool output of a tool block, like
+// ToolUse.FullOutput.
+func (b ContentBlock) FullToolOutput() string {
+	return fullOutput(b.ToolOutput, b.ToolOutputRef)
+}
+
+func fullOutput(inline, ref string) string {
+	if ref == "" {
+		return inline
+	}
+	if data, ok := cache.Blob(ref); ok {
+		return data
+	}
+	return inline
+}
+
+// ToolOutputsAvailable reports whether the full outputs of the compacted
+// tool results in msgs can still be loaded. Outputs kept only in memory
+// are lost when evicted; caches holding messages for which this is false
+// should re-parse the session to recover them.
+func ToolOutputsAvailable(msgs []Message) bool {
+	if cache.BlobsLost() == 0 {
+		return true
+	}
+	for i := range msgs {
+		for _, tu := range msgs[i].ToolUses {
+			if tu.OutputRef != "" && !cache.HasBlob(tu.OutputRef) {
+				return false
+			}
+		}
+		for _, b := range msgs[i].ContentBlocks {
+			if b.ToolOutputRef != "" && !cache.HasBlob(b.ToolOutputRef) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CompactToolOutputs moves tool outputs of at least cache.BlobThreshold
+// bytes into the content-addressed blob store, keeping a preview inline.
+// Identical outputs, such as a result linked to both a ToolUse and its
+// content block, then share one stored copy. Safe to call again on
+// messages it already compacted.
+func CompactToolOutputs(msgs []Message) {
+	for i := range msgs {
+		for j := range msgs[i].ToolUses {
+			tu := &msgs[i].ToolUses[j]
+			tu.Output, tu.OutputRef = compactOutput(tu.Output, tu.OutputRef)
+		}
+		for j := range msgs[i].ContentBlocks {
+			b := &msgs[i].ContentBlocks[j]
+			b.ToolOutput, b.ToolOutputRef = compactOutput(b.ToolOutput, b.ToolOutputRef)
+		}
+	}
+}
+
+func compactOutput(output, ref string) (string, string) {
+	if ref != "" || len(output) < cache.BlobThreshold {
+		return output, ref
+	}
+	ref = cache.PutBlob(output)
+	cut := toolOutputPreview
+	for cut > 0 && !utf8.RuneStart(output[cut]) {
+		cut--
+	}
+	// Copy so the preview doesn't pin the full output in memory.
+	return string([]byte(output[:cut])), ref
+}
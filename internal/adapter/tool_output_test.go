@@ -0,0 +1,68 @@
+package adapter
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/wilbur182/forge/internal/adapter/cache"
+)
+
+func TestCompactToolOutputs(t *testing.T) {
+	large := strings.Repeat("héllo wörld\n", cache.BlobThreshold/8)
+	msgs := []Message{{
+		ToolUses: []ToolUse{
+			{ID: "a", Output: large},
+			{ID: "b", Output: "short"},
+		},
+		ContentBlocks: []ContentBlock{
+			{Type: "tool_use", ToolUseID: "a", ToolOutput: large},
+		},
+	}}
+
+	CompactToolOutputs(msgs)
+
+	tu := msgs[0].ToolUses[0]
+	if tu.OutputRef == "" || len(tu.Output) > toolOutputPreview {
+		t.Fatalf("large output not compacted: ref=%q, %d bytes inline", tu.OutputRef, len(tu.Output))
+	}
+	if !utf8.ValidString(tu.Output) || !strings.HasPrefix(large, tu.Output) {
+		t.Error("preview should be a rune-safe prefix of the output")
+	}
+	if tu.FullOutput() != large {
+		t.Error("FullOutput should return the complete output")
+	}
+	block := msgs[0].ContentBlocks[0]
+	if block.ToolOutputRef != tu.OutputRef {
+		t.Error("identical outputs should share one blob")
+	}
+	if block.FullToolOutput() != large {
+		t.Error("FullToolOutput should return the complete output")
+	}
+	if small := msgs[0].ToolUses[1]; small.OutputRef != "" || small.FullOutput() != "short" {
+		t.Errorf("small output should stay inline, got %+v", small)
+	}
+
+	// Compacting again leaves the preview and ref alone
+	CompactToolOutputs(msgs)
+	if msgs[0].ToolUses[0] != tu {
+		t.Error("CompactToolOutputs should be idempotent")
+	}
+}
+
+func TestToolOutputsAvailable(t *testing.T) {
+	large := strings.Repeat("evicted ", cache.BlobThreshold)
+	msgs := []Message{{ToolUses: []ToolUse{{ID: "a", Output: large}}}}
+	CompactToolOutputs(msgs)
+	if !ToolOutputsAvailable(msgs) {
+		t.Fatal("fresh output should be available")
+	}
+
+	// Without a persistent store, pushing other outputs through evicts it
+	for i := range 8 {
+		cache.PutBlob(strings.Repeat(string(rune('a'+i)), 2*1024*1024))
+	}
+	if ToolOutputsAvailable(msgs) {
+		t.Error("evicted in-memory output should be reported missing")
+	}
+}
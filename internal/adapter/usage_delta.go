@@ -0,0 +1,99 @@
+package adapter
+
+import (
+	"sync"
+	"time"
+)
+
+// UsageSnapshot is a session's cumulative usage at a point in time.
+type UsageSnapshot struct {
+	Tokens      int            // matches Session.TotalTokens
+	Cost        float64        // matches Session.EstCost
+	Messages    int            // matches Session.MessageCount
+	ModelTokens map[string]int // input+output tokens by raw model ID; nil if unknown
+	UpdatedAt   time.Time
+}
+
+// UsageSnapshotter is implemented by adapters that can report a session's
+// usage cheaply, typically from an incremental metadata parse that only
+// reads bytes appended since the previous call.
+type UsageSnapshotter interface {
+	UsageSnapshot(sessionID string) (UsageSnapshot, bool)
+}
+
+// UsageDelta is carried in Event.Data when the change in a session's usage
+// since the previous event is known, so consumers can update aggregates
+// without re-reading sessions.
+type UsageDelta struct {
+	SessionID   string
+	Tokens      int
+	Cost        float64
+	Messages    int
+	ModelTokens map[string]int // nil when the baseline had no per-model data
+	At          time.Time      // session's UpdatedAt after the change
+	New         bool           // session was not in the baseline
+}
+
+// IsZero reports whether the delta carries no change.
+func (d UsageDelta) IsZero() bool {
+	return !d.New && d.Tokens == 0 && d.Cost == 0 && d.Messages == 0 && len(d.ModelTokens) == 0
+}
+
+// UsageTracker turns successive usage snapshots into deltas. Safe for
+// concurrent use.
+type UsageTracker struct {
+	mu   sync.Mutex
+	last map[string]UsageSnapshot
+}
+
+// NewUsageTracker creates an empty tracker.
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{last: make(map[string]UsageSnapshot)}
+}
+
+// Seed sets the baseline for a session, typically from the session list an
+// aggregate was computed from. A nil ModelTokens marks per-model usage as
+// unknown; the next Observe then adopts it without reporting model deltas.
+func (t *UsageTracker) Seed(sessionID string, s UsageSnapshot) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.last[sessionID] = s
+}
+
+// Reset drops all baselines.
+func (t *UsageTracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.last = make(map[string]UsageSnapshot)
+}
+
+// Observe records a new snapshot and returns the change since the baseline.
+// Sessions without a baseline are reported whole with New set.
+func (t *UsageTracker) Observe(sessionID string, s UsageSnapshot) UsageDelta {
+	t.mu.Lock()
+	prev, ok := t.last[sessionID]
+	t.last[sessionID] = s
+	t.mu.Unlock()
+
+	d := UsageDelta{SessionID: sessionID, At: s.UpdatedAt}
+	if !ok {
+		d.New = true
+		d.Tokens, d.Cost, d.Messages = s.Tokens, s.Cost, s.Messages
+		d.ModelTokens = s.ModelTokens
+		return d
+	}
+	d.Tokens = s.Tokens - prev.Tokens
+	d.Cost = s.Cost - prev.Cost
+	d.Messages = s.Messages - prev.Messages
+	if prev.ModelTokens != nil {
+		for model, n := range s.ModelTokens {
+			if diff := n - prev.ModelTokens[model]; diff != 0 {
+				if d.ModelTokens == nil {
+					d.ModelTokens = make(map[string]int)
+				}
+				d.ModelTokens[model] = diff
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,45 @@
+package adapter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUsageTracker_Observe(t *testing.T) {
+	tr := NewUsageTracker()
+
+	d := tr.Observe("s", UsageSnapshot{Tokens: 100, Cost: 1, Messages: 2, ModelTokens: map[string]int{"m": 100}})
+	if !d.New || d.Tokens != 100 || d.Messages != 2 || d.ModelTokens["m"] != 100 {
+		t.Errorf("first observe = %+v, want whole snapshot marked New", d)
+	}
+
+	d = tr.Observe("s", UsageSnapshot{Tokens: 130, Cost: 1.5, Messages: 3, ModelTokens: map[string]int{"m": 110, "n": 20}})
+	want := UsageDelta{SessionID: "s", Tokens: 30, Cost: 0.5, Messages: 1, ModelTokens: map[string]int{"m": 10, "n": 20}}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("delta = %+v, want %+v", d, want)
+	}
+
+	if d := tr.Observe("s", UsageSnapshot{Tokens: 130, Cost: 1.5, Messages: 3, ModelTokens: map[string]int{"m": 110, "n": 20}}); !d.IsZero() {
+		t.Errorf("unchanged snapshot delta = %+v, want zero", d)
+	}
+}
+
+func TestUsageTracker_SeedWithoutModels(t *testing.T) {
+	tr := NewUsageTracker()
+	tr.Seed("s", UsageSnapshot{Tokens: 100})
+
+	// Seeded totals without per-model data: model deltas are unknown
+	d := tr.Observe("s", UsageSnapshot{Tokens: 120, ModelTokens: map[string]int{"m": 120}})
+	if d.New || d.Tokens != 20 || d.ModelTokens != nil {
+		t.Errorf("delta = %+v, want +20 tokens without model deltas", d)
+	}
+	d = tr.Observe("s", UsageSnapshot{Tokens: 125, ModelTokens: map[string]int{"m": 125}})
+	if d.ModelTokens["m"] != 5 {
+		t.Errorf("model delta = %+v, want m=5", d.ModelTokens)
+	}
+
+	tr.Reset()
+	if d := tr.Observe("s", UsageSnapshot{Tokens: 1}); !d.New {
+		t.Error("Reset should drop baselines")
+	}
+}
@@ -0,0 +1,65 @@
+package adapter
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+)
+
+// Adapter API versions. Bump APIVersion when the Adapter contract changes;
+// raise MinAPIVersion only when dropping support for older adapters.
+const (
+	APIVersion    = 1
+	MinAPIVersion = 1
+)
+
+// Versioned is implemented by adapters that declare the API version they
+// were built against. Adapters without it are assumed to match APIVersion.
+type Versioned interface {
+	APIVersion() int
+}
+
+var (
+	incompatibleMu sync.Mutex
+	incompatible   = make(map[string]string) // adapter ID -> reason
+)
+
+// CheckVersion reports whether the host can load an adapter.
+func CheckVersion(a Adapter) error {
+	v, ok := a.(Versioned)
+	if !ok {
+		return nil
+	}
+	switch version := v.APIVersion(); {
+	case version > APIVersion:
+		return fmt.Errorf("requires adapter API v%d (this build supports v%d-v%d)", version, MinAPIVersion, APIVersion)
+	case version < MinAPIVersion:
+		return fmt.Errorf("built for adapter API v%d, no longer supported (minimum v%d)", version, MinAPIVersion)
+	}
+	return nil
+}
+
+// compatible checks an adapter's version, recording it as incompatible if
+// the host can't load it.
+func compatible(a Adapter) bool {
+	err := CheckVersion(a)
+	if err == nil {
+		return true
+	}
+	incompatibleMu.Lock()
+	defer incompatibleMu.Unlock()
+	if _, seen := incompatible[a.ID()]; !seen {
+		slog.Warn("adapter skipped", "id", a.ID(), "reason", err)
+	}
+	incompatible[a.ID()] = err.Error()
+	return false
+}
+
+// Incompatible returns adapters skipped because of their API version,
+// keyed by adapter ID.
+func Incompatible() map[string]string {
+	incompatibleMu.Lock()
+	defer incompatibleMu.Unlock()
+	return maps.Clone(incompatible)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/features"
+)
+
+const (
+	// borderAnimInterval is how often the active border's hue advances.
+	// Slow enough to keep redraws cheap, fast enough to look continuous.
+	borderAnimInterval = 200 * time.Millisecond
+
+	// borderHueCycle is the time for one full trip around the color wheel.
+	borderHueCycle = time.Minute
+)
+
+// borderAnimTickMsg advances the active border hue shift.
+type borderAnimTickMsg time.Time
+
+// borderAnimTick schedules the next hue step, or nothing when the
+// animated_borders feature is off.
+func borderAnimTick() tea.Cmd {
+	if !features.IsEnabled(features.AnimatedBorders.Name) {
+		return nil
+	}
+	return tea.Tick(borderAnimInterval, func(t time.Time) tea.Msg {
+		return borderAnimTickMsg(t)
+	})
+}
+
+// borderHueAt returns the hue shift for t. It is derived from the wall clock
+// so the animation needs no state and stays in step across instances.
+func borderHueAt(t time.Time) float64 {
+	phase := t.UnixMilli() % borderHueCycle.Milliseconds()
+	return float64(phase) / float64(borderHueCycle.Milliseconds()) * 360
+}
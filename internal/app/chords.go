@@ -0,0 +1,282 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// chordTimeoutMsg ends a pending chord that was not continued in time.
+type chordTimeoutMsg struct {
+	seq int // chord key press that scheduled the timeout
+}
+
+// whichKeyMsg shows the continuations of a chord still pending after the
+// which-key delay.
+type whichKeyMsg struct {
+	seq int // chord key press that scheduled the popup
+}
+
+// handleKeymap resolves a key against keymap chords, the keymap profile,
+// and user overrides. Returns true when the key was consumed.
+func (m *Model) handleKeymap(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if m.keymapBypass {
+		return false, nil
+	}
+	if m.keymap.HasPending() {
+		if msg.Type == tea.KeyEsc {
+			m.keymap.ResetPending()
+			m.showWhichKey = false
+			return true, nil
+		}
+		// "?" after a prefix lists its continuations, unless it continues it
+		if keymap.KeyString(msg) == "?" && !m.showWhichKey && !continuesChord(m.keymap.Continuations(m.activeContext), "?") {
+			m.openWhichKey()
+			return true, nil
+		}
+	}
+
+	res := m.keymap.Resolve(msg, m.activeContext)
+	var cmds []tea.Cmd
+	if len(res.Replay) > 0 {
+		// Keys of an abandoned chord go first, as typed
+		cmds = append(cmds, m.dispatchKeys(parseKeys(res.Replay)))
+	}
+
+	if res.Pending {
+		m.chordSeq++
+		if m.showWhichKey {
+			// The popup now lists the longer chord's continuations
+			return true, tea.Batch(cmds...)
+		}
+		seq := m.chordSeq
+		cmds = append(cmds, tea.Tick(m.keymap.SequenceTimeout(), func(time.Time) tea.Msg {
+			return chordTimeoutMsg{seq: seq}
+		}))
+		if delay := m.keymap.WhichKeyDelay(); delay > 0 {
+			cmds = append(cmds, tea.Tick(delay, func(time.Time) tea.Msg {
+				return whichKeyMsg{seq: seq}
+			}))
+		}
+		return true, tea.Batch(cmds...)
+	}
+	m.showWhichKey = false
+	if cmd, ok := m.runResolution(res); ok {
+		return true, tea.Sequence(append(cmds, cmd)...)
+	}
+	if strings.Contains(res.Keys, " ") {
+		// A chord was typed on purpose; nothing to run here
+		return true, tea.Sequence(cmds...)
+	}
+	if len(cmds) > 0 {
+		// Keep the key after the replayed ones
+		cmds = append(cmds, m.dispatchKeys([]tea.KeyMsg{msg}))
+		return true, tea.Sequence(cmds...)
+	}
+	// A remapped key whose command isn't bound here keeps its meaning
+	return false, nil
+}
+
+// handleChordTimeout ends the pending chord if no key continued it since
+// the timeout was scheduled. Keys that are bound on their own run their
+// command; otherwise they are handled as typed.
+func (m *Model) handleChordTimeout(msg chordTimeoutMsg) tea.Cmd {
+	if msg.seq != m.chordSeq || m.showWhichKey {
+		return nil
+	}
+	res := m.keymap.ExpirePending(m.activeContext)
+	if len(res.Replay) > 0 {
+		return m.dispatchKeys(parseKeys(res.Replay))
+	}
+	cmd, _ := m.runResolution(res)
+	return cmd
+}
+
+// handleWhichKey shows the which-key popup if the chord that scheduled it
+// is still pending.
+func (m *Model) handleWhichKey(msg whichKeyMsg) {
+	if msg.seq == m.chordSeq && m.keymap.HasPending() {
+		m.openWhichKey()
+	}
+}
+
+// openWhichKey shows the continuations of the pending chord. The chord
+// waits without timing out until a key continues or abandons it.
+func (m *Model) openWhichKey() {
+	m.keymap.HoldPending()
+	m.showWhichKey = true
+}
+
+// continuesChord reports whether key is among conts.
+func continuesChord(conts []keymap.Continuation, key string) bool {
+	for _, c := range conts {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// runResolution runs a resolved command: its handler's result if it has
+// one, otherwise the keys of its default binding in the active context,
+// sent as if typed. Returns false when the command can't run here.
+func (m *Model) runResolution(res keymap.Resolution) (tea.Cmd, bool) {
+	if res.Cmd != nil {
+		return res.Cmd, true
+	}
+	if res.Command == "" {
+		return nil, false
+	}
+	keys := m.keymap.KeysForCommand(res.Command, m.activeContext)
+	if len(keys) == 0 {
+		return nil, false
+	}
+	return m.dispatchKeys(parseKeys(keys)), true
+}
+
+// runPaletteCommand runs a command picked in the palette. A command from
+// another plugin's view focuses that plugin first. The command then runs
+// by its handler, or by the keys of its binding in the focused view, sent
+// as if typed.
+func (m *Model) runPaletteCommand(commandID, context string) tea.Cmd {
+	if cmd, ok := m.keymap.GetCommand(commandID); ok && cmd.Handler != nil {
+		return cmd.Handler()
+	}
+
+	var cmds []tea.Cmd
+	if context != "" && context != "global" && context != m.activeContext {
+		if idx := m.pluginForContext(context); idx >= 0 && idx != m.activePlugin {
+			cmds = append(cmds, m.SetActivePlugin(idx))
+		}
+	}
+	if p := m.ActivePlugin(); p != nil {
+		for _, c := range p.Commands() {
+			if c.ID == commandID && c.Context == context && c.Handler != nil {
+				return tea.Sequence(append(cmds, c.Handler())...)
+			}
+		}
+	}
+
+	// The view the binding belongs to may not be open after switching
+	if cmd, ok := m.runResolution(keymap.Resolution{Command: commandID}); ok {
+		return tea.Sequence(append(cmds, cmd)...)
+	}
+	m.ShowToast(fmt.Sprintf("%s runs in %s; open that view first", commandID, context), 3*time.Second)
+	m.statusIsError = false
+	return tea.Batch(cmds...)
+}
+
+// pluginForContext returns the index of the plugin owning a focus
+// context: the plugin with commands in it, or else the one whose ID
+// prefixes it, e.g. "git-status" for "git-status-diff". Returns -1 when no
+// plugin owns it.
+func (m *Model) pluginForContext(context string) int {
+	plugins := m.registry.Plugins()
+	for i, p := range plugins {
+		for _, c := range p.Commands() {
+			if c.Context == context {
+				return i
+			}
+		}
+	}
+	for i, p := range plugins {
+		if context == p.ID() || strings.HasPrefix(context, p.ID()+"-") {
+			return i
+		}
+	}
+	return -1
+}
+
+// dispatchKeys handles keys as if typed, skipping keymap resolution so a
+// remapped key can't be remapped again.
+func (m *Model) dispatchKeys(keys []tea.KeyMsg) tea.Cmd {
+	m.keymapBypass = true
+	defer func() { m.keymapBypass = false }()
+
+	var cmds []tea.Cmd
+	for _, k := range keys {
+		_, cmd := m.handleKeyMsg(k)
+		cmds = append(cmds, cmd)
+	}
+	return tea.Sequence(cmds...)
+}
+
+// parseKeys converts key names to key messages.
+func parseKeys(names []string) []tea.KeyMsg {
+	keys := make([]tea.KeyMsg, len(names))
+	for i, name := range names {
+		keys[i] = keymap.ParseKey(name)
+	}
+	return keys
+}
+
+// chordStatus is the footer indicator for a pending chord, e.g. "space w …".
+func (m Model) chordStatus() string {
+	keys := m.keymap.Pending()
+	if len(keys) == 0 {
+		return ""
+	}
+	return strings.Join(keys, " ") + " …"
+}
+
+// renderWhichKey overlays the continuations of the pending chord, laid
+// out in as many columns as fit.
+func (m Model) renderWhichKey(content string) string {
+	keys := m.keymap.Pending()
+	if len(keys) == 0 {
+		return content
+	}
+	conts := m.keymap.Continuations(m.activeContext)
+
+	entries := make([]string, len(conts))
+	entryW := 0
+	for i, c := range conts {
+		desc := c.Command
+		if desc == "" {
+			desc = "+more"
+		} else if c.Prefix {
+			desc += " +"
+		}
+		entries[i] = styles.Current().KeyHint.Render(c.Key) + " " + styles.Current().Muted.Render(desc)
+		entryW = max(entryW, lipgloss.Width(entries[i]))
+	}
+
+	var b strings.Builder
+	b.WriteString(styles.Current().ModalTitle.Render(strings.Join(keys, " ") + " …"))
+	b.WriteString("\n\n")
+	if len(entries) == 0 {
+		b.WriteString(styles.Current().Muted.Render("No continuations here"))
+	} else {
+		colW := entryW + 3
+		cols := max(1, min(len(entries), (m.width-8)/colW))
+		rows := (len(entries) + cols - 1) / cols
+		for r := 0; r < rows; r++ {
+			if r > 0 {
+				b.WriteString("\n")
+			}
+			for c := 0; c < cols; c++ {
+				i := c*rows + r
+				if i >= len(entries) {
+					break
+				}
+				cell := entries[i]
+				if c < cols-1 {
+					cell += strings.Repeat(" ", colW-lipgloss.Width(cell))
+				}
+				b.WriteString(cell)
+			}
+		}
+	}
+	b.WriteString("\n\n")
+	b.WriteString(styles.Current().KeyHint.Render("esc"))
+	b.WriteString(styles.Current().Muted.Render(" cancel"))
+
+	popup := styles.Current().ModalBox.Render(b.String())
+	return ui.OverlayModal(content, popup, m.width, m.height)
+}
@@ -0,0 +1,205 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/palette"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestLeaderChordRunsRemappedCommand(t *testing.T) {
+	rp := &resizablePlugin{width: 30}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(rp); err != nil {
+		t.Fatal(err)
+	}
+	km := keymap.NewRegistry()
+	keymap.RegisterDefaults(km)
+	km.SetLeader("space")
+	km.SetUserOverride("<leader> p r", "resize-pane")
+	m := &Model{registry: reg, keymap: km, ui: &UIState{}, width: 120}
+	m.updateContext()
+
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m.handleKeyMsg(runeKey('p'))
+	if footer := m.renderFooter(); !strings.Contains(footer, "space p …") {
+		t.Errorf("footer missing pending chord: %q", footer)
+	}
+
+	// The chord sends resize-pane's default key, "="
+	m.handleKeyMsg(runeKey('r'))
+	if !m.resizeMode || km.HasPending() {
+		t.Fatalf("chord should enter resize mode, mode=%v pending=%v", m.resizeMode, km.HasPending())
+	}
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Esc cancels a pending chord without reaching the plugin
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if km.HasPending() || m.chordStatus() != "" {
+		t.Error("esc should cancel the chord")
+	}
+
+	// A stale timeout is ignored; the current one ends the chord
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m.handleChordTimeout(chordTimeoutMsg{seq: m.chordSeq - 1})
+	if !km.HasPending() {
+		t.Error("stale timeout ended the chord")
+	}
+	m.handleChordTimeout(chordTimeoutMsg{seq: m.chordSeq})
+	if km.HasPending() {
+		t.Error("timeout should end the chord")
+	}
+}
+
+func TestAbandonedChordKeysAreReplayed(t *testing.T) {
+	rp := &resizablePlugin{width: 30}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(rp); err != nil {
+		t.Fatal(err)
+	}
+	km := keymap.NewRegistry()
+	keymap.RegisterDefaults(km)
+	if err := km.SetProfile("emacs"); err != nil {
+		t.Fatal(err)
+	}
+	m := &Model{registry: reg, keymap: km, ui: &UIState{}, width: 120}
+	m.updateContext()
+
+	// "ctrl+x" waits for "o"; "=" abandons the chord and still acts
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyCtrlX})
+	if !km.HasPending() {
+		t.Fatal("ctrl+x should start a chord")
+	}
+	m.handleKeyMsg(runeKey('='))
+	if !m.resizeMode || km.HasPending() {
+		t.Errorf("= after abandoned chord: mode=%v pending=%v", m.resizeMode, km.HasPending())
+	}
+}
+
+func TestWhichKeyPopup(t *testing.T) {
+	rp := &resizablePlugin{width: 30}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(rp); err != nil {
+		t.Fatal(err)
+	}
+	km := keymap.NewRegistry()
+	keymap.RegisterDefaults(km)
+	km.SetLeader("space")
+	km.SetUserOverride("<leader> p r", "resize-pane")
+	km.SetUserOverride("<leader> t", "switch-theme")
+	m := &Model{registry: reg, keymap: km, ui: &UIState{}, width: 120, height: 40}
+	m.updateContext()
+
+	// "?" after a prefix lists its continuations and holds the chord
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m.handleKeyMsg(runeKey('?'))
+	if !m.showWhichKey || !km.HasPending() {
+		t.Fatalf("popup=%v pending=%v", m.showWhichKey, km.HasPending())
+	}
+	view := m.renderWhichKey("")
+	for _, want := range []string{"space …", "p", "+more", "switch-theme"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("popup missing %q:\n%s", want, view)
+		}
+	}
+	m.handleChordTimeout(chordTimeoutMsg{seq: m.chordSeq})
+	if !km.HasPending() {
+		t.Error("timeout ended a chord shown in the popup")
+	}
+
+	// Continuing updates the popup; completing closes it
+	m.handleKeyMsg(runeKey('p'))
+	if !m.showWhichKey || !strings.Contains(m.renderWhichKey(""), "resize-pane") {
+		t.Fatal("popup should list the longer chord's continuations")
+	}
+	m.handleKeyMsg(runeKey('r'))
+	if m.showWhichKey || !m.resizeMode {
+		t.Errorf("popup=%v resize=%v after completing the chord", m.showWhichKey, m.resizeMode)
+	}
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// The delay shows the popup once the chord has waited long enough
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m.handleWhichKey(whichKeyMsg{seq: m.chordSeq - 1})
+	if m.showWhichKey {
+		t.Error("stale which-key delay opened the popup")
+	}
+	m.handleWhichKey(whichKeyMsg{seq: m.chordSeq})
+	if !m.showWhichKey {
+		t.Error("which-key delay should open the popup")
+	}
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showWhichKey || km.HasPending() {
+		t.Error("esc should close the popup and cancel the chord")
+	}
+}
+
+// keyPlugin records the keys it receives.
+type keyPlugin struct {
+	id, context string
+	commands    []plugin.Command
+	keys        []string
+	focused     bool
+}
+
+func (p *keyPlugin) ID() string                 { return p.id }
+func (p *keyPlugin) Name() string               { return p.id }
+func (p *keyPlugin) Icon() string               { return "" }
+func (p *keyPlugin) Init(*plugin.Context) error { return nil }
+func (p *keyPlugin) Start() tea.Cmd             { return nil }
+func (p *keyPlugin) Stop()                      {}
+func (p *keyPlugin) View(int, int) string       { return "" }
+func (p *keyPlugin) IsFocused() bool            { return p.focused }
+func (p *keyPlugin) SetFocused(f bool)          { p.focused = f }
+func (p *keyPlugin) Commands() []plugin.Command { return p.commands }
+func (p *keyPlugin) FocusContext() string       { return p.context }
+func (p *keyPlugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
+	if k, ok := msg.(tea.KeyMsg); ok {
+		p.keys = append(p.keys, k.String())
+	}
+	return p, nil
+}
+
+func TestPaletteRunsCommandsInTheirPlugin(t *testing.T) {
+	files := &keyPlugin{id: "files", context: "files-tree"}
+	git := &keyPlugin{id: "git", context: "git-status", commands: []plugin.Command{
+		{ID: "stage-file", Name: "Stage", Context: "git-status"},
+		{ID: "amend", Name: "Amend", Context: "git-commit"},
+		{ID: "blame", Name: "Blame", Context: "git-status"},
+	}}
+	reg := plugin.NewRegistry(nil)
+	for _, p := range []plugin.Plugin{files, git} {
+		if err := reg.Register(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	km := keymap.NewRegistry()
+	km.RegisterBinding(keymap.Binding{Key: "s", Command: "stage-file", Context: "git-status"})
+	km.RegisterBinding(keymap.Binding{Key: "ctrl+a", Command: "amend", Context: "git-commit"})
+	m := Model{registry: reg, keymap: km, ui: &UIState{}, width: 120, height: 40}
+	m.SetActivePlugin(0)
+
+	// Every plugin command is listed, bound or not
+	entries := palette.BuildEntries(km, reg.Plugins(), m.activeContext, "files")
+	if len(entries) != 3 || entries[2].CommandID != "blame" || entries[2].Key != "" {
+		t.Fatalf("entries = %+v", entries)
+	}
+
+	// Picking another plugin's command focuses it and sends the binding
+	updated, _ := m.Update(palette.CommandSelectedMsg{CommandID: "stage-file", Context: "git-status"})
+	m = updated.(Model)
+	if m.activePlugin != 1 || strings.Join(git.keys, ",") != "s" || len(files.keys) != 0 {
+		t.Fatalf("active=%d git keys=%v files keys=%v", m.activePlugin, git.keys, files.keys)
+	}
+
+	// A command of a view that isn't open can't run
+	updated, _ = m.Update(palette.CommandSelectedMsg{CommandID: "amend", Context: "git-commit"})
+	m = updated.(Model)
+	if len(git.keys) != 1 || !strings.Contains(m.statusMsg, "git-commit") {
+		t.Errorf("keys=%v status=%q", git.keys, m.statusMsg)
+	}
+}
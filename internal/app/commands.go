@@ -99,6 +99,20 @@ type WorktreeDeletedMsg struct {
 	MainPath    string // Path to switch to (main worktree)
 }
 
+// WorktreesChangedMsg is broadcast when a plugin creates or deletes a worktree,
+// so plugins that track worktree-derived data can refresh immediately.
+type WorktreesChangedMsg struct {
+	Path    string // Absolute path of the created or deleted worktree
+	Removed bool   // True when the worktree was deleted
+}
+
+// WorktreesChanged returns a command that broadcasts a WorktreesChangedMsg.
+func WorktreesChanged(path string, removed bool) tea.Cmd {
+	return func() tea.Msg {
+		return WorktreesChangedMsg{Path: path, Removed: removed}
+	}
+}
+
 // checkWorktreeExists returns a command that checks if the current worktree still exists.
 func checkWorktreeExists(workDir string) tea.Cmd {
 	return func() tea.Msg {
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/wilbur182/forge/internal/community"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/palette"
+	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/styles"
 )
 
@@ -101,3 +104,17 @@ func TestUnifiedThemeCursorNavigation(t *testing.T) {
 		t.Error("expected community themes in unified list")
 	}
 }
+
+func TestPaletteSwitchThemeOpensSwitcher(t *testing.T) {
+	m := Model{registry: plugin.NewRegistry(nil), keymap: keymap.NewRegistry(), ui: &UIState{}, width: 80, height: 40}
+	m.showPalette = true
+
+	updated, _ := m.Update(palette.CommandSelectedMsg{CommandID: "switch-theme", Context: "global"})
+	got := updated.(Model)
+	if got.showPalette || !got.showThemeSwitcher {
+		t.Fatalf("palette=%v switcher=%v, want switcher open", got.showPalette, got.showThemeSwitcher)
+	}
+	if got.activeContext != "theme-switcher" || len(got.themeSwitcherFiltered) == 0 {
+		t.Errorf("context = %q, %d themes", got.activeContext, len(got.themeSwitcherFiltered))
+	}
+}
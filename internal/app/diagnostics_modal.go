@@ -3,12 +3,16 @@ package app
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/features"
 	"github.com/wilbur182/forge/internal/modal"
 	"github.com/wilbur182/forge/internal/mouse"
 	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/theme"
 	"github.com/wilbur182/forge/internal/ui"
 )
 
@@ -40,6 +44,12 @@ func (m *Model) ensureDiagnosticsModal() {
 		AddSection(m.diagnosticsVersionSection()).
 		AddSection(m.diagnosticsUpdateSection()).
 		AddSection(m.diagnosticsErrorSection()).
+		AddSection(m.diagnosticsThemeSection()).
+		AddSection(m.diagnosticsKeymapSection()).
+		AddSection(m.diagnosticsFeaturesSection()).
+		AddSection(m.diagnosticsFeatureUsageSection()).
+		AddSection(m.diagnosticsCacheSection()).
+		AddSection(m.diagnosticsEventBusSection()).
 		AddSection(m.diagnosticsHintsSection())
 }
 
@@ -58,7 +68,7 @@ func (m *Model) diagnosticsLogoSection() modal.Section {
   \__ \/ / __  / _ \/ ___/ __ \/ ___/
  ___/ / / /_/ /  __/ /__/ /_/ / /
 /____/_/\__,_/\___/\___/\__,_/_/     `
-		return modal.RenderedSection{Content: styles.Logo.Render(logo)}
+		return modal.RenderedSection{Content: styles.Current().Logo.Render(logo)}
 	}, nil)
 }
 
@@ -66,27 +76,33 @@ func (m *Model) diagnosticsLogoSection() modal.Section {
 func (m *Model) diagnosticsPluginsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		var b strings.Builder
-		b.WriteString(styles.Title.Render("Plugins"))
+		b.WriteString(styles.Current().Title.Render("Plugins"))
 		b.WriteString("\n")
 
 		plugins := m.registry.Plugins()
 		for _, p := range plugins {
-			status := styles.StatusCompleted.Render("✓")
+			status := styles.Current().StatusCompleted.Render("✓")
 			b.WriteString(fmt.Sprintf("  %s %s: active\n", status, p.Name()))
 
+			// Older plugin API: note what the host left out
+			if c, ok := m.registry.Compatibility(p.ID()); ok && c.IsDegraded() {
+				b.WriteString(fmt.Sprintf("    %s plugin API v%d, reduced features: %s\n",
+					styles.Current().StatusModified.Render("•"), c.Version, joinCapabilities(c.Degraded)))
+			}
+
 			// Check for plugin-specific diagnostics
-			if dp, ok := p.(plugin.DiagnosticProvider); ok {
+			if dp, ok := p.(plugin.DiagnosticProvider); ok && m.registry.Supports(p, plugin.CapDiagnostics) {
 				for _, d := range dp.Diagnostics() {
 					var statusIcon string
 					switch d.Status {
 					case "ok":
-						statusIcon = styles.StatusCompleted.Render("•")
+						statusIcon = styles.Current().StatusCompleted.Render("•")
 					case "warning":
-						statusIcon = styles.StatusModified.Render("•")
+						statusIcon = styles.Current().StatusModified.Render("•")
 					case "error":
-						statusIcon = styles.StatusBlocked.Render("•")
+						statusIcon = styles.Current().StatusBlocked.Render("•")
 					default:
-						statusIcon = styles.Muted.Render("•")
+						statusIcon = styles.Current().Muted.Render("•")
 					}
 					b.WriteString(fmt.Sprintf("    %s %s\n", statusIcon, d.Detail))
 				}
@@ -95,26 +111,35 @@ func (m *Model) diagnosticsPluginsSection() modal.Section {
 
 		unavail := m.registry.Unavailable()
 		for id, reason := range unavail {
-			status := styles.StatusBlocked.Render("✗")
+			status := styles.Current().StatusBlocked.Render("✗")
 			b.WriteString(fmt.Sprintf("  %s %s: %s\n", status, id, reason))
 		}
 
 		if len(plugins) == 0 && len(unavail) == 0 {
-			b.WriteString(styles.Muted.Render("  No plugins registered\n"))
+			b.WriteString(styles.Current().Muted.Render("  No plugins registered\n"))
 		}
 
 		return modal.RenderedSection{Content: strings.TrimSuffix(b.String(), "\n")}
 	}, nil)
 }
 
+// joinCapabilities formats capability names for display.
+func joinCapabilities(caps []plugin.Capability) string {
+	names := make([]string, len(caps))
+	for i, c := range caps {
+		names[i] = string(c)
+	}
+	return strings.Join(names, ", ")
+}
+
 // diagnosticsSystemSection renders the system info section.
 func (m *Model) diagnosticsSystemSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		var b strings.Builder
-		b.WriteString(styles.Title.Render("System"))
+		b.WriteString(styles.Current().Title.Render("System"))
 		b.WriteString("\n")
-		b.WriteString(fmt.Sprintf("  WorkDir: %s\n", styles.Muted.Render(m.ui.WorkDir)))
-		b.WriteString(fmt.Sprintf("  Refresh: %s", styles.Muted.Render(m.ui.LastRefresh.Format("15:04:05"))))
+		b.WriteString(fmt.Sprintf("  WorkDir: %s\n", styles.Current().Muted.Render(m.ui.WorkDir)))
+		b.WriteString(fmt.Sprintf("  Refresh: %s", styles.Current().Muted.Render(m.ui.LastRefresh.Format("15:04:05"))))
 		return modal.RenderedSection{Content: b.String()}
 	}, nil)
 }
@@ -123,33 +148,33 @@ func (m *Model) diagnosticsSystemSection() modal.Section {
 func (m *Model) diagnosticsVersionSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		var b strings.Builder
-		b.WriteString(styles.Title.Render("Version"))
+		b.WriteString(styles.Current().Title.Render("Version"))
 		b.WriteString("\n")
 
 		// Sidecar version
 		if m.updateAvailable != nil {
 			b.WriteString(fmt.Sprintf("  sidecar: %s → %s ",
-				styles.Muted.Render(m.currentVersion),
+				styles.Current().Muted.Render(m.currentVersion),
 				m.updateAvailable.LatestVersion))
-			b.WriteString(styles.StatusModified.Render("available"))
+			b.WriteString(styles.Current().StatusModified.Render("available"))
 		} else {
-			b.WriteString(fmt.Sprintf("  sidecar: %s ", styles.Muted.Render(m.currentVersion)))
-			b.WriteString(styles.StatusCompleted.Render("✓"))
+			b.WriteString(fmt.Sprintf("  sidecar: %s ", styles.Current().Muted.Render(m.currentVersion)))
+			b.WriteString(styles.Current().StatusCompleted.Render("✓"))
 		}
 
 		// td version
 		if m.tdVersionInfo != nil {
 			b.WriteString("\n")
 			if !m.tdVersionInfo.Installed {
-				b.WriteString(fmt.Sprintf("  td:      %s", styles.Muted.Render("not installed")))
+				b.WriteString(fmt.Sprintf("  td:      %s", styles.Current().Muted.Render("not installed")))
 			} else if m.tdVersionInfo.HasUpdate {
 				b.WriteString(fmt.Sprintf("  td:      %s → %s ",
-					styles.Muted.Render(m.tdVersionInfo.CurrentVersion),
+					styles.Current().Muted.Render(m.tdVersionInfo.CurrentVersion),
 					m.tdVersionInfo.LatestVersion))
-				b.WriteString(styles.StatusModified.Render("available"))
+				b.WriteString(styles.Current().StatusModified.Render("available"))
 			} else {
-				b.WriteString(fmt.Sprintf("  td:      %s ", styles.Muted.Render(m.tdVersionInfo.CurrentVersion)))
-				b.WriteString(styles.StatusCompleted.Render("✓"))
+				b.WriteString(fmt.Sprintf("  td:      %s ", styles.Current().Muted.Render(m.tdVersionInfo.CurrentVersion)))
+				b.WriteString(styles.Current().StatusCompleted.Render("✓"))
 			}
 		}
 
@@ -169,15 +194,15 @@ func (m *Model) diagnosticsUpdateSection() modal.Section {
 
 		if m.needsRestart {
 			b.WriteString("\n  ")
-			b.WriteString(styles.StatusCompleted.Render("✓ "))
+			b.WriteString(styles.Current().StatusCompleted.Render("✓ "))
 			b.WriteString("Update complete. ")
-			b.WriteString(styles.StatusModified.Render("Restart sidecar to use new version"))
+			b.WriteString(styles.Current().StatusModified.Render("Restart sidecar to use new version"))
 			return modal.RenderedSection{Content: b.String()}
 		}
 
 		// Show update available message with hint
 		b.WriteString("\n  ")
-		b.WriteString(styles.StatusModified.Render("⬆ "))
+		b.WriteString(styles.Current().StatusModified.Render("⬆ "))
 
 		// Version comparison
 		if m.updateAvailable != nil {
@@ -191,9 +216,9 @@ func (m *Model) diagnosticsUpdateSection() modal.Section {
 		}
 
 		b.WriteString("\n  ")
-		b.WriteString(styles.Muted.Render("  Press "))
-		b.WriteString(styles.KeyHint.Render("u"))
-		b.WriteString(styles.Muted.Render(" to view details and update"))
+		b.WriteString(styles.Current().Muted.Render("  Press "))
+		b.WriteString(styles.Current().KeyHint.Render("u"))
+		b.WriteString(styles.Current().Muted.Render(" to view details and update"))
 
 		return modal.RenderedSection{Content: b.String()}
 	}, nil)
@@ -207,9 +232,147 @@ func (m *Model) diagnosticsErrorSection() modal.Section {
 		}
 		var b strings.Builder
 		b.WriteString("\n")
-		b.WriteString(styles.Title.Render("Last Error"))
+		b.WriteString(styles.Current().Title.Render("Last Error"))
+		b.WriteString("\n")
+		b.WriteString(styles.Current().StatusBlocked.Render(fmt.Sprintf("  %s", m.lastError.Error())))
+		return modal.RenderedSection{Content: b.String()}
+	}, nil)
+}
+
+// diagnosticsKeymapSection lists key overrides that shadow other bindings.
+func (m *Model) diagnosticsKeymapSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if m.keymap == nil {
+			return modal.RenderedSection{}
+		}
+		conflicts := m.keymap.Conflicts()
+		if len(conflicts) == 0 {
+			return modal.RenderedSection{}
+		}
+		var b strings.Builder
+		b.WriteString("\n")
+		b.WriteString(styles.Current().Title.Render("Key Binding Conflicts"))
+		for _, c := range conflicts {
+			b.WriteString("\n")
+			b.WriteString(styles.Current().StatusModified.Render("  " + c.String()))
+		}
+		return modal.RenderedSection{Content: b.String()}
+	}, nil)
+}
+
+// diagnosticsThemeSection lists custom theme files that failed to load.
+func (m *Model) diagnosticsThemeSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		errs := theme.LoadErrors()
+		if len(errs) == 0 {
+			return modal.RenderedSection{}
+		}
+		var b strings.Builder
+		b.WriteString("\n")
+		b.WriteString(styles.Current().Title.Render("Custom Themes"))
+		for _, err := range errs {
+			for _, line := range strings.Split(err.Error(), "\n") {
+				b.WriteString("\n")
+				b.WriteString(styles.Current().StatusBlocked.Render("  " + line))
+			}
+		}
+		return modal.RenderedSection{Content: b.String()}
+	}, nil)
+}
+
+// diagnosticsFeaturesSection lists feature flags past their expiry release.
+func (m *Model) diagnosticsFeaturesSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		expired := features.Expired(m.currentVersion)
+		if len(expired) == 0 {
+			return modal.RenderedSection{}
+		}
+		var b strings.Builder
 		b.WriteString("\n")
-		b.WriteString(styles.StatusBlocked.Render(fmt.Sprintf("  %s", m.lastError.Error())))
+		b.WriteString(styles.Current().Title.Render("Expired Feature Flags"))
+		for _, f := range expired {
+			line := fmt.Sprintf("  %s expired in %s", f.Name, f.ExpiresIn)
+			if f.Owner != "" {
+				line += fmt.Sprintf(" (owner: %s)", f.Owner)
+			}
+			b.WriteString("\n")
+			b.WriteString(styles.Current().StatusModified.Render(line))
+		}
+		return modal.RenderedSection{Content: b.String()}
+	}, nil)
+}
+
+// diagnosticsFeatureUsageSection lists the flags read during this run with
+// the value and source code saw, to explain why a feature is on or off.
+func (m *Model) diagnosticsFeatureUsageSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		reads := features.Usage()
+		if len(reads) == 0 {
+			return modal.RenderedSection{}
+		}
+		var b strings.Builder
+		b.WriteString("\n")
+		b.WriteString(styles.Current().Title.Render("Feature Flags Read"))
+		for _, r := range reads {
+			b.WriteString("\n")
+			b.WriteString(fmt.Sprintf("  %s = %s ", r.Name, r.Value))
+			b.WriteString(styles.Current().Muted.Render(fmt.Sprintf("(%s, %d reads)", r.Source, r.Count)))
+		}
+		return modal.RenderedSection{Content: b.String()}
+	}, nil)
+}
+
+// diagnosticsCacheSection shows session cache hit rates and memory use, so
+// parsing regressions are visible without profiling.
+func (m *Model) diagnosticsCacheSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		stats := cache.Snapshot()
+		if len(stats) == 0 {
+			return modal.RenderedSection{}
+		}
+		var b strings.Builder
+		b.WriteString("\n")
+		b.WriteString(styles.Current().Title.Render("Caches"))
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("  memory: %.1f / %.0f MB",
+			float64(cache.BudgetUsed())/(1<<20), float64(cache.Budget())/(1<<20)))
+		for _, st := range stats {
+			b.WriteString("\n")
+			b.WriteString(fmt.Sprintf("  %s: %.0f%% hits\n", st.Name, st.HitRate()*100))
+			line := fmt.Sprintf("    %d hit, %d miss, %d incr, %d full, %d evict",
+				st.Hits, st.Misses, st.Incremental, st.Full, st.Evictions)
+			if st.Recoveries > 0 {
+				line += fmt.Sprintf(", %d recovered", st.Recoveries)
+			}
+			b.WriteString(styles.Current().Muted.Render(line))
+		}
+		return modal.RenderedSection{Content: b.String()}
+	}, nil)
+}
+
+// diagnosticsEventBusSection shows event bus publish latency and any
+// subscriber whose queue keeps backing up, the usual cause of UI stalls.
+func (m *Model) diagnosticsEventBusSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if m.registry == nil || m.registry.Context() == nil || m.registry.Context().EventBus == nil {
+			return modal.RenderedSection{}
+		}
+		stats := m.registry.Context().EventBus.Stats()
+		if stats.Publishes == 0 {
+			return modal.RenderedSection{}
+		}
+		var b strings.Builder
+		b.WriteString("\n")
+		b.WriteString(styles.Current().Title.Render("Event Bus"))
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("  %d published, %s avg, %s max",
+			stats.Publishes, stats.AvgLatency.Round(time.Microsecond), stats.MaxLatency.Round(time.Microsecond)))
+		for _, sub := range stats.SlowSubscribers() {
+			b.WriteString("\n")
+			b.WriteString(styles.Current().StatusModified.Render(fmt.Sprintf("  slow: %s", sub.Name)))
+			b.WriteString(styles.Current().Muted.Render(fmt.Sprintf(" (%d/%d queued, %d dropped)",
+				sub.Depth, sub.Buffer, sub.Dropped)))
+		}
 		return modal.RenderedSection{Content: b.String()}
 	}, nil)
 }
@@ -217,7 +380,7 @@ func (m *Model) diagnosticsErrorSection() modal.Section {
 // diagnosticsHintsSection renders the close hint.
 func (m *Model) diagnosticsHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		return modal.RenderedSection{Content: "\n" + styles.Subtle.Render("Press ! or esc to close")}
+		return modal.RenderedSection{Content: "\n" + styles.Current().Subtle.Render("Press ! or esc to close")}
 	}, nil)
 }
 
@@ -0,0 +1,213 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/features"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+const featureFlagsItemPrefix = "feature-flags-item-"
+
+// featureFlagsItemID returns the ID for a flag at the given index.
+func featureFlagsItemID(idx int) string {
+	return fmt.Sprintf("%s%d", featureFlagsItemPrefix, idx)
+}
+
+// openFeatureFlags shows the feature flags modal.
+func (m *Model) openFeatureFlags() {
+	m.showFeatureFlags = true
+	m.activeContext = "feature-flags"
+	m.featureFlagsCursor = 0
+	m.clearFeatureFlagsModal()
+}
+
+// resetFeatureFlags closes the feature flags modal.
+func (m *Model) resetFeatureFlags() {
+	m.showFeatureFlags = false
+	m.featureFlagsCursor = 0
+	m.clearFeatureFlagsModal()
+}
+
+// clearFeatureFlagsModal clears the modal cache.
+func (m *Model) clearFeatureFlagsModal() {
+	m.featureFlagsModal = nil
+	m.featureFlagsModalWidth = 0
+	m.featureFlagsMouseHandler = nil
+}
+
+// toggleFeatureFlag flips a flag for this run and tells plugins about it.
+func (m *Model) toggleFeatureFlag(idx int) tea.Cmd {
+	flags := features.ListAll()
+	if idx < 0 || idx >= len(flags) {
+		return nil
+	}
+	name := flags[idx].Name
+	enabled := !features.List()[name] // List doesn't count as a read in Usage
+	features.SetRuntime(name, enabled)
+	return func() tea.Msg {
+		return plugin.FeatureChangedMsg{Name: name, Enabled: enabled}
+	}
+}
+
+// ensureFeatureFlagsModal builds/rebuilds the feature flags modal.
+func (m *Model) ensureFeatureFlagsModal() {
+	modalW := 64
+	if modalW > m.width-4 {
+		modalW = m.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if m.featureFlagsModal != nil && m.featureFlagsModalWidth == modalW {
+		return
+	}
+	m.featureFlagsModalWidth = modalW
+
+	m.featureFlagsModal = modal.New("Feature Flags",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(m.featureFlagsListSection()).
+		AddSection(modal.Spacer()).
+		AddSection(m.featureFlagsHintsSection())
+}
+
+// featureFlagsListSection renders each flag with its state and source.
+func (m *Model) featureFlagsListSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		flags := features.ListAll()
+		if len(flags) == 0 {
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No feature flags registered")}
+		}
+		states := features.List()
+
+		cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Current().Secondary)
+		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+
+		var sb strings.Builder
+		focusables := make([]modal.FocusableInfo, 0, len(flags))
+		for i, f := range flags {
+			isCursor := i == m.featureFlagsCursor
+			itemID := featureFlagsItemID(i)
+
+			if isCursor {
+				sb.WriteString(cursorStyle.Render("> "))
+			} else {
+				sb.WriteString("  ")
+			}
+
+			if states[f.Name] {
+				sb.WriteString(styles.Current().StatusCompleted.Render("[on] "))
+			} else {
+				sb.WriteString(styles.Current().Muted.Render("[off]"))
+			}
+			sb.WriteString(" ")
+
+			nameStyle := nameNormalStyle
+			if isCursor || itemID == hoverID {
+				nameStyle = nameSelectedStyle
+			}
+			sb.WriteString(nameStyle.Render(f.Name))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf(" (%s)", features.SourceOf(f.Name))))
+			if f.Expired(m.currentVersion) {
+				sb.WriteString(styles.Current().StatusModified.Render(" expired"))
+			}
+			sb.WriteString("\n")
+
+			desc := f.Description
+			if maxLen := contentWidth - 9; maxLen > 3 && len(desc) > maxLen {
+				desc = desc[:maxLen-3] + "..."
+			}
+			sb.WriteString(styles.Current().Muted.Render("         " + desc))
+			if i < len(flags)-1 {
+				sb.WriteString("\n")
+			}
+
+			// Each flag takes 2 lines (name + description)
+			focusables = append(focusables, modal.FocusableInfo{
+				ID:      itemID,
+				OffsetX: 0,
+				OffsetY: i * 2,
+				Width:   contentWidth,
+				Height:  2,
+			})
+		}
+
+		return modal.RenderedSection{Content: sb.String(), Focusables: focusables}
+	}, nil)
+}
+
+// featureFlagsHintsSection renders the key hints.
+func (m *Model) featureFlagsHintsSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		return modal.RenderedSection{Content: styles.Current().Subtle.Render("space toggle for this session · esc close")}
+	}, nil)
+}
+
+// handleFeatureFlagsKey handles keys while the feature flags modal is open.
+func (m *Model) handleFeatureFlagsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	n := len(features.ListAll())
+	switch msg.String() {
+	case "up", "k", "ctrl+p":
+		if m.featureFlagsCursor > 0 {
+			m.featureFlagsCursor--
+		}
+	case "down", "j", "ctrl+n":
+		if m.featureFlagsCursor < n-1 {
+			m.featureFlagsCursor++
+		}
+	case " ", "enter":
+		return m, m.toggleFeatureFlag(m.featureFlagsCursor)
+	case "$", "q":
+		m.resetFeatureFlags()
+		m.updateContext()
+	}
+	return m, nil
+}
+
+// renderFeatureFlagsModal renders the feature flags modal.
+func (m *Model) renderFeatureFlagsModal(content string) string {
+	m.ensureFeatureFlagsModal()
+	if m.featureFlagsModal == nil {
+		return content
+	}
+
+	if m.featureFlagsMouseHandler == nil {
+		m.featureFlagsMouseHandler = mouse.NewHandler()
+	}
+	modalContent := m.featureFlagsModal.Render(m.width, m.height, m.featureFlagsMouseHandler)
+	return ui.OverlayModal(content, modalContent, m.width, m.height)
+}
+
+// handleFeatureFlagsMouse handles mouse events for the feature flags modal.
+// Clicking a flag toggles it.
+func (m *Model) handleFeatureFlagsMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	m.ensureFeatureFlagsModal()
+	if m.featureFlagsModal == nil {
+		return m, nil
+	}
+	if m.featureFlagsMouseHandler == nil {
+		m.featureFlagsMouseHandler = mouse.NewHandler()
+	}
+
+	action := m.featureFlagsModal.HandleMouse(msg, m.featureFlagsMouseHandler)
+	if strings.HasPrefix(action, featureFlagsItemPrefix) {
+		var idx int
+		if _, err := fmt.Sscanf(action, featureFlagsItemPrefix+"%d", &idx); err == nil {
+			m.featureFlagsCursor = idx
+			return m, m.toggleFeatureFlag(idx)
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/features"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/palette"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestFeatureFlagsModalToggle(t *testing.T) {
+	features.Init(config.Default())
+	t.Cleanup(func() { features.Init(config.Default()) })
+
+	m := Model{registry: plugin.NewRegistry(nil), keymap: keymap.NewRegistry(), ui: &UIState{}, width: 80, height: 40}
+	m.showPalette = true
+	updated, _ := m.Update(palette.CommandSelectedMsg{CommandID: "feature-flags", Context: "global"})
+	m = updated.(Model)
+	if !m.showFeatureFlags || m.activeModal() != ModalFeatureFlags {
+		t.Fatalf("feature flags modal not open, active modal = %v", m.activeModal())
+	}
+	if view := m.renderFeatureFlagsModal(""); view == "" {
+		t.Error("expected modal content")
+	}
+
+	flag := features.ListAll()[0]
+	before := features.IsEnabled(flag.Name)
+	_, cmd := m.handleFeatureFlagsKey(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if cmd == nil {
+		t.Fatal("toggle should return a command")
+	}
+	msg, ok := cmd().(plugin.FeatureChangedMsg)
+	if !ok || msg.Name != flag.Name || msg.Enabled == before {
+		t.Errorf("got %#v, want FeatureChangedMsg{%q, %v}", msg, flag.Name, !before)
+	}
+	if features.IsEnabled(flag.Name) == before {
+		t.Error("flag was not toggled")
+	}
+	if src := features.SourceOf(flag.Name); src != features.SourceRuntime {
+		t.Errorf("source = %q, want %q", src, features.SourceRuntime)
+	}
+
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showFeatureFlags {
+		t.Error("esc should close the modal")
+	}
+}
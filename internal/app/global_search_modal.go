@@ -0,0 +1,582 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+const (
+	globalSearchInputID    = "global-search-input"
+	globalSearchItemPrefix = "global-search-item-"
+
+	// globalSearchDebounce delays fan-out until typing pauses.
+	globalSearchDebounce = 150 * time.Millisecond
+	// globalSearchMinQuery is the minimum query length that triggers a search.
+	globalSearchMinQuery = 2
+	// globalSearchGroupLimit caps each group in the unfiltered view.
+	globalSearchGroupLimit = 5
+	// globalSearchMaxVisible is the number of list rows shown at once.
+	globalSearchMaxVisible = 14
+
+	// globalSearchTaskSource is the result source for td tasks.
+	globalSearchTaskSource = "td"
+)
+
+// globalSearchDebounceMsg fires after the input has been idle.
+type globalSearchDebounceMsg struct {
+	Seq int
+}
+
+// globalSearchResultsMsg carries one source's results for a search.
+type globalSearchResultsMsg struct {
+	Seq     int
+	Source  string
+	Results []plugin.SearchResult
+}
+
+// globalSearchRow is a rendered list row: a group header or a result.
+type globalSearchRow struct {
+	header plugin.SearchKind // set for group headers
+	item   int               // index into the visible results (-1 for headers)
+}
+
+// globalSearchItemID returns the ID for a result item at the given index.
+func globalSearchItemID(idx int) string {
+	return fmt.Sprintf("%s%d", globalSearchItemPrefix, idx)
+}
+
+// searchKindLabel returns the plural group label for a result kind.
+func searchKindLabel(k plugin.SearchKind) string {
+	switch k {
+	case plugin.SearchKindSession:
+		return "Sessions"
+	case plugin.SearchKindMessage:
+		return "Messages"
+	case plugin.SearchKindTask:
+		return "Tasks"
+	case plugin.SearchKindFile:
+		return "Files"
+	case plugin.SearchKindWorktree:
+		return "Worktrees"
+	case "":
+		return "All"
+	}
+	return string(k)
+}
+
+// initGlobalSearch initializes the global search modal.
+func (m *Model) initGlobalSearch() {
+	m.clearGlobalSearchModal()
+
+	ti := textinput.New()
+	ti.Placeholder = "Search sessions, messages, tasks, files, worktrees..."
+	ti.Focus()
+	ti.CharLimit = 100
+	ti.Width = 50
+	m.globalSearchInput = ti
+
+	m.globalSearchQuery = ""
+	m.globalSearchResults = nil
+	m.globalSearchPending = 0
+	m.globalSearchFilter = ""
+	m.globalSearchCursor = 0
+	m.globalSearchScroll = 0
+}
+
+// resetGlobalSearch resets the global search modal state.
+func (m *Model) resetGlobalSearch() {
+	m.showGlobalSearch = false
+	m.globalSearchSeq++ // Drop in-flight results
+	m.globalSearchQuery = ""
+	m.globalSearchResults = nil
+	m.globalSearchPending = 0
+	m.globalSearchCursor = 0
+	m.globalSearchScroll = 0
+	m.clearGlobalSearchModal()
+}
+
+// clearGlobalSearchModal clears the modal cache.
+func (m *Model) clearGlobalSearchModal() {
+	m.globalSearchModal = nil
+	m.globalSearchModalWidth = 0
+	m.globalSearchMouseHandler = nil
+}
+
+// globalSearchInputChanged schedules a debounced search for the current input.
+func (m *Model) globalSearchInputChanged() tea.Cmd {
+	query := strings.TrimSpace(m.globalSearchInput.Value())
+	if query == m.globalSearchQuery {
+		return nil
+	}
+	m.globalSearchSeq++
+	if len(query) < globalSearchMinQuery {
+		m.globalSearchQuery = ""
+		m.globalSearchResults = nil
+		m.globalSearchPending = 0
+		m.globalSearchCursor = 0
+		m.globalSearchScroll = 0
+		m.clearGlobalSearchModal()
+		return nil
+	}
+	seq := m.globalSearchSeq
+	return tea.Tick(globalSearchDebounce, func(time.Time) tea.Msg {
+		return globalSearchDebounceMsg{Seq: seq}
+	})
+}
+
+// startGlobalSearch fans the current query out to every search source.
+// Plugins snapshot their state here; the searches run in the background.
+func (m *Model) startGlobalSearch() tea.Cmd {
+	query := strings.TrimSpace(m.globalSearchInput.Value())
+	seq := m.globalSearchSeq
+	m.globalSearchQuery = query
+	m.globalSearchResults = make(map[string][]plugin.SearchResult)
+	m.globalSearchPending = 0
+	m.globalSearchCursor = 0
+	m.globalSearchScroll = 0
+	m.clearGlobalSearchModal()
+
+	var cmds []tea.Cmd
+	for _, p := range m.registry.Plugins() {
+		searcher, ok := p.(plugin.GlobalSearcher)
+		if !ok || !m.registry.Supports(p, plugin.CapGlobalSearch) {
+			continue
+		}
+		search := searcher.GlobalSearch(query)
+		if search == nil {
+			continue
+		}
+		source := p.ID()
+		m.globalSearchPending++
+		cmds = append(cmds, func() tea.Msg {
+			return globalSearchResultsMsg{Seq: seq, Source: source, Results: search()}
+		})
+	}
+
+	if m.registry.Get("td-monitor") != nil {
+		workDir := m.ui.WorkDir
+		m.globalSearchPending++
+		cmds = append(cmds, func() tea.Msg {
+			return globalSearchResultsMsg{Seq: seq, Source: globalSearchTaskSource, Results: taskSearchResults(workDir, query)}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// taskSearchResults searches td tasks. Errors (e.g. td not installed) yield no results.
+func taskSearchResults(workDir, query string) []plugin.SearchResult {
+	issues, err := runIssueSearch(workDir, query, false)
+	if err != nil {
+		return nil
+	}
+	results := make([]plugin.SearchResult, 0, len(issues))
+	for i, issue := range issues {
+		detail := issue.ID + " · " + issue.Status
+		if issue.Priority != "" {
+			detail += " · " + issue.Priority
+		}
+		results = append(results, plugin.SearchResult{
+			Kind:     plugin.SearchKindTask,
+			Title:    issue.Title,
+			Detail:   detail,
+			Score:    len(issues) - i, // td returns most recently updated first
+			PluginID: "td-monitor",
+			Open:     OpenFullIssueMsg{IssueID: issue.ID},
+		})
+	}
+	return results
+}
+
+// handleGlobalSearchResults merges one source's results into the modal.
+func (m *Model) handleGlobalSearchResults(msg globalSearchResultsMsg) {
+	if msg.Seq != m.globalSearchSeq || !m.showGlobalSearch || m.globalSearchResults == nil {
+		return
+	}
+	m.globalSearchResults[msg.Source] = msg.Results
+	if m.globalSearchPending > 0 {
+		m.globalSearchPending--
+	}
+	m.clampGlobalSearchCursor()
+	m.clearGlobalSearchModal()
+}
+
+// groupSearchResults groups results by kind in display order, ranking each
+// group by score. When filter is empty every group is capped at limit.
+func groupSearchResults(bySource map[string][]plugin.SearchResult, filter plugin.SearchKind, limit int) []plugin.SearchResult {
+	sources := make([]string, 0, len(bySource))
+	for s := range bySource {
+		sources = append(sources, s)
+	}
+	sort.Strings(sources) // Deterministic tie-breaking across sources
+
+	var out []plugin.SearchResult
+	for _, kind := range plugin.SearchKinds {
+		if filter != "" && kind != filter {
+			continue
+		}
+		var group []plugin.SearchResult
+		for _, s := range sources {
+			for _, r := range bySource[s] {
+				if r.Kind == kind {
+					group = append(group, r)
+				}
+			}
+		}
+		sort.SliceStable(group, func(i, j int) bool { return group[i].Score > group[j].Score })
+		if filter == "" && limit > 0 && len(group) > limit {
+			group = group[:limit]
+		}
+		out = append(out, group...)
+	}
+	return out
+}
+
+// countSearchResults returns the number of results per kind.
+func countSearchResults(bySource map[string][]plugin.SearchResult) map[plugin.SearchKind]int {
+	counts := make(map[plugin.SearchKind]int)
+	for _, results := range bySource {
+		for _, r := range results {
+			counts[r.Kind]++
+		}
+	}
+	return counts
+}
+
+// globalSearchVisible returns the results shown for the current filter.
+func (m *Model) globalSearchVisible() []plugin.SearchResult {
+	return groupSearchResults(m.globalSearchResults, m.globalSearchFilter, globalSearchGroupLimit)
+}
+
+// globalSearchRows interleaves group headers with result rows.
+func globalSearchRows(results []plugin.SearchResult) []globalSearchRow {
+	var rows []globalSearchRow
+	var last plugin.SearchKind
+	for i, r := range results {
+		if r.Kind != last {
+			rows = append(rows, globalSearchRow{header: r.Kind, item: -1})
+			last = r.Kind
+		}
+		rows = append(rows, globalSearchRow{item: i})
+	}
+	return rows
+}
+
+// cycleGlobalSearchFilter moves the kind filter forward or backward.
+func (m *Model) cycleGlobalSearchFilter(delta int) {
+	filters := append([]plugin.SearchKind{""}, plugin.SearchKinds...)
+	idx := 0
+	for i, f := range filters {
+		if f == m.globalSearchFilter {
+			idx = i
+			break
+		}
+	}
+	idx = (idx + delta + len(filters)) % len(filters)
+	m.globalSearchFilter = filters[idx]
+	m.globalSearchCursor = 0
+	m.globalSearchScroll = 0
+	m.clearGlobalSearchModal()
+}
+
+// moveGlobalSearchCursor moves the selection and keeps it (and its group
+// header) scrolled into view.
+func (m *Model) moveGlobalSearchCursor(delta int) {
+	m.globalSearchCursor += delta
+	m.clampGlobalSearchCursor()
+	m.clearGlobalSearchModal()
+}
+
+// clampGlobalSearchCursor keeps the cursor and scroll within the results.
+func (m *Model) clampGlobalSearchCursor() {
+	results := m.globalSearchVisible()
+	if m.globalSearchCursor >= len(results) {
+		m.globalSearchCursor = len(results) - 1
+	}
+	if m.globalSearchCursor < 0 {
+		m.globalSearchCursor = 0
+	}
+
+	rows := globalSearchRows(results)
+	cursorRow := 0
+	for i, r := range rows {
+		if r.item == m.globalSearchCursor {
+			cursorRow = i
+			break
+		}
+	}
+	// Show the group header when selecting the first item of a group
+	topRow := cursorRow
+	if topRow > 0 && rows[topRow-1].item == -1 {
+		topRow--
+	}
+	if topRow < m.globalSearchScroll {
+		m.globalSearchScroll = topRow
+	}
+	if cursorRow >= m.globalSearchScroll+globalSearchMaxVisible {
+		m.globalSearchScroll = cursorRow - globalSearchMaxVisible + 1
+	}
+	if maxScroll := len(rows) - globalSearchMaxVisible; m.globalSearchScroll > maxScroll {
+		m.globalSearchScroll = maxScroll
+	}
+	if m.globalSearchScroll < 0 {
+		m.globalSearchScroll = 0
+	}
+}
+
+// openGlobalSearchResult closes the modal, focuses the owning plugin and
+// sends it the result's navigation message.
+func (m *Model) openGlobalSearchResult(idx int) tea.Cmd {
+	results := m.globalSearchVisible()
+	if idx < 0 || idx >= len(results) {
+		return nil
+	}
+	r := results[idx]
+	m.resetGlobalSearch()
+	m.updateContext()
+	if r.Open == nil {
+		return FocusPlugin(r.PluginID)
+	}
+	open := r.Open
+	return tea.Sequence(FocusPlugin(r.PluginID), func() tea.Msg { return open })
+}
+
+// handleGlobalSearchKeys handles keys while the global search modal is open.
+func (m *Model) handleGlobalSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		return m, m.openGlobalSearchResult(m.globalSearchCursor)
+	case "up", "ctrl+p":
+		m.moveGlobalSearchCursor(-1)
+		return m, nil
+	case "down", "ctrl+n":
+		m.moveGlobalSearchCursor(1)
+		return m, nil
+	case "tab":
+		m.cycleGlobalSearchFilter(1)
+		return m, nil
+	case "shift+tab":
+		m.cycleGlobalSearchFilter(-1)
+		return m, nil
+	case "ctrl+k":
+		// Close modal with same key
+		m.resetGlobalSearch()
+		m.updateContext()
+		return m, nil
+	}
+
+	// Filter out unparsed mouse escape sequences
+	if isMouseEscapeSequence(msg) {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.globalSearchInput, cmd = m.globalSearchInput.Update(msg)
+	m.clearGlobalSearchModal()
+	return m, tea.Batch(cmd, m.globalSearchInputChanged())
+}
+
+// ensureGlobalSearchModal builds/rebuilds the global search modal.
+func (m *Model) ensureGlobalSearchModal() {
+	modalW := 80
+	if modalW > m.width-4 {
+		modalW = m.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if m.globalSearchModal != nil && m.globalSearchModalWidth == modalW {
+		return
+	}
+	m.globalSearchModalWidth = modalW
+
+	m.globalSearchModal = modal.New("Search",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(modal.Input(globalSearchInputID, &m.globalSearchInput, modal.WithSubmitOnEnter(false))).
+		AddSection(m.globalSearchFilterSection()).
+		AddSection(modal.Spacer()).
+		AddSection(m.globalSearchListSection()).
+		AddSection(m.globalSearchHintsSection())
+}
+
+// globalSearchFilterSection renders the kind filter bar with result counts.
+func (m *Model) globalSearchFilterSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		counts := countSearchResults(m.globalSearchResults)
+		total := 0
+		for _, c := range counts {
+			total += c
+		}
+		activeStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+
+		parts := make([]string, 0, len(plugin.SearchKinds)+1)
+		for _, kind := range append([]plugin.SearchKind{""}, plugin.SearchKinds...) {
+			n := counts[kind]
+			if kind == "" {
+				n = total
+			}
+			label := fmt.Sprintf("%s %d", searchKindLabel(kind), n)
+			if kind == m.globalSearchFilter {
+				parts = append(parts, activeStyle.Render("["+label+"]"))
+			} else {
+				parts = append(parts, styles.Current().Muted.Render(" "+label+" "))
+			}
+		}
+		line := strings.Join(parts, " ")
+		if m.globalSearchPending > 0 {
+			line += styles.Current().Muted.Render("  searching...")
+		}
+		return modal.RenderedSection{Content: line}
+	}, nil)
+}
+
+// globalSearchListSection renders grouped results with selection.
+func (m *Model) globalSearchListSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		query := strings.TrimSpace(m.globalSearchInput.Value())
+		results := m.globalSearchVisible()
+		if len(results) == 0 {
+			var hint string
+			switch {
+			case len(query) < globalSearchMinQuery:
+				hint = "Type to search"
+			case m.globalSearchPending > 0 || query != m.globalSearchQuery:
+				hint = "Searching..."
+			default:
+				hint = "No results"
+			}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render(hint)}
+		}
+
+		cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+		headerStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning).Bold(true)
+		titleNormalStyle := lipgloss.NewStyle().Foreground(styles.Current().Secondary)
+		titleSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+
+		rows := globalSearchRows(results)
+		start := m.globalSearchScroll
+		end := min(start+globalSearchMaxVisible, len(rows))
+
+		var lines []string
+		focusables := make([]modal.FocusableInfo, 0, end-start)
+		if start > 0 {
+			lines = append(lines, styles.Current().Muted.Render(fmt.Sprintf("  ↑ %d more above", start)))
+		}
+		for _, row := range rows[start:end] {
+			if row.item < 0 {
+				lines = append(lines, headerStyle.Render(searchKindLabel(row.header)))
+				continue
+			}
+			r := results[row.item]
+			itemID := globalSearchItemID(row.item)
+			selected := row.item == m.globalSearchCursor || itemID == hoverID
+
+			prefix := "  "
+			if row.item == m.globalSearchCursor {
+				prefix = cursorStyle.Render("> ")
+			}
+			titleStyle := titleNormalStyle
+			if selected {
+				titleStyle = titleSelectedStyle
+			}
+			titleW := contentWidth - 2
+			detail := ""
+			if r.Detail != "" {
+				detail = "  " + r.Detail
+				titleW = max(contentWidth*3/5, contentWidth-2-lipgloss.Width(detail))
+			}
+			title := ui.TruncateString(strings.Join(strings.Fields(r.Title), " "), titleW)
+			detailW := contentWidth - 2 - lipgloss.Width(title)
+			line := prefix + titleStyle.Render(title)
+			if detail != "" && detailW > 4 {
+				line += styles.Current().Muted.Render(ui.TruncateString(detail, detailW))
+			}
+
+			focusables = append(focusables, modal.FocusableInfo{
+				ID:      itemID,
+				OffsetX: 0,
+				OffsetY: len(lines),
+				Width:   contentWidth,
+				Height:  1,
+			})
+			lines = append(lines, line)
+		}
+		if remaining := len(rows) - end; remaining > 0 {
+			lines = append(lines, styles.Current().Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
+		}
+
+		return modal.RenderedSection{Content: strings.Join(lines, "\n"), Focusables: focusables}
+	}, nil)
+}
+
+// globalSearchHintsSection renders the help text.
+func (m *Model) globalSearchHintsSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		var sb strings.Builder
+		sb.WriteString("\n")
+		sb.WriteString(styles.Current().KeyHint.Render("enter"))
+		sb.WriteString(styles.Current().Muted.Render(" open  "))
+		sb.WriteString(styles.Current().KeyHint.Render("↑/↓"))
+		sb.WriteString(styles.Current().Muted.Render(" navigate  "))
+		sb.WriteString(styles.Current().KeyHint.Render("tab"))
+		sb.WriteString(styles.Current().Muted.Render(" filter  "))
+		sb.WriteString(styles.Current().KeyHint.Render("esc"))
+		sb.WriteString(styles.Current().Muted.Render(" cancel"))
+		return modal.RenderedSection{Content: sb.String()}
+	}, nil)
+}
+
+// renderGlobalSearchModal renders the global search modal.
+func (m *Model) renderGlobalSearchModal(content string) string {
+	m.ensureGlobalSearchModal()
+	if m.globalSearchModal == nil {
+		return content
+	}
+
+	if m.globalSearchMouseHandler == nil {
+		m.globalSearchMouseHandler = mouse.NewHandler()
+	}
+	modalContent := m.globalSearchModal.Render(m.width, m.height, m.globalSearchMouseHandler)
+	return ui.OverlayModal(content, modalContent, m.width, m.height)
+}
+
+// handleGlobalSearchMouse handles mouse events for the global search modal.
+func (m *Model) handleGlobalSearchMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	m.ensureGlobalSearchModal()
+	if m.globalSearchModal == nil {
+		return m, nil
+	}
+	if m.globalSearchMouseHandler == nil {
+		m.globalSearchMouseHandler = mouse.NewHandler()
+	}
+
+	action := m.globalSearchModal.HandleMouse(msg, m.globalSearchMouseHandler)
+
+	if strings.HasPrefix(action, globalSearchItemPrefix) {
+		var idx int
+		if _, err := fmt.Sscanf(action, globalSearchItemPrefix+"%d", &idx); err == nil {
+			return m, m.openGlobalSearchResult(idx)
+		}
+		return m, nil
+	}
+
+	if action == "cancel" {
+		m.resetGlobalSearch()
+		m.updateContext()
+	}
+	return m, nil
+}
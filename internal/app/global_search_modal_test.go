@@ -0,0 +1,90 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestGroupSearchResults(t *testing.T) {
+	bySource := map[string][]plugin.SearchResult{
+		"file-browser": {
+			{Kind: plugin.SearchKindFile, Title: "a.go", Score: 5},
+			{Kind: plugin.SearchKindFile, Title: "b.go", Score: 9},
+			{Kind: plugin.SearchKindFile, Title: "c.go", Score: 1},
+		},
+		"conversations": {
+			{Kind: plugin.SearchKindMessage, Title: "msg", Score: 3},
+			{Kind: plugin.SearchKindSession, Title: "sess", Score: 2},
+		},
+	}
+
+	all := groupSearchResults(bySource, "", 2)
+	var titles []string
+	for _, r := range all {
+		titles = append(titles, r.Title)
+	}
+	want := []string{"sess", "msg", "b.go", "a.go"}
+	if len(titles) != len(want) {
+		t.Fatalf("got %v, want %v", titles, want)
+	}
+	for i := range want {
+		if titles[i] != want[i] {
+			t.Fatalf("got %v, want %v", titles, want)
+		}
+	}
+
+	// Filtering by kind shows the whole group
+	files := groupSearchResults(bySource, plugin.SearchKindFile, 2)
+	if len(files) != 3 || files[0].Title != "b.go" {
+		t.Errorf("file filter = %+v, want 3 files ranked by score", files)
+	}
+
+	counts := countSearchResults(bySource)
+	if counts[plugin.SearchKindFile] != 3 || counts[plugin.SearchKindSession] != 1 {
+		t.Errorf("counts = %v", counts)
+	}
+}
+
+func TestGlobalSearchRows(t *testing.T) {
+	rows := globalSearchRows([]plugin.SearchResult{
+		{Kind: plugin.SearchKindSession},
+		{Kind: plugin.SearchKindSession},
+		{Kind: plugin.SearchKindFile},
+	})
+	// header, item, item, header, item
+	if len(rows) != 5 || rows[0].item != -1 || rows[3].header != plugin.SearchKindFile || rows[4].item != 2 {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestGlobalSearchStaleResultsDropped(t *testing.T) {
+	m := &Model{registry: plugin.NewRegistry(nil), ui: &UIState{}}
+	m.showGlobalSearch = true
+	m.initGlobalSearch()
+	m.globalSearchInput.SetValue("auth")
+	if cmd := m.globalSearchInputChanged(); cmd == nil {
+		t.Fatal("expected debounce tick")
+	}
+	m.startGlobalSearch()
+	seq := m.globalSearchSeq
+
+	m.handleGlobalSearchResults(globalSearchResultsMsg{Seq: seq - 1, Source: "x", Results: []plugin.SearchResult{{Kind: plugin.SearchKindFile}}})
+	if len(m.globalSearchVisible()) != 0 {
+		t.Error("stale results should be dropped")
+	}
+
+	m.handleGlobalSearchResults(globalSearchResultsMsg{Seq: seq, Source: "x", Results: []plugin.SearchResult{{Kind: plugin.SearchKindFile, PluginID: "file-browser"}}})
+	if len(m.globalSearchVisible()) != 1 {
+		t.Fatal("expected current results to be merged")
+	}
+
+	m.cycleGlobalSearchFilter(1)
+	if m.globalSearchFilter != plugin.SearchKindSession || len(m.globalSearchVisible()) != 0 {
+		t.Errorf("filter = %q, want session filter hiding files", m.globalSearchFilter)
+	}
+	m.cycleGlobalSearchFilter(-1)
+	if cmd := m.openGlobalSearchResult(0); cmd == nil || m.showGlobalSearch {
+		t.Error("opening a result should close the modal and focus its plugin")
+	}
+}
@@ -41,32 +41,38 @@ type IssueSearchResultMsg struct {
 // workDir sets the command's working directory so td uses the correct project database.
 func issueSearchCmd(workDir, query string, includeClosed bool) tea.Cmd {
 	return func() tea.Msg {
-		args := []string{"search", query, "--json", "-n", "50"}
-		if !includeClosed {
-			args = append(args, "-s", "open", "-s", "in_progress", "-s", "blocked", "-s", "in_review")
-		}
-		cmd := exec.Command("td", args...)
-		cmd.Dir = workDir
-		out, err := cmd.Output()
-		if err != nil {
-			return IssueSearchResultMsg{Query: query, Error: err}
-		}
-		var wrappers []tdSearchResultWrapper
-		if err := json.Unmarshal(out, &wrappers); err != nil {
-			return IssueSearchResultMsg{Query: query, Error: err}
-		}
-		// Sort by updated_at descending (most recently updated first).
-		sort.Slice(wrappers, func(i, j int) bool {
-			ti, _ := time.Parse(time.RFC3339Nano, wrappers[i].Issue.UpdatedAt)
-			tj, _ := time.Parse(time.RFC3339Nano, wrappers[j].Issue.UpdatedAt)
-			return ti.After(tj)
-		})
-		results := make([]IssueSearchResult, len(wrappers))
-		for i, w := range wrappers {
-			results[i] = w.Issue.IssueSearchResult
-		}
-		return IssueSearchResultMsg{Query: query, Results: results}
+		results, err := runIssueSearch(workDir, query, includeClosed)
+		return IssueSearchResultMsg{Query: query, Results: results, Error: err}
+	}
+}
+
+// runIssueSearch runs `td search` and returns results, most recently updated first.
+func runIssueSearch(workDir, query string, includeClosed bool) ([]IssueSearchResult, error) {
+	args := []string{"search", query, "--json", "-n", "50"}
+	if !includeClosed {
+		args = append(args, "-s", "open", "-s", "in_progress", "-s", "blocked", "-s", "in_review")
+	}
+	cmd := exec.Command("td", args...)
+	cmd.Dir = workDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var wrappers []tdSearchResultWrapper
+	if err := json.Unmarshal(out, &wrappers); err != nil {
+		return nil, err
+	}
+	// Sort by updated_at descending (most recently updated first).
+	sort.Slice(wrappers, func(i, j int) bool {
+		ti, _ := time.Parse(time.RFC3339Nano, wrappers[i].Issue.UpdatedAt)
+		tj, _ := time.Parse(time.RFC3339Nano, wrappers[j].Issue.UpdatedAt)
+		return ti.After(tj)
+	})
+	results := make([]IssueSearchResult, len(wrappers))
+	for i, w := range wrappers {
+		results[i] = w.Issue.IssueSearchResult
 	}
+	return results, nil
 }
 
 // IssuePreviewData holds lightweight issue data fetched via CLI.
@@ -101,23 +101,23 @@ func (m *Model) ensureIssueInputModal() {
 	var hintBuf strings.Builder
 	hasResults := len(m.issueSearchResults) > 0
 	if hasResults {
-		hintBuf.WriteString(styles.KeyHint.Render("enter"))
-		hintBuf.WriteString(styles.Muted.Render(" open  "))
-		hintBuf.WriteString(styles.KeyHint.Render("↑↓"))
-		hintBuf.WriteString(styles.Muted.Render(" select  "))
-		hintBuf.WriteString(styles.KeyHint.Render("tab"))
-		hintBuf.WriteString(styles.Muted.Render(" fill  "))
+		hintBuf.WriteString(styles.Current().KeyHint.Render("enter"))
+		hintBuf.WriteString(styles.Current().Muted.Render(" open  "))
+		hintBuf.WriteString(styles.Current().KeyHint.Render("↑↓"))
+		hintBuf.WriteString(styles.Current().Muted.Render(" select  "))
+		hintBuf.WriteString(styles.Current().KeyHint.Render("tab"))
+		hintBuf.WriteString(styles.Current().Muted.Render(" fill  "))
 	}
 	if m.issueSearchIncludeClosed {
-		hintBuf.WriteString(styles.KeyHint.Render("^x"))
-		hintBuf.WriteString(styles.Muted.Render(" hide closed  "))
+		hintBuf.WriteString(styles.Current().KeyHint.Render("^x"))
+		hintBuf.WriteString(styles.Current().Muted.Render(" hide closed  "))
 	} else {
-		hintBuf.WriteString(styles.KeyHint.Render("^x"))
-		hintBuf.WriteString(styles.Muted.Render(" show closed  "))
+		hintBuf.WriteString(styles.Current().KeyHint.Render("^x"))
+		hintBuf.WriteString(styles.Current().Muted.Render(" show closed  "))
 	}
 	if hasResults {
-		hintBuf.WriteString(styles.KeyHint.Render("esc"))
-		hintBuf.WriteString(styles.Muted.Render(" cancel"))
+		hintBuf.WriteString(styles.Current().KeyHint.Render("esc"))
+		hintBuf.WriteString(styles.Current().Muted.Render(" cancel"))
 	}
 
 	b := modal.New("Open Issue",
@@ -129,15 +129,15 @@ func (m *Model) ensureIssueInputModal() {
 
 	// Status line — always present to avoid layout jumps
 	if m.issueSearchLoading {
-		b = b.AddSection(modal.Text(styles.Muted.Render("Searching...")))
+		b = b.AddSection(modal.Text(styles.Current().Muted.Render("Searching...")))
 	} else if len(m.issueSearchResults) > 0 {
 		countStr := fmt.Sprintf("%d results", len(m.issueSearchResults))
 		if !m.issueSearchIncludeClosed {
 			countStr += " (excluding closed)"
 		}
-		b = b.AddSection(modal.Text(styles.Muted.Render(countStr)))
+		b = b.AddSection(modal.Text(styles.Current().Muted.Render(countStr)))
 	} else {
-		b = b.AddSection(modal.Text(styles.Muted.Render(" ")))
+		b = b.AddSection(modal.Text(styles.Current().Muted.Render(" ")))
 	}
 
 	// Search results dropdown — viewport window over all results
@@ -161,7 +161,7 @@ func (m *Model) ensureIssueInputModal() {
 				tag := formatSearchStatusTag(r.Status)
 				icon := formatSearchTypeIcon(r.Type)
 				pri := formatSearchPriority(r.Priority)
-				idStr := styles.Muted.Render(r.ID)
+				idStr := styles.Current().Muted.Render(r.ID)
 				prefix := fmt.Sprintf(" %s %s %s %s ", tag, icon, idStr, pri)
 				title := r.Title
 				titleWidth := contentWidth - lipgloss.Width(prefix)
@@ -175,9 +175,9 @@ func (m *Model) ensureIssueInputModal() {
 				itemID := fmt.Sprintf("%s%d", issueSearchResultPrefix, i)
 				isHovered := itemID == hoverID
 				if i == searchCursor || isHovered {
-					sb.WriteString(styles.ListItemSelected.Render(line))
+					sb.WriteString(styles.Current().ListItemSelected.Render(line))
 				} else {
-					sb.WriteString(styles.ListItemNormal.Render(line))
+					sb.WriteString(styles.Current().ListItemNormal.Render(line))
 				}
 				if i < endIdx-1 {
 					sb.WriteString("\n")
@@ -303,18 +303,18 @@ func (m *Model) ensureIssuePreviewModal() {
 
 	// Build fixed footer hint string
 	var hintBuf strings.Builder
-	hintBuf.WriteString(styles.KeyHint.Render("j/k"))
-	hintBuf.WriteString(styles.Muted.Render(" scroll  "))
-	hintBuf.WriteString(styles.KeyHint.Render("o"))
-	hintBuf.WriteString(styles.Muted.Render(" open  "))
-	hintBuf.WriteString(styles.KeyHint.Render("b"))
-	hintBuf.WriteString(styles.Muted.Render(" back  "))
-	hintBuf.WriteString(styles.KeyHint.Render("y"))
-	hintBuf.WriteString(styles.Muted.Render(" yank  "))
-	hintBuf.WriteString(styles.KeyHint.Render("Y"))
-	hintBuf.WriteString(styles.Muted.Render(" yank key  "))
-	hintBuf.WriteString(styles.KeyHint.Render("esc"))
-	hintBuf.WriteString(styles.Muted.Render(" close"))
+	hintBuf.WriteString(styles.Current().KeyHint.Render("j/k"))
+	hintBuf.WriteString(styles.Current().Muted.Render(" scroll  "))
+	hintBuf.WriteString(styles.Current().KeyHint.Render("o"))
+	hintBuf.WriteString(styles.Current().Muted.Render(" open  "))
+	hintBuf.WriteString(styles.Current().KeyHint.Render("b"))
+	hintBuf.WriteString(styles.Current().Muted.Render(" back  "))
+	hintBuf.WriteString(styles.Current().KeyHint.Render("y"))
+	hintBuf.WriteString(styles.Current().Muted.Render(" yank  "))
+	hintBuf.WriteString(styles.Current().KeyHint.Render("Y"))
+	hintBuf.WriteString(styles.Current().Muted.Render(" yank key  "))
+	hintBuf.WriteString(styles.Current().KeyHint.Render("esc"))
+	hintBuf.WriteString(styles.Current().Muted.Render(" close"))
 
 	// Build modal
 	b := modal.New(title,
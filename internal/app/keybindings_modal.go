@@ -0,0 +1,420 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+const keybindingsItemPrefix = "keybindings-item-"
+
+// keybindingRow is one command in the keybinding editor.
+type keybindingRow struct {
+	Context string
+	Command string
+	Keys    []string // default bindings in Context
+}
+
+// keybindingsItemID returns the ID for a row at the given index.
+func keybindingsItemID(idx int) string {
+	return fmt.Sprintf("%s%d", keybindingsItemPrefix, idx)
+}
+
+// buildKeybindingRows lists every bound command per context, global first
+// and the rest sorted, with commands in registration order.
+func buildKeybindingRows(km *keymap.Registry) []keybindingRow {
+	contexts := km.AllContexts()
+	sort.Slice(contexts, func(i, j int) bool {
+		if (contexts[i] == "global") != (contexts[j] == "global") {
+			return contexts[i] == "global"
+		}
+		return contexts[i] < contexts[j]
+	})
+
+	var rows []keybindingRow
+	for _, ctx := range contexts {
+		index := make(map[string]int)
+		for _, b := range km.BindingsForContext(ctx) {
+			if i, ok := index[b.Command]; ok {
+				rows[i].Keys = append(rows[i].Keys, b.Key)
+				continue
+			}
+			index[b.Command] = len(rows)
+			rows = append(rows, keybindingRow{Context: ctx, Command: b.Command, Keys: []string{b.Key}})
+		}
+	}
+	return rows
+}
+
+// overrideKeys returns the user override keys for a command, sorted.
+func overrideKeys(km *keymap.Registry, command string) []string {
+	var keys []string
+	for k, id := range km.UserOverrides() {
+		if id == command {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// keybindingConflicts returns the other commands sharing a key with row in
+// its context, either through another binding or a user override.
+func keybindingConflicts(km *keymap.Registry, row keybindingRow) []string {
+	seen := map[string]bool{row.Command: true}
+	var others []string
+	for _, key := range append(append([]string(nil), row.Keys...), overrideKeys(km, row.Command)...) {
+		for _, id := range km.CommandsForKey(key, row.Context) {
+			if !seen[id] {
+				seen[id] = true
+				others = append(others, fmt.Sprintf("%s (%s)", id, key))
+			}
+		}
+	}
+	return others
+}
+
+// openKeybindings shows the keybinding editor.
+func (m *Model) openKeybindings() {
+	m.showKeybindings = true
+	m.activeContext = "keybindings"
+	m.keybindingsRows = buildKeybindingRows(m.keymap)
+	m.keybindingsCursor = 0
+	m.keybindingsCapture = false
+	m.clearKeybindingsModal()
+}
+
+// resetKeybindings closes the keybinding editor.
+func (m *Model) resetKeybindings() {
+	m.showKeybindings = false
+	m.keybindingsRows = nil
+	m.keybindingsCursor = 0
+	m.keybindingsCapture = false
+	m.clearKeybindingsModal()
+}
+
+// clearKeybindingsModal clears the modal cache.
+func (m *Model) clearKeybindingsModal() {
+	m.keybindingsModal = nil
+	m.keybindingsModalWidth = 0
+	m.keybindingsMouseHandler = nil
+}
+
+// selectedKeybinding returns the row under the cursor.
+func (m *Model) selectedKeybinding() (keybindingRow, bool) {
+	if m.keybindingsCursor < 0 || m.keybindingsCursor >= len(m.keybindingsRows) {
+		return keybindingRow{}, false
+	}
+	return m.keybindingsRows[m.keybindingsCursor], true
+}
+
+// rebindCommand replaces the user overrides of command with key, or just
+// removes them when key is empty, and saves the change to the config.
+func (m *Model) rebindCommand(command, key string) tea.Cmd {
+	for k, id := range m.keymap.UserOverrides() {
+		if id == command {
+			m.keymap.RemoveUserOverride(k)
+		}
+	}
+	if key != "" {
+		m.keymap.SetUserOverride(key, command)
+	}
+	if m.cfg != nil {
+		m.cfg.Keymap.Overrides = editOverrides(m.cfg.Keymap.Overrides, command, key)
+	}
+	m.clearKeybindingsModal()
+
+	return func() tea.Msg {
+		// Reload config from disk to avoid overwriting external changes
+		cfg, err := config.Load()
+		if err != nil {
+			return ToastMsg{Message: "Failed to load config: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+		cfg.Keymap.Overrides = editOverrides(cfg.Keymap.Overrides, command, key)
+		if err := config.Save(cfg); err != nil {
+			return ToastMsg{Message: "Key binding changed (save failed: " + err.Error() + ")", Duration: 3 * time.Second, IsError: true}
+		}
+		if key == "" {
+			return ToastMsg{Message: "Reset " + command + " to its default keys", Duration: 2 * time.Second}
+		}
+		return ToastMsg{Message: fmt.Sprintf("Bound %s to %s", key, command), Duration: 2 * time.Second}
+	}
+}
+
+// editOverrides drops the overrides of command from overrides, then binds
+// key to it unless key is empty.
+func editOverrides(overrides map[string]string, command, key string) map[string]string {
+	if overrides == nil {
+		overrides = make(map[string]string)
+	}
+	for k, id := range overrides {
+		if id == command {
+			delete(overrides, k)
+		}
+	}
+	if key != "" {
+		overrides[key] = command
+	}
+	return overrides
+}
+
+// keybindingsVisibleRows is how many list lines fit in the modal.
+func (m *Model) keybindingsVisibleRows() int {
+	n := m.height - 12
+	if n < 5 {
+		n = 5
+	}
+	return n
+}
+
+// ensureKeybindingsModal builds/rebuilds the keybinding editor modal.
+func (m *Model) ensureKeybindingsModal() {
+	modalW := 76
+	if modalW > m.width-4 {
+		modalW = m.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if m.keybindingsModal != nil && m.keybindingsModalWidth == modalW {
+		return
+	}
+	m.keybindingsModalWidth = modalW
+
+	m.keybindingsModal = modal.New("Key Bindings",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(m.keybindingsListSection()).
+		AddSection(modal.Spacer()).
+		AddSection(m.keybindingsDetailSection()).
+		AddSection(modal.Spacer()).
+		AddSection(m.keybindingsHintsSection())
+}
+
+// keybindingsListSection renders the commands under context headings,
+// scrolled to keep the cursor visible.
+func (m *Model) keybindingsListSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		rows := m.keybindingsRows
+		if len(rows) == 0 {
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No key bindings registered")}
+		}
+
+		cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+		headerStyle := lipgloss.NewStyle().Foreground(styles.Current().Secondary).Bold(true)
+		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Current().TextPrimary)
+		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+		overrideStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+
+		// Lay out every line first; rows map to their line for scrolling
+		type line struct {
+			text string
+			row  int // -1 for context headings
+		}
+		var lines []line
+		cursorLine := 0
+		nameW := contentWidth / 2
+		for i, row := range rows {
+			if i == 0 || rows[i-1].Context != row.Context {
+				lines = append(lines, line{text: headerStyle.Render(row.Context), row: -1})
+			}
+			if i == m.keybindingsCursor {
+				cursorLine = len(lines)
+			}
+
+			var sb strings.Builder
+			isCursor := i == m.keybindingsCursor
+			itemID := keybindingsItemID(i)
+			if isCursor {
+				sb.WriteString(cursorStyle.Render("> "))
+			} else {
+				sb.WriteString("  ")
+			}
+			nameStyle := nameNormalStyle
+			if isCursor || itemID == hoverID {
+				nameStyle = nameSelectedStyle
+			}
+			name := row.Command
+			if len(name) > nameW-3 && nameW > 6 {
+				name = name[:nameW-6] + "..."
+			}
+			sb.WriteString(nameStyle.Render(name))
+			if pad := nameW - 2 - len(name); pad > 0 {
+				sb.WriteString(strings.Repeat(" ", pad))
+			}
+
+			if overrides := overrideKeys(m.keymap, row.Command); len(overrides) > 0 {
+				sb.WriteString(overrideStyle.Render(strings.Join(overrides, ", ")))
+				sb.WriteString(styles.Current().Muted.Render(" (" + strings.Join(row.Keys, ", ") + ")"))
+			} else {
+				sb.WriteString(styles.Current().Muted.Render(strings.Join(row.Keys, ", ")))
+			}
+			if len(keybindingConflicts(m.keymap, row)) > 0 {
+				sb.WriteString(styles.Current().StatusModified.Render(" !"))
+			}
+			lines = append(lines, line{text: sb.String(), row: i})
+		}
+
+		visible := m.keybindingsVisibleRows()
+		start := 0
+		if cursorLine >= visible {
+			start = cursorLine - visible + 1
+		}
+		// Show the context heading above the first row of a context
+		if start > 0 && start == cursorLine && lines[start-1].row == -1 {
+			start--
+		}
+		end := start + visible
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		var sb strings.Builder
+		var focusables []modal.FocusableInfo
+		for i, l := range lines[start:end] {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(l.text)
+			if l.row >= 0 {
+				focusables = append(focusables, modal.FocusableInfo{
+					ID:      keybindingsItemID(l.row),
+					OffsetX: 0,
+					OffsetY: i,
+					Width:   contentWidth,
+					Height:  1,
+				})
+			}
+		}
+		return modal.RenderedSection{Content: sb.String(), Focusables: focusables}
+	}, nil)
+}
+
+// keybindingsDetailSection renders the prompt while capturing a key, or
+// the conflicts of the selected command.
+func (m *Model) keybindingsDetailSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		row, ok := m.selectedKeybinding()
+		if !ok {
+			return modal.RenderedSection{}
+		}
+		if m.keybindingsCapture {
+			prompt := fmt.Sprintf("Press the new key for %s (esc cancels)", row.Command)
+			return modal.RenderedSection{Content: lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(prompt)}
+		}
+		if conflicts := keybindingConflicts(m.keymap, row); len(conflicts) > 0 {
+			text := "Conflicts with " + strings.Join(conflicts, ", ")
+			if len(text) > contentWidth && contentWidth > 3 {
+				text = text[:contentWidth-3] + "..."
+			}
+			return modal.RenderedSection{Content: styles.Current().StatusModified.Render(text)}
+		}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render("No conflicts in " + row.Context)}
+	}, nil)
+}
+
+// keybindingsHintsSection renders the key hints.
+func (m *Model) keybindingsHintsSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		return modal.RenderedSection{Content: styles.Current().Subtle.Render("enter rebind · backspace reset · esc close")}
+	}, nil)
+}
+
+// handleKeybindingsKey handles keys while the keybinding editor is open.
+// While capturing, the next key becomes the selected command's binding.
+func (m *Model) handleKeybindingsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.keybindingsCapture {
+		m.keybindingsCapture = false
+		m.clearKeybindingsModal()
+		row, ok := m.selectedKeybinding()
+		if !ok {
+			return m, nil
+		}
+		return m, m.rebindCommand(row.Command, keymap.KeyString(msg))
+	}
+
+	n := len(m.keybindingsRows)
+	page := m.keybindingsVisibleRows() / 2
+	switch msg.String() {
+	case "up", "k", "ctrl+p":
+		if m.keybindingsCursor > 0 {
+			m.keybindingsCursor--
+		}
+	case "down", "j", "ctrl+n":
+		if m.keybindingsCursor < n-1 {
+			m.keybindingsCursor++
+		}
+	case "ctrl+u", "pgup":
+		m.keybindingsCursor = max(m.keybindingsCursor-page, 0)
+	case "ctrl+d", "pgdown":
+		m.keybindingsCursor = max(min(m.keybindingsCursor+page, n-1), 0)
+	case "g", "home":
+		m.keybindingsCursor = 0
+	case "G", "end":
+		m.keybindingsCursor = max(n-1, 0)
+	case "enter":
+		if _, ok := m.selectedKeybinding(); ok {
+			m.keybindingsCapture = true
+		}
+	case "backspace", "delete":
+		if row, ok := m.selectedKeybinding(); ok && len(overrideKeys(m.keymap, row.Command)) > 0 {
+			return m, m.rebindCommand(row.Command, "")
+		}
+	case "%", "q":
+		m.resetKeybindings()
+		m.updateContext()
+		return m, nil
+	}
+	m.clearKeybindingsModal()
+	return m, nil
+}
+
+// renderKeybindingsModal renders the keybinding editor.
+func (m *Model) renderKeybindingsModal(content string) string {
+	m.ensureKeybindingsModal()
+	if m.keybindingsModal == nil {
+		return content
+	}
+
+	if m.keybindingsMouseHandler == nil {
+		m.keybindingsMouseHandler = mouse.NewHandler()
+	}
+	modalContent := m.keybindingsModal.Render(m.width, m.height, m.keybindingsMouseHandler)
+	return ui.OverlayModal(content, modalContent, m.width, m.height)
+}
+
+// handleKeybindingsMouse handles mouse events for the keybinding editor.
+// Clicking a command selects it and waits for its new key.
+func (m *Model) handleKeybindingsMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	m.ensureKeybindingsModal()
+	if m.keybindingsModal == nil {
+		return m, nil
+	}
+	if m.keybindingsMouseHandler == nil {
+		m.keybindingsMouseHandler = mouse.NewHandler()
+	}
+
+	action := m.keybindingsModal.HandleMouse(msg, m.keybindingsMouseHandler)
+	if strings.HasPrefix(action, keybindingsItemPrefix) {
+		var idx int
+		if _, err := fmt.Sscanf(action, keybindingsItemPrefix+"%d", &idx); err == nil && idx < len(m.keybindingsRows) {
+			m.keybindingsCursor = idx
+			m.keybindingsCapture = true
+			m.clearKeybindingsModal()
+		}
+	}
+	return m, nil
+}
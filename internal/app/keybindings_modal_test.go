@@ -0,0 +1,112 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/palette"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestKeybindingsModalRebind(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	km := keymap.NewRegistry()
+	km.RegisterBinding(keymap.Binding{Key: "j", Command: "cursor-down", Context: "global"})
+	km.RegisterBinding(keymap.Binding{Key: "down", Command: "cursor-down", Context: "global"})
+	km.RegisterBinding(keymap.Binding{Key: "x", Command: "delete", Context: "global"})
+	km.SetUserOverride("x", "quit")
+
+	m := Model{registry: plugin.NewRegistry(nil), keymap: km, cfg: config.Default(), ui: &UIState{}, width: 80, height: 40}
+	m.showPalette = true
+	updated, _ := m.Update(palette.CommandSelectedMsg{CommandID: "keybindings", Context: "global"})
+	m = updated.(Model)
+	if !m.showKeybindings || m.activeModal() != ModalKeybindings {
+		t.Fatalf("keybindings modal not open, active modal = %v", m.activeModal())
+	}
+	if len(m.keybindingsRows) != 2 || strings.Join(m.keybindingsRows[0].Keys, ",") != "j,down" {
+		t.Fatalf("rows = %+v", m.keybindingsRows)
+	}
+	if view := m.renderKeybindingsModal(""); !strings.Contains(view, "cursor-down") {
+		t.Error("expected commands in modal content")
+	}
+
+	// The override of "x" shadows delete's default binding
+	if got := keybindingConflicts(km, m.keybindingsRows[1]); len(got) != 1 || got[0] != "quit (x)" {
+		t.Errorf("conflicts = %v", got)
+	}
+
+	// enter waits for the new key, which becomes the override
+	m.handleKeybindingsKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.keybindingsCapture {
+		t.Fatal("enter should wait for a key")
+	}
+	_, cmd := m.handleKeybindingsKey(tea.KeyMsg{Type: tea.KeyCtrlJ})
+	if m.keybindingsCapture || cmd == nil {
+		t.Fatal("key press should rebind")
+	}
+	if msg, ok := cmd().(ToastMsg); !ok || msg.IsError {
+		t.Fatalf("save result = %#v", msg)
+	}
+	if got := km.UserOverrides()["ctrl+j"]; got != "cursor-down" {
+		t.Errorf("override = %q, want cursor-down", got)
+	}
+	if m.cfg.Keymap.Overrides["ctrl+j"] != "cursor-down" {
+		t.Errorf("in-memory config overrides = %v", m.cfg.Keymap.Overrides)
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".config", "forge", "config.json"))
+	if err != nil || !strings.Contains(string(data), `"ctrl+j": "cursor-down"`) {
+		t.Errorf("saved config = %s, %v", data, err)
+	}
+
+	// Rebinding replaces the previous override; backspace resets
+	m.handleKeybindingsKey(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd = m.handleKeybindingsKey(tea.KeyMsg{Type: tea.KeyCtrlL})
+	cmd()
+	if overrides := km.UserOverrides(); overrides["ctrl+l"] != "cursor-down" || overrides["ctrl+j"] != "" {
+		t.Errorf("overrides after rebind = %v", overrides)
+	}
+	_, cmd = m.handleKeybindingsKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	if cmd == nil {
+		t.Fatal("backspace should reset the override")
+	}
+	cmd()
+	if len(overrideKeys(km, "cursor-down")) != 0 {
+		t.Errorf("overrides after reset = %v", km.UserOverrides())
+	}
+
+	// esc cancels waiting for a key before closing the modal
+	m.handleKeybindingsKey(tea.KeyMsg{Type: tea.KeyEnter})
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.keybindingsCapture || !m.showKeybindings {
+		t.Fatal("esc should cancel the key capture only")
+	}
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showKeybindings {
+		t.Error("esc should close the modal")
+	}
+}
+
+func TestKeymapConflictsInDiagnostics(t *testing.T) {
+	km := keymap.NewRegistry()
+	km.RegisterBinding(keymap.Binding{Key: "x", Command: "delete", Context: "global"})
+	km.RegisterBinding(keymap.Binding{Key: "q", Command: "quit", Context: "global"})
+	km.SetUserOverride("x", "quit")
+	m := Model{registry: plugin.NewRegistry(nil), keymap: km, ui: &UIState{}, width: 80, height: 40}
+
+	section := m.diagnosticsKeymapSection().Render(60, "", "")
+	if !strings.Contains(section.Content, `"x" runs quit instead of delete in global`) {
+		t.Errorf("diagnostics = %q", section.Content)
+	}
+
+	km.SetUserOverride("x", "delete")
+	if section := m.diagnosticsKeymapSection().Render(60, "", ""); section.Content != "" {
+		t.Errorf("diagnostics without conflicts = %q", section.Content)
+	}
+}
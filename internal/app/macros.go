@@ -0,0 +1,160 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/state"
+)
+
+// Macro keys: "Q" then a register starts recording and "Q" stops it; "&"
+// then a register plays the macro back.
+const (
+	macroRecordKey = "Q"
+	macroPlayKey   = "&"
+)
+
+// macroKeyMsg is one key of a macro being played back.
+type macroKeyMsg struct {
+	key  tea.KeyMsg
+	last bool
+	play int // macroPlayID of the playback that sent it
+}
+
+// isMacroRegister reports whether key names a macro register, a-z.
+func isMacroRegister(key string) bool {
+	return len(key) == 1 && key[0] >= 'a' && key[0] <= 'z'
+}
+
+// recordMacroKey adds a key the user typed to the macro being recorded.
+// Keys that pick a register are not recorded.
+func (m *Model) recordMacroKey(msg tea.KeyMsg) {
+	if m.macroRecording != "" && m.macroAwait == "" {
+		m.macroKeys = append(m.macroKeys, keymap.KeyString(msg))
+	}
+}
+
+// handleMacroKey starts or stops recording, or asks for the register of a
+// macro to play. Returns false for keys it doesn't handle.
+func (m *Model) handleMacroKey(key string) (bool, tea.Cmd) {
+	switch key {
+	case macroRecordKey:
+		if m.macroRecording != "" {
+			return true, m.stopMacroRecording()
+		}
+		m.macroAwait = macroRecordKey
+		return true, nil
+	case macroPlayKey:
+		if m.macroPlaying {
+			// Macros don't play other macros, which could recurse
+			return true, nil
+		}
+		m.macroAwait = macroPlayKey
+		return true, nil
+	}
+	return false, nil
+}
+
+// handleMacroRegister handles the key after "Q" or "&": a register
+// starts recording or playback, anything else cancels.
+func (m *Model) handleMacroRegister(msg tea.KeyMsg) tea.Cmd {
+	action := m.macroAwait
+	m.macroAwait = ""
+	register := keymap.KeyString(msg)
+	if !isMacroRegister(register) {
+		if msg.Type != tea.KeyEsc {
+			m.ShowToast("Macro registers are a-z", 2*time.Second)
+			m.statusIsError = true
+		}
+		return nil
+	}
+
+	if action == macroRecordKey {
+		m.macroRecording = register
+		m.macroKeys = nil
+		return nil
+	}
+	return m.playMacro(register)
+}
+
+// stopMacroRecording saves the recorded keys to the register, dropping
+// the "Q" that stopped recording.
+func (m *Model) stopMacroRecording() tea.Cmd {
+	register, keys := m.macroRecording, m.macroKeys
+	m.macroRecording, m.macroKeys = "", nil
+	if n := len(keys); n > 0 && keys[n-1] == macroRecordKey {
+		keys = keys[:n-1]
+	}
+
+	return func() tea.Msg {
+		if err := state.SetMacro(register, keys); err != nil {
+			return ToastMsg{Message: "Failed to save macro: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+		if len(keys) == 0 {
+			return ToastMsg{Message: fmt.Sprintf("Cleared macro %s", register), Duration: 2 * time.Second}
+		}
+		return ToastMsg{Message: fmt.Sprintf("Recorded macro %s (%d keys)", register, len(keys)), Duration: 2 * time.Second}
+	}
+}
+
+// playMacro sends the keys of a register one message at a time, so each
+// key sees the results of the ones before it, as when typed. Playback stops
+// early if a key opens an app-level dialog, such as the quit confirmation.
+func (m *Model) playMacro(register string) tea.Cmd {
+	keys := parseKeys(state.GetMacro(register))
+	if len(keys) == 0 {
+		m.ShowToast(fmt.Sprintf("Macro %s is empty", register), 2*time.Second)
+		m.statusIsError = true
+		return nil
+	}
+
+	m.macroPlaying = true
+	m.macroPlayID++
+	cmds := make([]tea.Cmd, len(keys))
+	for i, k := range keys {
+		msg := macroKeyMsg{key: k, last: i == len(keys)-1, play: m.macroPlayID}
+		cmds[i] = func() tea.Msg { return msg }
+	}
+	return tea.Sequence(cmds...)
+}
+
+// handleMacroKeyMsg handles one played-back key as if typed. Keys left
+// over from a stopped playback are dropped.
+func (m *Model) handleMacroKeyMsg(msg macroKeyMsg) (tea.Model, tea.Cmd) {
+	if !m.macroPlaying || msg.play != m.macroPlayID {
+		return m, nil
+	}
+	modal := m.activeModal()
+	model, cmd := m.handleKeyMsg(msg.key)
+	switch {
+	case msg.last:
+		m.macroPlaying = false
+	case m.activeModal() != modal && m.hasModal():
+		// Later keys would land in the dialog, not where they were recorded
+		m.macroPlaying = false
+		m.ShowToast("Macro stopped: a dialog opened", 2*time.Second)
+	}
+	return model, cmd
+}
+
+// macroStatus is the footer indicator while recording a macro or choosing
+// a register, e.g. "recording macro a".
+func (m Model) macroStatus() string {
+	switch m.macroAwait {
+	case macroRecordKey:
+		return "record macro: press a-z"
+	case macroPlayKey:
+		return "play macro: press a-z"
+	}
+	if m.macroRecording != "" {
+		status := "recording macro " + m.macroRecording
+		if n := len(m.macroKeys); n > 0 {
+			status += " (" + strings.Join(m.macroKeys[max(0, n-5):], " ") + ")"
+		}
+		return status
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/state"
+)
+
+// runSequence runs cmd and, for a tea.Sequence, each of its commands in
+// order, returning the messages they produce.
+func runSequence(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if v := reflect.ValueOf(msg); v.Kind() == reflect.Slice && v.Type().Elem() == reflect.TypeOf(tea.Cmd(nil)) {
+		var msgs []tea.Msg
+		for i := 0; i < v.Len(); i++ {
+			msgs = append(msgs, runSequence(v.Index(i).Interface().(tea.Cmd))...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestMacroRecordAndPlay(t *testing.T) {
+	if err := state.InitWithDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	rp := &resizablePlugin{width: 30}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(rp); err != nil {
+		t.Fatal(err)
+	}
+	m := Model{registry: reg, keymap: keymap.NewRegistry(), ui: &UIState{}, width: 120, height: 40}
+	m.updateContext()
+	press := func(k tea.KeyMsg) tea.Cmd {
+		updated, cmd := m.Update(k)
+		m = *updated.(*Model)
+		return cmd
+	}
+
+	// Q a records into register a; Q stops
+	press(runeKey('Q'))
+	if !strings.Contains(m.renderFooter(), "record macro") {
+		t.Errorf("footer missing register prompt: %q", m.renderFooter())
+	}
+	press(runeKey('a'))
+	press(runeKey('='))
+	press(tea.KeyMsg{Type: tea.KeyRight})
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(m.renderFooter(), "recording macro a") {
+		t.Errorf("footer missing recording indicator: %q", m.renderFooter())
+	}
+	cmd := press(runeKey('Q'))
+	if m.macroRecording != "" || cmd == nil {
+		t.Fatal("Q should stop recording")
+	}
+	if msg, ok := cmd().(ToastMsg); !ok || msg.IsError {
+		t.Fatalf("save result = %#v", msg)
+	}
+	if got := strings.Join(state.GetMacro("a"), ","); got != "=,right,enter" {
+		t.Fatalf("recorded keys = %q", got)
+	}
+
+	// & a plays it back as typed, without recording anything
+	width := rp.width
+	press(runeKey('&'))
+	msgs := runSequence(press(runeKey('a')))
+	if len(msgs) != 3 || !m.macroPlaying {
+		t.Fatalf("playback messages = %#v", msgs)
+	}
+	for _, msg := range msgs {
+		updated, _ := m.Update(msg)
+		m = *updated.(*Model)
+	}
+	if m.macroPlaying || m.resizeMode || rp.width != width+5 {
+		t.Errorf("after playback: playing=%v resize=%v width=%d", m.macroPlaying, m.resizeMode, rp.width)
+	}
+
+	// Anything but a register cancels
+	press(runeKey('&'))
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.macroAwait != "" || m.macroPlaying {
+		t.Error("esc should cancel choosing a register")
+	}
+}
+
+func TestMacroPlaybackStopsAtDialog(t *testing.T) {
+	if err := state.InitWithDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	rp := &resizablePlugin{width: 30}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(rp); err != nil {
+		t.Fatal(err)
+	}
+	m := Model{registry: reg, keymap: keymap.NewRegistry(), ui: &UIState{}, width: 120, height: 40}
+	m.updateContext()
+	// Without stopping, esc would close the dialog the first key opened
+	if err := state.SetMacro("b", []string{"$", "esc", "=", "right", "enter"}); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs := runSequence(m.playMacro("b"))
+	for _, msg := range msgs {
+		updated, _ := m.Update(msg)
+		m = *updated.(*Model)
+	}
+	if m.macroPlaying || m.activeModal() != ModalFeatureFlags {
+		t.Fatalf("playing=%v modal=%v; want playback stopped with the dialog open", m.macroPlaying, m.activeModal())
+	}
+	if m.resizeMode || rp.width != 30 {
+		t.Errorf("keys after the dialog opened were replayed: resize=%v width=%d", m.resizeMode, rp.width)
+	}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/community"
 	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/features"
 	"github.com/wilbur182/forge/internal/keymap"
 	"github.com/wilbur182/forge/internal/modal"
 	"github.com/wilbur182/forge/internal/mouse"
@@ -36,7 +38,10 @@ const (
 	ModalQuitConfirm                       // Quit confirmation dialog
 	ModalProjectSwitcher                   // Project switcher
 	ModalWorktreeSwitcher                  // Worktree switcher
+	ModalGlobalSearch                      // App-wide search
 	ModalThemeSwitcher                     // Theme switcher
+	ModalFeatureFlags                      // Feature flag toggles
+	ModalKeybindings                       // Keybinding editor
 	ModalIssueInput                        // Issue ID text input
 	ModalIssuePreview                      // Issue preview display (lowest priority)
 )
@@ -59,8 +64,14 @@ func (m *Model) activeModal() ModalKind {
 		return ModalProjectSwitcher
 	case m.showWorktreeSwitcher:
 		return ModalWorktreeSwitcher
+	case m.showGlobalSearch:
+		return ModalGlobalSearch
 	case m.showThemeSwitcher:
 		return ModalThemeSwitcher
+	case m.showFeatureFlags:
+		return ModalFeatureFlags
+	case m.showKeybindings:
+		return ModalKeybindings
 	case m.showIssueInput:
 		return ModalIssueInput
 	case m.showIssuePreview:
@@ -90,7 +101,8 @@ type projectAddState struct {
 // Model is the root Bubble Tea model for the sidecar application.
 type Model struct {
 	// Configuration
-	cfg *config.Config
+	cfg        *config.Config
+	configPath string // file cfg was loaded from, watched for theme edits
 
 	// Plugin management
 	registry     *plugin.Registry
@@ -99,6 +111,17 @@ type Model struct {
 	// Keymap
 	keymap        *keymap.Registry
 	activeContext string
+	chordSeq      int  // bumped per chord key press; stale timeouts are ignored
+	keymapBypass  bool // set while replaying keys for a remapped command
+	showWhichKey  bool // continuations of the pending chord are shown
+	keyCaptured   bool // set while handling a key captured from interactive mode
+
+	// Macros
+	macroAwait     string   // "Q" or "&" while waiting for a register key
+	macroRecording string   // register being recorded, "" when not recording
+	macroKeys      []string // keys recorded so far
+	macroPlaying   bool     // set while a macro's keys are played back
+	macroPlayID    int      // current playback, so keys from a stopped one are dropped
 
 	// UI state
 	width, height           int
@@ -157,6 +180,20 @@ type Model struct {
 	worktreeSwitcherMouseHandler *mouse.Handler
 	worktreeCheckCounter         int // Counter for periodic worktree existence check
 
+	// Global search modal
+	showGlobalSearch         bool
+	globalSearchInput        textinput.Model
+	globalSearchQuery        string                           // query the current results belong to
+	globalSearchSeq          int                              // bumped on input change; stale results are dropped
+	globalSearchResults      map[string][]plugin.SearchResult // results by source (plugin ID or "td")
+	globalSearchPending      int                              // sources still searching
+	globalSearchFilter       plugin.SearchKind                // "" shows all kinds
+	globalSearchCursor       int
+	globalSearchScroll       int
+	globalSearchModal        *modal.Modal
+	globalSearchModalWidth   int
+	globalSearchMouseHandler *mouse.Handler
+
 	// Worktree info cache (avoids git subprocess forks on every View render)
 	cachedWorktreeInfo *WorktreeInfo
 
@@ -170,6 +207,23 @@ type Model struct {
 	themeSwitcherFiltered     []themeEntry
 	themeSwitcherOriginal     themeEntry // original theme to restore on cancel
 	themeSwitcherScope        string     // "global" or "project"
+	themeWatcher              *theme.Watcher
+
+	// Feature flags modal
+	showFeatureFlags         bool
+	featureFlagsModal        *modal.Modal
+	featureFlagsModalWidth   int
+	featureFlagsMouseHandler *mouse.Handler
+	featureFlagsCursor       int
+
+	// Keybinding editor modal
+	showKeybindings         bool
+	keybindingsModal        *modal.Modal
+	keybindingsModalWidth   int
+	keybindingsMouseHandler *mouse.Handler
+	keybindingsCursor       int
+	keybindingsRows         []keybindingRow
+	keybindingsCapture      bool // next key becomes the selected command's binding
 
 	// Issue preview - input phase
 	showIssueInput         bool
@@ -198,6 +252,10 @@ type Model struct {
 	// Header/footer
 	ui *UIState
 
+	// Keyboard pane resize mode
+	resizeMode    bool
+	resizePercent int
+
 	// Status/toast messages
 	statusMsg     string
 	statusExpiry  time.Time
@@ -254,7 +312,7 @@ type Model struct {
 
 // New creates a new application model.
 // initialPluginID optionally specifies which plugin to focus on startup (empty = first plugin).
-func New(reg *plugin.Registry, km *keymap.Registry, cfg *config.Config, currentVersion, workDir, projectRoot, initialPluginID string) Model {
+func New(reg *plugin.Registry, km *keymap.Registry, cfg *config.Config, configPath, currentVersion, workDir, projectRoot, initialPluginID string) Model {
 	repoName := GetRepoName(workDir)
 	ui := NewUIState()
 	ui.WorkDir = workDir
@@ -276,6 +334,7 @@ func New(reg *plugin.Registry, km *keymap.Registry, cfg *config.Config, currentV
 
 	return Model{
 		cfg:               cfg,
+		configPath:        configPath,
 		registry:          reg,
 		keymap:            km,
 		activePlugin:      activeIdx,
@@ -297,6 +356,24 @@ func (m Model) Init() tea.Cmd {
 		IntroTick(),
 		version.CheckAsync(m.currentVersion),
 		version.CheckTdAsync(),
+		startThemeWatcher(m.configPath),
+		borderAnimTick(),
+	}
+
+	// Surface custom theme files that failed to load (details in diagnostics)
+	if errs := theme.LoadErrors(); len(errs) > 0 {
+		cmds = append(cmds, func() tea.Msg {
+			return ToastMsg{Message: fmt.Sprintf("%d custom theme file(s) failed to load; press ! for details", len(errs)), Duration: 5 * time.Second, IsError: true}
+		})
+	}
+
+	// Surface key overrides that shadow plugin bindings (details in diagnostics)
+	if m.keymap != nil {
+		if conflicts := m.keymap.Conflicts(); len(conflicts) > 0 {
+			cmds = append(cmds, func() tea.Msg {
+				return ToastMsg{Message: fmt.Sprintf("%d key binding conflict(s); press ! for details", len(conflicts)), Duration: 5 * time.Second, IsError: true}
+			})
+		}
 	}
 
 	// Start all registered plugins
@@ -738,6 +815,11 @@ func (m *Model) switchProject(projectPath string) tea.Cmd {
 	resolved := theme.ResolveTheme(m.cfg, targetPath)
 	theme.ApplyResolved(resolved)
 
+	// Swap in the new project's feature flags before plugins restart
+	if err := features.LoadProject(newProjectRoot); err != nil {
+		slog.Warn("project feature flags", "err", err)
+	}
+
 	// Reinitialize all plugins with the new working directory and project root
 	// This stops all plugins, updates the context, and starts them again
 	startCmds := m.registry.Reinit(targetPath, newProjectRoot)
@@ -1145,6 +1227,14 @@ func (m *Model) backToIssueInput() {
 	m.issueInputMouseHandler = mouse.NewHandler()
 }
 
+// openThemeSwitcher shows the theme switcher. Moving the cursor previews
+// each theme live; enter saves it to config and esc restores the original.
+func (m *Model) openThemeSwitcher() {
+	m.showThemeSwitcher = true
+	m.activeContext = "theme-switcher"
+	m.initThemeSwitcher()
+}
+
 // initThemeSwitcher initializes the theme switcher modal.
 func (m *Model) initThemeSwitcher() {
 	ti := textinput.New()
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+// paneResizeBigStep is the step multiplier for shift+arrow / H / L.
+const paneResizeBigStep = 3
+
+// activePaneResizer returns the active plugin's divider control when it can
+// currently be resized from the keyboard.
+func (m *Model) activePaneResizer() plugin.PaneResizer {
+	p := m.ActivePlugin()
+	if p == nil {
+		return nil
+	}
+	if !m.registry.Supports(p, plugin.CapPaneResize) {
+		return nil
+	}
+	r, ok := p.(plugin.PaneResizer)
+	if !ok || !r.CanResizePane() {
+		return nil
+	}
+	return r
+}
+
+// enterResizeMode starts keyboard pane resizing. The first call reports the
+// current width without moving the divider.
+func (m *Model) enterResizeMode(r plugin.PaneResizer) tea.Cmd {
+	m.resizeMode = true
+	m.activeContext = "pane-resize"
+	percent, cmd := r.ResizePane(0)
+	m.resizePercent = percent
+	return cmd
+}
+
+// exitResizeMode leaves keyboard pane resizing.
+func (m *Model) exitResizeMode() {
+	m.resizeMode = false
+	m.updateContext()
+}
+
+// handleResizeKey processes a key while resize mode is active. Keys other
+// than resize controls end the mode and are reported unhandled so they
+// take their normal action.
+func (m *Model) handleResizeKey(msg tea.KeyMsg) (bool, tea.Cmd) {
+	r := m.activePaneResizer()
+	if r == nil {
+		m.exitResizeMode()
+		return false, nil
+	}
+
+	var delta int
+	switch msg.String() {
+	case "left", "h", "ctrl+left":
+		delta = -1
+	case "right", "l", "ctrl+right":
+		delta = 1
+	case "shift+left", "H":
+		delta = -paneResizeBigStep
+	case "shift+right", "L":
+		delta = paneResizeBigStep
+	case "esc", "enter", "=":
+		m.exitResizeMode()
+		return true, nil
+	default:
+		m.exitResizeMode()
+		return false, nil
+	}
+
+	percent, cmd := r.ResizePane(delta)
+	m.resizePercent = percent
+	return true, cmd
+}
+
+// resizeStatus is the footer feedback shown while resizing.
+func (m Model) resizeStatus() string {
+	return fmt.Sprintf("◂▸ sidebar %d%%", m.resizePercent)
+}
+
+// resizeFooterHints lists the resize-mode keys.
+func resizeFooterHints() []footerHint {
+	return []footerHint{
+		{keys: "←/→", label: "resize"},
+		{keys: "H/L", label: "big step"},
+		{keys: "esc", label: "done"},
+	}
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+// resizablePlugin is a minimal plugin with a divider measured in percent.
+type resizablePlugin struct {
+	width    int
+	disabled bool
+}
+
+func (p *resizablePlugin) ID() string                              { return "resizable" }
+func (p *resizablePlugin) Name() string                            { return "Resizable" }
+func (p *resizablePlugin) Icon() string                            { return "" }
+func (p *resizablePlugin) Init(*plugin.Context) error              { return nil }
+func (p *resizablePlugin) Start() tea.Cmd                          { return nil }
+func (p *resizablePlugin) Stop()                                   {}
+func (p *resizablePlugin) Update(tea.Msg) (plugin.Plugin, tea.Cmd) { return p, nil }
+func (p *resizablePlugin) View(int, int) string                    { return "" }
+func (p *resizablePlugin) IsFocused() bool                         { return true }
+func (p *resizablePlugin) SetFocused(bool)                         {}
+func (p *resizablePlugin) Commands() []plugin.Command              { return nil }
+func (p *resizablePlugin) FocusContext() string                    { return "resizable" }
+func (p *resizablePlugin) CanResizePane() bool                     { return !p.disabled }
+func (p *resizablePlugin) ResizePane(delta int) (int, tea.Cmd) {
+	p.width += delta * 5
+	return p.width, nil
+}
+
+func runeKey(r rune) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}} }
+
+func TestPaneResizeMode(t *testing.T) {
+	rp := &resizablePlugin{width: 30}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(rp); err != nil {
+		t.Fatal(err)
+	}
+	m := &Model{registry: reg, keymap: keymap.NewRegistry(), ui: &UIState{}, width: 120}
+
+	m.handleKeyMsg(runeKey('='))
+	if !m.resizeMode || m.activeContext != "pane-resize" || m.resizePercent != 30 {
+		t.Fatalf("expected resize mode at 30%%, got mode=%v ctx=%q pct=%d", m.resizeMode, m.activeContext, m.resizePercent)
+	}
+
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRight})
+	m.handleKeyMsg(runeKey('L'))
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyCtrlLeft})
+	if rp.width != 45 || m.resizePercent != 45 {
+		t.Errorf("width = %d (%d%%), want 45", rp.width, m.resizePercent)
+	}
+	if footer := m.renderFooter(); !strings.Contains(footer, "sidebar 45%") {
+		t.Errorf("footer missing resize feedback: %q", footer)
+	}
+
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.resizeMode || m.activeContext != "resizable" {
+		t.Errorf("esc should leave resize mode, ctx=%q", m.activeContext)
+	}
+
+	// No resize mode when the plugin cannot resize right now
+	rp.disabled = true
+	m.handleKeyMsg(runeKey('='))
+	if m.resizeMode {
+		t.Error("resize mode entered while plugin is not resizable")
+	}
+}
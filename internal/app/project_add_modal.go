@@ -81,10 +81,10 @@ func (m *Model) projectAddNameSection() modal.Section {
 		// Input field style based on focus
 		inputStyle := lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.TextMuted).
+			BorderForeground(styles.Current().TextMuted).
 			Padding(0, 1)
 		if isFocused {
-			inputStyle = inputStyle.BorderForeground(styles.Primary)
+			inputStyle = inputStyle.BorderForeground(styles.Current().Primary)
 		}
 
 		sb.WriteString(inputStyle.Render(m.projectAdd.nameInput.View()))
@@ -152,10 +152,10 @@ func (m *Model) projectAddPathSection() modal.Section {
 		// Input field style based on focus
 		inputStyle := lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.TextMuted).
+			BorderForeground(styles.Current().TextMuted).
 			Padding(0, 1)
 		if isFocused {
-			inputStyle = inputStyle.BorderForeground(styles.Primary)
+			inputStyle = inputStyle.BorderForeground(styles.Current().Primary)
 		}
 
 		sb.WriteString(inputStyle.Render(m.projectAdd.pathInput.View()))
@@ -217,10 +217,10 @@ func (m *Model) projectAddThemeSection() modal.Section {
 		// Field style based on focus/hover
 		fieldStyle := lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.TextMuted).
+			BorderForeground(styles.Current().TextMuted).
 			Padding(0, 1)
 		if focusID == projectAddThemeID || hoverID == projectAddThemeID {
-			fieldStyle = fieldStyle.BorderForeground(styles.Primary)
+			fieldStyle = fieldStyle.BorderForeground(styles.Current().Primary)
 		}
 
 		sb.WriteString(fieldStyle.Render(themeValue))
@@ -259,7 +259,7 @@ func (m *Model) projectAddThemeUpdate(msg tea.Msg, focusID string) (string, tea.
 // projectAddErrorSection renders the error message.
 func (m *Model) projectAddErrorSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		errStyle := lipgloss.NewStyle().Foreground(styles.Error)
+		errStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 		if m.projectAdd == nil {
 			return modal.RenderedSection{}
 		}
@@ -272,12 +272,12 @@ func (m *Model) projectAddHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		var sb strings.Builder
 
-		sb.WriteString(styles.KeyHint.Render("tab"))
-		sb.WriteString(styles.Muted.Render(" next  "))
-		sb.WriteString(styles.KeyHint.Render("enter"))
-		sb.WriteString(styles.Muted.Render(" confirm  "))
-		sb.WriteString(styles.KeyHint.Render("esc"))
-		sb.WriteString(styles.Muted.Render(" back"))
+		sb.WriteString(styles.Current().KeyHint.Render("tab"))
+		sb.WriteString(styles.Current().Muted.Render(" next  "))
+		sb.WriteString(styles.Current().KeyHint.Render("enter"))
+		sb.WriteString(styles.Current().Muted.Render(" confirm  "))
+		sb.WriteString(styles.Current().KeyHint.Render("esc"))
+		sb.WriteString(styles.Current().Muted.Render(" back"))
 
 		return modal.RenderedSection{Content: sb.String()}
 	}, nil)
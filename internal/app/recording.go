@@ -0,0 +1,21 @@
+package app
+
+// ActivePluginID returns the active plugin's ID, or "" if none.
+// Together with ActiveContext and TextInputFocused it implements
+// recorder.Inspector for interaction recording.
+func (m Model) ActivePluginID() string {
+	if p := m.ActivePlugin(); p != nil {
+		return p.ID()
+	}
+	return ""
+}
+
+// ActiveContext returns the current keymap context.
+func (m Model) ActiveContext() string {
+	return m.activeContext
+}
+
+// TextInputFocused reports whether printable keys are going to a text input.
+func (m Model) TextInputFocused() bool {
+	return m.consumesTextInput()
+}
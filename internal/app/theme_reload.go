@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/theme"
+)
+
+// themeWatchStartedMsg delivers the watcher for the config and theme files.
+type themeWatchStartedMsg struct {
+	Watcher *theme.Watcher
+}
+
+// themeFilesChangedMsg signals that the config file or a custom theme file
+// was written.
+type themeFilesChangedMsg struct{}
+
+// startThemeWatcher watches the config file at path and the custom theme
+// directory so theme edits apply without restarting.
+func startThemeWatcher(path string) tea.Cmd {
+	return func() tea.Msg {
+		if path == "" {
+			return nil
+		}
+		w, err := theme.NewWatcher(path, theme.CustomThemesDir())
+		if err != nil {
+			slog.Debug("theme watcher", "err", err)
+			return nil
+		}
+		return themeWatchStartedMsg{Watcher: w}
+	}
+}
+
+// listenForThemeChanges waits for the next config or theme file change.
+func listenForThemeChanges(w *theme.Watcher) tea.Cmd {
+	if w == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		if _, ok := <-w.Events(); !ok {
+			return nil
+		}
+		return themeFilesChangedMsg{}
+	}
+}
+
+// reloadTheme re-reads custom theme files and the theme and accessibility
+// settings of the config, then re-applies the resolved theme. The rest of
+// the config is shared with plugins and stays as loaded at startup. An
+// open theme or project switcher keeps its live preview; the reloaded
+// settings apply when it closes.
+func (m *Model) reloadTheme() tea.Cmd {
+	_, errs := theme.LoadCustomThemes(theme.CustomThemesDir())
+	m.clearDiagnosticsModal()
+
+	cfg, err := config.LoadFrom(m.configPath)
+	if err != nil {
+		return func() tea.Msg {
+			return ToastMsg{Message: "Config reload failed: " + err.Error(), Duration: 5 * time.Second, IsError: true}
+		}
+	}
+	m.cfg.UI.Theme = cfg.UI.Theme
+	m.cfg.Accessibility = cfg.Accessibility
+	for i := range m.cfg.Projects.List {
+		m.cfg.Projects.List[i].Theme = nil
+		for _, proj := range cfg.Projects.List {
+			if proj.Path == m.cfg.Projects.List[i].Path {
+				m.cfg.Projects.List[i].Theme = proj.Theme
+				break
+			}
+		}
+	}
+
+	styles.SetColorblindMode(m.cfg.Accessibility.ColorblindMode)
+	if !m.showThemeSwitcher && !m.showProjectSwitcher {
+		theme.ApplyResolved(theme.ResolveTheme(m.cfg, m.ui.WorkDir))
+	}
+
+	if len(errs) > 0 {
+		return func() tea.Msg {
+			return ToastMsg{Message: fmt.Sprintf("%d custom theme file(s) failed to load; press ! for details", len(errs)), Duration: 5 * time.Second, IsError: true}
+		}
+	}
+	return nil
+}
+
+// stopThemeWatcher stops watching for theme changes, e.g. on quit.
+func (m *Model) stopThemeWatcher() {
+	if m.themeWatcher != nil {
+		m.themeWatcher.Stop()
+		m.themeWatcher = nil
+	}
+}
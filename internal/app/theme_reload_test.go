@@ -0,0 +1,39 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/theme"
+)
+
+func TestReloadTheme_OnlyThemeSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	body := `{"ui":{"showClock":false,"theme":{"name":"dracula"}},"accessibility":{"colorblindMode":"protanopia"}}`
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		styles.SetColorblindMode("")
+		theme.ApplyResolved(theme.ResolveTheme(config.Default(), ""))
+	})
+
+	cfg := config.Default()
+	m := &Model{cfg: cfg, configPath: path, ui: &UIState{}}
+	if cmd := m.reloadTheme(); cmd != nil {
+		t.Fatalf("reload failed: %v", cmd())
+	}
+
+	if m.cfg != cfg {
+		t.Fatal("config replaced; plugins would keep the old one")
+	}
+	if cfg.UI.Theme.Name != "dracula" || cfg.Accessibility.ColorblindMode != "protanopia" {
+		t.Errorf("theme settings not reloaded: %+v %+v", cfg.UI.Theme, cfg.Accessibility)
+	}
+	if !cfg.UI.ShowClock {
+		t.Error("non-theme settings should stay as loaded at startup")
+	}
+}
@@ -134,7 +134,7 @@ func (m *Model) themeSwitcherCountSection() modal.Section {
 		if text == "" {
 			return modal.RenderedSection{Content: ""}
 		}
-		return modal.RenderedSection{Content: styles.Muted.Render(text)}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render(text)}
 	}, nil)
 }
 
@@ -144,13 +144,13 @@ func (m *Model) themeSwitcherListSection() modal.Section {
 		themes := m.themeSwitcherFiltered
 
 		if len(themes) == 0 {
-			return modal.RenderedSection{Content: styles.Muted.Render("No matches")}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No matches")}
 		}
 
-		cursorStyle := lipgloss.NewStyle().Foreground(styles.Primary)
-		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Secondary)
-		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
-		nameCurrentStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
+		cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Current().Secondary)
+		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+		nameCurrentStyle := lipgloss.NewStyle().Foreground(styles.Current().Success).Bold(true)
 
 		maxVisible := 12
 		visibleCount := min(maxVisible, len(themes))
@@ -179,7 +179,7 @@ func (m *Model) themeSwitcherListSection() modal.Section {
 		lineOffset := 0
 
 		if scrollOffset > 0 {
-			sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ↑ %d more above", scrollOffset)))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↑ %d more above", scrollOffset)))
 			sb.WriteString("\n")
 			lineOffset++
 		}
@@ -189,7 +189,7 @@ func (m *Model) themeSwitcherListSection() modal.Section {
 
 			// Render separator lines (non-selectable)
 			if entry.IsSeparator {
-				sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ── %s ──", entry.SeparatorText)))
+				sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ── %s ──", entry.SeparatorText)))
 				sb.WriteString("\n")
 				continue
 			}
@@ -236,7 +236,7 @@ func (m *Model) themeSwitcherListSection() modal.Section {
 			sb.WriteString(nameStyle.Render(entry.Name))
 
 			if isCurrent {
-				sb.WriteString(styles.Muted.Render(" (current)"))
+				sb.WriteString(styles.Current().Muted.Render(" (current)"))
 			}
 			sb.WriteString("\n")
 
@@ -251,7 +251,7 @@ func (m *Model) themeSwitcherListSection() modal.Section {
 
 		remaining := len(themes) - (scrollOffset + visibleCount)
 		if remaining > 0 {
-			sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
 		}
 
 		return modal.RenderedSection{Content: strings.TrimRight(sb.String(), "\n"), Focusables: focusables}
@@ -314,18 +314,18 @@ func (m *Model) themeSwitcherScopeSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		var sb strings.Builder
 
-		activeStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+		activeStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
 
 		scopeGlobal := "Global"
 		scopeProject := "This project"
 		if m.themeSwitcherScope == "project" {
-			sb.WriteString(styles.Muted.Render(scopeGlobal))
-			sb.WriteString(styles.Muted.Render("  │  "))
+			sb.WriteString(styles.Current().Muted.Render(scopeGlobal))
+			sb.WriteString(styles.Current().Muted.Render("  │  "))
 			sb.WriteString(activeStyle.Render(scopeProject))
 		} else {
 			sb.WriteString(activeStyle.Render(scopeGlobal))
-			sb.WriteString(styles.Muted.Render("  │  "))
-			sb.WriteString(styles.Muted.Render(scopeProject))
+			sb.WriteString(styles.Current().Muted.Render("  │  "))
+			sb.WriteString(styles.Current().Muted.Render(scopeProject))
 		}
 
 		return modal.RenderedSection{Content: sb.String()}
@@ -338,23 +338,23 @@ func (m *Model) themeSwitcherHintsSection() modal.Section {
 		var sb strings.Builder
 
 		if len(m.themeSwitcherFiltered) == 0 {
-			sb.WriteString(styles.KeyHint.Render("esc"))
-			sb.WriteString(styles.Muted.Render(" clear filter  "))
-			sb.WriteString(styles.KeyHint.Render("#"))
-			sb.WriteString(styles.Muted.Render(" close"))
+			sb.WriteString(styles.Current().KeyHint.Render("esc"))
+			sb.WriteString(styles.Current().Muted.Render(" clear filter  "))
+			sb.WriteString(styles.Current().KeyHint.Render("#"))
+			sb.WriteString(styles.Current().Muted.Render(" close"))
 		} else {
-			sb.WriteString(styles.KeyHint.Render("enter"))
-			sb.WriteString(styles.Muted.Render(" select  "))
-			sb.WriteString(styles.KeyHint.Render("↑/↓"))
-			sb.WriteString(styles.Muted.Render(" navigate"))
+			sb.WriteString(styles.Current().KeyHint.Render("enter"))
+			sb.WriteString(styles.Current().Muted.Render(" select  "))
+			sb.WriteString(styles.Current().KeyHint.Render("↑/↓"))
+			sb.WriteString(styles.Current().Muted.Render(" navigate"))
 			if m.currentProjectConfig() != nil {
-				sb.WriteString(styles.Muted.Render("  "))
-				sb.WriteString(styles.KeyHint.Render("←/→"))
-				sb.WriteString(styles.Muted.Render(" scope"))
+				sb.WriteString(styles.Current().Muted.Render("  "))
+				sb.WriteString(styles.Current().KeyHint.Render("←/→"))
+				sb.WriteString(styles.Current().Muted.Render(" scope"))
 			}
-			sb.WriteString(styles.Muted.Render("  "))
-			sb.WriteString(styles.KeyHint.Render("esc"))
-			sb.WriteString(styles.Muted.Render(" cancel"))
+			sb.WriteString(styles.Current().Muted.Render("  "))
+			sb.WriteString(styles.Current().KeyHint.Render("esc"))
+			sb.WriteString(styles.Current().Muted.Render(" cancel"))
 		}
 
 		return modal.RenderedSection{Content: sb.String()}
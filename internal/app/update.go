@@ -14,6 +14,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/community"
 	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/features"
 	"github.com/wilbur182/forge/internal/mouse"
 	"github.com/wilbur182/forge/internal/palette"
 	"github.com/wilbur182/forge/internal/plugin"
@@ -46,8 +47,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		m.recordMacroKey(msg)
 		return (&m).handleKeyMsg(msg)
 
+	case macroKeyMsg:
+		return (&m).handleMacroKeyMsg(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -101,8 +106,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleProjectSwitcherMouse(msg)
 		case ModalWorktreeSwitcher:
 			return m.handleWorktreeSwitcherMouse(msg)
+		case ModalGlobalSearch:
+			return m.handleGlobalSearchMouse(msg)
 		case ModalThemeSwitcher:
 			return m.handleThemeSwitcherMouse(msg)
+		case ModalFeatureFlags:
+			return m.handleFeatureFlagsMouse(msg)
+		case ModalKeybindings:
+			return m.handleKeybindingsMouse(msg)
 		case ModalIssueInput:
 			return m.handleIssueInputMouse(msg)
 		case ModalIssuePreview:
@@ -160,6 +171,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case whichKeyMsg:
+		m.handleWhichKey(msg)
+		return m, nil
+
+	case chordTimeoutMsg:
+		cmd := m.handleChordTimeout(msg)
+		return m, cmd
+
 	case IntroTickMsg:
 		if m.intro.Active {
 			m.intro.Update(16 * time.Millisecond)
@@ -173,6 +192,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case borderAnimTickMsg:
+		styles.SetActiveHueShift(borderHueAt(time.Time(msg)))
+		return m, borderAnimTick()
+
+	case plugin.FeatureChangedMsg:
+		// App-level features react here; the message still goes to all plugins
+		if msg.Name == features.AnimatedBorders.Name {
+			if msg.Enabled {
+				cmds = append(cmds, borderAnimTick())
+			} else {
+				styles.SetActiveHueShift(0)
+			}
+		}
+
 	case TickMsg:
 		m.ui.UpdateClock()
 		m.ui.ClearExpiredToast()
@@ -194,6 +227,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case themeWatchStartedMsg:
+		m.themeWatcher = msg.Watcher
+		return m, listenForThemeChanges(m.themeWatcher)
+
+	case themeFilesChangedMsg:
+		return m, tea.Batch(m.reloadTheme(), listenForThemeChanges(m.themeWatcher))
+
 	case ToastMsg:
 		m.ShowToast(msg.Message, msg.Duration)
 		m.statusIsError = msg.IsError
@@ -357,11 +397,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Execute the selected command from the palette
 		m.showPalette = false
 		m.updateContext()
-		// Look up and execute the command
-		if cmd, ok := m.keymap.GetCommand(msg.CommandID); ok && cmd.Handler != nil {
-			return m, cmd.Handler()
+		// App-level commands without a registered handler
+		if msg.CommandID == "switch-theme" {
+			m.openThemeSwitcher()
+			return m, nil
 		}
-		return m, nil
+		if msg.CommandID == "feature-flags" {
+			m.openFeatureFlags()
+			return m, nil
+		}
+		if msg.CommandID == "keybindings" {
+			m.openKeybindings()
+			return m, nil
+		}
+		// Run the command, switching to the plugin it belongs to
+		cmd := m.runPaletteCommand(msg.CommandID, msg.Context)
+		return m, cmd
 
 	case version.UpdateAvailableMsg:
 		m.updateAvailable = &msg
@@ -397,6 +448,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.issuePreviewModalWidth = 0
 		return m, nil
 
+	case globalSearchDebounceMsg:
+		if msg.Seq != m.globalSearchSeq || !m.showGlobalSearch {
+			return m, nil
+		}
+		return m, m.startGlobalSearch()
+
+	case globalSearchResultsMsg:
+		m.handleGlobalSearchResults(msg)
+		return m, nil
+
 	case IssueSearchResultMsg:
 		// Discard stale results
 		if msg.Query != m.issueSearchQuery || !m.showIssueInput {
@@ -432,6 +493,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleKeyMsg processes keyboard input.
 func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The key after "Q" or "&" picks a macro register
+	if m.macroAwait != "" {
+		return m, m.handleMacroRegister(msg)
+	}
+
 	// Close modals with escape (priority order via activeModal)
 	if msg.Type == tea.KeyEsc {
 		switch m.activeModal() {
@@ -490,6 +556,15 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.resetWorktreeSwitcher()
 			m.updateContext()
 			return m, nil
+		case ModalGlobalSearch:
+			// Esc: clear query if set, otherwise close
+			if m.globalSearchInput.Value() != "" {
+				m.globalSearchInput.SetValue("")
+				return m, m.globalSearchInputChanged()
+			}
+			m.resetGlobalSearch()
+			m.updateContext()
+			return m, nil
 		case ModalIssueInput:
 			m.resetIssueInput()
 			m.updateContext()
@@ -511,6 +586,20 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.resetThemeSwitcher()
 			m.updateContext()
 			return m, nil
+		case ModalFeatureFlags:
+			m.resetFeatureFlags()
+			m.updateContext()
+			return m, nil
+		case ModalKeybindings:
+			// Esc: cancel waiting for a key, otherwise close
+			if m.keybindingsCapture {
+				m.keybindingsCapture = false
+				m.clearKeybindingsModal()
+				return m, nil
+			}
+			m.resetKeybindings()
+			m.updateContext()
+			return m, nil
 		}
 	}
 
@@ -522,6 +611,7 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if activePlugin := m.ActivePlugin(); activePlugin != nil {
 				_ = state.SetActivePlugin(m.ui.ProjectRoot, activePlugin.ID())
 			}
+			m.stopThemeWatcher()
 			m.registry.Stop()
 			return m, tea.Quit
 		case "cancel":
@@ -540,7 +630,14 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// This ensures characters like `, ~, ?, !, @, q, 1-5 reach tmux instead of triggering app shortcuts
 	// Ctrl+C is forwarded to tmux (to interrupt running processes) instead of showing quit dialog
 	// User can exit interactive mode with Ctrl+\ first, then quit normally
-	if m.activeContext == "workspace-interactive" || m.activeContext == "file-browser-inline-edit" || m.activeContext == "notes-inline-edit" {
+	// Keys the plugin captures, and keys replayed for them, skip this and
+	// are handled as outside interactive mode
+	switch {
+	case m.keyCaptured:
+	case m.capturesKey(msg):
+		m.keyCaptured = true
+		defer func() { m.keyCaptured = false }()
+	case !m.hasModal() && (m.activeContext == "workspace-interactive" || m.activeContext == "file-browser-inline-edit" || m.activeContext == "notes-inline-edit"):
 		// Forward ALL keys to plugin (exit keys and ctrl+c handled by plugin)
 		if p := m.ActivePlugin(); p != nil {
 			newPlugin, cmd := p.Update(msg)
@@ -725,6 +822,11 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, cmd
 	}
 
+	// Handle global search modal keys (Esc handled above)
+	if m.showGlobalSearch {
+		return m.handleGlobalSearchKeys(msg)
+	}
+
 	// Handle project switcher modal keys (Esc handled above)
 	if m.showProjectSwitcher {
 		// Handle project add sub-mode keys
@@ -1150,11 +1252,33 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, cmd
 	}
 
+	// Handle feature flags modal keys (Esc handled above)
+	if m.showFeatureFlags {
+		return m.handleFeatureFlagsKey(msg)
+	}
+
+	// Handle keybinding editor keys (Esc handled above)
+	if m.showKeybindings {
+		return m.handleKeybindingsKey(msg)
+	}
+
 	// If any modal is open, don't process plugin/toggle keys
 	if m.hasModal() {
 		return m, nil
 	}
 
+	// Keyboard pane resize consumes arrows until finished
+	if m.resizeMode {
+		if handled, cmd := m.handleResizeKey(msg); handled {
+			return m, cmd
+		}
+	}
+
+	// Chords and user overrides take precedence over app and plugin keys
+	if handled, cmd := m.handleKeymap(msg); handled {
+		return m, cmd
+	}
+
 	// Plugin switching
 	switch msg.String() {
 	case "`":
@@ -1239,18 +1363,38 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.updateContext()
 		}
 		return m, nil
+	case "=":
+		// Enter keyboard pane resize when the plugin has a movable divider
+		if r := m.activePaneResizer(); r != nil {
+			return m, m.enterResizeMode(r)
+		}
+	case "ctrl+k":
+		// Open app-wide search
+		m.showGlobalSearch = true
+		m.activeContext = "global-search"
+		m.initGlobalSearch()
+		return m, nil
 	case "#":
 		// Toggle theme switcher modal
-		m.showThemeSwitcher = !m.showThemeSwitcher
-		if m.showThemeSwitcher {
-			m.activeContext = "theme-switcher"
-			m.initThemeSwitcher()
+		if !m.showThemeSwitcher {
+			m.openThemeSwitcher()
 		} else {
+			m.showThemeSwitcher = false
 			m.previewThemeEntry(m.themeSwitcherOriginal)
 			m.resetThemeSwitcher()
 			m.updateContext()
 		}
 		return m, nil
+	case "$":
+		m.openFeatureFlags()
+		return m, nil
+	case "%":
+		m.openKeybindings()
+		return m, nil
+	case macroRecordKey, macroPlayKey:
+		if handled, cmd := m.handleMacroKey(msg.String()); handled {
+			return m, cmd
+		}
 	case "i":
 		if !m.hasModal() {
 			m.showIssueInput = true
@@ -1268,11 +1412,6 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, Refresh()
 	}
 
-	// Try keymap for context-specific bindings
-	if cmd := m.keymap.Handle(msg, m.activeContext); cmd != nil {
-		return m, cmd
-	}
-
 	// Forward to active plugin
 	if p := m.ActivePlugin(); p != nil {
 		newPlugin, cmd := p.Update(msg)
@@ -1299,14 +1438,31 @@ func (m *Model) updateContext() {
 // consumesTextInput returns true when the active context should treat printable
 // keys as text input (block app-level navigation shortcuts).
 func (m *Model) consumesTextInput() bool {
+	if m.keyCaptured {
+		return false
+	}
 	if p := m.ActivePlugin(); p != nil {
-		if c, ok := p.(plugin.TextInputConsumer); ok && c.ConsumesTextInput() {
+		if c, ok := p.(plugin.TextInputConsumer); ok && m.registry.Supports(p, plugin.CapTextInput) && c.ConsumesTextInput() {
 			return true
 		}
 	}
 	return isTextInputContext(m.activeContext)
 }
 
+// capturesKey reports whether the active plugin keeps msg from its embedded
+// terminal app so the app-level shortcuts get it.
+func (m *Model) capturesKey(msg tea.KeyMsg) bool {
+	if m.activeContext != "workspace-interactive" {
+		return false
+	}
+	p := m.ActivePlugin()
+	if p == nil || !m.registry.Supports(p, plugin.CapKeyCapture) {
+		return false
+	}
+	c, ok := p.(plugin.KeyCapturer)
+	return ok && c.CapturesKey(msg.String())
+}
+
 // isRootContext returns true if the context is a root view where 'q' should quit.
 // Root contexts are plugin top-level views (not sub-views like detail/diff/commit).
 func isRootContext(ctx string) bool {
@@ -1603,6 +1759,7 @@ func (m *Model) handleUpdateModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if activePlugin := m.ActivePlugin(); activePlugin != nil {
 				_ = state.SetActivePlugin(m.ui.ProjectRoot, activePlugin.ID())
 			}
+			m.stopThemeWatcher()
 			m.registry.Stop()
 			return m, tea.Quit
 		}
@@ -1615,6 +1772,7 @@ func (m *Model) handleUpdateModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				if activePlugin := m.ActivePlugin(); activePlugin != nil {
 					_ = state.SetActivePlugin(m.ui.ProjectRoot, activePlugin.ID())
 				}
+				m.stopThemeWatcher()
 				m.registry.Stop()
 				return m, tea.Quit
 			case "cancel":
@@ -1742,6 +1900,7 @@ func (m *Model) handleUpdateModalMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 			if activePlugin := m.ActivePlugin(); activePlugin != nil {
 				_ = state.SetActivePlugin(m.ui.ProjectRoot, activePlugin.ID())
 			}
+			m.stopThemeWatcher()
 			m.registry.Stop()
 			return m, tea.Quit
 		case "cancel":
@@ -1784,6 +1943,7 @@ func (m *Model) handleQuitConfirmMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		if activePlugin := m.ActivePlugin(); activePlugin != nil {
 			_ = state.SetActivePlugin(m.ui.ProjectRoot, activePlugin.ID())
 		}
+		m.stopThemeWatcher()
 		m.registry.Stop()
 		return m, tea.Quit
 	case "cancel":
@@ -84,15 +84,15 @@ func (m *Model) ensureUpdatePreviewModal() {
 
 	// TD-only update: build a simpler modal
 	if m.updateAvailable == nil {
-		arrow := lipgloss.NewStyle().Foreground(styles.Success).Render(" → ")
+		arrow := lipgloss.NewStyle().Foreground(styles.Current().Success).Render(" → ")
 		versionLine := fmt.Sprintf("%s%s%s", m.tdVersionInfo.CurrentVersion, arrow, m.tdVersionInfo.LatestVersion)
 
 		var methodHint string
 		switch m.updateInstallMethod {
 		case version.InstallMethodHomebrew:
-			methodHint = styles.Muted.Render("Method: brew upgrade td")
+			methodHint = styles.Current().Muted.Render("Method: brew upgrade td")
 		default:
-			methodHint = styles.Muted.Render("Method: go install")
+			methodHint = styles.Current().Muted.Render("Method: go install")
 		}
 
 		m.updatePreviewModal = modal.New("td Update",
@@ -115,7 +115,7 @@ func (m *Model) ensureUpdatePreviewModal() {
 	// Version line
 	currentV := m.updateAvailable.CurrentVersion
 	latestV := m.updateAvailable.LatestVersion
-	arrow := lipgloss.NewStyle().Foreground(styles.Success).Render(" → ")
+	arrow := lipgloss.NewStyle().Foreground(styles.Current().Success).Render(" → ")
 	versionLine := fmt.Sprintf("%s%s%s", currentV, arrow, latestV)
 
 	// Release notes
@@ -134,11 +134,11 @@ func (m *Model) ensureUpdatePreviewModal() {
 	maxLines := 15
 	if len(lines) > maxLines {
 		lines = lines[:maxLines]
-		lines = append(lines, styles.Muted.Render("... (truncated)"))
+		lines = append(lines, styles.Current().Muted.Render("... (truncated)"))
 	}
 	notesContent := strings.Join(lines, "\n")
 
-	changelogHint := styles.Muted.Render("[c] View Full Changelog")
+	changelogHint := styles.Current().Muted.Render("[c] View Full Changelog")
 
 	// Build method-specific install hint and buttons
 	var methodHint string
@@ -146,7 +146,7 @@ func (m *Model) ensureUpdatePreviewModal() {
 
 	switch m.updateInstallMethod {
 	case version.InstallMethodHomebrew:
-		methodHint = styles.Muted.Render("Method: brew upgrade sidecar")
+		methodHint = styles.Current().Muted.Render("Method: brew upgrade sidecar")
 		buttons = []modal.ButtonDef{
 			modal.Btn(" Update Now ", "update"),
 			modal.Btn(" Later ", "cancel"),
@@ -154,12 +154,12 @@ func (m *Model) ensureUpdatePreviewModal() {
 	case version.InstallMethodBinary:
 		downloadURL := fmt.Sprintf("https://github.com/wilbur182/forge/releases/tag/%s",
 			m.updateAvailable.LatestVersion)
-		methodHint = styles.Muted.Render("Download: " + downloadURL)
+		methodHint = styles.Current().Muted.Render("Download: " + downloadURL)
 		buttons = []modal.ButtonDef{
 			modal.Btn(" Close ", "cancel"),
 		}
 	default:
-		methodHint = styles.Muted.Render("Method: go install")
+		methodHint = styles.Current().Muted.Render("Method: go install")
 		buttons = []modal.ButtonDef{
 			modal.Btn(" Update Now ", "update"),
 			modal.Btn(" Later ", "cancel"),
@@ -267,13 +267,13 @@ func (m *Model) renderUpdateProgressModal() string {
 	var sb strings.Builder
 
 	// Title
-	title := lipgloss.NewStyle().Bold(true).Foreground(styles.Warning).Render("Updating Sidecar")
+	title := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Warning).Render("Updating Sidecar")
 	sb.WriteString(centerText(title, contentW))
 	sb.WriteString("\n\n")
 
 	// Version being installed
 	if m.updateAvailable != nil {
-		version := lipgloss.NewStyle().Foreground(styles.TextMuted).Render(
+		version := lipgloss.NewStyle().Foreground(styles.Current().TextMuted).Render(
 			fmt.Sprintf("Installing %s", m.updateAvailable.LatestVersion))
 		sb.WriteString(centerText(version, contentW))
 		sb.WriteString("\n\n")
@@ -285,18 +285,18 @@ func (m *Model) renderUpdateProgressModal() string {
 	for _, phase := range phases {
 		status := m.updatePhaseStatus[phase]
 		icon := "○" // pending
-		color := styles.TextMuted
+		color := styles.Current().TextMuted
 
 		switch status {
 		case "running":
 			icon = "●"
-			color = styles.Warning
+			color = styles.Current().Warning
 		case "done":
 			icon = "✓"
-			color = styles.Success
+			color = styles.Current().Success
 		case "error":
 			icon = "✗"
-			color = styles.Error
+			color = styles.Current().Error
 		}
 
 		phaseName := phase.StringForMethod(methodStr)
@@ -316,17 +316,17 @@ func (m *Model) renderUpdateProgressModal() string {
 
 	// Elapsed time
 	elapsed := m.getUpdateElapsed()
-	elapsedStr := lipgloss.NewStyle().Foreground(styles.TextMuted).Render(
+	elapsedStr := lipgloss.NewStyle().Foreground(styles.Current().TextMuted).Render(
 		fmt.Sprintf("Elapsed: %s", formatElapsed(elapsed)))
 	sb.WriteString(centerText(elapsedStr, contentW))
 	sb.WriteString("\n\n")
 
 	// Divider
-	sb.WriteString(lipgloss.NewStyle().Foreground(styles.TextMuted).Render(strings.Repeat("─", contentW)))
+	sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().TextMuted).Render(strings.Repeat("─", contentW)))
 	sb.WriteString("\n\n")
 
 	// Cancel hint
-	cancelHint := lipgloss.NewStyle().Foreground(styles.TextMuted).Render("Esc: cancel")
+	cancelHint := lipgloss.NewStyle().Foreground(styles.Current().TextMuted).Render("Esc: cancel")
 	sb.WriteString(centerText(cancelHint, contentW))
 
 	// Constrain modal height to available space per CLAUDE.md
@@ -337,7 +337,7 @@ func (m *Model) renderUpdateProgressModal() string {
 
 	modalStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.TextMuted).
+		BorderForeground(styles.Current().TextMuted).
 		Padding(1, 2).
 		Width(modalW).
 		MaxHeight(maxHeight)
@@ -368,7 +368,7 @@ func (m *Model) ensureUpdateCompleteModal() {
 	}
 	m.updateCompleteModalWidth = modalW
 
-	checkmark := lipgloss.NewStyle().Foreground(styles.Success).Render("✓")
+	checkmark := lipgloss.NewStyle().Foreground(styles.Current().Success).Render("✓")
 
 	var updatesText string
 	if m.updateAvailable != nil {
@@ -380,8 +380,8 @@ func (m *Model) ensureUpdateCompleteModal() {
 		updatesText += fmt.Sprintf("\n  %s td updated to %s", checkmark, m.tdVersionInfo.LatestVersion)
 	}
 
-	restartMsg := styles.Muted.Render("Restart sidecar to use the new version.")
-	tip := styles.Muted.Render("Tip: Press q to quit, then run 'sidecar' again.")
+	restartMsg := styles.Current().Muted.Render("Restart sidecar to use the new version.")
+	tip := styles.Current().Muted.Render("Tip: Press q to quit, then run 'sidecar' again.")
 
 	m.updateCompleteModal = modal.New("Update Complete!",
 		modal.WithWidth(modalW),
@@ -419,7 +419,7 @@ func (m *Model) ensureUpdateErrorModal() {
 	}
 	m.updateErrorModalWidth = modalW
 
-	errorIcon := lipgloss.NewStyle().Foreground(styles.Error).Render("✗")
+	errorIcon := lipgloss.NewStyle().Foreground(styles.Current().Error).Render("✗")
 	phaseName := m.updatePhase.String()
 	errorLine := fmt.Sprintf("  %s Error during: %s", errorIcon, phaseName)
 
@@ -453,15 +453,15 @@ func (m *Model) ensureUpdateErrorModal() {
 		manualFix = "github.com/wilbur182/forge/releases"
 	}
 
-	infoLine := styles.Muted.Render(fmt.Sprintf(
+	infoLine := styles.Current().Muted.Render(fmt.Sprintf(
 		"Version: %s  Method: %s", currentV, methodName))
 
 	// Render error prominently (not muted)
-	errorStyle := lipgloss.NewStyle().Foreground(styles.Error)
+	errorStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 	errorText := errorStyle.Render("  " + errorMsg)
 
-	fixHint := styles.Muted.Render("Manual fix: " + manualFix)
-	reportHint := styles.Muted.Render("Report: github.com/wilbur182/forge/issues")
+	fixHint := styles.Current().Muted.Render("Manual fix: " + manualFix)
+	reportHint := styles.Current().Muted.Render("Report: github.com/wilbur182/forge/issues")
 
 	m.updateErrorModal = modal.New("Update Failed",
 		modal.WithWidth(modalW),
@@ -583,7 +583,7 @@ func (m *Model) ensureChangelogModal() {
 
 		// Add scroll indicator if needed
 		if len(lines) > maxVisible {
-			scrollInfo := styles.Muted.Render(fmt.Sprintf("Lines %d-%d of %d", startLine+1, endLine, len(lines)))
+			scrollInfo := styles.Current().Muted.Render(fmt.Sprintf("Lines %d-%d of %d", startLine+1, endLine, len(lines)))
 			visibleContent += "\n\n" + scrollInfo
 		}
 
@@ -597,7 +597,7 @@ func (m *Model) ensureChangelogModal() {
 	).
 		AddSection(scrollSection).
 		AddSection(modal.Spacer()).
-		AddSection(modal.Text(styles.Muted.Render("j/k scroll   Esc: close"))).
+		AddSection(modal.Text(styles.Current().Muted.Render("j/k scroll   Esc: close"))).
 		AddSection(modal.Buttons(
 			modal.Btn(" Close ", "cancel"),
 		))
@@ -1,6 +1,14 @@
 package app
 
-import "testing"
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/keymap"
+	"github.com/wilbur182/forge/internal/plugin"
+)
 
 func TestIsGlobalRefreshContext(t *testing.T) {
 	tests := []struct {
@@ -129,3 +137,45 @@ func TestIsTextInputContext(t *testing.T) {
 		})
 	}
 }
+
+// capturingPlugin embeds a terminal app that doesn't get the keys in capture.
+type capturingPlugin struct {
+	keyPlugin
+	capture []string
+}
+
+func (p *capturingPlugin) CapturesKey(key string) bool { return slices.Contains(p.capture, key) }
+
+func TestInteractiveCapturedKeys(t *testing.T) {
+	term := &capturingPlugin{keyPlugin: keyPlugin{id: "term", context: "workspace-interactive"}, capture: []string{"`", "ctrl+c"}}
+	other := &keyPlugin{id: "other", context: "other"}
+	reg := plugin.NewRegistry(nil)
+	for _, p := range []plugin.Plugin{term, other} {
+		if err := reg.Register(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := Model{registry: reg, keymap: keymap.NewRegistry(), ui: &UIState{}, width: 120, height: 40}
+	m.updateContext()
+
+	// Other keys, including app shortcuts, go to the terminal app
+	m.handleKeyMsg(runeKey('?'))
+	m.handleKeyMsg(runeKey('~'))
+	if strings.Join(term.keys, ",") != "?,~" || m.showPalette || m.activePlugin != 0 {
+		t.Fatalf("forwarded keys = %v, palette = %v, active = %d", term.keys, m.showPalette, m.activePlugin)
+	}
+
+	// Captured keys reach the app-level shortcuts instead
+	m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if !m.showQuitConfirm {
+		t.Error("captured ctrl+c should ask to quit")
+	}
+	m.showQuitConfirm = false
+	m.handleKeyMsg(runeKey('`'))
+	if m.activePlugin != 1 || len(term.keys) != 2 {
+		t.Errorf("captured ` should switch plugins: active = %d, forwarded = %v", m.activePlugin, term.keys)
+	}
+	if m.keyCaptured {
+		t.Error("capture flag should be cleared after the key")
+	}
+}
@@ -40,7 +40,7 @@ func (m Model) View() string {
 		msg := fmt.Sprintf("Terminal too small (%dx%d)\nMinimum: %dx%d",
 			m.width, m.height, minWidth, minHeight)
 		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
-			styles.StatusBlocked.Render(msg))
+			styles.Current().StatusBlocked.Render(msg))
 	}
 
 	// Calculate content area
@@ -87,14 +87,23 @@ func (m Model) View() string {
 		return m.renderProjectSwitcherOverlay(bg)
 	case ModalWorktreeSwitcher:
 		return m.renderWorktreeSwitcherModal(bg)
+	case ModalGlobalSearch:
+		return m.renderGlobalSearchModal(bg)
 	case ModalThemeSwitcher:
 		return m.renderThemeSwitcherModal(bg)
+	case ModalFeatureFlags:
+		return m.renderFeatureFlagsModal(bg)
+	case ModalKeybindings:
+		return m.renderKeybindingsModal(bg)
 	case ModalIssueInput:
 		return m.renderIssueInputOverlay(bg)
 	case ModalIssuePreview:
 		return m.renderIssuePreviewOverlay(bg)
 	}
 
+	if m.showWhichKey {
+		return m.renderWhichKey(bg)
+	}
 	return bg
 }
 
@@ -158,7 +167,7 @@ func (m *Model) projectSwitcherCountSection() modal.Section {
 		} else if len(allProjects) > 0 {
 			countText = fmt.Sprintf("%d projects", len(allProjects))
 		}
-		return modal.RenderedSection{Content: styles.Muted.Render(countText)}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render(countText)}
 	}, nil)
 }
 
@@ -172,13 +181,13 @@ func (m *Model) projectSwitcherListSection() modal.Section {
 
 		// No projects configured
 		if len(allProjects) == 0 {
-			b.WriteString(styles.Muted.Render("No projects configured"))
+			b.WriteString(styles.Current().Muted.Render("No projects configured"))
 			return modal.RenderedSection{Content: b.String()}
 		}
 
 		// Empty filtered state
 		if len(projects) == 0 {
-			b.WriteString(styles.Muted.Render("No matches"))
+			b.WriteString(styles.Current().Muted.Render("No matches"))
 			return modal.RenderedSection{Content: b.String()}
 		}
 
@@ -194,16 +203,16 @@ func (m *Model) projectSwitcherListSection() modal.Section {
 		lineOffset := 0
 
 		if scrollOffset > 0 {
-			b.WriteString(styles.Muted.Render(fmt.Sprintf("  ↑ %d more above", scrollOffset)))
+			b.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↑ %d more above", scrollOffset)))
 			b.WriteString("\n")
 			lineOffset++
 		}
 
-		cursorStyle := lipgloss.NewStyle().Foreground(styles.Primary)
-		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Secondary)
-		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
-		nameCurrentStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
-		nameCurrentSelectedStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
+		cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Current().Secondary)
+		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+		nameCurrentStyle := lipgloss.NewStyle().Foreground(styles.Current().Success).Bold(true)
+		nameCurrentSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Success).Bold(true)
 
 		for i := scrollOffset; i < scrollOffset+visibleCount && i < len(projects); i++ {
 			project := projects[i]
@@ -233,7 +242,7 @@ func (m *Model) projectSwitcherListSection() modal.Section {
 
 			b.WriteString(nameStyle.Render(project.Name))
 			if isCurrent {
-				b.WriteString(styles.Muted.Render(" (current)"))
+				b.WriteString(styles.Current().Muted.Render(" (current)"))
 			}
 			b.WriteString("\n")
 			pathDisplay := project.Path
@@ -241,7 +250,7 @@ func (m *Model) projectSwitcherListSection() modal.Section {
 			if len(pathDisplay) > maxPathLen {
 				pathDisplay = "..." + pathDisplay[len(pathDisplay)-maxPathLen+3:]
 			}
-			b.WriteString(styles.Muted.Render("  " + pathDisplay))
+			b.WriteString(styles.Current().Muted.Render("  " + pathDisplay))
 			if i < scrollOffset+visibleCount-1 && i < len(projects)-1 {
 				b.WriteString("\n")
 			}
@@ -259,7 +268,7 @@ func (m *Model) projectSwitcherListSection() modal.Section {
 		remaining := len(projects) - (scrollOffset + visibleCount)
 		if remaining > 0 {
 			b.WriteString("\n")
-			b.WriteString(styles.Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
+			b.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
 		}
 
 		return modal.RenderedSection{Content: b.String(), Focusables: focusables}
@@ -318,33 +327,33 @@ func (m *Model) projectSwitcherHintsSection() modal.Section {
 
 		// No projects configured
 		if len(allProjects) == 0 {
-			b.WriteString(styles.KeyHint.Render("ctrl+a"))
-			b.WriteString(styles.Muted.Render(" add  "))
-			b.WriteString(styles.KeyHint.Render("y"))
-			b.WriteString(styles.Muted.Render(" copy prompt  "))
-			b.WriteString(styles.KeyHint.Render("esc"))
-			b.WriteString(styles.Muted.Render(" close"))
+			b.WriteString(styles.Current().KeyHint.Render("ctrl+a"))
+			b.WriteString(styles.Current().Muted.Render(" add  "))
+			b.WriteString(styles.Current().KeyHint.Render("y"))
+			b.WriteString(styles.Current().Muted.Render(" copy prompt  "))
+			b.WriteString(styles.Current().KeyHint.Render("esc"))
+			b.WriteString(styles.Current().Muted.Render(" close"))
 			return modal.RenderedSection{Content: b.String()}
 		}
 
 		// Empty filtered state
 		if len(projects) == 0 {
-			b.WriteString(styles.KeyHint.Render("esc"))
-			b.WriteString(styles.Muted.Render(" clear filter  "))
-			b.WriteString(styles.KeyHint.Render("@"))
-			b.WriteString(styles.Muted.Render(" close"))
+			b.WriteString(styles.Current().KeyHint.Render("esc"))
+			b.WriteString(styles.Current().Muted.Render(" clear filter  "))
+			b.WriteString(styles.Current().KeyHint.Render("@"))
+			b.WriteString(styles.Current().Muted.Render(" close"))
 			return modal.RenderedSection{Content: b.String()}
 		}
 
 		// Normal hints
-		b.WriteString(styles.KeyHint.Render("enter"))
-		b.WriteString(styles.Muted.Render(" switch  "))
-		b.WriteString(styles.KeyHint.Render("↑/↓"))
-		b.WriteString(styles.Muted.Render(" navigate  "))
-		b.WriteString(styles.KeyHint.Render("ctrl+a"))
-		b.WriteString(styles.Muted.Render(" add  "))
-		b.WriteString(styles.KeyHint.Render("esc"))
-		b.WriteString(styles.Muted.Render(" close"))
+		b.WriteString(styles.Current().KeyHint.Render("enter"))
+		b.WriteString(styles.Current().Muted.Render(" switch  "))
+		b.WriteString(styles.Current().KeyHint.Render("↑/↓"))
+		b.WriteString(styles.Current().Muted.Render(" navigate  "))
+		b.WriteString(styles.Current().KeyHint.Render("ctrl+a"))
+		b.WriteString(styles.Current().Muted.Render(" add  "))
+		b.WriteString(styles.Current().KeyHint.Render("esc"))
+		b.WriteString(styles.Current().Muted.Render(" close"))
 		return modal.RenderedSection{Content: b.String()}
 	}, nil)
 }
@@ -372,12 +381,12 @@ func (m *Model) renderProjectSwitcherOverlay(content string) string {
 func (m Model) renderProjectAddThemePickerOverlay(content string) string {
 	var b strings.Builder
 	maxVisible := 6
-	cursorStyle := lipgloss.NewStyle().Foreground(styles.Primary)
-	selectedStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+	cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+	selectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
 
 	if m.projectAddCommunityMode {
 		// Community sub-browser
-		b.WriteString(styles.ModalTitle.Render("Community Themes"))
+		b.WriteString(styles.Current().ModalTitle.Render("Community Themes"))
 		b.WriteString("\n\n")
 
 		list := m.projectAddCommunityList
@@ -387,13 +396,13 @@ func (m Model) renderProjectAddThemePickerOverlay(content string) string {
 		}
 
 		if m.projectAddCommunityScroll > 0 {
-			b.WriteString(styles.Muted.Render("  ↑ more"))
+			b.WriteString(styles.Current().Muted.Render("  ↑ more"))
 			b.WriteString("\n")
 		}
 
 		for i := m.projectAddCommunityScroll; i < m.projectAddCommunityScroll+visibleCount && i < len(list); i++ {
 			cursor := "  "
-			nameStyle := styles.Muted
+			nameStyle := styles.Current().Muted
 			if i == m.projectAddCommunityCursor {
 				cursor = cursorStyle.Render("▸ ")
 				nameStyle = selectedStyle
@@ -404,20 +413,20 @@ func (m Model) renderProjectAddThemePickerOverlay(content string) string {
 		}
 
 		if len(list) > m.projectAddCommunityScroll+visibleCount {
-			b.WriteString(styles.Muted.Render("  ↓ more"))
+			b.WriteString(styles.Current().Muted.Render("  ↓ more"))
 			b.WriteString("\n")
 		}
 
 		b.WriteString("\n")
-		b.WriteString(styles.KeyHint.Render("enter"))
-		b.WriteString(styles.Muted.Render(" select  "))
-		b.WriteString(styles.KeyHint.Render("tab"))
-		b.WriteString(styles.Muted.Render(" built-in  "))
-		b.WriteString(styles.KeyHint.Render("esc"))
-		b.WriteString(styles.Muted.Render(" back"))
+		b.WriteString(styles.Current().KeyHint.Render("enter"))
+		b.WriteString(styles.Current().Muted.Render(" select  "))
+		b.WriteString(styles.Current().KeyHint.Render("tab"))
+		b.WriteString(styles.Current().Muted.Render(" built-in  "))
+		b.WriteString(styles.Current().KeyHint.Render("esc"))
+		b.WriteString(styles.Current().Muted.Render(" back"))
 	} else {
 		// Built-in theme list
-		b.WriteString(styles.ModalTitle.Render("Pick Theme"))
+		b.WriteString(styles.Current().ModalTitle.Render("Pick Theme"))
 		b.WriteString("\n\n")
 		b.WriteString(m.projectAddThemeInput.View())
 		b.WriteString("\n\n")
@@ -429,13 +438,13 @@ func (m Model) renderProjectAddThemePickerOverlay(content string) string {
 		}
 
 		if m.projectAddThemeScroll > 0 {
-			b.WriteString(styles.Muted.Render("  ↑ more"))
+			b.WriteString(styles.Current().Muted.Render("  ↑ more"))
 			b.WriteString("\n")
 		}
 
 		for i := m.projectAddThemeScroll; i < m.projectAddThemeScroll+visibleCount && i < len(list); i++ {
 			cursor := "  "
-			nameStyle := styles.Muted
+			nameStyle := styles.Current().Muted
 			if i == m.projectAddThemeCursor {
 				cursor = cursorStyle.Render("▸ ")
 				nameStyle = selectedStyle
@@ -446,20 +455,20 @@ func (m Model) renderProjectAddThemePickerOverlay(content string) string {
 		}
 
 		if len(list) > m.projectAddThemeScroll+visibleCount {
-			b.WriteString(styles.Muted.Render("  ↓ more"))
+			b.WriteString(styles.Current().Muted.Render("  ↓ more"))
 			b.WriteString("\n")
 		}
 
 		b.WriteString("\n")
-		b.WriteString(styles.KeyHint.Render("enter"))
-		b.WriteString(styles.Muted.Render(" select  "))
-		b.WriteString(styles.KeyHint.Render("tab"))
-		b.WriteString(styles.Muted.Render(" community  "))
-		b.WriteString(styles.KeyHint.Render("esc"))
-		b.WriteString(styles.Muted.Render(" back"))
+		b.WriteString(styles.Current().KeyHint.Render("enter"))
+		b.WriteString(styles.Current().Muted.Render(" select  "))
+		b.WriteString(styles.Current().KeyHint.Render("tab"))
+		b.WriteString(styles.Current().Muted.Render(" community  "))
+		b.WriteString(styles.Current().KeyHint.Render("esc"))
+		b.WriteString(styles.Current().Muted.Render(" back"))
 	}
 
-	modal := styles.ModalBox.Render(b.String())
+	modal := styles.Current().ModalBox.Render(b.String())
 	return ui.OverlayModal(content, modal, m.width, m.height)
 }
 
@@ -473,13 +482,13 @@ func (m Model) renderHeader() string {
 		if branchName == "" {
 			branchName = "worktree"
 		}
-		worktreeIndicator = styles.WorktreeIndicator.Render(" [" + branchName + "]")
+		worktreeIndicator = styles.Current().WorktreeIndicator.Render(" [" + branchName + "]")
 	}
 
 	// Calculate final title width (with repo name and worktree indicator) - used for tab positioning
-	finalTitleWidth := lipgloss.Width(styles.BarTitle.Render(" Sidecar"))
+	finalTitleWidth := lipgloss.Width(styles.Current().BarTitle.Render(" Sidecar"))
 	if m.intro.RepoName != "" {
-		finalTitleWidth += lipgloss.Width(styles.Subtitle.Render(" / " + m.intro.RepoName))
+		finalTitleWidth += lipgloss.Width(styles.Current().Subtitle.Render(" / " + m.intro.RepoName))
 	}
 	finalTitleWidth += lipgloss.Width(worktreeIndicator)
 	finalTitleWidth += 1 // trailing space
@@ -488,15 +497,15 @@ func (m Model) renderHeader() string {
 	var title string
 	if m.intro.Active {
 		// During animation, render into fixed-width container to keep tabs stable
-		titleContent := styles.BarTitle.Render(" "+m.intro.View()) + m.intro.RepoNameView() + worktreeIndicator + " "
+		titleContent := styles.Current().BarTitle.Render(" "+m.intro.View()) + m.intro.RepoNameView() + worktreeIndicator + " "
 		title = lipgloss.NewStyle().Width(finalTitleWidth).Render(titleContent)
 	} else {
 		// Static title with repo name and worktree indicator
 		repoSuffix := ""
 		if m.intro.RepoName != "" {
-			repoSuffix = styles.Subtitle.Render(" / " + m.intro.RepoName)
+			repoSuffix = styles.Current().Subtitle.Render(" / " + m.intro.RepoName)
 		}
-		title = styles.BarTitle.Render(" Sidecar") + repoSuffix + worktreeIndicator + " "
+		title = styles.Current().BarTitle.Render(" Sidecar") + repoSuffix + worktreeIndicator + " "
 	}
 
 	// Plugin tabs (themed)
@@ -504,7 +513,7 @@ func (m Model) renderHeader() string {
 	var tabs []string
 	for i, p := range plugins {
 		isActive := i == m.activePlugin
-		tab := styles.RenderTab(p.Name(), i, len(plugins), isActive, false)
+		tab := styles.RenderPluginTab(p.ID(), p.Name(), i, len(plugins), isActive)
 		tabs = append(tabs, tab)
 	}
 	tabBar := strings.Join(tabs, " ")
@@ -512,7 +521,7 @@ func (m Model) renderHeader() string {
 	// Clock (conditional on config)
 	clock := ""
 	if m.showClock {
-		clock = styles.BarText.Render(m.ui.Clock.Format("15:04"))
+		clock = styles.Current().BarText.Render(m.ui.Clock.Format("15:04"))
 	}
 
 	// Calculate spacing (always use finalTitleWidth so tabs don't shift)
@@ -527,16 +536,16 @@ func (m Model) renderHeader() string {
 	// Build header line
 	header := title + strings.Repeat(" ", spacing/2) + tabBar + strings.Repeat(" ", spacing-(spacing/2)) + clock
 
-	return styles.Header.Width(m.width).Render(header)
+	return styles.Current().Header.Width(m.width).Render(header)
 }
 
 // getTabBounds calculates the X position bounds for each tab in the header.
 // Used for mouse click detection on tabs.
 func (m Model) getTabBounds() []TabBounds {
 	// Always use final title width (must match renderHeader logic)
-	titleWidth := lipgloss.Width(styles.BarTitle.Render(" Sidecar"))
+	titleWidth := lipgloss.Width(styles.Current().BarTitle.Render(" Sidecar"))
 	if m.intro.RepoName != "" {
-		titleWidth += lipgloss.Width(styles.Subtitle.Render(" / " + m.intro.RepoName))
+		titleWidth += lipgloss.Width(styles.Current().Subtitle.Render(" / " + m.intro.RepoName))
 	}
 	// Add worktree indicator width if applicable
 	if wtInfo := m.currentWorktreeInfo(); wtInfo != nil && !wtInfo.IsMain {
@@ -544,7 +553,7 @@ func (m Model) getTabBounds() []TabBounds {
 		if branchName == "" {
 			branchName = "worktree"
 		}
-		titleWidth += lipgloss.Width(styles.WorktreeIndicator.Render(" [" + branchName + "]"))
+		titleWidth += lipgloss.Width(styles.Current().WorktreeIndicator.Render(" [" + branchName + "]"))
 	}
 	titleWidth += 1 // trailing space
 
@@ -554,7 +563,7 @@ func (m Model) getTabBounds() []TabBounds {
 	totalTabWidth := 0
 	for i, p := range plugins {
 		isActive := i == m.activePlugin
-		tab := styles.RenderTab(p.Name(), i, len(plugins), isActive, false)
+		tab := styles.RenderPluginTab(p.ID(), p.Name(), i, len(plugins), isActive)
 		w := lipgloss.Width(tab)
 		tabWidths = append(tabWidths, w)
 		totalTabWidth += w
@@ -565,7 +574,7 @@ func (m Model) getTabBounds() []TabBounds {
 	}
 
 	// Clock width
-	clock := styles.BarText.Render(m.ui.Clock.Format("15:04"))
+	clock := styles.Current().BarText.Render(m.ui.Clock.Format("15:04"))
 	clockWidth := lipgloss.Width(clock)
 
 	// Calculate spacing
@@ -593,9 +602,9 @@ func (m Model) getRepoNameBounds() (start, end int, ok bool) {
 		return 0, 0, false
 	}
 
-	titlePrefix := styles.BarTitle.Render(" Sidecar")
-	repoPrefix := styles.Subtitle.Render(" / ")
-	repoName := styles.Subtitle.Render(m.intro.RepoName)
+	titlePrefix := styles.Current().BarTitle.Render(" Sidecar")
+	repoPrefix := styles.Current().Subtitle.Render(" / ")
+	repoName := styles.Current().Subtitle.Render(m.intro.RepoName)
 
 	start = lipgloss.Width(titlePrefix) + lipgloss.Width(repoPrefix)
 	end = start + lipgloss.Width(repoName)
@@ -615,15 +624,15 @@ func (m Model) getWorktreeIndicatorBounds() (start, end int, ok bool) {
 	}
 
 	// Calculate position: after title + repo name
-	titlePrefix := styles.BarTitle.Render(" Sidecar")
+	titlePrefix := styles.Current().BarTitle.Render(" Sidecar")
 	start = lipgloss.Width(titlePrefix)
 
 	if m.intro.RepoName != "" {
-		repoSuffix := styles.Subtitle.Render(" / " + m.intro.RepoName)
+		repoSuffix := styles.Current().Subtitle.Render(" / " + m.intro.RepoName)
 		start += lipgloss.Width(repoSuffix)
 	}
 
-	indicator := styles.WorktreeIndicator.Render(" [" + branchName + "]")
+	indicator := styles.Current().WorktreeIndicator.Render(" [" + branchName + "]")
 	end = start + lipgloss.Width(indicator)
 	return start, end, true
 }
@@ -633,7 +642,7 @@ func (m Model) renderContent(width, height int) string {
 	p := m.ActivePlugin()
 	if p == nil {
 		msg := "No plugins loaded"
-		return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, styles.Muted.Render(msg))
+		return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, styles.Current().Muted.Render(msg))
 	}
 
 	content := p.View(width, height)
@@ -650,18 +659,24 @@ func (m Model) renderContent(width, height int) string {
 func (m Model) renderFooter() string {
 	// Toast/status message
 	var status string
-	if m.ui.HasToast() {
-		status = styles.StatusModified.Render(m.ui.ToastMessage)
+	if m.resizeMode {
+		status = styles.Current().StatusModified.Render(m.resizeStatus())
+	} else if chord := m.chordStatus(); chord != "" {
+		status = styles.Current().KeyHint.Render(chord)
+	} else if macro := m.macroStatus(); macro != "" {
+		status = styles.Current().StatusModified.Render(macro)
+	} else if m.ui.HasToast() {
+		status = styles.Current().StatusModified.Render(m.ui.ToastMessage)
 	} else if m.statusMsg != "" {
-		toastStyle := styles.ToastSuccess
+		toastStyle := styles.Current().ToastSuccess
 		if m.statusIsError {
-			toastStyle = styles.ToastError
+			toastStyle = styles.Current().ToastError
 		}
-		status = toastStyle.Render(m.statusMsg)
+		status = toastStyle.Render(styles.StatusGlyph(m.statusMsg, m.statusIsError))
 	}
 
 	// Last refresh
-	refresh := styles.Muted.Render(fmt.Sprintf("↻ %s", m.ui.LastRefresh.Format("15:04:05")))
+	refresh := styles.Current().Muted.Render(fmt.Sprintf("↻ %s", m.ui.LastRefresh.Format("15:04:05")))
 
 	// Calculate available width for hints (leave room for status, refresh, and spacing)
 	statusWidth := lipgloss.Width(status)
@@ -683,7 +698,7 @@ func (m Model) renderFooter() string {
 	footer := hintsStr + strings.Repeat(" ", spacing/2) + status + strings.Repeat(" ", spacing-(spacing/2)) + refresh
 
 	// Use MaxWidth to prevent wrapping and ensure single line
-	return styles.Footer.Width(m.width).MaxWidth(m.width).Render(footer)
+	return styles.Current().Footer.Width(m.width).MaxWidth(m.width).Render(footer)
 }
 
 type footerHint struct {
@@ -692,6 +707,9 @@ type footerHint struct {
 }
 
 func (m Model) footerHints() []footerHint {
+	if m.resizeMode {
+		return resizeFooterHints()
+	}
 	// Plugin-specific hints first - they're more contextually relevant
 	var hints []footerHint
 	if p := m.ActivePlugin(); p != nil {
@@ -794,7 +812,7 @@ func renderHintLineTruncated(hints []footerHint, maxWidth int) string {
 		if hint.keys == "" || hint.label == "" {
 			continue
 		}
-		part := fmt.Sprintf("%s %s", styles.KeyHint.Render(hint.keys), hint.label)
+		part := fmt.Sprintf("%s %s", styles.Current().KeyHint.Render(hint.keys), hint.label)
 		var candidate string
 		if i == 0 {
 			candidate = part
@@ -844,7 +862,7 @@ func (m *Model) clearHelpModal() {
 func (m *Model) helpGlobalSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		var b strings.Builder
-		b.WriteString(styles.Title.Render("Global"))
+		b.WriteString(styles.Current().Title.Render("Global"))
 		b.WriteString("\n")
 		m.renderBindingSection(&b, "global")
 		return modal.RenderedSection{Content: b.String()}
@@ -860,7 +878,7 @@ func (m *Model) helpPluginSection() modal.Section {
 				bindings := m.keymap.BindingsForContext(ctx)
 				if len(bindings) > 0 {
 					var b strings.Builder
-					b.WriteString(styles.Title.Render(p.Name()))
+					b.WriteString(styles.Current().Title.Render(p.Name()))
 					b.WriteString("\n")
 					m.renderBindingSection(&b, ctx)
 					return modal.RenderedSection{Content: b.String()}
@@ -910,7 +928,7 @@ func (m Model) renderBindingSection(b *strings.Builder, context string) {
 
 		// Pad key to align commands
 		padded := fmt.Sprintf("%-11s", keyStr)
-		fmt.Fprintf(b, "  %s %s\n", styles.Muted.Render(padded), cmdName)
+		fmt.Fprintf(b, "  %s %s\n", styles.Current().Muted.Render(padded), cmdName)
 	}
 }
 
@@ -136,7 +136,7 @@ func (m *Model) worktreeSwitcherCountSection() modal.Section {
 		} else if len(allWorktrees) > 0 {
 			countText = fmt.Sprintf("%d worktrees", len(allWorktrees))
 		}
-		return modal.RenderedSection{Content: styles.Muted.Render(countText)}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render(countText)}
 	}, nil)
 }
 
@@ -147,16 +147,16 @@ func (m *Model) worktreeSwitcherListSection() modal.Section {
 
 		// No worktrees
 		if len(worktrees) == 0 {
-			return modal.RenderedSection{Content: styles.Muted.Render("No worktrees found")}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No worktrees found")}
 		}
 
 		// Styles
-		cursorStyle := lipgloss.NewStyle().Foreground(styles.Primary)
-		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Secondary)
-		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
-		nameCurrentStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
-		nameCurrentSelectedStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
-		mainBadgeStyle := lipgloss.NewStyle().Foreground(styles.Warning)
+		cursorStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+		nameNormalStyle := lipgloss.NewStyle().Foreground(styles.Current().Secondary)
+		nameSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true)
+		nameCurrentStyle := lipgloss.NewStyle().Foreground(styles.Current().Success).Bold(true)
+		nameCurrentSelectedStyle := lipgloss.NewStyle().Foreground(styles.Current().Success).Bold(true)
+		mainBadgeStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning)
 
 		// Determine current worktree
 		normalizedWorkDir, _ := normalizePath(m.ui.WorkDir)
@@ -174,7 +174,7 @@ func (m *Model) worktreeSwitcherListSection() modal.Section {
 
 		// Scroll indicator (top)
 		if scrollOffset > 0 {
-			sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ↑ %d more above", scrollOffset)))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↑ %d more above", scrollOffset)))
 			sb.WriteString("\n")
 			lineOffset++
 		}
@@ -225,7 +225,7 @@ func (m *Model) worktreeSwitcherListSection() modal.Section {
 
 			// Current indicator
 			if isCurrent {
-				sb.WriteString(styles.Muted.Render(" (current)"))
+				sb.WriteString(styles.Current().Muted.Render(" (current)"))
 			}
 
 			sb.WriteString("\n")
@@ -236,7 +236,7 @@ func (m *Model) worktreeSwitcherListSection() modal.Section {
 			if len(pathDisplay) > maxPathLen {
 				pathDisplay = "..." + pathDisplay[len(pathDisplay)-maxPathLen+3:]
 			}
-			sb.WriteString(styles.Muted.Render("  " + pathDisplay))
+			sb.WriteString(styles.Current().Muted.Render("  " + pathDisplay))
 
 			if i < scrollOffset+visibleCount-1 && i < len(worktrees)-1 {
 				sb.WriteString("\n")
@@ -256,7 +256,7 @@ func (m *Model) worktreeSwitcherListSection() modal.Section {
 		remaining := len(worktrees) - (scrollOffset + visibleCount)
 		if remaining > 0 {
 			sb.WriteString("\n")
-			sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
 		}
 
 		return modal.RenderedSection{Content: sb.String(), Focusables: focusables}
@@ -311,17 +311,17 @@ func (m *Model) worktreeSwitcherHintsSection() modal.Section {
 		sb.WriteString("\n")
 
 		if len(worktrees) == 0 {
-			sb.WriteString(styles.KeyHint.Render("esc"))
-			sb.WriteString(styles.Muted.Render(" clear filter  "))
-			sb.WriteString(styles.KeyHint.Render("W"))
-			sb.WriteString(styles.Muted.Render(" close"))
+			sb.WriteString(styles.Current().KeyHint.Render("esc"))
+			sb.WriteString(styles.Current().Muted.Render(" clear filter  "))
+			sb.WriteString(styles.Current().KeyHint.Render("W"))
+			sb.WriteString(styles.Current().Muted.Render(" close"))
 		} else {
-			sb.WriteString(styles.KeyHint.Render("enter"))
-			sb.WriteString(styles.Muted.Render(" switch  "))
-			sb.WriteString(styles.KeyHint.Render("↑/↓"))
-			sb.WriteString(styles.Muted.Render(" navigate  "))
-			sb.WriteString(styles.KeyHint.Render("esc"))
-			sb.WriteString(styles.Muted.Render(" cancel"))
+			sb.WriteString(styles.Current().KeyHint.Render("enter"))
+			sb.WriteString(styles.Current().Muted.Render(" switch  "))
+			sb.WriteString(styles.Current().KeyHint.Render("↑/↓"))
+			sb.WriteString(styles.Current().Muted.Render(" navigate  "))
+			sb.WriteString(styles.Current().KeyHint.Render("esc"))
+			sb.WriteString(styles.Current().Muted.Render(" cancel"))
 		}
 
 		return modal.RenderedSection{Content: sb.String()}
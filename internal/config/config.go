@@ -4,16 +4,65 @@ import "time"
 
 // Config is the root configuration structure.
 type Config struct {
-	Projects ProjectsConfig `json:"projects"`
-	Plugins  PluginsConfig  `json:"plugins"`
-	Keymap   KeymapConfig   `json:"keymap"`
-	UI       UIConfig       `json:"ui"`
-	Features FeaturesConfig `json:"features"`
+	Projects      ProjectsConfig      `json:"projects"`
+	Plugins       PluginsConfig       `json:"plugins"`
+	Keymap        KeymapConfig        `json:"keymap"`
+	UI            UIConfig            `json:"ui"`
+	Features      FeaturesConfig      `json:"features"`
+	Accessibility AccessibilityConfig `json:"accessibility"`
+	Events        EventsConfig        `json:"events"`
+}
+
+// AccessibilityConfig holds accessibility settings.
+type AccessibilityConfig struct {
+	// ColorblindMode remaps success/warning/error colors for a color vision
+	// deficiency: "deuteranopia", "protanopia", or "tritanopia".
+	ColorblindMode string `json:"colorblindMode,omitempty"`
+}
+
+// EventsConfig configures forwarding event bus events outside forge.
+type EventsConfig struct {
+	Webhooks []WebhookConfig `json:"webhooks,omitempty"`
+	Bridge   BridgeConfig    `json:"bridge,omitempty"`
+}
+
+// BridgeConfig shares events between forge instances running on the same
+// machine over a unix socket. One instance, the hub, receives the events
+// of all the others; instances connected to it leave posting to webhooks
+// to the hub.
+type BridgeConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Socket is the unix socket path. Default: events.sock beside the state
+	// file.
+	Socket string `json:"socket,omitempty"`
+	// Events lists the event types to share. Empty shares the same three
+	// as webhooks. The types configured webhooks send are always shared.
+	Events []string `json:"events,omitempty"`
+}
+
+// WebhookConfig posts selected events to a URL as JSON, e.g. a Slack or
+// Discord incoming webhook.
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Events lists the event types to send: "agent_completed",
+	// "merge_completed", and "session_error". Empty sends all three.
+	Events []string `json:"events,omitempty"`
+	// Template is a Go text/template for the JSON body. It sees .Type,
+	// .Topic, .Time, .Text (a one-line summary), and .Data, and has a json
+	// function for quoting values. Empty uses a generic payload.
+	Template string `json:"template,omitempty"`
 }
 
 // FeaturesConfig holds feature flag settings.
 type FeaturesConfig struct {
 	Flags map[string]bool `json:"flags"`
+	// Variants holds non-boolean flags by name, e.g. "watcher.mode": "poll".
+	Variants map[string]string `json:"variants,omitempty"`
+	// RemoteURL is an optional http(s) URL polled for flag values that
+	// override the config file, e.g. to switch off an experimental feature.
+	RemoteURL string `json:"remoteUrl,omitempty"`
+	// RemoteInterval is how often RemoteURL is polled, e.g. "10m". Default: 5m.
+	RemoteInterval string `json:"remoteInterval,omitempty"`
 }
 
 // ProjectsConfig configures project detection and layout.
@@ -25,9 +74,10 @@ type ProjectsConfig struct {
 
 // ProjectConfig represents a single project in the project switcher.
 type ProjectConfig struct {
-	Name  string       `json:"name"`            // display name for the project
-	Path  string       `json:"path"`            // absolute path to project root (supports ~ expansion)
-	Theme *ThemeConfig `json:"theme,omitempty"` // per-project theme (nil = use global)
+	Name          string                   `json:"name"`                    // display name for the project
+	Path          string                   `json:"path"`                    // absolute path to project root (supports ~ expansion)
+	Theme         *ThemeConfig             `json:"theme,omitempty"`         // per-project theme (nil = use global)
+	Conversations *ConversationsViewConfig `json:"conversations,omitempty"` // per-project conversations view (nil = use global)
 }
 
 // PluginsConfig holds per-plugin configuration.
@@ -60,6 +110,148 @@ type ConversationsPluginConfig struct {
 	// Example: ["interactive"] hides cron/system sessions by default.
 	// Empty or omitted means show all sessions (no filter).
 	DefaultCategoryFilter []string `json:"defaultCategoryFilter,omitempty"`
+	// Budget sets token/cost thresholds that trigger warning toasts and row badges.
+	Budget BudgetConfig `json:"budget,omitempty"`
+	// BadgeRules attach custom colored badges to matching sessions in the list.
+	BadgeRules []BadgeRule `json:"badgeRules,omitempty"`
+	// View sets the initial layout, sort, grouping, and filters. A project's
+	// "conversations" entry in projects.list replaces it for that project.
+	View ConversationsViewConfig `json:"view,omitempty"`
+	// CacheBudgetMB caps the memory held by parsed-message caches across
+	// all agents, in megabytes. Default: 256.
+	CacheBudgetMB int `json:"cacheBudgetMB,omitempty"`
+	// MaxLineMB is the longest session file line parsed, in megabytes.
+	// Longer lines are skipped and listed in diagnostics. Default: 10.
+	MaxLineMB int `json:"maxLineMB,omitempty"`
+	// Prewarm parses the most recently updated sessions in the background
+	// once the session list loads, so opening one is instant.
+	Prewarm PrewarmConfig `json:"prewarm"`
+	// Watcher tunes how session files are watched for changes.
+	Watcher WatcherConfig `json:"watcher"`
+}
+
+// WatcherConfig tunes the tiered session file watcher.
+type WatcherConfig struct {
+	// HotIdle moves a session from real-time watching back to polling
+	// after this long without changes. Default: 5m.
+	HotIdle time.Duration `json:"hotIdle"`
+	// PollPaths lists directories to poll instead of watch, for network
+	// mounts that aren't detected automatically.
+	PollPaths []string `json:"pollPaths,omitempty"`
+	// NetworkPoll is how often sessions on network filesystems are
+	// polled. Default: 5s.
+	NetworkPoll time.Duration `json:"networkPoll"`
+}
+
+// PrewarmConfig sets how many sessions the conversations plugin parses
+// ahead of use.
+type PrewarmConfig struct {
+	Sessions int  `json:"sessions"` // most recent sessions to prewarm; 0 disables
+	Messages bool `json:"messages"` // also fill message caches, not just metadata
+}
+
+// Conversations view layouts.
+const (
+	ConversationsLayoutTwoPane = "two-pane" // session list beside the message pane (default)
+	ConversationsLayoutList    = "list"     // full-width session list until a session is opened
+)
+
+// Conversations session sort orders. All sort newest or largest first.
+const (
+	ConversationsSortUpdated = "updated" // last activity (default)
+	ConversationsSortCreated = "created"
+	ConversationsSortTokens  = "tokens"
+	ConversationsSortCost    = "cost"
+)
+
+// Conversations session groupings.
+const (
+	ConversationsGroupTime = "time" // Today, Yesterday, This Week, ... (default)
+	ConversationsGroupNone = "none"
+)
+
+// ConversationsViewConfig sets how the conversations plugin looks when it
+// starts. Empty fields use the defaults.
+type ConversationsViewConfig struct {
+	Layout  string `json:"layout,omitempty"`  // "two-pane" or "list"
+	Sort    string `json:"sort,omitempty"`    // "updated", "created", "tokens", or "cost"
+	GroupBy string `json:"groupBy,omitempty"` // "time" or "none"; time groups need the "updated" sort
+	// Filters are applied on startup and can be cleared from the filter menu.
+	Filters ConversationsFilterConfig `json:"filters,omitempty"`
+}
+
+// ConversationsFilterConfig lists the session filters applied on startup.
+type ConversationsFilterConfig struct {
+	Adapters   []string `json:"adapters,omitempty"`   // adapter IDs, e.g. "claude-code"
+	Models     []string `json:"models,omitempty"`     // model families, e.g. "opus"
+	Categories []string `json:"categories,omitempty"` // session categories, e.g. "interactive"
+	Date       string   `json:"date,omitempty"`       // "today", "yesterday", "week", or "month"
+	ActiveOnly bool     `json:"activeOnly,omitempty"`
+}
+
+// IsZero reports whether no view setting is set.
+func (v ConversationsViewConfig) IsZero() bool {
+	f := v.Filters
+	return v.Layout == "" && v.Sort == "" && v.GroupBy == "" &&
+		len(f.Adapters) == 0 && len(f.Models) == 0 && len(f.Categories) == 0 &&
+		f.Date == "" && !f.ActiveOnly
+}
+
+// ConversationsView returns the conversations view settings for a project:
+// the project's own entry when it has one, otherwise the global setting.
+func (c *Config) ConversationsView(projectPath string) ConversationsViewConfig {
+	for _, proj := range c.Projects.List {
+		if proj.Path == projectPath && proj.Conversations != nil {
+			return *proj.Conversations
+		}
+	}
+	return c.Plugins.Conversations.View
+}
+
+// BadgeRule attaches a colored badge to sessions matching all of its
+// conditions. Empty conditions are ignored; a rule with no conditions
+// matches every session.
+type BadgeRule struct {
+	Label     string   `json:"label"`               // badge text, e.g. "prod"
+	Color     string   `json:"color,omitempty"`     // hex ("#F59E0B") or ANSI ("203"); default: theme primary
+	NameRegex string   `json:"nameRegex,omitempty"` // matched against the session name
+	MinCost   float64  `json:"minCost,omitempty"`   // estimated cost (USD) at or above
+	MinTokens int      `json:"minTokens,omitempty"` // total tokens at or above
+	Tools     []string `json:"tools,omitempty"`     // any of these tools used (known once messages are loaded)
+}
+
+// BudgetConfig sets token and cost alert thresholds for conversations.
+// Zero values disable the corresponding check. Costs are in USD.
+type BudgetConfig struct {
+	SessionTokens int     `json:"sessionTokens,omitempty"` // per-session token limit
+	SessionCost   float64 `json:"sessionCost,omitempty"`   // per-session cost limit
+	DailyTokens   int     `json:"dailyTokens,omitempty"`   // tokens across sessions active today
+	DailyCost     float64 `json:"dailyCost,omitempty"`     // cost across sessions active today
+}
+
+// Enabled reports whether any budget threshold is set.
+func (b BudgetConfig) Enabled() bool {
+	return b.SessionTokens > 0 || b.SessionCost > 0 || b.DailyTokens > 0 || b.DailyCost > 0
+}
+
+// InteractiveKeys lists keys that change hands in interactive mode.
+type InteractiveKeys struct {
+	// Capture lists keys forge handles itself, e.g. "`" to switch plugins
+	// or "ctrl+k" for search, instead of sending them to the agent.
+	Capture []string `json:"capture,omitempty"`
+	// Passthrough lists interactive mode keys sent to the agent instead:
+	// the attach, copy, and paste keys, or "esc" to turn off the
+	// double-escape exit. The exit key always exits.
+	Passthrough []string `json:"passthrough,omitempty"`
+}
+
+// InteractiveKeysFor returns the interactive keys for an agent type,
+// falling back to the "*" entry.
+func (w WorkspacePluginConfig) InteractiveKeysFor(agentType string) InteractiveKeys {
+	if keys, ok := w.InteractiveKeys[agentType]; ok {
+		return keys
+	}
+	return w.InteractiveKeys["*"]
 }
 
 // WorkspacePluginConfig configures the workspace plugin.
@@ -79,6 +271,77 @@ type WorkspacePluginConfig struct {
 	InteractiveCopyKey string `json:"interactiveCopyKey,omitempty"`
 	// InteractivePasteKey is the keybinding to paste clipboard in interactive mode. Default: "alt+v".
 	InteractivePasteKey string `json:"interactivePasteKey,omitempty"`
+	// InteractiveKeys decides, per agent type ("claude", "shell", ...), which
+	// keys forge handles in interactive mode instead of sending them to the
+	// agent. The "*" entry applies to agent types without their own entry.
+	InteractiveKeys map[string]InteractiveKeys `json:"interactiveKeys,omitempty"`
+	// PRStatusInterval sets how often PR number, checks, review state, and
+	// mergeability are refreshed via gh. Zero disables polling. Default: 2m.
+	PRStatusInterval time.Duration `json:"prStatusInterval"`
+	// PostCreateHooks are shell commands run in order in a new worktree after
+	// it is created (e.g. "npm install"). Output streams to the preview pane;
+	// the first failure stops the remaining hooks and the agent auto-start.
+	PostCreateHooks []string `json:"postCreateHooks,omitempty"`
+	// CompletionNotify sends a desktop notification when an agent goes idle
+	// (waiting or done) after working, or prints a completion marker.
+	// Default: true.
+	CompletionNotify bool `json:"completionNotify"`
+	// CompletionMarkers are strings that mark a finished task when they
+	// appear in agent output (e.g. "ALL TESTS PASSED").
+	CompletionMarkers []string `json:"completionMarkers,omitempty"`
+	// Repos are additional repository roots offered by the workspace repo
+	// switcher alongside the current project and projects.list entries.
+	Repos []string `json:"repos,omitempty"`
+	// KanbanColumns replace the default kanban board columns. A worktree goes
+	// in the first column whose rules match, or the last column if none do.
+	KanbanColumns []KanbanColumn `json:"kanbanColumns,omitempty"`
+	// Agents extends the agent catalog offered when starting agents. An entry
+	// whose ID matches a built-in agent (claude, codex, ...) overrides it.
+	Agents []AgentDefinition `json:"agents,omitempty"`
+	// AutoLinkTasks links a worktree to the td task named in its branch
+	// (e.g. "td-a1b2-login") and updates the task when the branch is merged.
+	// Default: true.
+	AutoLinkTasks bool `json:"autoLinkTasks"`
+	// TaskBranchPattern overrides the regular expression used to find a task
+	// ID in a branch name. The first capture group is the task ID.
+	TaskBranchPattern string `json:"taskBranchPattern,omitempty"`
+	// Hosts are machines where workspaces can be created over SSH. Git and
+	// tmux run on the host; forge polls and attaches through ssh.
+	Hosts []RemoteHost `json:"hosts,omitempty"`
+	// UndoWindow is how long deleted worktrees, branches, and shells are kept
+	// so the delete can be undone. Zero deletes immediately. Default: 5m.
+	UndoWindow time.Duration `json:"undoWindow"`
+}
+
+// KanbanColumn configures a workspace kanban column. Empty rule lists match
+// anything, so a column without rules catches every remaining worktree.
+type KanbanColumn struct {
+	Name     string   `json:"name"`
+	Color    string   `json:"color,omitempty"`    // hex ("#F59E0B") or ANSI ("203"); default: theme muted
+	Statuses []string `json:"statuses,omitempty"` // agent status: active, thinking, waiting, done, paused, error
+	PR       []string `json:"pr,omitempty"`       // PR state: open, draft, merged, closed, or none
+	OnDrop   string   `json:"onDrop,omitempty"`   // start-agent, stop-agent, merge, or ready-for-review
+}
+
+// AgentDefinition describes a CLI agent in the workspace agent catalog.
+type AgentDefinition struct {
+	ID                  string `json:"id"`                            // stable identifier, e.g. "amp"
+	Name                string `json:"name,omitempty"`                // display name; default: ID
+	Command             string `json:"command"`                       // launch command, e.g. "amp"
+	ResumeCommand       string `json:"resumeCommand,omitempty"`       // continues the last session, e.g. "amp --continue"
+	SkipPermissionsFlag string `json:"skipPermissionsFlag,omitempty"` // appended when skip permissions is on
+	IdlePattern         string `json:"idlePattern,omitempty"`         // regex matched against the last output lines; a match means waiting for input
+	WorkingPattern      string `json:"workingPattern,omitempty"`      // regex whose match means the agent is working
+	ErrorPattern        string `json:"errorPattern,omitempty"`        // regex whose match means the agent hit an error
+}
+
+// RemoteHost describes a machine that hosts workspaces over SSH.
+type RemoteHost struct {
+	Name         string `json:"name"`                   // label shown in the UI and stored with each workspace
+	Address      string `json:"address"`                // ssh destination, e.g. "dev@buildbox" or a ~/.ssh/config alias
+	RepoPath     string `json:"repoPath"`               // clone of this repository on the host
+	WorktreeDir  string `json:"worktreeDir,omitempty"`  // where worktrees are created; default: parent of RepoPath
+	PollInterval string `json:"pollInterval,omitempty"` // minimum delay between output captures, e.g. "3s"
 }
 
 // NotesPluginConfig configures the notes plugin.
@@ -91,14 +354,42 @@ type NotesPluginConfig struct {
 
 // KeymapConfig holds key binding overrides.
 type KeymapConfig struct {
+	// Overrides maps a key or chord, e.g. "ctrl+j" or "<leader> w d", to
+	// a command ID.
 	Overrides map[string]string `json:"overrides"`
+	// Profile is a preset layered under Overrides: "default", "vim", or
+	// "emacs".
+	Profile string `json:"profile,omitempty"`
+	// Leader is the key "<leader>" stands for in overrides, e.g. "space".
+	Leader string `json:"leader,omitempty"`
+	// ChordTimeout is how long a chord waits for its next key, e.g. "1s".
+	// Default: 500ms.
+	ChordTimeout string `json:"chordTimeout,omitempty"`
+	// WhichKeyDelay is how long a chord stays pending before a popup lists
+	// its continuations, e.g. "300ms". Default: shown only when "?" is
+	// pressed after the prefix.
+	WhichKeyDelay string `json:"whichKeyDelay,omitempty"`
 }
 
 // UIConfig configures UI appearance.
 type UIConfig struct {
-	ShowClock        bool        `json:"showClock"`
-	Theme            ThemeConfig `json:"theme"`
-	NerdFontsEnabled bool        `json:"nerdFontsEnabled"` // enables Nerd Font glyphs (pill tabs, icons, etc.)
+	ShowClock         bool         `json:"showClock"`
+	Theme             ThemeConfig  `json:"theme"`
+	NerdFontsEnabled  bool         `json:"nerdFontsEnabled"`            // enables Nerd Font glyphs (pill tabs, icons, etc.)
+	HighContrastFocus bool         `json:"highContrastFocus,omitempty"` // double borders and inverse video for focused pane, row, and button
+	Icons             string       `json:"icons,omitempty"`             // icon set: auto (default), nerd-font, unicode, ascii
+	DimInactivePanes  bool         `json:"dimInactivePanes,omitempty"`  // desaturate and dim unfocused panes
+	Locale            LocaleConfig `json:"locale,omitempty"`
+}
+
+// LocaleConfig configures number and currency formatting.
+// Costs are computed in USD; CurrencyRate converts them for display.
+type LocaleConfig struct {
+	CurrencySymbol     string  `json:"currencySymbol,omitempty"`     // e.g. "€" (default "$")
+	SymbolAfter        bool    `json:"symbolAfter,omitempty"`        // "12,50 €" instead of "€12,50"
+	DecimalSeparator   string  `json:"decimalSeparator,omitempty"`   // default "."
+	ThousandsSeparator *string `json:"thousandsSeparator,omitempty"` // default ","; "" disables grouping
+	CurrencyRate       float64 `json:"currencyRate,omitempty"`       // USD -> currency multiplier (0 = none)
 }
 
 // ThemeConfig configures the color theme.
@@ -106,6 +397,10 @@ type ThemeConfig struct {
 	Name      string                 `json:"name"`
 	Community string                 `json:"community,omitempty"` // community scheme name (resolved at runtime)
 	Overrides map[string]interface{} `json:"overrides,omitempty"` // user customizations on top
+	// PluginOverrides layers palette overrides onto one plugin's styles, keyed by plugin ID
+	PluginOverrides map[string]map[string]interface{} `json:"pluginOverrides,omitempty"`
+	// TabOverrides assigns explicit header tab colors, keyed by plugin ID
+	TabOverrides map[string]string `json:"tabOverrides,omitempty"`
 }
 
 // Default returns the default configuration.
@@ -128,10 +423,16 @@ func Default() *Config {
 			Conversations: ConversationsPluginConfig{
 				Enabled:       true,
 				ClaudeDataDir: "~/.claude",
+				Prewarm:       PrewarmConfig{Sessions: 10, Messages: true},
+				Watcher:       WatcherConfig{HotIdle: 5 * time.Minute, NetworkPoll: 5 * time.Second},
 			},
 			Workspace: WorkspacePluginConfig{
 				DirPrefix:           true,
 				TmuxCaptureMaxBytes: 2 * 1024 * 1024,
+				PRStatusInterval:    2 * time.Minute,
+				CompletionNotify:    true,
+				AutoLinkTasks:       true,
+				UndoWindow:          5 * time.Minute,
 			},
 		},
 		Keymap: KeymapConfig{
@@ -161,5 +462,26 @@ func (c *Config) Validate() error {
 	if c.Plugins.Workspace.TmuxCaptureMaxBytes <= 0 {
 		c.Plugins.Workspace.TmuxCaptureMaxBytes = 2 * 1024 * 1024
 	}
+	if c.Plugins.Workspace.PRStatusInterval < 0 {
+		c.Plugins.Workspace.PRStatusInterval = 2 * time.Minute
+	}
+	if c.Plugins.Workspace.UndoWindow < 0 {
+		c.Plugins.Workspace.UndoWindow = 5 * time.Minute
+	}
+	if c.Plugins.Conversations.Watcher.HotIdle <= 0 {
+		c.Plugins.Conversations.Watcher.HotIdle = 5 * time.Minute
+	}
+	if c.Plugins.Conversations.Watcher.NetworkPoll <= 0 {
+		c.Plugins.Conversations.Watcher.NetworkPoll = 5 * time.Second
+	}
+	// Negative budget thresholds are treated as disabled
+	b := &c.Plugins.Conversations.Budget
+	b.SessionTokens = max(b.SessionTokens, 0)
+	b.SessionCost = max(b.SessionCost, 0)
+	b.DailyTokens = max(b.DailyTokens, 0)
+	b.DailyCost = max(b.DailyCost, 0)
+	if c.UI.Locale.CurrencyRate < 0 {
+		c.UI.Locale.CurrencyRate = 0
+	}
 	return nil
 }
@@ -30,17 +30,23 @@ func ResetTestConfigPath() {
 
 // rawConfig is the JSON-unmarshaling intermediary.
 type rawConfig struct {
-	Projects rawProjectsConfig `json:"projects"`
-	Plugins  rawPluginsConfig  `json:"plugins"`
-	Keymap   KeymapConfig      `json:"keymap"`
-	UI       rawUIConfig       `json:"ui"`
-	Features FeaturesConfig    `json:"features"`
+	Projects      rawProjectsConfig   `json:"projects"`
+	Plugins       rawPluginsConfig    `json:"plugins"`
+	Keymap        KeymapConfig        `json:"keymap"`
+	UI            rawUIConfig         `json:"ui"`
+	Features      FeaturesConfig      `json:"features"`
+	Accessibility AccessibilityConfig `json:"accessibility"`
+	Events        EventsConfig        `json:"events"`
 }
 
 type rawUIConfig struct {
-	ShowClock        *bool       `json:"showClock"`
-	Theme            ThemeConfig `json:"theme"`
-	NerdFontsEnabled *bool       `json:"nerdFontsEnabled"`
+	ShowClock         *bool         `json:"showClock"`
+	Theme             ThemeConfig   `json:"theme"`
+	NerdFontsEnabled  *bool         `json:"nerdFontsEnabled"`
+	HighContrastFocus *bool         `json:"highContrastFocus"`
+	Icons             string        `json:"icons"`
+	DimInactivePanes  *bool         `json:"dimInactivePanes"`
+	Locale            *LocaleConfig `json:"locale"`
 }
 
 type rawProjectsConfig struct {
@@ -50,9 +56,10 @@ type rawProjectsConfig struct {
 }
 
 type rawProjectConfig struct {
-	Name  string       `json:"name"`
-	Path  string       `json:"path"`
-	Theme *ThemeConfig `json:"theme,omitempty"`
+	Name          string                   `json:"name"`
+	Path          string                   `json:"path"`
+	Theme         *ThemeConfig             `json:"theme,omitempty"`
+	Conversations *ConversationsViewConfig `json:"conversations,omitempty"`
 }
 
 type rawPluginsConfig struct {
@@ -69,6 +76,18 @@ type rawWorkspaceConfig struct {
 	InteractiveAttachKey string `json:"interactiveAttachKey"`
 	InteractiveCopyKey   string `json:"interactiveCopyKey"`
 	InteractivePasteKey  string `json:"interactivePasteKey"`
+	InteractiveKeys      map[string]InteractiveKeys `json:"interactiveKeys"`
+	PRStatusInterval     string   `json:"prStatusInterval"`
+	PostCreateHooks      []string `json:"postCreateHooks"`
+	CompletionNotify     *bool    `json:"completionNotify"`
+	CompletionMarkers    []string `json:"completionMarkers"`
+	Repos                []string       `json:"repos"`
+	KanbanColumns        []KanbanColumn `json:"kanbanColumns"`
+	Agents               []AgentDefinition `json:"agents"`
+	AutoLinkTasks        *bool             `json:"autoLinkTasks"`
+	TaskBranchPattern    string            `json:"taskBranchPattern"`
+	Hosts                []RemoteHost      `json:"hosts"`
+	UndoWindow           string            `json:"undoWindow"`
 }
 
 type rawGitStatusConfig struct {
@@ -83,8 +102,26 @@ type rawTDMonitorConfig struct {
 }
 
 type rawConversationsConfig struct {
-	Enabled       *bool  `json:"enabled"`
-	ClaudeDataDir string `json:"claudeDataDir"`
+	Enabled       *bool         `json:"enabled"`
+	ClaudeDataDir string        `json:"claudeDataDir"`
+	Budget        *BudgetConfig            `json:"budget"`
+	BadgeRules    []BadgeRule              `json:"badgeRules"`
+	View          *ConversationsViewConfig `json:"view"`
+	CacheBudgetMB *int                     `json:"cacheBudgetMB"`
+	MaxLineMB     *int                     `json:"maxLineMB"`
+	Prewarm       *rawPrewarmConfig        `json:"prewarm"`
+	Watcher       *rawWatcherConfig        `json:"watcher"`
+}
+
+type rawPrewarmConfig struct {
+	Sessions *int  `json:"sessions"`
+	Messages *bool `json:"messages"`
+}
+
+type rawWatcherConfig struct {
+	HotIdle     string   `json:"hotIdle"`
+	PollPaths   []string `json:"pollPaths"`
+	NetworkPoll string   `json:"networkPoll"`
 }
 
 // Load loads configuration from the default location.
@@ -123,6 +160,9 @@ func LoadFrom(path string) (*Config, error) {
 
 	// Expand paths
 	cfg.Plugins.Conversations.ClaudeDataDir = ExpandPath(cfg.Plugins.Conversations.ClaudeDataDir)
+	for i := range cfg.Plugins.Workspace.Repos {
+		cfg.Plugins.Workspace.Repos[i] = ExpandPath(cfg.Plugins.Workspace.Repos[i])
+	}
 
 	// Expand paths in project list and warn if path doesn't exist
 	for i := range cfg.Projects.List {
@@ -186,6 +226,40 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 	if raw.Plugins.Conversations.ClaudeDataDir != "" {
 		cfg.Plugins.Conversations.ClaudeDataDir = raw.Plugins.Conversations.ClaudeDataDir
 	}
+	if raw.Plugins.Conversations.Budget != nil {
+		cfg.Plugins.Conversations.Budget = *raw.Plugins.Conversations.Budget
+	}
+	if raw.Plugins.Conversations.BadgeRules != nil {
+		cfg.Plugins.Conversations.BadgeRules = raw.Plugins.Conversations.BadgeRules
+	}
+	if raw.Plugins.Conversations.View != nil {
+		cfg.Plugins.Conversations.View = *raw.Plugins.Conversations.View
+	}
+	if raw.Plugins.Conversations.CacheBudgetMB != nil {
+		cfg.Plugins.Conversations.CacheBudgetMB = *raw.Plugins.Conversations.CacheBudgetMB
+	}
+	if raw.Plugins.Conversations.MaxLineMB != nil {
+		cfg.Plugins.Conversations.MaxLineMB = *raw.Plugins.Conversations.MaxLineMB
+	}
+	if pw := raw.Plugins.Conversations.Prewarm; pw != nil {
+		if pw.Sessions != nil && *pw.Sessions >= 0 {
+			cfg.Plugins.Conversations.Prewarm.Sessions = *pw.Sessions
+		}
+		if pw.Messages != nil {
+			cfg.Plugins.Conversations.Prewarm.Messages = *pw.Messages
+		}
+	}
+	if w := raw.Plugins.Conversations.Watcher; w != nil {
+		if d, err := time.ParseDuration(w.HotIdle); err == nil {
+			cfg.Plugins.Conversations.Watcher.HotIdle = d
+		}
+		if d, err := time.ParseDuration(w.NetworkPoll); err == nil {
+			cfg.Plugins.Conversations.Watcher.NetworkPoll = d
+		}
+		for _, p := range w.PollPaths {
+			cfg.Plugins.Conversations.Watcher.PollPaths = append(cfg.Plugins.Conversations.Watcher.PollPaths, ExpandPath(p))
+		}
+	}
 
 	// Workspace
 	if raw.Plugins.Workspace.DirPrefix != nil {
@@ -206,6 +280,46 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 	if raw.Plugins.Workspace.InteractivePasteKey != "" {
 		cfg.Plugins.Workspace.InteractivePasteKey = raw.Plugins.Workspace.InteractivePasteKey
 	}
+	if raw.Plugins.Workspace.InteractiveKeys != nil {
+		cfg.Plugins.Workspace.InteractiveKeys = raw.Plugins.Workspace.InteractiveKeys
+	}
+	if raw.Plugins.Workspace.PRStatusInterval != "" {
+		if d, err := time.ParseDuration(raw.Plugins.Workspace.PRStatusInterval); err == nil {
+			cfg.Plugins.Workspace.PRStatusInterval = d
+		}
+	}
+	if raw.Plugins.Workspace.PostCreateHooks != nil {
+		cfg.Plugins.Workspace.PostCreateHooks = raw.Plugins.Workspace.PostCreateHooks
+	}
+	if raw.Plugins.Workspace.CompletionNotify != nil {
+		cfg.Plugins.Workspace.CompletionNotify = *raw.Plugins.Workspace.CompletionNotify
+	}
+	if raw.Plugins.Workspace.CompletionMarkers != nil {
+		cfg.Plugins.Workspace.CompletionMarkers = raw.Plugins.Workspace.CompletionMarkers
+	}
+	if raw.Plugins.Workspace.Repos != nil {
+		cfg.Plugins.Workspace.Repos = raw.Plugins.Workspace.Repos
+	}
+	if raw.Plugins.Workspace.KanbanColumns != nil {
+		cfg.Plugins.Workspace.KanbanColumns = raw.Plugins.Workspace.KanbanColumns
+	}
+	if raw.Plugins.Workspace.Agents != nil {
+		cfg.Plugins.Workspace.Agents = raw.Plugins.Workspace.Agents
+	}
+	if raw.Plugins.Workspace.AutoLinkTasks != nil {
+		cfg.Plugins.Workspace.AutoLinkTasks = *raw.Plugins.Workspace.AutoLinkTasks
+	}
+	if raw.Plugins.Workspace.TaskBranchPattern != "" {
+		cfg.Plugins.Workspace.TaskBranchPattern = raw.Plugins.Workspace.TaskBranchPattern
+	}
+	if raw.Plugins.Workspace.Hosts != nil {
+		cfg.Plugins.Workspace.Hosts = raw.Plugins.Workspace.Hosts
+	}
+	if raw.Plugins.Workspace.UndoWindow != "" {
+		if d, err := time.ParseDuration(raw.Plugins.Workspace.UndoWindow); err == nil {
+			cfg.Plugins.Workspace.UndoWindow = d
+		}
+	}
 
 	// Keymap
 	if raw.Keymap.Overrides != nil {
@@ -213,6 +327,18 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 			cfg.Keymap.Overrides[k] = v
 		}
 	}
+	if raw.Keymap.Profile != "" {
+		cfg.Keymap.Profile = raw.Keymap.Profile
+	}
+	if raw.Keymap.Leader != "" {
+		cfg.Keymap.Leader = raw.Keymap.Leader
+	}
+	if raw.Keymap.ChordTimeout != "" {
+		cfg.Keymap.ChordTimeout = raw.Keymap.ChordTimeout
+	}
+	if raw.Keymap.WhichKeyDelay != "" {
+		cfg.Keymap.WhichKeyDelay = raw.Keymap.WhichKeyDelay
+	}
 
 	// UI
 	if raw.UI.ShowClock != nil {
@@ -221,6 +347,18 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 	if raw.UI.NerdFontsEnabled != nil {
 		cfg.UI.NerdFontsEnabled = *raw.UI.NerdFontsEnabled
 	}
+	if raw.UI.HighContrastFocus != nil {
+		cfg.UI.HighContrastFocus = *raw.UI.HighContrastFocus
+	}
+	if raw.UI.Icons != "" {
+		cfg.UI.Icons = raw.UI.Icons
+	}
+	if raw.UI.DimInactivePanes != nil {
+		cfg.UI.DimInactivePanes = *raw.UI.DimInactivePanes
+	}
+	if raw.UI.Locale != nil {
+		cfg.UI.Locale = *raw.UI.Locale
+	}
 	if raw.UI.Theme.Name != "" {
 		cfg.UI.Theme.Name = raw.UI.Theme.Name
 	}
@@ -232,6 +370,12 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 			cfg.UI.Theme.Overrides[k] = v
 		}
 	}
+	if raw.UI.Theme.PluginOverrides != nil {
+		cfg.UI.Theme.PluginOverrides = raw.UI.Theme.PluginOverrides
+	}
+	if raw.UI.Theme.TabOverrides != nil {
+		cfg.UI.Theme.TabOverrides = raw.UI.Theme.TabOverrides
+	}
 	// Migrate legacy communityName from overrides to Community field
 	if cfg.UI.Theme.Community == "" && cfg.UI.Theme.Overrides != nil {
 		if name, ok := cfg.UI.Theme.Overrides["communityName"]; ok {
@@ -249,6 +393,31 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 			cfg.Features.Flags[k] = v
 		}
 	}
+	if raw.Features.Variants != nil {
+		cfg.Features.Variants = make(map[string]string, len(raw.Features.Variants))
+		for k, v := range raw.Features.Variants {
+			cfg.Features.Variants[k] = v
+		}
+	}
+	if raw.Features.RemoteURL != "" {
+		cfg.Features.RemoteURL = raw.Features.RemoteURL
+	}
+	if raw.Features.RemoteInterval != "" {
+		cfg.Features.RemoteInterval = raw.Features.RemoteInterval
+	}
+
+	// Accessibility
+	if raw.Accessibility.ColorblindMode != "" {
+		cfg.Accessibility.ColorblindMode = raw.Accessibility.ColorblindMode
+	}
+
+	// Events
+	if len(raw.Events.Webhooks) > 0 {
+		cfg.Events.Webhooks = raw.Events.Webhooks
+	}
+	if raw.Events.Bridge.Enabled {
+		cfg.Events.Bridge = raw.Events.Bridge
+	}
 }
 
 // ExpandPath expands ~ to home directory.
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 )
@@ -65,6 +66,117 @@ func TestLoadFrom_ValidJSON(t *testing.T) {
 	}
 }
 
+func TestLoadFrom_ConversationsBudget(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{
+		"plugins": {
+			"conversations": {
+				"budget": {"sessionTokens": 500000, "dailyCost": 20, "sessionCost": -1},
+				"cacheBudgetMB": 512,
+				"maxLineMB": 32,
+				"prewarm": {"sessions": 3},
+				"watcher": {"hotIdle": "90s", "pollPaths": ["/mnt/nfs"], "networkPoll": "2s"}
+			}
+		}
+	}`)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	b := cfg.Plugins.Conversations.Budget
+	if b.SessionTokens != 500000 || b.DailyCost != 20 {
+		t.Errorf("got budget %+v, want sessionTokens=500000 dailyCost=20", b)
+	}
+	if b.SessionCost != 0 {
+		t.Errorf("negative sessionCost should be clamped to 0, got %v", b.SessionCost)
+	}
+	if !cfg.Plugins.Conversations.Enabled {
+		t.Error("conversations should still be enabled (default)")
+	}
+	if got := cfg.Plugins.Conversations.CacheBudgetMB; got != 512 {
+		t.Errorf("cacheBudgetMB = %d, want 512", got)
+	}
+	if got := cfg.Plugins.Conversations.MaxLineMB; got != 32 {
+		t.Errorf("maxLineMB = %d, want 32", got)
+	}
+	if pw := cfg.Plugins.Conversations.Prewarm; pw.Sessions != 3 || !pw.Messages {
+		t.Errorf("prewarm = %+v, want sessions=3 with default messages=true", pw)
+	}
+	if got := cfg.Plugins.Conversations.Watcher.HotIdle; got != 90*time.Second {
+		t.Errorf("watcher.hotIdle = %v, want 90s", got)
+	}
+	if w := cfg.Plugins.Conversations.Watcher; len(w.PollPaths) != 1 || w.PollPaths[0] != "/mnt/nfs" || w.NetworkPoll != 2*time.Second {
+		t.Errorf("watcher = %+v, want pollPaths=[/mnt/nfs] networkPoll=2s", w)
+	}
+}
+
+func TestLoadFrom_Accessibility(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"accessibility": {"colorblindMode": "tritanopia"}}`)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if got := cfg.Accessibility.ColorblindMode; got != "tritanopia" {
+		t.Errorf("ColorblindMode = %q, want tritanopia", got)
+	}
+}
+
+func TestLoadFrom_EventWebhooks(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"events": {"webhooks": [
+		{"url": "https://hooks.example.com/x", "events": ["merge_completed"], "template": "{\"text\": {{json .Text}}}"}
+	]}}`)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	hooks := cfg.Events.Webhooks
+	if len(hooks) != 1 || hooks[0].URL != "https://hooks.example.com/x" ||
+		len(hooks[0].Events) != 1 || hooks[0].Events[0] != "merge_completed" ||
+		hooks[0].Template != `{"text": {{json .Text}}}` {
+		t.Errorf("Webhooks = %+v", hooks)
+	}
+}
+
+func TestLoadFrom_EventBridge(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"events": {"bridge": {"enabled": true, "socket": "/tmp/forge.sock", "events": ["agent_completed"]}}}`)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	b := cfg.Events.Bridge
+	if !b.Enabled || b.Socket != "/tmp/forge.sock" || len(b.Events) != 1 || b.Events[0] != "agent_completed" {
+		t.Errorf("Bridge = %+v", b)
+	}
+}
+
 func TestLoadFrom_InvalidJSON(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "config.json")
@@ -184,3 +296,185 @@ func TestLoadFrom_EmptyProjectsList(t *testing.T) {
 		t.Errorf("got %d projects, want 0", len(cfg.Projects.List))
 	}
 }
+
+func TestLoadFrom_WorkspaceRepos(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"plugins": {"workspace": {"repos": ["/src/api", "~/code/web"]}}}`)
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	home, _ := os.UserHomeDir()
+	want := []string{"/src/api", filepath.Join(home, "code/web")}
+	if len(cfg.Plugins.Workspace.Repos) != 2 || cfg.Plugins.Workspace.Repos[0] != want[0] || cfg.Plugins.Workspace.Repos[1] != want[1] {
+		t.Errorf("got repos %v, want %v", cfg.Plugins.Workspace.Repos, want)
+	}
+}
+
+func TestLoadFrom_WorkspaceAgents(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"plugins": {"workspace": {"agents": [{"id": "amp", "name": "Amp", "command": "amp", "resumeCommand": "amp --continue", "idlePattern": "^> $"}]}}}`)
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	want := AgentDefinition{ID: "amp", Name: "Amp", Command: "amp", ResumeCommand: "amp --continue", IdlePattern: "^> $"}
+	if len(cfg.Plugins.Workspace.Agents) != 1 || cfg.Plugins.Workspace.Agents[0] != want {
+		t.Errorf("got agents %+v, want [%+v]", cfg.Plugins.Workspace.Agents, want)
+	}
+}
+
+func TestLoadFrom_WorkspaceTaskLinking(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if !cfg.Plugins.Workspace.AutoLinkTasks {
+		t.Error("expected task auto-linking on by default")
+	}
+
+	content := []byte(`{"plugins": {"workspace": {"autoLinkTasks": false, "taskBranchPattern": "(PROJ-[0-9]+)"}}}`)
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if cfg.Plugins.Workspace.AutoLinkTasks {
+		t.Error("expected autoLinkTasks false to disable auto-linking")
+	}
+	if cfg.Plugins.Workspace.TaskBranchPattern != "(PROJ-[0-9]+)" {
+		t.Errorf("TaskBranchPattern = %q", cfg.Plugins.Workspace.TaskBranchPattern)
+	}
+}
+
+func TestLoadFrom_WorkspaceHosts(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"plugins": {"workspace": {"hosts": [{"name": "box", "address": "dev@box", "repoPath": "/src/app", "pollInterval": "5s"}]}}}`)
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	want := RemoteHost{Name: "box", Address: "dev@box", RepoPath: "/src/app", PollInterval: "5s"}
+	if len(cfg.Plugins.Workspace.Hosts) != 1 || cfg.Plugins.Workspace.Hosts[0] != want {
+		t.Errorf("got hosts %+v, want [%+v]", cfg.Plugins.Workspace.Hosts, want)
+	}
+}
+
+func TestLoadFrom_WorkspaceUndoWindow(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	if err := os.WriteFile(configPath, []byte(`{}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if cfg.Plugins.Workspace.UndoWindow != 5*time.Minute {
+		t.Errorf("default undoWindow = %v, want 5m", cfg.Plugins.Workspace.UndoWindow)
+	}
+
+	if err := os.WriteFile(configPath, []byte(`{"plugins": {"workspace": {"undoWindow": "0s"}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if cfg.Plugins.Workspace.UndoWindow != 0 {
+		t.Errorf("undoWindow = %v, want 0 (disabled)", cfg.Plugins.Workspace.UndoWindow)
+	}
+}
+
+func TestLoadFrom_WorkspaceInteractiveKeys(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	content := []byte(`{"plugins": {"workspace": {"interactiveKeys": {
+		"*": {"capture": ["ctrl+k"]},
+		"shell": {"capture": ["` + "`" + `"], "passthrough": ["esc", "alt+c"]}
+	}}}}`)
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	shell := cfg.Plugins.Workspace.InteractiveKeysFor("shell")
+	if !slices.Equal(shell.Capture, []string{"`"}) || !slices.Equal(shell.Passthrough, []string{"esc", "alt+c"}) {
+		t.Errorf("shell keys = %+v", shell)
+	}
+	if claude := cfg.Plugins.Workspace.InteractiveKeysFor("claude"); !slices.Equal(claude.Capture, []string{"ctrl+k"}) || claude.Passthrough != nil {
+		t.Errorf("claude keys = %+v, want the * entry", claude)
+	}
+}
+
+func TestLoadFrom_ConversationsView(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	projectDir := filepath.Join(dir, "myproject")
+
+	content := []byte(`{
+		"projects": {
+			"list": [
+				{"name": "Mine", "path": "` + projectDir + `", "conversations": {"layout": "list", "sort": "cost"}}
+			]
+		},
+		"plugins": {
+			"conversations": {
+				"view": {"sort": "created", "groupBy": "none", "filters": {"adapters": ["codex"], "date": "week"}}
+			}
+		}
+	}`)
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	global := cfg.ConversationsView(filepath.Join(dir, "other"))
+	if global.Sort != ConversationsSortCreated || global.GroupBy != ConversationsGroupNone {
+		t.Errorf("global view = %+v", global)
+	}
+	if len(global.Filters.Adapters) != 1 || global.Filters.Date != "week" {
+		t.Errorf("global filters = %+v", global.Filters)
+	}
+
+	project := cfg.ConversationsView(projectDir)
+	if project.Layout != ConversationsLayoutList || project.Sort != ConversationsSortCost || project.GroupBy != "" {
+		t.Errorf("project view = %+v, want project entry to replace global", project)
+	}
+}
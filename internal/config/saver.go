@@ -10,11 +10,13 @@ import (
 
 // saveConfig is the JSON-marshaling intermediary that uses string durations.
 type saveConfig struct {
-	Projects saveProjectsConfig `json:"projects"`
-	Plugins  savePluginsConfig  `json:"plugins"`
-	Keymap   KeymapConfig       `json:"keymap"`
-	UI       UIConfig           `json:"ui"`
-	Features FeaturesConfig     `json:"features,omitempty"`
+	Projects      saveProjectsConfig  `json:"projects"`
+	Plugins       savePluginsConfig   `json:"plugins"`
+	Keymap        KeymapConfig        `json:"keymap"`
+	UI            UIConfig            `json:"ui"`
+	Features      FeaturesConfig      `json:"features,omitempty"`
+	Accessibility AccessibilityConfig `json:"accessibility,omitempty"`
+	Events        EventsConfig        `json:"events,omitempty"`
 }
 
 type saveProjectsConfig struct {
@@ -42,8 +44,21 @@ type saveTDMonitorConfig struct {
 }
 
 type saveConversationsConfig struct {
-	Enabled       *bool  `json:"enabled,omitempty"`
-	ClaudeDataDir string `json:"claudeDataDir,omitempty"`
+	Enabled       *bool         `json:"enabled,omitempty"`
+	ClaudeDataDir string        `json:"claudeDataDir,omitempty"`
+	Budget        *BudgetConfig            `json:"budget,omitempty"`
+	BadgeRules    []BadgeRule              `json:"badgeRules,omitempty"`
+	View          *ConversationsViewConfig `json:"view,omitempty"`
+	CacheBudgetMB int                      `json:"cacheBudgetMB,omitempty"`
+	MaxLineMB     int                      `json:"maxLineMB,omitempty"`
+	Prewarm       *PrewarmConfig           `json:"prewarm,omitempty"`
+	Watcher       *saveWatcherConfig       `json:"watcher,omitempty"`
+}
+
+type saveWatcherConfig struct {
+	HotIdle     string   `json:"hotIdle,omitempty"`
+	PollPaths   []string `json:"pollPaths,omitempty"`
+	NetworkPoll string   `json:"networkPoll,omitempty"`
 }
 
 type saveWorkspaceConfig struct {
@@ -53,6 +68,18 @@ type saveWorkspaceConfig struct {
 	InteractiveAttachKey string `json:"interactiveAttachKey,omitempty"`
 	InteractiveCopyKey   string `json:"interactiveCopyKey,omitempty"`
 	InteractivePasteKey  string `json:"interactivePasteKey,omitempty"`
+	InteractiveKeys      map[string]InteractiveKeys `json:"interactiveKeys,omitempty"`
+	PRStatusInterval     string   `json:"prStatusInterval,omitempty"`
+	PostCreateHooks      []string `json:"postCreateHooks,omitempty"`
+	CompletionNotify     *bool    `json:"completionNotify,omitempty"`
+	CompletionMarkers    []string `json:"completionMarkers,omitempty"`
+	Repos                []string       `json:"repos,omitempty"`
+	KanbanColumns        []KanbanColumn `json:"kanbanColumns,omitempty"`
+	Agents               []AgentDefinition `json:"agents,omitempty"`
+	AutoLinkTasks        *bool             `json:"autoLinkTasks,omitempty"`
+	TaskBranchPattern    string            `json:"taskBranchPattern,omitempty"`
+	Hosts                []RemoteHost      `json:"hosts,omitempty"`
+	UndoWindow           string            `json:"undoWindow,omitempty"`
 }
 
 // toSaveConfig converts Config to the JSON-serializable format.
@@ -76,6 +103,17 @@ func toSaveConfig(cfg *Config) saveConfig {
 			Conversations: saveConversationsConfig{
 				Enabled:       &cfg.Plugins.Conversations.Enabled,
 				ClaudeDataDir: cfg.Plugins.Conversations.ClaudeDataDir,
+				Budget:        budgetForSave(cfg.Plugins.Conversations.Budget),
+				BadgeRules:    cfg.Plugins.Conversations.BadgeRules,
+				View:          viewForSave(cfg.Plugins.Conversations.View),
+				CacheBudgetMB: cfg.Plugins.Conversations.CacheBudgetMB,
+				MaxLineMB:     cfg.Plugins.Conversations.MaxLineMB,
+				Prewarm:       &cfg.Plugins.Conversations.Prewarm,
+				Watcher: &saveWatcherConfig{
+					HotIdle:     cfg.Plugins.Conversations.Watcher.HotIdle.String(),
+					PollPaths:   cfg.Plugins.Conversations.Watcher.PollPaths,
+					NetworkPoll: cfg.Plugins.Conversations.Watcher.NetworkPoll.String(),
+				},
 			},
 			Workspace: saveWorkspaceConfig{
 				DirPrefix:            &cfg.Plugins.Workspace.DirPrefix,
@@ -84,12 +122,42 @@ func toSaveConfig(cfg *Config) saveConfig {
 				InteractiveAttachKey: cfg.Plugins.Workspace.InteractiveAttachKey,
 				InteractiveCopyKey:   cfg.Plugins.Workspace.InteractiveCopyKey,
 				InteractivePasteKey:  cfg.Plugins.Workspace.InteractivePasteKey,
+				InteractiveKeys:      cfg.Plugins.Workspace.InteractiveKeys,
+				PRStatusInterval:     cfg.Plugins.Workspace.PRStatusInterval.String(),
+				PostCreateHooks:      cfg.Plugins.Workspace.PostCreateHooks,
+				CompletionNotify:     &cfg.Plugins.Workspace.CompletionNotify,
+				CompletionMarkers:    cfg.Plugins.Workspace.CompletionMarkers,
+				Repos:                cfg.Plugins.Workspace.Repos,
+				KanbanColumns:        cfg.Plugins.Workspace.KanbanColumns,
+				Agents:               cfg.Plugins.Workspace.Agents,
+				AutoLinkTasks:        &cfg.Plugins.Workspace.AutoLinkTasks,
+				TaskBranchPattern:    cfg.Plugins.Workspace.TaskBranchPattern,
+				Hosts:                cfg.Plugins.Workspace.Hosts,
+				UndoWindow:           cfg.Plugins.Workspace.UndoWindow.String(),
 			},
 		},
-		Keymap:   cfg.Keymap,
-		UI:       cfg.UI,
-		Features: cfg.Features,
+		Keymap:        cfg.Keymap,
+		UI:            cfg.UI,
+		Features:      cfg.Features,
+		Accessibility: cfg.Accessibility,
+		Events:        cfg.Events,
+	}
+}
+
+// budgetForSave omits the budget block when no threshold is set.
+func budgetForSave(b BudgetConfig) *BudgetConfig {
+	if !b.Enabled() {
+		return nil
 	}
+	return &b
+}
+
+// viewForSave omits the conversations view when nothing is set.
+func viewForSave(v ConversationsViewConfig) *ConversationsViewConfig {
+	if v.IsZero() {
+		return nil
+	}
+	return &v
 }
 
 // Save writes the config to ~/.config/forge/config.json, preserving
@@ -124,6 +192,12 @@ func Save(cfg *Config) error {
 	if len(sc.Features.Flags) > 0 {
 		fields["features"] = sc.Features
 	}
+	if sc.Accessibility.ColorblindMode != "" {
+		fields["accessibility"] = sc.Accessibility
+	}
+	if len(sc.Events.Webhooks) > 0 || sc.Events.Bridge.Enabled {
+		fields["events"] = sc.Events
+	}
 	for key, val := range fields {
 		b, err := json.Marshal(val)
 		if err != nil {
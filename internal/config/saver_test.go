@@ -98,3 +98,36 @@ func TestSave_WorksWithNoExistingFile(t *testing.T) {
 		t.Error("missing 'projects' key")
 	}
 }
+
+func TestSave_ConversationsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	SetTestConfigPath(path)
+	defer ResetTestConfigPath()
+
+	cfg := Default()
+	cfg.Plugins.Conversations.Budget = BudgetConfig{SessionTokens: 250000}
+	cfg.Plugins.Conversations.BadgeRules = []BadgeRule{
+		{Label: "prod", Color: "#EF4444", NameRegex: "(?i)deploy", Tools: []string{"Bash"}},
+	}
+	cfg.Plugins.Conversations.View = ConversationsViewConfig{Layout: ConversationsLayoutList, Sort: ConversationsSortTokens}
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+	if got := loaded.Plugins.Conversations.Budget.SessionTokens; got != 250000 {
+		t.Errorf("budget sessionTokens = %d, want 250000", got)
+	}
+	rules := loaded.Plugins.Conversations.BadgeRules
+	if len(rules) != 1 || rules[0].Label != "prod" || rules[0].NameRegex != "(?i)deploy" || len(rules[0].Tools) != 1 {
+		t.Errorf("badge rules not round-tripped: %+v", rules)
+	}
+	if view := loaded.Plugins.Conversations.View; view.Layout != ConversationsLayoutList || view.Sort != ConversationsSortTokens {
+		t.Errorf("view not round-tripped: %+v", view)
+	}
+}
@@ -0,0 +1,332 @@
+package event
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// bridgeRetry is how long a bridge waits before reconnecting after the
+	// hub goes away or the socket cannot be used.
+	bridgeRetry = 2 * time.Second
+	// bridgeBuffer is how many events may wait to be forwarded to the hub
+	// before newer ones are dropped.
+	bridgeBuffer = 64
+	// bridgeWriteTimeout bounds forwarding one event to a stuck hub.
+	bridgeWriteTimeout = 5 * time.Second
+	// maxBridgeLine bounds one encoded event read from the socket.
+	maxBridgeLine = 1 << 20
+)
+
+// BridgeOptions configures a Bridge.
+type BridgeOptions struct {
+	Socket string // unix socket path shared by every instance
+	Origin string // labels this instance's events at the hub, e.g. the project name
+	Types  []Type // event types forwarded; empty forwards all
+	Logger *slog.Logger
+}
+
+// Bridge shares selected events between forge instances, e.g. ones open on
+// different projects, over a unix socket. The first instance to bind the
+// socket becomes the hub; the others connect to it and forward their local
+// events, which the hub publishes on its own dispatcher with Origin set.
+// Subscribers in the hub therefore see events from every instance, so one
+// instance can aggregate notifications. When the hub exits, the next
+// instance to reconnect takes over the socket.
+//
+// Forwarding is best-effort: events published while an instance is between
+// hubs, or while its queue is full, are not forwarded.
+type Bridge struct {
+	bus    *Dispatcher
+	socket string
+	origin string
+	logger *slog.Logger
+	events <-chan Event
+	done   chan struct{}
+
+	mu       sync.Mutex
+	listener net.Listener // set while this instance is the hub
+	hub      net.Conn     // set while connected to another instance's hub
+	clients  map[net.Conn]struct{}
+	closed   bool
+}
+
+// NewBridge starts a bridge for bus on opts.Socket.
+func NewBridge(bus *Dispatcher, opts BridgeOptions) (*Bridge, error) {
+	if opts.Socket == "" {
+		return nil, errors.New("bridge: no socket path")
+	}
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	origin := opts.Origin
+	if origin == "" {
+		origin = fmt.Sprintf("pid %d", os.Getpid())
+	}
+
+	b := &Bridge{
+		bus:     bus,
+		socket:  opts.Socket,
+		origin:  origin,
+		logger:  logger,
+		done:    make(chan struct{}),
+		clients: make(map[net.Conn]struct{}),
+	}
+	subOpts := []SubscribeOption{
+		// Bridged events are never forwarded again
+		WithFilter(func(e Event) bool { return e.Origin == "" }),
+		WithName("bridge"),
+		WithBuffer(bridgeBuffer),
+	}
+	if len(opts.Types) > 0 {
+		subOpts = append(subOpts, WithTypes(opts.Types...))
+	}
+	b.events = bus.Subscribe("*", subOpts...)
+
+	go b.run()
+	go b.forward()
+	return b, nil
+}
+
+// IsHub reports whether this instance currently serves the socket.
+func (b *Bridge) IsHub() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.listener != nil
+}
+
+// Forwarding reports whether this instance is connected to another
+// instance's hub, so its events are published there too.
+func (b *Bridge) Forwarding() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.hub != nil
+}
+
+// Close leaves the bridge. A hub removes its socket so another instance
+// can take over.
+func (b *Bridge) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	close(b.done)
+	if b.listener != nil {
+		_ = b.listener.Close()
+	}
+	if b.hub != nil {
+		_ = b.hub.Close()
+	}
+	for c := range b.clients {
+		_ = c.Close()
+	}
+	b.mu.Unlock()
+	b.bus.Unsubscribe(b.events)
+}
+
+// run connects to the hub, or becomes it when there is none, and retries
+// whenever the connection or listener is lost.
+func (b *Bridge) run() {
+	for {
+		if conn, err := net.Dial("unix", b.socket); err == nil {
+			b.follow(conn)
+		} else if l, err := b.listen(); err == nil {
+			b.serve(l)
+		} else {
+			b.logger.Warn("event bridge unavailable", "socket", b.socket, "err", err)
+		}
+
+		select {
+		case <-b.done:
+			return
+		case <-time.After(bridgeRetry):
+		}
+	}
+}
+
+// listen binds the socket, replacing a stale one left by an instance that
+// exited without removing it.
+func (b *Bridge) listen() (net.Listener, error) {
+	l, err := net.Listen("unix", b.socket)
+	if err == nil || !errors.Is(err, syscall.EADDRINUSE) {
+		return l, err
+	}
+	if conn, dialErr := net.Dial("unix", b.socket); dialErr == nil {
+		_ = conn.Close()
+		return nil, err // another instance became the hub meanwhile
+	}
+	_ = os.Remove(b.socket)
+	return net.Listen("unix", b.socket)
+}
+
+// follow holds the connection to the hub until either side closes it.
+func (b *Bridge) follow(conn net.Conn) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		_ = conn.Close()
+		return
+	}
+	b.hub = conn
+	b.mu.Unlock()
+
+	// The hub sends nothing; reading only detects when it goes away
+	_, _ = io.Copy(io.Discard, conn)
+
+	b.mu.Lock()
+	b.hub = nil
+	b.mu.Unlock()
+	_ = conn.Close()
+}
+
+// serve accepts other instances until the listener is closed.
+func (b *Bridge) serve(l net.Listener) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		_ = l.Close()
+		return
+	}
+	b.listener = l
+	b.mu.Unlock()
+	b.logger.Info("event bridge hub", "socket", b.socket)
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			break
+		}
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			_ = conn.Close()
+			break
+		}
+		b.clients[conn] = struct{}{}
+		b.mu.Unlock()
+		go b.receive(conn)
+	}
+
+	b.mu.Lock()
+	b.listener = nil
+	b.mu.Unlock()
+	_ = l.Close()
+}
+
+// receive publishes the events an instance forwards until it disconnects.
+func (b *Bridge) receive(conn net.Conn) {
+	defer func() {
+		b.mu.Lock()
+		delete(b.clients, conn)
+		b.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	sc := bufio.NewScanner(conn)
+	sc.Buffer(make([]byte, 0, 64*1024), maxBridgeLine)
+	for sc.Scan() {
+		e, err := decodeBridged(sc.Bytes())
+		if err != nil {
+			b.logger.Warn("event bridge: bad event", "err", err)
+			continue
+		}
+		b.bus.Publish(e.Topic, e)
+	}
+}
+
+// forward sends local events to the hub while connected to one. The hub's
+// own events are already on its dispatcher.
+func (b *Bridge) forward() {
+	for e := range b.events {
+		b.mu.Lock()
+		hub := b.hub
+		b.mu.Unlock()
+		if hub == nil {
+			continue
+		}
+
+		line, err := encodeBridged(b.origin, e)
+		if err != nil {
+			b.logger.Warn("event bridge: cannot encode event", "type", e.Type, "err", err)
+			continue
+		}
+		_ = hub.SetWriteDeadline(time.Now().Add(bridgeWriteTimeout))
+		if _, err := hub.Write(line); err != nil {
+			b.logger.Warn("event bridge: forward failed", "type", e.Type, "err", err)
+			_ = hub.Close() // follow returns and run reconnects
+		}
+	}
+}
+
+// bridgedEvent is an event as sent over the socket, one JSON object per
+// line.
+type bridgedEvent struct {
+	Origin string          `json:"origin"`
+	Type   Type            `json:"type"`
+	Topic  string          `json:"topic"`
+	Time   time.Time       `json:"time"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+// encodeBridged encodes e from origin as a newline-terminated line.
+func encodeBridged(origin string, e Event) ([]byte, error) {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	line, err := json.Marshal(bridgedEvent{Origin: origin, Type: e.Type, Topic: e.Topic, Time: e.Timestamp, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// decodeBridged decodes a line written by encodeBridged. Data of the event
+// types defined in this package decodes to the same Go types as local
+// events; anything else arrives as generic JSON values.
+func decodeBridged(line []byte) (Event, error) {
+	var w bridgedEvent
+	if err := json.Unmarshal(line, &w); err != nil {
+		return Event{}, err
+	}
+	if w.Origin == "" {
+		return Event{}, errors.New("event without origin")
+	}
+
+	var data any
+	var err error
+	if len(w.Data) > 0 && string(w.Data) != "null" {
+		switch w.Type {
+		case TypeAgentCompleted, TypeSessionError:
+			data, err = decodeAs[AgentCompletion](w.Data)
+		case TypeMergeCompleted:
+			data, err = decodeAs[MergeCompletion](w.Data)
+		case TypeTimer:
+			data, err = decodeAs[Tick](w.Data)
+		default:
+			err = json.Unmarshal(w.Data, &data)
+		}
+	}
+	if err != nil {
+		return Event{}, fmt.Errorf("%s data: %w", w.Type, err)
+	}
+	return Event{Type: w.Type, Topic: w.Topic, Timestamp: w.Time, Data: data, Origin: w.Origin}, nil
+}
+
+func decodeAs[T any](raw json.RawMessage) (any, error) {
+	var v T
+	err := json.Unmarshal(raw, &v)
+	return v, err
+}
@@ -0,0 +1,103 @@
+package event
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBridge_ForwardsToHub(t *testing.T) {
+	// Unix socket paths are length-limited, so avoid t.TempDir's long names
+	dir, err := os.MkdirTemp("", "bridge")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "events.sock")
+
+	hubBus, clientBus := New(), New()
+	defer hubBus.Close()
+	defer clientBus.Close()
+
+	hub, err := NewBridge(hubBus, BridgeOptions{Socket: socket, Origin: "hub", Types: []Type{TypeAgentCompleted}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hub.Close()
+	waitFor(t, hub.IsHub)
+
+	client, err := NewBridge(clientBus, BridgeOptions{Socket: socket, Origin: "api", Types: []Type{TypeAgentCompleted}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if client.IsHub() {
+		t.Fatal("second instance became hub")
+	}
+	waitFor(t, client.Forwarding)
+	if hub.Forwarding() {
+		t.Error("hub reports forwarding")
+	}
+
+	received := hubBus.Subscribe(TopicWorkspace, WithFilter(func(e Event) bool { return e.Origin != "" }))
+	want := AgentCompletion{Workspace: "feat", Agent: "claude", Status: "waiting"}
+	// The client may not have connected yet, so publish until one arrives
+	deadline := time.After(2 * time.Second)
+	for {
+		clientBus.Publish(TopicWorkspace, NewEvent(TypeMergeCompleted, TopicWorkspace, MergeCompletion{}))
+		clientBus.Publish(TopicWorkspace, NewEvent(TypeAgentCompleted, TopicWorkspace, want))
+		select {
+		case e := <-received:
+			if e.Type != TypeAgentCompleted || e.Origin != "api" || e.Topic != TopicWorkspace {
+				t.Fatalf("bridged event = %+v", e)
+			}
+			if got, ok := e.Data.(AgentCompletion); !ok || got != want {
+				t.Fatalf("bridged data = %#v", e.Data)
+			}
+			return
+		case <-deadline:
+			t.Fatal("no event reached the hub")
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+}
+
+func TestDecodeBridged(t *testing.T) {
+	e := NewEvent(TypeTimer, TimerTopic("report"), Tick{Name: "report", Count: 3})
+	line, err := encodeBridged("web", e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodeBridged(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick, ok := got.Data.(Tick); !ok || tick.Name != "report" || tick.Count != 3 ||
+		got.Origin != "web" || !got.Timestamp.Equal(e.Timestamp) {
+		t.Errorf("decoded = %+v", got)
+	}
+
+	// Unknown data decodes generically
+	line, _ = encodeBridged("web", NewEvent(TypeRefreshNeeded, "ui", map[string]int{"n": 1}))
+	if got, err := decodeBridged(line); err != nil || got.Data.(map[string]any)["n"] != 1.0 {
+		t.Errorf("generic decode = %+v, %v", got, err)
+	}
+
+	for _, bad := range []string{`nope`, `{"type":"timer"}`, `{"origin":"x","type":"timer","data":"x"}`} {
+		if _, err := decodeBridged([]byte(bad)); err == nil {
+			t.Errorf("decodeBridged(%s): expected error", bad)
+		}
+	}
+}
+
+// waitFor polls cond for up to a second.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -2,23 +2,106 @@ package event
 
 import (
 	"log/slog"
+	"strings"
 	"sync"
+	"time"
 )
 
 const defaultBufferSize = 16
 
 // Dispatcher handles fan-out event routing between plugins.
 type Dispatcher struct {
-	subscribers map[string][]chan Event
+	subscribers map[string][]*subscriber // exact topic -> subscribers
+	wildcards   []*subscriber            // subscribers to topic patterns
+	history     history                  // recent events for WithReplay
+	metrics     busMetrics
 	mu          sync.RWMutex
 	closed      bool
 	logger      *slog.Logger
 }
 
+// Filter reports whether a subscriber wants an event. Filters run on the
+// publisher's goroutine and must not block.
+type Filter func(Event) bool
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(*subscriber)
+
+// WithFilter delivers only events accepted by f. With several filters,
+// an event must pass all of them.
+func WithFilter(f Filter) SubscribeOption {
+	return func(s *subscriber) {
+		s.filters = append(s.filters, f)
+	}
+}
+
+// WithTypes delivers only events of the given types.
+func WithTypes(types ...Type) SubscribeOption {
+	set := make(map[Type]bool, len(types))
+	for _, t := range types {
+		set[t] = true
+	}
+	return WithFilter(func(e Event) bool { return set[e.Type] })
+}
+
+// WithBuffer sets the subscription's channel buffer, default 16.
+func WithBuffer(n int) SubscribeOption {
+	return func(s *subscriber) {
+		if n > 0 {
+			s.buffer = n
+		}
+	}
+}
+
+// subscriber is one subscription to a topic or topic pattern.
+type subscriber struct {
+	pattern string
+	name    string
+	ch      chan Event
+	filters []Filter
+	buffer  int
+	replay  bool
+	metrics subscriberMetrics
+}
+
+// wants reports whether the subscriber receives e published on topic.
+func (s *subscriber) wants(topic string, e Event) bool {
+	if !MatchTopic(s.pattern, topic) {
+		return false
+	}
+	for _, f := range s.filters {
+		if !f(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchTopic reports whether topic matches pattern. Topics are
+// dot-separated; a pattern is an exact topic, "*" for every topic, or a
+// prefix ending in ".*" for a topic and everything below it, so
+// "adapter.*" matches "adapter", "adapter.claude", and
+// "adapter.claude.watch".
+func MatchTopic(pattern, topic string) bool {
+	switch {
+	case pattern == topic || pattern == "*":
+		return true
+	case strings.HasSuffix(pattern, ".*"):
+		prefix := strings.TrimSuffix(pattern, ".*")
+		return topic == prefix || strings.HasPrefix(topic, prefix+".")
+	}
+	return false
+}
+
+func isPattern(topic string) bool {
+	return topic == "*" || strings.HasSuffix(topic, ".*")
+}
+
 // New creates a new event dispatcher.
 func New() *Dispatcher {
 	return &Dispatcher{
-		subscribers: make(map[string][]chan Event),
+		subscribers: make(map[string][]*subscriber),
+		history:     history{size: defaultReplaySize},
 		logger:      slog.Default(),
 	}
 }
@@ -26,13 +109,15 @@ func New() *Dispatcher {
 // NewWithLogger creates a dispatcher with custom logger.
 func NewWithLogger(logger *slog.Logger) *Dispatcher {
 	return &Dispatcher{
-		subscribers: make(map[string][]chan Event),
+		subscribers: make(map[string][]*subscriber),
+		history:     history{size: defaultReplaySize},
 		logger:      logger,
 	}
 }
 
-// Subscribe creates a buffered channel for receiving events on a topic.
-func (d *Dispatcher) Subscribe(topic string) <-chan Event {
+// Subscribe creates a buffered channel for receiving events on a topic or
+// topic pattern (see MatchTopic), narrowed by any filters in opts.
+func (d *Dispatcher) Subscribe(topic string, opts ...SubscribeOption) <-chan Event {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
@@ -42,12 +127,56 @@ func (d *Dispatcher) Subscribe(topic string) <-chan Event {
 		return ch
 	}
 
-	ch := make(chan Event, defaultBufferSize)
-	d.subscribers[topic] = append(d.subscribers[topic], ch)
-	return ch
+	s := &subscriber{pattern: topic, name: topic, buffer: defaultBufferSize}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.ch = make(chan Event, s.buffer)
+	if s.replay {
+		// Publishers hold d.mu, so nothing is missed or repeated between
+		// the replay and the first live event
+		missed := d.history.matching(s)
+		for _, e := range missed[max(len(missed)-s.buffer, 0):] {
+			s.ch <- e
+		}
+	}
+	if isPattern(topic) {
+		d.wildcards = append(d.wildcards, s)
+	} else {
+		d.subscribers[topic] = append(d.subscribers[topic], s)
+	}
+	return s.ch
 }
 
-// Publish sends an event to all subscribers of a topic.
+// Unsubscribe removes the subscription that returned ch and closes it.
+func (d *Dispatcher) Unsubscribe(ch <-chan Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return
+	}
+	remove := func(subs []*subscriber) []*subscriber {
+		for i, s := range subs {
+			if s.ch == ch {
+				close(s.ch)
+				return append(subs[:i:i], subs[i+1:]...)
+			}
+		}
+		return subs
+	}
+	d.wildcards = remove(d.wildcards)
+	for topic, subs := range d.subscribers {
+		if subs = remove(subs); len(subs) == 0 {
+			delete(d.subscribers, topic)
+		} else {
+			d.subscribers[topic] = subs
+		}
+	}
+}
+
+// Publish sends an event to all subscribers of a topic, and to pattern
+// subscribers matching it, whose filters accept it.
 // Non-blocking: drops events if subscriber buffer is full.
 func (d *Dispatcher) Publish(topic string, e Event) {
 	d.mu.RLock()
@@ -57,22 +186,18 @@ func (d *Dispatcher) Publish(topic string, e Event) {
 		return
 	}
 
-	subs, ok := d.subscribers[topic]
-	if !ok {
-		return
+	defer d.timePublish(time.Now())
+	d.history.record(topic, e)
+	for _, s := range d.subscribers[topic] {
+		d.deliver(s, topic, e)
 	}
-
-	for _, ch := range subs {
-		select {
-		case ch <- e:
-		default:
-			// Buffer full, drop event (best-effort delivery)
-			d.logger.Warn("event dropped", "topic", topic, "type", e.Type)
-		}
+	for _, s := range d.wildcards {
+		d.deliver(s, topic, e)
 	}
 }
 
-// PublishAll sends an event to all subscribers of all topics.
+// PublishAll sends an event to all subscribers of all topics whose
+// filters accept it.
 func (d *Dispatcher) PublishAll(e Event) {
 	d.mu.RLock()
 	defer d.mu.RUnlock()
@@ -81,15 +206,36 @@ func (d *Dispatcher) PublishAll(e Event) {
 		return
 	}
 
+	defer d.timePublish(time.Now())
 	for topic, subs := range d.subscribers {
-		for _, ch := range subs {
-			select {
-			case ch <- e:
-			default:
-				d.logger.Warn("event dropped", "topic", topic, "type", e.Type)
-			}
+		for _, s := range subs {
+			d.deliver(s, topic, e)
 		}
 	}
+	for _, s := range d.wildcards {
+		d.deliver(s, s.pattern, e)
+	}
+}
+
+// deliver sends e to s if it wants it. Must be called with d.mu held.
+func (d *Dispatcher) deliver(s *subscriber, topic string, e Event) {
+	if !s.wants(topic, e) {
+		return
+	}
+	depth := len(s.ch)
+	select {
+	case s.ch <- e:
+		d.observe(s, depth, false)
+	default:
+		// Buffer full, drop event (best-effort delivery)
+		d.logger.Warn("event dropped", "topic", topic, "type", e.Type, "subscriber", s.name)
+		d.observe(s, depth, true)
+	}
+}
+
+// timePublish records the latency of a publish that started at start.
+func (d *Dispatcher) timePublish(start time.Time) {
+	d.metrics.observe(time.Since(start))
 }
 
 // Close shuts down the dispatcher and all subscriber channels.
@@ -103,9 +249,13 @@ func (d *Dispatcher) Close() {
 
 	d.closed = true
 	for _, subs := range d.subscribers {
-		for _, ch := range subs {
-			close(ch)
+		for _, s := range subs {
+			close(s.ch)
 		}
 	}
+	for _, s := range d.wildcards {
+		close(s.ch)
+	}
 	d.subscribers = nil
+	d.wildcards = nil
 }
@@ -147,3 +147,179 @@ func TestDispatcher_Concurrent(t *testing.T) {
 		}
 	}
 }
+
+// recv returns the next event on ch, or false after a short wait.
+func recv(ch <-chan Event) (Event, bool) {
+	select {
+	case e, ok := <-ch:
+		return e, ok
+	case <-time.After(50 * time.Millisecond):
+		return Event{}, false
+	}
+}
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		pattern, topic string
+		want           bool
+	}{
+		{"workspace", "workspace", true},
+		{"workspace", "workspace.agent", false},
+		{"*", "anything.at.all", true},
+		{"adapter.*", "adapter", true},
+		{"adapter.*", "adapter.claude", true},
+		{"adapter.*", "adapter.claude.watch", true},
+		{"adapter.*", "adapters", false},
+		{"adapter.claude.*", "adapter.codex", false},
+	}
+	for _, tt := range tests {
+		if got := MatchTopic(tt.pattern, tt.topic); got != tt.want {
+			t.Errorf("MatchTopic(%q, %q) = %v, want %v", tt.pattern, tt.topic, got, tt.want)
+		}
+	}
+}
+
+func TestDispatcher_PatternsAndFilters(t *testing.T) {
+	d := New()
+	defer d.Close()
+
+	all := d.Subscribe("adapter.*")
+	completions := d.Subscribe("adapter.*", WithTypes(TypeAgentCompleted))
+	claudeOnly := d.Subscribe("adapter.claude", WithFilter(func(e Event) bool { return e.Data == "keep" }))
+
+	d.Publish("adapter.claude", NewEvent(TypeSessionUpdate, "adapter.claude", "drop"))
+	d.Publish("adapter.codex", NewEvent(TypeAgentCompleted, "adapter.codex", nil))
+	d.Publish("adapter.claude", NewEvent(TypeSessionUpdate, "adapter.claude", "keep"))
+	d.Publish("workspace", NewEvent(TypeAgentCompleted, "workspace", nil))
+
+	var got []Type
+	for {
+		e, ok := recv(all)
+		if !ok {
+			break
+		}
+		got = append(got, e.Type)
+	}
+	if len(got) != 3 {
+		t.Errorf("pattern subscriber got %v, want the 3 adapter events", got)
+	}
+
+	if e, ok := recv(completions); !ok || e.Topic != "adapter.codex" {
+		t.Errorf("type-filtered subscriber got %+v, want the codex completion", e)
+	}
+	if e, ok := recv(completions); ok {
+		t.Errorf("type-filtered subscriber got extra event %+v", e)
+	}
+
+	if e, ok := recv(claudeOnly); !ok || e.Data != "keep" {
+		t.Errorf("predicate subscriber got %+v, want the kept event", e)
+	}
+	if e, ok := recv(claudeOnly); ok {
+		t.Errorf("predicate subscriber got extra event %+v", e)
+	}
+}
+
+func TestDispatcher_Unsubscribe(t *testing.T) {
+	d := New()
+	defer d.Close()
+
+	exact := d.Subscribe("test")
+	pattern := d.Subscribe("*")
+	kept := d.Subscribe("test")
+	d.Unsubscribe(exact)
+	d.Unsubscribe(pattern)
+
+	for _, ch := range []<-chan Event{exact, pattern} {
+		if _, ok := <-ch; ok {
+			t.Error("unsubscribed channel should be closed")
+		}
+	}
+	d.Publish("test", NewEvent(TypeRefreshNeeded, "test", nil))
+	if _, ok := recv(kept); !ok {
+		t.Error("remaining subscriber should still receive events")
+	}
+}
+
+func TestDispatcher_Replay(t *testing.T) {
+	d := New()
+	defer d.Close()
+
+	d.Publish("workspace", NewEvent(TypeAgentCompleted, "workspace", 1))
+	d.Publish("git", NewEvent(TypeGitChanged, "git", 2))
+	d.Publish("workspace", NewEvent(TypeRefreshNeeded, "workspace", 3))
+
+	late := d.Subscribe("*", WithReplay())
+	d.Publish("workspace", NewEvent(TypeAgentCompleted, "workspace", 4))
+	for want := 1; want <= 4; want++ {
+		if e, ok := recv(late); !ok || e.Data != want {
+			t.Fatalf("event %d = %+v, want data %d in publish order", want, e, want)
+		}
+	}
+
+	// Filters apply to replayed events; without WithReplay nothing is replayed
+	completions := d.Subscribe("workspace", WithReplay(), WithTypes(TypeAgentCompleted))
+	for _, want := range []int{1, 4} {
+		if e, ok := recv(completions); !ok || e.Data != want {
+			t.Errorf("filtered replay = %+v, want data %d", e, want)
+		}
+	}
+	if e, ok := recv(d.Subscribe("workspace")); ok {
+		t.Errorf("subscriber without replay got %+v", e)
+	}
+}
+
+func TestDispatcher_ReplayBounds(t *testing.T) {
+	d := New()
+	defer d.Close()
+	d.SetReplaySize(3)
+
+	for i := range 5 {
+		d.Publish("t", NewEvent(TypeRefreshNeeded, "t", i))
+	}
+
+	// Only the last 3 are retained, and only the newest 2 fit the buffer
+	ch := d.Subscribe("t", WithReplay(), WithBuffer(2))
+	for _, want := range []int{3, 4} {
+		if e, ok := recv(ch); !ok || e.Data != want {
+			t.Errorf("replayed %+v, want data %d", e, want)
+		}
+	}
+
+	d.SetReplaySize(0)
+	if e, ok := recv(d.Subscribe("t", WithReplay())); ok {
+		t.Errorf("replay with retention disabled got %+v", e)
+	}
+}
+
+func TestDispatcher_SlowSubscriber(t *testing.T) {
+	d := New()
+	defer d.Close()
+
+	stuck := d.Subscribe("t", WithName("stuck"), WithBuffer(4))
+	fast := d.Subscribe("t", WithName("fast"))
+	for i := range 4 + slowStreak {
+		d.Publish("t", NewEvent(TypeRefreshNeeded, "t", i))
+		<-fast
+	}
+
+	st := d.Stats()
+	if st.Publishes != int64(4+slowStreak) || st.MaxLatency <= 0 {
+		t.Errorf("publishes = %d, max latency %v", st.Publishes, st.MaxLatency)
+	}
+	slow := st.SlowSubscribers()
+	if len(slow) != 1 || slow[0].Name != "stuck" {
+		t.Fatalf("slow subscribers = %+v, want only stuck", slow)
+	}
+	if s := slow[0]; s.Depth != 4 || s.MaxDepth != 4 || s.Delivered != 4 || s.Dropped != int64(slowStreak) {
+		t.Errorf("stuck stats = %+v", s)
+	}
+
+	// Draining the queue clears the slow mark on the next delivery
+	for range 4 {
+		<-stuck
+	}
+	d.Publish("t", NewEvent(TypeRefreshNeeded, "t", 0))
+	if slow := d.Stats().SlowSubscribers(); len(slow) != 0 {
+		t.Errorf("after draining, slow subscribers = %+v", slow)
+	}
+}
@@ -1,4 +1,13 @@
 // Package event defines typed event structures for system-wide event bus
 // communication including file changes, git updates, and UI refresh
 // notifications.
+//
+// Subscribers choose a topic or a topic pattern such as "adapter.*" and
+// may narrow it further with filters, so each receives only the events it
+// handles. The dispatcher keeps the last events published on each topic,
+// so a subscriber that starts late can ask for them with WithReplay.
+//
+// Delivery never blocks the publisher. Stats reports publish latency and
+// each subscriber's queue depth, and a subscriber whose queue stays backed
+// up is logged and marked slow until it catches up.
 package event
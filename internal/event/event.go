@@ -8,6 +8,7 @@ type Event struct {
 	Topic     string
 	Timestamp time.Time
 	Data      any
+	Origin    string // instance a bridged event came from; empty for local events
 }
 
 // Type identifies the kind of event.
@@ -23,14 +24,45 @@ const (
 	TypeTDUpdate      Type = "td_update"
 	TypeSessionUpdate Type = "session_update"
 
+	// Agent events
+	TypeAgentCompleted Type = "agent_completed"
+	TypeSessionError   Type = "session_error"
+
+	// Workspace events
+	TypeMergeCompleted Type = "merge_completed"
+
 	// UI events
 	TypeFocusChanged  Type = "focus_changed"
 	TypeRefreshNeeded Type = "refresh_needed"
 
 	// Error events
 	TypeError Type = "error"
+
+	// Scheduler events
+	TypeTimer Type = "timer"
 )
 
+// TopicWorkspace carries workspace plugin events such as agent completions.
+const TopicWorkspace = "workspace"
+
+// AgentCompletion is the Data of TypeAgentCompleted and TypeSessionError
+// events.
+type AgentCompletion struct {
+	Workspace string // worktree name
+	Agent     string // agent type, e.g. "claude"
+	Status    string // worktree status after the transition: "waiting", "done", or "error"
+	Marker    string // completion marker that triggered the event, if any
+}
+
+// MergeCompletion is the Data of a TypeMergeCompleted event.
+type MergeCompletion struct {
+	Workspace string // worktree name
+	Branch    string // branch that was merged
+	Target    string // branch merged into
+	Direct    bool   // merged locally rather than through a pull request
+	PRURL     string // pull request, if merged through one
+}
+
 // NewEvent creates a new event with the current timestamp.
 func NewEvent(t Type, topic string, data any) Event {
 	return Event{
@@ -0,0 +1,154 @@
+package event
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// slowStreak is how many consecutive deliveries must find a subscriber's
+// queue at least three-quarters full before it is reported as slow.
+const slowStreak = 8
+
+// WithName labels the subscription in logs and Stats. The default is its
+// topic or pattern.
+func WithName(name string) SubscribeOption {
+	return func(s *subscriber) {
+		s.name = name
+	}
+}
+
+// subscriberMetrics counts a subscriber's deliveries. Updated under the
+// dispatcher's read lock, so every field is atomic.
+type subscriberMetrics struct {
+	delivered atomic.Int64
+	dropped   atomic.Int64
+	maxDepth  atomic.Int64
+	streak    atomic.Int64 // consecutive deliveries finding the queue backed up
+	slow      atomic.Bool
+}
+
+// busMetrics times Publish and PublishAll calls.
+type busMetrics struct {
+	publishes atomic.Int64
+	totalNs   atomic.Int64
+	maxNs     atomic.Int64
+}
+
+// observe records one publish that took d.
+func (m *busMetrics) observe(d time.Duration) {
+	m.publishes.Add(1)
+	m.totalNs.Add(int64(d))
+	storeMax(&m.maxNs, int64(d))
+}
+
+// storeMax raises v to n if n is larger.
+func storeMax(v *atomic.Int64, n int64) {
+	for {
+		cur := v.Load()
+		if n <= cur || v.CompareAndSwap(cur, n) {
+			return
+		}
+	}
+}
+
+// observe records a delivery attempt to s that found depth events queued,
+// or that was dropped, and logs when s becomes slow or catches up. A
+// subscriber is slow when its queue stays at least three-quarters full
+// for slowStreak deliveries in a row, which means it consistently reads
+// slower than events arrive.
+func (d *Dispatcher) observe(s *subscriber, depth int, dropped bool) {
+	m := &s.metrics
+	if dropped {
+		m.dropped.Add(1)
+	} else {
+		m.delivered.Add(1)
+	}
+	storeMax(&m.maxDepth, int64(depth))
+
+	if dropped || depth*4 >= s.buffer*3 {
+		if m.streak.Add(1) >= slowStreak && m.slow.CompareAndSwap(false, true) {
+			d.logger.Warn("slow event subscriber", "subscriber", s.name,
+				"depth", depth, "buffer", s.buffer, "dropped", m.dropped.Load())
+		}
+		return
+	}
+	m.streak.Store(0)
+	if depth*4 <= s.buffer && m.slow.CompareAndSwap(true, false) {
+		d.logger.Info("event subscriber caught up", "subscriber", s.name)
+	}
+}
+
+// SubscriberStats is a snapshot of one subscription's delivery counters.
+type SubscriberStats struct {
+	Name      string
+	Pattern   string
+	Depth     int // events queued now
+	MaxDepth  int // most events ever found queued
+	Buffer    int
+	Delivered int64
+	Dropped   int64
+	Slow      bool // queue has stayed backed up; see Dispatcher.observe
+}
+
+// Stats is a snapshot of the dispatcher's publish latency and its
+// subscribers' queues.
+type Stats struct {
+	Publishes   int64
+	AvgLatency  time.Duration // mean time spent in Publish or PublishAll
+	MaxLatency  time.Duration
+	Subscribers []SubscriberStats // slow first, then by name
+}
+
+// SlowSubscribers returns the subscribers currently marked slow.
+func (s Stats) SlowSubscribers() []SubscriberStats {
+	var slow []SubscriberStats
+	for _, sub := range s.Subscribers {
+		if sub.Slow {
+			slow = append(slow, sub)
+		}
+	}
+	return slow
+}
+
+// Stats returns a snapshot of the dispatcher's metrics.
+func (d *Dispatcher) Stats() Stats {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	st := Stats{
+		Publishes:  d.metrics.publishes.Load(),
+		MaxLatency: time.Duration(d.metrics.maxNs.Load()),
+	}
+	if st.Publishes > 0 {
+		st.AvgLatency = time.Duration(d.metrics.totalNs.Load() / st.Publishes)
+	}
+	add := func(s *subscriber) {
+		st.Subscribers = append(st.Subscribers, SubscriberStats{
+			Name:      s.name,
+			Pattern:   s.pattern,
+			Depth:     len(s.ch),
+			MaxDepth:  int(s.metrics.maxDepth.Load()),
+			Buffer:    s.buffer,
+			Delivered: s.metrics.delivered.Load(),
+			Dropped:   s.metrics.dropped.Load(),
+			Slow:      s.metrics.slow.Load(),
+		})
+	}
+	for _, subs := range d.subscribers {
+		for _, s := range subs {
+			add(s)
+		}
+	}
+	for _, s := range d.wildcards {
+		add(s)
+	}
+	sort.Slice(st.Subscribers, func(i, j int) bool {
+		a, b := st.Subscribers[i], st.Subscribers[j]
+		if a.Slow != b.Slow {
+			return a.Slow
+		}
+		return a.Name < b.Name
+	})
+	return st
+}
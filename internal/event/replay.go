@@ -0,0 +1,91 @@
+package event
+
+import (
+	"sort"
+	"sync"
+)
+
+// defaultReplaySize is how many recent events are kept per topic.
+const defaultReplaySize = 32
+
+// WithReplay delivers the retained recent events of matching topics that
+// pass the subscription's filters before any new ones, oldest first, so a
+// subscriber that starts late can catch up on state it missed. When more
+// are retained than fit in the buffer, the newest are kept.
+func WithReplay() SubscribeOption {
+	return func(s *subscriber) {
+		s.replay = true
+	}
+}
+
+// replayed is a retained event with its publish order across topics.
+type replayed struct {
+	seq   uint64
+	event Event
+}
+
+// history holds the last events published on each topic.
+type history struct {
+	mu     sync.Mutex
+	size   int
+	seq    uint64
+	topics map[string][]replayed
+}
+
+// record retains e as published on topic, dropping the topic's oldest
+// event beyond the size.
+func (h *history) record(topic string, e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.size <= 0 {
+		return
+	}
+	if h.topics == nil {
+		h.topics = make(map[string][]replayed)
+	}
+	h.seq++
+	events := append(h.topics[topic], replayed{seq: h.seq, event: e})
+	if len(events) > h.size {
+		events = events[len(events)-h.size:]
+	}
+	h.topics[topic] = events
+}
+
+// matching returns the retained events s wants, oldest first.
+func (h *history) matching(s *subscriber) []Event {
+	h.mu.Lock()
+	var found []replayed
+	for topic, events := range h.topics {
+		for _, r := range events {
+			if s.wants(topic, r.event) {
+				found = append(found, r)
+			}
+		}
+	}
+	h.mu.Unlock()
+
+	sort.Slice(found, func(i, j int) bool { return found[i].seq < found[j].seq })
+	out := make([]Event, len(found))
+	for i, r := range found {
+		out[i] = r.event
+	}
+	return out
+}
+
+// SetReplaySize sets how many recent events are retained per topic for
+// subscribers using WithReplay. Zero disables retention. Shrinking the
+// size trims what is already retained.
+func (d *Dispatcher) SetReplaySize(n int) {
+	h := &d.history
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.size = max(n, 0)
+	for topic, events := range h.topics {
+		if len(events) > h.size {
+			h.topics[topic] = events[len(events)-h.size:]
+		}
+		if len(h.topics[topic]) == 0 {
+			delete(h.topics, topic)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package event
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TopicTimer is the parent topic of scheduler timers; each timer publishes
+// on TimerTopic(name), so "timer.*" receives them all.
+const TopicTimer = "timer"
+
+// TimerTopic returns the topic a named timer publishes on.
+func TimerTopic(name string) string {
+	return TopicTimer + "." + name
+}
+
+// Tick is the Data of a TypeTimer event.
+type Tick struct {
+	Name      string
+	Scheduled time.Time // when the timer was due
+	Count     int       // ticks published by this timer so far, including this one
+}
+
+// Schedule decides when a timer fires.
+type Schedule interface {
+	// Next returns the first firing time after t, or the zero time if the
+	// schedule never fires again.
+	Next(t time.Time) time.Time
+}
+
+// every fires at a fixed interval.
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// Every returns a schedule firing every d, measured from when the timer is
+// added and then from each firing. d must be positive.
+func Every(d time.Duration) Schedule {
+	return every(d)
+}
+
+// ParseSchedule parses a schedule spec: "@every <duration>" such as
+// "@every 15m", "@hourly", "@daily", or a five-field cron expression
+// (minute, hour, day of month, month, day of week) in local time, such as
+// "0 9 * * 1-5". Cron fields take "*", numbers, ranges "a-b", lists
+// "a,b", and steps "*/n" or "a-b/n"; day of week runs from 0 (Sunday) to
+// 6, with 7 also meaning Sunday.
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	switch spec {
+	case "@hourly":
+		spec = "0 * * * *"
+	case "@daily", "@midnight":
+		spec = "0 0 * * *"
+	case "@weekly":
+		spec = "0 0 * * 0"
+	}
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("schedule %q: need a positive duration", spec)
+		}
+		return Every(d), nil
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q: want 5 cron fields, got %d", spec, len(fields))
+	}
+	var c cron
+	bounds := []struct {
+		set      *uint64
+		min, max int
+	}{
+		{&c.minute, 0, 59},
+		{&c.hour, 0, 23},
+		{&c.dom, 1, 31},
+		{&c.month, 1, 12},
+		{&c.dow, 0, 7},
+	}
+	for i, b := range bounds {
+		set, err := parseCronField(fields[i], b.min, b.max)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", spec, err)
+		}
+		*b.set = set
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1 // 7 is Sunday too
+	}
+	c.domAny = fields[2] == "*"
+	c.dowAny = fields[4] == "*"
+	return c, nil
+}
+
+// parseCronField returns the set of values a cron field allows as a bit
+// mask.
+func parseCronField(field string, lo, hi int) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(field, ",") {
+		rng, stepText, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("bad step in %q", item)
+			}
+			step = n
+		}
+
+		from, to := lo, hi
+		if rng != "*" {
+			a, b, isRange := strings.Cut(rng, "-")
+			var err error
+			if from, err = strconv.Atoi(a); err != nil {
+				return 0, fmt.Errorf("bad value in %q", item)
+			}
+			to = from
+			if isRange {
+				if to, err = strconv.Atoi(b); err != nil {
+					return 0, fmt.Errorf("bad value in %q", item)
+				}
+			} else if hasStep {
+				to = hi
+			}
+		}
+		if from < lo || to > hi || from > to {
+			return 0, fmt.Errorf("%q out of range %d-%d", item, lo, hi)
+		}
+		for v := from; v <= to; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// cron is a parsed five-field cron expression.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+// dayMatches applies cron's rule that when both day fields are restricted,
+// a day matching either one fires.
+func (c cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<t.Day()) != 0
+	dow := c.dow&(1<<int(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (c cron) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// Five years covers every valid expression, including Feb 29
+	for limit := t.AddDate(5, 0, 0); t.Before(limit); {
+		switch {
+		case c.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// TimerInfo describes a scheduled timer.
+type TimerInfo struct {
+	Name  string
+	Next  time.Time
+	Count int
+}
+
+// timer is a named schedule and its next firing.
+type timer struct {
+	name     string
+	schedule Schedule
+	next     time.Time
+	count    int
+}
+
+// Scheduler publishes TypeTimer events on the dispatcher when named timers
+// come due, so plugins subscribe to shared timers instead of running their
+// own tickers. A timer that falls behind, e.g. across a system sleep, fires
+// once and resumes from the current time. Safe for concurrent use.
+type Scheduler struct {
+	bus  *Dispatcher
+	wake chan struct{}
+	done chan struct{}
+
+	mu     sync.Mutex
+	timers map[string]*timer
+	closed bool
+}
+
+// NewScheduler returns a running scheduler that publishes on bus.
+func NewScheduler(bus *Dispatcher) *Scheduler {
+	s := &Scheduler{
+		bus:    bus,
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		timers: make(map[string]*timer),
+	}
+	go s.run()
+	return s
+}
+
+// Add schedules a timer publishing on TimerTopic(name), replacing any
+// timer with the same name.
+func (s *Scheduler) Add(name string, schedule Schedule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.timers[name] = &timer{name: name, schedule: schedule, next: schedule.Next(time.Now())}
+	s.notify()
+}
+
+// Remove stops the named timer.
+func (s *Scheduler) Remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.timers, name)
+	s.notify()
+}
+
+// Timers returns the scheduled timers, soonest first.
+func (s *Scheduler) Timers() []TimerInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]TimerInfo, 0, len(s.timers))
+	for _, t := range s.timers {
+		out = append(out, TimerInfo{Name: t.name, Next: t.next, Count: t.count})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Next.Before(out[j].Next) })
+	return out
+}
+
+// Close stops all timers.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.timers = nil
+	close(s.done)
+}
+
+// notify wakes the run loop to reconsider the next deadline. Must be
+// called with s.mu held.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run waits for the soonest timer and publishes every timer that is due.
+func (s *Scheduler) run() {
+	clock := time.NewTimer(time.Hour)
+	defer clock.Stop()
+	for {
+		s.mu.Lock()
+		var next time.Time
+		for _, t := range s.timers {
+			if !t.next.IsZero() && (next.IsZero() || t.next.Before(next)) {
+				next = t.next
+			}
+		}
+		wait := time.Hour
+		if !next.IsZero() {
+			wait = max(time.Until(next), 0)
+		}
+		s.mu.Unlock()
+		clock.Reset(wait)
+
+		select {
+		case <-s.done:
+			return
+		case <-s.wake:
+			continue
+		case <-clock.C:
+		}
+
+		s.mu.Lock()
+		now := time.Now()
+		var due []Event
+		for _, t := range s.timers {
+			if t.next.IsZero() || t.next.After(now) {
+				continue
+			}
+			t.count++
+			due = append(due, NewEvent(TypeTimer, TimerTopic(t.name), Tick{Name: t.name, Scheduled: t.next, Count: t.count}))
+			t.next = t.schedule.Next(now)
+		}
+		s.mu.Unlock()
+
+		for _, e := range due {
+			s.bus.Publish(e.Topic, e)
+		}
+	}
+}
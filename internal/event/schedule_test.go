@@ -0,0 +1,83 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule_Errors(t *testing.T) {
+	for _, spec := range []string{
+		"", "@every", "@every -1m", "@every soon",
+		"* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+		"*/0 * * * *", "5-1 * * * *", "a * * * *",
+	} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("ParseSchedule(%q): expected error", spec)
+		}
+	}
+}
+
+func TestParseSchedule_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2026, 3, 4, 10, 17, 30, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"@every 15m", from.Add(15 * time.Minute)},
+		{"@hourly", time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)},
+		{"30 18 * * 0", time.Date(2026, 3, 8, 18, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 29 2 *", time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either matches (the 10th, or Fridays)
+		{"0 0 10 * 5", time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.spec)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tt.spec, err)
+			continue
+		}
+		if got := s.Next(from); !got.Equal(tt.want) {
+			t.Errorf("%q: Next = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestScheduler_PublishesTicks(t *testing.T) {
+	d := New()
+	defer d.Close()
+	s := NewScheduler(d)
+	defer s.Close()
+
+	ticks := d.Subscribe("timer.*")
+	s.Add("refresh", Every(20*time.Millisecond))
+	for want := 1; want <= 2; want++ {
+		select {
+		case e := <-ticks:
+			tick, ok := e.Data.(Tick)
+			if e.Type != TypeTimer || e.Topic != TimerTopic("refresh") || !ok || tick.Name != "refresh" || tick.Count != want {
+				t.Fatalf("tick %d = %+v", want, e)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no tick %d", want)
+		}
+	}
+	if timers := s.Timers(); len(timers) != 1 || timers[0].Name != "refresh" || timers[0].Count < 2 {
+		t.Errorf("Timers = %+v", timers)
+	}
+
+	s.Remove("refresh")
+	for len(ticks) > 0 {
+		<-ticks // a tick may have been published before Remove
+	}
+	select {
+	case e := <-ticks:
+		t.Errorf("tick after Remove: %+v", e)
+	case <-time.After(60 * time.Millisecond):
+	}
+}
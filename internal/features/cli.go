@@ -0,0 +1,101 @@
+package features
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// commandUsage describes the features subcommand.
+const commandUsage = "usage: features list | enable <flag> | disable <flag> | set <variant> <value>"
+
+// RunCommand runs the features subcommand: list prints every flag with its
+// state and source; enable, disable, and set save the flag to the config
+// file. Init must have been called.
+func RunCommand(args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(commandUsage)
+	}
+	switch args[0] {
+	case "list":
+		if len(args) != 1 {
+			return errors.New(commandUsage)
+		}
+		return printList(w)
+	case "enable", "disable":
+		if len(args) != 2 {
+			return errors.New(commandUsage)
+		}
+		return setFromCommand(w, args[1], args[0] == "enable")
+	case "set":
+		if len(args) != 3 {
+			return errors.New(commandUsage)
+		}
+		return setValueFromCommand(w, args[1], args[2])
+	}
+	return fmt.Errorf("unknown features command %q\n%s", args[0], commandUsage)
+}
+
+// printList writes a table of all flags.
+func printList(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FLAG\tSTATE\tSOURCE\tDESCRIPTION")
+	for _, f := range ListAll() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", f.Name, stateString(IsEnabled(f.Name)), SourceOf(f.Name), f.Description)
+	}
+	for _, v := range ListVariants() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", v.Name, Value(v.Name), SourceOf(v.Name), v.Description)
+	}
+	return tw.Flush()
+}
+
+// setFromCommand saves a flag and notes when a higher-priority source
+// still overrides the saved value.
+func setFromCommand(w io.Writer, name string, enabled bool) error {
+	if !IsKnownFeature(name) {
+		return fmt.Errorf("unknown feature %q (see 'features list')", name)
+	}
+	if err := SetEnabled(name, enabled); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+	fmt.Fprintf(w, "%s %s in config\n", name, state)
+
+	if IsEnabled(name) != enabled {
+		printOverrideNote(w, name)
+	}
+	return nil
+}
+
+// setValueFromCommand saves a variant value.
+func setValueFromCommand(w io.Writer, name, value string) error {
+	if !IsKnownVariant(name) {
+		return fmt.Errorf("unknown variant %q (see 'features list')", name)
+	}
+	if err := ValidateValue(name, value); err != nil {
+		return err
+	}
+	if err := SetValue(name, value); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	fmt.Fprintf(w, "%s set to %s in config\n", name, value)
+	if Value(name) != value {
+		printOverrideNote(w, name)
+	}
+	return nil
+}
+
+// printOverrideNote tells the user which source still wins over the config.
+func printOverrideNote(w io.Writer, name string) {
+	src := string(SourceOf(name))
+	if src == string(SourceEnv) {
+		src = EnvVar(name)
+	}
+	fmt.Fprintf(w, "note: %s is still overridden by %s\n", name, src)
+}
@@ -1,4 +1,8 @@
 // Package features provides a feature flag system for gating experimental
-// functionality, with priority resolution from CLI overrides, config file
-// values, and compiled-in defaults.
+// functionality, with priority resolution from runtime toggles, CLI
+// overrides, environment variables, an optional remote source, per-project
+// flag files, config file values, and compiled-in defaults. Long-lived
+// subsystems can Watch a flag to react when its value changes at runtime.
+// Variant flags select a string or integer value, such as watcher.mode,
+// with the same resolution.
 package features
@@ -2,9 +2,13 @@ package features
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"strings"
 	"sync"
 
 	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/version"
 )
 
 // ErrNotInitialized is returned when the feature manager is not initialized.
@@ -15,9 +19,21 @@ type Feature struct {
 	Name        string
 	Default     bool
 	Description string
+	Owner       string // who to ask before removing or changing the flag
+	ExpiresIn   string // release in which the flag should be gone, e.g. "v0.12.0"
+	// Rollout, when above zero, replaces Default: the flag starts enabled on
+	// this percentage of machines, picked by a stable hash of the machine ID.
+	Rollout int
 }
 
-// Known feature flags - add new features here.
+// Expired reports whether the flag has outlived its ExpiresIn release.
+// Flags without an expiry never expire.
+func (f Feature) Expired(currentVersion string) bool {
+	return f.ExpiresIn != "" && version.AtLeast(currentVersion, f.ExpiresIn)
+}
+
+// Known feature flags - add new features here. Temporary flags should set
+// ExpiresIn so they are reported once they outlive their release.
 var (
 	// TmuxInteractiveInput enables write support for tmux panes.
 	TmuxInteractiveInput = Feature{
@@ -39,6 +55,21 @@ var (
 		Default:     false,
 		Description: "Enable the notes plugin for capturing quick notes",
 	}
+
+	// ConversationCoview lets instances on the same project share the
+	// selected conversation position with each other.
+	ConversationCoview = Feature{
+		Name:        "conversation_coview",
+		Default:     false,
+		Description: "Share the selected conversation and message with other instances on the same project",
+	}
+
+	// AnimatedBorders slowly cycles the hue of the active panel's border.
+	AnimatedBorders = Feature{
+		Name:        "animated_borders",
+		Default:     false,
+		Description: "Slowly shift the hue of the active panel's gradient border",
+	}
 )
 
 // allFeatures is the registry of all known features.
@@ -46,42 +77,139 @@ var allFeatures = []Feature{
 	TmuxInteractiveInput,
 	TmuxInlineEdit,
 	NotesPlugin,
+	ConversationCoview,
+	AnimatedBorders,
 }
 
-// defaultValues provides O(1) lookup for feature defaults.
-var defaultValues = buildDefaultMap()
+// knownFeatures provides O(1) lookup for feature defaults.
+var knownFeatures = buildFeatureMap()
 
-func buildDefaultMap() map[string]bool {
-	m := make(map[string]bool, len(allFeatures))
+func buildFeatureMap() map[string]Feature {
+	m := make(map[string]Feature, len(allFeatures))
 	for _, f := range allFeatures {
-		m[f.Name] = f.Default
+		m[f.Name] = f
 	}
 	return m
 }
 
 // IsKnownFeature returns true if the feature name is registered.
 func IsKnownFeature(name string) bool {
-	_, ok := defaultValues[name]
+	_, ok := knownFeatures[name]
 	return ok
 }
 
+// EnvPrefix prefixes environment variables that override feature flags,
+// e.g. FORGE_FEATURE_NOTES_PLUGIN=on.
+const EnvPrefix = "FORGE_FEATURE_"
+
+// Source identifies where a flag's current value comes from.
+type Source string
+
+// Sources in priority order, highest first.
+const (
+	SourceRuntime Source = "runtime" // toggled while running
+	SourceCLI     Source = "cli"
+	SourceEnv     Source = "env"
+	SourceRemote  Source = "remote"  // polled from features.remoteUrl
+	SourceProject Source = "project" // .forge/features.yaml in the project root
+	SourceConfig  Source = "config"
+	SourceRollout Source = "rollout" // percentage rollout bucket
+	SourceDefault Source = "default"
+)
+
 // Manager handles feature flag state.
 type Manager struct {
 	mu        sync.RWMutex
 	cfg       *config.Config
-	overrides map[string]bool // CLI overrides take precedence
+	runtime   map[string]bool // live toggles take precedence over everything
+	overrides map[string]bool // CLI overrides
+	env       map[string]bool // environment overrides, read at Init
+	remote    map[string]bool // remote source values, see StartRemote
+	project   map[string]bool // per-project flags, see LoadProject
+
+	// Variant values, layered the same way as the boolean maps above.
+	runtimeValues  map[string]string
+	overrideValues map[string]string
+	envValues      map[string]string
+	remoteValues   map[string]string
+	projectValues  map[string]string
 }
 
 // globalManager is the singleton instance.
 var globalManager *Manager
 
-// Init initializes the feature flag manager with the given config.
-// Should be called once at startup after config is loaded.
+// Init initializes the feature flag manager with the given config and
+// reads environment overrides. Should be called once at startup after
+// config is loaded.
 func Init(cfg *config.Config) {
+	defer notifyChanges(List())
 	globalManager = &Manager{
 		cfg:       cfg,
+		runtime:   make(map[string]bool),
 		overrides: make(map[string]bool),
+		env:       loadEnvOverrides(),
+		remote:    make(map[string]bool),
+		project:   make(map[string]bool),
+
+		runtimeValues:  make(map[string]string),
+		overrideValues: make(map[string]string),
+		envValues:      loadEnvValues(),
+		remoteValues:   make(map[string]string),
+		projectValues:  make(map[string]string),
+	}
+}
+
+// EnvVar returns the environment variable that overrides a feature flag or
+// variant. Dots become underscores: watcher.mode is FORGE_FEATURE_WATCHER_MODE.
+func EnvVar(name string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(name, ".", "_"))
+}
+
+// loadEnvOverrides reads FORGE_FEATURE_<NAME> variables for known features.
+// Values that aren't recognized by parseEnvValue are ignored.
+func loadEnvOverrides() map[string]bool {
+	env := make(map[string]bool)
+	for _, f := range allFeatures {
+		if v, ok := os.LookupEnv(EnvVar(f.Name)); ok {
+			if enabled, ok := parseEnvValue(v); ok {
+				env[f.Name] = enabled
+			}
+		}
+	}
+	return env
+}
+
+// parseEnvValue parses on/off style values.
+func parseEnvValue(v string) (enabled, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "on", "true", "1", "yes", "enabled":
+		return true, true
+	case "off", "false", "0", "no", "disabled":
+		return false, true
 	}
+	return false, false
+}
+
+// InvalidEnv returns an error for each feature environment variable whose
+// value is not on/off, or not allowed for its variant, so callers can warn
+// about them.
+func InvalidEnv() []error {
+	var invalid []error
+	for _, f := range allFeatures {
+		if v, ok := os.LookupEnv(EnvVar(f.Name)); ok {
+			if _, ok := parseEnvValue(v); !ok {
+				invalid = append(invalid, fmt.Errorf("%s: %q is not on or off", EnvVar(f.Name), v))
+			}
+		}
+	}
+	for _, v := range allVariants {
+		if value, ok := os.LookupEnv(EnvVar(v.Name)); ok {
+			if err := v.Validate(strings.TrimSpace(value)); err != nil {
+				invalid = append(invalid, fmt.Errorf("%s: %w", EnvVar(v.Name), err))
+			}
+		}
+	}
+	return invalid
 }
 
 // SetOverride sets a CLI override for a feature flag.
@@ -90,42 +218,68 @@ func SetOverride(name string, enabled bool) {
 	if globalManager == nil {
 		return
 	}
+	defer notifyChanges(List())
 	globalManager.mu.Lock()
 	defer globalManager.mu.Unlock()
 	globalManager.overrides[name] = enabled
 }
 
-// IsEnabled checks if a feature is enabled.
-// Priority: CLI override > config > default.
-func IsEnabled(name string) bool {
+// SetRuntime toggles a feature for the rest of this run without saving it.
+// Runtime values take precedence over every other source.
+func SetRuntime(name string, enabled bool) {
 	if globalManager == nil {
-		// Fall back to default if not initialized
-		return getDefault(name)
+		return
 	}
+	defer notifyChanges(List())
+	globalManager.mu.Lock()
+	defer globalManager.mu.Unlock()
+	globalManager.runtime[name] = enabled
+}
 
+// SourceOf returns where a feature's or variant's current value comes from.
+func SourceOf(name string) Source {
+	if globalManager == nil {
+		return SourceDefault
+	}
 	globalManager.mu.RLock()
 	defer globalManager.mu.RUnlock()
-
-	// Check CLI overrides first
-	if enabled, ok := globalManager.overrides[name]; ok {
-		return enabled
+	if IsKnownVariant(name) {
+		_, src := resolveValueLocked(name)
+		return src
 	}
+	_, src := resolveLocked(name)
+	return src
+}
 
-	// Check config
-	if globalManager.cfg != nil && globalManager.cfg.Features.Flags != nil {
-		if enabled, ok := globalManager.cfg.Features.Flags[name]; ok {
-			return enabled
+// IsEnabled checks if a feature is enabled. Each call is recorded in the
+// Usage report.
+// Priority: runtime toggle > CLI override > environment > remote > project > config > default.
+func IsEnabled(name string) bool {
+	if globalManager == nil {
+		// Fall back to default if not initialized
+		enabled, src := getDefault(name), SourceDefault
+		if knownFeatures[name].Rollout > 0 {
+			src = SourceRollout
 		}
+		recordRead(name, stateString(enabled), src)
+		return enabled
 	}
 
-	// Fall back to default
-	return getDefault(name)
+	globalManager.mu.RLock()
+	enabled, src := resolveLocked(name)
+	globalManager.mu.RUnlock()
+	recordRead(name, stateString(enabled), src)
+	return enabled
 }
 
-// getDefault returns the default value for a feature.
+// getDefault returns the default value for a feature, or its rollout
+// bucket for percentage rollouts.
 func getDefault(name string) bool {
-	if val, ok := defaultValues[name]; ok {
-		return val
+	if f, ok := knownFeatures[name]; ok {
+		if f.Rollout > 0 {
+			return f.inRollout()
+		}
+		return f.Default
 	}
 	return false // Unknown features default to disabled
 }
@@ -149,17 +303,48 @@ func List() map[string]bool {
 
 // isEnabledLocked checks feature state without acquiring locks (caller must hold lock).
 func isEnabledLocked(name string) bool {
-	// Check CLI overrides first
+	enabled, _ := resolveLocked(name)
+	return enabled
+}
+
+// resolveLocked returns a feature's value and its source (caller must hold lock).
+func resolveLocked(name string) (bool, Source) {
+	if enabled, ok := globalManager.runtime[name]; ok {
+		return enabled, SourceRuntime
+	}
 	if enabled, ok := globalManager.overrides[name]; ok {
-		return enabled
+		return enabled, SourceCLI
+	}
+	if enabled, ok := globalManager.env[name]; ok {
+		return enabled, SourceEnv
+	}
+	if enabled, ok := globalManager.remote[name]; ok {
+		return enabled, SourceRemote
+	}
+	if enabled, ok := globalManager.project[name]; ok {
+		return enabled, SourceProject
 	}
-	// Check config
 	if globalManager.cfg != nil && globalManager.cfg.Features.Flags != nil {
 		if enabled, ok := globalManager.cfg.Features.Flags[name]; ok {
-			return enabled
+			return enabled, SourceConfig
+		}
+	}
+	if f := knownFeatures[name]; f.Rollout > 0 {
+		return f.inRollout(), SourceRollout
+	}
+	return getDefault(name), SourceDefault
+}
+
+// Expired returns the flags that have outlived their ExpiresIn release as
+// of currentVersion. Each should be removed or given a new expiry.
+func Expired(currentVersion string) []Feature {
+	var expired []Feature
+	for _, f := range allFeatures {
+		if f.Expired(currentVersion) {
+			expired = append(expired, f)
 		}
 	}
-	return getDefault(name)
+	return expired
 }
 
 // ListAll returns all known features with metadata.
@@ -177,6 +362,7 @@ func SetEnabled(name string, enabled bool) error {
 		return ErrNotInitialized
 	}
 
+	defer notifyChanges(List())
 	globalManager.mu.Lock()
 	defer globalManager.mu.Unlock()
 
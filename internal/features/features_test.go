@@ -1,7 +1,14 @@
 package features
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 
@@ -197,6 +204,429 @@ func TestConcurrentAccess(t *testing.T) {
 	wg.Wait()
 }
 
+func TestIsEnabled_EnvOverride(t *testing.T) {
+	t.Setenv("FORGE_FEATURE_NOTES_PLUGIN", "on")
+	t.Setenv("FORGE_FEATURE_TMUX_INLINE_EDIT", "off")
+
+	cfg := config.Default()
+	cfg.Features.Flags["tmux_inline_edit"] = true
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	if !IsEnabled(NotesPlugin.Name) {
+		t.Error("env override should enable notes_plugin")
+	}
+	if IsEnabled(TmuxInlineEdit.Name) {
+		t.Error("env override should take precedence over config")
+	}
+
+	// CLI overrides still win over the environment
+	SetOverride(NotesPlugin.Name, false)
+	if IsEnabled(NotesPlugin.Name) {
+		t.Error("CLI override should take precedence over env")
+	}
+}
+
+func TestInvalidEnv(t *testing.T) {
+	t.Setenv("FORGE_FEATURE_NOTES_PLUGIN", "maybe")
+
+	Init(config.Default())
+	defer func() { globalManager = nil }()
+
+	if IsEnabled(NotesPlugin.Name) != NotesPlugin.Default {
+		t.Error("invalid env value should be ignored")
+	}
+	invalid := InvalidEnv()
+	if len(invalid) != 1 || invalid[0].Error() != `FORGE_FEATURE_NOTES_PLUGIN: "maybe" is not on or off` {
+		t.Errorf("InvalidEnv() = %v, want the notes plugin variable", invalid)
+	}
+}
+
+func TestSourceOf(t *testing.T) {
+	t.Setenv("FORGE_FEATURE_TMUX_INLINE_EDIT", "off")
+
+	cfg := config.Default()
+	cfg.Features.Flags["tmux_interactive_input"] = false
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	tests := []struct {
+		name string
+		want Source
+	}{
+		{NotesPlugin.Name, SourceDefault},
+		{TmuxInteractiveInput.Name, SourceConfig},
+		{TmuxInlineEdit.Name, SourceEnv},
+	}
+	for _, tt := range tests {
+		if got := SourceOf(tt.name); got != tt.want {
+			t.Errorf("SourceOf(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	SetOverride(NotesPlugin.Name, true)
+	if got := SourceOf(NotesPlugin.Name); got != SourceCLI {
+		t.Errorf("SourceOf after SetOverride = %q, want %q", got, SourceCLI)
+	}
+	SetRuntime(NotesPlugin.Name, false)
+	if IsEnabled(NotesPlugin.Name) || SourceOf(NotesPlugin.Name) != SourceRuntime {
+		t.Error("runtime toggle should take precedence over CLI override")
+	}
+}
+
+func TestLoadProject(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, ".forge"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := "flags:\n  notes_plugin: true\n  tmux_inline_edit: false\n  no_such_flag: true\n"
+	if err := os.WriteFile(ProjectPath(root), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default()
+	cfg.Features.Flags["notes_plugin"] = false
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	err := LoadProject(root)
+	if err == nil || !strings.Contains(err.Error(), "no_such_flag") {
+		t.Errorf("LoadProject error = %v, want unknown feature no_such_flag", err)
+	}
+	if !IsEnabled(NotesPlugin.Name) || SourceOf(NotesPlugin.Name) != SourceProject {
+		t.Error("project flag should override config")
+	}
+	if IsEnabled(TmuxInlineEdit.Name) {
+		t.Error("project flag should disable tmux_inline_edit")
+	}
+
+	// Environment still wins over the project file
+	t.Setenv("FORGE_FEATURE_NOTES_PLUGIN", "off")
+	Init(cfg)
+	if err := LoadProject(root); err == nil {
+		t.Error("expected unknown feature error on reload")
+	}
+	if IsEnabled(NotesPlugin.Name) {
+		t.Error("env should take precedence over project flags")
+	}
+
+	// A project without the file clears the layer
+	if err := LoadProject(t.TempDir()); err != nil {
+		t.Errorf("LoadProject without file: %v", err)
+	}
+	if SourceOf(TmuxInlineEdit.Name) != SourceDefault {
+		t.Error("project flags should be cleared")
+	}
+}
+
+func TestVariantValue(t *testing.T) {
+	globalManager = nil
+	if got := Value(WatcherMode.Name); got != "tiered" {
+		t.Errorf("uninitialized Value = %q, want default %q", got, "tiered")
+	}
+
+	cfg := config.Default()
+	cfg.Features.Variants = map[string]string{WatcherMode.Name: "poll"}
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	if got := Value(WatcherMode.Name); got != "poll" || SourceOf(WatcherMode.Name) != SourceConfig {
+		t.Errorf("Value = %q from %s, want poll from config", got, SourceOf(WatcherMode.Name))
+	}
+
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, ".forge"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := "variants:\n  watcher.mode: fsnotify\n"
+	if err := os.WriteFile(ProjectPath(root), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadProject(root); err != nil {
+		t.Fatalf("LoadProject: %v", err)
+	}
+	if got := Value(WatcherMode.Name); got != "fsnotify" {
+		t.Errorf("project Value = %q, want fsnotify", got)
+	}
+
+	if err := SetValueOverride(WatcherMode.Name, "bogus"); err == nil {
+		t.Error("SetValueOverride should reject values outside the variant")
+	}
+	if err := SetValueOverride(WatcherMode.Name, "tiered"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Value(WatcherMode.Name); got != "tiered" || SourceOf(WatcherMode.Name) != SourceCLI {
+		t.Errorf("CLI override should win over project, got %q", got)
+	}
+
+	// Environment is read at Init; runtime still wins over it
+	t.Setenv("FORGE_FEATURE_WATCHER_MODE", "poll")
+	Init(cfg)
+	if got := Value(WatcherMode.Name); got != "poll" || SourceOf(WatcherMode.Name) != SourceEnv {
+		t.Errorf("env Value = %q, want poll", got)
+	}
+	if err := SetRuntimeValue(WatcherMode.Name, "fsnotify"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Value(WatcherMode.Name); got != "fsnotify" {
+		t.Errorf("runtime Value = %q, want fsnotify", got)
+	}
+
+	t.Setenv("FORGE_FEATURE_WATCHER_MODE", "sometimes")
+	if invalid := InvalidEnv(); len(invalid) != 1 || !strings.Contains(invalid[0].Error(), `FORGE_FEATURE_WATCHER_MODE: watcher.mode: "sometimes" is not one of`) {
+		t.Errorf("InvalidEnv() = %v, want the watcher mode variable", invalid)
+	}
+}
+
+func TestVariantValidate(t *testing.T) {
+	v := Variant{Name: "cache.size", Kind: KindInt, Default: "64"}
+	if err := v.Validate("128"); err != nil {
+		t.Errorf("Validate(128) = %v", err)
+	}
+	if err := v.Validate("big"); err == nil {
+		t.Error("int variant should reject non-integers")
+	}
+	if err := ValidateValue("no.such.variant", "x"); err == nil {
+		t.Error("ValidateValue should reject unknown variants")
+	}
+}
+
+func TestUsage(t *testing.T) {
+	usageMu.Lock()
+	usage = make(map[string]*Read)
+	usageMu.Unlock()
+
+	cfg := config.Default()
+	cfg.Features.Flags[NotesPlugin.Name] = true
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	IsEnabled(NotesPlugin.Name)
+	IsEnabled(NotesPlugin.Name)
+	Value(WatcherMode.Name)
+	List() // listing isn't a read
+
+	reads := Usage()
+	if len(reads) != 2 {
+		t.Fatalf("Usage() = %+v, want 2 reads", reads)
+	}
+	want := Read{Name: NotesPlugin.Name, Value: "on", Source: SourceConfig, Count: 2}
+	if reads[0] != want {
+		t.Errorf("Usage()[0] = %+v, want %+v", reads[0], want)
+	}
+	if reads[1].Name != WatcherMode.Name || reads[1].Value != "tiered" || reads[1].Source != SourceDefault {
+		t.Errorf("Usage()[1] = %+v, want watcher.mode default", reads[1])
+	}
+
+	var out bytes.Buffer
+	if err := WriteUsage(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "not read: tmux_interactive_input") {
+		t.Errorf("WriteUsage should list unread flags:\n%s", out.String())
+	}
+}
+
+func TestRemoteSource(t *testing.T) {
+	var requests, notModified int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"flags": {"notes_plugin": false, "no_such_flag": true}, "variants": {"watcher.mode": "poll"}}`)
+	}))
+	defer srv.Close()
+
+	cfg := config.Default()
+	cfg.Features.Flags[NotesPlugin.Name] = true
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	src := &remoteSource{url: srv.URL, client: srv.Client()}
+	for range 2 {
+		if err := src.poll(context.Background()); err != nil {
+			t.Fatalf("poll: %v", err)
+		}
+	}
+	if requests != 2 || notModified != 1 {
+		t.Errorf("requests = %d, not modified = %d; want the second poll to send the ETag", requests, notModified)
+	}
+	if IsEnabled(NotesPlugin.Name) || SourceOf(NotesPlugin.Name) != SourceRemote {
+		t.Error("remote value should override config")
+	}
+	if Value(WatcherMode.Name) != "poll" {
+		t.Errorf("remote variant = %q, want poll", Value(WatcherMode.Name))
+	}
+
+	// CLI overrides still win
+	SetOverride(NotesPlugin.Name, true)
+	if !IsEnabled(NotesPlugin.Name) {
+		t.Error("CLI override should take precedence over remote")
+	}
+
+	if err := StartRemote(context.Background(), "file:///etc/flags.json", 0); err == nil {
+		t.Error("StartRemote should reject non-http URLs")
+	}
+}
+
+func TestFeatureExpired(t *testing.T) {
+	f := Feature{Name: "temp", ExpiresIn: "v0.12.0"}
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"v0.11.9", false},
+		{"v0.12.0", true},
+		{"v1.0.0", true},
+		{"devel+abc123", false},
+		{"unknown", false},
+	}
+	for _, tt := range tests {
+		if got := f.Expired(tt.version); got != tt.want {
+			t.Errorf("Expired(%q) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+	if (Feature{Name: "forever"}).Expired("v99.0.0") {
+		t.Error("flags without ExpiresIn should never expire")
+	}
+
+	prev := allFeatures
+	allFeatures = append([]Feature{f}, prev...)
+	defer func() { allFeatures = prev }()
+	if got := Expired("v0.12.1"); len(got) != 1 || got[0].Name != "temp" {
+		t.Errorf("Expired() = %v, want [temp]", got)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	Init(config.Default())
+	defer func() { globalManager = nil }()
+
+	ch := Watch(NotesPlugin.Name)
+	defer Unwatch(ch)
+
+	SetRuntime(NotesPlugin.Name, true)
+	select {
+	case v := <-ch:
+		if !v {
+			t.Error("expected true after enabling")
+		}
+	default:
+		t.Fatal("expected a change notification")
+	}
+
+	// Setting the same value again is not a change
+	SetOverride(NotesPlugin.Name, true)
+	select {
+	case v := <-ch:
+		t.Errorf("unexpected notification %v", v)
+	default:
+	}
+
+	// Unread values are replaced by the latest one
+	SetRuntime(NotesPlugin.Name, false)
+	SetRuntime(NotesPlugin.Name, true)
+	SetRuntime(NotesPlugin.Name, false)
+	if v := <-ch; v {
+		t.Error("expected latest value false")
+	}
+	select {
+	case v := <-ch:
+		t.Errorf("expected a single pending value, got another %v", v)
+	default:
+	}
+}
+
+func TestUnwatchClosesChannel(t *testing.T) {
+	ch := Watch(NotesPlugin.Name)
+	Unwatch(ch)
+	if _, ok := <-ch; ok {
+		t.Error("channel should be closed after Unwatch")
+	}
+}
+
+func TestRollout(t *testing.T) {
+	prevID := machineID
+	defer func() { machineID = prevID }()
+
+	f := Feature{Name: "gradual", Rollout: 30}
+	enabled := 0
+	for i := 0; i < 2000; i++ {
+		id := fmt.Sprintf("machine-%d", i)
+		machineID = func() string { return id }
+		in := f.inRollout()
+		if in != f.inRollout() {
+			t.Fatal("rollout bucket should be stable for a machine")
+		}
+		if in {
+			enabled++
+		}
+	}
+	if pct := enabled * 100 / 2000; pct < 25 || pct > 35 {
+		t.Errorf("30%% rollout enabled %d%% of machines", pct)
+	}
+
+	machineID = func() string { return "machine-1" }
+	if !(Feature{Name: "all", Rollout: 100}).inRollout() {
+		t.Error("100% rollout should include every machine")
+	}
+}
+
+func TestRolloutSource(t *testing.T) {
+	prevID, prevKnown := machineID, knownFeatures
+	defer func() { machineID, knownFeatures = prevID, prevKnown }()
+	machineID = func() string { return "machine-1" }
+	knownFeatures = map[string]Feature{"gradual": {Name: "gradual", Rollout: 100}}
+
+	cfg := config.Default()
+	Init(cfg)
+	defer func() { globalManager = nil }()
+
+	if !IsEnabled("gradual") || SourceOf("gradual") != SourceRollout {
+		t.Errorf("IsEnabled = %v, source = %q; want rollout-enabled", IsEnabled("gradual"), SourceOf("gradual"))
+	}
+	cfg.Features.Flags["gradual"] = false
+	if IsEnabled("gradual") || SourceOf("gradual") != SourceConfig {
+		t.Error("config should take precedence over rollout")
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	setupTestConfig(t)
+	Init(config.Default())
+	defer func() { globalManager = nil }()
+
+	var out bytes.Buffer
+	if err := RunCommand([]string{"enable", NotesPlugin.Name}, &out); err != nil {
+		t.Fatalf("enable: %v", err)
+	}
+	cfg, err := config.LoadFrom(config.ConfigPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Features.Flags[NotesPlugin.Name] {
+		t.Error("enable should save the flag to config")
+	}
+
+	out.Reset()
+	if err := RunCommand([]string{"list"}, &out); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if !strings.Contains(out.String(), "notes_plugin") || !strings.Contains(out.String(), "config") {
+		t.Errorf("list output missing flag or source:\n%s", out.String())
+	}
+
+	for _, args := range [][]string{nil, {"enable"}, {"enable", "no_such_flag"}, {"toggle", "notes_plugin"}} {
+		if err := RunCommand(args, &out); err == nil {
+			t.Errorf("RunCommand(%v) should fail", args)
+		}
+	}
+}
+
 func TestConcurrentSetEnabled(t *testing.T) {
 	setupTestConfig(t)
 
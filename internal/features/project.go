@@ -0,0 +1,104 @@
+package features
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectFile is the per-project flag file, relative to the project root.
+const ProjectFile = ".forge/features.yaml"
+
+// projectFlags is the layout of ProjectFile:
+//
+//	flags:
+//	  notes_plugin: true
+//	variants:
+//	  watcher.mode: poll
+type projectFlags struct {
+	Flags    map[string]bool   `yaml:"flags"`
+	Variants map[string]string `yaml:"variants"`
+}
+
+// ProjectPath returns the flag file path for a project root.
+func ProjectPath(projectRoot string) string {
+	return filepath.Join(projectRoot, filepath.FromSlash(ProjectFile))
+}
+
+// LoadProject replaces the project flag layer with the flags in
+// projectRoot's ProjectFile. Project flags override the config file but not
+// environment, CLI, or runtime values. A missing file clears the layer.
+// Unknown flag names and invalid variant values are skipped and reported in
+// the returned error; the rest of the file still applies.
+func LoadProject(projectRoot string) error {
+	if globalManager == nil {
+		return ErrNotInitialized
+	}
+
+	flags, values, err := readProjectFlags(ProjectPath(projectRoot))
+
+	defer notifyChanges(List())
+	globalManager.mu.Lock()
+	defer globalManager.mu.Unlock()
+	globalManager.project = flags
+	globalManager.projectValues = values
+	return err
+}
+
+// readProjectFlags parses a project flag file, keeping only known flags
+// and valid variant values.
+func readProjectFlags(path string) (map[string]bool, map[string]string, error) {
+	flags := make(map[string]bool)
+	values := make(map[string]string)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return flags, values, nil
+	}
+	if err != nil {
+		return flags, values, err
+	}
+
+	var pf projectFlags
+	if err := yaml.Unmarshal(data, &pf); err != nil {
+		return flags, values, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var unknown []string
+	for name, enabled := range pf.Flags {
+		if !IsKnownFeature(name) {
+			unknown = append(unknown, name)
+			continue
+		}
+		flags[name] = enabled
+	}
+
+	var invalid []string
+	for name, value := range pf.Variants {
+		if !IsKnownVariant(name) {
+			unknown = append(unknown, name)
+			continue
+		}
+		if err := ValidateValue(name, value); err != nil {
+			invalid = append(invalid, err.Error())
+			continue
+		}
+		values[name] = value
+	}
+
+	var problems []string
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		problems = append(problems, "unknown feature "+strings.Join(unknown, ", "))
+	}
+	sort.Strings(invalid)
+	problems = append(problems, invalid...)
+	if len(problems) > 0 {
+		return flags, values, fmt.Errorf("%s: %s", path, strings.Join(problems, "; "))
+	}
+	return flags, values, nil
+}
@@ -0,0 +1,129 @@
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultRemoteInterval is how often the remote flag source is polled when
+// the config doesn't set features.remoteInterval.
+const DefaultRemoteInterval = 5 * time.Minute
+
+// remoteFlags is the document served by a remote flag source:
+//
+//	{"flags": {"notes_plugin": false}, "variants": {"watcher.mode": "poll"}}
+type remoteFlags struct {
+	Flags    map[string]bool   `json:"flags"`
+	Variants map[string]string `json:"variants"`
+}
+
+// remoteSource polls a URL for flag values, using the ETag to skip
+// unchanged documents.
+type remoteSource struct {
+	url    string
+	client *http.Client
+	etag   string
+}
+
+// StartRemote polls rawURL for flag values every interval until ctx is
+// done, starting immediately. Remote values override the project file and
+// config but not environment, CLI, or runtime values, so teams can switch
+// off an experimental feature without shipping a new config. Fetch errors
+// keep the last values that were served.
+func StartRemote(ctx context.Context, rawURL string, interval time.Duration) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("remote flags: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("remote flags: %q is not an http(s) URL", rawURL)
+	}
+	if interval <= 0 {
+		interval = DefaultRemoteInterval
+	}
+
+	src := &remoteSource{url: rawURL, client: &http.Client{Timeout: 10 * time.Second}}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var lastErr string
+		for {
+			if err := src.poll(ctx); err != nil && err.Error() != lastErr {
+				slog.Warn("remote feature flags", "url", rawURL, "err", err)
+				lastErr = err.Error()
+			} else if err == nil {
+				lastErr = ""
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// poll fetches the document once and applies it when it changed.
+func (s *remoteSource) poll(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s", resp.Status)
+	}
+
+	var doc remoteFlags
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return err
+	}
+	s.etag = resp.Header.Get("ETag")
+	setRemote(doc)
+	return nil
+}
+
+// setRemote replaces the remote layer with the known flags and valid
+// variant values in doc.
+func setRemote(doc remoteFlags) {
+	if globalManager == nil {
+		return
+	}
+	flags := make(map[string]bool)
+	for name, enabled := range doc.Flags {
+		if IsKnownFeature(name) {
+			flags[name] = enabled
+		}
+	}
+	values := make(map[string]string)
+	for name, value := range doc.Variants {
+		if ValidateValue(name, value) == nil {
+			values[name] = value
+		}
+	}
+
+	defer notifyChanges(List())
+	globalManager.mu.Lock()
+	defer globalManager.mu.Unlock()
+	globalManager.remote = flags
+	globalManager.remoteValues = values
+}
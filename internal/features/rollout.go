@@ -0,0 +1,41 @@
+package features
+
+import (
+	"hash/fnv"
+	"os"
+	"strings"
+	"sync"
+)
+
+// machineID returns a stable identifier for this machine, used to bucket it
+// for percentage rollouts. Tests replace it.
+var machineID = sync.OnceValue(readMachineID)
+
+// readMachineID reads the systemd/dbus machine ID, falling back to the
+// host and user names where those files don't exist (e.g. macOS).
+func readMachineID() string {
+	for _, path := range []string{"/etc/machine-id", "/var/lib/dbus/machine-id"} {
+		if data, err := os.ReadFile(path); err == nil {
+			if id := strings.TrimSpace(string(data)); id != "" {
+				return id
+			}
+		}
+	}
+	host, _ := os.Hostname()
+	return host + "/" + os.Getenv("USER")
+}
+
+// rolloutBucket places this machine in one of 100 buckets for a flag.
+// Hashing the flag name too keeps rollouts of different flags independent.
+func rolloutBucket(name string) int {
+	h := fnv.New32a()
+	h.Write([]byte(machineID()))
+	h.Write([]byte{0})
+	h.Write([]byte(name))
+	return int(h.Sum32() % 100)
+}
+
+// inRollout reports whether this machine falls within a flag's rollout.
+func (f Feature) inRollout() bool {
+	return rolloutBucket(f.Name) < f.Rollout
+}
@@ -0,0 +1,90 @@
+package features
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// Read describes how a flag or variant resolved when code looked it up.
+type Read struct {
+	Name   string
+	Value  string // "on"/"off" for boolean flags
+	Source Source
+	Count  int // lookups during this run
+}
+
+var (
+	usageMu sync.Mutex
+	usage   = make(map[string]*Read)
+)
+
+// recordRead notes a lookup. Value and Source track the latest lookup, so
+// a flag toggled mid-run reports what code saw last.
+func recordRead(name, value string, src Source) {
+	usageMu.Lock()
+	defer usageMu.Unlock()
+	r, ok := usage[name]
+	if !ok {
+		r = &Read{Name: name}
+		usage[name] = r
+	}
+	r.Value = value
+	r.Source = src
+	r.Count++
+}
+
+// Usage returns every flag and variant read during this run, sorted by name.
+func Usage() []Read {
+	usageMu.Lock()
+	defer usageMu.Unlock()
+	reads := make([]Read, 0, len(usage))
+	for _, r := range usage {
+		reads = append(reads, *r)
+	}
+	sort.Slice(reads, func(i, j int) bool { return reads[i].Name < reads[j].Name })
+	return reads
+}
+
+// WriteUsage writes the Usage report as a table, followed by the known
+// flags that were never read.
+func WriteUsage(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FLAG\tVALUE\tSOURCE\tREADS")
+	read := make(map[string]bool)
+	for _, r := range Usage() {
+		read[r.Name] = true
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\n", r.Name, r.Value, r.Source, r.Count)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	var unread []string
+	for _, f := range allFeatures {
+		if !read[f.Name] {
+			unread = append(unread, f.Name)
+		}
+	}
+	for _, v := range allVariants {
+		if !read[v.Name] {
+			unread = append(unread, v.Name)
+		}
+	}
+	if len(unread) > 0 {
+		_, err := fmt.Fprintf(w, "not read: %s\n", strings.Join(unread, ", "))
+		return err
+	}
+	return nil
+}
+
+// stateString formats a boolean flag value for the usage report.
+func stateString(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
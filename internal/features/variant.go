@@ -0,0 +1,220 @@
+package features
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/wilbur182/forge/internal/config"
+)
+
+// Kind is the type of a variant flag's value.
+type Kind string
+
+// Variant kinds.
+const (
+	KindString Kind = "string"
+	KindInt    Kind = "int"
+)
+
+// Variant is a feature flag that selects a value instead of being on or
+// off, e.g. watcher.mode = tiered|poll|fsnotify. Values resolve with the
+// same priority as boolean flags, minus rollouts.
+type Variant struct {
+	Name        string
+	Kind        Kind
+	Default     string
+	Values      []string // allowed string values; empty allows any
+	Description string
+}
+
+// Validate reports whether value is allowed for the variant.
+func (v Variant) Validate(value string) error {
+	if v.Kind == KindInt {
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%s: %q is not an integer", v.Name, value)
+		}
+		return nil
+	}
+	if len(v.Values) > 0 && !slices.Contains(v.Values, value) {
+		return fmt.Errorf("%s: %q is not one of %s", v.Name, value, strings.Join(v.Values, ", "))
+	}
+	return nil
+}
+
+// Known variant flags - add new variants here.
+var (
+	// WatcherMode selects how session files are watched: tiered keeps
+	// active sessions on fsnotify and polls the rest, poll never uses
+	// fsnotify, and fsnotify watches every session.
+	WatcherMode = Variant{
+		Name:        "watcher.mode",
+		Kind:        KindString,
+		Default:     "tiered",
+		Values:      []string{"tiered", "poll", "fsnotify"},
+		Description: "How session files are watched: tiered, poll, or fsnotify",
+	}
+)
+
+// allVariants is the registry of all known variants.
+var allVariants = []Variant{
+	WatcherMode,
+}
+
+// knownVariants provides O(1) lookup for variant defaults.
+var knownVariants = buildVariantMap()
+
+func buildVariantMap() map[string]Variant {
+	m := make(map[string]Variant, len(allVariants))
+	for _, v := range allVariants {
+		m[v.Name] = v
+	}
+	return m
+}
+
+// IsKnownVariant returns true if the variant name is registered.
+func IsKnownVariant(name string) bool {
+	_, ok := knownVariants[name]
+	return ok
+}
+
+// ListVariants returns all known variants with metadata.
+// Returns a copy to prevent mutation of internal state.
+func ListVariants() []Variant {
+	result := make([]Variant, len(allVariants))
+	copy(result, allVariants)
+	return result
+}
+
+// ValidateValue checks value against a variant's kind and allowed values.
+func ValidateValue(name, value string) error {
+	v, ok := knownVariants[name]
+	if !ok {
+		return fmt.Errorf("unknown variant %q", name)
+	}
+	return v.Validate(value)
+}
+
+// Value returns a variant's current value. Each call is recorded in the
+// Usage report.
+// Priority: runtime > CLI override > environment > remote > project > config > default.
+// Unknown variants return "".
+func Value(name string) string {
+	if globalManager == nil {
+		value := knownVariants[name].Default
+		recordRead(name, value, SourceDefault)
+		return value
+	}
+	globalManager.mu.RLock()
+	value, src := resolveValueLocked(name)
+	globalManager.mu.RUnlock()
+	recordRead(name, value, src)
+	return value
+}
+
+// IntValue returns an integer variant's current value, or 0 if it does not
+// parse.
+func IntValue(name string) int {
+	n, _ := strconv.Atoi(Value(name))
+	return n
+}
+
+// SetValueOverride sets a CLI override for a variant.
+func SetValueOverride(name, value string) error {
+	if err := ValidateValue(name, value); err != nil {
+		return err
+	}
+	if globalManager == nil {
+		return ErrNotInitialized
+	}
+	globalManager.mu.Lock()
+	defer globalManager.mu.Unlock()
+	globalManager.overrideValues[name] = value
+	return nil
+}
+
+// SetRuntimeValue sets a variant for the rest of this run without saving it.
+func SetRuntimeValue(name, value string) error {
+	if err := ValidateValue(name, value); err != nil {
+		return err
+	}
+	if globalManager == nil {
+		return ErrNotInitialized
+	}
+	globalManager.mu.Lock()
+	defer globalManager.mu.Unlock()
+	globalManager.runtimeValues[name] = value
+	return nil
+}
+
+// SetValue updates a variant in the config and saves it.
+func SetValue(name, value string) error {
+	if err := ValidateValue(name, value); err != nil {
+		return err
+	}
+	if globalManager == nil {
+		return ErrNotInitialized
+	}
+
+	globalManager.mu.Lock()
+	defer globalManager.mu.Unlock()
+
+	// Reload from disk to avoid overwriting changes made since startup.
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Features.Variants == nil {
+		cfg.Features.Variants = make(map[string]string)
+	}
+	cfg.Features.Variants[name] = value
+
+	// Update in-memory config.
+	globalManager.cfg.Features.Variants = cfg.Features.Variants
+
+	return config.Save(cfg)
+}
+
+// resolveValueLocked returns a variant's value and its source (caller must
+// hold lock). Invalid config values fall through to the default.
+func resolveValueLocked(name string) (string, Source) {
+	if value, ok := globalManager.runtimeValues[name]; ok {
+		return value, SourceRuntime
+	}
+	if value, ok := globalManager.overrideValues[name]; ok {
+		return value, SourceCLI
+	}
+	if value, ok := globalManager.envValues[name]; ok {
+		return value, SourceEnv
+	}
+	if value, ok := globalManager.remoteValues[name]; ok {
+		return value, SourceRemote
+	}
+	if value, ok := globalManager.projectValues[name]; ok {
+		return value, SourceProject
+	}
+	if globalManager.cfg != nil {
+		if value, ok := globalManager.cfg.Features.Variants[name]; ok && ValidateValue(name, value) == nil {
+			return value, SourceConfig
+		}
+	}
+	return knownVariants[name].Default, SourceDefault
+}
+
+// loadEnvValues reads FORGE_FEATURE_<NAME> variables for known variants.
+// Values the variant doesn't allow are ignored.
+func loadEnvValues() map[string]string {
+	values := make(map[string]string)
+	for _, v := range allVariants {
+		if value, ok := os.LookupEnv(EnvVar(v.Name)); ok {
+			value = strings.TrimSpace(value)
+			if v.Validate(value) == nil {
+				values[v.Name] = value
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,59 @@
+package features
+
+import "sync"
+
+// Watchers outlive the manager so subscriptions survive Init.
+var (
+	watchMu  sync.Mutex
+	watchers = make(map[string][]chan bool)
+)
+
+// Watch returns a channel that receives a feature's value each time it
+// changes, from any source. The channel holds only the latest value: a slow
+// reader sees the current state, not every flip. Call Unwatch to stop.
+func Watch(name string) <-chan bool {
+	ch := make(chan bool, 1)
+	watchMu.Lock()
+	defer watchMu.Unlock()
+	watchers[name] = append(watchers[name], ch)
+	return ch
+}
+
+// Unwatch stops delivery to a channel returned by Watch and closes it.
+func Unwatch(ch <-chan bool) {
+	watchMu.Lock()
+	defer watchMu.Unlock()
+	for name, chans := range watchers {
+		for i, c := range chans {
+			if c == ch {
+				watchers[name] = append(chans[:i], chans[i+1:]...)
+				close(c)
+				return
+			}
+		}
+	}
+}
+
+// notifyChanges sends the current value of every feature that differs from
+// before to its watchers.
+func notifyChanges(before map[string]bool) {
+	after := List()
+	watchMu.Lock()
+	defer watchMu.Unlock()
+	for name, enabled := range after {
+		if before[name] == enabled {
+			continue
+		}
+		for _, ch := range watchers[name] {
+			// Replace any unread value so the channel holds the latest
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- enabled:
+			default:
+			}
+		}
+	}
+}
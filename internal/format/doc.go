@@ -0,0 +1,4 @@
+// Package format renders token counts, numbers, and cost estimates using the
+// user's configured locale (currency symbol, separators, and an optional
+// conversion rate from USD).
+package format
@@ -0,0 +1,176 @@
+package format
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wilbur182/forge/internal/config"
+)
+
+// Locale controls how numbers and currency amounts are rendered.
+type Locale struct {
+	CurrencySymbol string  // e.g. "$", "€", "CHF "
+	SymbolAfter    bool    // render "12,50 €" instead of "€12,50"
+	DecimalSep     string  // e.g. "." or ","
+	ThousandsSep   string  // e.g. ",", ".", " " or "" to disable grouping
+	Rate           float64 // multiplier applied to USD amounts (0 = no conversion)
+}
+
+// DefaultLocale is the US-style locale used when nothing is configured.
+var DefaultLocale = Locale{
+	CurrencySymbol: "$",
+	DecimalSep:     ".",
+	ThousandsSep:   ",",
+}
+
+// current is the active locale. Set once at startup via SetLocale.
+var current = DefaultLocale
+
+// SetLocale sets the locale used by all formatting helpers.
+// Empty fields fall back to DefaultLocale values.
+func SetLocale(l Locale) {
+	if l.CurrencySymbol == "" {
+		l.CurrencySymbol = DefaultLocale.CurrencySymbol
+	}
+	if l.DecimalSep == "" {
+		l.DecimalSep = DefaultLocale.DecimalSep
+	}
+	if l.Rate < 0 {
+		l.Rate = 0
+	}
+	current = l
+}
+
+// Current returns the active locale.
+func Current() Locale {
+	return current
+}
+
+// FromConfig builds a Locale from the UI locale config.
+func FromConfig(cfg config.LocaleConfig) Locale {
+	l := Locale{
+		CurrencySymbol: cfg.CurrencySymbol,
+		SymbolAfter:    cfg.SymbolAfter,
+		DecimalSep:     cfg.DecimalSeparator,
+		ThousandsSep:   DefaultLocale.ThousandsSep,
+		Rate:           cfg.CurrencyRate,
+	}
+	if cfg.ThousandsSeparator != nil {
+		l.ThousandsSep = *cfg.ThousandsSeparator
+	}
+	return l
+}
+
+// Int formats an integer with thousands separators (e.g. 1,234,567).
+func Int(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	s = group(s, current.ThousandsSep)
+	if neg {
+		return "-" + s
+	}
+	return s
+}
+
+// Float formats a float with prec decimal places using the locale's
+// decimal and thousands separators.
+func Float(v float64, prec int) string {
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	out := group(intPart, current.ThousandsSep)
+	if hasFrac {
+		out += current.DecimalSep + fracPart
+	}
+	if neg {
+		return "-" + out
+	}
+	return out
+}
+
+// Compact formats a count with a k/M/B suffix (e.g. 1.5k, 2.3M).
+// Values below 1000 are returned as plain integers. upper selects "K"
+// instead of "k" for the thousands suffix.
+func Compact(n int64, upper bool) string {
+	k := "k"
+	if upper {
+		k = "K"
+	}
+	switch {
+	case n >= 1_000_000_000:
+		return ungrouped(float64(n)/1_000_000_000, 1) + "B"
+	case n >= 1_000_000:
+		return ungrouped(float64(n)/1_000_000, 1) + "M"
+	case n >= 1_000:
+		// No grouping here: 999999 must stay "1000.0k", not "1,000.0k".
+		return ungrouped(float64(n)/1_000, 1) + k
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// Money converts a USD amount into the locale currency and formats it
+// with prec decimal places and the currency symbol.
+func Money(usd float64, prec int) string {
+	return withSymbol(Float(convert(usd), prec))
+}
+
+// Cost formats a cost estimate for compact display: amounts below one
+// hundredth render as "<$0.01", below one unit with two decimals,
+// otherwise with one.
+func Cost(usd float64) string {
+	v := convert(usd)
+	if v < 0.01 {
+		return "<" + withSymbol(Float(0.01, 2))
+	}
+	if v < 1.0 {
+		return withSymbol(Float(v, 2))
+	}
+	return withSymbol(Float(v, 1))
+}
+
+// ungrouped formats v with the locale decimal separator but no grouping.
+func ungrouped(v float64, prec int) string {
+	return strings.Replace(strconv.FormatFloat(v, 'f', prec, 64), ".", current.DecimalSep, 1)
+}
+
+// convert applies the configured conversion rate.
+func convert(usd float64) float64 {
+	if current.Rate == 0 || math.IsNaN(current.Rate) {
+		return usd
+	}
+	return usd * current.Rate
+}
+
+// withSymbol attaches the currency symbol on the configured side.
+func withSymbol(s string) string {
+	if current.SymbolAfter {
+		return s + " " + strings.TrimSpace(current.CurrencySymbol)
+	}
+	return current.CurrencySymbol + s
+}
+
+// group inserts sep between every three digits of a digit string.
+func group(digits, sep string) string {
+	if sep == "" || len(digits) <= 3 {
+		return digits
+	}
+	var sb strings.Builder
+	pre := len(digits) % 3
+	if pre > 0 {
+		sb.WriteString(digits[:pre])
+	}
+	for i := pre; i < len(digits); i += 3 {
+		if sb.Len() > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(digits[i : i+3])
+	}
+	return sb.String()
+}
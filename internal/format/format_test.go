@@ -0,0 +1,75 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+)
+
+func withLocale(t *testing.T, l Locale) {
+	t.Helper()
+	prev := current
+	SetLocale(l)
+	t.Cleanup(func() { current = prev })
+}
+
+func TestDefaultLocale(t *testing.T) {
+	withLocale(t, DefaultLocale)
+
+	tests := []struct {
+		got, want string
+	}{
+		{Int(0), "0"},
+		{Int(999), "999"},
+		{Int(1234567), "1,234,567"},
+		{Int(-1234), "-1,234"},
+		{Float(1234.5, 2), "1,234.50"},
+		{Compact(999, false), "999"},
+		{Compact(1500, false), "1.5k"},
+		{Compact(999999, false), "1000.0k"},
+		{Compact(2500000, true), "2.5M"},
+		{Compact(3_200_000_000, true), "3.2B"},
+		{Cost(0.001), "<$0.01"},
+		{Cost(0.5), "$0.50"},
+		{Cost(12.34), "$12.3"},
+		{Money(1.23456, 4), "$1.2346"},
+	}
+	for i, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("case %d: got %q, want %q", i, tt.got, tt.want)
+		}
+	}
+}
+
+func TestEuroLocale(t *testing.T) {
+	dot := "."
+	withLocale(t, FromConfig(config.LocaleConfig{
+		CurrencySymbol:     "€",
+		SymbolAfter:        true,
+		DecimalSeparator:   ",",
+		ThousandsSeparator: &dot,
+		CurrencyRate:       0.5,
+	}))
+
+	if got := Money(2469, 2); got != "1.234,50 €" {
+		t.Errorf("Money = %q, want %q", got, "1.234,50 €")
+	}
+	if got := Cost(1); got != "0,50 €" {
+		t.Errorf("Cost = %q, want %q", got, "0,50 €")
+	}
+	if got := Compact(1500, false); got != "1,5k" {
+		t.Errorf("Compact = %q, want %q", got, "1,5k")
+	}
+}
+
+func TestSetLocaleDefaults(t *testing.T) {
+	empty := ""
+	withLocale(t, FromConfig(config.LocaleConfig{ThousandsSeparator: &empty, CurrencyRate: -2}))
+
+	if got := Int(1234567); got != "1234567" {
+		t.Errorf("Int with grouping disabled = %q, want %q", got, "1234567")
+	}
+	if got := Money(1, 2); got != "$1.00" {
+		t.Errorf("Money = %q, want %q (negative rate ignored)", got, "$1.00")
+	}
+}
@@ -0,0 +1,4 @@
+// Package icons provides the glyphs used for status dots, file tree entries,
+// and adapter badges, in Nerd Font, Unicode, and ASCII sets so the UI
+// degrades cleanly on fonts without glyph support.
+package icons
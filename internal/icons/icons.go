@@ -0,0 +1,228 @@
+package icons
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// Icon sets for ui.icons. SetAuto picks one from the environment.
+const (
+	SetAuto     = "auto"
+	SetNerdFont = "nerd-font"
+	SetUnicode  = "unicode"
+	SetASCII    = "ascii"
+)
+
+// Name identifies an icon independent of the set that draws it.
+type Name int
+
+const (
+	Dot      Name = iota // Active or running
+	DotEmpty             // Idle or pending
+	Check                // Done or success
+	Cross                // Error or failure
+	Paused
+	Thinking
+	Waiting // Waiting for input
+	Orphaned
+	DirOpen
+	DirClosed
+	File
+	Adapter // Fallback adapter badge
+)
+
+// Provider supplies glyphs for one icon set. Glyphs keep the width of the
+// Unicode set (one cell) so layouts don't shift between sets.
+type Provider interface {
+	// Set returns the icon set name, such as SetUnicode.
+	Set() string
+	// Icon returns the glyph for name.
+	Icon(name Name) string
+	// FileIcon returns the glyph for a file, chosen by its name.
+	FileIcon(filename string) string
+	// AdapterIcon returns the badge for an adapter. glyph is the adapter's
+	// own Unicode icon.
+	AdapterIcon(adapterID, glyph string) string
+}
+
+// glyphSet is a Provider backed by lookup tables.
+type glyphSet struct {
+	name     string
+	glyphs   map[Name]string
+	files    map[string]string // by lowercase extension
+	adapters map[string]string // by adapter ID
+	fallback *glyphSet         // for names this set doesn't draw
+}
+
+func (s *glyphSet) Set() string { return s.name }
+
+func (s *glyphSet) Icon(name Name) string {
+	if g, ok := s.glyphs[name]; ok {
+		return g
+	}
+	if s.fallback != nil {
+		return s.fallback.Icon(name)
+	}
+	return ""
+}
+
+func (s *glyphSet) FileIcon(filename string) string {
+	if g, ok := s.files[strings.ToLower(filepath.Ext(filename))]; ok {
+		return g
+	}
+	return s.Icon(File)
+}
+
+func (s *glyphSet) AdapterIcon(adapterID, glyph string) string {
+	if g, ok := s.adapters[adapterID]; ok {
+		return g
+	}
+	if s.name == SetASCII || glyph == "" {
+		return s.Icon(Adapter)
+	}
+	return glyph
+}
+
+// unicodeSet uses symbols found in most monospace fonts.
+var unicodeSet = &glyphSet{
+	name: SetUnicode,
+	glyphs: map[Name]string{
+		Dot:       "●",
+		DotEmpty:  "○",
+		Check:     "✓",
+		Cross:     "✗",
+		Paused:    "⏸",
+		Thinking:  "◐",
+		Waiting:   "⧗",
+		Orphaned:  "◌",
+		DirOpen:   ">",
+		DirClosed: "+",
+		File:      " ",
+		Adapter:   "◆",
+	},
+}
+
+// nerdFontSet adds Nerd Font file type and folder glyphs.
+var nerdFontSet = &glyphSet{
+	name: SetNerdFont,
+	glyphs: map[Name]string{
+		DirOpen:   "\uf07c", // nf-fa-folder_open
+		DirClosed: "\uf07b", // nf-fa-folder
+		File:      "\uf15b", // nf-fa-file
+	},
+	files: map[string]string{
+		".go":   "\ue627", // nf-seti-go
+		".md":   "\ue609", // nf-seti-markdown
+		".json": "\ue60b", // nf-seti-json
+		".js":   "\ue74e", // nf-dev-javascript
+		".ts":   "\ue628", // nf-seti-typescript
+		".py":   "\ue73c", // nf-dev-python
+		".rs":   "\ue7a8", // nf-dev-rust
+		".yaml": "\ue6a8", // nf-seti-yml
+		".yml":  "\ue6a8",
+		".sh":   "\uf489", // nf-oct-terminal
+	},
+	fallback: unicodeSet,
+}
+
+// asciiSet is for terminals without Unicode fonts (e.g. the Linux console).
+var asciiSet = &glyphSet{
+	name: SetASCII,
+	glyphs: map[Name]string{
+		Dot:       "*",
+		DotEmpty:  "o",
+		Check:     "+",
+		Cross:     "x",
+		Paused:    "=",
+		Thinking:  "~",
+		Waiting:   "?",
+		Orphaned:  "-",
+		DirOpen:   ">",
+		DirClosed: "+",
+		File:      " ",
+		Adapter:   "*",
+	},
+	adapters: map[string]string{
+		"claude-code": "C",
+		"codex":       "X",
+		"gemini-cli":  "G",
+		"opencode":    "O",
+		"cursor-cli":  "U",
+		"amp":         "A",
+		"warp":        "W",
+		"kiro":        "K",
+		"pi":          "P",
+	},
+}
+
+var providers = map[string]Provider{
+	SetNerdFont: nerdFontSet,
+	SetUnicode:  unicodeSet,
+	SetASCII:    asciiSet,
+}
+
+// current is the active provider.
+var current atomic.Pointer[Provider]
+
+func init() {
+	Use(SetUnicode)
+}
+
+// IsValidSet reports whether set is empty, SetAuto, or a known icon set.
+func IsValidSet(set string) bool {
+	_, ok := providers[set]
+	return ok || set == "" || set == SetAuto
+}
+
+// Detect resolves a configured set. Empty or SetAuto uses Nerd Font glyphs
+// when nerdFonts is on, ASCII when the terminal can't show Unicode, and
+// Unicode otherwise. Unknown sets resolve as SetAuto.
+func Detect(set string, nerdFonts bool) string {
+	if _, ok := providers[set]; ok {
+		return set
+	}
+	if nerdFonts {
+		return SetNerdFont
+	}
+	if !unicodeTerminal() {
+		return SetASCII
+	}
+	return SetUnicode
+}
+
+// unicodeTerminal reports whether the locale and terminal can show Unicode.
+// The Linux console's default font lacks most symbols.
+func unicodeTerminal() bool {
+	if os.Getenv("TERM") == "linux" {
+		return false
+	}
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(key); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	// No locale set: assume a modern terminal
+	return true
+}
+
+// Use makes the named set current. Unknown names select Unicode.
+func Use(set string) {
+	p, ok := providers[set]
+	if !ok {
+		p = unicodeSet
+	}
+	current.Store(&p)
+}
+
+// Current returns the active provider.
+func Current() Provider {
+	return *current.Load()
+}
+
+// Get returns the active set's glyph for name.
+func Get(name Name) string {
+	return Current().Icon(name)
+}
@@ -0,0 +1,81 @@
+package icons
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name      string
+		set       string
+		nerdFonts bool
+		term      string
+		lang      string
+		want      string
+	}{
+		{"explicit set wins", SetASCII, true, "xterm-256color", "en_US.UTF-8", SetASCII},
+		{"auto with nerd fonts", SetAuto, true, "xterm-256color", "en_US.UTF-8", SetNerdFont},
+		{"empty is auto", "", false, "xterm-256color", "en_US.UTF-8", SetUnicode},
+		{"linux console", "", false, "linux", "en_US.UTF-8", SetASCII},
+		{"non-utf8 locale", SetAuto, false, "xterm", "C", SetASCII},
+		{"no locale", SetAuto, false, "xterm", "", SetUnicode},
+		{"unknown set is auto", "emoji", false, "xterm", "en_US.utf8", SetUnicode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TERM", tt.term)
+			t.Setenv("LC_ALL", "")
+			t.Setenv("LC_CTYPE", "")
+			t.Setenv("LANG", tt.lang)
+			if got := Detect(tt.set, tt.nerdFonts); got != tt.want {
+				t.Errorf("Detect(%q, %v) = %q, want %q", tt.set, tt.nerdFonts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidSet(t *testing.T) {
+	for _, set := range []string{"", SetAuto, SetNerdFont, SetUnicode, SetASCII} {
+		if !IsValidSet(set) {
+			t.Errorf("IsValidSet(%q) = false, want true", set)
+		}
+	}
+	if IsValidSet("emoji") {
+		t.Error("IsValidSet(\"emoji\") = true, want false")
+	}
+}
+
+func TestProviders(t *testing.T) {
+	t.Cleanup(func() { Use(SetUnicode) })
+
+	Use(SetNerdFont)
+	if got := Get(Check); got != "✓" {
+		t.Errorf("nerd-font Check = %q, want Unicode fallback %q", got, "✓")
+	}
+	if got := Current().FileIcon("main.GO"); got != "\ue627" {
+		t.Errorf("nerd-font FileIcon(main.GO) = %q, want Go glyph", got)
+	}
+	if got := Current().FileIcon("notes.txt"); got != "\uf15b" {
+		t.Errorf("nerd-font FileIcon(notes.txt) = %q, want generic file glyph", got)
+	}
+	if got := Current().AdapterIcon("codex", "◇"); got != "◇" {
+		t.Errorf("nerd-font AdapterIcon = %q, want adapter glyph", got)
+	}
+
+	Use(SetASCII)
+	if got := Get(Dot); got != "*" {
+		t.Errorf("ascii Dot = %q, want %q", got, "*")
+	}
+	if got := Current().AdapterIcon("claude-code", "◆"); got != "C" {
+		t.Errorf("ascii AdapterIcon(claude-code) = %q, want %q", got, "C")
+	}
+	if got := Current().AdapterIcon("unknown", "◆"); got != "*" {
+		t.Errorf("ascii AdapterIcon(unknown) = %q, want %q", got, "*")
+	}
+
+	Use("bogus")
+	if got := Current().Set(); got != SetUnicode {
+		t.Errorf("Use(bogus) selected %q, want %q", got, SetUnicode)
+	}
+	if got := Current().AdapterIcon("codex", ""); got != "◆" {
+		t.Errorf("unicode AdapterIcon with no glyph = %q, want %q", got, "◆")
+	}
+}
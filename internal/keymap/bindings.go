@@ -10,6 +10,13 @@ func DefaultBindings() []Binding {
 		{Key: "`", Command: "next-plugin", Context: "global"},
 		{Key: "~", Command: "prev-plugin", Context: "global"},
 		{Key: "@", Command: "switch-project", Context: "global"},
+		{Key: "#", Command: "switch-theme", Context: "global"},
+		{Key: "$", Command: "feature-flags", Context: "global"},
+		{Key: "%", Command: "keybindings", Context: "global"},
+		{Key: "Q", Command: "record-macro", Context: "global"},
+		{Key: "&", Command: "play-macro", Context: "global"},
+		{Key: "ctrl+k", Command: "global-search", Context: "global"},
+		{Key: "=", Command: "resize-pane", Context: "global"},
 		{Key: "1", Command: "focus-plugin-1", Context: "global"},
 		{Key: "2", Command: "focus-plugin-2", Context: "global"},
 		{Key: "3", Command: "focus-plugin-3", Context: "global"},
@@ -32,6 +39,18 @@ func DefaultBindings() []Binding {
 		{Key: "enter", Command: "select", Context: "global"},
 		{Key: "esc", Command: "back", Context: "global"},
 
+		// Keyboard pane resize mode
+		{Key: "left", Command: "shrink-sidebar", Context: "pane-resize"},
+		{Key: "right", Command: "grow-sidebar", Context: "pane-resize"},
+		{Key: "h", Command: "shrink-sidebar", Context: "pane-resize"},
+		{Key: "l", Command: "grow-sidebar", Context: "pane-resize"},
+		{Key: "ctrl+left", Command: "shrink-sidebar", Context: "pane-resize"},
+		{Key: "ctrl+right", Command: "grow-sidebar", Context: "pane-resize"},
+		{Key: "H", Command: "shrink-sidebar-more", Context: "pane-resize"},
+		{Key: "L", Command: "grow-sidebar-more", Context: "pane-resize"},
+		{Key: "esc", Command: "done", Context: "pane-resize"},
+		{Key: "enter", Command: "done", Context: "pane-resize"},
+
 		// Project switcher context
 		{Key: "@", Command: "toggle", Context: "project-switcher"},
 		{Key: "esc", Command: "close", Context: "project-switcher"},
@@ -41,6 +60,14 @@ func DefaultBindings() []Binding {
 		{Key: "ctrl+n", Command: "cursor-down", Context: "project-switcher"},
 		{Key: "ctrl+p", Command: "cursor-up", Context: "project-switcher"},
 
+		// Global search context
+		{Key: "esc", Command: "close", Context: "global-search"},
+		{Key: "enter", Command: "open-result", Context: "global-search"},
+		{Key: "tab", Command: "next-filter", Context: "global-search"},
+		{Key: "shift+tab", Command: "prev-filter", Context: "global-search"},
+		{Key: "down", Command: "cursor-down", Context: "global-search"},
+		{Key: "up", Command: "cursor-up", Context: "global-search"},
+
 		// Git status context
 		{Key: "i", Command: "init-repo", Context: "git-no-repo"},
 		{Key: "enter", Command: "init-repo", Context: "git-no-repo"},
@@ -212,6 +239,8 @@ func DefaultBindings() []Binding {
 		{Key: "/", Command: "search", Context: "conversations-sidebar"},
 		{Key: "s", Command: "toggle-star", Context: "conversations-sidebar"},
 		{Key: "A", Command: "show-analytics", Context: "conversations-sidebar"},
+		{Key: "S", Command: "show-stats", Context: "conversations-sidebar"},
+		{Key: "M", Command: "compare-models", Context: "conversations-sidebar"},
 		{Key: "l", Command: "focus-right", Context: "conversations-sidebar"},
 		{Key: "right", Command: "focus-right", Context: "conversations-sidebar"},
 		{Key: "v", Command: "toggle-view", Context: "conversations-sidebar"},
@@ -221,6 +250,11 @@ func DefaultBindings() []Binding {
 		{Key: "Y", Command: "yank-resume", Context: "conversations-sidebar"},
 		{Key: "C", Command: "toggle-category", Context: "conversations-sidebar"},
 		{Key: "R", Command: "resume-in-workspace", Context: "conversations-sidebar"},
+		{Key: "N", Command: "rename-session", Context: "conversations-sidebar"},
+		{Key: "T", Command: "retitle-session", Context: "conversations-sidebar"},
+		{Key: "|", Command: "split-session", Context: "conversations-sidebar"},
+		{Key: "P", Command: "toggle-coview", Context: "conversations-sidebar"},
+		{Key: "W", Command: "jump-to-peer", Context: "conversations-sidebar"},
 
 		// Conversations main context (two-pane mode, right pane focused)
 		{Key: "tab", Command: "switch-pane", Context: "conversations-main"},
@@ -239,6 +273,41 @@ func DefaultBindings() []Binding {
 		{Key: "y", Command: "yank-details", Context: "conversations-main"},
 		{Key: "Y", Command: "yank-resume", Context: "conversations-main"},
 		{Key: "R", Command: "resume-in-workspace", Context: "conversations-main"},
+		{Key: "O", Command: "tool-output", Context: "conversations-main"},
+		{Key: "J", Command: "raw-source", Context: "conversations-main"},
+		{Key: "L", Command: "copy-permalink", Context: "conversations-main"},
+		{Key: "I", Command: "view-image", Context: "conversations-main"},
+		{Key: "X", Command: "export-json", Context: "conversations-main"},
+		{Key: "f", Command: "toggle-follow", Context: "conversations-main"},
+		{Key: "|", Command: "close-split", Context: "conversations-main"},
+		{Key: "P", Command: "toggle-coview", Context: "conversations-main"},
+		{Key: "W", Command: "jump-to-peer", Context: "conversations-main"},
+
+		// Conversations split pane (second session below the main pane)
+		{Key: "tab", Command: "switch-pane", Context: "conversations-split"},
+		{Key: "shift+tab", Command: "switch-pane", Context: "conversations-split"},
+		{Key: "esc", Command: "back", Context: "conversations-split"},
+		{Key: "j", Command: "scroll", Context: "conversations-split"},
+		{Key: "k", Command: "scroll", Context: "conversations-split"},
+		{Key: "g", Command: "cursor-top", Context: "conversations-split"},
+		{Key: "G", Command: "cursor-bottom", Context: "conversations-split"},
+		{Key: "f", Command: "toggle-follow", Context: "conversations-split"},
+		{Key: "|", Command: "close-split", Context: "conversations-split"},
+
+		// Conversations tool output pager
+		{Key: "esc", Command: "close", Context: "conversations-tool-pager"},
+		{Key: "q", Command: "close", Context: "conversations-tool-pager"},
+		{Key: "w", Command: "toggle-wrap", Context: "conversations-tool-pager"},
+		{Key: "]", Command: "next-tool", Context: "conversations-tool-pager"},
+		{Key: "[", Command: "prev-tool", Context: "conversations-tool-pager"},
+		{Key: "y", Command: "yank", Context: "conversations-tool-pager"},
+
+		// Conversations image attachment viewer
+		{Key: "esc", Command: "close", Context: "conversations-image-viewer"},
+		{Key: "q", Command: "close", Context: "conversations-image-viewer"},
+		{Key: "o", Command: "open-external", Context: "conversations-image-viewer"},
+		{Key: "]", Command: "next-image", Context: "conversations-image-viewer"},
+		{Key: "[", Command: "prev-image", Context: "conversations-image-viewer"},
 
 		// File browser tree context
 		{Key: "tab", Command: "switch-pane", Context: "file-browser-tree"},
@@ -364,11 +433,79 @@ func DefaultBindings() []Binding {
 		{Key: "[", Command: "prev-tab", Context: "workspace-list"},
 		{Key: "]", Command: "next-tab", Context: "workspace-list"},
 		{Key: "F", Command: "fetch-pr", Context: "workspace-list"},
+		{Key: "B", Command: "rebase-workflow", Context: "workspace-list"},
+		{Key: "C", Command: "cleanup", Context: "workspace-list"},
+		{Key: "P", Command: "switch-repo", Context: "workspace-list"},
+		{Key: "e", Command: "edit-env", Context: "workspace-list"},
+		{Key: "b", Command: "broadcast", Context: "workspace-list"},
+		{Key: "L", Command: "ports", Context: "workspace-list"},
+		{Key: "A", Command: "discover-sessions", Context: "workspace-list"},
+		{Key: "V", Command: "toggle-recording", Context: "workspace-list"},
+		{Key: "W", Command: "replay", Context: "workspace-list"},
+		{Key: "|", Command: "toggle-split-preview", Context: "workspace-list"},
+		{Key: "H", Command: "new-remote-workspace", Context: "workspace-list"},
+		{Key: "u", Command: "undo-delete", Context: "workspace-list"},
+		{Key: "M", Command: "bulk-create", Context: "workspace-list"},
+
+		// Workspace bulk create context
+		{Key: "esc", Command: "cancel", Context: "workspace-bulk-create"},
+		{Key: "enter", Command: "create", Context: "workspace-bulk-create"},
+		{Key: "ctrl+a", Command: "toggle-all", Context: "workspace-bulk-create"},
+
+		// Workspace remote create context
+		{Key: "esc", Command: "cancel", Context: "workspace-remote-create"},
+		{Key: "enter", Command: "create", Context: "workspace-remote-create"},
 
 		// Workspace fetch PR context
 		{Key: "esc", Command: "cancel", Context: "workspace-fetch-pr"},
 		{Key: "enter", Command: "fetch", Context: "workspace-fetch-pr"},
 
+		// Workspace rebase context
+		{Key: "esc", Command: "cancel", Context: "workspace-rebase"},
+		{Key: "enter", Command: "start-rebase", Context: "workspace-rebase"},
+
+		// Workspace rebase context, stopped on conflicts
+		{Key: "esc", Command: "cancel", Context: "workspace-rebase-conflicts"},
+		{Key: "enter", Command: "open-conflict", Context: "workspace-rebase-conflicts"},
+		{Key: "c", Command: "continue-rebase", Context: "workspace-rebase-conflicts"},
+		{Key: "a", Command: "abort-rebase", Context: "workspace-rebase-conflicts"},
+
+		// Workspace cleanup context
+		{Key: "esc", Command: "cancel", Context: "workspace-cleanup"},
+		{Key: "D", Command: "delete-selected", Context: "workspace-cleanup"},
+		{Key: "a", Command: "toggle-all", Context: "workspace-cleanup"},
+
+		// Workspace repo switcher context
+		{Key: "esc", Command: "cancel", Context: "workspace-repo-switcher"},
+		{Key: "enter", Command: "select", Context: "workspace-repo-switcher"},
+
+		// Workspace env editor context
+		{Key: "esc", Command: "cancel", Context: "workspace-env-editor"},
+		{Key: "ctrl+s", Command: "save", Context: "workspace-env-editor"},
+
+		// Workspace broadcast context
+		{Key: "esc", Command: "cancel", Context: "workspace-broadcast"},
+		{Key: "enter", Command: "send", Context: "workspace-broadcast"},
+		{Key: "ctrl+a", Command: "toggle-all", Context: "workspace-broadcast"},
+
+		// Workspace ports context
+		{Key: "esc", Command: "cancel", Context: "workspace-ports"},
+		{Key: "o", Command: "open-port", Context: "workspace-ports"},
+		{Key: "x", Command: "kill-port", Context: "workspace-ports"},
+
+		// Workspace replay context
+		{Key: "esc", Command: "close-replay", Context: "workspace-replay"},
+		{Key: "space", Command: "play-pause", Context: "workspace-replay"},
+		{Key: "left", Command: "step-back", Context: "workspace-replay"},
+		{Key: "right", Command: "step-forward", Context: "workspace-replay"},
+		{Key: "+", Command: "faster", Context: "workspace-replay"},
+		{Key: "-", Command: "slower", Context: "workspace-replay"},
+
+		// Workspace discover sessions context
+		{Key: "esc", Command: "cancel", Context: "workspace-discover"},
+		{Key: "a", Command: "attach-session", Context: "workspace-discover"},
+		{Key: "s", Command: "adopt-session", Context: "workspace-discover"},
+
 		// Workspace preview context
 		{Key: "h", Command: "focus-left", Context: "workspace-preview"},
 		{Key: "left", Command: "focus-left", Context: "workspace-preview"},
@@ -379,6 +516,9 @@ func DefaultBindings() []Binding {
 		{Key: "Y", Command: "approve-all", Context: "workspace-preview"},
 		{Key: "N", Command: "reject", Context: "workspace-preview"},
 		{Key: "v", Command: "toggle-diff-view", Context: "workspace-preview"},
+		{Key: "|", Command: "toggle-split-preview", Context: "workspace-preview"},
+		{Key: "f", Command: "toggle-ci-folding", Context: "workspace-preview"},
+		{Key: "R", Command: "rerun-ci", Context: "workspace-preview"},
 		{Key: "0", Command: "reset-scroll", Context: "workspace-preview"},
 		{Key: "tab", Command: "switch-pane", Context: "workspace-preview"},
 		{Key: "shift+tab", Command: "switch-pane", Context: "workspace-preview"},
@@ -0,0 +1,57 @@
+package keymap
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Conflict is a user override that shadows other bindings of its key in a
+// focus context.
+type Conflict struct {
+	Key      string   // overridden key or chord, leader expanded
+	Context  string   // focus context of the shadowed bindings
+	Command  string   // command the override runs
+	Shadowed []string // commands bound to Key in Context that no longer run
+}
+
+// String describes the conflict, e.g. `"x" runs quit instead of delete in
+// files`.
+func (c Conflict) String() string {
+	return `"` + c.Key + `" runs ` + c.Command + " instead of " + strings.Join(c.Shadowed, ", ") + " in " + c.Context
+}
+
+// Conflicts lists the user overrides that shadow a binding in some
+// context, sorted by key and context. An override only shadows bindings
+// where its command can run; elsewhere the key keeps its meaning.
+func (r *Registry) Conflicts() []Conflict {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var conflicts []Conflict
+	for key, cmdID := range r.userOverrides {
+		cmd, hasCmd := r.commands[cmdID]
+		for ctx, bindings := range r.bindings {
+			var shadowed []string
+			for _, b := range bindings {
+				if b.Key == key && b.Command != cmdID && !slices.Contains(shadowed, b.Command) {
+					shadowed = append(shadowed, b.Command)
+				}
+			}
+			if len(shadowed) == 0 {
+				continue
+			}
+			if !(hasCmd && cmd.Handler != nil) && !r.hasBinding(cmdID, ctx) {
+				continue
+			}
+			conflicts = append(conflicts, Conflict{Key: key, Context: ctx, Command: cmdID, Shadowed: shadowed})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].Key != conflicts[j].Key {
+			return conflicts[i].Key < conflicts[j].Key
+		}
+		return conflicts[i].Context < conflicts[j].Context
+	})
+	return conflicts
+}
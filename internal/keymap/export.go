@@ -0,0 +1,190 @@
+package keymap
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Export is a shareable keymap: the profile and leader, and the keys of
+// each command in each context with user overrides applied.
+type Export struct {
+	Profile string `yaml:"profile,omitempty"`
+	Leader  string `yaml:"leader,omitempty"`
+	// Bindings maps a context to its commands and their keys, e.g.
+	// {"global": {"quit": ["q", "ctrl+c"]}}.
+	Bindings map[string]map[string][]string `yaml:"bindings"`
+}
+
+// Export returns the effective keymap. A key overridden to another
+// command is dropped where that command can run, and the override's key
+// is listed instead.
+func (r *Registry) Export() Export {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e := Export{Leader: r.leader, Bindings: make(map[string]map[string][]string)}
+	if r.profileName != "" && r.profileName != "default" {
+		e.Profile = r.profileName
+	}
+	add := func(ctx, cmd, key string) {
+		if e.Bindings[ctx] == nil {
+			e.Bindings[ctx] = make(map[string][]string)
+		}
+		if !slices.Contains(e.Bindings[ctx][cmd], key) {
+			e.Bindings[ctx][cmd] = append(e.Bindings[ctx][cmd], key)
+		}
+	}
+
+	for ctx, bindings := range r.bindings {
+		for _, b := range bindings {
+			if id, ok := r.userOverrides[b.Key]; ok && id != b.Command && r.overrideRunsIn(id, ctx) {
+				continue
+			}
+			add(ctx, b.Command, b.Key)
+		}
+	}
+
+	keys := make([]string, 0, len(r.userOverrides))
+	for k := range r.userOverrides {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		id := r.userOverrides[key]
+		bound := false
+		for ctx, bindings := range r.bindings {
+			if slices.ContainsFunc(bindings, func(b Binding) bool { return b.Command == id }) {
+				add(ctx, id, key)
+				bound = true
+			}
+		}
+		if !bound {
+			add("global", id, key)
+		}
+	}
+	return e
+}
+
+// overrideRunsIn reports whether an override to the command takes effect
+// in the context. Must be called with r.mu held.
+func (r *Registry) overrideRunsIn(commandID, ctx string) bool {
+	cmd, ok := r.commands[commandID]
+	return (ok && cmd.Handler != nil) || r.hasBinding(commandID, ctx)
+}
+
+// ImportOverrides returns the user overrides that give the registry's
+// default bindings the keys of e. Keys that can only run one of several
+// commands are given to the first, by context and command name, and the
+// rest are reported as skipped, as are commands the registry doesn't know.
+func (r *Registry) ImportOverrides(e Export) (overrides map[string]string, skipped []string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	overrides = make(map[string]string)
+	contexts := make([]string, 0, len(e.Bindings))
+	for ctx := range e.Bindings {
+		contexts = append(contexts, ctx)
+	}
+	sort.Strings(contexts)
+	for _, ctx := range contexts {
+		commands := make([]string, 0, len(e.Bindings[ctx]))
+		for cmd := range e.Bindings[ctx] {
+			commands = append(commands, cmd)
+		}
+		sort.Strings(commands)
+		for _, cmd := range commands {
+			if !r.knowsCommand(cmd) {
+				skipped = append(skipped, fmt.Sprintf("%s in %s (unknown command)", cmd, ctx))
+				continue
+			}
+			for _, key := range e.Bindings[ctx][cmd] {
+				if r.bindsByDefault(key, cmd, ctx) {
+					continue
+				}
+				if prev, ok := overrides[key]; ok && prev != cmd {
+					skipped = append(skipped, fmt.Sprintf("%q for %s in %s (already runs %s)", key, cmd, ctx, prev))
+					continue
+				}
+				overrides[key] = cmd
+			}
+		}
+	}
+	return overrides, skipped
+}
+
+// knowsCommand reports whether the command is registered or bound in any
+// context. Must be called with r.mu held.
+func (r *Registry) knowsCommand(commandID string) bool {
+	if _, ok := r.commands[commandID]; ok {
+		return true
+	}
+	for _, bindings := range r.bindings {
+		for _, b := range bindings {
+			if b.Command == commandID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bindsByDefault reports whether key runs the command in the context
+// without overrides. Must be called with r.mu held.
+func (r *Registry) bindsByDefault(key, commandID, ctx string) bool {
+	for _, c := range []string{ctx, "global"} {
+		for _, b := range r.bindings[c] {
+			if b.Key == key && b.Command == commandID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ExportFile writes the effective keymap to a YAML file at path.
+func (r *Registry) ExportFile(path string) error {
+	if !isYAMLFile(path) {
+		return fmt.Errorf("export keymap: %s: must be a .yaml file", path)
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(r.Export()); err != nil {
+		return fmt.Errorf("export keymap: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("export keymap: %w", err)
+	}
+	return nil
+}
+
+// ReadExportFile reads a keymap written by ExportFile.
+func ReadExportFile(path string) (Export, error) {
+	var e Export
+	if !isYAMLFile(path) {
+		return e, fmt.Errorf("import keymap: %s: must be a .yaml file", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return e, fmt.Errorf("import keymap: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &e); err != nil {
+		return e, fmt.Errorf("import keymap: %s: %w", path, err)
+	}
+	if e.Profile != "" && !slices.Contains(ProfileNames(), e.Profile) {
+		return e, fmt.Errorf("import keymap: unknown profile %q (want %s)", e.Profile, strings.Join(ProfileNames(), ", "))
+	}
+	return e, nil
+}
+
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
@@ -0,0 +1,48 @@
+package keymap
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// keyTypes maps the names bubbletea gives special keys, e.g. "enter" or
+// "ctrl+s", to their key types.
+var keyTypes = func() map[string]tea.KeyType {
+	types := make(map[string]tea.KeyType)
+	for t := tea.KeyType(-128); t < 128; t++ {
+		if t == tea.KeyRunes || t == tea.KeySpace {
+			continue
+		}
+		if name := (tea.Key{Type: t}).String(); name != "" {
+			if _, seen := types[name]; !seen {
+				types[name] = t
+			}
+		}
+	}
+	return types
+}()
+
+// ParseKey returns the key message for a single key name as used in
+// bindings, e.g. "j", "G", "space", "ctrl+s", or "alt+enter". It is the
+// inverse of the names Registry matches, so a binding's keys can be sent
+// to a plugin as if typed.
+func ParseKey(name string) tea.KeyMsg {
+	alt := false
+	if rest, ok := strings.CutPrefix(name, "alt+"); ok && rest != "" {
+		alt, name = true, rest
+	}
+	if name == "space" || name == " " {
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}, Alt: alt}
+	}
+	if t, ok := keyTypes[name]; ok {
+		return tea.KeyMsg{Type: t, Alt: alt}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name), Alt: alt}
+}
+
+// KeyString returns the name bindings use for a key press, e.g. "space"
+// or "ctrl+s". It is the inverse of ParseKey.
+func KeyString(key tea.KeyMsg) string {
+	return keyToString(key)
+}
@@ -0,0 +1,122 @@
+package keymap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// profiles are keymap presets layered between plugin bindings and user
+// overrides. Each maps a key or chord to the commands it runs, tried in
+// order until one is bound in the active context. A context that binds the
+// key itself keeps it, so presets never shadow plugin-specific keys.
+var profiles = map[string]map[string][]string{
+	"default": {},
+	"vim": {
+		"ctrl+f":   {"page-down"},
+		"ctrl+b":   {"page-up"},
+		"ctrl+e":   {"scroll-down"},
+		"ctrl+y":   {"scroll-up"},
+		"ctrl+w w": {"switch-pane"},
+		"ctrl+w h": {"focus-left"},
+		"ctrl+w l": {"focus-right"},
+	},
+	"emacs": {
+		"ctrl+n":   {"cursor-down", "scroll-down"},
+		"ctrl+p":   {"cursor-up", "scroll-up"},
+		"ctrl+v":   {"page-down"},
+		"alt+v":    {"page-up"},
+		"alt+<":    {"cursor-top"},
+		"alt+>":    {"cursor-bottom"},
+		"ctrl+f":   {"focus-right"},
+		"ctrl+b":   {"focus-left"},
+		"ctrl+s":   {"search", "search-content", "search-history"},
+		"ctrl+g":   {"cancel", "close", "back"},
+		"ctrl+x o": {"switch-pane"},
+	},
+}
+
+// ProfileNames returns the available keymap profiles, sorted.
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetProfile selects a keymap profile by name; "" selects "default".
+func (r *Registry) SetProfile(name string) error {
+	if name == "" {
+		name = "default"
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return fmt.Errorf("unknown keymap profile %q (want %s)", name, strings.Join(ProfileNames(), ", "))
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.profile = p
+	r.profileName = name
+	return nil
+}
+
+// Profile returns the selected keymap profile's name.
+func (r *Registry) Profile() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.profileName == "" {
+		return "default"
+	}
+	return r.profileName
+}
+
+// findInProfile finds the first of the profile's commands for key that
+// can run in the active context. Must be called with r.mu held.
+func (r *Registry) findInProfile(key, activeContext string) (Resolution, bool) {
+	cmdIDs, ok := r.profile[key]
+	if !ok {
+		return Resolution{}, false
+	}
+	first, _, _ := strings.Cut(key, " ")
+	if r.boundInContext(first, activeContext) {
+		return Resolution{}, false
+	}
+	for _, id := range cmdIDs {
+		if cmd, ok := r.commands[id]; ok && cmd.Handler != nil {
+			return Resolution{Command: id, Keys: key, Cmd: cmd.Handler()}, true
+		}
+		if r.hasBinding(id, activeContext) {
+			return Resolution{Command: id, Keys: key}, true
+		}
+	}
+	return Resolution{}, false
+}
+
+// boundInContext reports whether the active context, not counting global,
+// binds key or a chord starting with it. Must be called with r.mu held.
+func (r *Registry) boundInContext(key, activeContext string) bool {
+	if activeContext == "" || activeContext == "global" {
+		return false
+	}
+	for _, b := range r.bindings[activeContext] {
+		if b.Key == key || strings.HasPrefix(b.Key, key+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBinding reports whether the command is bound in the active context
+// or globally. Must be called with r.mu held.
+func (r *Registry) hasBinding(commandID, activeContext string) bool {
+	for _, ctx := range []string{activeContext, "global"} {
+		for _, b := range r.bindings[ctx] {
+			if b.Command == commandID {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -8,8 +8,13 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// sequenceTimeout is how long a chord waits for its next key by default.
 const sequenceTimeout = 500 * time.Millisecond
 
+// LeaderToken stands for the configured leader key in binding keys, e.g.
+// "<leader> w d".
+const LeaderToken = "<leader>"
+
 // Command represents a registered command handler.
 type Command struct {
 	ID      string
@@ -18,30 +23,101 @@ type Command struct {
 	Context string
 }
 
-// Binding maps a key or key sequence to a command.
+// Binding maps a key or key sequence to a command. A sequence (chord)
+// lists its keys separated by spaces.
 type Binding struct {
-	Key     string // e.g., "tab", "ctrl+s", "g g"
+	Key     string // e.g., "tab", "ctrl+s", "g g", "space w d"
 	Command string // Command ID
 	Context string // "global", plugin ID, etc.
 }
 
 // Registry manages key bindings and command dispatch.
 type Registry struct {
-	commands      map[string]Command  // ID -> Command
+	commands      map[string]Command   // ID -> Command
 	bindings      map[string][]Binding // context -> bindings
-	userOverrides map[string]string   // key -> command ID
-	pendingKey    string
+	userOverrides map[string]string    // key -> command ID
+	pending       []string             // keys of an unfinished chord
 	pendingTime   time.Time
+	held          bool // pending chord doesn't time out, see HoldPending
+	timeout       time.Duration
+	whichKeyDelay time.Duration
+	leader        string
+	profile       map[string][]string // key -> command IDs, see SetProfile
+	profileName   string
 	mu            sync.RWMutex
 }
 
+// Resolution is the outcome of resolving one key press.
+type Resolution struct {
+	// Pending is set when the key continues a chord; the caller should
+	// consume it and wait for the next key.
+	Pending bool
+	// Command is the ID bound to the key or completed chord, when found.
+	Command string
+	// Keys is the key or chord Command is bound to, e.g. "space w d".
+	Keys string
+	// Replay holds the keys of an abandoned chord, to be handled as typed
+	// before this resolution.
+	Replay []string
+	// Cmd is the result of the command's handler, if it has one. A command
+	// without a handler is run by the caller, e.g. by sending the keys of
+	// its default binding to the plugin.
+	Cmd tea.Cmd
+}
+
 // NewRegistry creates a new keymap registry.
 func NewRegistry() *Registry {
 	return &Registry{
 		commands:      make(map[string]Command),
 		bindings:      make(map[string][]Binding),
 		userOverrides: make(map[string]string),
+		timeout:       sequenceTimeout,
+	}
+}
+
+// SetLeader sets the key that LeaderToken stands for in user overrides,
+// e.g. "space" or ",". Call it before SetUserOverride.
+func (r *Registry) SetLeader(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.leader = key
+}
+
+// SetSequenceTimeout sets how long a chord waits for its next key.
+// Non-positive values restore the default.
+func (r *Registry) SetSequenceTimeout(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if d <= 0 {
+		d = sequenceTimeout
 	}
+	r.timeout = d
+}
+
+// SequenceTimeout returns how long a chord waits for its next key.
+func (r *Registry) SequenceTimeout() time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.timeout
+}
+
+// SetWhichKeyDelay sets how long a chord stays pending before its
+// continuations are shown. Zero shows them only on request.
+func (r *Registry) SetWhichKeyDelay(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if d < 0 {
+		d = 0
+	}
+	r.whichKeyDelay = d
+}
+
+// WhichKeyDelay returns how long a chord stays pending before its
+// continuations are shown, or zero when they are shown only on request.
+func (r *Registry) WhichKeyDelay() time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.whichKeyDelay
 }
 
 // RegisterCommand adds a command to the registry.
@@ -64,82 +140,146 @@ func (r *Registry) RegisterPluginBinding(key, command, context string) {
 	r.RegisterBinding(Binding{Key: key, Command: command, Context: context})
 }
 
-// SetUserOverride sets a user-configured key override.
+// SetUserOverride sets a user-configured key override. key may be a chord
+// and may use LeaderToken.
 func (r *Registry) SetUserOverride(key, commandID string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.userOverrides[key] = commandID
+	r.userOverrides[r.expandLeader(key)] = commandID
+}
+
+// RemoveUserOverride removes the user override for key, if any.
+func (r *Registry) RemoveUserOverride(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.userOverrides, r.expandLeader(key))
+}
+
+// UserOverrides returns a copy of the user overrides, key to command ID,
+// with the leader expanded.
+func (r *Registry) UserOverrides() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	overrides := make(map[string]string, len(r.userOverrides))
+	for k, v := range r.userOverrides {
+		overrides[k] = v
+	}
+	return overrides
+}
+
+// expandLeader replaces LeaderToken in key with the leader key and
+// normalizes spacing. Must be called with r.mu held.
+func (r *Registry) expandLeader(key string) string {
+	keys := strings.Fields(key)
+	for i, k := range keys {
+		if k == LeaderToken && r.leader != "" {
+			keys[i] = r.leader
+		}
+	}
+	return strings.Join(keys, " ")
 }
 
 // Handle dispatches a key event to the appropriate command handler.
 // Returns nil if no matching binding is found.
 func (r *Registry) Handle(key tea.KeyMsg, activeContext string) tea.Cmd {
+	return r.Resolve(key, activeContext).Cmd
+}
+
+// Resolve looks up a key press, tracking chords across calls. Chords are
+// recognized for user overrides, the keymap profile, and bindings whose
+// command has a handler; other bindings describe keys plugins interpret
+// themselves, so their prefixes are not consumed. A key that doesn't
+// continue the pending chord abandons it and is resolved on its own, with
+// the abandoned keys returned in Replay.
+func (r *Registry) Resolve(key tea.KeyMsg, activeContext string) Resolution {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	keyStr := keyToString(key)
 
-	// Check for pending key sequence
-	if r.pendingKey != "" {
-		if time.Since(r.pendingTime) < sequenceTimeout {
-			seq := r.pendingKey + " " + keyStr
-			r.pendingKey = ""
-			if cmd := r.findCommand(seq, activeContext); cmd != nil {
-				return cmd
-			}
-			// Sequence didn't match, try just the new key
-		} else {
-			r.pendingKey = ""
+	defer func() {
+		if len(r.pending) == 0 {
+			r.held = false
+		}
+	}()
+
+	var abandoned []string
+	if r.expired() {
+		abandoned, r.pending = r.pending, nil
+	}
+	if len(r.pending) > 0 {
+		seq := strings.Join(r.pending, " ") + " " + keyStr
+		if r.isSequenceStart(seq, activeContext) {
+			r.pending = append(r.pending, keyStr)
+			r.pendingTime = time.Now()
+			return Resolution{Pending: true}
+		}
+		abandoned, r.pending = r.pending, nil
+		if res := r.lookup(seq, activeContext); res.Command != "" {
+			return res
 		}
 	}
 
-	// Check if this key starts a sequence
+	var res Resolution
 	if r.isSequenceStart(keyStr, activeContext) {
-		r.pendingKey = keyStr
+		r.pending = []string{keyStr}
 		r.pendingTime = time.Now()
-		return nil
+		res.Pending = true
+	} else {
+		res = r.lookup(keyStr, activeContext)
 	}
-
-	return r.findCommand(keyStr, activeContext)
+	res.Replay = abandoned
+	return res
 }
 
-// findCommand looks up a command for the given key in order of precedence.
-func (r *Registry) findCommand(key, activeContext string) tea.Cmd {
-	// 1. Check user overrides first
+// lookup finds the command for a key or chord in order of precedence:
+// user overrides, the active context, the profile, then global bindings.
+// Must be called with r.mu held.
+func (r *Registry) lookup(key, activeContext string) Resolution {
 	if cmdID, ok := r.userOverrides[key]; ok {
+		res := Resolution{Command: cmdID, Keys: key}
 		if cmd, ok := r.commands[cmdID]; ok && cmd.Handler != nil {
-			return cmd.Handler()
+			res.Cmd = cmd.Handler()
 		}
+		return res
 	}
 
-	// 2. Check active context bindings
 	if activeContext != "" && activeContext != "global" {
-		if cmd, found := r.findInContext(key, activeContext); found {
-			return cmd
+		if res, found := r.findInContext(key, activeContext); found {
+			return res
 		}
 	}
-
-	// 3. Fall back to global bindings
-	cmd, _ := r.findInContext(key, "global")
-	return cmd
+	if res, found := r.findInProfile(key, activeContext); found {
+		return res
+	}
+	res, _ := r.findInContext(key, "global")
+	return res
 }
 
-// findInContext finds a command for a key in a specific context.
-// Returns the command result and whether a binding was found.
-func (r *Registry) findInContext(key, context string) (tea.Cmd, bool) {
+// findInContext finds a command for a key in a specific context: one with
+// a handler, or any command for a chord, since a chord only completes here
+// when its prefix was consumed. Returns the resolution and whether a
+// binding was found.
+func (r *Registry) findInContext(key, context string) (Resolution, bool) {
+	chord := strings.Contains(key, " ")
 	for _, b := range r.bindings[context] {
-		if b.Key == key {
-			if cmd, ok := r.commands[b.Command]; ok && cmd.Handler != nil {
-				return cmd.Handler(), true
-			}
+		if b.Key != key {
+			continue
+		}
+		if cmd, ok := r.commands[b.Command]; ok && cmd.Handler != nil {
+			return Resolution{Command: b.Command, Keys: key, Cmd: cmd.Handler()}, true
+		}
+		if chord {
+			return Resolution{Command: b.Command, Keys: key}, true
 		}
 	}
-	return nil, false
+	return Resolution{}, false
 }
 
-// isSequenceStart checks if this key could start a multi-key sequence.
-func (r *Registry) isSequenceStart(key, activeContext string) bool {
-	prefix := key + " "
+// isSequenceStart checks if keys, one key or an unfinished chord, is the
+// beginning of a longer chord.
+func (r *Registry) isSequenceStart(keys, activeContext string) bool {
+	prefix := keys + " "
 
 	// Check all contexts that could be active
 	contexts := []string{"global"}
@@ -149,7 +289,10 @@ func (r *Registry) isSequenceStart(key, activeContext string) bool {
 
 	for _, ctx := range contexts {
 		for _, b := range r.bindings[ctx] {
-			if strings.HasPrefix(b.Key, prefix) {
+			if !strings.HasPrefix(b.Key, prefix) {
+				continue
+			}
+			if cmd, ok := r.commands[b.Command]; ok && cmd.Handler != nil {
 				return true
 			}
 		}
@@ -162,6 +305,17 @@ func (r *Registry) isSequenceStart(key, activeContext string) bool {
 		}
 	}
 
+	// Profile chords yield to a context that binds their first key
+	first, _, _ := strings.Cut(keys, " ")
+	if r.boundInContext(first, activeContext) {
+		return false
+	}
+	for k := range r.profile {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -169,7 +323,102 @@ func (r *Registry) isSequenceStart(key, activeContext string) bool {
 func (r *Registry) ResetPending() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.pendingKey = ""
+	r.pending = nil
+	r.held = false
+}
+
+// HoldPending keeps the pending chord from timing out until it completes
+// or is abandoned, e.g. while its continuations are shown.
+func (r *Registry) HoldPending() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pending) > 0 {
+		r.held = true
+	}
+}
+
+// expired reports whether the pending chord timed out. Must be called
+// with r.mu held.
+func (r *Registry) expired() bool {
+	return len(r.pending) > 0 && !r.held && time.Since(r.pendingTime) >= r.timeout
+}
+
+// ExpirePending ends the pending chord once its timeout passes. When the
+// keys typed so far are themselves bound, e.g. "space w" alongside
+// "space w d", their command is returned; otherwise they are returned in
+// Replay.
+func (r *Registry) ExpirePending(activeContext string) Resolution {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pending) == 0 {
+		return Resolution{}
+	}
+	pending := r.pending
+	r.pending = nil
+	r.held = false
+	res := r.lookup(strings.Join(pending, " "), activeContext)
+	if res.Command == "" {
+		res.Replay = pending
+	}
+	return res
+}
+
+// Pending returns the keys of the unfinished chord, or nil when none is
+// pending or it timed out.
+func (r *Registry) Pending() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.pending) == 0 || r.expired() {
+		return nil
+	}
+	return append([]string(nil), r.pending...)
+}
+
+// KeysForCommand returns the keys of the command's default binding in the
+// active context, falling back to global, split into individual key
+// presses. Returns nil when the command has no binding there.
+func (r *Registry) KeysForCommand(commandID, activeContext string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	contexts := []string{"global"}
+	if activeContext != "" && activeContext != "global" {
+		contexts = []string{activeContext, "global"}
+	}
+	for _, ctx := range contexts {
+		for _, b := range r.bindings[ctx] {
+			if b.Command == commandID {
+				return strings.Fields(b.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// CommandsForKey returns the distinct commands key may run in context: the
+// user override for it first, then the context's bindings. More than one
+// means the bindings conflict.
+func (r *Registry) CommandsForKey(key, context string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key = r.expandLeader(key)
+	var cmds []string
+	add := func(id string) {
+		for _, c := range cmds {
+			if c == id {
+				return
+			}
+		}
+		cmds = append(cmds, id)
+	}
+	if id, ok := r.userOverrides[key]; ok {
+		add(id)
+	}
+	for _, b := range r.bindings[context] {
+		if b.Key == key {
+			add(b.Command)
+		}
+	}
+	return cmds
 }
 
 // GetCommand retrieves a command by ID.
@@ -203,11 +452,14 @@ func (r *Registry) AllContexts() []string {
 func (r *Registry) HasPending() bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	return r.pendingKey != "" && time.Since(r.pendingTime) < sequenceTimeout
+	return len(r.pending) > 0 && !r.expired()
 }
 
 // keyToString converts a tea.KeyMsg to a string representation.
 func keyToString(key tea.KeyMsg) string {
+	if key.Alt {
+		return key.String() // e.g. "alt+v"
+	}
 	switch key.Type {
 	case tea.KeyCtrlC:
 		return "ctrl+c"
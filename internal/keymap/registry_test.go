@@ -1,6 +1,9 @@
 package keymap
 
 import (
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -220,3 +223,284 @@ func TestRegistry_GetCommand(t *testing.T) {
 		t.Error("GetCommand should return false for missing command")
 	}
 }
+
+func TestRegistry_LeaderChord(t *testing.T) {
+	r := NewRegistry()
+	r.SetLeader("space")
+	r.SetUserOverride("<leader> w  d", "delete-worktree")
+	r.RegisterBinding(Binding{Key: "w", Command: "switch-pane", Context: "global"})
+
+	if res := r.Resolve(ParseKey("space"), "workspace-list"); !res.Pending {
+		t.Fatalf("space: %+v, want pending", res)
+	}
+	if res := r.Resolve(ParseKey("w"), "workspace-list"); !res.Pending {
+		t.Fatalf("space w: %+v, want pending", res)
+	}
+	if got := r.Pending(); len(got) != 2 || got[0] != "space" || got[1] != "w" {
+		t.Errorf("Pending = %v", got)
+	}
+	res := r.Resolve(ParseKey("d"), "workspace-list")
+	if res.Pending || res.Command != "delete-worktree" || res.Cmd != nil {
+		t.Errorf("space w d: %+v", res)
+	}
+	if r.HasPending() {
+		t.Error("chord should be finished")
+	}
+
+	// A key that doesn't continue the chord abandons it and resolves alone
+	r.SetUserOverride("x", "close")
+	r.Resolve(ParseKey("space"), "global")
+	if res := r.Resolve(ParseKey("x"), "global"); res.Pending || res.Command != "close" {
+		t.Errorf("space x: %+v", res)
+	}
+}
+
+func TestRegistry_ExpirePending(t *testing.T) {
+	r := NewRegistry()
+	r.SetUserOverride("space w", "switch-pane")
+	r.SetUserOverride("space w d", "delete-worktree")
+
+	r.Resolve(ParseKey("space"), "global")
+	if res := r.Resolve(ParseKey("w"), "global"); !res.Pending {
+		t.Fatalf("space w should wait for a longer chord: %+v", res)
+	}
+	if res := r.ExpirePending("global"); res.Command != "switch-pane" {
+		t.Errorf("ExpirePending = %+v", res)
+	}
+	if res := r.ExpirePending("global"); res.Command != "" {
+		t.Errorf("second ExpirePending = %+v", res)
+	}
+}
+
+func TestRegistry_DisplayOnlySequences(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterBinding(Binding{Key: "g g", Command: "cursor-top", Context: "global"})
+
+	// Plugins handle "g g" themselves, so "g" is not consumed
+	if res := r.Resolve(ParseKey("g"), "global"); res.Pending || res.Command != "" {
+		t.Errorf("g: %+v", res)
+	}
+	if keys := r.KeysForCommand("cursor-top", "git-status"); len(keys) != 2 || keys[0] != "g" {
+		t.Errorf("KeysForCommand = %v", keys)
+	}
+	if keys := r.KeysForCommand("missing", "global"); keys != nil {
+		t.Errorf("KeysForCommand(missing) = %v", keys)
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	for _, name := range []string{"j", "G", "?", "space", "enter", "esc", "tab", "shift+tab", "ctrl+s", "ctrl+k", "up", "pgdown", "alt+enter", "alt+x"} {
+		if got := keyToString(ParseKey(name)); got != name && ParseKey(name).String() != name {
+			t.Errorf("ParseKey(%q) round trip = %q", name, got)
+		}
+	}
+}
+
+func TestRegistry_Profiles(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+	if err := r.SetProfile("nano"); err == nil {
+		t.Error("expected unknown profile error")
+	}
+	if err := r.SetProfile("emacs"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Presets pick the first of their commands bound in the context
+	if res := r.Resolve(ParseKey("ctrl+g"), "workspace-preview"); res.Command != "back" {
+		t.Errorf("ctrl+g in preview = %+v", res)
+	}
+	if res := r.Resolve(ParseKey("alt+>"), "git-status"); res.Command != "cursor-bottom" {
+		t.Errorf("alt+> = %+v", res)
+	}
+	// A context binding the key keeps it
+	if res := r.Resolve(ParseKey("ctrl+s"), "git-commit"); res.Command != "" {
+		t.Errorf("ctrl+s in commit = %+v", res)
+	}
+
+	r.Resolve(ParseKey("ctrl+x"), "git-status")
+	if res := r.Resolve(ParseKey("o"), "git-status"); res.Command != "switch-pane" {
+		t.Errorf("ctrl+x o = %+v", res)
+	}
+	// An abandoned chord's keys are replayed
+	r.Resolve(ParseKey("ctrl+x"), "git-status")
+	if res := r.Resolve(ParseKey("j"), "git-status"); len(res.Replay) != 1 || res.Replay[0] != "ctrl+x" {
+		t.Errorf("ctrl+x j = %+v", res)
+	}
+
+	// User overrides win over the profile
+	r.SetUserOverride("ctrl+g", "quit")
+	if res := r.Resolve(ParseKey("ctrl+g"), "workspace-preview"); res.Command != "quit" {
+		t.Errorf("overridden ctrl+g = %+v", res)
+	}
+}
+
+func TestRegistry_CommandsForKey(t *testing.T) {
+	r := NewRegistry()
+	r.SetLeader("space")
+	r.RegisterBinding(Binding{Key: "d", Command: "delete", Context: "files"})
+	r.RegisterBinding(Binding{Key: "d", Command: "diff", Context: "files"})
+	r.SetUserOverride("<leader> d", "delete")
+	r.SetUserOverride("d", "quit")
+
+	if got := r.CommandsForKey("d", "files"); len(got) != 3 || got[0] != "quit" || got[1] != "delete" || got[2] != "diff" {
+		t.Errorf("CommandsForKey(d) = %v", got)
+	}
+	if got := r.CommandsForKey("<leader> d", "files"); len(got) != 1 || got[0] != "delete" {
+		t.Errorf("CommandsForKey(<leader> d) = %v", got)
+	}
+
+	r.RemoveUserOverride("<leader> d")
+	if overrides := r.UserOverrides(); len(overrides) != 1 || overrides["d"] != "quit" {
+		t.Errorf("UserOverrides = %v", overrides)
+	}
+	if got := KeyString(ParseKey("space")); got != "space" {
+		t.Errorf("KeyString(space) = %q", got)
+	}
+}
+
+func TestRegistry_Continuations(t *testing.T) {
+	r := NewRegistry()
+	r.SetLeader("space")
+	r.SetSequenceTimeout(time.Millisecond)
+	r.RegisterCommand(Command{ID: "stage-file", Handler: func() tea.Cmd { return nil }})
+	r.RegisterBinding(Binding{Key: "space g s", Command: "stage-file", Context: "git-status"})
+	r.SetUserOverride("<leader> w d", "delete-workspace")
+	r.SetUserOverride("<leader> w", "new-workspace")
+	r.SetUserOverride("<leader> t", "switch-theme")
+
+	if conts := r.Continuations("git-status"); conts != nil {
+		t.Errorf("continuations without a chord = %v", conts)
+	}
+	r.Resolve(ParseKey("space"), "git-status")
+	r.HoldPending()
+	time.Sleep(5 * time.Millisecond)
+
+	// Held chords outlive the timeout
+	conts := r.Continuations("git-status")
+	want := []Continuation{
+		{Key: "g", Prefix: true},
+		{Key: "t", Command: "switch-theme"},
+		{Key: "w", Command: "new-workspace", Prefix: true},
+	}
+	if len(conts) != len(want) {
+		t.Fatalf("continuations = %+v", conts)
+	}
+	for i := range want {
+		if conts[i] != want[i] {
+			t.Errorf("continuation %d = %+v, want %+v", i, conts[i], want[i])
+		}
+	}
+	// Another context doesn't offer the git chord
+	if conts := r.Continuations("files"); len(conts) != 2 {
+		t.Errorf("continuations in files = %+v", conts)
+	}
+
+	if res := r.Resolve(ParseKey("t"), "git-status"); res.Command != "switch-theme" {
+		t.Errorf("space t = %+v", res)
+	}
+	// Completing the chord releases the hold
+	r.Resolve(ParseKey("space"), "git-status")
+	time.Sleep(5 * time.Millisecond)
+	if r.HasPending() {
+		t.Error("new chord should time out again")
+	}
+}
+
+func TestRegistry_Conflicts(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterBinding(Binding{Key: "x", Command: "delete", Context: "files"})
+	r.RegisterBinding(Binding{Key: "x", Command: "discard", Context: "git-status"})
+	r.RegisterBinding(Binding{Key: "s", Command: "stage-file", Context: "git-status"})
+	r.RegisterBinding(Binding{Key: "r", Command: "refresh", Context: "files"})
+	r.RegisterBinding(Binding{Key: "ctrl+r", Command: "refresh", Context: "files"})
+	r.SetUserOverride("x", "stage-file")   // shadows discard only where stage-file runs
+	r.SetUserOverride("ctrl+r", "refresh") // same command, no conflict
+
+	conflicts := r.Conflicts()
+	if len(conflicts) != 1 {
+		t.Fatalf("conflicts = %+v", conflicts)
+	}
+	c := conflicts[0]
+	if c.Key != "x" || c.Context != "git-status" || c.Command != "stage-file" || len(c.Shadowed) != 1 || c.Shadowed[0] != "discard" {
+		t.Errorf("conflict = %+v", c)
+	}
+	if got, want := c.String(), `"x" runs stage-file instead of discard in git-status`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	// A command with a handler runs everywhere, so it shadows every binding
+	r.RegisterCommand(Command{ID: "stage-file", Handler: func() tea.Cmd { return nil }})
+	if conflicts := r.Conflicts(); len(conflicts) != 2 || conflicts[0].Context != "files" {
+		t.Errorf("conflicts with handler = %+v", conflicts)
+	}
+}
+
+func TestRegistry_ExportImport(t *testing.T) {
+	defaults := func() *Registry {
+		r := NewRegistry()
+		r.RegisterBinding(Binding{Key: "q", Command: "quit", Context: "global"})
+		r.RegisterBinding(Binding{Key: "x", Command: "delete", Context: "files"})
+		r.RegisterBinding(Binding{Key: "x", Command: "discard", Context: "git-status"})
+		r.RegisterBinding(Binding{Key: "s", Command: "stage-file", Context: "git-status"})
+		return r
+	}
+
+	r := defaults()
+	if err := r.SetProfile("vim"); err != nil {
+		t.Fatal(err)
+	}
+	r.SetLeader("space")
+	r.SetUserOverride("x", "stage-file") // shadows discard, not delete
+	r.SetUserOverride("<leader> q", "quit")
+
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	if err := r.ExportFile(path); err != nil {
+		t.Fatal(err)
+	}
+	e, err := ReadExportFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Profile != "vim" || e.Leader != "space" {
+		t.Errorf("profile = %q, leader = %q", e.Profile, e.Leader)
+	}
+	want := map[string]map[string][]string{
+		"global":     {"quit": {"q", "space q"}},
+		"files":      {"delete": {"x"}},
+		"git-status": {"stage-file": {"s", "x"}},
+	}
+	if !reflect.DeepEqual(e.Bindings, want) {
+		t.Errorf("bindings = %v, want %v", e.Bindings, want)
+	}
+
+	// Importing into the defaults recreates the overrides
+	overrides, skipped := defaults().ImportOverrides(e)
+	if !reflect.DeepEqual(overrides, map[string]string{"x": "stage-file", "space q": "quit"}) || len(skipped) != 0 {
+		t.Errorf("overrides = %v, skipped = %v", overrides, skipped)
+	}
+
+	// A key can only be overridden to one command; unknown commands are skipped
+	e.Bindings["files"]["delete"] = []string{"d"}
+	e.Bindings["git-status"]["discard"] = []string{"d"}
+	e.Bindings["files"]["rename"] = []string{"R"}
+	_, skipped = defaults().ImportOverrides(e)
+	if len(skipped) != 2 || !strings.Contains(skipped[0], "rename in files") || !strings.Contains(skipped[1], `"d" for discard`) {
+		t.Errorf("skipped = %v", skipped)
+	}
+
+	if _, err := ReadExportFile(filepath.Join(t.TempDir(), "team.json")); err == nil {
+		t.Error("expected an error for a non-YAML file")
+	}
+}
+
+func TestDefaultBindings_OneCommandPerKey(t *testing.T) {
+	seen := make(map[[2]string]string)
+	for _, b := range DefaultBindings() {
+		k := [2]string{b.Context, b.Key}
+		if prev, ok := seen[k]; ok && prev != b.Command {
+			t.Errorf("%q in %s runs both %s and %s", b.Key, b.Context, prev, b.Command)
+		}
+		seen[k] = b.Command
+	}
+}
@@ -0,0 +1,68 @@
+package keymap
+
+import (
+	"sort"
+	"strings"
+)
+
+// Continuation is a key that continues the pending chord.
+type Continuation struct {
+	Key string // next key, e.g. "d"
+	// Command is what the chord ending in Key runs. It is empty when Key
+	// only leads to longer chords.
+	Command string
+	// Prefix is set when longer chords continue after Key.
+	Prefix bool
+}
+
+// Continuations lists the keys that continue the pending chord in the
+// active context, sorted by key. Each is resolved with the same
+// precedence as Resolve. Returns nil when no chord is pending.
+func (r *Registry) Continuations(activeContext string) []Continuation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.pending) == 0 || r.expired() {
+		return nil
+	}
+	prefix := strings.Join(r.pending, " ") + " "
+
+	// Every key or chord that could follow the prefix
+	candidates := make([]string, 0, len(r.userOverrides)+len(r.profile))
+	for k := range r.userOverrides {
+		candidates = append(candidates, k)
+	}
+	for k := range r.profile {
+		candidates = append(candidates, k)
+	}
+	for _, ctx := range []string{activeContext, "global"} {
+		for _, b := range r.bindings[ctx] {
+			candidates = append(candidates, b.Key)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var conts []Continuation
+	for _, k := range candidates {
+		rest, ok := strings.CutPrefix(k, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		next, _, _ := strings.Cut(rest, " ")
+		if seen[next] {
+			continue
+		}
+		seen[next] = true
+
+		seq := prefix + next
+		c := Continuation{
+			Key:     next,
+			Command: r.lookup(seq, activeContext).Command,
+			Prefix:  r.isSequenceStart(seq, activeContext),
+		}
+		if c.Command != "" || c.Prefix {
+			conts = append(conts, c)
+		}
+	}
+	sort.Slice(conts, func(i, j int) bool { return conts[i].Key < conts[j].Key })
+	return conts
+}
@@ -73,7 +73,7 @@ func (s *inputSection) Render(contentWidth int, focusID, hoverID string) Rendere
 
 	// Render label if present
 	if s.label != "" {
-		sb.WriteString(styles.Body.Render(s.label))
+		sb.WriteString(styles.Current().Body.Render(s.label))
 		sb.WriteString("\n")
 		labelLines = 1
 	}
@@ -102,17 +102,17 @@ func (s *inputSection) Render(contentWidth int, focusID, hoverID string) Rendere
 	if isFocused {
 		inputStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.Primary).
+			BorderForeground(styles.Current().Primary).
 			Width(inputBoxWidth)
 	} else if s.id == hoverID {
 		inputStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.TextMuted).
+			BorderForeground(styles.Current().TextMuted).
 			Width(inputBoxWidth)
 	} else {
 		inputStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.BorderNormal).
+			BorderForeground(styles.Current().BorderNormal).
 			Width(inputBoxWidth)
 	}
 
@@ -215,7 +215,7 @@ func (s *textareaSection) Render(contentWidth int, focusID, hoverID string) Rend
 
 	// Render label if present
 	if s.label != "" {
-		sb.WriteString(styles.Body.Render(s.label))
+		sb.WriteString(styles.Current().Body.Render(s.label))
 		sb.WriteString("\n")
 		labelLines = 1
 	}
@@ -245,17 +245,17 @@ func (s *textareaSection) Render(contentWidth int, focusID, hoverID string) Rend
 	if isFocused {
 		areaStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.Primary).
+			BorderForeground(styles.Current().Primary).
 			Width(textareaBoxWidth)
 	} else if s.id == hoverID {
 		areaStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.TextMuted).
+			BorderForeground(styles.Current().TextMuted).
 			Width(textareaBoxWidth)
 	} else {
 		areaStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(styles.BorderNormal).
+			BorderForeground(styles.Current().BorderNormal).
 			Width(textareaBoxWidth)
 	}
 
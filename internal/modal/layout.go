@@ -146,7 +146,7 @@ func (m *Modal) buildLayout(screenW, screenH int, handler *mouse.Handler) string
 	// Inner elements with ANSI resets clear the parent's background, leaving
 	// terminal-default black for the remaining width. Explicitly padding each
 	// line with BgSecondary ensures a uniform background.
-	viewport = styles.FillBackground(viewport, contentWidth, styles.BgSecondary)
+	viewport = styles.FillBackground(viewport, contentWidth, styles.Current().BgSecondary)
 
 	// 6. Build modal content
 	var inner strings.Builder
@@ -257,8 +257,8 @@ func renderScrollbar(totalItems, scrollOffset, viewportHeight int) string {
 		thumbPos = viewportHeight - thumbSize
 	}
 
-	trackStyle := lipgloss.NewStyle().Foreground(styles.ScrollbarTrackColor).Background(styles.BgSecondary)
-	thumbStyle := lipgloss.NewStyle().Foreground(styles.ScrollbarThumbColor).Background(styles.BgSecondary)
+	trackStyle := lipgloss.NewStyle().Foreground(styles.Current().ScrollbarTrackColor).Background(styles.Current().BgSecondary)
+	thumbStyle := lipgloss.NewStyle().Foreground(styles.Current().ScrollbarThumbColor).Background(styles.Current().BgSecondary)
 
 	trackChar := trackStyle.Render("│") // │
 	thumbChar := thumbStyle.Render("┃") // ┃
@@ -277,41 +277,41 @@ func renderScrollbar(totalItems, scrollOffset, viewportHeight int) string {
 
 // modalStyle returns the lipgloss style for the modal box based on variant.
 func (m *Modal) modalStyle(width int) lipgloss.Style {
-	borderColor := styles.Primary
+	borderColor := styles.Current().Primary
 	switch m.variant {
 	case VariantDanger:
-		borderColor = styles.Error
+		borderColor = styles.Current().Error
 	case VariantWarning:
-		borderColor = styles.Warning
+		borderColor = styles.Current().Warning
 	case VariantInfo:
-		borderColor = styles.Info
+		borderColor = styles.Current().Info
 	}
 
 	return lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(borderColor).
-		Background(styles.BgSecondary).
+		Background(styles.Current().BgSecondary).
 		Padding(1, 2).
 		Width(width)
 }
 
 // renderTitleLine renders the modal title.
 func renderTitleLine(title string, variant Variant) string {
-	titleStyle := styles.ModalTitle
+	titleStyle := styles.Current().ModalTitle
 	switch variant {
 	case VariantDanger:
-		titleStyle = titleStyle.Foreground(styles.Error)
+		titleStyle = titleStyle.Foreground(styles.Current().Error)
 	case VariantWarning:
-		titleStyle = titleStyle.Foreground(styles.Warning)
+		titleStyle = titleStyle.Foreground(styles.Current().Warning)
 	case VariantInfo:
-		titleStyle = titleStyle.Foreground(styles.Info)
+		titleStyle = titleStyle.Foreground(styles.Current().Info)
 	}
 	return titleStyle.Render(title)
 }
 
 // renderHintLine renders the keyboard hint line.
 func renderHintLine() string {
-	return styles.Muted.Render("Tab to switch \u00b7 Enter to confirm \u00b7 Esc to cancel")
+	return styles.Current().Muted.Render("Tab to switch \u00b7 Enter to confirm \u00b7 Esc to cancel")
 }
 
 // hintLines returns the number of lines the hint takes (0 if hidden, 1 if shown).
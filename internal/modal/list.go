@@ -74,7 +74,7 @@ func WithPerItemFocus() ListOption {
 
 func (s *listSection) Render(contentWidth int, focusID, hoverID string) RenderedSection {
 	if len(s.items) == 0 {
-		return RenderedSection{Content: styles.Muted.Render("(no items)")}
+		return RenderedSection{Content: styles.Current().Muted.Render("(no items)")}
 	}
 
 	// Determine visible range
@@ -114,20 +114,20 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 		// Determine style
 		var style lipgloss.Style
 		if isSelected {
-			style = styles.ListItemFocused
+			style = styles.Current().ListItemFocused
 		} else if isHovered {
-			style = styles.ListItemSelected
+			style = styles.Current().ListItemSelected
 		} else {
-			style = styles.ListItemNormal
+			style = styles.Current().ListItemNormal
 		}
 
 		// Render cursor - show when selected, or when list has focus and this is selected item
 		cursor := "  "
 		if isSelected {
 			if listHasFocus {
-				cursor = styles.ListCursor.Render("▸ ") // Filled cursor when list has focus
+				cursor = styles.Current().ListCursor.Render("▸ ") // Filled cursor when list has focus
 			} else {
-				cursor = styles.ListCursor.Render("> ")
+				cursor = styles.Current().ListCursor.Render("> ")
 			}
 		}
 
@@ -165,14 +165,14 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 	content := sb.String()
 	hasTopIndicator := s.scrollOffset > 0
 	if hasTopIndicator {
-		content = styles.Muted.Render("\u2191 more above") + "\n" + content
+		content = styles.Current().Muted.Render("\u2191 more above") + "\n" + content
 		// Adjust focusable offsets since we prepended a line
 		for i := range focusables {
 			focusables[i].OffsetY++
 		}
 	}
 	if s.scrollOffset+visibleCount < len(s.items) {
-		content = content + "\n" + styles.Muted.Render("\u2193 more below")
+		content = content + "\n" + styles.Current().Muted.Render("\u2193 more below")
 	}
 
 	return RenderedSection{
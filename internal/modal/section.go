@@ -231,21 +231,21 @@ func (b *buttonsSection) resolveStyle(btn ButtonDef, focusID, hoverID string) li
 
 	if btn.IsDanger {
 		if isFocused {
-			return styles.ButtonDangerFocused
+			return styles.Current().ButtonDangerFocused
 		}
 		if isHovered {
-			return styles.ButtonDangerHover
+			return styles.Current().ButtonDangerHover
 		}
-		return styles.ButtonDanger
+		return styles.Current().ButtonDanger
 	}
 
 	if isFocused {
-		return styles.ButtonFocused
+		return styles.Current().ButtonFocused
 	}
 	if isHovered {
-		return styles.ButtonHover
+		return styles.Current().ButtonHover
 	}
-	return styles.Button
+	return styles.Current().Button
 }
 
 func (b *buttonsSection) Update(msg tea.Msg, focusID string) (string, tea.Cmd) {
@@ -290,11 +290,11 @@ func (c *checkboxSection) Render(contentWidth int, focusID, hoverID string) Rend
 
 	var style lipgloss.Style
 	if isFocused {
-		style = styles.ButtonFocused
+		style = styles.Current().ButtonFocused
 	} else if isHovered {
-		style = styles.ButtonHover
+		style = styles.Current().ButtonHover
 	} else {
-		style = styles.Button
+		style = styles.Current().Button
 	}
 
 	content := style.Render(box + " " + c.label)
@@ -357,11 +357,11 @@ func (c *checkboxDisplaySection) Render(contentWidth int, focusID, hoverID strin
 	}
 
 	// Always use muted style since it's not focusable
-	content := styles.Muted.Render(box + " " + c.label)
+	content := styles.Current().Muted.Render(box + " " + c.label)
 
 	// Add hint if provided
 	if c.hint != "" {
-		hintText := styles.Muted.Render(" (" + c.hint + ")")
+		hintText := styles.Current().Muted.Render(" (" + c.hint + ")")
 		content += hintText
 	}
 
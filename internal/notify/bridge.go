@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"log/slog"
+	"path/filepath"
+	"slices"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/event"
+)
+
+// StartBridge starts the event bridge cfg.Bridge describes, or returns nil
+// when it is disabled. origin labels this instance's events at the hub,
+// e.g. the project name, and stateDir holds the default socket.
+func StartBridge(d *event.Dispatcher, events config.EventsConfig, origin, stateDir string, logger *slog.Logger) (*event.Bridge, error) {
+	cfg := events.Bridge
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	socket := config.ExpandPath(cfg.Socket)
+	if socket == "" && stateDir != "" {
+		socket = filepath.Join(stateDir, "events.sock")
+	}
+	types := DefaultWebhookEvents
+	if len(cfg.Events) > 0 {
+		types = make([]event.Type, len(cfg.Events))
+		for i, name := range cfg.Events {
+			types[i] = event.Type(name)
+		}
+	}
+	types = withWebhookTypes(types, events.Webhooks)
+	return event.NewBridge(d, event.BridgeOptions{Socket: socket, Origin: origin, Types: types, Logger: logger})
+}
+
+// withWebhookTypes adds the event types the webhooks send to types. An
+// instance connected to a hub leaves posting to it, so the hub must
+// receive every event a webhook would post.
+func withWebhookTypes(types []event.Type, hooks []config.WebhookConfig) []event.Type {
+	types = slices.Clone(types)
+	for _, h := range hooks {
+		hookTypes := DefaultWebhookEvents
+		if len(h.Events) > 0 {
+			hookTypes = make([]event.Type, len(h.Events))
+			for i, name := range h.Events {
+				hookTypes[i] = event.Type(name)
+			}
+		}
+		for _, t := range hookTypes {
+			if !slices.Contains(types, t) {
+				types = append(types, t)
+			}
+		}
+	}
+	return types
+}
@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/event"
+)
+
+func TestWithWebhookTypes(t *testing.T) {
+	types := []event.Type{event.TypeTimer}
+	got := withWebhookTypes(types, []config.WebhookConfig{
+		{URL: "https://example.com/a", Events: []string{"merge_completed"}},
+		{URL: "https://example.com/b"},
+	})
+	want := append([]event.Type{event.TypeTimer}, event.TypeMergeCompleted)
+	for _, typ := range DefaultWebhookEvents {
+		if !slices.Contains(want, typ) {
+			want = append(want, typ)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("types = %v, want %v", got, want)
+	}
+	if len(types) != 1 {
+		t.Errorf("input modified: %v", types)
+	}
+}
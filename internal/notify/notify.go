@@ -0,0 +1,42 @@
+// Package notify sends desktop notifications through the platform's
+// notification tool, and forwards event bus events to webhooks and to
+// other forge instances.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnsupported is returned when no notification tool is available.
+var ErrUnsupported = errors.New("desktop notifications not supported")
+
+// Desktop shows a desktop notification. It uses osascript on macOS and
+// notify-send on Linux, and returns ErrUnsupported elsewhere or when the
+// tool is not installed.
+func Desktop(title, body string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux":
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return ErrUnsupported
+		}
+		cmd = exec.Command("notify-send", "--app-name=forge", title, body)
+	default:
+		return ErrUnsupported
+	}
+	return cmd.Run()
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
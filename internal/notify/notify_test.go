@@ -0,0 +1,11 @@
+package notify
+
+import "testing"
+
+func TestAppleScriptString(t *testing.T) {
+	got := appleScriptString(`say "hi" \ bye`)
+	want := `"say \"hi\" \\ bye"`
+	if got != want {
+		t.Errorf("appleScriptString = %s, want %s", got, want)
+	}
+}
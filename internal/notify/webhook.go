@@ -0,0 +1,221 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/event"
+)
+
+const (
+	// webhookTimeout bounds each webhook request.
+	webhookTimeout = 10 * time.Second
+	// webhookBuffer is how many events may wait for a slow endpoint before
+	// newer ones are dropped.
+	webhookBuffer = 32
+)
+
+// DefaultWebhookEvents are sent by a webhook that lists no events.
+var DefaultWebhookEvents = []event.Type{
+	event.TypeAgentCompleted,
+	event.TypeMergeCompleted,
+	event.TypeSessionError,
+}
+
+// defaultWebhookTemplate is the body sent by a webhook without a template.
+const defaultWebhookTemplate = `{"event": {{json .Type}}, "topic": {{json .Topic}}, "time": {{json .Time}}, "text": {{json .Text}}, "data": {{json .Data}}}`
+
+// Webhook forwards selected event bus events to a URL as JSON rendered
+// from a template.
+type Webhook struct {
+	url    string
+	types  []event.Type
+	tmpl   *template.Template
+	client *http.Client
+	logger *slog.Logger
+}
+
+// webhookData is what a webhook template sees.
+type webhookData struct {
+	Type  event.Type
+	Topic string
+	Time  time.Time
+	Text  string // one-line summary, see Summary
+	Data  any
+}
+
+// NewWebhook validates cfg and returns its webhook. The URL must be http
+// or https, the events known, and the template parseable.
+func NewWebhook(cfg config.WebhookConfig, logger *slog.Logger) (*Webhook, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("webhook %q: url must be http or https", cfg.URL)
+	}
+
+	types := DefaultWebhookEvents
+	if len(cfg.Events) > 0 {
+		types = nil
+		for _, name := range cfg.Events {
+			t := event.Type(name)
+			if !isWebhookEvent(t) {
+				return nil, fmt.Errorf("webhook %s: unknown event %q", u.Host, name)
+			}
+			types = append(types, t)
+		}
+	}
+
+	text := cfg.Template
+	if strings.TrimSpace(text) == "" {
+		text = defaultWebhookTemplate
+	}
+	tmpl, err := template.New(u.Host).Funcs(template.FuncMap{"json": jsonValue}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("webhook %s: %w", u.Host, err)
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Webhook{
+		url:    cfg.URL,
+		types:  types,
+		tmpl:   tmpl,
+		client: &http.Client{Timeout: webhookTimeout},
+		logger: logger,
+	}, nil
+}
+
+// isWebhookEvent reports whether t can be forwarded to a webhook.
+func isWebhookEvent(t event.Type) bool {
+	for _, known := range DefaultWebhookEvents {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonValue quotes v as JSON for use inside a template.
+func jsonValue(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+// Start subscribes the webhook to d and posts matching events from a
+// goroutine until d is closed. opts narrow the subscription further.
+// Failed posts are logged and not retried.
+func (w *Webhook) Start(d *event.Dispatcher, opts ...event.SubscribeOption) {
+	host := w.url
+	if u, err := url.Parse(w.url); err == nil {
+		host = u.Host
+	}
+	opts = append([]event.SubscribeOption{
+		event.WithTypes(w.types...),
+		event.WithName("webhook " + host),
+		event.WithBuffer(webhookBuffer),
+	}, opts...)
+	events := d.Subscribe("*", opts...)
+	go func() {
+		for e := range events {
+			if err := w.Send(context.Background(), e); err != nil {
+				w.logger.Warn("webhook failed", "host", host, "event", e.Type, "err", err)
+			}
+		}
+	}()
+}
+
+// Payload renders the JSON body for e.
+func (w *Webhook) Payload(e event.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	data := webhookData{Type: e.Type, Topic: e.Topic, Time: e.Timestamp, Text: Summary(e), Data: e.Data}
+	if err := w.tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, errors.New("template did not produce valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// Send posts e to the webhook, failing on a non-2xx response.
+func (w *Webhook) Send(ctx context.Context, e event.Event) error {
+	body, err := w.Payload(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("status %s", resp.Status)
+	}
+	return nil
+}
+
+// StartWebhooks starts a webhook for each valid entry of hooks, passing
+// opts to Start. Invalid entries are skipped and reported together in the
+// returned error.
+func StartWebhooks(d *event.Dispatcher, hooks []config.WebhookConfig, logger *slog.Logger, opts ...event.SubscribeOption) error {
+	var errs []error
+	for _, cfg := range hooks {
+		w, err := NewWebhook(cfg, logger)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		w.Start(d, opts...)
+	}
+	return errors.Join(errs...)
+}
+
+// Summary describes e in one line, e.g. "feat: claude is waiting for
+// input", for notification text. Events bridged from another instance are
+// prefixed with its origin, e.g. "[api] feat: claude finished".
+func Summary(e event.Event) string {
+	if e.Origin != "" {
+		local := e
+		local.Origin = ""
+		return fmt.Sprintf("[%s] %s", e.Origin, Summary(local))
+	}
+	switch c := e.Data.(type) {
+	case event.AgentCompletion:
+		agent := c.Agent
+		if agent == "" {
+			agent = "agent"
+		}
+		switch {
+		case c.Marker != "":
+			return fmt.Sprintf("%s: %s printed %q", c.Workspace, agent, c.Marker)
+		case c.Status == "waiting":
+			return fmt.Sprintf("%s: %s is waiting for input", c.Workspace, agent)
+		case c.Status == "error":
+			return fmt.Sprintf("%s: %s stopped with an error", c.Workspace, agent)
+		}
+		return fmt.Sprintf("%s: %s finished", c.Workspace, agent)
+	case event.MergeCompletion:
+		if c.PRURL != "" {
+			return fmt.Sprintf("%s: merged %s into %s (%s)", c.Workspace, c.Branch, c.Target, c.PRURL)
+		}
+		return fmt.Sprintf("%s: merged %s into %s", c.Workspace, c.Branch, c.Target)
+	}
+	return fmt.Sprintf("%s on %s", e.Type, e.Topic)
+}
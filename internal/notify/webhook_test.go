@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/event"
+)
+
+func TestNewWebhook_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.WebhookConfig
+	}{
+		{"bad scheme", config.WebhookConfig{URL: "ftp://example.com"}},
+		{"no host", config.WebhookConfig{URL: "https://"}},
+		{"unknown event", config.WebhookConfig{URL: "https://example.com", Events: []string{"focus_changed"}}},
+		{"bad template", config.WebhookConfig{URL: "https://example.com", Template: "{{json .Text"}},
+	}
+	for _, tt := range tests {
+		if _, err := NewWebhook(tt.cfg, nil); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestWebhook_Payload(t *testing.T) {
+	w, err := NewWebhook(config.WebhookConfig{
+		URL:      "https://hooks.example.com/x",
+		Template: `{"content": {{json .Text}}}`,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := event.NewEvent(event.TypeMergeCompleted, event.TopicWorkspace,
+		event.MergeCompletion{Workspace: "feat", Branch: "feat-x", Target: "main", Direct: true})
+	body, err := w.Payload(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"content": "feat: merged feat-x into main"}` {
+		t.Errorf("payload = %s", body)
+	}
+
+	// Events bridged from another instance name it
+	e.Origin = "api"
+	if body, _ := w.Payload(e); string(body) != `{"content": "[api] feat: merged feat-x into main"}` {
+		t.Errorf("bridged payload = %s", body)
+	}
+
+	// Templates that don't render JSON are rejected rather than posted
+	w, _ = NewWebhook(config.WebhookConfig{URL: "https://example.com", Template: `text: {{.Text}}`}, nil)
+	if _, err := w.Payload(e); err == nil {
+		t.Error("expected invalid JSON error")
+	}
+}
+
+func TestWebhook_Start(t *testing.T) {
+	bodies := make(chan map[string]any, 4)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+		b, _ := io.ReadAll(r.Body)
+		var m map[string]any
+		if err := json.Unmarshal(b, &m); err != nil {
+			t.Errorf("body %s: %v", b, err)
+		}
+		bodies <- m
+	}))
+	defer srv.Close()
+
+	d := event.New()
+	defer d.Close()
+	if err := StartWebhooks(d, []config.WebhookConfig{
+		{URL: srv.URL, Events: []string{"session_error"}},
+		{URL: "not a url"},
+	}, nil); err == nil || !strings.Contains(err.Error(), "not a url") {
+		t.Errorf("StartWebhooks error = %v, want the invalid entry reported", err)
+	}
+
+	failed := event.AgentCompletion{Workspace: "feat", Agent: "claude", Status: "error"}
+	d.Publish(event.TopicWorkspace, event.NewEvent(event.TypeAgentCompleted, event.TopicWorkspace, failed))
+	d.Publish(event.TopicWorkspace, event.NewEvent(event.TypeSessionError, event.TopicWorkspace, failed))
+
+	select {
+	case m := <-bodies:
+		if m["event"] != "session_error" || m["text"] != "feat: claude stopped with an error" {
+			t.Errorf("body = %v", m)
+		}
+		if data, _ := m["data"].(map[string]any); data["Workspace"] != "feat" {
+			t.Errorf("data = %v", m["data"])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook not called")
+	}
+	select {
+	case m := <-bodies:
+		t.Errorf("unselected event posted: %v", m)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -79,6 +79,20 @@ func BuildEntries(km *keymap.Registry, plugins []plugin.Plugin, activeContext, p
 		}
 	}
 
+	// Plugin commands without a key binding are still searchable
+	for _, p := range plugins {
+		for _, cmd := range p.Commands() {
+			key := cmd.ID + ":" + cmd.Context
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			b := keymap.Binding{Command: cmd.ID, Context: cmd.Context}
+			entries = append(entries, bindingToEntry(b, cmdMeta, activeContext, pluginContext))
+		}
+	}
+
 	return entries
 }
 
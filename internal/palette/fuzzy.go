@@ -107,8 +107,13 @@ func ScoreEntry(entry *PaletteEntry, query string) {
 	// Score category
 	catScore, _ := FuzzyMatch(query, string(entry.Category))
 
-	// Weighted combination: name 3x, key 2x, desc 1x, category 0.5x
-	baseScore := nameScore*3 + keyScore*2 + descScore + catScore/2
+	// Score command ID and context, so "stage-file" or "git" find commands
+	idScore, _ := FuzzyMatch(query, entry.CommandID)
+	ctxScore, _ := FuzzyMatch(query, entry.Context)
+
+	// Weighted combination: name 3x, key 2x, desc and ID 1x, category and
+	// context 0.5x
+	baseScore := nameScore*3 + keyScore*2 + descScore + idScore + catScore/2 + ctxScore/2
 
 	// Only apply layer boost if there's at least one match
 	if baseScore > 0 {
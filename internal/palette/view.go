@@ -16,43 +16,43 @@ const keyColumnWidth = 12
 var (
 	paletteBox = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
-			BorderForeground(styles.Primary).
-			Background(styles.BgSecondary).
+			BorderForeground(styles.Current().Primary).
+			Background(styles.Current().BgSecondary).
 			Padding(1, 2)
 
 	layerHeaderCurrent = lipgloss.NewStyle().
-				Foreground(styles.Primary).
+				Foreground(styles.Current().Primary).
 				Bold(true).
 				PaddingLeft(1).
 				MarginTop(1)
 
 	layerHeaderPlugin = lipgloss.NewStyle().
-				Foreground(styles.Secondary).
+				Foreground(styles.Current().Secondary).
 				Bold(true).
 				PaddingLeft(1).
 				MarginTop(1)
 
 	layerHeaderGlobal = lipgloss.NewStyle().
-				Foreground(styles.TextSubtle).
+				Foreground(styles.Current().TextSubtle).
 				PaddingLeft(1).
 				MarginTop(1)
 
 	entryNormal = lipgloss.NewStyle().
-			Foreground(styles.TextPrimary)
+			Foreground(styles.Current().TextPrimary)
 
 	entrySelected = lipgloss.NewStyle().
-			Foreground(styles.TextPrimary).
-			Background(styles.BgTertiary)
+			Foreground(styles.Current().TextPrimary).
+			Background(styles.Current().BgTertiary)
 
 	entryName = lipgloss.NewStyle().
-			Foreground(styles.TextPrimary).
+			Foreground(styles.Current().TextPrimary).
 			Width(20)
 
 	entryDesc = lipgloss.NewStyle().
-			Foreground(styles.TextSecondary)
+			Foreground(styles.Current().TextSecondary)
 
 	matchHighlight = lipgloss.NewStyle().
-			Foreground(styles.Primary).
+			Foreground(styles.Current().Primary).
 			Bold(true)
 )
 
@@ -81,8 +81,8 @@ func (m Model) View() string {
 	// Calculate content width (inside padding)
 	contentWidth := width - 4
 
-	promptPrefix := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true).Render(">")
-	escChip := styles.KeyHint.Render("esc")
+	promptPrefix := lipgloss.NewStyle().Foreground(styles.Current().Primary).Bold(true).Render(">")
+	escChip := styles.Current().KeyHint.Render("esc")
 	inputWidth := contentWidth - lipgloss.Width(promptPrefix) - lipgloss.Width(escChip) - 3
 	paddedInput := lipgloss.NewStyle().Width(inputWidth).Render(m.textInput.View())
 	header := fmt.Sprintf("%s %s %s", promptPrefix, paddedInput, escChip)
@@ -92,11 +92,11 @@ func (m Model) View() string {
 	// Mode indicator with context badge
 	var modeText string
 	if m.showAllContexts {
-		modeText = styles.BarChip.Render("All Contexts")
+		modeText = styles.Current().BarChip.Render("All Contexts")
 	} else {
-		modeText = styles.BarChip.Render(m.activeContext)
+		modeText = styles.Current().BarChip.Render(m.activeContext)
 	}
-	toggleHint := styles.Muted.Render("tab to toggle")
+	toggleHint := styles.Current().Muted.Render("tab to toggle")
 	b.WriteString(fmt.Sprintf("%s  %s", modeText, toggleHint))
 	b.WriteString("\n")
 	b.WriteString(strings.Repeat("─", contentWidth))
@@ -119,7 +119,7 @@ func (m Model) View() string {
 
 	// Show scroll-up indicator if content above
 	if m.offset > 0 {
-		b.WriteString(styles.Muted.Render(fmt.Sprintf("  ↑ %d more above", m.offset)))
+		b.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↑ %d more above", m.offset)))
 		b.WriteString("\n")
 		currentY++
 	}
@@ -152,13 +152,13 @@ func (m Model) View() string {
 	// Show scroll-down indicator if content below
 	if visibleEnd < totalEntries {
 		remaining := totalEntries - visibleEnd
-		b.WriteString(styles.Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
+		b.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ↓ %d more below", remaining)))
 		b.WriteString("\n")
 	}
 
 	// Empty state
 	if len(m.filtered) == 0 {
-		emptyMsg := styles.Muted.Render("No matching commands")
+		emptyMsg := styles.Current().Muted.Render("No matching commands")
 		b.WriteString("\n")
 		b.WriteString(emptyMsg)
 		b.WriteString("\n")
@@ -250,7 +250,10 @@ func (m Model) renderLayerHeader(layer Layer, count int) string {
 // renderEntry renders a single palette entry.
 func (m Model) renderEntry(entry PaletteEntry, selected bool, maxWidth int) string {
 	// Key column - render as pill/chip using KeyHint style
-	keyStr := styles.KeyHint.Render(entry.Key)
+	keyStr := ""
+	if entry.Key != "" {
+		keyStr = styles.Current().KeyHint.Render(entry.Key)
+	}
 	keyWidth := lipgloss.Width(keyStr)
 
 	// Pad key to fixed column width for alignment
@@ -270,6 +273,9 @@ func (m Model) renderEntry(entry PaletteEntry, selected bool, maxWidth int) stri
 	// Show context count if command appears in multiple contexts
 	if entry.ContextCount > 1 {
 		desc = fmt.Sprintf("%s (%d contexts)", desc, entry.ContextCount)
+	} else if entry.Context != m.activeContext && entry.Context != m.pluginContext && entry.Context != "global" {
+		// Another plugin's command; selecting it switches there
+		desc = fmt.Sprintf("%s (%s)", desc, entry.Context)
 	}
 
 	if descWidth > 3 && len(desc) > descWidth {
@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Plugin API versions. Bump APIVersion when the Plugin contract changes or
+// an optional capability is added, and record the capability in
+// capabilitySince. Raise MinAPIVersion only when dropping support for
+// plugins built against an older contract.
+const (
+	APIVersion    = 3
+	MinAPIVersion = 1
+)
+
+// Versioned is implemented by plugins that declare the API version they
+// were built against. Plugins without it are assumed to be built in-tree
+// against the current APIVersion.
+type Versioned interface {
+	APIVersion() int
+}
+
+// Capability names an optional plugin interface the host can use.
+type Capability string
+
+const (
+	CapDiagnostics  Capability = "diagnostics"   // DiagnosticProvider
+	CapTextInput    Capability = "text-input"    // TextInputConsumer
+	CapPaneResize   Capability = "pane-resize"   // PaneResizer
+	CapGlobalSearch Capability = "global-search" // GlobalSearcher
+	CapKeyCapture   Capability = "key-capture"   // KeyCapturer
+)
+
+// capabilitySince records the API version that introduced each capability.
+// Plugins declaring an older version don't get the capability even if they
+// happen to implement a method with the same name.
+var capabilitySince = map[Capability]int{
+	CapDiagnostics:  1,
+	CapTextInput:    2,
+	CapPaneResize:   2,
+	CapGlobalSearch: 2,
+	CapKeyCapture:   3,
+}
+
+// CapabilityAdvertiser is implemented by plugins that list the capabilities
+// they support. Without it, every capability whose interface the plugin
+// implements is advertised.
+type CapabilityAdvertiser interface {
+	Capabilities() []Capability
+}
+
+// Compatibility is the result of negotiating with a plugin at registration.
+type Compatibility struct {
+	Version      int          // API version the plugin declared
+	Capabilities []Capability // Capabilities the host will use
+	Degraded     []Capability // Implemented but unavailable at Version
+}
+
+// IsDegraded reports whether the plugin loaded with reduced features.
+func (c Compatibility) IsDegraded() bool {
+	return len(c.Degraded) > 0
+}
+
+// Has reports whether the negotiated capabilities include c.
+func (c Compatibility) Has(capability Capability) bool {
+	return slices.Contains(c.Capabilities, capability)
+}
+
+// Negotiate checks a plugin's declared API version and works out which
+// optional capabilities the host will use. Plugins newer than the host or
+// older than MinAPIVersion are rejected; older supported plugins load with
+// the capabilities their version knew about.
+func Negotiate(p Plugin) (Compatibility, error) {
+	c := Compatibility{Version: APIVersion}
+	if v, ok := p.(Versioned); ok {
+		c.Version = v.APIVersion()
+	}
+	switch {
+	case c.Version > APIVersion:
+		return c, fmt.Errorf("requires plugin API v%d (this build supports v%d-v%d)", c.Version, MinAPIVersion, APIVersion)
+	case c.Version < MinAPIVersion:
+		return c, fmt.Errorf("built for plugin API v%d, no longer supported (minimum v%d)", c.Version, MinAPIVersion)
+	}
+
+	var advertised []Capability
+	if a, ok := p.(CapabilityAdvertiser); ok {
+		advertised = a.Capabilities()
+	}
+	for _, capability := range implementedCapabilities(p) {
+		if advertised != nil && !slices.Contains(advertised, capability) {
+			continue
+		}
+		if capabilitySince[capability] > c.Version {
+			c.Degraded = append(c.Degraded, capability)
+			continue
+		}
+		c.Capabilities = append(c.Capabilities, capability)
+	}
+	return c, nil
+}
+
+// implementedCapabilities lists the optional interfaces p implements.
+func implementedCapabilities(p Plugin) []Capability {
+	var caps []Capability
+	if _, ok := p.(DiagnosticProvider); ok {
+		caps = append(caps, CapDiagnostics)
+	}
+	if _, ok := p.(TextInputConsumer); ok {
+		caps = append(caps, CapTextInput)
+	}
+	if _, ok := p.(PaneResizer); ok {
+		caps = append(caps, CapPaneResize)
+	}
+	if _, ok := p.(GlobalSearcher); ok {
+		caps = append(caps, CapGlobalSearch)
+	}
+	if _, ok := p.(KeyCapturer); ok {
+		caps = append(caps, CapKeyCapture)
+	}
+	return caps
+}
@@ -0,0 +1,95 @@
+package plugin
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// versionedPlugin declares an API version and implements optional
+// capabilities from different versions.
+type versionedPlugin struct {
+	mockPlugin
+	version    int
+	advertised []Capability
+}
+
+func (v *versionedPlugin) APIVersion() int                           { return v.version }
+func (v *versionedPlugin) Diagnostics() []Diagnostic                 { return nil }
+func (v *versionedPlugin) ConsumesTextInput() bool                   { return true }
+func (v *versionedPlugin) GlobalSearch(string) func() []SearchResult { return nil }
+
+type advertisingPlugin struct{ versionedPlugin }
+
+func (a *advertisingPlugin) Capabilities() []Capability { return a.advertised }
+
+func TestNegotiate_UnversionedGetsCurrentAPI(t *testing.T) {
+	c, err := Negotiate(&mockPlugin{id: "plain"})
+	if err != nil {
+		t.Fatalf("Negotiate: %v", err)
+	}
+	if c.Version != APIVersion || c.IsDegraded() || len(c.Capabilities) != 0 {
+		t.Errorf("compat = %+v", c)
+	}
+}
+
+func TestNegotiate_OlderPluginDegrades(t *testing.T) {
+	p := &versionedPlugin{mockPlugin: mockPlugin{id: "old"}, version: 1}
+	c, err := Negotiate(p)
+	if err != nil {
+		t.Fatalf("Negotiate: %v", err)
+	}
+	if !c.Has(CapDiagnostics) {
+		t.Error("v1 plugin should keep diagnostics")
+	}
+	if c.Has(CapTextInput) || c.Has(CapGlobalSearch) {
+		t.Errorf("v1 plugin should not get v2 capabilities: %+v", c)
+	}
+	if !slices.Equal(c.Degraded, []Capability{CapTextInput, CapGlobalSearch}) {
+		t.Errorf("degraded = %v", c.Degraded)
+	}
+}
+
+func TestNegotiate_RejectsUnsupportedVersions(t *testing.T) {
+	for _, version := range []int{MinAPIVersion - 1, APIVersion + 1} {
+		p := &versionedPlugin{mockPlugin: mockPlugin{id: "x"}, version: version}
+		if _, err := Negotiate(p); err == nil {
+			t.Errorf("version %d: expected error", version)
+		}
+	}
+}
+
+func TestNegotiate_AdvertisedCapabilitiesLimitUse(t *testing.T) {
+	p := &advertisingPlugin{versionedPlugin{mockPlugin: mockPlugin{id: "ad"}, version: APIVersion, advertised: []Capability{CapGlobalSearch}}}
+	c, err := Negotiate(p)
+	if err != nil {
+		t.Fatalf("Negotiate: %v", err)
+	}
+	if !slices.Equal(c.Capabilities, []Capability{CapGlobalSearch}) {
+		t.Errorf("capabilities = %v, want only global-search", c.Capabilities)
+	}
+}
+
+func TestRegistry_NegotiatesOnRegister(t *testing.T) {
+	r := NewRegistry(nil)
+	old := &versionedPlugin{mockPlugin: mockPlugin{id: "old"}, version: 1}
+	future := &versionedPlugin{mockPlugin: mockPlugin{id: "future"}, version: APIVersion + 1}
+	_ = r.Register(old)
+	_ = r.Register(future)
+
+	if len(r.Plugins()) != 1 {
+		t.Fatalf("got %d plugins, want only the supported one", len(r.Plugins()))
+	}
+	if reason := r.Unavailable()["future"]; !strings.Contains(reason, "requires plugin API") {
+		t.Errorf("unavailable reason = %q", reason)
+	}
+	if future.started || r.Supports(future, CapDiagnostics) {
+		t.Error("incompatible plugin should not be used")
+	}
+	if !r.Supports(old, CapDiagnostics) || r.Supports(old, CapTextInput) {
+		t.Error("old plugin should keep v1 capabilities only")
+	}
+	if c, ok := r.Compatibility("old"); !ok || !c.IsDegraded() {
+		t.Errorf("compatibility = %+v, %v", c, ok)
+	}
+}
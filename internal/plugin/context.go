@@ -22,6 +22,7 @@ type Context struct {
 	Config    *config.Config
 	Adapters  map[string]adapter.Adapter
 	EventBus  *event.Dispatcher
+	Scheduler *event.Scheduler // Publishes shared timer events on EventBus
 	Logger    *slog.Logger
 	Keymap    BindingRegistrar // For plugins to register dynamic bindings
 	Epoch     uint64           // Incremented on project switch to invalidate stale async messages
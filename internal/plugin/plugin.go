@@ -25,6 +25,33 @@ type TextInputConsumer interface {
 	ConsumesTextInput() bool
 }
 
+// KeyCapturer is an optional capability for plugins that send every key
+// to an embedded terminal app, letting the user keep some keys for the
+// app-level shortcuts.
+type KeyCapturer interface {
+	// CapturesKey reports whether key should be handled by forge instead
+	// of being sent to the embedded app.
+	CapturesKey(key string) bool
+}
+
+// PaneResizer is an optional capability for plugins with a draggable pane
+// divider, letting the app move the divider from the keyboard.
+type PaneResizer interface {
+	// CanResizePane reports whether the divider is on screen and no modal
+	// or text input owns the keyboard.
+	CanResizePane() bool
+	// ResizePane moves the divider by delta steps (negative shrinks the
+	// sidebar), persists the width, and returns the sidebar share of the
+	// plugin width in percent.
+	ResizePane(delta int) (int, tea.Cmd)
+}
+
+// PaneResizeStep returns the divider step in columns for a keyboard resize:
+// 5% of the available width, at least 2 columns.
+func PaneResizeStep(available int) int {
+	return max(available/20, 2)
+}
+
 // Category represents a logical grouping of commands for the command palette.
 type Category string
 
@@ -69,6 +96,13 @@ type OpenFileMsg struct {
 	LineNo int    // Line number to open at (0 = start of file)
 }
 
+// FeatureChangedMsg is broadcast to all plugins when a feature flag is
+// toggled at runtime, so they can react without a restart.
+type FeatureChangedMsg struct {
+	Name    string
+	Enabled bool
+}
+
 // PluginFocusedMsg is sent to a plugin when it becomes the active plugin.
 // Plugins can use this to refresh data or update their state on focus.
 type PluginFocusedMsg struct{}
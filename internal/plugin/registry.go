@@ -10,7 +10,8 @@ import (
 // Registry manages plugin registration and lifecycle.
 type Registry struct {
 	plugins     []Plugin
-	unavailable map[string]string // pluginID -> error reason
+	unavailable map[string]string        // pluginID -> error reason
+	compat      map[string]Compatibility // pluginID -> negotiated API
 	ctx         *Context
 	mu          sync.RWMutex
 }
@@ -20,16 +21,30 @@ func NewRegistry(ctx *Context) *Registry {
 	return &Registry{
 		plugins:     make([]Plugin, 0),
 		unavailable: make(map[string]string),
+		compat:      make(map[string]Compatibility),
 		ctx:         ctx,
 	}
 }
 
 // Register adds a plugin to the registry.
-// If Init fails, the plugin is marked unavailable (silent degradation).
+// If API negotiation or Init fails, the plugin is marked unavailable
+// (silent degradation).
 func (r *Registry) Register(p Plugin) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	compat, err := Negotiate(p)
+	if err != nil {
+		r.unavailable[p.ID()] = err.Error()
+		if r.ctx != nil && r.ctx.Logger != nil {
+			r.ctx.Logger.Debug("plugin incompatible", "id", p.ID(), "reason", err)
+		}
+		return nil
+	}
+	if compat.IsDegraded() && r.ctx != nil && r.ctx.Logger != nil {
+		r.ctx.Logger.Info("plugin loaded with reduced features", "id", p.ID(), "api", compat.Version, "unavailable", compat.Degraded)
+	}
+
 	if err := r.safeInit(p); err != nil {
 		r.unavailable[p.ID()] = err.Error()
 		if r.ctx != nil && r.ctx.Logger != nil {
@@ -39,6 +54,7 @@ func (r *Registry) Register(p Plugin) error {
 	}
 
 	r.plugins = append(r.plugins, p)
+	r.compat[p.ID()] = compat
 	return nil
 }
 
@@ -136,6 +152,30 @@ func (r *Registry) Unavailable() map[string]string {
 	return result
 }
 
+// Compatibility returns the API negotiated with a registered plugin.
+func (r *Registry) Compatibility(id string) (Compatibility, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.compat[id]
+	return c, ok
+}
+
+// Supports reports whether the host should use capability for plugin p.
+// Plugins that were not registered are negotiated on the fly.
+func (r *Registry) Supports(p Plugin, capability Capability) bool {
+	if p == nil {
+		return false
+	}
+	c, ok := r.Compatibility(p.ID())
+	if !ok {
+		var err error
+		if c, err = Negotiate(p); err != nil {
+			return false
+		}
+	}
+	return c.Has(capability)
+}
+
 // Reinit stops all plugins, updates the context with a new WorkDir and ProjectRoot,
 // and reinitializes all plugins. Returns the start commands for all plugins.
 func (r *Registry) Reinit(newWorkDir, newProjectRoot string) []tea.Cmd {
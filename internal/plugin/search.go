@@ -0,0 +1,41 @@
+package plugin
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// SearchKind identifies the type of a global search result.
+type SearchKind string
+
+const (
+	SearchKindSession  SearchKind = "session"
+	SearchKindMessage  SearchKind = "message"
+	SearchKindTask     SearchKind = "task"
+	SearchKindFile     SearchKind = "file"
+	SearchKindWorktree SearchKind = "worktree"
+)
+
+// SearchKinds lists result kinds in display order.
+var SearchKinds = []SearchKind{
+	SearchKindSession,
+	SearchKindMessage,
+	SearchKindTask,
+	SearchKindFile,
+	SearchKindWorktree,
+}
+
+// SearchResult is a single hit returned to the app-wide search modal.
+type SearchResult struct {
+	Kind     SearchKind
+	Title    string  // Primary line (session name, file path, task title)
+	Detail   string  // Secondary text (snippet, status, branch)
+	Score    int     // Higher ranks first within a kind
+	PluginID string  // Plugin to focus when the result is opened
+	Open     tea.Msg // Message broadcast to plugins to navigate to the result (optional)
+}
+
+// GlobalSearcher is an optional capability for plugins that contribute results
+// to the app-wide search. GlobalSearch runs on the UI goroutine and should only
+// snapshot plugin state; the returned function runs in the background.
+// Return nil when the plugin has nothing to search.
+type GlobalSearcher interface {
+	GlobalSearch(query string) func() []SearchResult
+}
@@ -115,7 +115,7 @@ func renderMessages(messages []ChatMessage, width int) string {
 		return lipgloss.NewStyle().
 			Width(width).
 			Align(lipgloss.Center).
-			Foreground(styles.TextMuted).
+			Foreground(styles.Current().TextMuted).
 			Render("\n\nStart a conversation — type a message below")
 	}
 
@@ -129,12 +129,12 @@ func renderMessages(messages []ChatMessage, width int) string {
 
 		if msg.Role == "user" {
 			prefix := lipgloss.NewStyle().
-				Foreground(styles.Secondary).
+				Foreground(styles.Current().Secondary).
 				Bold(true).
 				Render("> ")
 
 			content := lipgloss.NewStyle().
-				Foreground(styles.TextPrimary).
+				Foreground(styles.Current().TextPrimary).
 				Render(msg.Content)
 
 			sb.WriteString(prefix + content)
@@ -148,7 +148,7 @@ func renderMessages(messages []ChatMessage, width int) string {
 
 			if msg.IsStreaming {
 				sb.WriteString(lipgloss.NewStyle().
-					Foreground(styles.Accent).
+					Foreground(styles.Current().Accent).
 					Blink(true).
 					Render(" ▍"))
 			}
@@ -162,8 +162,8 @@ func renderMessages(messages []ChatMessage, width int) string {
 func renderStatusBar(sessionID string, streaming bool, width int) string {
 	statusStyle := lipgloss.NewStyle().
 		Width(width).
-		Background(styles.BgSecondary).
-		Foreground(styles.TextMuted)
+		Background(styles.Current().BgSecondary).
+		Foreground(styles.Current().TextMuted)
 
 	left := ""
 	if sessionID != "" {
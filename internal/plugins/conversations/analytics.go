@@ -8,6 +8,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/wilbur182/forge/internal/adapter/claudecode"
+	"github.com/wilbur182/forge/internal/format"
 	"github.com/wilbur182/forge/internal/styles"
 )
 
@@ -19,16 +20,16 @@ func (p *Plugin) renderAnalytics() string {
 	// Load stats
 	stats, err := claudecode.LoadStatsCache()
 	if err != nil {
-		lines = append(lines, styles.Title.Render(" Usage Analytics"))
-		lines = append(lines, styles.Muted.Render(strings.Repeat("━", p.width-2)))
-		lines = append(lines, styles.StatusDeleted.Render(" Unable to load stats: "+err.Error()))
+		lines = append(lines, styles.Current().Title.Render(" Usage Analytics"))
+		lines = append(lines, styles.Current().Muted.Render(strings.Repeat("━", p.width-2)))
+		lines = append(lines, styles.Current().StatusDeleted.Render(" Unable to load stats: "+err.Error()))
 		p.analyticsLines = lines
 		return strings.Join(lines, "\n")
 	}
 
 	// Header
-	lines = append(lines, styles.Title.Render(" Usage Analytics"))
-	lines = append(lines, styles.Muted.Render(strings.Repeat("━", p.width-2)))
+	lines = append(lines, styles.Current().Title.Render(" Usage Analytics"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("━", p.width-2)))
 
 	// Summary line
 	firstDate := stats.FirstSessionDate.Format("Jan 2")
@@ -36,12 +37,12 @@ func (p *Plugin) renderAnalytics() string {
 		firstDate,
 		stats.TotalSessions,
 		formatLargeNumber(stats.TotalMessages))
-	lines = append(lines, styles.Body.Render(summary))
+	lines = append(lines, styles.Current().Body.Render(summary))
 	lines = append(lines, "")
 
 	// Weekly activity chart
-	lines = append(lines, styles.Title.Render(" This Week's Activity"))
-	lines = append(lines, styles.Muted.Render(strings.Repeat("─", p.width-2)))
+	lines = append(lines, styles.Current().Title.Render(" This Week's Activity"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", p.width-2)))
 
 	recentActivity := stats.GetRecentActivity(7)
 	maxMsgs := 0
@@ -55,15 +56,15 @@ func (p *Plugin) renderAnalytics() string {
 		date, _ := time.Parse("2006-01-02", day.Date)
 		dayName := date.Format("Mon")
 		bar := renderColoredBar(day.MessageCount, maxMsgs, 16)
-		dayLabel := styles.Body.Render(fmt.Sprintf(" %s │ ", dayName))
-		statsLabel := styles.Subtitle.Render(fmt.Sprintf(" │ %5d msgs │ %2d sessions", day.MessageCount, day.SessionCount))
+		dayLabel := styles.Current().Body.Render(fmt.Sprintf(" %s │ ", dayName))
+		statsLabel := styles.Current().Subtitle.Render(fmt.Sprintf(" │ %5d msgs │ %2d sessions", day.MessageCount, day.SessionCount))
 		lines = append(lines, dayLabel+bar+statsLabel)
 	}
 	lines = append(lines, "")
 
 	// Model usage
-	lines = append(lines, styles.Title.Render(" Model Usage"))
-	lines = append(lines, styles.Muted.Render(strings.Repeat("─", p.width-2)))
+	lines = append(lines, styles.Current().Title.Render(" Model Usage"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", p.width-2)))
 
 	// Sort models by total tokens descending for stable ordering
 	type modelEntry struct {
@@ -96,25 +97,25 @@ func (p *Plugin) renderAnalytics() string {
 		bar := renderColoredBar64(m.totalTokens, maxTokens, 12)
 		cost := claudecode.CalculateModelCost(m.name, m.usage)
 
-		modelLabel := styles.Body.Render(fmt.Sprintf(" %-6s │ ", shortName))
-		tokensLabel := styles.Subtitle.Render(fmt.Sprintf(" │ %s in  %s out │ ",
+		modelLabel := styles.Current().Body.Render(fmt.Sprintf(" %-6s │ ", shortName))
+		tokensLabel := styles.Current().Subtitle.Render(fmt.Sprintf(" │ %s in  %s out │ ",
 			formatLargeNumber64(int64(m.usage.InputTokens)),
 			formatLargeNumber64(int64(m.usage.OutputTokens))))
-		costLabel := lipgloss.NewStyle().Foreground(styles.Accent).Render(fmt.Sprintf("~$%.0f", cost))
+		costLabel := lipgloss.NewStyle().Foreground(styles.Current().Accent).Render("~" + format.Money(cost, 0))
 		lines = append(lines, modelLabel+bar+tokensLabel+costLabel)
 	}
 	lines = append(lines, "")
 
 	// Stats footer
 	cacheEff := stats.CacheEfficiency()
-	cacheLabel := styles.Subtitle.Render(" Cache Efficiency: ")
-	cacheValue := lipgloss.NewStyle().Foreground(styles.Success).Render(fmt.Sprintf("%.0f%%", cacheEff))
+	cacheLabel := styles.Current().Subtitle.Render(" Cache Efficiency: ")
+	cacheValue := lipgloss.NewStyle().Foreground(styles.Current().Success).Render(fmt.Sprintf("%.0f%%", cacheEff))
 	lines = append(lines, cacheLabel+cacheValue)
 
 	// Peak hours
 	peakHours := stats.GetPeakHours(3)
 	if len(peakHours) > 0 {
-		peakLabel := styles.Subtitle.Render(" Peak Hours:")
+		peakLabel := styles.Current().Subtitle.Render(" Peak Hours:")
 		peakValues := ""
 		for i, ph := range peakHours {
 			if i > 0 {
@@ -122,21 +123,21 @@ func (p *Plugin) renderAnalytics() string {
 			}
 			peakValues += fmt.Sprintf(" %s:00", ph.Hour)
 		}
-		lines = append(lines, peakLabel+styles.Body.Render(peakValues))
+		lines = append(lines, peakLabel+styles.Current().Body.Render(peakValues))
 	}
 
 	// Longest session
 	if stats.LongestSession.Duration > 0 {
 		dur := time.Duration(stats.LongestSession.Duration) * time.Millisecond
-		sessionLabel := styles.Subtitle.Render(" Longest Session: ")
-		sessionValue := styles.Body.Render(formatSessionDuration(dur))
+		sessionLabel := styles.Current().Subtitle.Render(" Longest Session: ")
+		sessionValue := styles.Current().Body.Render(formatSessionDuration(dur))
 		lines = append(lines, sessionLabel+sessionValue)
 	}
 
 	// Total cost
 	totalCost := stats.TotalCost()
-	costLabel := styles.Subtitle.Render(" Total Estimated Cost: ")
-	costValue := lipgloss.NewStyle().Foreground(styles.Accent).Bold(true).Render(fmt.Sprintf("~$%.0f", totalCost))
+	costLabel := styles.Current().Subtitle.Render(" Total Estimated Cost: ")
+	costValue := lipgloss.NewStyle().Foreground(styles.Current().Accent).Bold(true).Render("~" + format.Money(totalCost, 0))
 	lines = append(lines, costLabel+costValue)
 
 	// Store lines for scroll calculation
@@ -167,28 +168,28 @@ func (p *Plugin) renderAnalytics() string {
 // renderColoredBar renders a colored ASCII bar chart segment.
 func renderColoredBar(value, max, width int) string {
 	if max == 0 {
-		return styles.Muted.Render(strings.Repeat("░", width))
+		return styles.Current().Muted.Render(strings.Repeat("░", width))
 	}
 	filled := (value * width) / max
 	if filled > width {
 		filled = width
 	}
-	filledBar := lipgloss.NewStyle().Foreground(styles.Primary).Render(strings.Repeat("█", filled))
-	emptyBar := styles.Muted.Render(strings.Repeat("░", width-filled))
+	filledBar := lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(strings.Repeat("█", filled))
+	emptyBar := styles.Current().Muted.Render(strings.Repeat("░", width-filled))
 	return filledBar + emptyBar
 }
 
 // renderColoredBar64 renders a colored ASCII bar chart segment for int64 values.
 func renderColoredBar64(value, max int64, width int) string {
 	if max == 0 {
-		return styles.Muted.Render(strings.Repeat("░", width))
+		return styles.Current().Muted.Render(strings.Repeat("░", width))
 	}
 	filled := int((value * int64(width)) / max)
 	if filled > width {
 		filled = width
 	}
-	filledBar := lipgloss.NewStyle().Foreground(styles.Secondary).Render(strings.Repeat("█", filled))
-	emptyBar := styles.Muted.Render(strings.Repeat("░", width-filled))
+	filledBar := lipgloss.NewStyle().Foreground(styles.Current().Secondary).Render(strings.Repeat("█", filled))
+	emptyBar := styles.Current().Muted.Render(strings.Repeat("░", width-filled))
 	return filledBar + emptyBar
 }
 
@@ -199,14 +200,5 @@ func formatLargeNumber(n int) string {
 
 // formatLargeNumber64 formats an int64 with K/M/B suffix.
 func formatLargeNumber64(n int64) string {
-	if n >= 1_000_000_000 {
-		return fmt.Sprintf("%.1fB", float64(n)/1_000_000_000)
-	}
-	if n >= 1_000_000 {
-		return fmt.Sprintf("%.1fM", float64(n)/1_000_000)
-	}
-	if n >= 1_000 {
-		return fmt.Sprintf("%.1fK", float64(n)/1_000)
-	}
-	return fmt.Sprintf("%d", n)
+	return format.Compact(n, true)
 }
@@ -0,0 +1,144 @@
+package conversations
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+// badgeRule is a compiled config.BadgeRule.
+type badgeRule struct {
+	label     string
+	color     lipgloss.TerminalColor // nil = theme primary
+	nameRe    *regexp.Regexp
+	minCost   float64
+	minTokens int
+	tools     []string
+}
+
+// compileBadgeRules compiles config badge rules, skipping rules without a
+// label or with an invalid name regex.
+func compileBadgeRules(rules []config.BadgeRule, ctx *plugin.Context) []badgeRule {
+	var out []badgeRule
+	for _, r := range rules {
+		label := strings.TrimSpace(r.Label)
+		if label == "" {
+			continue
+		}
+		br := badgeRule{
+			label:     label,
+			minCost:   r.MinCost,
+			minTokens: r.MinTokens,
+			tools:     r.Tools,
+		}
+		if r.Color != "" {
+			br.color = lipgloss.Color(r.Color)
+		}
+		if r.NameRegex != "" {
+			re, err := regexp.Compile(r.NameRegex)
+			if err != nil {
+				if ctx != nil && ctx.Logger != nil {
+					ctx.Logger.Warn("conversations: invalid badge rule regex", "label", label, "err", err)
+				}
+				continue
+			}
+			br.nameRe = re
+		}
+		out = append(out, br)
+	}
+	return out
+}
+
+// matches reports whether session satisfies every condition of the rule.
+// tools is the set of tool names seen in the session (nil if unknown).
+func (r *badgeRule) matches(s *adapter.Session, tools map[string]bool) bool {
+	if r.nameRe != nil {
+		name := s.Name
+		if name == "" {
+			name = s.ID
+		}
+		if !r.nameRe.MatchString(name) {
+			return false
+		}
+	}
+	if r.minCost > 0 && s.EstCost < r.minCost {
+		return false
+	}
+	if r.minTokens > 0 && s.TotalTokens < r.minTokens {
+		return false
+	}
+	if len(r.tools) > 0 {
+		found := false
+		for _, t := range r.tools {
+			if tools[t] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// sessionBadges returns the rules matching session, in config order.
+func (p *Plugin) sessionBadges(s *adapter.Session) []*badgeRule {
+	var out []*badgeRule
+	for i := range p.badgeRules {
+		if p.badgeRules[i].matches(s, p.sessionTools[s.ID]) {
+			out = append(out, &p.badgeRules[i])
+		}
+	}
+	return out
+}
+
+// recordSessionTools remembers which tools a session used so tool-based
+// badge rules can match it after its messages have been loaded.
+func (p *Plugin) recordSessionTools(sessionID string, msgs []adapter.Message) {
+	if len(p.badgeRules) == 0 || sessionID == "" {
+		return
+	}
+	set := p.sessionTools[sessionID]
+	if set == nil {
+		set = make(map[string]bool)
+		p.sessionTools[sessionID] = set
+	}
+	for i := range msgs {
+		for _, tu := range msgs[i].ToolUses {
+			set[tu.Name] = true
+		}
+		for _, b := range msgs[i].ContentBlocks {
+			if b.Type == "tool_use" && b.ToolName != "" {
+				set[b.ToolName] = true
+			}
+		}
+	}
+}
+
+// badgeRulesText returns the plain text of matched badges (for width calculations).
+func badgeRulesText(badges []*badgeRule) string {
+	labels := make([]string, len(badges))
+	for i, b := range badges {
+		labels[i] = b.label
+	}
+	return strings.Join(labels, " ")
+}
+
+// renderBadgeRules renders matched badges in their configured colors.
+func renderBadgeRules(badges []*badgeRule) string {
+	parts := make([]string, len(badges))
+	for i, b := range badges {
+		color := b.color
+		if color == nil {
+			color = styles.Current().Primary
+		}
+		parts[i] = lipgloss.NewStyle().Foreground(color).Render(b.label)
+	}
+	return strings.Join(parts, " ")
+}
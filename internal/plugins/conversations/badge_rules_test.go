@@ -0,0 +1,67 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/config"
+)
+
+func TestCompileBadgeRules_SkipsInvalid(t *testing.T) {
+	rules := compileBadgeRules([]config.BadgeRule{
+		{Label: "ok", NameRegex: "deploy"},
+		{Label: "", NameRegex: "x"},        // missing label
+		{Label: "bad", NameRegex: "(open"}, // invalid regex
+	}, nil)
+	if len(rules) != 1 || rules[0].label != "ok" {
+		t.Fatalf("expected only the valid rule, got %+v", rules)
+	}
+}
+
+func TestBadgeRule_Matches(t *testing.T) {
+	rules := compileBadgeRules([]config.BadgeRule{
+		{Label: "deploy", NameRegex: "(?i)deploy"},
+		{Label: "pricey", MinCost: 5},
+		{Label: "big", MinTokens: 100_000},
+		{Label: "shell", Tools: []string{"Bash", "exec_command"}},
+		{Label: "combo", NameRegex: "fix", MinCost: 1},
+	}, nil)
+
+	s := &adapter.Session{ID: "s1", Name: "Deploy fix", EstCost: 2, TotalTokens: 150_000}
+	tools := map[string]bool{"Read": true, "Bash": true}
+
+	var got []string
+	for i := range rules {
+		if rules[i].matches(s, tools) {
+			got = append(got, rules[i].label)
+		}
+	}
+	want := "deploy big shell combo"
+	if strings.Join(got, " ") != want {
+		t.Errorf("matched %v, want %s", got, want)
+	}
+
+	// Tool rules don't match when the session's tools are unknown
+	if rules[3].matches(s, nil) {
+		t.Error("tool rule should not match without recorded tools")
+	}
+}
+
+func TestRecordSessionTools(t *testing.T) {
+	p := New()
+	p.badgeRules = compileBadgeRules([]config.BadgeRule{{Label: "web", Tools: []string{"WebFetch"}}}, nil)
+	p.recordSessionTools("s1", []adapter.Message{
+		{ToolUses: []adapter.ToolUse{{Name: "Read"}}},
+		{ContentBlocks: []adapter.ContentBlock{{Type: "tool_use", ToolName: "WebFetch"}}},
+	})
+
+	session := adapter.Session{ID: "s1", Name: "research", AdapterIcon: "◆"}
+	badges := p.sessionBadges(&session)
+	if len(badges) != 1 || badges[0].label != "web" {
+		t.Fatalf("expected web badge, got %+v", badges)
+	}
+	if row := p.renderCompactSessionRow(session, false, 60); !strings.Contains(row, "web") {
+		t.Errorf("expected badge in rendered row %q", row)
+	}
+}
@@ -0,0 +1,88 @@
+package conversations
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// budgetBadgeText marks session rows that exceed the per-session budget.
+const budgetBadgeText = "$!"
+
+// overSessionBudget reports whether s exceeds the configured per-session budget.
+func (p *Plugin) overSessionBudget(s *adapter.Session) bool {
+	b := p.budget
+	return (b.SessionTokens > 0 && s.TotalTokens >= b.SessionTokens) ||
+		(b.SessionCost > 0 && s.EstCost >= b.SessionCost)
+}
+
+// dailyUsage sums tokens and cost for sessions updated on now's local day.
+// Whole-session totals are counted, so long sessions that started yesterday
+// still contribute their full usage.
+func dailyUsage(sessions []adapter.Session, now time.Time) (int, float64) {
+	y, m, d := now.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	var tokens int
+	var cost float64
+	for i := range sessions {
+		if sessions[i].UpdatedAt.Before(start) {
+			continue
+		}
+		tokens += sessions[i].TotalTokens
+		cost += sessions[i].EstCost
+	}
+	return tokens, cost
+}
+
+// checkBudgetAlerts returns a warning toast when an active session or the
+// day's total crosses a configured budget. Each session alerts once and the
+// daily alert fires at most once per day; over-budget rows stay badged.
+func (p *Plugin) checkBudgetAlerts() tea.Cmd {
+	if !p.budget.Enabled() {
+		return nil
+	}
+
+	var alerts []string
+	for i := range p.sessions {
+		s := &p.sessions[i]
+		// Only watched (active) sessions alert; old sessions are just badged
+		if !s.IsActive || p.budgetWarned[s.ID] || !p.overSessionBudget(s) {
+			continue
+		}
+		p.budgetWarned[s.ID] = true
+		name := s.Name
+		if name == "" {
+			name = shortID(s.ID)
+		}
+		alerts = append(alerts, fmt.Sprintf("Session %s over budget: %s tokens, %s",
+			ui.TruncateString(name, 30), formatK(s.TotalTokens), formatCost(s.EstCost)))
+	}
+
+	now := time.Now()
+	day := now.Format("2006-01-02")
+	b := p.budget
+	if p.budgetDailyWarned != day && (b.DailyTokens > 0 || b.DailyCost > 0) {
+		tokens, cost := dailyUsage(p.sessions, now)
+		if (b.DailyTokens > 0 && tokens >= b.DailyTokens) || (b.DailyCost > 0 && cost >= b.DailyCost) {
+			p.budgetDailyWarned = day
+			alerts = append(alerts, fmt.Sprintf("Daily budget exceeded: %s tokens, %s today",
+				formatK(tokens), formatCost(cost)))
+		}
+	}
+
+	if len(alerts) == 0 {
+		return nil
+	}
+	// Only show one toast at a time to avoid toast spam
+	msg := alerts[0]
+	if len(alerts) > 1 {
+		msg += fmt.Sprintf(" (+%d more)", len(alerts)-1)
+	}
+	return func() tea.Msg {
+		return app.ToastMsg{Message: msg, Duration: 5 * time.Second, IsError: true}
+	}
+}
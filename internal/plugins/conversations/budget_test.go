@@ -0,0 +1,95 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/config"
+)
+
+func TestCheckBudgetAlerts_Disabled(t *testing.T) {
+	p := New()
+	p.sessions = []adapter.Session{{ID: "s1", IsActive: true, TotalTokens: 1_000_000}}
+	if cmd := p.checkBudgetAlerts(); cmd != nil {
+		t.Error("expected no alert when budget is not configured")
+	}
+}
+
+func TestCheckBudgetAlerts_SessionOnce(t *testing.T) {
+	p := New()
+	p.budget = config.BudgetConfig{SessionTokens: 100_000}
+	p.sessions = []adapter.Session{
+		{ID: "s1", Name: "runaway", IsActive: true, TotalTokens: 150_000, UpdatedAt: time.Now()},
+		{ID: "s2", Name: "old", TotalTokens: 200_000, UpdatedAt: time.Now().Add(-48 * time.Hour)},
+	}
+
+	cmd := p.checkBudgetAlerts()
+	if cmd == nil {
+		t.Fatal("expected alert for active session over budget")
+	}
+	toast, ok := cmd().(app.ToastMsg)
+	if !ok || !toast.IsError {
+		t.Fatalf("expected error toast, got %#v", cmd())
+	}
+	if !strings.Contains(toast.Message, "runaway") {
+		t.Errorf("toast should name the session, got %q", toast.Message)
+	}
+	if p.budgetWarned["s2"] {
+		t.Error("inactive session should not alert")
+	}
+
+	if cmd := p.checkBudgetAlerts(); cmd != nil {
+		t.Error("session should only alert once")
+	}
+}
+
+func TestCheckBudgetAlerts_Daily(t *testing.T) {
+	p := New()
+	p.budget = config.BudgetConfig{DailyCost: 10}
+	now := time.Now()
+	p.sessions = []adapter.Session{
+		{ID: "s1", EstCost: 6, UpdatedAt: now},
+		{ID: "s2", EstCost: 5, UpdatedAt: now},
+		{ID: "s3", EstCost: 50, UpdatedAt: now.Add(-72 * time.Hour)},
+	}
+
+	cmd := p.checkBudgetAlerts()
+	if cmd == nil {
+		t.Fatal("expected daily budget alert")
+	}
+	if toast := cmd().(app.ToastMsg); !strings.Contains(toast.Message, "Daily budget") {
+		t.Errorf("unexpected toast %q", toast.Message)
+	}
+	if cmd := p.checkBudgetAlerts(); cmd != nil {
+		t.Error("daily alert should fire once per day")
+	}
+}
+
+func TestDailyUsage_ExcludesOlderSessions(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.Local)
+	sessions := []adapter.Session{
+		{TotalTokens: 100, EstCost: 1, UpdatedAt: now.Add(-time.Hour)},
+		{TotalTokens: 200, EstCost: 2, UpdatedAt: now.Add(-16 * time.Hour)}, // yesterday
+	}
+	tokens, cost := dailyUsage(sessions, now)
+	if tokens != 100 || cost != 1 {
+		t.Errorf("dailyUsage = %d, %v; want 100, 1", tokens, cost)
+	}
+}
+
+func TestRenderCompactSessionRow_BudgetBadge(t *testing.T) {
+	p := New()
+	p.budget = config.BudgetConfig{SessionCost: 5}
+	over := adapter.Session{ID: "s1", Name: "pricey", EstCost: 7, AdapterIcon: "◆"}
+	under := adapter.Session{ID: "s2", Name: "cheap", EstCost: 1, AdapterIcon: "◆"}
+
+	if row := p.renderCompactSessionRow(over, true, 60); !strings.Contains(row, budgetBadgeText) {
+		t.Errorf("expected budget badge in row %q", row)
+	}
+	if row := p.renderCompactSessionRow(under, true, 60); strings.Contains(row, budgetBadgeText) {
+		t.Errorf("unexpected budget badge in row %q", row)
+	}
+}
@@ -0,0 +1,58 @@
+package conversations
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+)
+
+// adapterSupports reports whether an adapter supports a capability. Unknown
+// adapters and adapters that declare no capabilities are treated as fully
+// capable so the UI never hides data it could have shown.
+func adapterSupports(a adapter.Adapter, capability adapter.Capability) bool {
+	if a == nil {
+		return true
+	}
+	caps := a.Capabilities()
+	return len(caps) == 0 || caps.Has(capability)
+}
+
+// sessionSupports reports whether the adapter that owns a session supports
+// a capability.
+func (p *Plugin) sessionSupports(s *adapter.Session, capability adapter.Capability) bool {
+	if s == nil {
+		return true
+	}
+	return adapterSupports(p.adapters[s.AdapterID], capability)
+}
+
+// selectedSupports reports whether the selected session supports a capability.
+func (p *Plugin) selectedSupports(capability adapter.Capability) bool {
+	return p.sessionSupports(p.findSelectedSession(), capability)
+}
+
+// capabilityHint explains why a panel is empty for a session's adapter.
+func capabilityHint(s *adapter.Session, capability adapter.Capability) string {
+	name := "This adapter"
+	if s != nil && s.AdapterName != "" {
+		name = s.AdapterName
+	}
+	switch capability {
+	case adapter.CapMessages:
+		return name + " does not expose message history"
+	case adapter.CapUsage:
+		return name + " does not record token usage"
+	}
+	return name + " does not support " + string(capability)
+}
+
+// requireSelectedCapability returns a toast explaining the missing capability,
+// or nil when the selected session supports it.
+func (p *Plugin) requireSelectedCapability(capability adapter.Capability) tea.Cmd {
+	if p.selectedSupports(capability) {
+		return nil
+	}
+	return app.ShowToast(capabilityHint(p.findSelectedSession(), capability), 2*time.Second)
+}
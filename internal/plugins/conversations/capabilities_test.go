@@ -0,0 +1,61 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+// metadataOnlyAdapter lists sessions but exposes no messages or usage.
+type metadataOnlyAdapter struct {
+	mockAdapter
+}
+
+func (m *metadataOnlyAdapter) Capabilities() adapter.CapabilitySet {
+	return adapter.CapabilitySet{adapter.CapSessions: true, adapter.CapWatch: true}
+}
+
+func TestAdapterSupports(t *testing.T) {
+	if !adapterSupports(nil, adapter.CapMessages) {
+		t.Error("unknown adapter should be treated as capable")
+	}
+	if !adapterSupports(&mockAdapter{}, adapter.CapUsage) {
+		t.Error("adapter without declared capabilities should be treated as capable")
+	}
+	if adapterSupports(&metadataOnlyAdapter{}, adapter.CapMessages) {
+		t.Error("metadata-only adapter should not support messages")
+	}
+}
+
+func TestMetadataOnlySessionGating(t *testing.T) {
+	p := New()
+	p.width, p.height = 120, 40
+	p.adapters = map[string]adapter.Adapter{"meta": &metadataOnlyAdapter{}}
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "meta", AdapterName: "Meta", MessageCount: 5, TotalTokens: 1200}}
+	p.selectedSession = "s1"
+	p.activePane = PaneMessages
+
+	if out := p.renderMainPane(80, 20); !strings.Contains(out, "Meta does not expose message history") {
+		t.Errorf("expected capability hint, got %q", out)
+	}
+	if row := p.renderCompactSessionRow(p.sessions[0], false, 80); strings.Contains(row, "1.2k") {
+		t.Errorf("token column should be hidden without usage capability: %q", row)
+	}
+
+	_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || p.detailMode {
+		t.Error("enter should explain the missing capability instead of opening detail")
+	}
+	_, cmd = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	if cmd == nil || p.turnViewMode {
+		t.Error("v should not toggle turn view without message support")
+	}
+
+	for _, c := range p.Commands() {
+		if c.ID == "detail" || c.ID == "toggle-view" {
+			t.Errorf("command %q should be hidden for metadata-only sessions", c.ID)
+		}
+	}
+}
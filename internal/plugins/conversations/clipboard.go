@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/format"
 )
 
 // yankSessionDetails copies session summary to clipboard.
@@ -182,7 +183,7 @@ func formatSessionSummary(s *sessionRef) string {
 			sb.WriteString(fmt.Sprintf("- **Tokens:** %d\n", s.Tokens))
 		}
 		if s.EstCost > 0 {
-			sb.WriteString(fmt.Sprintf("- **Est. Cost:** $%.4f\n", s.EstCost))
+			sb.WriteString(fmt.Sprintf("- **Est. Cost:** %s\n", format.Money(s.EstCost, 4)))
 		}
 	}
 
@@ -58,19 +58,19 @@ func contentSearchHeaderSection(state *ContentSearchState, contentWidth int) mod
 			var sb strings.Builder
 
 			// Search prompt with query and cursor
-			sb.WriteString(styles.Subtitle.Render("Search: "))
+			sb.WriteString(styles.Current().Subtitle.Render("Search: "))
 
 			query := state.Query
 			if len(query) > contentWidth-12 {
 				query = query[:contentWidth-15] + "..."
 			}
-			sb.WriteString(styles.Body.Render(query))
-			sb.WriteString(styles.StatusInProgress.Render("\u2588")) // Block cursor
+			sb.WriteString(styles.Current().Body.Render(query))
+			sb.WriteString(styles.Current().StatusInProgress.Render("\u2588")) // Block cursor
 
 			// Show searching indicator
 			if state.IsSearching {
 				sb.WriteString("  ")
-				sb.WriteString(styles.Muted.Render("Searching..."))
+				sb.WriteString(styles.Current().Muted.Render("Searching..."))
 			}
 
 			// Show error if present
@@ -80,7 +80,7 @@ func contentSearchHeaderSection(state *ContentSearchState, contentWidth int) mod
 				if len(errMsg) > contentWidth {
 					errMsg = errMsg[:contentWidth-3] + "..."
 				}
-				sb.WriteString(styles.StatusDeleted.Render(errMsg))
+				sb.WriteString(styles.Current().StatusDeleted.Render(errMsg))
 			}
 
 			return modal.RenderedSection{Content: sb.String()}
@@ -96,25 +96,25 @@ func contentSearchOptionsSection(state *ContentSearchState) modal.Section {
 			var sb strings.Builder
 
 			// Regex toggle
-			regexStyle := styles.Muted
+			regexStyle := styles.Current().Muted
 			if state.UseRegex {
-				regexStyle = styles.StatusInProgress
+				regexStyle = styles.Current().StatusInProgress
 			}
 			sb.WriteString(regexStyle.Render("[.*]"))
-			sb.WriteString(styles.Subtle.Render(" regex"))
+			sb.WriteString(styles.Current().Subtle.Render(" regex"))
 
 			sb.WriteString("  ")
 
 			// Case sensitivity toggle
-			caseStyle := styles.Muted
+			caseStyle := styles.Current().Muted
 			if state.CaseSensitive {
-				caseStyle = styles.StatusInProgress
+				caseStyle = styles.Current().StatusInProgress
 			}
 			sb.WriteString(caseStyle.Render("[Aa]"))
-			sb.WriteString(styles.Subtle.Render(" case"))
+			sb.WriteString(styles.Current().Subtle.Render(" case"))
 
 			sb.WriteString("  ")
-			sb.WriteString(styles.Subtle.Render("(ctrl+r / alt+c to toggle)"))
+			sb.WriteString(styles.Current().Subtle.Render("(ctrl+r / alt+c to toggle)"))
 
 			return modal.RenderedSection{Content: sb.String()}
 		},
@@ -137,16 +137,16 @@ func contentSearchResultsSection(state *ContentSearchState, viewportHeight, cont
 			if len(state.Results) == 0 {
 				queryRunes := []rune(state.Query)
 				if len(queryRunes) == 0 {
-					return modal.RenderedSection{Content: styles.Muted.Render("Enter a search query...")}
+					return modal.RenderedSection{Content: styles.Current().Muted.Render("Enter a search query...")}
 				}
 				if len(queryRunes) < 2 {
-					return modal.RenderedSection{Content: styles.Muted.Render("Type at least 2 characters to search...")}
+					return modal.RenderedSection{Content: styles.Current().Muted.Render("Type at least 2 characters to search...")}
 				}
 				if state.IsSearching {
 					// Show animated skeleton loader while searching (td-e740e4)
 					return modal.RenderedSection{Content: state.Skeleton.View(contentWidth)}
 				}
-				return modal.RenderedSection{Content: styles.Muted.Render("No matches found")}
+				return modal.RenderedSection{Content: styles.Current().Muted.Render("No matches found")}
 			}
 
 			// Build all result lines
@@ -207,7 +207,7 @@ func contentSearchResultsSection(state *ContentSearchState, viewportHeight, cont
 			// Add scroll indicators if needed
 			var result strings.Builder
 			if scrollOffset > 0 {
-				result.WriteString(styles.Muted.Render(fmt.Sprintf("\u2191 %d more above", scrollOffset)))
+				result.WriteString(styles.Current().Muted.Render(fmt.Sprintf("\u2191 %d more above", scrollOffset)))
 				result.WriteString("\n")
 			}
 
@@ -221,7 +221,7 @@ func contentSearchResultsSection(state *ContentSearchState, viewportHeight, cont
 			remaining := len(allLines) - end
 			if remaining > 0 {
 				result.WriteString("\n")
-				result.WriteString(styles.Muted.Render(fmt.Sprintf("\u2193 %d more below", remaining)))
+				result.WriteString(styles.Current().Muted.Render(fmt.Sprintf("\u2193 %d more below", remaining)))
 			}
 
 			return modal.RenderedSection{Content: result.String()}
@@ -249,7 +249,7 @@ func contentSearchStatsSection(state *ContentSearchState) modal.Section {
 				} else {
 					statsText = fmt.Sprintf("%d matches in %d sessions", visibleMatches, sessionCount)
 				}
-				sb.WriteString(styles.Subtitle.Render(statsText))
+				sb.WriteString(styles.Current().Subtitle.Render(statsText))
 				sb.WriteString("  ")
 			}
 
@@ -258,7 +258,7 @@ func contentSearchStatsSection(state *ContentSearchState) modal.Section {
 			if contentWidth < 60 {
 				hints = "[\u2191\u2193] [enter] [tab] [esc]"
 			}
-			sb.WriteString(styles.Muted.Render(hints))
+			sb.WriteString(styles.Current().Muted.Render(hints))
 
 			return modal.RenderedSection{Content: sb.String()}
 		},
@@ -343,19 +343,19 @@ func renderSessionHeader(sr SessionSearchResult, selected bool, maxWidth int) st
 		if ansi.StringWidth(content) < maxWidth {
 			content += strings.Repeat(" ", maxWidth-ansi.StringWidth(content))
 		}
-		return styles.ListItemSelected.Render(content)
+		return selectedItemStyle.Render(content)
 	}
 
 	// Styled content for unselected row
-	sb.WriteString(styles.Muted.Render(chevron))
+	sb.WriteString(styles.Current().Muted.Render(chevron))
 	sb.WriteString(" ")
-	sb.WriteString(styles.Title.Render("\"" + name + "\""))
+	sb.WriteString(styles.Current().Title.Render("\"" + name + "\""))
 	sb.WriteString(" ")
-	sb.WriteString(styles.Code.Render("(" + adapterBadge + ")"))
+	sb.WriteString(styles.Current().Code.Render("(" + adapterBadge + ")"))
 	sb.WriteString(" ")
-	sb.WriteString(styles.Subtle.Render(timeAgo))
+	sb.WriteString(styles.Current().Subtle.Render(timeAgo))
 	sb.WriteString("  ")
-	sb.WriteString(styles.Muted.Render(countStr))
+	sb.WriteString(styles.Current().Muted.Render(countStr))
 
 	return sb.String()
 }
@@ -407,22 +407,22 @@ func renderMessageHeader(msg adapter.MessageMatch, selected bool, maxWidth int)
 		if ansi.StringWidth(content) < maxWidth {
 			content += strings.Repeat(" ", maxWidth-ansi.StringWidth(content))
 		}
-		return styles.ListItemSelected.Render(content)
+		return selectedItemStyle.Render(content)
 	}
 
 	// Styled content
 	sb.WriteString(indent)
 
 	// Role with color based on type
-	roleStyle := styles.StatusStaged // Default for assistant
+	roleStyle := styles.Current().StatusStaged // Default for assistant
 	if msg.Role == "user" {
-		roleStyle = styles.StatusInProgress
+		roleStyle = styles.Current().StatusInProgress
 	}
 	sb.WriteString(roleStyle.Render(roleBadge))
 	sb.WriteString(" ")
-	sb.WriteString(styles.Muted.Render(timestamp))
+	sb.WriteString(styles.Current().Muted.Render(timestamp))
 	sb.WriteString(" ")
-	sb.WriteString(styles.Body.Render("\"" + preview + "\""))
+	sb.WriteString(styles.Current().Body.Render("\"" + preview + "\""))
 
 	return sb.String()
 }
@@ -517,12 +517,12 @@ func renderMatchLine(match adapter.ContentMatch, query string, caseSensitive, se
 		if ansi.StringWidth(content) < maxWidth {
 			content += strings.Repeat(" ", maxWidth-ansi.StringWidth(content))
 		}
-		return styles.ListItemSelected.Render(content)
+		return selectedItemStyle.Render(content)
 	}
 
 	// Styled content with ALL matches highlighted (td-c24c84)
 	sb.WriteString(indent)
-	sb.WriteString(styles.Muted.Render(linePrefix))
+	sb.WriteString(styles.Current().Muted.Render(linePrefix))
 
 	// Highlight all occurrences of the query
 	highlightedText := highlightAllMatches(displayText, query, caseSensitive)
@@ -540,26 +540,26 @@ func highlightMatchRunes(text string, colStart, colEnd int) string {
 
 	if colStart < 0 || colEnd < 0 || colStart >= runeLen || colEnd > runeLen || colStart >= colEnd {
 		// Invalid range, return text with muted styling
-		return styles.Muted.Render(text)
+		return styles.Current().Muted.Render(text)
 	}
 
 	var sb strings.Builder
 
 	// Before match
 	if colStart > 0 {
-		sb.WriteString(styles.Muted.Render(string(runes[:colStart])))
+		sb.WriteString(styles.Current().Muted.Render(string(runes[:colStart])))
 	}
 
 	// Matched portion with highlight
 	matchStyle := lipgloss.NewStyle().
-		Background(styles.Warning).   // Yellow/amber background
-		Foreground(styles.BgPrimary). // Dark text for contrast
+		Background(styles.Current().Warning).   // Yellow/amber background
+		Foreground(styles.Current().BgPrimary). // Dark text for contrast
 		Bold(true)
 	sb.WriteString(matchStyle.Render(string(runes[colStart:colEnd])))
 
 	// After match
 	if colEnd < runeLen {
-		sb.WriteString(styles.Muted.Render(string(runes[colEnd:])))
+		sb.WriteString(styles.Current().Muted.Render(string(runes[colEnd:])))
 	}
 
 	return sb.String()
@@ -570,7 +570,7 @@ func highlightMatchRunes(text string, colStart, colEnd int) string {
 // Uses rune-safe iteration for UTF-8 support.
 func highlightAllMatches(text, query string, caseSensitive bool) string {
 	if query == "" {
-		return styles.Muted.Render(text)
+		return styles.Current().Muted.Render(text)
 	}
 
 	runes := []rune(text)
@@ -579,7 +579,7 @@ func highlightAllMatches(text, query string, caseSensitive bool) string {
 	queryLen := len(queryRunes)
 
 	if queryLen == 0 || queryLen > runeLen {
-		return styles.Muted.Render(text)
+		return styles.Current().Muted.Render(text)
 	}
 
 	// Prepare search text (case-fold if needed)
@@ -607,13 +607,13 @@ func highlightAllMatches(text, query string, caseSensitive bool) string {
 	}
 
 	if len(matches) == 0 {
-		return styles.Muted.Render(text)
+		return styles.Current().Muted.Render(text)
 	}
 
 	// Build result string with highlighted matches
 	matchStyle := lipgloss.NewStyle().
-		Background(styles.Warning).   // Yellow/amber background
-		Foreground(styles.BgPrimary). // Dark text for contrast
+		Background(styles.Current().Warning).   // Yellow/amber background
+		Foreground(styles.Current().BgPrimary). // Dark text for contrast
 		Bold(true)
 
 	var sb strings.Builder
@@ -622,7 +622,7 @@ func highlightAllMatches(text, query string, caseSensitive bool) string {
 		start, end := m[0], m[1]
 		// Add text before this match
 		if pos < start {
-			sb.WriteString(styles.Muted.Render(string(runes[pos:start])))
+			sb.WriteString(styles.Current().Muted.Render(string(runes[pos:start])))
 		}
 		// Add highlighted match
 		sb.WriteString(matchStyle.Render(string(runes[start:end])))
@@ -630,7 +630,7 @@ func highlightAllMatches(text, query string, caseSensitive bool) string {
 	}
 	// Add remaining text after last match
 	if pos < runeLen {
-		sb.WriteString(styles.Muted.Render(string(runes[pos:])))
+		sb.WriteString(styles.Current().Muted.Render(string(runes[pos:])))
 	}
 
 	return sb.String()
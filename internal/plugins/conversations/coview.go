@@ -0,0 +1,303 @@
+package conversations
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/features"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+const (
+	// coviewDir holds one position file per sharing instance, relative to
+	// the project root, next to the workspace shell manifest.
+	coviewDir = ".forge/coview"
+
+	// coviewInterval is how often a sharing instance publishes its position
+	// and reads its peers'.
+	coviewInterval = time.Second
+
+	// coviewPeerTTL is how long a position is trusted without a heartbeat.
+	// Instances that exit without cleaning up drop out after this.
+	coviewPeerTTL = 30 * time.Second
+
+	// coviewHeartbeat is how often an unchanged position is rewritten.
+	coviewHeartbeat = coviewPeerTTL / 3
+
+	// coviewBadge marks sessions a peer is viewing.
+	coviewBadge = "◉"
+)
+
+// CoviewPosition is what a sharing instance publishes: which session and
+// message it has selected. Message content is never written.
+type CoviewPosition struct {
+	InstanceID string    `json:"instanceId"`
+	PID        int       `json:"pid"`
+	User       string    `json:"user,omitempty"`
+	AdapterID  string    `json:"adapterId,omitempty"`
+	SessionID  string    `json:"sessionId,omitempty"`
+	MessageID  string    `json:"messageId,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// samePlace reports whether two positions point at the same message.
+func (c CoviewPosition) samePlace(o CoviewPosition) bool {
+	return c.AdapterID == o.AdapterID && c.SessionID == o.SessionID && c.MessageID == o.MessageID
+}
+
+// viewer returns a short label for the peer.
+func (c CoviewPosition) viewer() string {
+	if c.User != "" {
+		return c.User
+	}
+	return fmt.Sprintf("pid %d", c.PID)
+}
+
+// coviewState tracks this instance's sharing and the peers it can see.
+type coviewState struct {
+	sharing   bool
+	token     int // invalidates ticks from earlier sharing sessions
+	published CoviewPosition
+	peers     []CoviewPosition // newest first
+}
+
+// CoviewTickMsg triggers publishing this instance's position.
+type CoviewTickMsg struct {
+	Epoch uint64
+	Token int
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m CoviewTickMsg) GetEpoch() uint64 { return m.Epoch }
+
+// CoviewPeersMsg delivers the positions read after publishing.
+type CoviewPeersMsg struct {
+	Epoch     uint64
+	Token     int
+	Published CoviewPosition
+	Peers     []CoviewPosition
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m CoviewPeersMsg) GetEpoch() uint64 { return m.Epoch }
+
+// coviewInstanceID identifies this process among instances sharing a
+// project directory, which may live on a shared filesystem.
+var coviewInstanceID = func() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}()
+
+// coviewPath returns this instance's position file, or "" without a project.
+func (p *Plugin) coviewPath() string {
+	if p.ctx == nil || p.ctx.ProjectRoot == "" {
+		return ""
+	}
+	return filepath.Join(p.ctx.ProjectRoot, coviewDir, coviewInstanceID+".json")
+}
+
+// toggleCoview starts or stops sharing this instance's position. Sharing is
+// off by default and requires the conversation_coview feature flag; peers
+// are only read while sharing, so viewing is reciprocal.
+func (p *Plugin) toggleCoview() tea.Cmd {
+	if p.coview.sharing {
+		p.stopCoview()
+		return app.ShowToast("Co-viewing off", 2*time.Second)
+	}
+	if !features.IsEnabled(features.ConversationCoview.Name) {
+		return func() tea.Msg {
+			return app.ToastMsg{Message: "Co-viewing is disabled (enable the " + features.ConversationCoview.Name + " feature)", Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	if p.coviewPath() == "" {
+		return nil
+	}
+	p.coview.sharing = true
+	p.coview.token++
+	return tea.Batch(
+		app.ShowToast("Co-viewing on: sharing your selected session", 2*time.Second),
+		p.coviewTick(),
+	)
+}
+
+// stopCoview stops sharing and removes this instance's position file.
+func (p *Plugin) stopCoview() {
+	if !p.coview.sharing {
+		return
+	}
+	p.coview.sharing = false
+	p.coview.token++
+	p.coview.published = CoviewPosition{}
+	p.coview.peers = nil
+	if path := p.coviewPath(); path != "" {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			slog.Debug("coview: remove failed", "err", err)
+		}
+	}
+}
+
+// coviewTick publishes the current position, then schedules the next tick.
+func (p *Plugin) coviewTick() tea.Cmd {
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	token := p.coview.token
+	pos := p.coviewPosition()
+	last := p.coview.published
+	write := !pos.samePlace(last) || time.Since(last.UpdatedAt) >= coviewHeartbeat
+	path := p.coviewPath()
+	return func() tea.Msg {
+		if write {
+			pos.UpdatedAt = time.Now()
+			if err := writeCoviewPosition(path, pos); err != nil {
+				slog.Debug("coview: write failed", "err", err)
+			}
+		} else {
+			pos = last
+		}
+		return CoviewPeersMsg{
+			Epoch:     epoch,
+			Token:     token,
+			Published: pos,
+			Peers:     readCoviewPeers(filepath.Dir(path), coviewInstanceID, time.Now()),
+		}
+	}
+}
+
+// handleCoviewMessage processes co-viewing ticks and peer updates.
+func (p *Plugin) handleCoviewMessage(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case CoviewTickMsg:
+		if plugin.IsStale(p.ctx, msg) || msg.Token != p.coview.token || !p.coview.sharing {
+			return nil
+		}
+		if !features.IsEnabled(features.ConversationCoview.Name) {
+			p.stopCoview()
+			return nil
+		}
+		return p.coviewTick()
+
+	case CoviewPeersMsg:
+		if plugin.IsStale(p.ctx, msg) || msg.Token != p.coview.token || !p.coview.sharing {
+			return nil
+		}
+		p.coview.published = msg.Published
+		p.coview.peers = msg.Peers
+		token, epoch := msg.Token, msg.Epoch
+		return tea.Tick(coviewInterval, func(time.Time) tea.Msg {
+			return CoviewTickMsg{Epoch: epoch, Token: token}
+		})
+	}
+	return nil
+}
+
+// coviewPosition returns the session and message this instance has selected.
+func (p *Plugin) coviewPosition() CoviewPosition {
+	pos := CoviewPosition{InstanceID: coviewInstanceID, PID: os.Getpid(), User: os.Getenv("USER")}
+	session := p.findSelectedSession()
+	if session == nil {
+		return pos
+	}
+	pos.AdapterID = session.AdapterID
+	pos.SessionID = session.ID
+	if p.activePane == PaneMessages {
+		if msg := p.permalinkMessage(); msg != nil {
+			pos.MessageID = msg.ID
+		}
+	}
+	return pos
+}
+
+// coviewPeersOn returns the peers viewing a session.
+func (p *Plugin) coviewPeersOn(sessionID string) []CoviewPosition {
+	var peers []CoviewPosition
+	for _, peer := range p.coview.peers {
+		if peer.SessionID == sessionID {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// coviewHeaderText describes who else is viewing the session, or "".
+func (p *Plugin) coviewHeaderText(sessionID string) string {
+	peers := p.coviewPeersOn(sessionID)
+	switch len(peers) {
+	case 0:
+		return ""
+	case 1:
+		return coviewBadge + " " + peers[0].viewer()
+	}
+	return fmt.Sprintf("%s %d viewers", coviewBadge, len(peers))
+}
+
+// jumpToCoviewPeer moves to the most recently updated peer's position.
+func (p *Plugin) jumpToCoviewPeer() tea.Cmd {
+	if !p.coview.sharing {
+		return app.ShowToast("Co-viewing is off (P to share)", 2*time.Second)
+	}
+	if len(p.coview.peers) == 0 {
+		return app.ShowToast("No one else is co-viewing this project", 2*time.Second)
+	}
+	peer := p.coview.peers[0]
+	if peer.SessionID == "" {
+		return app.ShowToast(peer.viewer()+" has no session selected", 2*time.Second)
+	}
+	cmd := p.openPermalink(Permalink{AdapterID: peer.AdapterID, SessionID: peer.SessionID, MessageID: peer.MessageID})
+	return tea.Batch(cmd, app.ShowToast("Jumped to "+peer.viewer(), 2*time.Second))
+}
+
+// writeCoviewPosition writes a position file atomically.
+func writeCoviewPosition(path string, pos CoviewPosition) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// readCoviewPeers reads other instances' positions from dir, skipping self
+// and positions not refreshed within coviewPeerTTL. Newest first.
+func readCoviewPeers(dir, self string, now time.Time) []CoviewPosition {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var peers []CoviewPosition
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") || strings.TrimSuffix(name, ".json") == self {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		var pos CoviewPosition
+		if err := json.Unmarshal(data, &pos); err != nil || pos.InstanceID == self {
+			continue
+		}
+		if now.Sub(pos.UpdatedAt) > coviewPeerTTL {
+			continue
+		}
+		peers = append(peers, pos)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].UpdatedAt.After(peers[j].UpdatedAt) })
+	return peers
+}
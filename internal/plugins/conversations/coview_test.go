@@ -0,0 +1,104 @@
+package conversations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/features"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func enableCoview(t *testing.T, enabled bool) {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Features.Flags = map[string]bool{features.ConversationCoview.Name: enabled}
+	features.Init(cfg)
+	t.Cleanup(func() { features.Init(&config.Config{}) })
+}
+
+func TestReadCoviewPeers(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	for name, pos := range map[string]CoviewPosition{
+		"self.json":  {InstanceID: "self", SessionID: "a", UpdatedAt: now},
+		"old.json":   {InstanceID: "old", SessionID: "a", UpdatedAt: now.Add(-2 * coviewPeerTTL)},
+		"alice.json": {InstanceID: "alice", SessionID: "a", UpdatedAt: now.Add(-5 * time.Second)},
+		"bob.json":   {InstanceID: "bob", SessionID: "b", UpdatedAt: now.Add(-time.Second)},
+	} {
+		if err := writeCoviewPosition(filepath.Join(dir, name), pos); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "junk.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	peers := readCoviewPeers(dir, "self", now)
+	if len(peers) != 2 || peers[0].InstanceID != "bob" || peers[1].InstanceID != "alice" {
+		t.Fatalf("peers = %+v, want bob then alice", peers)
+	}
+}
+
+func TestToggleCoview_RequiresFeature(t *testing.T) {
+	enableCoview(t, false)
+	p := splitTestPlugin()
+	p.ctx = &plugin.Context{ProjectRoot: t.TempDir()}
+
+	p.toggleCoview()
+	if p.coview.sharing {
+		t.Error("sharing should stay off while the feature is disabled")
+	}
+}
+
+func TestCoview_PublishesAndSeesPeers(t *testing.T) {
+	enableCoview(t, true)
+	root := t.TempDir()
+	p := splitTestPlugin()
+	p.ctx = &plugin.Context{ProjectRoot: root}
+
+	if p.toggleCoview() == nil || !p.coview.sharing {
+		t.Fatal("expected sharing to start")
+	}
+
+	peer := CoviewPosition{InstanceID: "peer", User: "alice", AdapterID: "mock", SessionID: "c", UpdatedAt: time.Now()}
+	if err := writeCoviewPosition(filepath.Join(root, coviewDir, "peer.json"), peer); err != nil {
+		t.Fatal(err)
+	}
+
+	msg, ok := p.coviewTick()().(CoviewPeersMsg)
+	if !ok {
+		t.Fatal("expected CoviewPeersMsg")
+	}
+	if msg.Published.SessionID != "b" {
+		t.Errorf("published session = %q, want b", msg.Published.SessionID)
+	}
+	if _, err := os.Stat(p.coviewPath()); err != nil {
+		t.Fatalf("position file not written: %v", err)
+	}
+
+	if p.handleCoviewMessage(msg) == nil {
+		t.Error("expected the next tick to be scheduled")
+	}
+	if got := p.coviewHeaderText("c"); got != coviewBadge+" alice" {
+		t.Errorf("header text = %q", got)
+	}
+
+	p.jumpToCoviewPeer()
+	if p.selectedSession != "c" {
+		t.Errorf("selected = %q after jump, want c", p.selectedSession)
+	}
+
+	p.toggleCoview()
+	if p.coview.sharing || len(p.coview.peers) != 0 {
+		t.Error("expected sharing off and peers cleared")
+	}
+	if _, err := os.Stat(p.coviewPath()); !os.IsNotExist(err) {
+		t.Error("position file should be removed when sharing stops")
+	}
+	if p.handleCoviewMessage(msg) != nil {
+		t.Error("ticks from a previous sharing session should be ignored")
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/atotto/clipboard"
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/format"
 )
 
 // ExportSessionAsMarkdown converts a session and its messages to markdown format.
@@ -34,7 +35,7 @@ func ExportSessionAsMarkdown(session *adapter.Session, messages []adapter.Messag
 			sb.WriteString(fmt.Sprintf("**Tokens**: %d\n", session.TotalTokens))
 		}
 		if session.EstCost > 0 {
-			sb.WriteString(fmt.Sprintf("**Estimated Cost**: $%.2f\n", session.EstCost))
+			sb.WriteString(fmt.Sprintf("**Estimated Cost**: %s\n", format.Money(session.EstCost, 2)))
 		}
 		sb.WriteString("\n---\n\n")
 	}
@@ -101,24 +102,37 @@ func CopyToClipboard(content string) error {
 // ExportSessionToFile writes a session to a markdown file.
 func ExportSessionToFile(session *adapter.Session, messages []adapter.Message, workDir string) (string, error) {
 	md := ExportSessionAsMarkdown(session, messages)
+	filename := exportFilename(session, "md")
+	if err := os.WriteFile(filepath.Join(workDir, filename), []byte(md), 0644); err != nil {
+		return "", err
+	}
+	return filename, nil
+}
 
-	// Generate filename from session name or ID
+// ExportSessionToJSONFile writes a session as a canonical session JSON
+// document (see adapter.MarshalSession) that can be re-imported losslessly.
+func ExportSessionToJSONFile(session *adapter.Session, messages []adapter.Message, usage *adapter.UsageStats, workDir string) (string, error) {
+	data, err := adapter.MarshalSession(session, messages, usage)
+	if err != nil {
+		return "", err
+	}
+	filename := exportFilename(session, "json")
+	if err := os.WriteFile(filepath.Join(workDir, filename), data, 0644); err != nil {
+		return "", err
+	}
+	return filename, nil
+}
+
+// exportFilename builds a timestamped export filename from the session name or ID.
+func exportFilename(session *adapter.Session, ext string) string {
 	name := "session"
 	if session != nil && session.Name != "" {
 		name = sanitizeFilename(session.Name)
 	} else if session != nil {
-		name = session.ID[:8]
+		name = shortID(session.ID)
 	}
-
 	timestamp := time.Now().Format("20060102-150405")
-	filename := fmt.Sprintf("%s-%s.md", name, timestamp)
-	path := filepath.Join(workDir, filename)
-
-	if err := os.WriteFile(path, []byte(md), 0644); err != nil {
-		return "", err
-	}
-
-	return filename, nil
+	return fmt.Sprintf("%s-%s.%s", name, timestamp, ext)
 }
 
 // formatExportDuration formats duration for export.
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/testutil"
 )
 
 func TestSanitizeFilename(t *testing.T) {
@@ -168,3 +169,33 @@ func TestExportSessionAsMarkdown_WithThinkingBlocks(t *testing.T) {
 		t.Error("should show token count in thinking summary")
 	}
 }
+
+func TestExportSessionToJSONFile_ImportsIntoFakeAdapter(t *testing.T) {
+	dir := t.TempDir()
+	session := &adapter.Session{ID: "abc12345-xyz", Name: "Fix: login", AdapterID: "claude-code", UpdatedAt: time.Now()}
+	messages := []adapter.Message{
+		{ID: "m1", Role: "user", Content: "hello"},
+		{ID: "m2", Role: "assistant", Content: "hi", Model: "claude-opus-4-5", TokenUsage: adapter.TokenUsage{InputTokens: 5}},
+	}
+
+	filename, err := ExportSessionToJSONFile(session, messages, nil, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(filename, "Fix- login-") || !strings.HasSuffix(filename, ".json") {
+		t.Errorf("filename = %q", filename)
+	}
+
+	fake := testutil.NewFakeAdapter("fake")
+	if err := fake.LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	sessions, _ := fake.Sessions("")
+	if len(sessions) != 1 || sessions[0].Name != "Fix: login" || sessions[0].AdapterID != "fake" {
+		t.Fatalf("imported sessions = %+v", sessions)
+	}
+	got, _ := fake.Messages(session.ID)
+	if len(got) != 2 || got[1].InputTokens != 5 || got[1].Model != "claude-opus-4-5" {
+		t.Errorf("imported messages = %+v", got)
+	}
+}
@@ -0,0 +1,124 @@
+package conversations
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/palette"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+const (
+	// globalSearchMaxResults caps session and message hits returned to the app search.
+	globalSearchMaxResults = 20
+	// globalSearchMessageSessions is how many recent sessions are scanned for message hits.
+	globalSearchMessageSessions = 30
+	// globalSearchMinMessageQuery avoids full-text scans for very short queries.
+	globalSearchMinMessageQuery = 3
+)
+
+// OpenPermalinkMsg asks the plugin to navigate to a session or message.
+// Sent by the app-wide search when a conversation result is opened.
+type OpenPermalinkMsg struct {
+	Link Permalink
+}
+
+// GlobalSearch contributes session name and message content matches to the
+// app-wide search.
+func (p *Plugin) GlobalSearch(query string) func() []plugin.SearchResult {
+	if len(p.sessions) == 0 || strings.TrimSpace(query) == "" {
+		return nil
+	}
+	sessions := append([]adapter.Session(nil), p.sessions...)
+	adapters := p.adapters
+	return func() []plugin.SearchResult {
+		results := searchSessionNames(sessions, query)
+		if len(query) >= globalSearchMinMessageQuery {
+			results = append(results, searchSessionMessages(sessions, adapters, query)...)
+		}
+		return results
+	}
+}
+
+// searchSessionNames fuzzy-matches session names and ID prefixes.
+func searchSessionNames(sessions []adapter.Session, query string) []plugin.SearchResult {
+	var results []plugin.SearchResult
+	for _, s := range sessions {
+		score, _ := palette.FuzzyMatch(query, s.Name)
+		if strings.HasPrefix(s.ID, query) {
+			score += 100
+		}
+		if score == 0 {
+			continue
+		}
+		name := s.Name
+		if name == "" {
+			name = shortID(s.ID)
+		}
+		results = append(results, plugin.SearchResult{
+			Kind:     plugin.SearchKindSession,
+			Title:    name,
+			Detail:   s.AdapterName + " · " + formatTimeAgo(s.UpdatedAt),
+			Score:    score,
+			PluginID: pluginID,
+			Open:     OpenPermalinkMsg{Link: Permalink{AdapterID: s.AdapterID, SessionID: s.ID}},
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > globalSearchMaxResults {
+		results = results[:globalSearchMaxResults]
+	}
+	return results
+}
+
+// searchSessionMessages runs a literal content search over the most recent
+// sessions whose adapters support it. More recent sessions score higher.
+func searchSessionMessages(sessions []adapter.Session, adapters map[string]adapter.Adapter, query string) []plugin.SearchResult {
+	var results []plugin.SearchResult
+	opts := adapter.SearchOptions{MaxResults: 3}
+	for i, s := range sessions {
+		if i >= globalSearchMessageSessions || len(results) >= globalSearchMaxResults {
+			break
+		}
+		searcher, ok := adapters[s.AdapterID].(adapter.MessageSearcher)
+		if !ok {
+			continue
+		}
+		matches, err := searcher.SearchMessages(s.ID, query, opts)
+		if err != nil {
+			continue
+		}
+		for _, m := range matches {
+			if len(m.Matches) == 0 {
+				continue
+			}
+			name := s.Name
+			if name == "" {
+				name = shortID(s.ID)
+			}
+			results = append(results, plugin.SearchResult{
+				Kind:     plugin.SearchKindMessage,
+				Title:    strings.TrimSpace(m.Matches[0].LineText),
+				Detail:   m.Role + " in " + name,
+				Score:    globalSearchMessageSessions - i,
+				PluginID: pluginID,
+				Open:     OpenPermalinkMsg{Link: Permalink{AdapterID: s.AdapterID, SessionID: s.ID, MessageID: m.MessageID}},
+			})
+			if len(results) >= globalSearchMaxResults {
+				break
+			}
+		}
+	}
+	return results
+}
+
+// openPermalink navigates to a link, waiting for sessions if still loading.
+func (p *Plugin) openPermalink(l Permalink) tea.Cmd {
+	p.pendingPermalink = &l
+	p.view = ViewSessions
+	p.toolPager = nil
+	p.imageViewer = nil
+	return p.applyPendingPermalink(!p.loadingAdapters)
+}
@@ -0,0 +1,43 @@
+package conversations
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestSearchSessionNames(t *testing.T) {
+	sessions := []adapter.Session{
+		{ID: "a1", AdapterID: "claude-code", Name: "Refactor auth middleware"},
+		{ID: "b2", AdapterID: "codex", Name: "Update docs"},
+		{ID: "auth99", AdapterID: "codex"},
+	}
+
+	results := searchSessionNames(sessions, "auth")
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	// ID prefix match ranks first
+	if results[0].Title != shortID("auth99") {
+		t.Errorf("first result = %q, want ID prefix match", results[0].Title)
+	}
+	open, ok := results[1].Open.(OpenPermalinkMsg)
+	if !ok || open.Link != (Permalink{AdapterID: "claude-code", SessionID: "a1"}) {
+		t.Errorf("Open = %#v", results[1].Open)
+	}
+	if results[1].Kind != plugin.SearchKindSession || results[1].PluginID != pluginID {
+		t.Errorf("unexpected result %+v", results[1])
+	}
+}
+
+func TestOpenPermalinkMsg(t *testing.T) {
+	p := New()
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "claude-code"}}
+	p.view = ViewAnalytics
+
+	p.Update(OpenPermalinkMsg{Link: Permalink{AdapterID: "claude-code", SessionID: "s1"}})
+	if p.view != ViewSessions || p.selectedSession != "s1" || p.pendingPermalink != nil {
+		t.Errorf("view=%v selected=%q pending=%v", p.view, p.selectedSession, p.pendingPermalink)
+	}
+}
@@ -0,0 +1,275 @@
+package conversations
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/image"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// imageViewerState holds the overlay showing a message's image attachments.
+type imageViewerState struct {
+	images []adapter.ContentBlock
+	index  int
+	path   string // temp file for the current image ("" if it couldn't be written)
+	err    error  // error writing the current image
+}
+
+// imagePlaceholder is the inline label shown in place of an image block.
+func imagePlaceholder(b adapter.ContentBlock) string {
+	if b.ImageWidth > 0 && b.ImageHeight > 0 {
+		return fmt.Sprintf("[image %dx%d]", b.ImageWidth, b.ImageHeight)
+	}
+	if ext := imageExtension(b.MediaType); ext != "" {
+		return "[image " + strings.TrimPrefix(ext, ".") + "]"
+	}
+	return "[image]"
+}
+
+// imageExtension maps a media type to a file extension.
+func imageExtension(mediaType string) string {
+	switch mediaType {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	}
+	return ""
+}
+
+// collectImages returns the image blocks of the given messages.
+func collectImages(msgs []adapter.Message) []adapter.ContentBlock {
+	var images []adapter.ContentBlock
+	for i := range msgs {
+		for _, b := range msgs[i].ContentBlocks {
+			if b.Type == "image" && b.ImageData != "" {
+				images = append(images, b)
+			}
+		}
+	}
+	return images
+}
+
+// selectedImages returns the images of the selected message, or of the
+// whole turn in turn/detail view.
+func (p *Plugin) selectedImages() []adapter.ContentBlock {
+	if p.turnViewMode || p.detailMode {
+		if turn := p.getCurrentTurn(); turn != nil {
+			return collectImages(turn.Messages)
+		}
+		return nil
+	}
+	if msg := p.getSelectedMessage(); msg != nil {
+		return collectImages([]adapter.Message{*msg})
+	}
+	return nil
+}
+
+var (
+	imageDirMu sync.Mutex
+	imageDir   string // this process's image dir, "" until first use
+)
+
+// imageTempDir returns a private directory for decoded images. It comes
+// from os.MkdirTemp, so another user can't pre-create it or swap in a
+// symlink the way they could with a fixed name under the shared temp dir.
+func imageTempDir() (string, error) {
+	imageDirMu.Lock()
+	defer imageDirMu.Unlock()
+	if imageDir != "" {
+		if _, err := os.Stat(imageDir); err == nil {
+			return imageDir, nil
+		}
+	}
+	dir, err := os.MkdirTemp("", "forge-images-")
+	if err != nil {
+		return "", err
+	}
+	imageDir = dir
+	return dir, nil
+}
+
+// removeImageTempDir deletes the decoded images when the plugin stops.
+func removeImageTempDir() {
+	imageDirMu.Lock()
+	defer imageDirMu.Unlock()
+	if imageDir != "" {
+		_ = os.RemoveAll(imageDir)
+		imageDir = ""
+	}
+}
+
+// writeImageFile decodes an image block into a content-addressed temp file
+// so it can be rendered or handed to an external viewer.
+func writeImageFile(b adapter.ContentBlock) (string, error) {
+	if base64.StdEncoding.DecodedLen(len(b.ImageData)) > image.MaxImageSize {
+		return "", errors.New("image too large (>10MB)")
+	}
+	data, err := base64.StdEncoding.DecodeString(b.ImageData)
+	if err != nil {
+		return "", fmt.Errorf("decode image: %w", err)
+	}
+	ext := imageExtension(b.MediaType)
+	if ext == "" {
+		ext = ".img"
+	}
+	sum := sha256.Sum256(data)
+	dir, err := imageTempDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, hex.EncodeToString(sum[:8])+ext)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// load selects image idx and writes it out for rendering.
+func (s *imageViewerState) load(idx int) {
+	s.index = idx
+	s.path, s.err = writeImageFile(s.images[idx])
+}
+
+// openImageViewer shows the selected message's images in an overlay.
+func (p *Plugin) openImageViewer() tea.Cmd {
+	images := p.selectedImages()
+	if len(images) == 0 {
+		return app.ShowToast("No images in this message", 2*time.Second)
+	}
+	if p.imageRenderer == nil {
+		p.imageRenderer = image.New()
+	}
+	p.imageViewer = &imageViewerState{images: images}
+	p.imageViewer.load(0)
+	return nil
+}
+
+// closeImageViewer dismisses the image overlay.
+func (p *Plugin) closeImageViewer() {
+	p.imageViewer = nil
+}
+
+// handleImageViewerKey handles keys while the image overlay is open.
+func (p *Plugin) handleImageViewerKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	s := p.imageViewer
+	switch msg.String() {
+	case "esc", "q", "I":
+		p.closeImageViewer()
+	case "]", "tab", "l", "right":
+		s.load((s.index + 1) % len(s.images))
+	case "[", "shift+tab", "h", "left":
+		s.load((s.index - 1 + len(s.images)) % len(s.images))
+	case "o":
+		if s.path == "" {
+			return p, nil
+		}
+		return p, openImageExternally(s.path)
+	}
+	return p, nil
+}
+
+// openImageExternally opens an image file with the system viewer.
+func openImageExternally(path string) tea.Cmd {
+	return func() tea.Msg {
+		var cmd *exec.Cmd
+		switch runtime.GOOS {
+		case "darwin":
+			cmd = exec.Command("open", path)
+		case "linux":
+			cmd = exec.Command("xdg-open", path)
+		case "windows":
+			cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+		default:
+			return app.ToastMsg{Message: "Open not supported on " + runtime.GOOS, Duration: 3 * time.Second, IsError: true}
+		}
+		if err := cmd.Start(); err != nil {
+			return app.ToastMsg{Message: "Open failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+		go func() { _ = cmd.Wait() }()
+		return app.ToastMsg{Message: "Opened " + filepath.Base(path), Duration: 2 * time.Second}
+	}
+}
+
+// renderImageViewer renders the image overlay using the shared image renderer.
+func (p *Plugin) renderImageViewer(width, height int) string {
+	s := p.imageViewer
+	modalW, bodyH := p.toolPagerDims()
+	b := s.images[s.index]
+
+	title := imagePlaceholder(b)
+	if len(s.images) > 1 {
+		title = fmt.Sprintf("Image %d/%d %s", s.index+1, len(s.images), title)
+	}
+
+	body := modal.Custom(
+		func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+			content := p.renderImageBody(contentWidth, bodyH)
+			lines := strings.Split(content, "\n")
+			for len(lines) < bodyH {
+				lines = append(lines, "")
+			}
+			return modal.RenderedSection{Content: strings.Join(lines[:bodyH], "\n")}
+		},
+		nil,
+	)
+
+	status := []string{b.MediaType, "terminal: " + p.imageRenderer.Protocol().String(), "o open externally"}
+	if len(s.images) > 1 {
+		status = append(status, "[/] prev/next")
+	}
+	m := modal.New(ui.TruncateString(title, modalW-8),
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(body).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Custom(
+			func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+				return modal.RenderedSection{Content: styles.Current().Muted.Render(ui.TruncateString(strings.Join(status, " · "), contentWidth))}
+			},
+			nil,
+		))
+
+	return ui.OverlayModal(p.renderTwoPane(), m.Render(width, height, nil), width, height)
+}
+
+// renderImageBody renders the current image, or a muted error/fallback message.
+func (p *Plugin) renderImageBody(width, height int) string {
+	s := p.imageViewer
+	if s.err != nil {
+		return styles.Current().Muted.Render("Image error: " + s.err.Error())
+	}
+	result, err := p.imageRenderer.Render(s.path, width, height)
+	if err != nil {
+		return styles.Current().Muted.Render("Image error: " + err.Error())
+	}
+	if result.IsFallback {
+		return styles.Current().Muted.Render(result.Content)
+	}
+	return result.Content
+}
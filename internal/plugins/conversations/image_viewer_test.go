@@ -0,0 +1,124 @@
+package conversations
+
+import (
+	"bytes"
+	"encoding/base64"
+	goimage "image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+// pngBlock returns an image content block holding a w×h PNG.
+func pngBlock(t *testing.T, w, h int) adapter.ContentBlock {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, goimage.NewRGBA(goimage.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	return adapter.ContentBlock{
+		Type:        "image",
+		MediaType:   "image/png",
+		ImageData:   base64.StdEncoding.EncodeToString(buf.Bytes()),
+		ImageWidth:  w,
+		ImageHeight: h,
+	}
+}
+
+func TestImagePlaceholder(t *testing.T) {
+	tests := []struct {
+		block adapter.ContentBlock
+		want  string
+	}{
+		{adapter.ContentBlock{ImageWidth: 800, ImageHeight: 600}, "[image 800x600]"},
+		{adapter.ContentBlock{MediaType: "image/webp"}, "[image webp]"},
+		{adapter.ContentBlock{}, "[image]"},
+	}
+	for _, tt := range tests {
+		if got := imagePlaceholder(tt.block); got != tt.want {
+			t.Errorf("imagePlaceholder(%+v) = %q, want %q", tt.block, got, tt.want)
+		}
+	}
+}
+
+func TestWriteImageFile(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	b := pngBlock(t, 4, 4)
+
+	path, err := writeImageFile(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(path, ".png") {
+		t.Errorf("path = %q, want .png extension", path)
+	}
+	if info, err := os.Stat(filepath.Dir(path)); err != nil {
+		t.Fatal(err)
+	} else if info.Mode().Perm() != 0o700 {
+		t.Errorf("image dir mode = %v, want private", info.Mode())
+	}
+	if filepath.Base(filepath.Dir(path)) == "forge-images" {
+		t.Error("image dir should not have a predictable name")
+	}
+	data, _ := os.ReadFile(path)
+	if base64.StdEncoding.EncodeToString(data) != b.ImageData {
+		t.Error("written file does not match image data")
+	}
+
+	// Same content maps to the same file
+	if again, _ := writeImageFile(b); again != path {
+		t.Errorf("expected stable path, got %q then %q", path, again)
+	}
+
+	if _, err := writeImageFile(adapter.ContentBlock{Type: "image", ImageData: "!!"}); err == nil {
+		t.Error("expected decode error for invalid data")
+	}
+}
+
+func TestImageViewer_OpenAndNavigate(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	p := New()
+	p.width, p.height = 100, 40
+	p.messages = []adapter.Message{{
+		ID:   "m1",
+		Role: "user",
+		ContentBlocks: []adapter.ContentBlock{
+			{Type: "text", Text: "two screenshots"},
+			pngBlock(t, 8, 8),
+			pngBlock(t, 16, 8),
+		},
+	}}
+
+	if lines := p.renderContentBlocks(p.messages[0], 80); !strings.Contains(strings.Join(lines, "\n"), "[image 16x8]") {
+		t.Errorf("expected image placeholder in rendered message, got %q", lines)
+	}
+
+	p.openImageViewer()
+	if p.imageViewer == nil || len(p.imageViewer.images) != 2 || p.imageViewer.path == "" {
+		t.Fatalf("expected viewer with 2 images, got %+v", p.imageViewer)
+	}
+	if ctx := p.FocusContext(); ctx != "conversations-image-viewer" {
+		t.Errorf("FocusContext = %q", ctx)
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	if p.imageViewer.index != 1 {
+		t.Errorf("index = %d after ], want 1", p.imageViewer.index)
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.imageViewer != nil {
+		t.Error("esc should close the viewer")
+	}
+
+	// Messages without images just toast
+	p.messages[0].ContentBlocks = p.messages[0].ContentBlocks[:1]
+	if cmd := p.openImageViewer(); cmd == nil || p.imageViewer != nil {
+		t.Error("expected toast and no viewer without images")
+	}
+}
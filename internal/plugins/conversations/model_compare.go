@@ -0,0 +1,537 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	appmsg "github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/state"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+const (
+	// compareAllTasks is the group covering every scanned session.
+	compareAllTasks = "all tasks"
+	// compareMinSample marks model rows with too few sessions to trust.
+	compareMinSample = 3
+)
+
+// modelOutcome aggregates outcome signals for sessions sharing a primary model.
+type modelOutcome struct {
+	Model      string
+	Sessions   int
+	ToolCalls  int
+	ToolErrors int
+	Reprompts  int
+	durTotal   time.Duration
+	durCount   int
+}
+
+// ErrorRate returns the fraction of tool calls that returned an error.
+func (o *modelOutcome) ErrorRate() float64 {
+	if o.ToolCalls == 0 {
+		return 0
+	}
+	return float64(o.ToolErrors) / float64(o.ToolCalls)
+}
+
+// RepromptRate returns the average number of follow-up user prompts per session.
+func (o *modelOutcome) RepromptRate() float64 {
+	if o.Sessions == 0 {
+		return 0
+	}
+	return float64(o.Reprompts) / float64(o.Sessions)
+}
+
+// AvgDuration returns the mean duration of sessions with a known duration.
+func (o *modelOutcome) AvgDuration() time.Duration {
+	if o.durCount == 0 {
+		return 0
+	}
+	return o.durTotal / time.Duration(o.durCount)
+}
+
+// taskComparison lists model outcomes for one task type.
+type taskComparison struct {
+	Task     string
+	Sessions int
+	Models   []*modelOutcome // descending by session count
+}
+
+// modelComparison is the computed model-vs-outcome breakdown.
+type modelComparison struct {
+	Tasks      []taskComparison // "all tasks" first, then by session count
+	Models     []string         // every primary model, descending by session count
+	Scanned    int
+	ComputedAt time.Time
+}
+
+// ModelComparisonMsg delivers a computed model comparison.
+type ModelComparisonMsg struct {
+	Epoch      uint64
+	Comparison *modelComparison
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m ModelComparisonMsg) GetEpoch() uint64 { return m.Epoch }
+
+// sessionOutcome holds the outcome signals extracted from one session.
+type sessionOutcome struct {
+	model      string
+	toolCalls  int
+	toolErrors int
+	reprompts  int
+	tools      map[string]bool
+}
+
+// extractSessionOutcome derives the primary model and outcome signals from
+// a session's messages. Re-prompts are user prompts after the first one;
+// tool-result-only user messages are not prompts.
+func extractSessionOutcome(msgs []adapter.Message) sessionOutcome {
+	out := sessionOutcome{tools: make(map[string]bool)}
+	models := make(map[string]int)
+	prompts := 0
+	for i := range msgs {
+		m := &msgs[i]
+		if m.Role == "assistant" && m.Model != "" {
+			models[m.Model]++
+		}
+		hasBlocks, hasText, hasResult := false, m.Content != "", false
+		for _, b := range m.ContentBlocks {
+			switch b.Type {
+			case "tool_use":
+				if b.ToolName != "" {
+					out.toolCalls++
+					out.tools[b.ToolName] = true
+					hasBlocks = true
+				}
+			case "tool_result":
+				hasResult = true
+				if b.IsError {
+					out.toolErrors++
+				}
+			case "text":
+				hasText = hasText || strings.TrimSpace(b.Text) != ""
+			}
+		}
+		if !hasBlocks {
+			for _, tu := range m.ToolUses {
+				out.toolCalls++
+				out.tools[tu.Name] = true
+			}
+		}
+		if m.Role == "user" && hasText && !hasResult {
+			prompts++
+		}
+	}
+	if prompts > 1 {
+		out.reprompts = prompts - 1
+	}
+
+	best := 0
+	for model, n := range models {
+		if n > best || (n == best && model < out.model) {
+			best, out.model = n, model
+		}
+	}
+	if short := modelShortName(out.model); short != "" {
+		out.model = short
+	}
+	return out
+}
+
+// sessionTaskTypes returns the task types a session counts toward: its
+// matching badge rules, or a type inferred from the tools it used.
+func sessionTaskTypes(s *adapter.Session, tools map[string]bool, rules []badgeRule) []string {
+	var types []string
+	for i := range rules {
+		if rules[i].matches(s, tools) {
+			types = append(types, rules[i].label)
+		}
+	}
+	if len(types) > 0 {
+		return types
+	}
+	return []string{inferTaskType(tools)}
+}
+
+// inferTaskType classifies a session by the tools it used.
+func inferTaskType(tools map[string]bool) string {
+	if len(tools) == 0 {
+		return "chat"
+	}
+	for name := range tools {
+		n := strings.ToLower(name)
+		if strings.Contains(n, "edit") || strings.Contains(n, "write") || strings.Contains(n, "patch") {
+			return "coding"
+		}
+	}
+	return "research"
+}
+
+// compareAccumulator groups session outcomes by task type and model.
+// Safe for concurrent use.
+type compareAccumulator struct {
+	mu      sync.Mutex
+	rules   []badgeRule
+	groups  map[string]map[string]*modelOutcome // task -> model -> outcome
+	models  map[string]int
+	scanned int
+}
+
+func newCompareAccumulator(rules []badgeRule) *compareAccumulator {
+	return &compareAccumulator{
+		rules:  rules,
+		groups: make(map[string]map[string]*modelOutcome),
+		models: make(map[string]int),
+	}
+}
+
+// add records one session. Sessions without an identifiable model are skipped.
+func (a *compareAccumulator) add(s *adapter.Session, msgs []adapter.Message) {
+	o := extractSessionOutcome(msgs)
+	if o.model == "" {
+		return
+	}
+	tasks := append([]string{compareAllTasks}, sessionTaskTypes(s, o.tools, a.rules)...)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.scanned++
+	a.models[o.model]++
+	for _, task := range tasks {
+		byModel := a.groups[task]
+		if byModel == nil {
+			byModel = make(map[string]*modelOutcome)
+			a.groups[task] = byModel
+		}
+		mo := byModel[o.model]
+		if mo == nil {
+			mo = &modelOutcome{Model: o.model}
+			byModel[o.model] = mo
+		}
+		mo.Sessions++
+		mo.ToolCalls += o.toolCalls
+		mo.ToolErrors += o.toolErrors
+		mo.Reprompts += o.reprompts
+		if s.Duration > 0 {
+			mo.durTotal += s.Duration
+			mo.durCount++
+		}
+	}
+}
+
+// result finalizes the comparison with deterministic ordering.
+func (a *compareAccumulator) result(now time.Time) *modelComparison {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cmp := &modelComparison{Scanned: a.scanned, ComputedAt: now}
+	for _, nc := range rankCounts(toInt64Counts(a.models), len(a.models)) {
+		cmp.Models = append(cmp.Models, nc.Name)
+	}
+	for task, byModel := range a.groups {
+		tc := taskComparison{Task: task}
+		for _, mo := range byModel {
+			tc.Sessions += mo.Sessions
+			tc.Models = append(tc.Models, mo)
+		}
+		sort.Slice(tc.Models, func(i, j int) bool {
+			if tc.Models[i].Sessions != tc.Models[j].Sessions {
+				return tc.Models[i].Sessions > tc.Models[j].Sessions
+			}
+			return tc.Models[i].Model < tc.Models[j].Model
+		})
+		cmp.Tasks = append(cmp.Tasks, tc)
+	}
+	sort.Slice(cmp.Tasks, func(i, j int) bool {
+		ti, tj := cmp.Tasks[i], cmp.Tasks[j]
+		if (ti.Task == compareAllTasks) != (tj.Task == compareAllTasks) {
+			return ti.Task == compareAllTasks
+		}
+		if ti.Sessions != tj.Sessions {
+			return ti.Sessions > tj.Sessions
+		}
+		return ti.Task < tj.Task
+	})
+	return cmp
+}
+
+func toInt64Counts(m map[string]int) map[string]int64 {
+	out := make(map[string]int64, len(m))
+	for k, v := range m {
+		out[k] = int64(v)
+	}
+	return out
+}
+
+// loadModelComparison scans the most recent sessions in the background,
+// with the same caps as project stats, and returns ModelComparisonMsg.
+func loadModelComparison(sessions []adapter.Session, adapters map[string]adapter.Adapter, rules []badgeRule, epoch uint64) tea.Cmd {
+	sorted := make([]adapter.Session, len(sessions))
+	copy(sorted, sessions)
+	return func() tea.Msg {
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].UpdatedAt.After(sorted[j].UpdatedAt)
+		})
+
+		acc := newCompareAccumulator(rules)
+		ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, searchConcurrency())
+		for i := range sorted {
+			s := &sorted[i]
+			if i >= statsMaxSessions || s.MessageCount == 0 || s.SizeLevel() >= 2 {
+				continue
+			}
+			a := adapters[s.AdapterID]
+			if a == nil || !adapterSupports(a, adapter.CapMessages) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				continue
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(a adapter.Adapter, s *adapter.Session) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				msgs, err := a.Messages(s.ID)
+				if err != nil || ctx.Err() != nil {
+					return
+				}
+				acc.add(s, msgs)
+			}(a, s)
+		}
+		wg.Wait()
+
+		return ModelComparisonMsg{Epoch: epoch, Comparison: acc.result(time.Now())}
+	}
+}
+
+// openModelCompare switches to the model comparison view.
+func (p *Plugin) openModelCompare() tea.Cmd {
+	p.view = ViewModels
+	p.compareScrollOff = 0
+	p.comparePins = state.GetPinnedModels()
+	return p.refreshModelCompare()
+}
+
+// refreshModelCompare recomputes the comparison in the background.
+func (p *Plugin) refreshModelCompare() tea.Cmd {
+	p.compareLoading = true
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	return loadModelComparison(p.sessions, p.adapters, p.badgeRules, epoch)
+}
+
+// isPinned reports whether model is pinned for comparison.
+func (p *Plugin) isPinned(model string) bool {
+	for _, m := range p.comparePins {
+		if m == model {
+			return true
+		}
+	}
+	return false
+}
+
+// togglePin pins or unpins the model under the cursor and persists the pins.
+func (p *Plugin) togglePin() tea.Cmd {
+	if p.comparison == nil || p.compareCursor >= len(p.comparison.Models) {
+		return nil
+	}
+	model := p.comparison.Models[p.compareCursor]
+	var pins []string
+	for _, m := range p.comparePins {
+		if m != model {
+			pins = append(pins, m)
+		}
+	}
+	if len(pins) == len(p.comparePins) {
+		pins = append(pins, model)
+	}
+	p.comparePins = pins
+	if err := state.SetPinnedModels(pins); err != nil {
+		return appmsg.ShowToast("Failed to save pins: "+err.Error(), 3*time.Second)
+	}
+	return nil
+}
+
+// updateModelCompare handles key events in the model comparison view.
+func (p *Plugin) updateModelCompare(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	maxScroll := max(len(p.compareLines)-(p.height-2), 0)
+	models := 0
+	if p.comparison != nil {
+		models = len(p.comparison.Models)
+	}
+
+	switch msg.String() {
+	case "esc", "q", "M":
+		p.view = ViewSessions
+		p.compareScrollOff = 0
+	case "r":
+		return p, p.refreshModelCompare()
+	case "[", "h", "left":
+		p.compareCursor = max(p.compareCursor-1, 0)
+	case "]", "l", "right":
+		p.compareCursor = max(min(p.compareCursor+1, models-1), 0)
+	case "p", " ":
+		return p, p.togglePin()
+	case "c":
+		p.comparePins = nil
+		_ = state.SetPinnedModels(nil)
+	case "j", "down":
+		p.compareScrollOff = min(p.compareScrollOff+1, maxScroll)
+	case "k", "up":
+		p.compareScrollOff = max(p.compareScrollOff-1, 0)
+	case "g":
+		p.compareScrollOff = 0
+	case "G":
+		p.compareScrollOff = maxScroll
+	case "ctrl+d":
+		p.compareScrollOff = min(p.compareScrollOff+10, maxScroll)
+	case "ctrl+u":
+		p.compareScrollOff = max(p.compareScrollOff-10, 0)
+	}
+	return p, nil
+}
+
+// renderModelCompare renders per-task model outcome tables. When models are
+// pinned, only pinned models are compared; the best value per column among
+// the shown rows is highlighted.
+func (p *Plugin) renderModelCompare() string {
+	width := max(p.width-2, 20)
+	var lines []string
+	lines = append(lines, styles.Current().Title.Render(" Model Comparison"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("━", width)))
+
+	cmp := p.comparison
+	if cmp == nil {
+		lines = append(lines, styles.Current().Muted.Render(" Computing comparison..."))
+		p.compareLines = lines
+		return strings.Join(lines, "\n")
+	}
+	if len(cmp.Models) == 0 {
+		lines = append(lines, styles.Current().Muted.Render(" No sessions with model data"))
+		p.compareLines = lines
+		return strings.Join(lines, "\n")
+	}
+	p.compareCursor = min(p.compareCursor, len(cmp.Models)-1)
+
+	// Model chips: cursor in brackets, pinned marked with ★
+	var chips []string
+	for i, m := range cmp.Models {
+		label := m
+		if p.isPinned(m) {
+			label = "★ " + label
+		}
+		if i == p.compareCursor {
+			chips = append(chips, selectedItemStyle.Render("["+label+"]"))
+		} else if p.isPinned(m) {
+			chips = append(chips, styles.Current().Body.Render(label))
+		} else {
+			chips = append(chips, styles.Current().Muted.Render(label))
+		}
+	}
+	lines = append(lines, " "+strings.Join(chips, "  "))
+	hint := " [/]: select  p: pin  c: clear pins  r: refresh"
+	if p.compareLoading {
+		hint += "  │  refreshing..."
+	}
+	lines = append(lines, styles.Current().Subtle.Render(hint))
+	lines = append(lines, "")
+
+	for _, tc := range cmp.Tasks {
+		rows := tc.Models
+		if len(p.comparePins) > 0 {
+			rows = nil
+			for _, mo := range tc.Models {
+				if p.isPinned(mo.Model) {
+					rows = append(rows, mo)
+				}
+			}
+			if len(rows) == 0 {
+				continue
+			}
+		}
+		lines = append(lines, styles.Current().Title.Render(fmt.Sprintf(" %s", tc.Task))+
+			styles.Current().Muted.Render(fmt.Sprintf("  %d sessions", tc.Sessions)))
+		lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", width)))
+		lines = append(lines, renderOutcomeTable(rows)...)
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, styles.Current().Muted.Render(fmt.Sprintf(" Based on %d recent sessions; rows marked * have fewer than %d sessions", cmp.Scanned, compareMinSample)))
+	p.compareLines = lines
+
+	contentHeight := max(p.height-2, 1)
+	start := min(p.compareScrollOff, max(len(lines)-1, 0))
+	end := min(start+contentHeight, len(lines))
+	return strings.Join(lines[start:end], "\n")
+}
+
+// renderOutcomeTable renders one row per model with the best value in each
+// column highlighted (lowest error rate, re-prompts, and duration).
+func renderOutcomeTable(rows []*modelOutcome) []string {
+	nameWidth := 8
+	for _, mo := range rows {
+		nameWidth = max(nameWidth, min(len(mo.Model), 20))
+	}
+	bestErr, bestRe, bestDur := -1.0, -1.0, time.Duration(-1)
+	if len(rows) > 1 {
+		for _, mo := range rows {
+			if mo.ToolCalls > 0 && (bestErr < 0 || mo.ErrorRate() < bestErr) {
+				bestErr = mo.ErrorRate()
+			}
+			if bestRe < 0 || mo.RepromptRate() < bestRe {
+				bestRe = mo.RepromptRate()
+			}
+			if d := mo.AvgDuration(); d > 0 && (bestDur < 0 || d < bestDur) {
+				bestDur = d
+			}
+		}
+	}
+	cell := func(text string, best bool) string {
+		if best {
+			return styles.Current().StatusStaged.Render(text)
+		}
+		return styles.Current().Body.Render(text)
+	}
+
+	lines := []string{styles.Current().Subtitle.Render(fmt.Sprintf(" %-*s %6s %9s %11s %9s", nameWidth, "model", "sess", "tool err", "re-prompts", "avg dur"))}
+	for _, mo := range rows {
+		name := mo.Model
+		if mo.Sessions < compareMinSample {
+			name += "*"
+		}
+		if len(name) > nameWidth {
+			name = name[:nameWidth-1] + "…"
+		}
+		errText := "-"
+		if mo.ToolCalls > 0 {
+			errText = fmt.Sprintf("%.1f%%", mo.ErrorRate()*100)
+		}
+		durText := "-"
+		if d := mo.AvgDuration(); d > 0 {
+			durText = formatSessionDuration(d)
+		}
+		line := styles.Current().Body.Render(fmt.Sprintf(" %-*s %6d ", nameWidth, name, mo.Sessions)) +
+			cell(fmt.Sprintf("%9s", errText), mo.ToolCalls > 0 && mo.ErrorRate() == bestErr) + " " +
+			cell(fmt.Sprintf("%11.1f", mo.RepromptRate()), mo.RepromptRate() == bestRe) + " " +
+			cell(fmt.Sprintf("%9s", durText), mo.AvgDuration() > 0 && mo.AvgDuration() == bestDur)
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,126 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/state"
+)
+
+func userPrompt(text string) adapter.Message {
+	return adapter.Message{Role: "user", ContentBlocks: []adapter.ContentBlock{{Type: "text", Text: text}}}
+}
+
+func toolTurn(model, tool string, failed bool) []adapter.Message {
+	return []adapter.Message{
+		{Role: "assistant", Model: model, ContentBlocks: []adapter.ContentBlock{{Type: "tool_use", ToolName: tool, ToolUseID: "t"}}},
+		{Role: "user", ContentBlocks: []adapter.ContentBlock{{Type: "tool_result", ToolUseID: "t", IsError: failed}}},
+	}
+}
+
+func TestExtractSessionOutcome(t *testing.T) {
+	var msgs []adapter.Message
+	msgs = append(msgs, userPrompt("fix the bug"))
+	msgs = append(msgs, toolTurn("claude-opus-4-5-20251101", "Edit", true)...)
+	msgs = append(msgs, toolTurn("claude-opus-4-5-20251101", "Read", false)...)
+	msgs = append(msgs, userPrompt("no, the other file"))
+	msgs = append(msgs, userPrompt("  "))
+	msgs = append(msgs, adapter.Message{Role: "assistant", Model: "claude-haiku-4-5", Content: "done"})
+
+	o := extractSessionOutcome(msgs)
+	if o.model != modelShortName("claude-opus-4-5-20251101") {
+		t.Errorf("model = %q, want primary opus", o.model)
+	}
+	if o.toolCalls != 2 || o.toolErrors != 1 {
+		t.Errorf("tool calls/errors = %d/%d, want 2/1", o.toolCalls, o.toolErrors)
+	}
+	if o.reprompts != 1 {
+		t.Errorf("reprompts = %d, want 1 (tool results and blank prompts excluded)", o.reprompts)
+	}
+	if got := inferTaskType(o.tools); got != "coding" {
+		t.Errorf("task type = %q, want coding", got)
+	}
+	if got := inferTaskType(map[string]bool{"Grep": true}); got != "research" {
+		t.Errorf("task type = %q, want research", got)
+	}
+	if got := inferTaskType(nil); got != "chat" {
+		t.Errorf("task type = %q, want chat", got)
+	}
+}
+
+func TestCompareAccumulator_GroupsByTaskAndModel(t *testing.T) {
+	rules := compileBadgeRules([]config.BadgeRule{{Label: "infra", NameRegex: "^deploy"}}, nil)
+	acc := newCompareAccumulator(rules)
+
+	opus := append([]adapter.Message{userPrompt("go")}, toolTurn("claude-opus-4-5", "Edit", false)...)
+	sonnet := append([]adapter.Message{userPrompt("go"), userPrompt("again")}, toolTurn("claude-sonnet-4-5", "Edit", true)...)
+	acc.add(&adapter.Session{Name: "refactor", Duration: 10 * time.Minute}, opus)
+	acc.add(&adapter.Session{Name: "refactor 2", Duration: 20 * time.Minute}, sonnet)
+	acc.add(&adapter.Session{Name: "deploy api"}, sonnet)
+	acc.add(&adapter.Session{Name: "empty"}, []adapter.Message{userPrompt("hi")}) // no model: skipped
+
+	cmp := acc.result(time.Now())
+	if cmp.Scanned != 3 {
+		t.Errorf("scanned = %d, want 3", cmp.Scanned)
+	}
+	if len(cmp.Models) != 2 || cmp.Models[0] != modelShortName("claude-sonnet-4-5") {
+		t.Errorf("models = %v, want sonnet first", cmp.Models)
+	}
+	var tasks []string
+	for _, tc := range cmp.Tasks {
+		tasks = append(tasks, tc.Task)
+	}
+	if strings.Join(tasks, ",") != "all tasks,coding,infra" {
+		t.Errorf("tasks = %v", tasks)
+	}
+	coding := cmp.Tasks[1]
+	for _, mo := range coding.Models {
+		if mo.Sessions != 1 {
+			t.Errorf("%s sessions = %d, want 1", mo.Model, mo.Sessions)
+		}
+	}
+	all := cmp.Tasks[0].Models[0]
+	if all.ErrorRate() != 1 || all.RepromptRate() != 1 || all.AvgDuration() != 20*time.Minute {
+		t.Errorf("sonnet outcome = err %.2f re %.2f dur %v", all.ErrorRate(), all.RepromptRate(), all.AvgDuration())
+	}
+}
+
+func TestModelCompareView_PinFiltersRows(t *testing.T) {
+	if err := state.InitWithDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	acc := newCompareAccumulator(nil)
+	acc.add(&adapter.Session{}, toolTurn("claude-opus-4-5", "Read", false))
+	acc.add(&adapter.Session{}, toolTurn("claude-sonnet-4-5", "Read", true))
+	acc.add(&adapter.Session{}, toolTurn("claude-sonnet-4-5", "Read", false))
+
+	p := New()
+	p.width, p.height = 120, 40
+	p.view = ViewModels
+	p.Update(ModelComparisonMsg{Comparison: acc.result(time.Now())})
+	opus := modelShortName("claude-opus-4-5")
+	if out := ansi.Strip(p.renderModelCompare()); !strings.Contains(out, opus+"*") {
+		t.Fatalf("expected low-sample opus row:\n%s", out)
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	if got := state.GetPinnedModels(); len(got) != 1 || got[0] != opus {
+		t.Fatalf("pinned = %v, want [%s]", got, opus)
+	}
+	// Only the model chip mentions the unpinned model once pins are set
+	out := ansi.Strip(p.renderModelCompare())
+	if n := strings.Count(out, modelShortName("claude-sonnet-4-5")); n != 1 {
+		t.Errorf("unpinned model appears %d times, want chip only:\n%s", n, out)
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if p.view != ViewSessions {
+		t.Error("q should return to sessions")
+	}
+}
@@ -3,6 +3,7 @@ package conversations
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
 )
 
@@ -14,6 +15,24 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 		return p, cmd
 	}
 
+	if p.showRenameModal {
+		return p, p.handleRenameModalMouse(msg)
+	}
+
+	// Tool output pager only scrolls; clicks are swallowed
+	if p.toolPager != nil {
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			p.toolPager.scroll -= 3
+		case tea.MouseButtonWheelDown:
+			p.toolPager.scroll += 3
+		}
+		return p, nil
+	}
+	if p.imageViewer != nil {
+		return p, nil
+	}
+
 	action := p.mouseHandler.HandleMouse(msg)
 
 	switch action.Type {
@@ -66,6 +85,7 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 			if idx >= 0 && idx < len(p.turns) {
 				p.turnCursor = idx
 				p.activePane = PaneMessages
+				p.splitFocus = false
 				p.ensureTurnCursorVisible()
 			}
 		}
@@ -73,6 +93,12 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 
 	case regionMainPane:
 		p.activePane = PaneMessages
+		p.splitFocus = false
+		return p, nil
+
+	case regionSplitPane:
+		p.activePane = PaneMessages
+		p.splitFocus = true
 		return p, nil
 
 	case regionMessageItem:
@@ -81,6 +107,7 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 			if idx >= 0 && idx < len(p.messages) {
 				p.messageCursor = idx
 				p.activePane = PaneMessages
+				p.splitFocus = false
 			}
 		}
 		return p, nil
@@ -186,6 +213,9 @@ func (p *Plugin) handleMouseScroll(action mouse.MouseAction) (*Plugin, tea.Cmd)
 	case regionSidebar, regionSessionItem:
 		return p.scrollSidebar(action.Delta)
 
+	case regionSplitPane:
+		return p.scrollSplitPane(action.Delta)
+
 	case regionMainPane, regionTurnItem, regionMessageItem:
 		if p.detailMode {
 			return p.scrollDetailPane(action.Delta)
@@ -235,6 +265,9 @@ func (p *Plugin) scrollSidebar(delta int) (*Plugin, tea.Cmd) {
 
 // scrollMainPane scrolls the main messages pane.
 func (p *Plugin) scrollMainPane(delta int) (*Plugin, tea.Cmd) {
+	if delta < 0 {
+		p.followMain = false
+	}
 	if p.turnViewMode {
 		// Turn view: scroll by moving turn cursor
 		if len(p.turns) == 0 {
@@ -287,6 +320,22 @@ func (p *Plugin) scrollMainPane(delta int) (*Plugin, tea.Cmd) {
 	return p, nil
 }
 
+// scrollSplitPane scrolls the split pane; scrolling up pauses follow mode.
+func (p *Plugin) scrollSplitPane(delta int) (*Plugin, tea.Cmd) {
+	if p.split == nil {
+		return p, nil
+	}
+	if delta < 0 {
+		p.split.follow = false
+	}
+	p.split.scroll += delta
+	if p.split.scroll < 0 {
+		p.split.scroll = 0
+	}
+	// Max scroll is clamped in renderSplitPane
+	return p, nil
+}
+
 // scrollDetailPane scrolls the detail view content.
 func (p *Plugin) scrollDetailPane(delta int) (*Plugin, tea.Cmd) {
 	p.detailScroll += delta
@@ -305,9 +354,13 @@ func (p *Plugin) handleMouseDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 
 	// Calculate new sidebar width based on drag
 	startValue := p.mouseHandler.DragStartValue()
-	newWidth := startValue + action.DragDX
+	p.setSidebarWidth(startValue + action.DragDX)
 
-	// Clamp to reasonable bounds
+	return p, nil
+}
+
+// setSidebarWidth clamps and applies a sidebar width.
+func (p *Plugin) setSidebarWidth(newWidth int) {
 	available := p.width - 5 - dividerWidth
 	minWidth := 25
 	maxWidth := available - 40 // Leave at least 40 for main pane
@@ -322,8 +375,26 @@ func (p *Plugin) handleMouseDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 	}
 
 	p.sidebarWidth = newWidth
+}
 
-	return p, nil
+// CanResizePane implements plugin.PaneResizer.
+func (p *Plugin) CanResizePane() bool {
+	if !p.sidebarVisible || p.width == 0 {
+		return false
+	}
+	switch p.FocusContext() {
+	case "conversations-sidebar", "conversations-main", "conversations-split":
+		return true
+	}
+	return false
+}
+
+// ResizePane implements plugin.PaneResizer.
+func (p *Plugin) ResizePane(delta int) (int, tea.Cmd) {
+	available := p.width - 5 - dividerWidth
+	p.setSidebarWidth(p.sidebarWidth + delta*plugin.PaneResizeStep(available))
+	_ = state.SetConversationsSideWidth(p.sidebarWidth)
+	return p.sidebarWidth * 100 / max(available, 1), nil
 }
 
 // handleMouseDragEnd handles the end of a drag operation (saves pane width).
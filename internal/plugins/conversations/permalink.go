@@ -0,0 +1,167 @@
+package conversations
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+)
+
+// permalinkPrefix is the scheme and host of conversation permalinks.
+const permalinkPrefix = "forge://conversations/"
+
+// Permalink identifies a session, and optionally a message within it.
+type Permalink struct {
+	AdapterID string
+	SessionID string
+	MessageID string // "" links to the session itself
+}
+
+// String formats the link as forge://conversations/<adapter>/<session>[/<message>].
+func (l Permalink) String() string {
+	s := permalinkPrefix + url.PathEscape(l.AdapterID) + "/" + url.PathEscape(l.SessionID)
+	if l.MessageID != "" {
+		s += "/" + url.PathEscape(l.MessageID)
+	}
+	return s
+}
+
+// ParsePermalink parses a forge://conversations/ URI.
+func ParsePermalink(s string) (Permalink, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(s), permalinkPrefix)
+	if !ok {
+		return Permalink{}, fmt.Errorf("not a conversation link (want %s<adapter>/<session>[/<message>])", permalinkPrefix)
+	}
+	parts := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Permalink{}, errors.New("conversation link needs an adapter and session ID")
+	}
+	for i, part := range parts {
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			return Permalink{}, fmt.Errorf("invalid link segment %q: %w", part, err)
+		}
+		parts[i] = unescaped
+	}
+	l := Permalink{AdapterID: parts[0], SessionID: parts[1]}
+	if len(parts) == 3 {
+		l.MessageID = parts[2]
+	}
+	if l.AdapterID == "" || l.SessionID == "" {
+		return Permalink{}, errors.New("conversation link needs an adapter and session ID")
+	}
+	return l, nil
+}
+
+// OpenPermalink queues a link to be opened once its session has been loaded.
+// Used by the --open flag before the plugin starts.
+func (p *Plugin) OpenPermalink(l Permalink) {
+	p.pendingPermalink = &l
+}
+
+// applyPendingPermalink selects the linked session and message if it has
+// been loaded. When final is set and the session is still missing, the link
+// is dropped with an error toast.
+func (p *Plugin) applyPendingPermalink(final bool) tea.Cmd {
+	l := p.pendingPermalink
+	if l == nil {
+		return nil
+	}
+
+	idx := -1
+	for i := range p.sessions {
+		if p.sessions[i].ID == l.SessionID && (p.sessions[i].AdapterID == "" || p.sessions[i].AdapterID == l.AdapterID) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		if !final {
+			return nil
+		}
+		p.pendingPermalink = nil
+		return func() tea.Msg {
+			return app.ToastMsg{Message: "Linked session not found: " + shortID(l.SessionID), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	p.pendingPermalink = nil
+
+	var cmds []tea.Cmd
+	if !p.moveCursorToSession(l.SessionID) {
+		// Hidden by a search, filter, or paging: show every session so the
+		// cursor lands on the link
+		p.showAllSessions()
+		p.moveCursorToSession(l.SessionID)
+		cmds = append(cmds, app.ShowToast("Cleared filters to show linked session", 2*time.Second))
+	}
+	p.setSelectedSession(l.SessionID)
+	p.activePane = PaneMessages
+	p.pendingScrollMsgID = l.MessageID
+	p.pendingScrollActive = l.MessageID != ""
+
+	cmds = append(cmds, p.loadMessages(l.SessionID), p.loadUsage(l.SessionID))
+	return tea.Batch(cmds...)
+}
+
+// moveCursorToSession puts the cursor on a session in the visible list and
+// reports whether it was there.
+func (p *Plugin) moveCursorToSession(id string) bool {
+	for i, s := range p.visibleSessions() {
+		if s.ID == id {
+			p.cursor = i
+			p.ensureCursorVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// showAllSessions leaves search, clears filters, and loads every page of
+// sessions.
+func (p *Plugin) showAllSessions() {
+	p.searchMode = false
+	p.searchQuery = ""
+	p.searchResults = nil
+	p.filters = SearchFilters{}
+	p.filterActive = false
+	p.displayedCount = len(p.sessions)
+	p.hasMoreSessions = false
+	p.scrollOff = 0
+	p.hitRegionsDirty = true
+}
+
+// permalinkMessage returns the message the permalink should point at.
+func (p *Plugin) permalinkMessage() *adapter.Message {
+	if p.turnViewMode || p.detailMode {
+		if turn := p.getCurrentTurn(); turn != nil && len(turn.Messages) > 0 {
+			return &turn.Messages[0]
+		}
+		return nil
+	}
+	return p.getSelectedMessage()
+}
+
+// yankPermalink copies a permalink to the selected message to the clipboard.
+func (p *Plugin) yankPermalink() tea.Cmd {
+	session := p.findSelectedSession()
+	if session == nil {
+		return nil
+	}
+	l := Permalink{AdapterID: session.AdapterID, SessionID: session.ID}
+	if msg := p.permalinkMessage(); msg != nil {
+		l.MessageID = msg.ID
+	}
+	link := l.String()
+	return func() tea.Msg {
+		if err := clipboard.WriteAll(link); err != nil {
+			return app.ToastMsg{Message: "Copy failed: " + err.Error(), Duration: 2 * time.Second, IsError: true}
+		}
+		return app.ToastMsg{Message: "Yanked: " + link, Duration: 2 * time.Second}
+	}
+}
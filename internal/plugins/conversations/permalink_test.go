@@ -0,0 +1,96 @@
+package conversations
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+func TestPermalink_RoundTrip(t *testing.T) {
+	links := []Permalink{
+		{AdapterID: "claude-code", SessionID: "abc-123", MessageID: "msg_01"},
+		{AdapterID: "codex", SessionID: "rollout/2025 06"},
+		{AdapterID: "pi", SessionID: "s1", MessageID: "a/b?c#d"},
+	}
+	for _, want := range links {
+		got, err := ParsePermalink(want.String())
+		if err != nil {
+			t.Fatalf("ParsePermalink(%q): %v", want.String(), err)
+		}
+		if got != want {
+			t.Errorf("round trip of %q = %+v, want %+v", want.String(), got, want)
+		}
+	}
+
+	if s := links[0].String(); s != "forge://conversations/claude-code/abc-123/msg_01" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestParsePermalink_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"https://example.com/x/y",
+		"forge://conversations/claude-code",
+		"forge://conversations//s1",
+		"forge://conversations/a/b/c/d",
+		"forge://conversations/a/%zz",
+	} {
+		if _, err := ParsePermalink(s); err == nil {
+			t.Errorf("ParsePermalink(%q) should fail", s)
+		}
+	}
+}
+
+func TestApplyPendingPermalink(t *testing.T) {
+	p := New()
+	p.OpenPermalink(Permalink{AdapterID: "claude-code", SessionID: "s2", MessageID: "m5"})
+
+	// Session not loaded yet: keep waiting
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "claude-code"}}
+	if cmd := p.applyPendingPermalink(false); cmd != nil || p.pendingPermalink == nil {
+		t.Fatal("link should stay pending until its session loads")
+	}
+
+	p.sessions = append(p.sessions, adapter.Session{ID: "s2", AdapterID: "claude-code"})
+	if cmd := p.applyPendingPermalink(false); cmd == nil {
+		t.Fatal("expected message load once session is present")
+	}
+	if p.pendingPermalink != nil || p.selectedSession != "s2" || p.cursor != 1 {
+		t.Errorf("selected %q at cursor %d, want s2 at 1", p.selectedSession, p.cursor)
+	}
+	if p.activePane != PaneMessages || !p.pendingScrollActive || p.pendingScrollMsgID != "m5" {
+		t.Error("expected messages pane with pending scroll to m5")
+	}
+}
+
+func TestApplyPendingPermalink_NotFound(t *testing.T) {
+	p := New()
+	p.OpenPermalink(Permalink{AdapterID: "codex", SessionID: "s1"})
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "claude-code"}}
+
+	if cmd := p.applyPendingPermalink(true); cmd == nil || p.pendingPermalink != nil {
+		t.Error("final load should drop the link with a toast")
+	}
+	if p.selectedSession == "s1" {
+		t.Error("session from a different adapter should not be selected")
+	}
+}
+
+func TestApplyPendingPermalink_ClearsHidingFilter(t *testing.T) {
+	p := New()
+	p.sessions = []adapter.Session{
+		{ID: "s1", AdapterID: "claude-code"},
+		{ID: "s2", AdapterID: "codex"},
+	}
+	p.filters.Adapters = []string{"claude-code"}
+	p.filterActive = true
+	p.OpenPermalink(Permalink{AdapterID: "codex", SessionID: "s2"})
+
+	if cmd := p.applyPendingPermalink(false); cmd == nil {
+		t.Fatal("expected the linked session to load")
+	}
+	if p.filterActive || p.cursor != 1 || p.selectedSession != "s2" {
+		t.Errorf("filterActive=%v cursor=%d selected=%q; want filter cleared and cursor on s2", p.filterActive, p.cursor, p.selectedSession)
+	}
+}
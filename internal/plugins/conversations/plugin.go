@@ -5,7 +5,8 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"sort"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,12 +14,17 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/cache"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 	"github.com/wilbur182/forge/internal/adapter/tieredwatcher"
 	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/image"
 	"github.com/wilbur182/forge/internal/modal"
 	"github.com/wilbur182/forge/internal/mouse"
 	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
+	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/ui"
 )
 
@@ -50,6 +56,10 @@ const (
 	worktreeCacheTTL = 5 * time.Second
 )
 
+// selectedItemStyle is the selected row style; its colors can be overridden
+// per plugin with ui.theme.pluginOverrides.
+var selectedItemStyle = styles.RegisterPluginStyle(pluginID, "selected", styles.SelectedItemStyle)
+
 // Mouse hit region identifiers
 const (
 	regionSidebar     = "sidebar"
@@ -70,6 +80,8 @@ const (
 	ViewMessages
 	ViewAnalytics
 	ViewMessageDetail
+	ViewStats
+	ViewModels
 )
 
 // FocusPane represents which pane is active in two-pane mode.
@@ -137,6 +149,21 @@ type Plugin struct {
 	analyticsScrollOff int
 	analyticsLines     []string // pre-rendered lines for scrolling
 
+	// Project stats view state
+	stats          *projectStats
+	statsLoading   bool
+	statsScrollOff int
+	statsLines     []string              // pre-rendered lines for scrolling
+	usageTracker   *adapter.UsageTracker // baselines for watch-event usage deltas
+
+	// Model comparison view state
+	comparison       *modelComparison
+	compareLoading   bool
+	compareScrollOff int
+	compareCursor    int      // index into comparison.Models
+	comparePins      []string // pinned models (persisted in state)
+	compareLines     []string // pre-rendered lines for scrolling
+
 	// Layout state
 	activePane         FocusPane // Which pane is focused
 	sidebarRestore     FocusPane // Tracks pane focused before collapse; restored on expand via toggleSidebar()
@@ -144,6 +171,9 @@ type Plugin struct {
 	sidebarVisible     bool      // Toggle sidebar visibility with \
 	previewToken       int       // monotonically increasing token for debounced preview loads
 	messageReloadToken int       // monotonically increasing token for debounced watch reloads
+	listLayout         bool      // full-width session list while the sidebar is focused
+	sortMode           string    // session order (config.ConversationsSort*)
+	groupByTime        bool      // show Today/Yesterday/... headers in the session list
 
 	// View dimensions
 	width  int
@@ -158,6 +188,9 @@ type Plugin struct {
 	// Tiered watcher manager for FD reduction (td-dca6fe)
 	tieredManager *tieredwatcher.Manager
 
+	// Cancels background cache prewarming
+	prewarmCancel context.CancelFunc
+
 	// Event coalescing for watch events
 	coalescer         *EventCoalescer
 	coalesceChan      chan CoalescedRefreshMsg
@@ -205,6 +238,7 @@ type Plugin struct {
 	prevScrollOff   int
 	prevMsgScroll   int
 	prevTurnScroll  int
+	prevListView    bool
 
 	// Unfocused refresh throttling (td-05149f66)
 	pendingRefresh bool // true when refresh was skipped due to unfocused state
@@ -221,6 +255,15 @@ type Plugin struct {
 	// Large session warning tracking (td-ee67d8)
 	warnedSessions map[string]bool // session ID -> already warned about size
 
+	// Token budget alerts
+	budget            config.BudgetConfig
+	budgetWarned      map[string]bool // session ID -> already alerted about exceeding budget
+	budgetDailyWarned string          // day (YYYY-MM-DD) the daily budget alert was shown
+
+	// User-defined session badges
+	badgeRules   []badgeRule
+	sessionTools map[string]map[string]bool // session ID -> tool names seen in loaded messages
+
 	// Pi adapter discovery toast (td-697e89)
 	piDiscoveryToastShown bool // true after showing one-time Pi discovery toast
 
@@ -241,14 +284,41 @@ type Plugin struct {
 	resumeFocus           int
 	resumeSession         *adapter.Session
 
+	// Rename modal state
+	showRenameModal  bool
+	renameModal      *modal.Modal
+	renameModalWidth int
+	renameInput      textinput.Model
+	renameSession    *adapter.Session // copy of the session being renamed
+	renameError      string
+	originalTitles   map[string]string // session ID -> adapter name, for renamed sessions
+
 	// Content search state (td-6ac70a: cross-conversation search)
 	contentSearchMode  bool                // True when content search modal is open
 	contentSearchState *ContentSearchState // Content search state
 
+	// Tool output pager overlay (nil when closed)
+	toolPager *toolPagerState
+
+	// Split view: a second live session stacked under the main pane
+	split      *splitPaneState
+	splitFocus bool // split pane has focus within the message column
+	followMain bool // main pane keeps the newest message in view
+
+	// Image attachment overlay
+	imageViewer   *imageViewerState
+	imageRenderer *image.Renderer // created on first use
+
 	// Pending scroll target after messages load (td-b74d9f)
 	// Uses message ID (not index) to handle pagination correctly
 	pendingScrollMsgID  string // Target message ID to scroll to after load ("" = none)
 	pendingScrollActive bool   // True when we have a pending scroll request
+
+	// Permalink queued by --open, applied once its session loads
+	pendingPermalink *Permalink
+
+	// Co-viewing: position shared with other instances on the project
+	coview coviewState
 }
 
 // msgLineRange tracks which screen lines a message occupies (after scroll).
@@ -287,9 +357,14 @@ func New() *Plugin {
 		renderCache:         make(map[renderCacheKey]string),
 		hitRegionsDirty:     true, // Start dirty to ensure first render builds regions
 		sidebarVisible:      true, // Sidebar visible by default
+		sortMode:            config.ConversationsSortUpdated,
+		groupByTime:         true,
 		sidebarRestore:      PaneSidebar,
 		warnedSessions:      make(map[string]bool),
+		budgetWarned:        make(map[string]bool),
+		sessionTools:        make(map[string]map[string]bool),
 		skeleton:            ui.NewSkeleton(8, nil), // 8 placeholder rows
+		usageTracker:        adapter.NewUsageTracker(),
 	}
 	p.coalescer = NewEventCoalescer(0, coalesceChan)
 	return p
@@ -360,12 +435,29 @@ func (p *Plugin) resetState() {
 	p.analyticsScrollOff = 0
 	p.analyticsLines = nil
 
+	// Project stats view state
+	p.stats = nil
+	p.statsLoading = false
+	p.statsScrollOff = 0
+	p.statsLines = nil
+	p.usageTracker.Reset()
+
+	// Model comparison view state
+	p.comparison = nil
+	p.compareLoading = false
+	p.compareScrollOff = 0
+	p.compareCursor = 0
+	p.compareLines = nil
+
 	// Layout state - reset to defaults but preserve sidebarWidth (persisted)
 	p.activePane = PaneSidebar
 	p.sidebarRestore = PaneSidebar
 	p.sidebarVisible = true
 	p.previewToken = 0
 	p.messageReloadToken = 0
+	p.listLayout = false
+	p.sortMode = config.ConversationsSortUpdated
+	p.groupByTime = true
 
 	// Search state
 	p.searchMode = false
@@ -403,6 +495,18 @@ func (p *Plugin) resetState() {
 	// Large session warning tracking
 	p.warnedSessions = make(map[string]bool)
 
+	// Budget alert tracking
+	p.budget = config.BudgetConfig{}
+	p.budgetWarned = make(map[string]bool)
+	p.budgetDailyWarned = ""
+
+	// Co-viewing is opt-in per project; bump the token to drop old ticks
+	p.coview = coviewState{token: p.coview.token + 1}
+
+	// Badge rules
+	p.badgeRules = nil
+	p.sessionTools = make(map[string]map[string]bool)
+
 	// Recreate coalescer infrastructure (td-84a1cb)
 	// The old coalescer has closed=true and channel is closed after Stop()
 	p.coalesceChanClose = sync.Once{}
@@ -413,6 +517,7 @@ func (p *Plugin) resetState() {
 	p.adapterBatchChan = make(chan AdapterBatchMsg, 8)
 
 	// Initial load state (td-6cc19f)
+	p.stopPrewarm()
 	p.initialLoadDone = false
 	p.skeleton = ui.NewSkeleton(8, nil)
 	p.loadSettleToken = 0
@@ -421,6 +526,15 @@ func (p *Plugin) resetState() {
 	p.contentSearchMode = false
 	p.contentSearchState = nil
 
+	// Tool output pager
+	p.toolPager = nil
+	p.imageViewer = nil
+
+	// Split view
+	p.split = nil
+	p.splitFocus = false
+	p.followMain = false
+
 	// Pending scroll state (td-b74d9f)
 	p.pendingScrollMsgID = ""
 	p.pendingScrollActive = false
@@ -450,15 +564,23 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	} else {
 		p.defaultCategoryFilter = []string{adapter.SessionCategoryInteractive}
 	}
+	if ctx.Config != nil {
+		p.budget = ctx.Config.Plugins.Conversations.Budget
+		p.badgeRules = compileBadgeRules(ctx.Config.Plugins.Conversations.BadgeRules, ctx)
+		p.applyViewConfig(ctx.Config.ConversationsView(ctx.ProjectRoot))
+	}
 
 	p.adapters = make(map[string]adapter.Adapter)
 	for id, a := range ctx.Adapters {
-		found, err := a.Detect(ctx.ProjectRoot)
+		found, err := adapter.DetectCached(a, ctx.ProjectRoot)
 		if err != nil || !found {
 			continue
 		}
 		p.adapters[id] = a
 	}
+	if err := adapter.SaveDetectCache(); err != nil && ctx.Logger != nil {
+		ctx.Logger.Debug("detect cache save failed", "err", err)
+	}
 	if len(p.adapters) == 0 {
 		return nil
 	}
@@ -484,10 +606,14 @@ func (p *Plugin) Start() tea.Cmd {
 // Stop cleans up plugin resources.
 func (p *Plugin) Stop() {
 	p.stopped = true
+	// Withdraw the shared position so peers stop seeing it
+	p.stopCoview()
 	// Cancel watcher goroutines (td-eb2699b4)
 	if p.watchCancel != nil {
 		p.watchCancel()
 	}
+	p.stopPrewarm()
+	removeImageTempDir()
 	// Stop event coalescer
 	if p.coalescer != nil {
 		p.coalescer.Stop()
@@ -525,6 +651,12 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		return p, nil
 
+	case app.WorktreesChangedMsg:
+		return p, p.handleWorktreesChanged(msg)
+
+	case OpenPermalinkMsg:
+		return p, p.openPermalink(msg.Link)
+
 	case ui.SkeletonTickMsg:
 		// Forward tick to skeleton for animation (td-6cc19f)
 		var cmds []tea.Cmd
@@ -559,11 +691,30 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, cmd
 		}
 
+		if p.showRenameModal {
+			return p, p.handleRenameModalKeys(msg)
+		}
+
+		if p.toolPager != nil {
+			return p.handleToolPagerKey(msg)
+		}
+
+		if p.imageViewer != nil {
+			return p.handleImageViewerKey(msg)
+		}
+
 		switch p.view {
 		case ViewAnalytics:
 			return p.updateAnalytics(msg)
+		case ViewStats:
+			return p.updateStats(msg)
+		case ViewModels:
+			return p.updateModelCompare(msg)
 		default:
 			// Route based on active pane
+			if p.activePane == PaneMessages && p.split != nil && p.splitFocus {
+				return p.updateSplitPane(msg)
+			}
 			if p.activePane == PaneMessages {
 				return p.updateMessages(msg)
 			}
@@ -591,16 +742,14 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		for _, s := range p.sessions {
 			seen[s.ID] = true
 		}
+		p.applySessionTitles(msg.Sessions)
 		for _, s := range msg.Sessions {
 			if !seen[s.ID] {
 				seen[s.ID] = true
 				p.sessions = append(p.sessions, s)
 			}
 		}
-		// Re-sort by UpdatedAt descending
-		sort.Slice(p.sessions, func(i, j int) bool {
-			return p.sessions[i].UpdatedAt.After(p.sessions[j].UpdatedAt)
-		})
+		p.sortSessions()
 
 		// Update pagination state (td-7198a5)
 		if p.displayedCount == 0 {
@@ -636,6 +785,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			if cmd := p.checkPiDiscoveryToast(); cmd != nil {
 				cmds = append(cmds, cmd)
 			}
+			if cmd := p.checkBudgetAlerts(); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
 			// Schedule settle check for skeleton hide
 			if !p.initialLoadDone {
 				p.loadSettleToken++
@@ -646,6 +798,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			}
 		}
 
+		if cmd := p.applyPendingPermalink(msg.Final); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 		// Ensure a selection so the right pane can render
 		if p.selectedSession == "" && len(p.sessions) > 0 {
 			if p.cursor >= len(p.visibleSessions()) {
@@ -666,6 +822,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, nil // Ignore stale message from previous project
 		}
 		p.sessions = msg.Sessions
+		p.sortSessions()
+		p.applySessionTitles(p.sessions)
 		// Update session pagination state (td-7198a5)
 		if p.displayedCount == 0 {
 			p.displayedCount = defaultSessionPageSize
@@ -715,6 +873,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 
 		// Ensure a selection so the right pane can render.
 		var cmds []tea.Cmd
+		if cmd := p.applyPendingPermalink(true); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		if p.selectedSession == "" && len(p.sessions) > 0 {
 			if p.cursor >= len(p.sessions) {
 				p.cursor = len(p.sessions) - 1
@@ -728,6 +889,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if warningCmd != nil {
 			cmds = append(cmds, warningCmd)
 		}
+		if cmd := p.checkBudgetAlerts(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		if settleCmd != nil {
 			cmds = append(cmds, settleCmd)
 		}
@@ -743,6 +907,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		// Merge refreshed sessions into current list (not a stale snapshot).
 		// This avoids overwriting sessions added concurrently by loadSessions.
+		p.applySessionTitles(msg.Refreshed)
 		refreshMap := make(map[string]*adapter.Session, len(msg.Refreshed))
 		for i := range msg.Refreshed {
 			refreshMap[msg.Refreshed[i].ID] = &msg.Refreshed[i]
@@ -760,12 +925,31 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		for _, s := range refreshMap {
 			p.sessions = append(p.sessions, *s)
 		}
-		// Re-sort by UpdatedAt descending
-		sort.Slice(p.sessions, func(i, j int) bool {
-			return p.sessions[i].UpdatedAt.After(p.sessions[j].UpdatedAt)
-		})
+		p.sortSessions()
 		p.hasMoreSessions = len(p.sessions) > p.displayedCount
 		p.updateTieredHotTargets()
+		return p, p.checkBudgetAlerts()
+
+	case SessionTitleSuggestedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		return p, p.handleSessionTitleSuggested(msg)
+
+	case ProjectStatsMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.stats = msg.Stats
+		p.statsLoading = false
+		return p, nil
+
+	case ModelComparisonMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.comparison = msg.Comparison
+		p.compareLoading = false
 		return p, nil
 
 	case LoadSettledMsg:
@@ -773,6 +957,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if msg.Token == p.loadSettleToken && !p.initialLoadDone {
 			p.initialLoadDone = true
 			p.skeleton.Stop()
+			p.startPrewarm()
 		}
 		return p, nil
 
@@ -806,6 +991,12 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		return p, p.loadMessages(msg.SessionID)
 
+	case SplitReloadMsg, SplitMessagesLoadedMsg:
+		return p, p.handleSplitMessage(msg)
+
+	case CoviewTickMsg, CoviewPeersMsg:
+		return p, p.handleCoviewMessage(msg)
+
 	case MessagesLoadedMsg:
 		if plugin.IsStale(p.ctx, msg) {
 			return p, nil // Ignore stale message from previous project
@@ -840,6 +1031,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			oldLen := len(p.messages)
 			newMessages := msg.Messages[oldLen:]
 			p.messages = msg.Messages
+			p.recordSessionTools(msg.SessionID, newMessages)
 
 			// Incrementally update turns (handles extending last turn if same role)
 			p.turns = AppendMessagesToTurns(p.turns, newMessages, oldLen)
@@ -855,6 +1047,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			// Full reload: different session or messages don't match
 			p.loadedSession = msg.SessionID
 			p.messages = msg.Messages
+			p.recordSessionTools(msg.SessionID, msg.Messages)
 			p.turns = GroupMessagesIntoTurns(msg.Messages)
 			p.turnCursor = 0
 			p.turnScrollOff = 0
@@ -891,6 +1084,11 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		// hasOlderMsgs: true when there are messages beyond the current window (td-07fc795d)
 		p.hasOlderMsgs = (msg.Offset + len(msg.Messages)) < msg.TotalCount
 
+		// Keep streaming output in view when following
+		if p.followMain && !p.pendingScrollActive && p.messageOffset == 0 {
+			p.applyMainFollow()
+		}
+
 		// Process pending scroll request from content search (td-b74d9f)
 		// Uses message ID (not index) to handle pagination correctly
 		if p.pendingScrollActive && p.pendingScrollMsgID != "" {
@@ -951,6 +1149,12 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if plugin.IsStale(p.ctx, msg) {
 			return p, nil // Ignore stale message from previous project
 		}
+		if msg.Warning != "" {
+			warning := msg.Warning
+			return p, tea.Batch(p.listenForWatchEvents(), func() tea.Msg {
+				return app.ToastMsg{Message: warning, Duration: 10 * time.Second, IsError: true}
+			})
+		}
 		// Queue event for coalescing instead of immediate reload
 		// Pass epoch so CoalescedRefreshMsg can be validated for staleness
 		var epoch uint64
@@ -959,15 +1163,24 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		p.coalescer.Add(msg.SessionID, epoch)
 
+		// Keep the stats dashboard current without re-walking sessions
+		if msg.Usage != nil && p.stats != nil {
+			p.stats.applyUsageDelta(*msg.Usage)
+			p.statsLines = nil
+		}
+
 		cmds := []tea.Cmd{
 			p.listenForWatchEvents(),
 		}
 
 		// Still reload messages immediately if selected session changed
 		// (coalescer handles session list refresh)
-		if msg.SessionID != "" && msg.SessionID == p.selectedSession {
+		if p.selectedSession != "" && (msg.Resync || msg.SessionID == p.selectedSession) {
 			cmds = append(cmds, p.scheduleMessageReload(p.selectedSession))
 		}
+		if p.split != nil && (msg.Resync || msg.SessionID == p.split.sessionID) {
+			cmds = append(cmds, p.scheduleSplitReload())
+		}
 
 		return p, tea.Batch(cmds...)
 
@@ -1084,6 +1297,23 @@ func (p *Plugin) View(width, height int) string {
 		return lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(content)
 	}
 
+	if p.showRenameModal {
+		content := p.renderRenameModal(width, height)
+		return lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(content)
+	}
+
+	// Tool output pager overlay
+	if p.toolPager != nil {
+		content := p.renderToolPager(width, height)
+		return lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(content)
+	}
+
+	// Image attachment overlay
+	if p.imageViewer != nil {
+		content := p.renderImageViewer(width, height)
+		return lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(content)
+	}
+
 	var content string
 	if len(p.adapters) == 0 {
 		content = renderNoAdapter()
@@ -1091,6 +1321,10 @@ func (p *Plugin) View(width, height int) string {
 		switch p.view {
 		case ViewAnalytics:
 			content = p.renderAnalytics()
+		case ViewStats:
+			content = p.renderStats()
+		case ViewModels:
+			content = p.renderModelCompare()
 		default:
 			content = p.renderTwoPane()
 		}
@@ -1120,6 +1354,21 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "case", Name: "Case", Description: "Toggle alt+c", Category: plugin.CategoryView, Context: "conversations-content-search", Priority: 6},
 		}
 	}
+	if p.toolPager != nil {
+		return []plugin.Command{
+			{ID: "close", Name: "Close", Description: "Close tool output", Category: plugin.CategoryNavigation, Context: "conversations-tool-pager", Priority: 1},
+			{ID: "toggle-wrap", Name: "Wrap", Description: "Toggle line wrap", Category: plugin.CategoryView, Context: "conversations-tool-pager", Priority: 2},
+			{ID: "next-tool", Name: "Next", Description: "Next tool output", Category: plugin.CategoryNavigation, Context: "conversations-tool-pager", Priority: 3},
+			{ID: "yank", Name: "Yank", Description: "Yank tool output", Category: plugin.CategoryActions, Context: "conversations-tool-pager", Priority: 4},
+		}
+	}
+	if p.imageViewer != nil {
+		return []plugin.Command{
+			{ID: "close", Name: "Close", Description: "Close image", Category: plugin.CategoryNavigation, Context: "conversations-image-viewer", Priority: 1},
+			{ID: "open-external", Name: "Open", Description: "Open in system viewer", Category: plugin.CategoryActions, Context: "conversations-image-viewer", Priority: 2},
+			{ID: "next-image", Name: "Next", Description: "Next image", Category: plugin.CategoryNavigation, Context: "conversations-image-viewer", Priority: 3},
+		}
+	}
 	if p.searchMode {
 		return []plugin.Command{
 			{ID: "select", Name: "Select", Description: "Select search result", Category: plugin.CategoryActions, Context: "conversations-search", Priority: 1},
@@ -1140,15 +1389,38 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "yank", Name: "Yank", Description: "Yank turn content", Category: plugin.CategoryActions, Context: "turn-detail", Priority: 3},
 		}
 	}
+	if p.activePane == PaneMessages && p.split != nil && p.splitFocus {
+		return []plugin.Command{
+			{ID: "toggle-follow", Name: "Follow", Description: "Toggle follow mode", Category: plugin.CategoryView, Context: "conversations-split", Priority: 1},
+			{ID: "close-split", Name: "Unsplit", Description: "Close split pane", Category: plugin.CategoryView, Context: "conversations-split", Priority: 2},
+			{ID: "switch-pane", Name: "Pane", Description: "Focus sidebar", Category: plugin.CategoryNavigation, Context: "conversations-split", Priority: 3},
+		}
+	}
+	if p.activePane == PaneMessages && !p.selectedSupports(adapter.CapMessages) {
+		// Metadata-only adapter: no message views to offer
+		return []plugin.Command{
+			{ID: "back", Name: "Back", Description: "Return to sidebar", Category: plugin.CategoryNavigation, Context: "conversations-main", Priority: 1},
+			{ID: "open", Name: "Open", Description: "Open in CLI", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 2},
+			{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "conversations-main", Priority: 3},
+		}
+	}
 	if p.activePane == PaneMessages {
 		return []plugin.Command{
 			{ID: "toggle-view", Name: "View", Description: "Toggle conversation/turn view", Category: plugin.CategoryView, Context: "conversations-main", Priority: 1},
 			{ID: "detail", Name: "Detail", Description: "View turn details", Category: plugin.CategoryView, Context: "conversations-main", Priority: 2},
 			{ID: "expand", Name: "Expand", Description: "Expand selected item", Category: plugin.CategoryView, Context: "conversations-main", Priority: 3},
+			{ID: "tool-output", Name: "Output", Description: "Page tool output", Category: plugin.CategoryView, Context: "conversations-main", Priority: 4},
+			{ID: "raw-source", Name: "Raw", Description: "Show raw JSONL line", Category: plugin.CategoryView, Context: "conversations-main", Priority: 5},
+			{ID: "view-image", Name: "Image", Description: "View image attachments", Category: plugin.CategoryView, Context: "conversations-main", Priority: 6},
+			{ID: "copy-permalink", Name: "Link", Description: "Copy permalink to message", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 6},
+			{ID: "toggle-follow", Name: "Follow", Description: "Toggle follow mode", Category: plugin.CategoryView, Context: "conversations-main", Priority: 6},
+			{ID: "toggle-coview", Name: "Co-view", Description: "Share position with other instances", Category: plugin.CategoryView, Context: "conversations-main", Priority: 7},
+			{ID: "jump-to-peer", Name: "Peer", Description: "Jump to a co-viewing peer", Category: plugin.CategoryNavigation, Context: "conversations-main", Priority: 7},
 			{ID: "content-search", Name: "Find", Description: "Search content (F)", Category: plugin.CategorySearch, Context: "conversations-main", Priority: 3},
 			{ID: "back", Name: "Back", Description: "Return to sidebar", Category: plugin.CategoryNavigation, Context: "conversations-main", Priority: 4},
 			{ID: "open", Name: "Open", Description: "Open in CLI", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 5},
 			{ID: "yank", Name: "Yank", Description: "Yank turn content", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 6},
+			{ID: "export-json", Name: "JSON", Description: "Export session as JSON", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 7},
 			{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "conversations-main", Priority: 7},
 		}
 	}
@@ -1157,13 +1429,34 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "back", Name: "Back", Description: "Return to conversations", Category: plugin.CategoryNavigation, Context: "analytics", Priority: 1},
 		}
 	}
+	if p.view == ViewStats {
+		return []plugin.Command{
+			{ID: "back", Name: "Back", Description: "Return to conversations", Category: plugin.CategoryNavigation, Context: "conversations-stats", Priority: 1},
+			{ID: "refresh", Name: "Refresh", Description: "Recompute stats", Category: plugin.CategoryActions, Context: "conversations-stats", Priority: 2},
+		}
+	}
+	if p.view == ViewModels {
+		return []plugin.Command{
+			{ID: "back", Name: "Back", Description: "Return to conversations", Category: plugin.CategoryNavigation, Context: "conversations-models", Priority: 1},
+			{ID: "pin-model", Name: "Pin", Description: "Pin model for comparison", Category: plugin.CategoryActions, Context: "conversations-models", Priority: 2},
+			{ID: "clear-pins", Name: "Clear", Description: "Clear pinned models", Category: plugin.CategoryActions, Context: "conversations-models", Priority: 3},
+			{ID: "refresh", Name: "Refresh", Description: "Recompute comparison", Category: plugin.CategoryActions, Context: "conversations-models", Priority: 4},
+		}
+	}
 	return []plugin.Command{
 		{ID: "view-session", Name: "View", Description: "View session messages", Category: plugin.CategoryView, Context: "conversations-sidebar", Priority: 1},
 		{ID: "search", Name: "Search", Description: "Search conversations", Category: plugin.CategorySearch, Context: "conversations-sidebar", Priority: 2},
 		{ID: "filter", Name: "Filter", Description: "Filter by project", Category: plugin.CategorySearch, Context: "conversations-sidebar", Priority: 2},
 		{ID: "content-search", Name: "Find", Description: "Search content (F)", Category: plugin.CategorySearch, Context: "conversations-sidebar", Priority: 2},
 		{ID: "toggle-category", Name: "Category", Description: "Toggle category filter", Category: plugin.CategorySearch, Context: "conversations-sidebar", Priority: 3},
+		{ID: "show-stats", Name: "Stats", Description: "Project statistics", Category: plugin.CategoryView, Context: "conversations-sidebar", Priority: 4},
+		{ID: "compare-models", Name: "Models", Description: "Compare model outcomes by task type", Category: plugin.CategoryView, Context: "conversations-sidebar", Priority: 5},
 		{ID: "resume-in-workspace", Name: "Resume", Description: "Resume in workspace", Category: plugin.CategoryActions, Context: "conversations-sidebar", Priority: 3},
+		{ID: "rename-session", Name: "Rename", Description: "Rename session", Category: plugin.CategoryActions, Context: "conversations-sidebar", Priority: 4},
+		{ID: "retitle-session", Name: "Retitle", Description: "Re-title from best user message", Category: plugin.CategoryActions, Context: "conversations-sidebar", Priority: 5},
+		{ID: "split-session", Name: "Split", Description: "Pin session to a split pane", Category: plugin.CategoryView, Context: "conversations-sidebar", Priority: 5},
+		{ID: "toggle-coview", Name: "Co-view", Description: "Share position with other instances", Category: plugin.CategoryView, Context: "conversations-sidebar", Priority: 6},
+		{ID: "jump-to-peer", Name: "Peer", Description: "Jump to a co-viewing peer", Category: plugin.CategoryNavigation, Context: "conversations-sidebar", Priority: 6},
 		{ID: "yank-details", Name: "Copy Details", Description: "Copy session details", Category: plugin.CategoryActions, Context: "conversations-sidebar", Priority: 3},
 		{ID: "yank-resume", Name: "Copy Resume", Description: "Copy resume command", Category: plugin.CategoryActions, Context: "conversations-sidebar", Priority: 4},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "conversations-sidebar", Priority: 5},
@@ -1180,6 +1473,15 @@ func (p *Plugin) FocusContext() string {
 	if p.showResumeModal {
 		return "conversations-resume-modal"
 	}
+	if p.showRenameModal {
+		return "conversations-rename-modal"
+	}
+	if p.toolPager != nil {
+		return "conversations-tool-pager"
+	}
+	if p.imageViewer != nil {
+		return "conversations-image-viewer"
+	}
 	if p.searchMode {
 		return "conversations-search"
 	}
@@ -1193,11 +1495,18 @@ func (p *Plugin) FocusContext() string {
 	switch p.view {
 	case ViewAnalytics:
 		return "analytics"
+	case ViewStats:
+		return "conversations-stats"
+	case ViewModels:
+		return "conversations-models"
 	default:
 		// Return context based on active pane
 		if p.activePane == PaneSidebar {
 			return "conversations-sidebar"
 		}
+		if p.split != nil && p.splitFocus {
+			return "conversations-split"
+		}
 		return "conversations-main"
 	}
 }
@@ -1205,7 +1514,7 @@ func (p *Plugin) FocusContext() string {
 // ConsumesTextInput reports whether conversation UI currently has a focused
 // text-entry flow where app shortcuts should not intercept characters.
 func (p *Plugin) ConsumesTextInput() bool {
-	return p.searchMode || p.filterMode || p.contentSearchMode
+	return p.searchMode || p.filterMode || p.contentSearchMode || p.showRenameModal
 }
 
 // Diagnostics returns plugin health info.
@@ -1238,10 +1547,132 @@ func (p *Plugin) Diagnostics() []plugin.Diagnostic {
 		watchStatus = "on"
 	}
 
-	return []plugin.Diagnostic{
+	diags := []plugin.Diagnostic{
 		{ID: "conversations", Status: status, Detail: detail},
-		{ID: "watcher", Status: watchStatus, Detail: "fsnotify"},
+		{ID: "watcher", Status: watchStatus, Detail: p.watcherDetail()},
+	}
+	diags = append(diags, p.tierDiagnostics()...)
+	incompatible := adapter.Incompatible()
+	for _, id := range slices.Sorted(maps.Keys(incompatible)) {
+		diags = append(diags, plugin.Diagnostic{ID: "adapter-api", Status: "error", Detail: id + ": " + incompatible[id]})
+	}
+	diags = append(diags, ingestDiagnostics()...)
+	return append(diags, skippedLineDiagnostics()...)
+}
+
+// watcherDetail summarizes how sessions are split across watcher tiers.
+func (p *Plugin) watcherDetail() string {
+	if p.tieredManager == nil {
+		return "fsnotify"
+	}
+	hot, cold, frozen, _ := p.tieredManager.Stats()
+	dirs, _ := tieredwatcher.SharedMux().Stats()
+	detail := fmt.Sprintf("fsnotify: %d hot, %d cold, %d frozen, %d dir(s) watched", hot, cold, frozen, dirs)
+	if n := len(p.tieredManager.PollOnlyDirs()); n > 0 {
+		detail += fmt.Sprintf(", %d dir(s) polled", n)
+	}
+	return detail
+}
+
+// tierDiagnostics reports inotify limit warnings, dropped watcher events,
+// directories polled because fsnotify can't watch them, and the sessions
+// currently watched with fsnotify, most recently active first.
+func (p *Plugin) tierDiagnostics() []plugin.Diagnostic {
+	if p.tieredManager == nil {
+		return nil
+	}
+	var diags []plugin.Diagnostic
+	if warning := tieredwatcher.InotifyWarning(); warning != "" {
+		diags = append(diags, plugin.Diagnostic{ID: "watcher", Status: "warning", Detail: "  " + warning})
+	}
+	if d := p.tieredManager.DropStats(); d.Dropped() > 0 {
+		diags = append(diags, plugin.Diagnostic{
+			ID:     "watcher",
+			Status: "warning",
+			Detail: fmt.Sprintf("  %d notification(s), %d event(s) dropped; %d resync(s)", d.Notifications, d.Events, d.Resyncs),
+		})
+	}
+	pollOnly := p.tieredManager.PollOnlyDirs()
+	interval := p.watcherConfig().NetworkPoll
+	if interval <= 0 {
+		interval = tieredwatcher.DefaultNetworkPollInterval
+	}
+	for _, dir := range slices.Sorted(maps.Keys(pollOnly)) {
+		if len(diags) == maxIngestDiagnostics {
+			break
+		}
+		diags = append(diags, plugin.Diagnostic{
+			ID:     "watcher",
+			Status: "ok",
+			Detail: fmt.Sprintf("  %s: polled every %s (%s)", dir, interval, pollOnly[dir]),
+		})
 	}
+
+	assignments := p.tieredManager.Assignments()
+	hot := 0
+	for _, adapterID := range slices.Sorted(maps.Keys(assignments)) {
+		for _, a := range assignments[adapterID] {
+			if a.Tier != tieredwatcher.TierHot || hot == maxIngestDiagnostics {
+				break
+			}
+			hot++
+			diags = append(diags, plugin.Diagnostic{
+				ID:     "watcher",
+				Status: "ok",
+				Detail: fmt.Sprintf("  %s: %s hot, last active %s", adapterID, a.SessionID, a.LastActive.Format("15:04:05")),
+			})
+		}
+	}
+	return diags
+}
+
+// maxIngestDiagnostics caps how many rejected files are listed individually.
+const maxIngestDiagnostics = 3
+
+// ingestDiagnostics reports session files the adapters refused to parse,
+// newest first.
+func ingestDiagnostics() []plugin.Diagnostic {
+	violations := ingest.Violations()
+	if len(violations) == 0 {
+		return nil
+	}
+	diags := []plugin.Diagnostic{{
+		ID:     "ingest",
+		Status: "warning",
+		Detail: fmt.Sprintf("%d session file(s) rejected as unsafe", len(violations)),
+	}}
+	for i := len(violations) - 1; i >= 0 && len(diags) <= maxIngestDiagnostics; i-- {
+		v := violations[i]
+		diags = append(diags, plugin.Diagnostic{
+			ID:     "ingest",
+			Status: "warning",
+			Detail: fmt.Sprintf("  %s: %s: %s", v.Adapter, v.Path, v.Reason),
+		})
+	}
+	return diags
+}
+
+// skippedLineDiagnostics reports session file lines too long to parse,
+// newest first.
+func skippedLineDiagnostics() []plugin.Diagnostic {
+	skipped := cache.SkippedLines()
+	if len(skipped) == 0 {
+		return nil
+	}
+	diags := []plugin.Diagnostic{{
+		ID:     "scanner",
+		Status: "warning",
+		Detail: fmt.Sprintf("%d line(s) over %d MB skipped", len(skipped), cache.MaxLineSize()>>20),
+	}}
+	for i := len(skipped) - 1; i >= 0 && len(diags) <= maxIngestDiagnostics; i-- {
+		l := skipped[i]
+		diags = append(diags, plugin.Diagnostic{
+			ID:     "scanner",
+			Status: "warning",
+			Detail: fmt.Sprintf("  %s@%d: line too long (%d bytes) — skipped", l.Path, l.Offset, l.Size),
+		})
+	}
+	return diags
 }
 
 // copySessionToClipboard copies the current session as markdown to clipboard.
@@ -1273,6 +1704,34 @@ func (p *Plugin) exportSessionToFile() tea.Cmd {
 	}
 }
 
+// exportSessionToJSON exports the selected session as canonical session JSON.
+func (p *Plugin) exportSessionToJSON() tea.Cmd {
+	session := p.findSelectedSession()
+	if session == nil {
+		return nil
+	}
+	messages := p.messages
+	workDir := p.ctx.WorkDir
+	var usage *adapter.UsageStats
+	if s := p.sessionSummary; s != nil && p.sessionSupports(session, adapter.CapUsage) {
+		usage = &adapter.UsageStats{
+			TotalInputTokens:  s.TotalTokensIn,
+			TotalOutputTokens: s.TotalTokensOut,
+			TotalCacheRead:    s.TotalCacheRead,
+			TotalCacheWrite:   s.TotalCacheWrite,
+			MessageCount:      s.MessageCount,
+		}
+	}
+
+	return func() tea.Msg {
+		filename, err := ExportSessionToJSONFile(session, messages, usage, workDir)
+		if err != nil {
+			return app.ToastMsg{Message: "Export failed: " + err.Error(), Duration: 2 * time.Second, IsError: true}
+		}
+		return app.ToastMsg{Message: "Exported to " + filename, Duration: 2 * time.Second}
+	}
+}
+
 // Message types
 type SessionsLoadedMsg struct {
 	Epoch    uint64 // Epoch when request was issued (for stale detection)
@@ -1313,8 +1772,11 @@ type MessagesLoadedMsg struct {
 func (m MessagesLoadedMsg) GetEpoch() uint64 { return m.Epoch }
 
 type WatchEventMsg struct {
-	Epoch     uint64 // Epoch when request was issued (for stale detection)
-	SessionID string // ID of the session that changed (empty for periodic refresh)
+	Epoch     uint64              // Epoch when request was issued (for stale detection)
+	SessionID string              // ID of the session that changed (empty for periodic refresh)
+	Usage     *adapter.UsageDelta // usage change carried by the event, if known
+	Resync    bool                // events were dropped; reload everything
+	Warning   string              // message for the user; no session changed
 }
 
 // GetEpoch implements plugin.EpochMessage.
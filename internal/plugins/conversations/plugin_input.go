@@ -165,6 +165,14 @@ func (p *Plugin) updateSessions(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		p.view = ViewAnalytics
 		return p, nil
 
+	case "S":
+		// Project statistics dashboard
+		return p, p.openStats()
+
+	case "M":
+		// Model outcome comparison by task type
+		return p, p.openModelCompare()
+
 	case "y":
 		// Yank session details to clipboard
 		return p, p.yankSessionDetails()
@@ -180,6 +188,26 @@ func (p *Plugin) updateSessions(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	case "R":
 		// Open resume modal for workspace
 		return p, p.openResumeModal()
+
+	case "N":
+		// Rename selected session
+		return p, p.openRenameModal()
+
+	case "|":
+		// Pin session to a split pane below the main pane
+		return p, p.toggleSplit()
+
+	case "T":
+		// Re-title from the most informative user message
+		return p, p.retitleSelectedSession()
+
+	case "P":
+		// Share this position with other instances on the project
+		return p, p.toggleCoview()
+
+	case "W":
+		// Jump to where a co-viewing peer is looking
+		return p, p.jumpToCoviewPeer()
 	}
 
 	return p, nil
@@ -408,6 +436,46 @@ func (p *Plugin) updateAnalytics(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	return p, nil
 }
 
+// updateStats handles key events in the project stats view.
+func (p *Plugin) updateStats(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	maxScroll := len(p.statsLines) - (p.height - 2)
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	switch msg.String() {
+	case "esc", "q", "S":
+		p.view = ViewSessions
+		p.statsScrollOff = 0
+
+	case "r":
+		return p, p.refreshStats()
+
+	case "j", "down":
+		if p.statsScrollOff < maxScroll {
+			p.statsScrollOff++
+		}
+
+	case "k", "up":
+		if p.statsScrollOff > 0 {
+			p.statsScrollOff--
+		}
+
+	case "g":
+		p.statsScrollOff = 0
+
+	case "G":
+		p.statsScrollOff = maxScroll
+
+	case "ctrl+d":
+		p.statsScrollOff = min(p.statsScrollOff+10, maxScroll)
+
+	case "ctrl+u":
+		p.statsScrollOff = max(p.statsScrollOff-10, 0)
+	}
+	return p, nil
+}
+
 // updateMessages handles key events in message view (now uses turns).
 func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	// In detail mode, handle detail-specific navigation
@@ -415,6 +483,12 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		return p.updateDetailMode(msg)
 	}
 
+	// Scrolling back through history pauses follow mode
+	switch msg.String() {
+	case "k", "up", "g", "ctrl+u", "p":
+		p.followMain = false
+	}
+
 	switch msg.String() {
 	case "esc":
 		// Restore sidebar if hidden, otherwise return focus to sidebar
@@ -433,12 +507,27 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		return p, nil
 
 	case "tab", "shift+tab":
-		// Switch focus to sidebar (if visible)
+		// Cycle into the split pane, then back to the sidebar (if visible)
+		if p.split != nil {
+			p.splitFocus = true
+			return p, nil
+		}
 		if p.sidebarVisible {
 			p.activePane = PaneSidebar
 		}
 		return p, nil
 
+	case "f":
+		// Toggle follow mode (keep newest message in view)
+		return p, p.toggleMainFollow()
+
+	case "|":
+		// Close split view
+		if p.split != nil {
+			p.closeSplit()
+		}
+		return p, nil
+
 	case "\\":
 		// Toggle sidebar visibility
 		p.toggleSidebar()
@@ -598,6 +687,9 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 
 	case "v":
 		// Toggle between conversation flow and turn view
+		if cmd := p.requireSelectedCapability(adapter.CapMessages); cmd != nil {
+			return p, cmd
+		}
 		p.turnViewMode = !p.turnViewMode
 		p.hitRegionsDirty = true // Different hit regions per view mode (td-455e378b)
 		return p, nil
@@ -671,6 +763,9 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 
 	case "enter":
 		// Open turn detail view in right pane
+		if cmd := p.requireSelectedCapability(adapter.CapMessages); cmd != nil {
+			return p, cmd
+		}
 		if p.turnViewMode {
 			// Turn view: use turnCursor
 			if p.turnCursor < len(p.turns) {
@@ -706,6 +801,12 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			return p, p.exportSessionToFile()
 		}
 
+	case "X":
+		// Export session as canonical JSON
+		if p.selectedSession != "" {
+			return p, p.exportSessionToJSON()
+		}
+
 	case " ":
 		// Load more messages (would need to implement paging in adapter)
 		return p, nil
@@ -725,6 +826,30 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	case "F":
 		// Open content search modal (td-6ac70a)
 		return p.openContentSearch()
+
+	case "O":
+		// Open tool output pager for the selected message/turn
+		return p, p.openToolPager()
+
+	case "J":
+		// Show the selected message's raw JSONL line
+		return p, p.openRawSource()
+
+	case "L":
+		// Copy a forge:// permalink to the selected message
+		return p, p.yankPermalink()
+
+	case "I":
+		// View image attachments of the selected message
+		return p, p.openImageViewer()
+
+	case "P":
+		// Share this position with other instances on the project
+		return p, p.toggleCoview()
+
+	case "W":
+		// Jump to where a co-viewing peer is looking
+		return p, p.jumpToCoviewPeer()
 	}
 
 	return p, nil
@@ -15,11 +15,37 @@ import (
 	"github.com/wilbur182/forge/internal/adapter"
 	"github.com/wilbur182/forge/internal/adapter/tieredwatcher"
 	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/config"
 	"github.com/wilbur182/forge/internal/fdmonitor"
+	"github.com/wilbur182/forge/internal/features"
 )
 
 // Data loading and file watching methods
 
+// handleWorktreesChanged notifies adapters of a created or deleted worktree and
+// drops the worktree cache so the next scan rediscovers related project dirs
+// right away instead of after worktreeCacheTTL.
+func (p *Plugin) handleWorktreesChanged(msg app.WorktreesChangedMsg) tea.Cmd {
+	for _, a := range p.adapters {
+		if obs, ok := a.(adapter.WorktreeObserver); ok {
+			obs.WorktreesChanged(msg.Path, msg.Removed)
+		}
+	}
+	p.cachedWorktreePaths = nil
+	p.cachedWorktreeNames = nil
+	p.worktreeCacheTime = time.Time{}
+
+	if len(p.adapters) == 0 {
+		return nil
+	}
+	// Defer the rescan while unfocused, like coalesced watch refreshes (td-05149f66)
+	if !p.focused {
+		p.pendingRefresh = true
+		return nil
+	}
+	return p.loadSessions()
+}
+
 // loadSessions loads sessions from the adapter.
 // Queries sessions from all related worktree paths to show cross-worktree conversations.
 // Sessions from deleted worktrees are marked with "(deleted)" in their worktree name.
@@ -252,11 +278,15 @@ func (p *Plugin) loadMessages(sessionID string) tea.Cmd {
 		if len(p.adapters) == 0 {
 			return MessagesLoadedMsg{Epoch: epoch}
 		}
-		adapter := p.adapterForSession(sessionID)
-		if adapter == nil {
+		a := p.adapterForSession(sessionID)
+		if a == nil {
 			return MessagesLoadedMsg{Epoch: epoch}
 		}
-		messages, err := adapter.Messages(sessionID)
+		if !adapterSupports(a, adapter.CapMessages) {
+			// Metadata-only adapter; the main pane explains the empty state
+			return MessagesLoadedMsg{Epoch: epoch, SessionID: sessionID}
+		}
+		messages, err := a.Messages(sessionID)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -319,6 +349,13 @@ func (p *Plugin) startWatcher() tea.Cmd {
 		p.tieredManager = manager
 
 		merged := make(chan adapter.Event, 32)
+		tracker := p.usageTracker
+		snapshotters := make(map[string]adapter.UsageSnapshotter)
+		for adapterID, a := range p.adapters {
+			if us, ok := a.(adapter.UsageSnapshotter); ok {
+				snapshotters[adapterID] = us
+			}
+		}
 		var wg sync.WaitGroup
 		watchCount := 0
 
@@ -390,6 +427,27 @@ func (p *Plugin) startWatcher() tea.Cmd {
 				return strings.TrimSuffix(base, filepath.Ext(base))
 			}
 			scale := p.hotTargetScale()
+			watcherMode := features.Value(features.WatcherMode.Name)
+
+			// Tiered events carry no adapter ID; resolve the usage source
+			// from the session's adapter, falling back to every file-based
+			// snapshotter for sessions created after the watcher started.
+			sessionAdapters := make(map[string]string)
+			var tieredSnapshotters []adapter.UsageSnapshotter
+			for adapterID, cfg := range adapterConfigs {
+				for _, s := range cfg.sessions {
+					sessionAdapters[s.ID] = adapterID
+				}
+				if us, ok := snapshotters[adapterID]; ok {
+					tieredSnapshotters = append(tieredSnapshotters, us)
+				}
+			}
+			usageSources := func(sessionID string) []adapter.UsageSnapshotter {
+				if us, ok := snapshotters[sessionAdapters[sessionID]]; ok {
+					return []adapter.UsageSnapshotter{us}
+				}
+				return tieredSnapshotters
+			}
 
 			for adapterID, cfg := range adapterConfigs {
 				if len(cfg.sessions) == 0 {
@@ -433,10 +491,13 @@ func (p *Plugin) startWatcher() tea.Cmd {
 				}
 
 				tw, ch, err := tieredwatcher.New(tieredwatcher.Config{
-					FilePattern: "",
-					Filter:      extFilter,
-					ExtractID:   extractID,
-					ScanDir:     scanDir,
+					FilePattern:         "",
+					Filter:              extFilter,
+					ExtractID:           extractID,
+					ScanDir:             scanDir,
+					Policy:              p.watcherPolicy(watcherMode),
+					PollPaths:           p.watcherConfig().PollPaths,
+					NetworkPollInterval: p.watcherConfig().NetworkPoll,
 				})
 				if err != nil {
 					continue
@@ -445,7 +506,7 @@ func (p *Plugin) startWatcher() tea.Cmd {
 				// Register all sessions with this watcher
 				tw.RegisterSessions(cfg.sessions)
 				manager.AddWatcher(adapterID, tw, ch)
-				manager.SetHotTarget(adapterID, applyHotTargetScale(cfg.activeCount, scale))
+				manager.SetHotTarget(adapterID, hotTargetForMode(watcherMode, cfg.activeCount, len(cfg.sessions), scale))
 				watchCount++
 			}
 
@@ -461,9 +522,11 @@ func (p *Plugin) startWatcher() tea.Cmd {
 						if !ok {
 							return
 						}
+						evt = withUsageDelta(evt, tracker, usageSources(evt.SessionID))
 						select {
 						case merged <- evt:
 						default:
+							manager.ReportDropped()
 						}
 					}
 				}
@@ -488,6 +551,11 @@ func (p *Plugin) startWatcher() tea.Cmd {
 				pathsToWatch = worktreePaths[:1]
 			}
 
+			var sources []adapter.UsageSnapshotter
+			if us, ok := snapshotters[adapterID]; ok {
+				sources = []adapter.UsageSnapshotter{us}
+			}
+
 			for _, wtPath := range pathsToWatch {
 				ch, closer, err := a.Watch(wtPath)
 				if err != nil || ch == nil || closer == nil {
@@ -510,6 +578,7 @@ func (p *Plugin) startWatcher() tea.Cmd {
 							if !ok {
 								return
 							}
+							evt = withUsageDelta(evt, tracker, sources)
 							select {
 							case merged <- evt:
 							default:
@@ -552,7 +621,14 @@ func (p *Plugin) listenForWatchEvents() tea.Cmd {
 			// Channel closed
 			return nil
 		}
-		return WatchEventMsg{Epoch: epoch, SessionID: evt.SessionID}
+		msg := WatchEventMsg{Epoch: epoch, SessionID: evt.SessionID, Resync: evt.Type == adapter.EventResync}
+		if d, ok := evt.Data.(adapter.UsageDelta); ok {
+			msg.Usage = &d
+		}
+		if evt.Type == adapter.EventWarning {
+			msg.Warning, _ = evt.Data.(string)
+		}
+		return msg
 	}
 }
 
@@ -619,6 +695,19 @@ func (p *Plugin) hotTargetScale() float64 {
 	return 1.0 - (1.0-hotTargetMinScale)*progress
 }
 
+// hotTargetForMode returns how many of an adapter's total sessions get
+// fsnotify watches under the watcher.mode variant: none when polling, all
+// of them for fsnotify, and the scaled active count when tiered.
+func hotTargetForMode(mode string, activeCount, total int, scale float64) int {
+	switch mode {
+	case "poll":
+		return 0
+	case "fsnotify":
+		return total
+	}
+	return applyHotTargetScale(activeCount, scale)
+}
+
 func applyHotTargetScale(activeCount int, scale float64) int {
 	if activeCount <= 0 {
 		return 0
@@ -642,7 +731,7 @@ func (p *Plugin) updateTieredHotTargets() {
 	}
 
 	activeCounts := make(map[string]int)
-	hasSessions := make(map[string]bool)
+	totals := make(map[string]int)
 
 	selectedAdapter := ""
 	selectedActive := false
@@ -651,7 +740,7 @@ func (p *Plugin) updateTieredHotTargets() {
 		if s.AdapterID == "" || s.Path == "" {
 			continue
 		}
-		hasSessions[s.AdapterID] = true
+		totals[s.AdapterID]++
 		if s.IsActive {
 			activeCounts[s.AdapterID]++
 		}
@@ -663,14 +752,34 @@ func (p *Plugin) updateTieredHotTargets() {
 
 	if selectedAdapter != "" && !selectedActive {
 		activeCounts[selectedAdapter]++
-		hasSessions[selectedAdapter] = true
 	}
 
 	scale := p.hotTargetScale()
-	for adapterID := range hasSessions {
-		target := applyHotTargetScale(activeCounts[adapterID], scale)
+	mode := features.Value(features.WatcherMode.Name)
+	for adapterID, total := range totals {
+		target := hotTargetForMode(mode, activeCounts[adapterID], total, scale)
 		p.tieredManager.SetHotTarget(adapterID, target)
+		p.tieredManager.SetPolicy(adapterID, p.watcherPolicy(mode))
+	}
+}
+
+// watcherPolicy returns the tiered watcher policy for the watcher.mode
+// variant and the configured idle timeout. Polling mode never promotes
+// sessions to fsnotify, even on change.
+func (p *Plugin) watcherPolicy(mode string) tieredwatcher.Policy {
+	return tieredwatcher.Policy{
+		IdleTimeout: p.watcherConfig().HotIdle,
+		PollOnly:    mode == "poll",
+	}
+}
+
+// watcherConfig returns the configured watcher settings, or zero values
+// (the tiered watcher's defaults) without a config.
+func (p *Plugin) watcherConfig() config.WatcherConfig {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return config.WatcherConfig{}
 	}
+	return p.ctx.Config.Plugins.Conversations.Watcher
 }
 
 // loadUsage loads usage stats for a session (placeholder for future implementation).
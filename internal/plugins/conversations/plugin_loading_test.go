@@ -57,3 +57,20 @@ func TestDeriveWorktreeNameFromPath(t *testing.T) {
 		})
 	}
 }
+
+func TestHotTargetForMode(t *testing.T) {
+	tests := []struct {
+		mode string
+		want int
+	}{
+		{"tiered", 2},
+		{"poll", 0},
+		{"fsnotify", 10},
+		{"", 2},
+	}
+	for _, tt := range tests {
+		if got := hotTargetForMode(tt.mode, 2, 10, 1.0); got != tt.want {
+			t.Errorf("hotTargetForMode(%q) = %d, want %d", tt.mode, got, tt.want)
+		}
+	}
+}
@@ -243,7 +243,7 @@ func (p *Plugin) ensureCursorVisible() {
 	sessions := p.visibleSessions()
 
 	// When not in search mode and we have sessions, account for group headers
-	if !p.searchMode && len(sessions) > 0 {
+	if p.groupedView() && len(sessions) > 0 {
 		// Calculate visual lines between scrollOff and cursor (including headers)
 		headerLines := p.countHeaderLinesBetween(p.scrollOff, p.cursor)
 		visualOffset := (p.cursor - p.scrollOff) + headerLines
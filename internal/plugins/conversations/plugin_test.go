@@ -3,12 +3,15 @@ package conversations
 import (
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/ingest"
 	"github.com/wilbur182/forge/internal/app"
 	"github.com/wilbur182/forge/internal/plugin"
 )
@@ -403,6 +406,30 @@ func TestDiagnosticsWatcherOn(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsIngestViolations(t *testing.T) {
+	ingest.Reset()
+	defer ingest.Reset()
+	root, other := t.TempDir(), t.TempDir()
+	path := filepath.Join(other, "session.jsonl")
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ingest.Check("claude-code", root, path); err == nil {
+		t.Fatal("expected path outside root to be rejected")
+	}
+
+	diags := New().Diagnostics()
+	if len(diags) != 4 {
+		t.Fatalf("expected 4 diagnostics, got %d", len(diags))
+	}
+	if diags[2].ID != "ingest" || diags[2].Status != "warning" {
+		t.Errorf("expected ingest warning, got %+v", diags[2])
+	}
+	if !strings.Contains(diags[3].Detail, path) {
+		t.Errorf("expected rejected path in detail, got %q", diags[3].Detail)
+	}
+}
+
 // Test WatchStartedMsg with nil channel
 func TestUpdateWatchStartedMsgNilChannel(t *testing.T) {
 	p := New()
@@ -3673,3 +3700,40 @@ func TestSessionsRefreshedMsgAddsNewSession(t *testing.T) {
 		t.Errorf("expected new session at top, got %s", p.sessions[0].ID)
 	}
 }
+
+// worktreeObserverAdapter records WorktreesChanged notifications.
+type worktreeObserverAdapter struct {
+	mockAdapter
+	paths []string
+}
+
+func (m *worktreeObserverAdapter) WorktreesChanged(path string, removed bool) {
+	m.paths = append(m.paths, path)
+}
+
+func TestWorktreesChangedMsgInvalidatesWorktreeCache(t *testing.T) {
+	obs := &worktreeObserverAdapter{}
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": obs}
+	p.cachedWorktreePaths = []string{"/repo"}
+	p.worktreeCacheTime = time.Now()
+
+	_, cmd := p.Update(app.WorktreesChangedMsg{Path: "/repo-feature"})
+
+	if len(obs.paths) != 1 || obs.paths[0] != "/repo-feature" {
+		t.Errorf("adapter notified with %v, want [/repo-feature]", obs.paths)
+	}
+	if p.cachedWorktreePaths != nil || !p.worktreeCacheTime.IsZero() {
+		t.Error("expected worktree cache to be cleared")
+	}
+	// Unfocused: rescan is deferred until focus
+	if cmd != nil || !p.pendingRefresh {
+		t.Error("expected deferred refresh while unfocused")
+	}
+
+	p.focused = true
+	p.pendingRefresh = false
+	if _, cmd := p.Update(app.WorktreesChangedMsg{Path: "/repo-feature", Removed: true}); cmd == nil {
+		t.Error("expected immediate session reload when focused")
+	}
+}
@@ -0,0 +1,115 @@
+package conversations
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+const (
+	// prewarmWorkers bounds concurrent prewarm parses so they don't compete
+	// with loads the user is waiting on.
+	prewarmWorkers = 2
+	// prewarmPause spaces out each worker's parses to keep prewarming low
+	// priority.
+	prewarmPause = 50 * time.Millisecond
+)
+
+// prewarmTarget is a session to parse ahead of use.
+type prewarmTarget struct {
+	id string
+	a  adapter.Adapter
+}
+
+// prewarmTargets returns the n most recently updated sessions.
+func (p *Plugin) prewarmTargets(n int) []prewarmTarget {
+	sessions := make([]adapter.Session, len(p.sessions))
+	copy(sessions, p.sessions)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
+	})
+
+	targets := make([]prewarmTarget, 0, n)
+	for _, s := range sessions {
+		if len(targets) == n {
+			break
+		}
+		if a := p.adapters[s.AdapterID]; a != nil {
+			targets = append(targets, prewarmTarget{id: s.ID, a: a})
+		}
+	}
+	return targets
+}
+
+// startPrewarm fills adapter caches for the most recently updated sessions
+// in the background, so the first click on one doesn't wait on parsing.
+// Called once the initial session load settles; a project switch or Stop
+// cancels it.
+func (p *Plugin) startPrewarm() {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return
+	}
+	cfg := p.ctx.Config.Plugins.Conversations.Prewarm
+	if cfg.Sessions <= 0 {
+		return
+	}
+	targets := p.prewarmTargets(cfg.Sessions)
+	if len(targets) == 0 {
+		return
+	}
+
+	p.stopPrewarm()
+	ctx, cancel := context.WithCancel(context.Background())
+	p.prewarmCancel = cancel
+	go prewarm(ctx, targets, cfg.Messages)
+}
+
+// stopPrewarm cancels a running prewarm.
+func (p *Plugin) stopPrewarm() {
+	if p.prewarmCancel != nil {
+		p.prewarmCancel()
+		p.prewarmCancel = nil
+	}
+}
+
+// prewarm parses targets, most recent first, on a small worker pool.
+func prewarm(ctx context.Context, targets []prewarmTarget, messages bool) {
+	jobs := make(chan prewarmTarget)
+	var wg sync.WaitGroup
+	for range prewarmWorkers {
+		wg.Go(func() {
+			for t := range jobs {
+				prewarmSession(t, messages)
+				select {
+				case <-ctx.Done():
+				case <-time.After(prewarmPause):
+				}
+			}
+		})
+	}
+
+feed:
+	for _, t := range targets {
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- t:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// prewarmSession loads a session's metadata and optionally its messages,
+// discarding the results; the adapter caches keep them.
+func prewarmSession(t prewarmTarget, messages bool) {
+	if s, ok := t.a.(adapter.UsageSnapshotter); ok {
+		s.UsageSnapshot(t.id)
+	}
+	if messages && adapterSupports(t.a, adapter.CapMessages) {
+		_, _ = t.a.Messages(t.id)
+	}
+}
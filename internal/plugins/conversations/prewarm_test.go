@@ -0,0 +1,59 @@
+package conversations
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+// prewarmAdapter records which sessions had their messages loaded.
+type prewarmAdapter struct {
+	mockAdapter
+	mu     sync.Mutex
+	loaded []string
+}
+
+func (m *prewarmAdapter) Messages(sessionID string) ([]adapter.Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.loaded = append(m.loaded, sessionID)
+	return nil, nil
+}
+
+func TestPrewarm_MostRecentSessions(t *testing.T) {
+	a := &prewarmAdapter{}
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": a}
+	now := time.Now()
+	p.sessions = []adapter.Session{
+		{ID: "old", AdapterID: "mock", UpdatedAt: now.Add(-2 * time.Hour)},
+		{ID: "new", AdapterID: "mock", UpdatedAt: now},
+		{ID: "orphan", AdapterID: "gone", UpdatedAt: now},
+		{ID: "mid", AdapterID: "mock", UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	targets := p.prewarmTargets(2)
+	var ids []string
+	for _, tg := range targets {
+		ids = append(ids, tg.id)
+	}
+	if !slices.Equal(ids, []string{"new", "mid"}) {
+		t.Fatalf("targets = %v, want [new mid]", ids)
+	}
+
+	prewarm(context.Background(), targets, true)
+	slices.Sort(a.loaded)
+	if !slices.Equal(a.loaded, []string{"mid", "new"}) {
+		t.Errorf("loaded = %v, want [mid new]", a.loaded)
+	}
+
+	a.loaded = nil
+	prewarm(context.Background(), targets, false)
+	if len(a.loaded) != 0 {
+		t.Errorf("messages loaded with messages disabled: %v", a.loaded)
+	}
+}
@@ -0,0 +1,152 @@
+package conversations
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+)
+
+const (
+	// rawContextLines is how many lines are shown before and after the target line.
+	rawContextLines = 20
+	// rawContextMaxChars caps each context line so one huge record can't crowd
+	// the target out of the pager's highlight limit.
+	rawContextMaxChars = 2000
+	// rawTargetMaxChars caps the target line itself.
+	rawTargetMaxChars = toolPagerMaxChars / 2
+	// rawLookbehind is how far before the target offset we read for context.
+	rawLookbehind = 64 * 1024
+)
+
+// rawSourceWindow is a slice of a session file around a message's line.
+type rawSourceWindow struct {
+	Lines     []string
+	FirstLine int // 1-based line number of Lines[0]
+	Target    int // index of the target line in Lines
+}
+
+// readRawSourceWindow reads up to `context` lines on each side of ref.
+func readRawSourceWindow(path string, ref adapter.SourceRef, context int) (*rawSourceWindow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	// Lines before the target: read a bounded chunk and keep the complete tail lines
+	var before []string
+	if ref.Offset > 0 && context > 0 {
+		start := max(ref.Offset-rawLookbehind, 0)
+		chunk := make([]byte, ref.Offset-start)
+		if _, err := f.ReadAt(chunk, start); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		parts := strings.Split(strings.TrimSuffix(string(chunk), "\n"), "\n")
+		if start > 0 && len(parts) > 0 {
+			parts = parts[1:] // first line is partial
+		}
+		if len(parts) > context {
+			parts = parts[len(parts)-context:]
+		}
+		for _, line := range parts {
+			before = append(before, truncateRawLine(line, rawContextMaxChars))
+		}
+	}
+
+	if _, err := f.Seek(ref.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(f)
+	var after []string
+	for i := 0; i <= context; i++ {
+		line, err := r.ReadString('\n')
+		if line == "" && err != nil {
+			if i == 0 {
+				return nil, fmt.Errorf("line %d not found (file changed?)", ref.Line)
+			}
+			break
+		}
+		limit := rawContextMaxChars
+		if i == 0 {
+			limit = rawTargetMaxChars
+		}
+		after = append(after, truncateRawLine(strings.TrimSuffix(line, "\n"), limit))
+		if err != nil {
+			break
+		}
+	}
+
+	return &rawSourceWindow{
+		Lines:     append(before, after...),
+		FirstLine: ref.Line - len(before),
+		Target:    len(before),
+	}, nil
+}
+
+// truncateRawLine shortens a line to limit bytes, marking the cut.
+func truncateRawLine(line string, limit int) string {
+	if len(line) <= limit {
+		return line
+	}
+	kept := truncateAtRune(line, limit)
+	return fmt.Sprintf("%s … (%d more bytes)", kept, len(line)-len(kept))
+}
+
+// selectedSourceMessage returns the message whose source location should be
+// shown: the selected message, or the first locatable message in the turn.
+func (p *Plugin) selectedSourceMessage() *adapter.Message {
+	if p.turnViewMode || p.detailMode {
+		if turn := p.getCurrentTurn(); turn != nil {
+			for i := range turn.Messages {
+				if turn.Messages[i].Source.Known() {
+					return &turn.Messages[i]
+				}
+			}
+			if len(turn.Messages) > 0 {
+				return &turn.Messages[0]
+			}
+		}
+		return nil
+	}
+	return p.getSelectedMessage()
+}
+
+// openRawSource shows the selected message's raw JSONL line in the pager,
+// positioned at the line, and reports its line number and byte offset.
+func (p *Plugin) openRawSource() tea.Cmd {
+	msg := p.selectedSourceMessage()
+	session := p.findSelectedSession()
+	if msg == nil || session == nil {
+		return nil
+	}
+	if !msg.Source.Known() || session.Path == "" {
+		return app.ShowToast("Source location not available for this adapter", 2*time.Second)
+	}
+
+	win, err := readRawSourceWindow(session.Path, msg.Source, rawContextLines)
+	if err != nil {
+		return func() tea.Msg {
+			return app.ToastMsg{Message: "Read source failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+
+	location := fmt.Sprintf("%s:%d (byte %d)", filepath.Base(session.Path), msg.Source.Line, msg.Source.Offset)
+	p.toolPager = &toolPagerState{entries: []toolPagerEntry{{
+		ToolName: location,
+		Output:   strings.Join(win.Lines, "\n"),
+		Raw:      true,
+		Caption:  fmt.Sprintf("lines %d-%d", win.FirstLine, win.FirstLine+len(win.Lines)-1),
+	}}}
+	p.toolPager.load(0)
+	p.toolPager.scroll = win.Target
+	return app.ShowToast(location, 3*time.Second)
+}
@@ -0,0 +1,95 @@
+package conversations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+// writeJSONL writes n numbered JSON lines and returns the path and line offsets.
+func writeJSONL(t *testing.T, n int) (string, []int64) {
+	t.Helper()
+	var sb strings.Builder
+	offsets := make([]int64, n)
+	for i := 0; i < n; i++ {
+		offsets[i] = int64(sb.Len())
+		fmt.Fprintf(&sb, `{"n":%d}`+"\n", i+1)
+	}
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path, offsets
+}
+
+func TestReadRawSourceWindow(t *testing.T) {
+	path, offsets := writeJSONL(t, 50)
+
+	win, err := readRawSourceWindow(path, adapter.SourceRef{Line: 30, Offset: offsets[29]}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if win.FirstLine != 25 || win.Target != 5 || len(win.Lines) != 11 {
+		t.Fatalf("window = first %d target %d len %d; want 25, 5, 11", win.FirstLine, win.Target, len(win.Lines))
+	}
+	if win.Lines[win.Target] != `{"n":30}` {
+		t.Errorf("target line = %q", win.Lines[win.Target])
+	}
+}
+
+func TestReadRawSourceWindow_FileEdges(t *testing.T) {
+	path, offsets := writeJSONL(t, 3)
+
+	win, err := readRawSourceWindow(path, adapter.SourceRef{Line: 1, Offset: 0}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if win.FirstLine != 1 || win.Target != 0 || len(win.Lines) != 3 {
+		t.Errorf("start window = %+v", win)
+	}
+
+	if _, err := readRawSourceWindow(path, adapter.SourceRef{Line: 9, Offset: offsets[2] + 100}, 5); err == nil {
+		t.Error("expected error for offset past end of file")
+	}
+}
+
+func TestOpenRawSource(t *testing.T) {
+	path, offsets := writeJSONL(t, 10)
+	p := New()
+	p.width, p.height = 120, 40
+	p.sessions = []adapter.Session{{ID: "s1", Path: path}}
+	p.selectedSession = "s1"
+	p.messages = []adapter.Message{
+		{ID: "m1", Role: "user", Source: adapter.SourceRef{Line: 7, Offset: offsets[6]}},
+	}
+
+	p.openRawSource()
+	if p.toolPager == nil {
+		t.Fatal("expected pager to open")
+	}
+	s := p.toolPager
+	if s.raw[s.scroll] != `{"n":7}` {
+		t.Errorf("pager positioned at %q, want target line", s.raw[s.scroll])
+	}
+	if !strings.Contains(s.entries[0].ToolName, "session.jsonl:7") {
+		t.Errorf("title = %q", s.entries[0].ToolName)
+	}
+
+	// Messages without a source location just toast
+	p.closeToolPager()
+	p.messages[0].Source = adapter.SourceRef{}
+	if cmd := p.openRawSource(); cmd == nil || p.toolPager != nil {
+		t.Error("expected toast and no pager for unknown source")
+	}
+}
+
+func TestTruncateRawLine_RuneBoundary(t *testing.T) {
+	got := truncateRawLine("aé"+strings.Repeat("b", 10), 2)
+	if want := "a … (12 more bytes)"; got != want {
+		t.Errorf("truncateRawLine = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,410 @@
+package conversations
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/state"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// Rename modal element IDs
+const (
+	renameInputID   = "session-rename-input"
+	renameSubmitID  = "session-rename-submit"
+	renameSuggestID = "session-rename-suggest"
+	renameResetID   = "session-rename-reset"
+	renameCancelID  = "session-rename-cancel"
+)
+
+const (
+	// sessionTitleMaxLen caps user-entered titles.
+	sessionTitleMaxLen = 120
+	// suggestedTitleMaxLen caps heuristic titles, which are cut from message text.
+	suggestedTitleMaxLen = 72
+)
+
+// SessionTitleSuggestedMsg carries a heuristic title for a session.
+// Apply saves it directly; otherwise it fills the rename input.
+type SessionTitleSuggestedMsg struct {
+	Epoch     uint64
+	SessionID string
+	Title     string
+	Apply     bool
+	Err       error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m SessionTitleSuggestedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// applySessionTitles replaces adapter-derived names with user-set titles.
+// The adapter name is remembered so a reset can restore it.
+func (p *Plugin) applySessionTitles(sessions []adapter.Session) {
+	for i := range sessions {
+		s := &sessions[i]
+		title := state.GetSessionTitle(s.AdapterID, s.ID)
+		if title == "" {
+			continue
+		}
+		if p.originalTitles == nil {
+			p.originalTitles = make(map[string]string)
+		}
+		p.originalTitles[s.ID] = s.Name
+		s.Name = title
+	}
+}
+
+// setSessionTitle persists a title for a session and updates it in place.
+// An empty title restores the adapter-derived name.
+func (p *Plugin) setSessionTitle(adapterID, sessionID, title string) tea.Cmd {
+	if err := state.SetSessionTitle(adapterID, sessionID, title); err != nil {
+		return app.ShowToast("Rename failed: "+err.Error(), 3*time.Second)
+	}
+	for i := range p.sessions {
+		s := &p.sessions[i]
+		if s.ID != sessionID || s.AdapterID != adapterID {
+			continue
+		}
+		if title == "" {
+			if orig, ok := p.originalTitles[sessionID]; ok {
+				s.Name = orig
+				delete(p.originalTitles, sessionID)
+			}
+		} else {
+			if _, ok := p.originalTitles[sessionID]; !ok {
+				if p.originalTitles == nil {
+					p.originalTitles = make(map[string]string)
+				}
+				p.originalTitles[sessionID] = s.Name
+			}
+			s.Name = title
+		}
+	}
+	p.hitRegionsDirty = true
+	if title == "" {
+		return app.ShowToast("Title reset", 2*time.Second)
+	}
+	return app.ShowToast("Renamed: "+title, 2*time.Second)
+}
+
+// openRenameModal opens the rename modal for the selected session.
+func (p *Plugin) openRenameModal() tea.Cmd {
+	session := p.getSessionForResume()
+	if session == nil {
+		return app.ShowToast("No session selected", 2*time.Second)
+	}
+	s := *session
+	p.renameSession = &s
+	p.renameError = ""
+	p.renameInput = textinput.New()
+	p.renameInput.SetValue(s.Name)
+	p.renameInput.CharLimit = sessionTitleMaxLen
+	p.renameInput.Prompt = ""
+	p.renameInput.Focus()
+	p.renameModal = nil
+	p.renameModalWidth = 0
+	p.showRenameModal = true
+	return nil
+}
+
+// resetRenameModal closes and resets the rename modal state.
+func (p *Plugin) resetRenameModal() {
+	p.showRenameModal = false
+	p.renameModal = nil
+	p.renameModalWidth = 0
+	p.renameSession = nil
+	p.renameError = ""
+}
+
+// ensureRenameModal builds or caches the rename modal.
+func (p *Plugin) ensureRenameModal() {
+	if p.renameSession == nil {
+		return
+	}
+	modalW := 60
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 20 {
+		modalW = 20
+	}
+	if p.renameModal != nil && p.renameModalWidth == modalW {
+		return
+	}
+	p.renameModalWidth = modalW
+
+	p.renameModal = modal.New("Rename Session",
+		modal.WithWidth(modalW),
+		modal.WithPrimaryAction(renameSubmitID),
+		modal.WithHints(false),
+	).
+		AddSection(p.renameInfoSection()).
+		AddSection(modal.Spacer()).
+		AddSection(modal.InputWithLabel(renameInputID, "Title:", &p.renameInput)).
+		AddSection(modal.When(func() bool { return p.renameError != "" }, modal.Custom(
+			func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+				return modal.RenderedSection{Content: lipgloss.NewStyle().Foreground(styles.Current().Error).Render("Error: " + p.renameError)}
+			}, nil))).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Buttons(
+			modal.Btn(" Rename ", renameSubmitID),
+			modal.Btn(" Suggest ", renameSuggestID),
+			modal.Btn(" Reset ", renameResetID),
+			modal.Btn(" Cancel ", renameCancelID),
+		))
+}
+
+// renameInfoSection shows the session being renamed and its original name.
+func (p *Plugin) renameInfoSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		s := p.renameSession
+		if s == nil {
+			return modal.RenderedSection{}
+		}
+		var sb strings.Builder
+		sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("%s · %s", s.AdapterName, shortID(s.ID))))
+		if orig, ok := p.originalTitles[s.ID]; ok {
+			sb.WriteString("\n")
+			sb.WriteString(styles.Current().Muted.Render("Original: "))
+			sb.WriteString(lipgloss.NewStyle().Bold(true).Render(ui.TruncateString(orig, contentWidth-10)))
+		}
+		return modal.RenderedSection{Content: sb.String()}
+	}, nil)
+}
+
+// renderRenameModal renders the rename modal over the background.
+func (p *Plugin) renderRenameModal(width, height int) string {
+	p.ensureRenameModal()
+	if p.renameModal == nil {
+		return ""
+	}
+	return ui.OverlayModal(p.renderTwoPane(), p.renameModal.Render(width, height, p.mouseHandler), width, height)
+}
+
+// handleRenameModalKeys handles keyboard input for the rename modal.
+func (p *Plugin) handleRenameModalKeys(msg tea.KeyMsg) tea.Cmd {
+	p.ensureRenameModal()
+	if p.renameModal == nil {
+		return nil
+	}
+	if p.renameModal.FocusedID() == renameInputID {
+		p.renameError = ""
+	}
+	action, cmd := p.renameModal.HandleKey(msg)
+	if c := p.handleRenameAction(action); c != nil {
+		return c
+	}
+	return cmd
+}
+
+// handleRenameModalMouse handles mouse input for the rename modal.
+func (p *Plugin) handleRenameModalMouse(msg tea.MouseMsg) tea.Cmd {
+	p.ensureRenameModal()
+	if p.renameModal == nil {
+		return nil
+	}
+	return p.handleRenameAction(p.renameModal.HandleMouse(msg, p.mouseHandler))
+}
+
+// handleRenameAction runs a rename modal action.
+func (p *Plugin) handleRenameAction(action string) tea.Cmd {
+	s := p.renameSession
+	if s == nil {
+		return nil
+	}
+	switch action {
+	case renameSubmitID:
+		title := strings.Join(strings.Fields(p.renameInput.Value()), " ")
+		if title == "" {
+			p.renameError = "Title cannot be empty"
+			return nil
+		}
+		p.resetRenameModal()
+		if orig, ok := p.originalTitles[s.ID]; ok && title == orig {
+			// Renaming back to the adapter name is a reset
+			return p.setSessionTitle(s.AdapterID, s.ID, "")
+		}
+		return p.setSessionTitle(s.AdapterID, s.ID, title)
+	case renameSuggestID:
+		return p.suggestSessionTitle(*s, false)
+	case renameResetID:
+		p.resetRenameModal()
+		return p.setSessionTitle(s.AdapterID, s.ID, "")
+	case renameCancelID, "cancel":
+		p.resetRenameModal()
+	}
+	return nil
+}
+
+// retitleSelectedSession re-titles the selected session from its most
+// informative user message and saves the result.
+func (p *Plugin) retitleSelectedSession() tea.Cmd {
+	session := p.getSessionForResume()
+	if session == nil {
+		return app.ShowToast("No session selected", 2*time.Second)
+	}
+	return p.suggestSessionTitle(*session, true)
+}
+
+// suggestSessionTitle computes a heuristic title, using loaded messages when
+// the session is open and reading them from the adapter otherwise.
+func (p *Plugin) suggestSessionTitle(s adapter.Session, apply bool) tea.Cmd {
+	if !p.sessionSupports(&s, adapter.CapMessages) {
+		hint := capabilityHint(&s, adapter.CapMessages)
+		if p.showRenameModal {
+			p.renameError = hint
+			return nil
+		}
+		return app.ShowToast(hint, 2*time.Second)
+	}
+
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	if s.ID == p.selectedSession && len(p.messages) > 0 {
+		title := suggestTitle(p.messages)
+		return func() tea.Msg {
+			return SessionTitleSuggestedMsg{Epoch: epoch, SessionID: s.ID, Title: title, Apply: apply}
+		}
+	}
+	a := p.adapters[s.AdapterID]
+	if a == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msgs, err := a.Messages(s.ID)
+		if err != nil {
+			return SessionTitleSuggestedMsg{Epoch: epoch, SessionID: s.ID, Apply: apply, Err: err}
+		}
+		return SessionTitleSuggestedMsg{Epoch: epoch, SessionID: s.ID, Title: suggestTitle(msgs), Apply: apply}
+	}
+}
+
+// handleSessionTitleSuggested fills the rename input or saves the title.
+func (p *Plugin) handleSessionTitleSuggested(msg SessionTitleSuggestedMsg) tea.Cmd {
+	if msg.Err != nil {
+		return app.ShowToast("Suggest failed: "+msg.Err.Error(), 3*time.Second)
+	}
+	if msg.Title == "" {
+		if p.showRenameModal {
+			p.renameError = "No informative user message found"
+			return nil
+		}
+		return app.ShowToast("No informative user message found", 2*time.Second)
+	}
+	if !msg.Apply {
+		if p.showRenameModal && p.renameSession != nil && p.renameSession.ID == msg.SessionID {
+			p.renameInput.SetValue(msg.Title)
+			p.renameInput.CursorEnd()
+		}
+		return nil
+	}
+	for i := range p.sessions {
+		if p.sessions[i].ID == msg.SessionID {
+			return p.setSessionTitle(p.sessions[i].AdapterID, msg.SessionID, msg.Title)
+		}
+	}
+	return nil
+}
+
+// titleNoiseRe matches harness-injected blocks that never make good titles.
+var titleNoiseRe = regexp.MustCompile(`(?s)<(system-reminder|command-[a-z-]+|local-command-[a-z-]+|user-prompt-submit-hook)[^>]*>.*?</(system-reminder|command-[a-z-]+|local-command-[a-z-]+|user-prompt-submit-hook)>`)
+
+// titleTagRe matches any remaining XML-style tag.
+var titleTagRe = regexp.MustCompile(`</?[A-Za-z][^>]*>`)
+
+// titleStopwords are common words that carry no topic information.
+var titleStopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "that": true, "this": true, "with": true,
+	"you": true, "your": true, "can": true, "please": true, "what": true, "have": true,
+	"are": true, "was": true, "not": true, "but": true, "from": true, "into": true,
+	"then": true, "them": true, "they": true, "just": true, "also": true, "should": true,
+	"would": true, "could": true, "about": true, "there": true, "here": true, "now": true,
+	"let's": true, "lets": true, "some": true, "any": true, "all": true, "its": true,
+	"it's": true, "make": true, "sure": true, "want": true, "need": true, "how": true,
+}
+
+// titleAcks are replies that steer the agent but never describe the task.
+var titleAcks = map[string]bool{
+	"ok": true, "okay": true, "yes": true, "no": true, "continue": true, "go ahead": true,
+	"thanks": true, "thank you": true, "lgtm": true, "looks good": true, "do it": true,
+	"proceed": true, "try again": true, "keep going": true, "sounds good": true,
+}
+
+// cleanTitleText strips harness noise and collapses whitespace.
+func cleanTitleText(s string) string {
+	s = titleNoiseRe.ReplaceAllString(s, " ")
+	s = titleTagRe.ReplaceAllString(s, " ")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// titleScore rates how well a user message describes the session: the
+// number of distinct topic words, discounted for pastes of code or logs.
+func titleScore(text string) int {
+	if len(text) < 12 || strings.HasPrefix(text, "/") {
+		return 0
+	}
+	lower := strings.ToLower(strings.TrimRight(text, ".!?"))
+	if titleAcks[lower] {
+		return 0
+	}
+
+	words := strings.Fields(lower)
+	seen := make(map[string]bool)
+	for _, w := range words {
+		w = strings.TrimFunc(w, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' })
+		if len(w) < 3 || titleStopwords[w] {
+			continue
+		}
+		seen[w] = true
+	}
+	score := min(len(seen), 25)
+
+	letters := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsSpace(r) {
+			letters++
+		}
+	}
+	if len(words) > 120 || letters*10 < len([]rune(text))*6 {
+		score /= 2
+	}
+	return score
+}
+
+// suggestTitle picks the most informative user message and cuts a title
+// from its first sentence. Earlier messages win ties.
+func suggestTitle(msgs []adapter.Message) string {
+	best, bestScore := "", 0
+	for i := range msgs {
+		if msgs[i].Role != "user" {
+			continue
+		}
+		text := cleanTitleText(msgs[i].Content)
+		if score := titleScore(text); score > bestScore {
+			best, bestScore = text, score
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	// Prefer the first sentence when it is long enough to stand alone
+	for _, sep := range []string{". ", "? ", "! "} {
+		if idx := strings.Index(best, sep); idx >= 20 {
+			best = best[:idx+1]
+			break
+		}
+	}
+	return ui.TruncateString(best, suggestedTitleMaxLen)
+}
@@ -0,0 +1,103 @@
+package conversations
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/state"
+)
+
+func TestSuggestTitle(t *testing.T) {
+	msgs := []adapter.Message{
+		{Role: "user", Content: "<command-name>/clear</command-name>"},
+		{Role: "user", Content: "hi"},
+		{Role: "assistant", Content: "Hello! How can I help refactor the authentication middleware today?"},
+		{Role: "user", Content: "Refactor the auth middleware to validate JWT expiry and rotate refresh tokens. Keep the API stable."},
+		{Role: "user", Content: "ok, continue"},
+		{Role: "user", Content: "continue"},
+	}
+	got := suggestTitle(msgs)
+	want := "Refactor the auth middleware to validate JWT expiry and rotate refres..."
+	if got != want {
+		t.Errorf("suggestTitle() = %q, want %q", got, want)
+	}
+
+	if got := suggestTitle([]adapter.Message{{Role: "user", Content: "yes"}}); got != "" {
+		t.Errorf("expected no title from acknowledgements, got %q", got)
+	}
+}
+
+func TestTitleScore(t *testing.T) {
+	if titleScore("/compact") != 0 {
+		t.Error("slash commands should not score")
+	}
+	if titleScore("Thanks.") != 0 {
+		t.Error("acknowledgements should not score")
+	}
+	prose := titleScore("Add pagination to the sessions list and persist the page size")
+	code := titleScore("{\"a\": 1, \"b\": [2, 3], \"c\": {\"d\": 4}} // add pagination sessions list")
+	if prose <= code {
+		t.Errorf("prose score %d should beat code paste score %d", prose, code)
+	}
+}
+
+func TestRenameSessionPersists(t *testing.T) {
+	if err := state.InitWithDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	p := New()
+	p.width, p.height = 100, 40
+	p.Update(SessionsLoadedMsg{Sessions: []adapter.Session{{ID: "s1", AdapterID: "claude-code", Name: "first msg junk"}}})
+	p.cursor = 0
+
+	p.openRenameModal()
+	if !p.showRenameModal || p.FocusContext() != "conversations-rename-modal" || !p.ConsumesTextInput() {
+		t.Fatal("expected rename modal to be open and consume text input")
+	}
+	p.renameInput.SetValue("  Auth  refactor ")
+	p.handleRenameAction(renameSubmitID)
+	if p.showRenameModal || p.sessions[0].Name != "Auth refactor" {
+		t.Fatalf("name = %q after rename", p.sessions[0].Name)
+	}
+	if got := state.GetSessionTitle("claude-code", "s1"); got != "Auth refactor" {
+		t.Errorf("persisted title = %q", got)
+	}
+
+	// Reloaded sessions keep the override
+	p.Update(SessionsLoadedMsg{Sessions: []adapter.Session{{ID: "s1", AdapterID: "claude-code", Name: "first msg junk"}}})
+	if p.sessions[0].Name != "Auth refactor" {
+		t.Errorf("reload lost title: %q", p.sessions[0].Name)
+	}
+
+	// Reset restores the adapter name
+	p.setSessionTitle("claude-code", "s1", "")
+	if p.sessions[0].Name != "first msg junk" || state.GetSessionTitle("claude-code", "s1") != "" {
+		t.Errorf("reset failed: name=%q", p.sessions[0].Name)
+	}
+}
+
+func TestRetitleAppliesSuggestion(t *testing.T) {
+	if err := state.InitWithDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	p := New()
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "claude-code", Name: "hi"}}
+	p.selectedSession = "s1"
+	p.messages = []adapter.Message{
+		{Role: "user", Content: "hi"},
+		{Role: "user", Content: "Investigate flaky websocket reconnect test in CI"},
+	}
+
+	cmd := p.retitleSelectedSession()
+	if cmd == nil {
+		t.Fatal("expected suggestion command")
+	}
+	msg, ok := cmd().(SessionTitleSuggestedMsg)
+	if !ok || !msg.Apply {
+		t.Fatalf("unexpected msg %#v", msg)
+	}
+	p.Update(msg)
+	if p.sessions[0].Name != "Investigate flaky websocket reconnect test in CI" {
+		t.Errorf("name = %q", p.sessions[0].Name)
+	}
+}
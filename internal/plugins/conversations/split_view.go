@@ -0,0 +1,398 @@
+package conversations
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/adapter"
+	appmsg "github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+const regionSplitPane = "split-pane"
+
+// splitPaneState holds the second session streamed below the main pane.
+// Messages are reloaded on watch events independently of the main pane.
+type splitPaneState struct {
+	sessionID string
+	messages  []adapter.Message
+	loaded    bool
+	scroll    int  // first visible line
+	follow    bool // keep the newest message in view as the session streams
+	token     int  // debounce token for watch reloads
+}
+
+// SplitMessagesLoadedMsg carries messages for the split pane session.
+type SplitMessagesLoadedMsg struct {
+	Epoch     uint64
+	SessionID string
+	Messages  []adapter.Message
+	Err       error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m SplitMessagesLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// SplitReloadMsg is the debounced reload trigger for the split pane.
+type SplitReloadMsg struct {
+	Epoch     uint64
+	Token     int
+	SessionID string
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m SplitReloadMsg) GetEpoch() uint64 { return m.Epoch }
+
+// toggleSplit pins the highlighted session to a split pane below the main
+// pane, then moves the selection to the most recent other active session so
+// both live sessions are visible. Pressing it on the pinned session closes
+// the split.
+func (p *Plugin) toggleSplit() tea.Cmd {
+	sessions := p.visibleSessions()
+	if p.cursor < 0 || p.cursor >= len(sessions) {
+		return nil
+	}
+	target := sessions[p.cursor]
+	if p.split != nil && p.split.sessionID == target.ID {
+		p.closeSplit()
+		return appmsg.ShowToast("Split closed", 2*time.Second)
+	}
+	if !p.sessionSupports(&target, adapter.CapMessages) {
+		return appmsg.ShowToast(capabilityHint(&target, adapter.CapMessages), 2*time.Second)
+	}
+
+	p.split = &splitPaneState{sessionID: target.ID, follow: true}
+	p.splitFocus = false
+	p.hitRegionsDirty = true
+	cmds := []tea.Cmd{p.loadSplitMessages(target.ID)}
+
+	if other, ok := p.splitCandidate(target.ID); ok {
+		for i, s := range sessions {
+			if s.ID == other.ID {
+				p.cursor = i
+				p.ensureCursorVisible()
+				p.setSelectedSession(other.ID)
+				p.followMain = true
+				cmds = append(cmds, p.schedulePreviewLoad(other.ID))
+				break
+			}
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// closeSplit removes the split pane and returns focus to the main pane.
+func (p *Plugin) closeSplit() {
+	p.split = nil
+	p.splitFocus = false
+	p.hitRegionsDirty = true
+}
+
+// splitCandidate returns the most recently updated active session other than
+// exclude. Sessions are kept sorted by UpdatedAt descending.
+func (p *Plugin) splitCandidate(exclude string) (adapter.Session, bool) {
+	for _, s := range p.sessions {
+		if s.IsActive && s.ID != exclude {
+			return s, true
+		}
+	}
+	return adapter.Session{}, false
+}
+
+// splitSession returns the session shown in the split pane, if still listed.
+func (p *Plugin) splitSession() *adapter.Session {
+	if p.split == nil {
+		return nil
+	}
+	for i := range p.sessions {
+		if p.sessions[i].ID == p.split.sessionID {
+			return &p.sessions[i]
+		}
+	}
+	return nil
+}
+
+// loadSplitMessages loads the most recent messages of the split session.
+func (p *Plugin) loadSplitMessages(sessionID string) tea.Cmd {
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	a := p.adapterForSession(sessionID)
+	return func() tea.Msg {
+		if a == nil || !adapterSupports(a, adapter.CapMessages) {
+			return SplitMessagesLoadedMsg{Epoch: epoch, SessionID: sessionID}
+		}
+		messages, err := a.Messages(sessionID)
+		if err != nil {
+			return SplitMessagesLoadedMsg{Epoch: epoch, SessionID: sessionID, Err: err}
+		}
+		if len(messages) > maxMessagesInMemory {
+			messages = messages[len(messages)-maxMessagesInMemory:]
+		}
+		return SplitMessagesLoadedMsg{Epoch: epoch, SessionID: sessionID, Messages: messages}
+	}
+}
+
+// scheduleSplitReload debounces watch-driven reloads of the split session.
+func (p *Plugin) scheduleSplitReload() tea.Cmd {
+	if p.split == nil {
+		return nil
+	}
+	p.split.token++
+	token, sessionID := p.split.token, p.split.sessionID
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	return tea.Tick(watchReloadDebounce, func(time.Time) tea.Msg {
+		return SplitReloadMsg{Epoch: epoch, Token: token, SessionID: sessionID}
+	})
+}
+
+// handleSplitMessage processes split pane load and reload messages.
+func (p *Plugin) handleSplitMessage(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case SplitReloadMsg:
+		if plugin.IsStale(p.ctx, msg) || p.split == nil {
+			return nil
+		}
+		if msg.Token != p.split.token || msg.SessionID != p.split.sessionID {
+			return nil
+		}
+		return p.loadSplitMessages(msg.SessionID)
+
+	case SplitMessagesLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) || p.split == nil || msg.SessionID != p.split.sessionID {
+			return nil
+		}
+		if msg.Err != nil {
+			return appmsg.ShowToast("Split pane: "+msg.Err.Error(), 3*time.Second)
+		}
+		p.split.messages = msg.Messages
+		p.split.loaded = true
+	}
+	return nil
+}
+
+// applyMainFollow scrolls the main pane to the newest message.
+func (p *Plugin) applyMainFollow() {
+	if p.turnViewMode {
+		if len(p.turns) > 0 {
+			p.turnCursor = len(p.turns) - 1
+			p.ensureTurnCursorVisible()
+		}
+		return
+	}
+	if visible := p.visibleMessageIndices(); len(visible) > 0 {
+		p.messageCursor = visible[len(visible)-1]
+		p.messageScroll = 999999 // Clamped in renderer
+	}
+}
+
+// updateSplitPane handles keys while the split pane has focus.
+func (p *Plugin) updateSplitPane(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	s := p.split
+	page := p.height / 4
+	if page < 1 {
+		page = 1
+	}
+	switch msg.String() {
+	case "esc", "h", "left":
+		p.splitFocus = false
+		p.activePane = PaneSidebar
+	case "tab", "shift+tab":
+		p.splitFocus = false
+		if p.sidebarVisible {
+			p.activePane = PaneSidebar
+		}
+	case "j", "down":
+		s.scroll++
+	case "k", "up":
+		s.scroll--
+		s.follow = false
+	case "ctrl+d":
+		s.scroll += page
+	case "ctrl+u":
+		s.scroll -= page
+		s.follow = false
+	case "g":
+		s.scroll = 0
+		s.follow = false
+	case "G":
+		s.follow = true
+	case "f":
+		s.follow = !s.follow
+		return p, appmsg.ShowToast(followToast(s.follow), 2*time.Second)
+	case "|":
+		p.closeSplit()
+	}
+	if s.scroll < 0 {
+		s.scroll = 0
+	}
+	return p, nil
+}
+
+// toggleMainFollow flips follow mode for the main pane.
+func (p *Plugin) toggleMainFollow() tea.Cmd {
+	p.followMain = !p.followMain
+	if p.followMain {
+		p.applyMainFollow()
+	}
+	return appmsg.ShowToast(followToast(p.followMain), 2*time.Second)
+}
+
+func followToast(on bool) string {
+	if on {
+		return "Follow on"
+	}
+	return "Follow off"
+}
+
+// splitHeights divides the main pane's outer height between the top and
+// split panes.
+func splitHeights(paneHeight int) (top, bottom int) {
+	top = paneHeight / 2
+	if top < 4 {
+		top = 4
+	}
+	bottom = paneHeight - top
+	if bottom < 4 {
+		bottom = 4
+	}
+	return top, bottom
+}
+
+// renderMainColumn renders the main pane, stacked over the split pane when
+// one is open. x is the column's left edge for hit regions.
+func (p *Plugin) renderMainColumn(mainWidth, paneHeight, x int) string {
+	mainActive := p.activePane != PaneSidebar && !p.splitFocus
+	if p.split == nil {
+		innerHeight := paneHeight - 2
+		if innerHeight < 1 {
+			innerHeight = 1
+		}
+		return styles.RenderPanel(p.renderMainPane(mainWidth, innerHeight), mainWidth, paneHeight, mainActive)
+	}
+
+	topHeight, bottomHeight := splitHeights(paneHeight)
+	top := styles.RenderPanel(p.renderMainPane(mainWidth, topHeight-2), mainWidth, topHeight, mainActive)
+	splitActive := p.activePane == PaneMessages && p.splitFocus
+	bottom := styles.RenderPanel(p.renderSplitPane(mainWidth, bottomHeight-2), mainWidth, bottomHeight, splitActive)
+	if p.hitRegionsDirty {
+		p.mouseHandler.HitMap.AddRect(regionSplitPane, x, topHeight, mainWidth, bottomHeight, nil)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, top, bottom)
+}
+
+// mainContentHeight returns the inner height available to the main pane.
+func (p *Plugin) mainContentHeight(paneHeight int) int {
+	if p.split != nil {
+		paneHeight, _ = splitHeights(paneHeight)
+	}
+	if paneHeight-2 < 1 {
+		return 1
+	}
+	return paneHeight - 2
+}
+
+// renderSplitPane renders the split session as a read-only, scrollable flow.
+func (p *Plugin) renderSplitPane(paneWidth, height int) string {
+	contentWidth := paneWidth - 4
+	if contentWidth < 20 {
+		contentWidth = 20
+	}
+	session := p.splitSession()
+
+	var sb strings.Builder
+	name := shortID(p.split.sessionID)
+	if session != nil {
+		sb.WriteString(renderAdapterIcon(*session))
+		sb.WriteString(" ")
+		if session.Name != "" {
+			name = session.Name
+		}
+	}
+	status := "paused"
+	if p.split.follow {
+		status = "following"
+	}
+	if session != nil && session.IsActive {
+		status = "● live · " + status
+	}
+	maxName := contentWidth - lipgloss.Width(status) - 4
+	if maxName < 10 {
+		maxName = 10
+	}
+	if len(name) > maxName {
+		name = name[:maxName-3] + "..."
+	}
+	sb.WriteString(styles.Current().Title.Render(name))
+	sb.WriteString("  ")
+	sb.WriteString(styles.Current().Muted.Render(status))
+	sb.WriteString("\n")
+
+	sepWidth := contentWidth
+	if sepWidth > 60 {
+		sepWidth = 60
+	}
+	sb.WriteString(styles.Current().Muted.Render(strings.Repeat("─", sepWidth)))
+	sb.WriteString("\n")
+
+	contentHeight := height - 2
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+	if !p.split.loaded {
+		sb.WriteString(styles.Current().Muted.Render("Loading messages..."))
+		return sb.String()
+	}
+
+	lines := p.splitFlowLines(session, contentWidth)
+	if len(lines) == 0 {
+		sb.WriteString(styles.Current().Muted.Render("No messages"))
+		return sb.String()
+	}
+	maxScroll := len(lines) - contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if p.split.follow || p.split.scroll > maxScroll {
+		p.split.scroll = maxScroll
+	}
+	end := p.split.scroll + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+	for _, line := range lines[p.split.scroll:end] {
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return stripANSIBackground(sb.String())
+}
+
+// splitFlowLines renders every split session message in conversation flow
+// style, without a selection cursor.
+func (p *Plugin) splitFlowLines(session *adapter.Session, contentWidth int) []string {
+	var lines []string
+	prevRole := ""
+	for _, msg := range p.split.messages {
+		if p.isToolResultOnlyMessage(msg) {
+			continue
+		}
+		if prevRole != "" && prevRole != msg.Role {
+			sepWidth := contentWidth / 3
+			if sepWidth > 20 {
+				sepWidth = 20
+			}
+			lines = append(lines, styles.Current().Subtle.Render("  "+strings.Repeat("─", sepWidth)))
+		}
+		prevRole = msg.Role
+		lines = append(lines, p.renderSessionBubble(session, msg, false, contentWidth)...)
+		lines = append(lines, "")
+	}
+	return lines
+}
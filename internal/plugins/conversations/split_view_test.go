@@ -0,0 +1,122 @@
+package conversations
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+func splitTestPlugin() *Plugin {
+	p := New()
+	p.width, p.height = 120, 40
+	p.adapters = map[string]adapter.Adapter{"mock": &mockAdapter{}}
+	now := time.Now()
+	p.sessions = []adapter.Session{
+		{ID: "a", Name: "alpha", AdapterID: "mock", IsActive: true, UpdatedAt: now},
+		{ID: "b", Name: "beta", AdapterID: "mock", IsActive: true, UpdatedAt: now.Add(-time.Minute)},
+		{ID: "c", Name: "gamma", AdapterID: "mock", UpdatedAt: now.Add(-time.Hour)},
+	}
+	p.cursor = 1
+	p.setSelectedSession("b")
+	return p
+}
+
+func TestToggleSplit_PinsAndSelectsOtherActive(t *testing.T) {
+	p := splitTestPlugin()
+
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'|'}})
+	if p.split == nil || p.split.sessionID != "b" || !p.split.follow {
+		t.Fatalf("expected split pinned to b with follow on, got %+v", p.split)
+	}
+	if p.selectedSession != "a" || p.cursor != 0 {
+		t.Errorf("selected = %q cursor = %d, want the other active session a", p.selectedSession, p.cursor)
+	}
+	if !p.followMain {
+		t.Error("expected main pane to follow the newly selected live session")
+	}
+
+	// Pressing again on the pinned session closes the split
+	p.cursor = 1
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'|'}})
+	if p.split != nil {
+		t.Error("expected split closed")
+	}
+}
+
+func TestSplitPane_FocusAndFollow(t *testing.T) {
+	p := splitTestPlugin()
+	p.toggleSplit()
+	p.activePane = PaneMessages
+
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if !p.splitFocus || p.FocusContext() != "conversations-split" {
+		t.Fatalf("tab should focus split pane, context = %q", p.FocusContext())
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
+	if p.split.follow {
+		t.Error("scrolling up should pause follow")
+	}
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if !p.split.follow {
+		t.Error("G should resume follow")
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if p.splitFocus || p.activePane != PaneSidebar {
+		t.Error("tab from split pane should return to sidebar")
+	}
+}
+
+func TestSplitPane_StreamsAndFollows(t *testing.T) {
+	p := splitTestPlugin()
+	p.toggleSplit()
+
+	var msgs []adapter.Message
+	for i := 0; i < 40; i++ {
+		msgs = append(msgs, adapter.Message{ID: fmt.Sprintf("m%d", i), Role: "assistant", Content: fmt.Sprintf("line %d", i)})
+	}
+	p.Update(SplitMessagesLoadedMsg{SessionID: "b", Messages: msgs})
+	// Loads for other sessions are ignored
+	p.Update(SplitMessagesLoadedMsg{SessionID: "c", Messages: msgs[:1]})
+	if len(p.split.messages) != 40 {
+		t.Fatalf("split messages = %d, want 40", len(p.split.messages))
+	}
+
+	out := ansi.Strip(p.renderSplitPane(80, 10))
+	if !strings.Contains(out, "line 39") || strings.Contains(out, "line 0 ") {
+		t.Errorf("following split pane should show the newest message:\n%s", out)
+	}
+
+	p.split.follow = false
+	p.split.scroll = 0
+	if out := ansi.Strip(p.renderSplitPane(80, 10)); !strings.Contains(out, "line 0") {
+		t.Errorf("paused split pane should keep its scroll position:\n%s", out)
+	}
+
+	// Watch events for the split session schedule its own reload
+	before := p.split.token
+	p.Update(WatchEventMsg{SessionID: "b"})
+	if p.split.token != before+1 {
+		t.Error("expected split reload scheduled on watch event")
+	}
+}
+
+func TestRenderTwoPane_WithSplit(t *testing.T) {
+	p := splitTestPlugin()
+	p.toggleSplit()
+	p.Update(SplitMessagesLoadedMsg{SessionID: "b", Messages: []adapter.Message{{ID: "m1", Role: "user", Content: "hello"}}})
+
+	out := p.renderTwoPane()
+	if !strings.Contains(out, "hello") || !strings.Contains(out, "live · following") {
+		t.Errorf("expected split pane content in layout:\n%s", out)
+	}
+	if got := strings.Count(out, "\n") + 1; got != p.height {
+		t.Errorf("layout height = %d, want %d", got, p.height)
+	}
+}
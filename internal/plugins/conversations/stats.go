@@ -0,0 +1,526 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/format"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+const (
+	// statsMaxSessions caps how many recent sessions have their messages
+	// scanned for model, tool, and hour statistics.
+	statsMaxSessions = 200
+	// statsTimeout bounds the background message scan.
+	statsTimeout = 30 * time.Second
+	// statsTopN is how many models/tools are listed.
+	statsTopN = 8
+)
+
+// heatmapShades maps relative activity to characters, from idle to busiest.
+var heatmapShades = []rune{' ', '·', '░', '▒', '▓', '█'}
+
+// sparkBlocks are used for the daily cost sparkline.
+var sparkBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// namedCount is a label with a count, used for ranked lists.
+type namedCount struct {
+	Name  string
+	Count int64
+}
+
+// projectStats aggregates usage across all sessions in the project.
+type projectStats struct {
+	Sessions     int
+	TotalCost    float64
+	TotalTokens  int64
+	WeekCost     float64 // sessions updated in the last 7 days
+	WeekTokens   int64
+	WeekSessions int
+	DailyCost    [7]float64 // index 6 = today
+	AvgDuration  time.Duration
+	Models       []namedCount // tokens by model, descending
+	Tools        []namedCount // tool calls by name, descending
+	Hours        [7][24]int   // weekday (Mon=0) x hour message counts
+	Scanned      int          // sessions whose messages were scanned
+	Skipped      int          // sessions not scanned (cap, size, or timeout)
+	NoUsage      int          // sessions whose adapter records no token usage
+	ComputedAt   time.Time
+
+	modelTotals map[string]int64 // unranked Models, for applying watch deltas
+}
+
+// ProjectStatsMsg delivers computed project statistics.
+type ProjectStatsMsg struct {
+	Epoch uint64
+	Stats *projectStats
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m ProjectStatsMsg) GetEpoch() uint64 { return m.Epoch }
+
+// statsAccumulator builds projectStats incrementally. Safe for concurrent use.
+type statsAccumulator struct {
+	mu          sync.Mutex
+	now         time.Time
+	stats       projectStats
+	durTotal    time.Duration
+	durCount    int
+	models      map[string]int64
+	tools       map[string]int64
+	sawMessages bool
+}
+
+func newStatsAccumulator(now time.Time) *statsAccumulator {
+	return &statsAccumulator{
+		now:    now,
+		models: make(map[string]int64),
+		tools:  make(map[string]int64),
+	}
+}
+
+// addSession records session-level metadata (cost, tokens, duration).
+func (a *statsAccumulator) addSession(s *adapter.Session) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.stats.Sessions++
+	a.stats.TotalCost += s.EstCost
+	a.stats.TotalTokens += int64(s.TotalTokens)
+	if s.Duration > 0 {
+		a.durTotal += s.Duration
+		a.durCount++
+	}
+
+	y, m, d := a.now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, a.now.Location())
+	if s.UpdatedAt.Before(today.AddDate(0, 0, -6)) {
+		return
+	}
+	a.stats.WeekCost += s.EstCost
+	a.stats.WeekTokens += int64(s.TotalTokens)
+	a.stats.WeekSessions++
+	daysAgo := int(today.Sub(startOfDay(s.UpdatedAt)).Hours() / 24)
+	if daysAgo >= 0 && daysAgo < 7 {
+		a.stats.DailyCost[6-daysAgo] += s.EstCost
+	}
+}
+
+// addNoUsage counts a session from an adapter without usage data.
+func (a *statsAccumulator) addNoUsage() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stats.NoUsage++
+}
+
+// addMessages records message-level statistics for one session.
+func (a *statsAccumulator) addMessages(msgs []adapter.Message) {
+	models := make(map[string]int64)
+	tools := make(map[string]int64)
+	var hours [7][24]int
+	for i := range msgs {
+		m := &msgs[i]
+		if m.Model != "" {
+			name := modelShortName(m.Model)
+			if name == "" {
+				name = m.Model
+			}
+			models[name] += int64(m.InputTokens + m.OutputTokens)
+		}
+		hasBlocks := false
+		for _, b := range m.ContentBlocks {
+			if b.Type == "tool_use" && b.ToolName != "" {
+				tools[b.ToolName]++
+				hasBlocks = true
+			}
+		}
+		if !hasBlocks {
+			for _, tu := range m.ToolUses {
+				tools[tu.Name]++
+			}
+		}
+		if !m.Timestamp.IsZero() {
+			t := m.Timestamp.Local()
+			hours[(int(t.Weekday())+6)%7][t.Hour()]++
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stats.Scanned++
+	a.sawMessages = true
+	for k, v := range models {
+		a.models[k] += v
+	}
+	for k, v := range tools {
+		a.tools[k] += v
+	}
+	for d := range hours {
+		for h := range hours[d] {
+			a.stats.Hours[d][h] += hours[d][h]
+		}
+	}
+}
+
+// result finalizes and returns the aggregated statistics.
+func (a *statsAccumulator) result(sessions []adapter.Session) *projectStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	st := a.stats
+	st.ComputedAt = a.now
+	if a.durCount > 0 {
+		st.AvgDuration = a.durTotal / time.Duration(a.durCount)
+	}
+	st.Models = rankCounts(a.models, statsTopN)
+	if a.sawMessages {
+		st.modelTotals = make(map[string]int64, len(a.models))
+		for k, v := range a.models {
+			st.modelTotals[k] = v
+		}
+	}
+	st.Tools = rankCounts(a.tools, statsTopN)
+	st.Skipped = st.Sessions - st.Scanned
+
+	// Without any scanned messages, approximate the heatmap from session starts
+	if !a.sawMessages {
+		for i := range sessions {
+			if t := sessions[i].CreatedAt; !t.IsZero() {
+				t = t.Local()
+				st.Hours[(int(t.Weekday())+6)%7][t.Hour()]++
+			}
+		}
+	}
+	return &st
+}
+
+// applyUsageDelta folds a session's usage change from a watch event into the
+// totals, so the dashboard stays current between full recomputes. Per-model
+// tokens are only updated when the last recompute scanned messages.
+func (st *projectStats) applyUsageDelta(d adapter.UsageDelta) {
+	if d.New {
+		st.Sessions++
+		st.Skipped++
+	}
+	st.TotalCost += d.Cost
+	st.TotalTokens += int64(d.Tokens)
+
+	if !d.At.IsZero() {
+		today := startOfDay(st.ComputedAt)
+		daysAgo := int(today.Sub(startOfDay(d.At)).Hours() / 24)
+		if daysAgo >= 0 && daysAgo < 7 {
+			st.WeekCost += d.Cost
+			st.WeekTokens += int64(d.Tokens)
+			if d.New {
+				st.WeekSessions++
+			}
+			st.DailyCost[6-daysAgo] += d.Cost
+		}
+	}
+
+	if st.modelTotals == nil || len(d.ModelTokens) == 0 {
+		return
+	}
+	for model, n := range d.ModelTokens {
+		name := modelShortName(model)
+		if name == "" {
+			name = model
+		}
+		st.modelTotals[name] += int64(n)
+	}
+	st.Models = rankCounts(st.modelTotals, statsTopN)
+}
+
+// withUsageDelta attaches the session's usage change since the last event
+// to evt.Data, using the first source that knows the session. Events that
+// already carry data or have no session are returned unchanged.
+func withUsageDelta(evt adapter.Event, tracker *adapter.UsageTracker, sources []adapter.UsageSnapshotter) adapter.Event {
+	if tracker == nil || evt.SessionID == "" || evt.Data != nil {
+		return evt
+	}
+	for _, src := range sources {
+		snap, ok := src.UsageSnapshot(evt.SessionID)
+		if !ok {
+			continue
+		}
+		if d := tracker.Observe(evt.SessionID, snap); !d.IsZero() {
+			evt.Data = d
+		}
+		return evt
+	}
+	return evt
+}
+
+// rankCounts returns the top n entries of counts, descending by count then name.
+func rankCounts(counts map[string]int64, n int) []namedCount {
+	out := make([]namedCount, 0, len(counts))
+	for name, c := range counts {
+		if c > 0 {
+			out = append(out, namedCount{Name: name, Count: c})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Name < out[j].Name
+	})
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// startOfDay returns midnight of t's day in t's location.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// loadProjectStats scans sessions in the background and returns ProjectStatsMsg.
+// Session metadata covers every session; message-level stats (models, tools,
+// hours) cover the most recent statsMaxSessions, skipping huge sessions.
+func loadProjectStats(sessions []adapter.Session, adapters map[string]adapter.Adapter, epoch uint64) tea.Cmd {
+	sorted := make([]adapter.Session, len(sessions))
+	copy(sorted, sessions)
+	return func() tea.Msg {
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].UpdatedAt.After(sorted[j].UpdatedAt)
+		})
+
+		acc := newStatsAccumulator(time.Now())
+		ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, searchConcurrency())
+		for i := range sorted {
+			s := &sorted[i]
+			acc.addSession(s)
+			a := adapters[s.AdapterID]
+			if !adapterSupports(a, adapter.CapUsage) {
+				acc.addNoUsage()
+			}
+			if i >= statsMaxSessions || s.MessageCount == 0 || s.SizeLevel() >= 2 {
+				continue
+			}
+			if a == nil || !adapterSupports(a, adapter.CapMessages) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				continue
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(a adapter.Adapter, id string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				msgs, err := a.Messages(id)
+				if err != nil || ctx.Err() != nil {
+					return
+				}
+				acc.addMessages(msgs)
+			}(a, s.ID)
+		}
+		wg.Wait()
+
+		return ProjectStatsMsg{Epoch: epoch, Stats: acc.result(sorted)}
+	}
+}
+
+// openStats switches to the stats view and starts computing statistics.
+func (p *Plugin) openStats() tea.Cmd {
+	p.view = ViewStats
+	p.statsScrollOff = 0
+	return p.refreshStats()
+}
+
+// refreshStats recomputes project statistics in the background.
+func (p *Plugin) refreshStats() tea.Cmd {
+	p.statsLoading = true
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	// Rebase watch-event deltas on the sessions being aggregated
+	for i := range p.sessions {
+		s := &p.sessions[i]
+		p.usageTracker.Seed(s.ID, adapter.UsageSnapshot{
+			Tokens:    s.TotalTokens,
+			Cost:      s.EstCost,
+			Messages:  s.MessageCount,
+			UpdatedAt: s.UpdatedAt,
+		})
+	}
+	return loadProjectStats(p.sessions, p.adapters, epoch)
+}
+
+// renderStats renders the project statistics dashboard with scrolling support.
+func (p *Plugin) renderStats() string {
+	width := p.width - 2
+	if width < 20 {
+		width = 20
+	}
+	var lines []string
+	lines = append(lines, styles.Current().Title.Render(" Project Stats"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("━", width)))
+
+	st := p.stats
+	if st == nil {
+		lines = append(lines, styles.Current().Muted.Render(" Computing statistics..."))
+		p.statsLines = lines
+		return strings.Join(lines, "\n")
+	}
+
+	summary := fmt.Sprintf(" %d sessions  │  %s tokens  │  ~%s total",
+		st.Sessions, formatLargeNumber64(st.TotalTokens), format.Money(st.TotalCost, 2))
+	if p.statsLoading {
+		summary += "  │  refreshing..."
+	}
+	lines = append(lines, styles.Current().Body.Render(summary))
+	lines = append(lines, "")
+
+	// Last 7 days
+	accent := lipgloss.NewStyle().Foreground(styles.Current().Accent)
+	lines = append(lines, styles.Current().Title.Render(" Last 7 Days"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", width)))
+	lines = append(lines, styles.Current().Subtitle.Render(" Cost: ")+accent.Bold(true).Render("~"+format.Money(st.WeekCost, 2))+
+		styles.Current().Subtitle.Render(fmt.Sprintf("  │  %s tokens  │  %d sessions", formatLargeNumber64(st.WeekTokens), st.WeekSessions)))
+	lines = append(lines, styles.Current().Subtitle.Render(" Daily: ")+accent.Render(renderSparkline(st.DailyCost[:])))
+	lines = append(lines, styles.Current().Muted.Render("        "+dailyLabels(st.ComputedAt)))
+	if st.AvgDuration > 0 {
+		lines = append(lines, styles.Current().Subtitle.Render(" Avg Session: ")+styles.Current().Body.Render(formatSessionDuration(st.AvgDuration)))
+	}
+	lines = append(lines, "")
+
+	// Tokens by model
+	lines = append(lines, styles.Current().Title.Render(" Tokens by Model"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", width)))
+	lines = append(lines, renderRankedBars(st.Models, "tokens", width)...)
+	lines = append(lines, "")
+
+	// Top tools
+	lines = append(lines, styles.Current().Title.Render(" Top Tools"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", width)))
+	lines = append(lines, renderRankedBars(st.Tools, "calls", width)...)
+	lines = append(lines, "")
+
+	// Busiest hours
+	lines = append(lines, styles.Current().Title.Render(" Busiest Hours"))
+	lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", width)))
+	lines = append(lines, renderHourHeatmap(st.Hours)...)
+	lines = append(lines, "")
+
+	if st.Skipped > 0 {
+		lines = append(lines, styles.Current().Muted.Render(fmt.Sprintf(" Model, tool, and hour stats cover %d of %d sessions", st.Scanned, st.Sessions)))
+	}
+	if st.NoUsage > 0 {
+		lines = append(lines, styles.Current().Muted.Render(fmt.Sprintf(" %d sessions come from adapters without token usage and add no tokens or cost", st.NoUsage)))
+	}
+
+	p.statsLines = lines
+
+	contentHeight := p.height - 2
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+	start := min(p.statsScrollOff, max(len(lines)-1, 0))
+	end := min(start+contentHeight, len(lines))
+	return strings.Join(lines[start:end], "\n")
+}
+
+// renderRankedBars renders one bar per entry, scaled to the largest.
+func renderRankedBars(entries []namedCount, unit string, width int) []string {
+	if len(entries) == 0 {
+		return []string{styles.Current().Muted.Render(" No data")}
+	}
+	nameWidth := 6
+	for _, e := range entries {
+		nameWidth = max(nameWidth, min(len(e.Name), 18))
+	}
+	barWidth := min(max(width-nameWidth-20, 8), 30)
+	maxCount := entries[0].Count
+	var lines []string
+	for _, e := range entries {
+		name := e.Name
+		if len(name) > nameWidth {
+			name = name[:nameWidth-1] + "…"
+		}
+		label := styles.Current().Body.Render(fmt.Sprintf(" %-*s │ ", nameWidth, name))
+		bar := renderColoredBar64(e.Count, maxCount, barWidth)
+		value := styles.Current().Subtitle.Render(fmt.Sprintf(" │ %s %s", formatLargeNumber64(e.Count), unit))
+		lines = append(lines, label+bar+value)
+	}
+	return lines
+}
+
+// renderHourHeatmap renders a weekday x hour activity heatmap.
+func renderHourHeatmap(hours [7][24]int) []string {
+	peak := 0
+	for d := range hours {
+		for h := range hours[d] {
+			peak = max(peak, hours[d][h])
+		}
+	}
+	if peak == 0 {
+		return []string{styles.Current().Muted.Render(" No data")}
+	}
+
+	cell := lipgloss.NewStyle().Foreground(styles.Current().Primary)
+	lines := []string{styles.Current().Muted.Render("      0     6     12    18   ")}
+	days := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	for d, day := range days {
+		var row strings.Builder
+		for h := 0; h < 24; h++ {
+			row.WriteRune(heatmapShade(hours[d][h], peak))
+		}
+		lines = append(lines, styles.Current().Body.Render(fmt.Sprintf(" %s │", day))+cell.Render(row.String())+styles.Current().Body.Render("│"))
+	}
+	return lines
+}
+
+// heatmapShade returns the shade character for count relative to peak.
+func heatmapShade(count, peak int) rune {
+	if count <= 0 || peak <= 0 {
+		return heatmapShades[0]
+	}
+	// Any activity gets at least the lightest visible shade
+	idx := 1 + (count*(len(heatmapShades)-2))/peak
+	return heatmapShades[min(idx, len(heatmapShades)-1)]
+}
+
+// renderSparkline renders values as a block sparkline scaled to the max.
+func renderSparkline(values []float64) string {
+	peak := 0.0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+	var sb strings.Builder
+	for _, v := range values {
+		if peak <= 0 || v <= 0 {
+			sb.WriteRune(sparkBlocks[0])
+			continue
+		}
+		idx := int(v / peak * float64(len(sparkBlocks)-1))
+		sb.WriteRune(sparkBlocks[idx])
+	}
+	return sb.String()
+}
+
+// dailyLabels returns the weekday initials for the 7 days ending at now.
+func dailyLabels(now time.Time) string {
+	var sb strings.Builder
+	for i := 6; i >= 0; i-- {
+		sb.WriteString(now.AddDate(0, 0, -i).Format("Mon")[:1])
+	}
+	return sb.String()
+}
@@ -0,0 +1,163 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+func TestStatsAccumulator_Sessions(t *testing.T) {
+	now := time.Date(2025, 6, 12, 15, 0, 0, 0, time.Local) // Thursday
+	acc := newStatsAccumulator(now)
+
+	sessions := []adapter.Session{
+		{ID: "a", EstCost: 2, TotalTokens: 1000, Duration: 10 * time.Minute, UpdatedAt: now.Add(-time.Hour)},
+		{ID: "b", EstCost: 3, TotalTokens: 2000, Duration: 30 * time.Minute, UpdatedAt: now.AddDate(0, 0, -2)},
+		{ID: "c", EstCost: 50, TotalTokens: 9000, UpdatedAt: now.AddDate(0, 0, -30)},
+	}
+	for i := range sessions {
+		acc.addSession(&sessions[i])
+	}
+	st := acc.result(sessions)
+
+	if st.Sessions != 3 || st.TotalCost != 55 {
+		t.Errorf("totals = %d sessions, %v cost; want 3, 55", st.Sessions, st.TotalCost)
+	}
+	if st.WeekSessions != 2 || st.WeekCost != 5 || st.WeekTokens != 3000 {
+		t.Errorf("week = %d sessions, %v cost, %d tokens; want 2, 5, 3000", st.WeekSessions, st.WeekCost, st.WeekTokens)
+	}
+	if st.DailyCost[6] != 2 || st.DailyCost[4] != 3 {
+		t.Errorf("daily cost = %v, want today=2 and two-days-ago=3", st.DailyCost)
+	}
+	if st.AvgDuration != 20*time.Minute {
+		t.Errorf("avg duration = %v, want 20m", st.AvgDuration)
+	}
+}
+
+func TestStatsAccumulator_Messages(t *testing.T) {
+	acc := newStatsAccumulator(time.Now())
+	ts := time.Date(2025, 6, 9, 14, 30, 0, 0, time.Local) // Monday 14:00
+	acc.addMessages([]adapter.Message{
+		{Model: "claude-opus-4-5-20251101", Timestamp: ts, TokenUsage: adapter.TokenUsage{InputTokens: 100, OutputTokens: 50},
+			ContentBlocks: []adapter.ContentBlock{{Type: "tool_use", ToolName: "Bash"}, {Type: "tool_use", ToolName: "Read"}}},
+		{Model: "custom-model", Timestamp: ts, TokenUsage: adapter.TokenUsage{InputTokens: 10},
+			ToolUses: []adapter.ToolUse{{Name: "Bash"}}},
+	})
+	st := acc.result(nil)
+
+	if len(st.Models) != 2 || st.Models[0].Name != "opus" || st.Models[0].Count != 150 {
+		t.Errorf("models = %+v, want opus=150 first", st.Models)
+	}
+	if st.Models[1].Name != "custom-model" {
+		t.Errorf("unknown models should keep their full name, got %+v", st.Models[1])
+	}
+	if len(st.Tools) != 2 || st.Tools[0] != (namedCount{Name: "Bash", Count: 2}) {
+		t.Errorf("tools = %+v, want Bash=2 first", st.Tools)
+	}
+	if st.Hours[0][14] != 2 {
+		t.Errorf("heatmap Mon 14:00 = %d, want 2", st.Hours[0][14])
+	}
+	if st.Scanned != 1 {
+		t.Errorf("scanned = %d, want 1", st.Scanned)
+	}
+}
+
+func TestProjectStats_ApplyUsageDelta(t *testing.T) {
+	now := time.Date(2025, 6, 12, 15, 0, 0, 0, time.Local)
+	acc := newStatsAccumulator(now)
+	s := adapter.Session{ID: "a", EstCost: 1, TotalTokens: 100, UpdatedAt: now}
+	acc.addSession(&s)
+	acc.addMessages([]adapter.Message{{Model: "claude-opus-4-5-20251101", TokenUsage: adapter.TokenUsage{InputTokens: 100}}})
+	st := acc.result(nil)
+
+	st.applyUsageDelta(adapter.UsageDelta{SessionID: "a", Tokens: 50, Cost: 0.5, At: now,
+		ModelTokens: map[string]int{"claude-sonnet-4-5-20250929": 200}})
+	st.applyUsageDelta(adapter.UsageDelta{SessionID: "b", Tokens: 10, Cost: 2, At: now.AddDate(0, 0, -1), New: true})
+
+	if st.Sessions != 2 || st.TotalTokens != 160 || st.TotalCost != 3.5 {
+		t.Errorf("totals = %d sessions, %d tokens, %v cost; want 2, 160, 3.5", st.Sessions, st.TotalTokens, st.TotalCost)
+	}
+	if st.WeekSessions != 2 || st.DailyCost[6] != 1.5 || st.DailyCost[5] != 2 {
+		t.Errorf("week = %d sessions, daily %v; want 2 and today=1.5, yesterday=2", st.WeekSessions, st.DailyCost)
+	}
+	if len(st.Models) != 2 || st.Models[0] != (namedCount{Name: "sonnet4", Count: 200}) {
+		t.Errorf("models = %+v, want sonnet4=200 ranked first", st.Models)
+	}
+}
+
+func TestWithUsageDelta(t *testing.T) {
+	tracker := adapter.NewUsageTracker()
+	tracker.Seed("s1", adapter.UsageSnapshot{Tokens: 100, Cost: 1})
+	src := fakeUsageSource{"s1": {Tokens: 150, Cost: 1.25}}
+
+	evt := withUsageDelta(adapter.Event{SessionID: "s1"}, tracker, []adapter.UsageSnapshotter{src})
+	d, ok := evt.Data.(adapter.UsageDelta)
+	if !ok || d.Tokens != 50 || d.Cost != 0.25 || d.New {
+		t.Fatalf("delta = %+v, want +50 tokens, +0.25 cost", evt.Data)
+	}
+
+	// Unchanged usage and unknown sessions carry no delta
+	if evt := withUsageDelta(adapter.Event{SessionID: "s1"}, tracker, []adapter.UsageSnapshotter{src}); evt.Data != nil {
+		t.Errorf("unchanged usage produced %+v", evt.Data)
+	}
+	if evt := withUsageDelta(adapter.Event{SessionID: "zz"}, tracker, []adapter.UsageSnapshotter{src}); evt.Data != nil {
+		t.Errorf("unknown session produced %+v", evt.Data)
+	}
+}
+
+type fakeUsageSource map[string]adapter.UsageSnapshot
+
+func (f fakeUsageSource) UsageSnapshot(id string) (adapter.UsageSnapshot, bool) {
+	s, ok := f[id]
+	return s, ok
+}
+
+func TestHeatmapShade(t *testing.T) {
+	if heatmapShade(0, 10) != ' ' {
+		t.Error("zero activity should be blank")
+	}
+	if heatmapShade(1, 100) == ' ' {
+		t.Error("any activity should be visible")
+	}
+	if heatmapShade(10, 10) != '█' {
+		t.Error("peak activity should be full block")
+	}
+}
+
+func TestRenderSparkline(t *testing.T) {
+	got := renderSparkline([]float64{0, 1, 2, 4})
+	if got != "▁▂▄█" {
+		t.Errorf("renderSparkline = %q", got)
+	}
+}
+
+func TestStatsView_OpenAndClose(t *testing.T) {
+	p := New()
+	p.width, p.height = 100, 40
+	p.sessions = []adapter.Session{{ID: "s1", EstCost: 1, UpdatedAt: time.Now()}}
+
+	cmd := p.openStats()
+	if p.view != ViewStats || !p.statsLoading || cmd == nil {
+		t.Fatal("expected stats view to open and start loading")
+	}
+	if ctx := p.FocusContext(); ctx != "conversations-stats" {
+		t.Errorf("FocusContext = %q, want conversations-stats", ctx)
+	}
+
+	msg := cmd()
+	p.Update(msg)
+	if p.statsLoading || p.stats == nil || p.stats.Sessions != 1 {
+		t.Fatalf("expected stats to be loaded, got %+v", p.stats)
+	}
+	if out := p.renderStats(); !strings.Contains(out, "Project Stats") || !strings.Contains(out, "Busiest Hours") {
+		t.Errorf("unexpected stats render:\n%s", out)
+	}
+
+	p.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.view != ViewSessions {
+		t.Error("esc should return to sessions view")
+	}
+}
@@ -0,0 +1,376 @@
+package conversations
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters"
+	"github.com/alecthomas/chroma/v2/lexers"
+	chromastyles "github.com/alecthomas/chroma/v2/styles"
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/plugin"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// toolPagerMaxChars caps how much tool output is highlighted to keep the
+// pager responsive on multi-megabyte results.
+const toolPagerMaxChars = 200000
+
+// toolPagerEntry is a single tool call with output that can be paged.
+type toolPagerEntry struct {
+	ToolName string
+	Input    string
+	Output   string
+	IsError  bool
+	Raw      bool   // show output verbatim (no JSON prettifying)
+	Caption  string // extra status line text
+}
+
+// toolPagerState holds the scrollable pager overlay for a tool result.
+type toolPagerState struct {
+	entries []toolPagerEntry
+	index   int // selected entry
+
+	lang        string   // detected language name ("" = plain text)
+	raw         []string // unhighlighted output lines
+	highlighted []string // ANSI-highlighted output lines (same length as raw)
+
+	scroll  int  // first visible line (wrapped lines when wrap is on)
+	hScroll int  // horizontal offset in cells (no-wrap only)
+	wrap    bool // soft-wrap long lines
+}
+
+// collectToolPagerEntries returns the tool calls with output for a message.
+func collectToolPagerEntries(msg *adapter.Message) []toolPagerEntry {
+	if msg == nil {
+		return nil
+	}
+	var entries []toolPagerEntry
+	for _, block := range msg.ContentBlocks {
+		if block.Type == "tool_use" && block.ToolOutput != "" {
+			entries = append(entries, toolPagerEntry{
+				ToolName: block.ToolName,
+				Input:    block.ToolInput,
+				Output:   block.FullToolOutput(),
+				IsError:  block.IsError,
+			})
+		}
+	}
+	if len(entries) > 0 {
+		return entries
+	}
+	// Older adapters only populate ToolUses
+	for _, tu := range msg.ToolUses {
+		if tu.Output != "" {
+			entries = append(entries, toolPagerEntry{ToolName: tu.Name, Input: tu.Input, Output: tu.FullOutput()})
+		}
+	}
+	return entries
+}
+
+// openToolPager opens the pager for tool results in the current selection.
+func (p *Plugin) openToolPager() tea.Cmd {
+	var entries []toolPagerEntry
+	if p.turnViewMode {
+		if turn := p.getCurrentTurn(); turn != nil {
+			for i := range turn.Messages {
+				entries = append(entries, collectToolPagerEntries(&turn.Messages[i])...)
+			}
+		}
+	} else {
+		entries = collectToolPagerEntries(p.getSelectedMessage())
+	}
+	if len(entries) == 0 {
+		return app.ShowToast("No tool output for selection", 2*time.Second)
+	}
+
+	p.toolPager = &toolPagerState{entries: entries}
+	p.toolPager.load(0)
+	return nil
+}
+
+// closeToolPager dismisses the pager overlay.
+func (p *Plugin) closeToolPager() {
+	p.toolPager = nil
+}
+
+// load selects entry idx and (re)computes its highlighted lines.
+func (s *toolPagerState) load(idx int) {
+	if idx < 0 || idx >= len(s.entries) {
+		return
+	}
+	s.index = idx
+	s.scroll = 0
+	s.hScroll = 0
+
+	e := s.entries[idx]
+	output := truncateAtRune(e.Output, toolPagerMaxChars)
+	more := len(e.Output) - len(output)
+	if !e.Raw {
+		output = prettifyJSON(output)
+	}
+	output = strings.ReplaceAll(output, "\t", "    ")
+	if more > 0 {
+		output += fmt.Sprintf("\n… (%d more bytes)", more)
+	}
+
+	s.raw = strings.Split(output, "\n")
+	s.lang, s.highlighted = highlightToolOutput(output, extractFilePath(e.Input))
+	if len(s.highlighted) != len(s.raw) {
+		s.highlighted = s.raw
+	}
+}
+
+// truncateAtRune returns the longest prefix of s that is at most limit
+// bytes and doesn't split a UTF-8 sequence.
+func truncateAtRune(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
+
+// detectToolOutputLexer picks a lexer from the tool's file path, falling
+// back to content analysis. Returns nil for plain text.
+func detectToolOutputLexer(content, filePath string) chroma.Lexer {
+	var lexer chroma.Lexer
+	if filePath != "" {
+		lexer = lexers.Match(filepath.Base(filePath))
+	}
+	if lexer == nil {
+		trimmed := strings.TrimSpace(content)
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			if prettifyJSON(trimmed) != trimmed || strings.Contains(trimmed, "\n") {
+				lexer = lexers.Get("json")
+			}
+		}
+	}
+	if lexer == nil {
+		lexer = lexers.Analyse(content)
+	}
+	return lexer
+}
+
+// highlightToolOutput returns the detected language name and the output
+// split into ANSI-highlighted lines.
+func highlightToolOutput(content, filePath string) (string, []string) {
+	lexer := detectToolOutputLexer(content, filePath)
+	if lexer == nil {
+		return "", strings.Split(content, "\n")
+	}
+	style := chromastyles.Get(styles.GetSyntaxTheme())
+	if style == nil {
+		style = chromastyles.Fallback
+	}
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, content)
+	if err != nil {
+		return "", strings.Split(content, "\n")
+	}
+	var buf bytes.Buffer
+	if err := formatters.TTY256.Format(&buf, style, iterator); err != nil {
+		return "", strings.Split(content, "\n")
+	}
+	return lexer.Config().Name, strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+}
+
+// visibleLines returns the pager body lines for the given viewport.
+func (s *toolPagerState) visibleLines(width int) []string {
+	if width < 1 {
+		width = 1
+	}
+	if !s.wrap {
+		out := make([]string, len(s.highlighted))
+		for i, line := range s.highlighted {
+			out[i] = ansi.Cut(line, s.hScroll, s.hScroll+width)
+		}
+		return out
+	}
+	var out []string
+	for _, line := range s.highlighted {
+		wrapped := ansi.Hardwrap(line, width, true)
+		out = append(out, strings.Split(wrapped, "\n")...)
+	}
+	return out
+}
+
+// clampScroll keeps scroll within [0, total-height].
+func (s *toolPagerState) clampScroll(total, height int) {
+	maxScroll := total - height
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if s.scroll > maxScroll {
+		s.scroll = maxScroll
+	}
+	if s.scroll < 0 {
+		s.scroll = 0
+	}
+}
+
+// clampHScroll keeps the horizontal offset within the longest line in the
+// current viewport, so scrolling right stops once that line's end is shown.
+func (s *toolPagerState) clampHScroll(width, height int) {
+	longest := 0
+	for i := s.scroll; i < min(s.scroll+height, len(s.raw)); i++ {
+		longest = max(longest, ansi.StringWidth(s.raw[i]))
+	}
+	s.hScroll = min(s.hScroll, max(longest-width, 0))
+	s.hScroll = max(s.hScroll, 0)
+}
+
+// toolPagerDims returns the pager modal width and body height.
+func (p *Plugin) toolPagerDims() (int, int) {
+	modalW := p.width - 6
+	if modalW > 160 {
+		modalW = 160
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+	bodyH := p.height - 10
+	if bodyH < 3 {
+		bodyH = 3
+	}
+	return modalW, bodyH
+}
+
+// handleToolPagerKey handles keys while the pager is open.
+func (p *Plugin) handleToolPagerKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	s := p.toolPager
+	modalW, bodyH := p.toolPagerDims()
+	switch msg.String() {
+	case "esc", "q", "O":
+		p.closeToolPager()
+		return p, nil
+	case "j", "down":
+		s.scroll++
+	case "k", "up":
+		s.scroll--
+	case "ctrl+d", "pgdown", " ":
+		s.scroll += bodyH / 2
+	case "ctrl+u", "pgup":
+		s.scroll -= bodyH / 2
+	case "g", "home":
+		s.scroll = 0
+	case "G", "end":
+		s.scroll = len(s.visibleLines(modalW - 6))
+	case "l", "right":
+		if !s.wrap {
+			s.hScroll += 8
+		}
+	case "h", "left":
+		if !s.wrap {
+			s.hScroll = max(0, s.hScroll-8)
+		}
+	case "w":
+		s.wrap = !s.wrap
+		s.scroll = 0
+		s.hScroll = 0
+	case "]", "tab":
+		s.load((s.index + 1) % len(s.entries))
+	case "[", "shift+tab":
+		s.load((s.index - 1 + len(s.entries)) % len(s.entries))
+	case "y":
+		output := s.entries[s.index].Output
+		return p, func() tea.Msg {
+			if err := clipboard.WriteAll(output); err != nil {
+				return app.ToastMsg{Message: "Copy failed: " + err.Error(), Duration: 2 * time.Second, IsError: true}
+			}
+			return app.ToastMsg{Message: "Yanked tool output", Duration: 2 * time.Second}
+		}
+	}
+	s.clampScroll(len(s.visibleLines(modalW-6)), bodyH)
+	if !s.wrap {
+		s.clampHScroll(modalW-6, bodyH)
+	}
+	return p, nil
+}
+
+// renderToolPager renders the pager modal.
+func (p *Plugin) renderToolPager(width, height int) string {
+	s := p.toolPager
+	modalW, bodyH := p.toolPagerDims()
+	e := s.entries[s.index]
+
+	title := e.ToolName
+	if cmd := extractToolCommand(e.ToolName, e.Input, modalW-len(title)-12); cmd != "" {
+		title += ": " + cmd
+	} else if fp := extractFilePath(e.Input); fp != "" {
+		title += ": " + fp
+	}
+	variant := modal.VariantDefault
+	if e.IsError {
+		variant = modal.VariantDanger
+	}
+
+	body := modal.Custom(
+		func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+			lines := s.visibleLines(contentWidth)
+			s.clampScroll(len(lines), bodyH)
+			end := min(s.scroll+bodyH, len(lines))
+			visible := append([]string(nil), lines[s.scroll:end]...)
+			for len(visible) < bodyH {
+				visible = append(visible, "")
+			}
+			return modal.RenderedSection{Content: strings.Join(visible, "\n")}
+		},
+		nil,
+	)
+
+	m := modal.New(ui.TruncateString(title, modalW-8),
+		modal.WithWidth(modalW),
+		modal.WithVariant(variant),
+		modal.WithHints(false),
+	).
+		AddSection(body).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Custom(
+			func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+				return modal.RenderedSection{Content: styles.Current().Muted.Render(s.statusLine(contentWidth))}
+			},
+			nil,
+		))
+
+	return ui.OverlayModal(p.renderTwoPane(), m.Render(width, height, nil), width, height)
+}
+
+// statusLine summarizes position, language, and wrap mode.
+func (s *toolPagerState) statusLine(width int) string {
+	lang := s.lang
+	if lang == "" {
+		lang = "plain"
+	}
+	wrap := "nowrap"
+	if s.wrap {
+		wrap = "wrap"
+	}
+	parts := []string{
+		fmt.Sprintf("%d lines", len(s.raw)),
+		lang,
+		wrap,
+	}
+	if len(s.entries) > 1 {
+		parts = append([]string{fmt.Sprintf("tool %d/%d", s.index+1, len(s.entries))}, parts...)
+	}
+	if caption := s.entries[s.index].Caption; caption != "" {
+		parts = append([]string{caption}, parts...)
+	}
+	if !s.wrap && s.hScroll > 0 {
+		parts = append(parts, fmt.Sprintf("col %d", s.hScroll+1))
+	}
+	return ui.TruncateString(strings.Join(parts, " · "), width)
+}
@@ -0,0 +1,102 @@
+package conversations
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/adapter"
+)
+
+func TestCollectToolPagerEntries(t *testing.T) {
+	msg := &adapter.Message{
+		ContentBlocks: []adapter.ContentBlock{
+			{Type: "text", Text: "hello"},
+			{Type: "tool_use", ToolName: "Read", ToolOutput: "package main"},
+			{Type: "tool_use", ToolName: "Bash"}, // no output
+		},
+	}
+	entries := collectToolPagerEntries(msg)
+	if len(entries) != 1 || entries[0].ToolName != "Read" {
+		t.Fatalf("got %+v, want single Read entry", entries)
+	}
+
+	legacy := &adapter.Message{ToolUses: []adapter.ToolUse{{Name: "Bash", Output: "ok"}}}
+	if got := collectToolPagerEntries(legacy); len(got) != 1 {
+		t.Errorf("expected ToolUses fallback, got %d entries", len(got))
+	}
+	if got := collectToolPagerEntries(nil); got != nil {
+		t.Errorf("expected nil for nil message, got %v", got)
+	}
+}
+
+func TestDetectToolOutputLexer(t *testing.T) {
+	if l := detectToolOutputLexer("package main\n", "/tmp/main.go"); l == nil || l.Config().Name != "Go" {
+		t.Errorf("expected Go lexer from file path, got %v", l)
+	}
+	if l := detectToolOutputLexer(`{"a": 1}`, ""); l == nil || l.Config().Name != "JSON" {
+		t.Errorf("expected JSON lexer, got %v", l)
+	}
+}
+
+func TestToolPagerWrapToggle(t *testing.T) {
+	p := New()
+	p.width, p.height = 80, 30
+	p.toolPager = &toolPagerState{entries: []toolPagerEntry{{ToolName: "Bash", Output: strings.Repeat("x", 200)}}}
+	p.toolPager.load(0)
+
+	if lines := p.toolPager.visibleLines(50); len(lines) != 1 || ansi.StringWidth(lines[0]) != 50 {
+		t.Fatalf("nowrap: expected 1 line cut to 50 cells, got %d", len(lines))
+	}
+
+	p.handleToolPagerKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	if !p.toolPager.wrap {
+		t.Fatal("expected wrap on after 'w'")
+	}
+	if lines := p.toolPager.visibleLines(50); len(lines) != 4 {
+		t.Errorf("wrap: expected 4 lines, got %d", len(lines))
+	}
+
+	p.handleToolPagerKey(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.toolPager != nil {
+		t.Error("expected pager closed after esc")
+	}
+}
+
+func TestToolPagerHScrollClamped(t *testing.T) {
+	p := New()
+	p.width, p.height = 80, 30
+	p.toolPager = &toolPagerState{entries: []toolPagerEntry{{ToolName: "Bash", Output: "short\n" + strings.Repeat("x", 100)}}}
+	p.toolPager.load(0)
+	modalW, _ := p.toolPagerDims()
+
+	for range 50 {
+		p.handleToolPagerKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	}
+	if want := 100 - (modalW - 6); p.toolPager.hScroll != want {
+		t.Errorf("hScroll = %d, want %d (longest line end at the right edge)", p.toolPager.hScroll, want)
+	}
+	p.handleToolPagerKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}})
+	if want := 100 - (modalW - 6) - 8; p.toolPager.hScroll != want {
+		t.Errorf("after h: hScroll = %d, want %d", p.toolPager.hScroll, want)
+	}
+}
+
+func TestToolPagerTruncatesAtRuneBoundary(t *testing.T) {
+	// "é" is two bytes, so the cap falls inside one
+	output := "a" + strings.Repeat("é", toolPagerMaxChars)
+	s := &toolPagerState{entries: []toolPagerEntry{{ToolName: "Bash", Output: output, Raw: true}}}
+	s.load(0)
+
+	text := strings.Join(s.raw, "\n")
+	if !utf8.ValidString(text) {
+		t.Fatal("truncated output splits a UTF-8 sequence")
+	}
+	kept := len(s.raw[0])
+	if want := fmt.Sprintf("… (%d more bytes)", len(output)-kept); s.raw[len(s.raw)-1] != want {
+		t.Errorf("marker = %q, want %q", s.raw[len(s.raw)-1], want)
+	}
+}
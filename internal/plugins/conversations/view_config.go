@@ -0,0 +1,71 @@
+package conversations
+
+import (
+	"sort"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/config"
+)
+
+// applyViewConfig sets the initial layout, sort, grouping, and filters from
+// config. Unknown values keep the defaults.
+func (p *Plugin) applyViewConfig(v config.ConversationsViewConfig) {
+	p.listLayout = v.Layout == config.ConversationsLayoutList
+
+	switch v.Sort {
+	case config.ConversationsSortCreated, config.ConversationsSortTokens, config.ConversationsSortCost:
+		p.sortMode = v.Sort
+	default:
+		p.sortMode = config.ConversationsSortUpdated
+	}
+	p.groupByTime = v.GroupBy != config.ConversationsGroupNone
+
+	f := v.Filters
+	p.filters.Adapters = append([]string(nil), f.Adapters...)
+	p.filters.Models = append([]string(nil), f.Models...)
+	p.filters.Categories = append([]string(nil), f.Categories...)
+	p.filters.ActiveOnly = f.ActiveOnly
+	switch f.Date {
+	case "today", "yesterday", "week", "month":
+		p.filters.SetDateRange(f.Date)
+	}
+	p.filterActive = p.filters.IsActive()
+}
+
+// sortSessions orders p.sessions by the configured sort, newest or largest
+// first. Ties fall back to last activity.
+func (p *Plugin) sortSessions() {
+	sortSessionsBy(p.sessions, p.sortMode)
+}
+
+func sortSessionsBy(sessions []adapter.Session, mode string) {
+	sort.SliceStable(sessions, func(i, j int) bool {
+		a, b := sessions[i], sessions[j]
+		switch mode {
+		case config.ConversationsSortCreated:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+		case config.ConversationsSortTokens:
+			if a.TotalTokens != b.TotalTokens {
+				return a.TotalTokens > b.TotalTokens
+			}
+		case config.ConversationsSortCost:
+			if a.EstCost != b.EstCost {
+				return a.EstCost > b.EstCost
+			}
+		}
+		return a.UpdatedAt.After(b.UpdatedAt)
+	})
+}
+
+// groupedView reports whether the session list shows time group headers.
+// Groups follow last activity, so other sort orders list sessions flat.
+func (p *Plugin) groupedView() bool {
+	return !p.searchMode && p.groupByTime && p.sortMode == config.ConversationsSortUpdated
+}
+
+// listView reports whether the session list spans the full width.
+func (p *Plugin) listView() bool {
+	return p.listLayout && p.activePane == PaneSidebar
+}
@@ -0,0 +1,105 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestInit_AppliesProjectViewConfig(t *testing.T) {
+	cfg := config.Default()
+	cfg.Plugins.Conversations.View = config.ConversationsViewConfig{Sort: config.ConversationsSortTokens}
+	cfg.Projects.List = []config.ProjectConfig{{
+		Name: "a",
+		Path: "/project/a",
+		Conversations: &config.ConversationsViewConfig{
+			Layout:  config.ConversationsLayoutList,
+			Sort:    config.ConversationsSortCost,
+			Filters: config.ConversationsFilterConfig{Adapters: []string{"codex"}, Date: "today"},
+		},
+	}}
+
+	p := New()
+	if err := p.Init(&plugin.Context{ProjectRoot: "/project/a", Config: cfg}); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if !p.listLayout || p.sortMode != config.ConversationsSortCost {
+		t.Errorf("layout/sort = %v/%q, want list/cost", p.listLayout, p.sortMode)
+	}
+	if !p.filterActive || !p.filters.HasAdapter("codex") || p.filters.DateRange.Start.IsZero() {
+		t.Errorf("filters not applied: %+v", p.filters)
+	}
+
+	// Other projects use the global view
+	if err := p.Init(&plugin.Context{ProjectRoot: "/project/b", Config: cfg}); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if p.listLayout || p.sortMode != config.ConversationsSortTokens || p.filterActive {
+		t.Errorf("global view not applied: list=%v sort=%q filtered=%v", p.listLayout, p.sortMode, p.filterActive)
+	}
+}
+
+func TestSortSessionsBy(t *testing.T) {
+	now := time.Now()
+	sessions := []adapter.Session{
+		{ID: "a", UpdatedAt: now, CreatedAt: now.Add(-3 * time.Hour), TotalTokens: 10, EstCost: 0.5},
+		{ID: "b", UpdatedAt: now.Add(-time.Hour), CreatedAt: now.Add(-time.Hour), TotalTokens: 30, EstCost: 0.1},
+		{ID: "c", UpdatedAt: now.Add(-2 * time.Hour), CreatedAt: now.Add(-2 * time.Hour), TotalTokens: 20, EstCost: 0.9},
+	}
+	tests := map[string]string{
+		config.ConversationsSortUpdated: "abc",
+		config.ConversationsSortCreated: "bca",
+		config.ConversationsSortTokens:  "bca",
+		config.ConversationsSortCost:    "cab",
+	}
+	for mode, want := range tests {
+		sortSessionsBy(sessions, mode)
+		var got strings.Builder
+		for _, s := range sessions {
+			got.WriteString(s.ID)
+		}
+		if got.String() != want {
+			t.Errorf("sort %q = %s, want %s", mode, got.String(), want)
+		}
+	}
+}
+
+func TestListLayout_FullWidthUntilOpened(t *testing.T) {
+	p := New()
+	p.width, p.height = 120, 20
+	p.listLayout = true
+	p.sessions = []adapter.Session{{ID: "s1", Name: "only session", UpdatedAt: time.Now()}}
+
+	// Session rows span the full width; there is no message pane
+	p.renderTwoPane()
+	if r := p.mouseHandler.HitMap.Test(100, 3); r == nil || r.ID != regionSessionItem {
+		t.Errorf("region at x=100 = %v, want session item", r)
+	}
+
+	// Opening a session switches to the two-pane layout
+	p.activePane = PaneMessages
+	p.renderTwoPane()
+	if r := p.mouseHandler.HitMap.Test(100, 3); r == nil || r.ID == regionSessionItem || r.ID == regionSidebar {
+		t.Errorf("region at x=100 = %v, want message pane", r)
+	}
+}
+
+func TestGroupedView(t *testing.T) {
+	p := New()
+	if !p.groupedView() {
+		t.Error("default view should be grouped by time")
+	}
+	p.sortMode = config.ConversationsSortTokens
+	if p.groupedView() {
+		t.Error("time groups only apply to the updated sort")
+	}
+	p.sortMode = config.ConversationsSortUpdated
+	p.groupByTime = false
+	if p.groupedView() {
+		t.Error("groupBy none should list sessions flat")
+	}
+}
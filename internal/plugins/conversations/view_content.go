@@ -10,6 +10,9 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/format"
+	"github.com/wilbur182/forge/internal/icons"
+	"github.com/wilbur182/forge/internal/plugins/gitstatus"
 	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/ui"
 )
@@ -30,7 +33,7 @@ func stripANSIBackground(s string) string {
 
 // renderNoAdapter renders the view when no adapter is available.
 func renderNoAdapter() string {
-	return styles.Muted.Render(" No AI sessions available")
+	return styles.Current().Muted.Render(" No AI sessions available")
 }
 
 // getSessionGroup returns the time group label for a given timestamp.
@@ -98,26 +101,14 @@ func formatTokens(input, output, cache int) string {
 	return " (" + strings.Join(parts, " ") + ")"
 }
 
-// formatK formats a number with K/M suffix.
+// formatK formats a number with k/M suffix.
 func formatK(n int) string {
-	if n >= 1000000 {
-		return fmt.Sprintf("%.1fM", float64(n)/1000000)
-	}
-	if n >= 1000 {
-		return fmt.Sprintf("%.1fk", float64(n)/1000)
-	}
-	return fmt.Sprintf("%d", n)
+	return format.Compact(int64(n), false)
 }
 
-// formatCost formats a cost estimate in dollars.
+// formatCost formats a cost estimate in the configured currency.
 func formatCost(cost float64) string {
-	if cost < 0.01 {
-		return "<$0.01"
-	}
-	if cost < 1.0 {
-		return fmt.Sprintf("$%.2f", cost)
-	}
-	return fmt.Sprintf("$%.1f", cost)
+	return format.Cost(cost)
 }
 
 // renderCategoryBadge returns a dim category badge for non-interactive sessions.
@@ -125,9 +116,9 @@ func formatCost(cost float64) string {
 func renderCategoryBadge(session adapter.Session) string {
 	switch session.SessionCategory {
 	case adapter.SessionCategoryCron:
-		return lipgloss.NewStyle().Foreground(styles.TextSubtle).Render("cron")
+		return lipgloss.NewStyle().Foreground(styles.Current().TextSubtle).Render("cron")
 	case adapter.SessionCategorySystem:
-		return lipgloss.NewStyle().Foreground(styles.TextSubtle).Render("sys")
+		return lipgloss.NewStyle().Foreground(styles.Current().TextSubtle).Render("sys")
 	default:
 		return ""
 	}
@@ -147,28 +138,25 @@ func categoryBadgeText(session adapter.Session) string {
 
 func adapterBadgeText(session adapter.Session) string {
 	if session.AdapterIcon != "" {
-		return session.AdapterIcon
+		return icons.Current().AdapterIcon(session.AdapterID, session.AdapterIcon)
 	}
 	// Fallback for sessions without icon
 	abbr := adapterAbbrev(session)
 	if abbr == "" {
 		return "?" // Unknown adapter fallback
 	}
-	return "●" + abbr
+	return icons.Get(icons.Dot) + abbr
 }
 
 // renderAdapterIcon returns a colorized adapter icon based on the adapter type.
 func renderAdapterIcon(session adapter.Session) string {
-	icon := session.AdapterIcon
-	if icon == "" {
-		icon = "◆"
-	}
+	icon := icons.Current().AdapterIcon(session.AdapterID, session.AdapterIcon)
 
 	// Color based on adapter
 	switch session.AdapterID {
 	case "claude-code":
 		// Amber for Claude Code (matches existing StatusModified)
-		return styles.StatusModified.Render(icon)
+		return styles.Current().StatusModified.Render(icon)
 	case "gemini-cli":
 		// Google blue
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("#4285F4")).Render(icon)
@@ -177,12 +165,12 @@ func renderAdapterIcon(session adapter.Session) string {
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("#10A37F")).Render(icon)
 	case "cursor-cli":
 		// Cursor purple
-		return lipgloss.NewStyle().Foreground(styles.Primary).Render(icon)
+		return lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(icon)
 	case "amp":
 		// Sourcegraph orange
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5543")).Render(icon)
 	default:
-		return styles.Muted.Render(icon)
+		return styles.Current().Muted.Render(icon)
 	}
 }
 
@@ -456,7 +444,7 @@ func renderModelBadge(model string) string {
 	default:
 		// Default: amber/code style
 		badgeStyle = lipgloss.NewStyle().
-			Foreground(styles.Accent).
+			Foreground(styles.Current().Accent).
 			Padding(0, 1)
 	}
 
@@ -468,7 +456,7 @@ func renderTokenFlow(in, out int) string {
 	if in == 0 && out == 0 {
 		return ""
 	}
-	return styles.Muted.Render(fmt.Sprintf("in:%s out:%s", formatK(in), formatK(out)))
+	return styles.Current().Muted.Render(fmt.Sprintf("in:%s out:%s", formatK(in), formatK(out)))
 }
 
 // formatSessionDuration formats session duration for display.
@@ -636,22 +624,26 @@ func renderSourceLabel(label string) string {
 	if idx := strings.Index(label, "] "); idx != -1 {
 		badge := label[:idx+1] // "[TG]"
 		name := label[idx+2:]  // "Marcus Vorwaller"
-		return styles.Muted.Render(badge) + " " + styles.StatusInProgress.Render(name)
+		return styles.Current().Muted.Render(badge) + " " + styles.Current().StatusInProgress.Render(name)
 	}
 	// No name part, just the badge (e.g. "[WA]", "[sys]")
-	return styles.Muted.Render(label)
+	return styles.Current().Muted.Render(label)
 }
 
 // renderMessageBubble renders a single message as a chat bubble with content blocks.
 func (p *Plugin) renderMessageBubble(msg adapter.Message, msgIndex int, maxWidth int) []string {
+	return p.renderSessionBubble(p.findSelectedSession(), msg, msgIndex == p.messageCursor, maxWidth)
+}
+
+// renderSessionBubble renders a message bubble for any session, so the split
+// pane can reuse the main pane's styling.
+func (p *Plugin) renderSessionBubble(session *adapter.Session, msg adapter.Message, selected bool, maxWidth int) []string {
 	var lines []string
-	selected := msgIndex == p.messageCursor
 
 	// Header: timestamp + role + model badge + token flow
 	ts := msg.Timestamp.Local().Format("15:04")
 
 	// Get agent name from session's adapter
-	session := p.findSelectedSession()
 	agentName := adapterShortName(session)
 
 	// Cursor indicator for selected message
@@ -691,11 +683,11 @@ func (p *Plugin) renderMessageBubble(msg adapter.Message, msgIndex int, maxWidth
 				// Show channel badge dim, user name styled
 				userLabel = renderSourceLabel(msg.SourceLabel)
 			} else {
-				userLabel = styles.StatusInProgress.Render("you")
+				userLabel = styles.Current().StatusInProgress.Render("you")
 			}
 			headerLine = fmt.Sprintf("%s[%s] %s", cursorPrefix, ts, userLabel)
 		} else {
-			headerLine = fmt.Sprintf("%s[%s] %s", cursorPrefix, ts, styles.StatusStaged.Render(agentName))
+			headerLine = fmt.Sprintf("%s[%s] %s", cursorPrefix, ts, styles.Current().StatusStaged.Render(agentName))
 
 			// Add colorful model badge
 			if msg.Model != "" {
@@ -738,7 +730,7 @@ func (p *Plugin) renderMessageBubble(msg adapter.Message, msgIndex int, maxWidth
 			if visibleWidth < maxWidth {
 				line += strings.Repeat(" ", maxWidth-visibleWidth)
 			}
-			styledLines = append(styledLines, styles.ListItemSelected.Render(line))
+			styledLines = append(styledLines, selectedItemStyle.Render(line))
 		}
 		return styledLines
 	}
@@ -764,6 +756,9 @@ func (p *Plugin) renderContentBlocks(msg adapter.Message, maxWidth int) []string
 			toolLines := p.renderToolUseBlock(block, maxWidth)
 			lines = append(lines, toolLines...)
 
+		case "image":
+			lines = append(lines, styles.Current().Code.Render(imagePlaceholder(block))+styles.Current().Muted.Render(" (I to view)"))
+
 		case "tool_result":
 			// Tool results are rendered inline with tool_use via ToolOutput
 			// Skip standalone tool_result blocks in the flow
@@ -832,7 +827,7 @@ func (p *Plugin) renderThinkingBlock(block adapter.ContentBlock, msgID string, m
 
 	// Light purple style for thinking blocks
 	thinkingStyle := lipgloss.NewStyle().
-		Foreground(styles.Primary).
+		Foreground(styles.Current().Primary).
 		Italic(true)
 
 	thinkingIcon := "◈"
@@ -846,7 +841,7 @@ func (p *Plugin) renderThinkingBlock(block adapter.ContentBlock, msgID string, m
 		// Render thinking content with | prefix for visual distinction
 		thinkingLines := wrapText(block.Text, maxWidth-4)
 		for _, line := range thinkingLines {
-			lines = append(lines, styles.Muted.Render("  │ "+line))
+			lines = append(lines, styles.Current().Muted.Render("  │ "+line))
 		}
 	} else {
 		// Collapsed: show ▶ indicator and preview
@@ -864,7 +859,7 @@ func (p *Plugin) renderThinkingBlock(block adapter.ContentBlock, msgID string, m
 		header := fmt.Sprintf("%s thinking (%s tokens) ▶", thinkingIcon, tokenStr)
 		if preview != "" {
 			// Add preview in subtle style
-			lines = append(lines, thinkingStyle.Render(header)+" "+styles.Subtle.Render(preview))
+			lines = append(lines, thinkingStyle.Render(header)+" "+styles.Current().Subtle.Render(preview))
 		} else {
 			lines = append(lines, thinkingStyle.Render(header))
 		}
@@ -924,17 +919,22 @@ func (p *Plugin) renderToolUseBlock(block adapter.ContentBlock, maxWidth int) []
 	if block.IsError {
 		// Red styling for errors with x indicator
 		errorStyle := lipgloss.NewStyle().
-			Foreground(styles.Error)
+			Foreground(styles.Current().Error)
 		// Build error header without the original icon (avoid byte slicing Unicode)
 		errorHeader := "✗ " + strings.TrimPrefix(toolHeader, icon+" ")
 		lines = append(lines, errorStyle.Render(errorHeader))
 	} else {
-		lines = append(lines, styles.Code.Render(toolHeader))
+		lines = append(lines, styles.Current().Code.Render(toolHeader))
+	}
+
+	// Expanded edits show the change as a diff
+	if expanded {
+		lines = append(lines, renderEditDiff(block.ToolName, block.ToolInput, maxWidth)...)
 	}
 
 	// Show result if expanded or if there's an error
 	if block.ToolOutput != "" && (expanded || block.IsError) {
-		output := block.ToolOutput
+		output := block.FullToolOutput()
 
 		// Truncate before prettifying to prevent memory issues with large outputs
 		const maxChars = 10000
@@ -955,7 +955,7 @@ func (p *Plugin) renderToolUseBlock(block adapter.ContentBlock, maxWidth int) []
 			if len(line) > maxWidth-4 {
 				line = ui.TruncateString(line, maxWidth-4)
 			}
-			lines = append(lines, styles.Muted.Render("  "+line))
+			lines = append(lines, styles.Current().Muted.Render("  "+line))
 		}
 	} else if block.ToolOutput != "" {
 		// Collapsed: show first meaningful line of output as preview
@@ -976,41 +976,72 @@ func (p *Plugin) renderToolUseBlock(block adapter.ContentBlock, maxWidth int) []
 			if runes := []rune(preview); len(runes) > maxWidth-6 {
 				preview = string(runes[:maxWidth-9]) + "..."
 			}
-			lines = append(lines, styles.Muted.Render("  → "+preview))
+			lines = append(lines, styles.Current().Muted.Render("  → "+preview))
 		}
 	}
 
 	return lines
 }
 
+// maxEditDiffLines caps the diff shown for an expanded edit tool call.
+const maxEditDiffLines = 20
+
+// renderEditDiff renders an edit tool call's old_string/new_string as a
+// diff with word-level highlighting. Returns nil for other tools.
+func renderEditDiff(toolName, input string, maxWidth int) []string {
+	switch strings.ToLower(toolName) {
+	case "edit", "strreplace", "str_replace_editor":
+	default:
+		return nil
+	}
+	var data struct {
+		OldString string `json:"old_string"`
+		NewString string `json:"new_string"`
+	}
+	if err := json.Unmarshal([]byte(input), &data); err != nil || data.OldString == data.NewString {
+		return nil
+	}
+
+	diffLines := gitstatus.DiffStrings(data.OldString, data.NewString)
+	var lines []string
+	for i, dl := range diffLines {
+		if i == maxEditDiffLines {
+			lines = append(lines, styles.Current().Muted.Render(fmt.Sprintf("  ... (%d more lines)", len(diffLines)-maxEditDiffLines)))
+			break
+		}
+		lines = append(lines, "  "+gitstatus.RenderDiffLine(dl, maxWidth-4))
+	}
+	return lines
+}
+
 // renderFilterMenu renders the filter selection menu.
-func (p *Plugin) renderFilterMenu(height int) string {
+func (p *Plugin) renderFilterMenu(width, height int) string {
 	var sb strings.Builder
 
-	sb.WriteString(styles.Title.Render("Filters"))
+	sb.WriteString(styles.Current().Title.Render("Filters"))
 	sb.WriteString("                    ")
-	sb.WriteString(styles.Muted.Render("[esc to cancel]"))
+	sb.WriteString(styles.Current().Muted.Render("[esc to cancel]"))
 	sb.WriteString("\n")
-	sb.WriteString(styles.Muted.Render(strings.Repeat("─", p.sidebarWidth-4)))
+	sb.WriteString(styles.Current().Muted.Render(strings.Repeat("─", width-4)))
 	sb.WriteString("\n\n")
 
 	// Adapter filters
 	adapterOptions := adapterFilterOptions(p.adapters)
 	if len(adapterOptions) > 0 {
-		sb.WriteString(styles.Subtitle.Render("Adapter:"))
+		sb.WriteString(styles.Current().Subtitle.Render("Adapter:"))
 		sb.WriteString("\n")
 		for _, opt := range adapterOptions {
 			checkbox := "[ ]"
 			if p.filters.HasAdapter(opt.id) {
 				checkbox = "[✓]"
 			}
-			sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Code.Render(opt.key), checkbox, opt.name))
+			sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Current().Code.Render(opt.key), checkbox, opt.name))
 		}
 		sb.WriteString("\n")
 	}
 
 	// Category filters
-	sb.WriteString(styles.Subtitle.Render("Category:"))
+	sb.WriteString(styles.Current().Subtitle.Render("Category:"))
 	sb.WriteString("\n")
 	categories := []struct {
 		key  string
@@ -1026,12 +1057,12 @@ func (p *Plugin) renderFilterMenu(height int) string {
 		if p.filters.HasCategory(c.cat) {
 			checkbox = "[✓]"
 		}
-		sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Code.Render(c.key), checkbox, c.name))
+		sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Current().Code.Render(c.key), checkbox, c.name))
 	}
 	sb.WriteString("\n")
 
 	// Model filters
-	sb.WriteString(styles.Subtitle.Render("Model:"))
+	sb.WriteString(styles.Current().Subtitle.Render("Model:"))
 	sb.WriteString("\n")
 	models := []struct {
 		key   string
@@ -1047,12 +1078,12 @@ func (p *Plugin) renderFilterMenu(height int) string {
 		if p.filters.HasModel(m.model) {
 			checkbox = "[✓]"
 		}
-		sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Code.Render(m.key), checkbox, m.name))
+		sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Current().Code.Render(m.key), checkbox, m.name))
 	}
 	sb.WriteString("\n")
 
 	// Date filters
-	sb.WriteString(styles.Subtitle.Render("Date:"))
+	sb.WriteString(styles.Current().Subtitle.Render("Date:"))
 	sb.WriteString("\n")
 	dates := []struct {
 		key    string
@@ -1068,7 +1099,7 @@ func (p *Plugin) renderFilterMenu(height int) string {
 		if p.filters.DateRange.Preset == d.preset {
 			checkbox = "[✓]"
 		}
-		sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Code.Render(d.key), checkbox, d.name))
+		sb.WriteString(fmt.Sprintf("  %s %s %s\n", styles.Current().Code.Render(d.key), checkbox, d.name))
 	}
 	sb.WriteString("\n")
 
@@ -1077,11 +1108,11 @@ func (p *Plugin) renderFilterMenu(height int) string {
 	if p.filters.ActiveOnly {
 		activeCheck = "[✓]"
 	}
-	sb.WriteString(fmt.Sprintf("  %s %s Active only\n", styles.Code.Render("a"), activeCheck))
+	sb.WriteString(fmt.Sprintf("  %s %s Active only\n", styles.Current().Code.Render("a"), activeCheck))
 	sb.WriteString("\n")
 
 	// Clear filters
-	sb.WriteString(fmt.Sprintf("  %s Clear all filters\n", styles.Code.Render("x")))
+	sb.WriteString(fmt.Sprintf("  %s Clear all filters\n", styles.Current().Code.Render("x")))
 
 	return sb.String()
 }
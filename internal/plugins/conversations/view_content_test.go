@@ -1,6 +1,7 @@
 package conversations
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/wilbur182/forge/internal/adapter"
@@ -156,3 +157,21 @@ func TestAdapterBadgeText(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderEditDiff(t *testing.T) {
+	input := `{"file_path":"a.go","old_string":"x := 1","new_string":"x := 2"}`
+	lines := renderEditDiff("Edit", input, 80)
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2: %q", len(lines), lines)
+	}
+	if !strings.Contains(lines[0], "-") || !strings.Contains(lines[1], "+") {
+		t.Errorf("expected removed then added line, got %q", lines)
+	}
+
+	if got := renderEditDiff("Bash", input, 80); got != nil {
+		t.Errorf("non-edit tools should not render a diff, got %q", got)
+	}
+	if got := renderEditDiff("Edit", `{"old_string":"same","new_string":"same"}`, 80); got != nil {
+		t.Errorf("unchanged edit should not render a diff, got %q", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/icons"
 	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/ui"
 )
@@ -31,6 +32,10 @@ func (p *Plugin) renderTwoPane() string {
 		p.hitRegionsDirty = true
 		p.prevTurnScroll = p.turnScrollOff
 	}
+	if listView := p.listView(); listView != p.prevListView {
+		p.hitRegionsDirty = true
+		p.prevListView = listView
+	}
 
 	// Pane height for panels (outer dimensions including borders)
 	paneHeight := p.height
@@ -51,20 +56,33 @@ func (p *Plugin) renderTwoPane() string {
 			mainWidth = 40
 		}
 
-		mainContent := p.renderMainPane(mainWidth, innerHeight)
-		rightPane := styles.RenderPanel(mainContent, mainWidth, paneHeight, true)
-
 		// Update hit regions for collapsed state
-		if p.hitRegionsDirty {
+		dirty := p.hitRegionsDirty
+		if dirty {
 			p.mouseHandler.HitMap.Clear()
 			p.mouseHandler.HitMap.AddRect(regionMainPane, 0, 0, mainWidth, p.height, nil)
-			p.registerTurnHitRegions(1, mainWidth-2, innerHeight)
+		}
+		rightPane := p.renderMainColumn(mainWidth, paneHeight, 0)
+		if dirty {
+			p.registerTurnHitRegions(1, mainWidth-2, p.mainContentHeight(paneHeight))
 			p.hitRegionsDirty = false
 		}
 
 		return rightPane
 	}
 
+	// List layout - full-width session list until a session is opened
+	if p.listView() {
+		leftPane := styles.RenderPanel(p.renderSidebarPane(p.width, innerHeight), p.width, paneHeight, true)
+		if p.hitRegionsDirty {
+			p.mouseHandler.HitMap.Clear()
+			p.mouseHandler.HitMap.AddRect(regionSidebar, 0, 0, p.width, p.height, nil)
+			p.registerSessionHitRegions(p.width, innerHeight)
+			p.hitRegionsDirty = false
+		}
+		return leftPane
+	}
+
 	// RenderPanel handles borders internally, so only subtract divider
 	available := p.width - dividerWidth
 	sidebarWidth := p.sidebarWidth
@@ -87,13 +105,9 @@ func (p *Plugin) renderTwoPane() string {
 
 	// Determine if panes are active based on focus
 	sidebarActive := p.activePane == PaneSidebar
-	mainActive := p.activePane != PaneSidebar
 
 	// Render sidebar (session list)
-	sidebarContent := p.renderSidebarPane(innerHeight)
-
-	// Render main pane (messages)
-	mainContent := p.renderMainPane(mainWidth, innerHeight)
+	sidebarContent := p.renderSidebarPane(sidebarWidth, innerHeight)
 
 	// Apply gradient border styles
 	leftPane := styles.RenderPanel(sidebarContent, sidebarWidth, paneHeight, sidebarActive)
@@ -101,11 +115,10 @@ func (p *Plugin) renderTwoPane() string {
 	// Render visible divider
 	divider := ui.RenderDivider(paneHeight)
 
-	rightPane := styles.RenderPanel(mainContent, mainWidth, paneHeight, mainActive)
-
 	// Only rebuild hit regions when dirty (td-ea784b03)
 	mainX := sidebarWidth + dividerWidth
-	if p.hitRegionsDirty {
+	dirty := p.hitRegionsDirty
+	if dirty {
 		// Clear and re-register hit regions
 		p.mouseHandler.HitMap.Clear()
 
@@ -114,6 +127,12 @@ func (p *Plugin) renderTwoPane() string {
 		p.mouseHandler.HitMap.AddRect(regionSidebar, 0, 0, sidebarWidth, p.height, nil)
 		// Main pane region (after divider) - medium priority
 		p.mouseHandler.HitMap.AddRect(regionMainPane, mainX, 0, mainWidth, p.height, nil)
+	}
+
+	// Render main pane (messages), with the split pane registering its region
+	rightPane := p.renderMainColumn(mainWidth, paneHeight, mainX)
+
+	if dirty {
 		// Divider region - HIGH PRIORITY (registered after panes so it wins in overlap)
 		dividerX := sidebarWidth
 		dividerHitWidth := 3
@@ -123,7 +142,7 @@ func (p *Plugin) renderTwoPane() string {
 		p.registerSessionHitRegions(sidebarWidth, innerHeight)
 
 		// Turn item regions - HIGHEST PRIORITY (registered last)
-		p.registerTurnHitRegions(mainX+1, mainWidth-2, innerHeight)
+		p.registerTurnHitRegions(mainX+1, mainWidth-2, p.mainContentHeight(paneHeight))
 
 		p.hitRegionsDirty = false
 	}
@@ -167,7 +186,7 @@ func (p *Plugin) registerSessionHitRegions(sidebarWidth, contentHeight int) {
 		session := sessions[i]
 
 		// In grouped mode (not searching), account for group headers and spacers
-		if !p.searchMode {
+		if p.groupedView() {
 			sessionGroup := getSessionGroup(session.UpdatedAt)
 			if sessionGroup != currentGroup {
 				// Spacer before Yesterday/This Week (except first group)
@@ -259,13 +278,13 @@ func (p *Plugin) calculateTurnHeight(turn Turn, maxWidth int) int {
 }
 
 // renderSidebarPane renders the session list for the sidebar.
-func (p *Plugin) renderSidebarPane(height int) string {
+func (p *Plugin) renderSidebarPane(width, height int) string {
 	var sb strings.Builder
 
 	sessions := p.visibleSessions()
 
 	// Content width = sidebar width - border (2) - padding (2) = 4
-	contentWidth := p.sidebarWidth - 4
+	contentWidth := width - 4
 	if contentWidth < 15 {
 		contentWidth = 15
 	}
@@ -283,15 +302,15 @@ func (p *Plugin) renderSidebarPane(height int) string {
 	if maxCountLen > 0 && len(countStr) > maxCountLen {
 		countStr = countStr[:maxCountLen]
 	}
-	sb.WriteString(styles.Title.Render("Sessions"))
-	sb.WriteString(styles.Muted.Render(" " + countStr))
+	sb.WriteString(styles.Current().Title.Render("Sessions"))
+	sb.WriteString(styles.Current().Muted.Render(" " + countStr))
 	// Show category filter pill when active (td-91bbc4)
 	if len(p.filters.Categories) > 0 {
 		catLabel := strings.Join(p.filters.Categories, "+")
 		if len(catLabel) > 0 {
 			catLabel = strings.ToUpper(catLabel[:1]) + catLabel[1:]
 		}
-		sb.WriteString(" " + styles.RenderPillWithStyle(catLabel, styles.BarChipActive, ""))
+		sb.WriteString(" " + styles.RenderPillWithStyle(catLabel, styles.Current().BarChipActive, ""))
 	}
 	// Show animated spinner while adapters are still sending batches (td-7198a5)
 	if p.loadingAdapters {
@@ -307,7 +326,7 @@ func (p *Plugin) renderSidebarPane(height int) string {
 		if len(searchLine) > contentWidth {
 			searchLine = searchLine[:contentWidth]
 		}
-		sb.WriteString(styles.StatusInProgress.Render(searchLine))
+		sb.WriteString(styles.Current().StatusInProgress.Render(searchLine))
 		sb.WriteString("\n")
 		linesUsed++
 	} else if p.filterActive {
@@ -315,14 +334,14 @@ func (p *Plugin) renderSidebarPane(height int) string {
 		if len(filterStr) > contentWidth {
 			filterStr = filterStr[:contentWidth-3] + "..."
 		}
-		sb.WriteString(styles.Muted.Render(filterStr))
+		sb.WriteString(styles.Current().Muted.Render(filterStr))
 		sb.WriteString("\n")
 		linesUsed++
 	}
 
 	// Filter menu (if in filter mode)
 	if p.filterMode {
-		sb.WriteString(p.renderFilterMenu(height - linesUsed))
+		sb.WriteString(p.renderFilterMenu(width, height-linesUsed))
 		return sb.String()
 	}
 
@@ -334,16 +353,16 @@ func (p *Plugin) renderSidebarPane(height int) string {
 			return sb.String()
 		}
 		if p.searchMode {
-			sb.WriteString(styles.Muted.Render("No matching sessions"))
+			sb.WriteString(styles.Current().Muted.Render("No matching sessions"))
 		} else if p.filterActive && len(p.filters.Categories) > 0 {
 			// Category filter is hiding all sessions (td-7d13d8)
 			label := strings.Join(p.filters.Categories, "/")
 			line1 := "No " + label + " sessions."
 			line2 := "Press C to show all."
-			msg := styles.Muted.Render(line1) + "\n" + styles.Subtle.Render(line2)
+			msg := styles.Current().Muted.Render(line1) + "\n" + styles.Current().Subtle.Render(line2)
 			sb.WriteString(msg)
 		} else {
-			sb.WriteString(styles.Muted.Render("No sessions"))
+			sb.WriteString(styles.Current().Muted.Render("No sessions"))
 		}
 		return sb.String()
 	}
@@ -368,7 +387,7 @@ func (p *Plugin) renderSidebarPane(height int) string {
 	}
 
 	var sessionSB strings.Builder
-	if !p.searchMode {
+	if p.groupedView() {
 		groups := GroupSessionsByTime(sessions)
 		p.renderGroupedCompactSessions(&sessionSB, groups, contentHeight, sessionWidth)
 	} else {
@@ -389,7 +408,7 @@ func (p *Plugin) renderSidebarPane(height int) string {
 	if p.hasMoreSessions && !p.searchMode && !p.filterMode {
 		remaining := len(p.sessions) - p.displayedCount
 		loadMoreLine := fmt.Sprintf("  \u2193 %d more", remaining)
-		sessionSB.WriteString(styles.Muted.Render(loadMoreLine) + "\n")
+		sessionSB.WriteString(styles.Current().Muted.Render(loadMoreLine) + "\n")
 	}
 	// Show animated loading indicator at bottom while adapters still loading (td-7198a5)
 	if p.loadingAdapters && !p.searchMode && !p.filterMode {
@@ -445,7 +464,7 @@ func (p *Plugin) renderGroupedCompactSessions(sb *strings.Builder, groups []Sess
 			if len(groupHeader) > contentWidth {
 				groupHeader = groupHeader[:contentWidth]
 			}
-			sb.WriteString(styles.Code.Render(groupHeader))
+			sb.WriteString(styles.Current().Code.Render(groupHeader))
 			sb.WriteString("\n")
 			lineCount++
 			if lineCount >= contentHeight {
@@ -486,7 +505,7 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 
 	// Format token count - only if we have data
 	tokenCol := ""
-	if session.TotalTokens > 0 {
+	if session.TotalTokens > 0 && p.sessionSupports(&session, adapter.CapUsage) {
 		tokenCol = formatK(session.TotalTokens)
 	}
 
@@ -505,6 +524,22 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 	// Category badge (cron/sys) for non-interactive sessions
 	catBadge := categoryBadgeText(session)
 
+	// Budget badge for sessions over the per-session token/cost limit
+	budgetBadge := ""
+	if p.overSessionBudget(&session) {
+		budgetBadge = budgetBadgeText
+	}
+
+	// Co-viewing badge for sessions a peer has selected
+	peerBadge := ""
+	if len(p.coviewPeersOn(session.ID)) > 0 {
+		peerBadge = coviewBadge
+	}
+
+	// User-defined badges from config rules
+	ruleBadges := p.sessionBadges(&session)
+	ruleBadge := badgeRulesText(ruleBadges)
+
 	// Calculate prefix length for width calculations
 	// active(1) + badge + space + worktree + space (if worktree)
 	prefixLen := 1 + len(badgeText) + 1
@@ -514,6 +549,15 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 	if catBadge != "" {
 		prefixLen += len(catBadge) + 1 // category badge + space
 	}
+	if budgetBadge != "" {
+		prefixLen += len(budgetBadge) + 1 // budget badge + space
+	}
+	if peerBadge != "" {
+		prefixLen += lipgloss.Width(peerBadge) + 1 // peer badge + space
+	}
+	if ruleBadge != "" {
+		prefixLen += lipgloss.Width(ruleBadge) + 1 // rule badges + space
+	}
 	if session.IsSubAgent {
 		prefixLen += 2 // extra indent for sub-agents
 	}
@@ -552,6 +596,15 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 	if catBadge != "" {
 		visibleLen += len(catBadge) + 1 // category badge + space
 	}
+	if budgetBadge != "" {
+		visibleLen += len(budgetBadge) + 1 // budget badge + space
+	}
+	if peerBadge != "" {
+		visibleLen += lipgloss.Width(peerBadge) + 1 // peer badge + space
+	}
+	if ruleBadge != "" {
+		visibleLen += lipgloss.Width(ruleBadge) + 1 // rule badges + space
+	}
 	padding := maxWidth - visibleLen - rightColWidth - 1
 	if padding < 0 {
 		padding = 0
@@ -567,9 +620,9 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 
 	// Activity indicator with colors
 	if session.IsActive {
-		sb.WriteString(styles.StatusInProgress.Render("●"))
+		sb.WriteString(styles.Current().StatusInProgress.Render(icons.Get(icons.Dot)))
 	} else if session.IsSubAgent {
-		sb.WriteString(styles.Muted.Render("↳"))
+		sb.WriteString(styles.Current().Muted.Render("↳"))
 	} else {
 		sb.WriteString(" ")
 	}
@@ -577,23 +630,23 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 	// Colored adapter icon + worktree badge + name + category badge based on session type
 	if session.IsSubAgent {
 		// Sub-agents: muted styling
-		sb.WriteString(styles.Muted.Render(badgeText))
+		sb.WriteString(styles.Current().Muted.Render(badgeText))
 		sb.WriteString(" ")
 		if worktreeBadge != "" {
-			sb.WriteString(styles.Muted.Render(worktreeBadge))
+			sb.WriteString(styles.Current().Muted.Render(worktreeBadge))
 			sb.WriteString(" ")
 		}
-		sb.WriteString(styles.Subtitle.Render(name))
+		sb.WriteString(styles.Current().Subtitle.Render(name))
 	} else {
 		// Top-level: use colored adapter icon
 		sb.WriteString(renderAdapterIcon(session))
 		sb.WriteString(" ")
 		if worktreeBadge != "" {
 			// Cyan/teal color for worktree badge to stand out
-			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Success).Render(worktreeBadge))
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Success).Render(worktreeBadge))
 			sb.WriteString(" ")
 		}
-		sb.WriteString(styles.Body.Render(name))
+		sb.WriteString(styles.Current().Body.Render(name))
 	}
 
 	// Category badge (cron/sys) after name
@@ -601,6 +654,18 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 		sb.WriteString(" ")
 		sb.WriteString(renderCategoryBadge(session))
 	}
+	if budgetBadge != "" {
+		sb.WriteString(" ")
+		sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Warning).Bold(true).Render(budgetBadge))
+	}
+	if peerBadge != "" {
+		sb.WriteString(" ")
+		sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Info).Render(peerBadge))
+	}
+	if ruleBadge != "" {
+		sb.WriteString(" ")
+		sb.WriteString(renderBadgeRules(ruleBadges))
+	}
 
 	// Padding and right-aligned stats (only if we have data)
 	if rightColWidth > 0 && padding > 0 {
@@ -608,16 +673,16 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 		sb.WriteString(" ")
 		if lengthCol != "" {
 			if session.IsSubAgent {
-				sb.WriteString(styles.Muted.Render(lengthCol))
+				sb.WriteString(styles.Current().Muted.Render(lengthCol))
 			} else {
-				sb.WriteString(styles.Subtitle.Render(lengthCol))
+				sb.WriteString(styles.Current().Subtitle.Render(lengthCol))
 			}
 		}
 		if tokenCol != "" {
 			if lengthCol != "" {
 				sb.WriteString(" ")
 			}
-			sb.WriteString(styles.Subtle.Render(tokenCol))
+			sb.WriteString(styles.Current().Subtle.Render(tokenCol))
 		}
 	}
 
@@ -630,7 +695,7 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 			plain.WriteString("  ")
 		}
 		if session.IsActive {
-			plain.WriteString("●")
+			plain.WriteString(icons.Get(icons.Dot))
 		} else if session.IsSubAgent {
 			plain.WriteString("↳")
 		} else {
@@ -647,6 +712,18 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 			plain.WriteString(" ")
 			plain.WriteString(catBadge)
 		}
+		if budgetBadge != "" {
+			plain.WriteString(" ")
+			plain.WriteString(budgetBadge)
+		}
+		if peerBadge != "" {
+			plain.WriteString(" ")
+			plain.WriteString(peerBadge)
+		}
+		if ruleBadge != "" {
+			plain.WriteString(" ")
+			plain.WriteString(ruleBadge)
+		}
 		if rightColWidth > 0 && padding > 0 {
 			plain.WriteString(strings.Repeat(" ", padding))
 			plain.WriteString(" ")
@@ -665,7 +742,7 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 		if len(plainRow) < maxWidth {
 			plainRow += strings.Repeat(" ", maxWidth-len(plainRow))
 		}
-		return styles.ListItemSelected.Render(plainRow)
+		return selectedItemStyle.Render(plainRow)
 	}
 
 	return row
@@ -680,7 +757,7 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 	}
 
 	if p.selectedSession == "" {
-		return styles.Muted.Render("Select a session to view messages")
+		return styles.Current().Muted.Render("Select a session to view messages")
 	}
 
 	// If in detail mode, render the turn detail instead of turn list
@@ -719,7 +796,13 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 		sb.WriteString(renderAdapterIcon(*session))
 		sb.WriteString(" ")
 	}
-	sb.WriteString(styles.Title.Render(sessionName))
+	sb.WriteString(styles.Current().Title.Render(sessionName))
+	if session != nil {
+		if peers := p.coviewHeaderText(session.ID); peers != "" && lipgloss.Width(sessionName)+lipgloss.Width(peers)+6 <= contentWidth {
+			sb.WriteString("  ")
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Info).Render(peers))
+		}
+	}
 	sb.WriteString("\n")
 
 	// Header Line 2: Model badge │ msgs │ tokens │ cost │ date
@@ -739,19 +822,21 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 			// Fallback to adapter short name
 			shortName := adapterShortName(session)
 			if shortName != "" {
-				statsParts = append(statsParts, styles.Code.Render(shortName))
+				statsParts = append(statsParts, styles.Current().Code.Render(shortName))
 			}
 		}
 
 		// Message count
 		statsParts = append(statsParts, fmt.Sprintf("%d msgs", s.MessageCount))
 
-		// Token flow
-		statsParts = append(statsParts, fmt.Sprintf("in:%s out:%s", formatK(s.TotalTokensIn), formatK(s.TotalTokensOut)))
-
-		// Cost estimate
-		if session != nil && session.EstCost > 0 {
-			statsParts = append(statsParts, formatCost(session.EstCost))
+		// Token flow and cost estimate (or a note when the adapter has no usage data)
+		if p.sessionSupports(session, adapter.CapUsage) {
+			statsParts = append(statsParts, fmt.Sprintf("in:%s out:%s", formatK(s.TotalTokensIn), formatK(s.TotalTokensOut)))
+			if session != nil && session.EstCost > 0 {
+				statsParts = append(statsParts, formatCost(session.EstCost))
+			}
+		} else {
+			statsParts = append(statsParts, "no usage data")
 		}
 
 		// Last updated
@@ -766,7 +851,7 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 			statsParts = statsParts[1:] // Remove badge
 			statsLine = strings.Join(statsParts, " │ ")
 		}
-		sb.WriteString(styles.Muted.Render(statsLine))
+		sb.WriteString(styles.Current().Muted.Render(statsLine))
 		sb.WriteString("\n")
 	}
 
@@ -778,9 +863,9 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 			if len(resumeCmd) > maxCmdLen {
 				resumeCmd = resumeCmd[:maxCmdLen-3] + "..."
 			}
-			sb.WriteString(styles.Code.Render(resumeCmd))
+			sb.WriteString(styles.Current().Code.Render(resumeCmd))
 			sb.WriteString("  ")
-			sb.WriteString(styles.Subtle.Render("[Y:copy]"))
+			sb.WriteString(styles.Current().Subtle.Render("[Y:copy]"))
 			sb.WriteString("\n")
 		}
 	}
@@ -805,7 +890,7 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 		if len(pageInfo) > contentWidth {
 			pageInfo = pageInfo[:contentWidth-3] + "..."
 		}
-		sb.WriteString(styles.StatusModified.Render(pageInfo))
+		sb.WriteString(styles.Current().StatusModified.Render(pageInfo))
 		sb.WriteString("\n")
 	}
 
@@ -813,7 +898,7 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 	if sepWidth > 60 {
 		sepWidth = 60
 	}
-	sb.WriteString(styles.Muted.Render(strings.Repeat("─", sepWidth)))
+	sb.WriteString(styles.Current().Muted.Render(strings.Repeat("─", sepWidth)))
 	sb.WriteString("\n")
 
 	contentHeight := height - 4 // Account for header lines
@@ -827,10 +912,12 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 
 	// Check for empty/loading state
 	if len(p.messages) == 0 && len(p.turns) == 0 {
-		if session != nil && session.MessageCount == 0 {
-			sb.WriteString(styles.Muted.Render("No messages (metadata only)"))
+		if !p.sessionSupports(session, adapter.CapMessages) {
+			sb.WriteString(styles.Current().Muted.Render(capabilityHint(session, adapter.CapMessages)))
+		} else if session != nil && session.MessageCount == 0 {
+			sb.WriteString(styles.Current().Muted.Render("No messages (metadata only)"))
 		} else {
-			sb.WriteString(styles.Muted.Render("Loading messages..."))
+			sb.WriteString(styles.Current().Muted.Render("Loading messages..."))
 		}
 		return sb.String()
 	}
@@ -838,7 +925,7 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 	if p.turnViewMode {
 		// Turn-based view (metadata-focused)
 		if len(p.turns) == 0 {
-			sb.WriteString(styles.Muted.Render("No turns"))
+			sb.WriteString(styles.Current().Muted.Render("No turns"))
 			return sb.String()
 		}
 		lineCount := 0
@@ -874,7 +961,7 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 	var sb strings.Builder
 
 	if p.detailTurn == nil {
-		return styles.Muted.Render("No turn selected")
+		return styles.Current().Muted.Render("No turn selected")
 	}
 
 	turn := p.detailTurn
@@ -898,9 +985,9 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 	if len(header) > contentWidth-10 {
 		header = header[:contentWidth-13] + "..."
 	}
-	sb.WriteString(styles.Title.Render(header))
+	sb.WriteString(styles.Current().Title.Render(header))
 	sb.WriteString("  ")
-	sb.WriteString(styles.Muted.Render("[esc]"))
+	sb.WriteString(styles.Current().Muted.Render("[esc]"))
 	sb.WriteString("\n")
 
 	// Stats line
@@ -919,7 +1006,7 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 		if len(statsLine) > contentWidth {
 			statsLine = statsLine[:contentWidth-3] + "..."
 		}
-		sb.WriteString(styles.Muted.Render(statsLine))
+		sb.WriteString(styles.Current().Muted.Render(statsLine))
 		sb.WriteString("\n")
 	}
 
@@ -928,7 +1015,7 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 	if sepWidth > 60 {
 		sepWidth = 60
 	}
-	sb.WriteString(styles.Muted.Render(strings.Repeat("─", sepWidth)))
+	sb.WriteString(styles.Current().Muted.Render(strings.Repeat("─", sepWidth)))
 	sb.WriteString("\n")
 
 	// Build content lines for all messages in turn
@@ -938,17 +1025,17 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 		// Message separator (except for first)
 		if msgIdx > 0 {
 			contentLines = append(contentLines, "")
-			contentLines = append(contentLines, styles.Muted.Render(fmt.Sprintf("── Message %d/%d ──", msgIdx+1, msgCount)))
+			contentLines = append(contentLines, styles.Current().Muted.Render(fmt.Sprintf("── Message %d/%d ──", msgIdx+1, msgCount)))
 			contentLines = append(contentLines, "")
 		}
 
 		// Thinking blocks
 		for i, tb := range msg.ThinkingBlocks {
-			contentLines = append(contentLines, styles.Code.Render(fmt.Sprintf("Thinking %d (%d tokens)", i+1, tb.TokenCount)))
+			contentLines = append(contentLines, styles.Current().Code.Render(fmt.Sprintf("Thinking %d (%d tokens)", i+1, tb.TokenCount)))
 			// Wrap thinking content
 			thinkingLines := wrapText(tb.Content, contentWidth-2)
 			for _, line := range thinkingLines {
-				contentLines = append(contentLines, styles.Muted.Render(line))
+				contentLines = append(contentLines, styles.Current().Muted.Render(line))
 			}
 			contentLines = append(contentLines, "")
 		}
@@ -961,9 +1048,17 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 			contentLines = append(contentLines, "")
 		}
 
+		// Image attachments
+		if images := collectImages([]adapter.Message{msg}); len(images) > 0 {
+			for _, b := range images {
+				contentLines = append(contentLines, styles.Current().Code.Render(imagePlaceholder(b)))
+			}
+			contentLines = append(contentLines, "")
+		}
+
 		// Tool uses
 		if len(msg.ToolUses) > 0 {
-			contentLines = append(contentLines, styles.Subtitle.Render("Tools:"))
+			contentLines = append(contentLines, styles.Current().Subtitle.Render("Tools:"))
 			for _, tu := range msg.ToolUses {
 				toolLine := tu.Name
 				if filePath := extractFilePath(tu.Input); filePath != "" {
@@ -972,7 +1067,7 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 				if len(toolLine) > contentWidth-2 {
 					toolLine = toolLine[:contentWidth-5] + "..."
 				}
-				contentLines = append(contentLines, styles.Code.Render("  "+toolLine))
+				contentLines = append(contentLines, styles.Current().Code.Render("  "+toolLine))
 			}
 			contentLines = append(contentLines, "")
 		}
@@ -1026,12 +1121,12 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 	// Scroll indicators (space already reserved)
 	if maxScroll > 0 {
 		if p.detailScroll > 0 {
-			sb.WriteString(styles.Muted.Render(fmt.Sprintf("↑ %d more above", p.detailScroll)))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("↑ %d more above", p.detailScroll)))
 			sb.WriteString("\n")
 		}
 		remaining := len(contentLines) - end
 		if remaining > 0 {
-			sb.WriteString(styles.Muted.Render(fmt.Sprintf("↓ %d more below", remaining)))
+			sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("↓ %d more below", remaining)))
 			sb.WriteString("\n")
 		}
 	}
@@ -1079,14 +1174,14 @@ func (p *Plugin) renderCompactTurn(turn Turn, turnIndex int, maxWidth int) []str
 		// For unselected: colored role badge with muted styling
 		var roleStyle lipgloss.Style
 		if turn.Role == "user" {
-			roleStyle = styles.StatusInProgress
+			roleStyle = styles.Current().StatusInProgress
 		} else {
-			roleStyle = styles.StatusStaged
+			roleStyle = styles.Current().StatusStaged
 		}
 		styledHeader := fmt.Sprintf("[%s] %s%s",
-			styles.Muted.Render(ts),
+			styles.Current().Muted.Render(ts),
 			roleStyle.Render(roleName),
-			styles.Muted.Render(statsStr))
+			styles.Current().Muted.Render(statsStr))
 		lines = append(lines, styledHeader)
 	}
 
@@ -1124,9 +1219,9 @@ func (p *Plugin) styleTurnLine(content string, selected bool, maxWidth int) stri
 		if len(content) < maxWidth {
 			content += strings.Repeat(" ", maxWidth-len(content))
 		}
-		return styles.ListItemSelected.Render(content)
+		return selectedItemStyle.Render(content)
 	}
-	return styles.Muted.Render(content)
+	return styles.Current().Muted.Render(content)
 }
 
 // renderConversationFlow renders messages as a scrollable chat thread (Claude Code web UI style).
@@ -1136,7 +1231,7 @@ func (p *Plugin) renderConversationFlow(contentWidth, height int) []string {
 	p.msgLinePositions = p.msgLinePositions[:0]
 
 	if len(p.messages) == 0 {
-		return []string{styles.Muted.Render("No messages")}
+		return []string{styles.Current().Muted.Render("No messages")}
 	}
 
 	var allLines []string
@@ -1156,7 +1251,7 @@ func (p *Plugin) renderConversationFlow(contentWidth, height int) []string {
 				sepWidth = 20
 			}
 			separator := strings.Repeat("─", sepWidth)
-			allLines = append(allLines, styles.Subtle.Render("  "+separator))
+			allLines = append(allLines, styles.Current().Subtle.Render("  "+separator))
 		}
 		prevRole = msg.Role
 
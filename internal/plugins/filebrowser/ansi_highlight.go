@@ -38,12 +38,6 @@ func (p *Plugin) highlightMarkdownLineMatches(lineNo int) string {
 	return injectHighlightsIntoANSI(ansiLine, ranges, p.contentSearchCursor)
 }
 
-// Pre-render highlight style prefixes by rendering a known marker and extracting the ANSI prefix.
-var (
-	searchMatchStyle        = styles.SearchMatch.Render
-	searchMatchCurrentStyle = styles.SearchMatchCurrent.Render
-)
-
 // injectHighlightsIntoANSI walks an ANSI-styled string and injects highlight
 // escape sequences at positions corresponding to visible-text byte offsets.
 func injectHighlightsIntoANSI(s string, matches []matchRange, currentMatchIdx int) string {
@@ -85,9 +79,9 @@ func injectHighlightsIntoANSI(s string, matches []matchRange, currentMatchIdx in
 		if !inHighlight && matchIdx < len(matches) && visiblePos == matches[matchIdx].start {
 			inHighlight = true
 			if matches[matchIdx].matchIdx == currentMatchIdx {
-				result.WriteString(extractANSIPrefix(searchMatchCurrentStyle))
+				result.WriteString(extractANSIPrefix(styles.Current().SearchMatchCurrent.Render))
 			} else {
-				result.WriteString(extractANSIPrefix(searchMatchStyle))
+				result.WriteString(extractANSIPrefix(styles.Current().SearchMatch.Render))
 			}
 		}
 
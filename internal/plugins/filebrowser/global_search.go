@@ -0,0 +1,43 @@
+package filebrowser
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+// globalSearchMaxFiles caps file hits returned to the app-wide search.
+const globalSearchMaxFiles = 20
+
+// GlobalSearch contributes fuzzy file path matches from the quick open index
+// to the app-wide search.
+func (p *Plugin) GlobalSearch(query string) func() []plugin.SearchResult {
+	if p.ctx == nil || strings.TrimSpace(query) == "" {
+		return nil
+	}
+	// Same cache as Ctrl+P
+	if len(p.quickOpenFiles) == 0 {
+		p.buildFileCache()
+	}
+	files := p.quickOpenFiles
+	return func() []plugin.SearchResult {
+		matches := FuzzyFilter(files, query, globalSearchMaxFiles)
+		results := make([]plugin.SearchResult, 0, len(matches))
+		for _, m := range matches {
+			dir := filepath.Dir(m.Path)
+			if dir == "." {
+				dir = ""
+			}
+			results = append(results, plugin.SearchResult{
+				Kind:     plugin.SearchKindFile,
+				Title:    m.Name,
+				Detail:   dir,
+				Score:    m.Score,
+				PluginID: pluginID,
+				Open:     NavigateToFileMsg{Path: m.Path},
+			})
+		}
+		return results
+	}
+}
@@ -341,9 +341,9 @@ func (p *Plugin) renderInlineEditorContent(visibleHeight int) string {
 	// Header with file being edited and exit hint
 	fileName := filepath.Base(p.inlineEditFile)
 	header := fmt.Sprintf("Editing: %s", fileName)
-	sb.WriteString(styles.Title.Render(header))
+	sb.WriteString(styles.Current().Title.Render(header))
 	sb.WriteString("  ")
-	sb.WriteString(styles.Muted.Render("(Ctrl+\\ or ESC ESC to exit)"))
+	sb.WriteString(styles.Current().Muted.Render("(Ctrl+\\ or ESC ESC to exit)"))
 	sb.WriteString("\n")
 
 	// Calculate content height (account for tab line and header)
@@ -383,12 +383,12 @@ func (p *Plugin) renderExitConfirmation(visibleHeight int) string {
 		sb.WriteString("\n")
 	}
 
-	sb.WriteString(styles.Title.Render("Exit editor?"))
+	sb.WriteString(styles.Current().Title.Render("Exit editor?"))
 	sb.WriteString("\n\n")
 
 	for i, opt := range options {
 		if i == p.exitConfirmSelection {
-			sb.WriteString(styles.ListItemSelected.Render("> " + opt))
+			sb.WriteString(selectedItemStyle.Render("> " + opt))
 		} else {
 			sb.WriteString("  " + opt)
 		}
@@ -396,7 +396,7 @@ func (p *Plugin) renderExitConfirmation(visibleHeight int) string {
 	}
 
 	sb.WriteString("\n")
-	sb.WriteString(styles.Muted.Render("[j/k to select, Enter to confirm, Esc to cancel]"))
+	sb.WriteString(styles.Current().Muted.Render("[j/k to select, Enter to confirm, Esc to cancel]"))
 
 	return sb.String()
 }
@@ -6,6 +6,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/mouse"
 	"github.com/wilbur182/forge/internal/msg"
+	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
 	"github.com/wilbur182/forge/internal/ui"
 )
@@ -420,9 +421,13 @@ func (p *Plugin) handleMouseDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 // handlePaneDividerDrag handles dragging the pane divider to resize.
 func (p *Plugin) handlePaneDividerDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 	startValue := p.mouseHandler.DragStartValue()
-	newWidth := startValue + action.DragDX
+	p.setTreeWidth(startValue + action.DragDX)
 
-	// Clamp to reasonable bounds (match calculatePaneWidths logic)
+	return p, nil
+}
+
+// setTreeWidth clamps and applies a tree pane width (match calculatePaneWidths logic).
+func (p *Plugin) setTreeWidth(newWidth int) {
 	available := p.width - 6 - dividerWidth
 	minWidth := 20
 	maxWidth := available - 40 // Leave at least 40 for preview
@@ -437,8 +442,26 @@ func (p *Plugin) handlePaneDividerDrag(action mouse.MouseAction) (*Plugin, tea.C
 
 	p.treeWidth = newWidth
 	p.previewWidth = available - p.treeWidth
+}
 
-	return p, nil
+// CanResizePane implements plugin.PaneResizer.
+func (p *Plugin) CanResizePane() bool {
+	if !p.treeVisible || p.width == 0 || p.ConsumesTextInput() {
+		return false
+	}
+	switch p.FocusContext() {
+	case "file-browser-tree", "file-browser-preview":
+		return true
+	}
+	return false
+}
+
+// ResizePane implements plugin.PaneResizer.
+func (p *Plugin) ResizePane(delta int) (int, tea.Cmd) {
+	available := p.width - 6 - dividerWidth
+	p.setTreeWidth(p.treeWidth + delta*plugin.PaneResizeStep(available))
+	_ = state.SetFileBrowserTreeWidth(p.treeWidth)
+	return p.treeWidth * 100 / max(available, 1), nil
 }
 
 // handlePreviewSelectionDrag handles drag-to-select in the preview pane.
@@ -1102,7 +1102,11 @@ func (p *Plugin) navigateToFile(path string) (plugin.Plugin, tea.Cmd) {
 	})
 
 	if targetNode == nil {
-		// File not found in tree, maybe it's new or ignored
+		// Tree not built yet (e.g. right after a worktree switch): open it
+		// once the first build lands. Otherwise the file is new or ignored.
+		if !p.stateRestored {
+			p.pendingOpenFile = path
+		}
 		return p, nil
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/wilbur182/forge/internal/mouse"
 	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
+	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/tty"
 	"github.com/wilbur182/forge/internal/ui"
 )
@@ -34,6 +35,10 @@ const (
 	dirCacheMaxResults = 5     // Max suggestions to show
 )
 
+// selectedItemStyle is the selected row style; its colors can be overridden
+// per plugin with ui.theme.pluginOverrides.
+var selectedItemStyle = styles.RegisterPluginStyle(pluginID, "selected", styles.SelectedItemStyle)
+
 // FileOpMode represents the current file operation mode.
 type FileOpMode int
 
@@ -166,9 +166,9 @@ func (p *Plugin) projectSearchOptionsSection() modal.Section {
 				x++
 			}
 
-			style := styles.BarChip
+			style := styles.Current().BarChip
 			if opt.active || opt.id == focusID || opt.id == hoverID {
-				style = styles.BarChipActive
+				style = styles.Current().BarChipActive
 			}
 
 			rendered := style.Render(opt.label)
@@ -232,21 +232,21 @@ func (p *Plugin) projectSearchResultsSection() modal.Section {
 		}
 
 		if state.IsSearching {
-			return modal.RenderedSection{Content: padToMinHeight(styles.Muted.Render("Searching..."))}
+			return modal.RenderedSection{Content: padToMinHeight(styles.Current().Muted.Render("Searching..."))}
 		}
 		if state.Error != "" {
-			return modal.RenderedSection{Content: padToMinHeight(styles.StatusDeleted.Render(state.Error))}
+			return modal.RenderedSection{Content: padToMinHeight(styles.Current().StatusDeleted.Render(state.Error))}
 		}
 		if len(state.Results) == 0 {
 			if state.Query != "" {
-				return modal.RenderedSection{Content: padToMinHeight(styles.Muted.Render("No matches found"))}
+				return modal.RenderedSection{Content: padToMinHeight(styles.Current().Muted.Render("No matches found"))}
 			}
-			return modal.RenderedSection{Content: padToMinHeight(styles.Muted.Render("Type to search project files..."))}
+			return modal.RenderedSection{Content: padToMinHeight(styles.Current().Muted.Render("Type to search project files..."))}
 		}
 
 		flatLen := state.FlatLen()
 		if flatLen == 0 {
-			return modal.RenderedSection{Content: padToMinHeight(styles.Muted.Render("No matches found"))}
+			return modal.RenderedSection{Content: padToMinHeight(styles.Current().Muted.Render("No matches found"))}
 		}
 
 		if state.Cursor >= state.ScrollOffset+maxVisible {
@@ -341,7 +341,7 @@ func (p *Plugin) projectSearchStatsSection() modal.Section {
 		}
 		stats := fmt.Sprintf("%d matches in %d files", state.TotalMatches(), state.FileCount())
 
-		return modal.RenderedSection{Content: styles.Muted.Render(position + stats)}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render(position + stats)}
 	}, nil)
 }
 
@@ -373,7 +373,7 @@ func (p *Plugin) renderProjectSearchHeader(width int) string {
 	}
 
 	header := fmt.Sprintf("%s%s%s", prefix, query, cursor)
-	return styles.ModalTitle.Render(header)
+	return styles.Current().ModalTitle.Render(header)
 }
 
 // renderSearchFileHeader renders a file header line.
@@ -398,13 +398,13 @@ func (p *Plugin) renderSearchFileHeader(file SearchFileResult, fileIdx int, sele
 		if len(plainLine) < width {
 			plainLine += strings.Repeat(" ", width-len(plainLine))
 		}
-		return styles.ListItemSelected.Render(plainLine)
+		return selectedItemStyle.Render(plainLine)
 	}
 
 	return fmt.Sprintf("%s%s%s",
-		styles.FileBrowserIcon.Render(icon),
-		styles.FileBrowserDir.Render(path),
-		styles.Muted.Render(matchCount),
+		styles.Current().FileBrowserIcon.Render(icon),
+		styles.Current().FileBrowserDir.Render(path),
+		styles.Current().Muted.Render(matchCount),
 	)
 }
 
@@ -452,7 +452,7 @@ func (p *Plugin) renderSearchMatchLine(match SearchMatch, matchIdx int, selected
 	highlightedLine := highlightMatchInLineRunes(lineText, hlStart, hlEnd)
 	return fmt.Sprintf("%s%s%s",
 		indent,
-		styles.FileBrowserLineNumber.Render(lineNum),
+		styles.Current().FileBrowserLineNumber.Render(lineNum),
 		highlightedLine,
 	)
 }
@@ -466,7 +466,7 @@ func highlightMatchInSelection(line string, matchStart, matchEnd int) string {
 		matchEnd = len(line)
 	}
 	if matchStart >= matchEnd || matchStart >= len(line) {
-		return styles.ListItemSelected.Render(line)
+		return selectedItemStyle.Render(line)
 	}
 
 	// Split the line and apply styles
@@ -474,9 +474,9 @@ func highlightMatchInSelection(line string, matchStart, matchEnd int) string {
 	match := line[matchStart:matchEnd]
 	after := line[matchEnd:]
 
-	return styles.ListItemSelected.Render(before) +
-		styles.SearchMatchCurrent.Render(match) +
-		styles.ListItemSelected.Render(after)
+	return selectedItemStyle.Render(before) +
+		styles.Current().SearchMatchCurrent.Render(match) +
+		selectedItemStyle.Render(after)
 }
 
 // highlightMatchInLineRunes applies highlighting using rune positions (safe for Unicode).
@@ -497,7 +497,7 @@ func highlightMatchInLineRunes(lineText string, runeStart, runeEnd int) string {
 	if runeStart > 0 {
 		result.WriteString(string(runes[:runeStart]))
 	}
-	result.WriteString(styles.SearchMatchCurrent.Render(string(runes[runeStart:runeEnd])))
+	result.WriteString(styles.Current().SearchMatchCurrent.Render(string(runes[runeStart:runeEnd])))
 	if runeEnd < len(runes) {
 		result.WriteString(string(runes[runeEnd:]))
 	}
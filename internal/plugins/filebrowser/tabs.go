@@ -404,7 +404,7 @@ func (p *Plugin) renderPreviewTabs(width int) string {
 	x := 0
 
 	if showLeft {
-		left := styles.Muted.Render("<")
+		left := styles.Current().Muted.Render("<")
 		tokens = append(tokens, left)
 		x += 1
 	}
@@ -423,7 +423,7 @@ func (p *Plugin) renderPreviewTabs(width int) string {
 		if len(tokens) > 0 {
 			tokens = append(tokens, " ")
 		}
-		right := styles.Muted.Render(">")
+		right := styles.Current().Muted.Render(">")
 		tokens = append(tokens, right)
 	}
 
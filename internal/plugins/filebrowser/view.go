@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/cellbuf"
+	"github.com/wilbur182/forge/internal/icons"
 	"github.com/wilbur182/forge/internal/image"
 	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/ui"
@@ -293,7 +294,7 @@ func (p *Plugin) renderContentSearchBar() string {
 	}
 
 	searchLine := fmt.Sprintf(" / %s%s%s", p.contentSearchQuery, cursor, matchInfo)
-	return styles.ModalTitle.Render(searchLine)
+	return styles.Current().ModalTitle.Render(searchLine)
 }
 
 // renderTreeSearchBar renders the tree search bar inline within the tree pane.
@@ -308,7 +309,7 @@ func (p *Plugin) renderTreeSearchBar() string {
 
 	searchLine := fmt.Sprintf("/%s%s%s", p.searchQuery, cursor, matchInfo)
 	// Use a subtle style that fits inside the pane
-	return styles.StatusInProgress.Render(searchLine)
+	return styles.Current().StatusInProgress.Render(searchLine)
 }
 
 // renderFileOpBar renders the file operation input bar (move/rename/create/delete).
@@ -344,10 +345,10 @@ func (p *Plugin) renderFileOpBar() string {
 	inputLine := fmt.Sprintf(" %s%s", prompt, p.fileOpTextInput.View())
 
 	var lines []string
-	lines = append(lines, styles.ModalTitle.Render(inputLine))
+	lines = append(lines, styles.Current().ModalTitle.Render(inputLine))
 
 	if p.fileOpError != "" {
-		lines = append(lines, styles.StatusDeleted.Render(" "+p.fileOpError))
+		lines = append(lines, styles.Current().StatusDeleted.Render(" "+p.fileOpError))
 	}
 
 	// Show suggestion dropdown for move mode
@@ -375,11 +376,11 @@ func (p *Plugin) renderFileOpConfirmation(message string) string {
 	// Yes button
 	yesBtn := "Yes"
 	if p.fileOpButtonHover == 1 {
-		sb.WriteString(styles.ButtonHover.Render(yesBtn))
+		sb.WriteString(styles.Current().ButtonHover.Render(yesBtn))
 	} else if p.fileOpButtonFocus == 1 {
-		sb.WriteString(styles.ButtonFocused.Render(yesBtn))
+		sb.WriteString(styles.Current().ButtonFocused.Render(yesBtn))
 	} else {
-		sb.WriteString(styles.Button.Render(yesBtn))
+		sb.WriteString(styles.Current().Button.Render(yesBtn))
 	}
 	yesWidth := len(yesBtn) + 4 // Padding adds 2 on each side
 	p.mouseHandler.HitMap.AddRect(regionFileOpConfirm, baseX, 0, yesWidth, 1, nil)
@@ -390,16 +391,16 @@ func (p *Plugin) renderFileOpConfirmation(message string) string {
 	// No button
 	noBtn := "No"
 	if p.fileOpButtonHover == 2 {
-		sb.WriteString(styles.ButtonHover.Render(noBtn))
+		sb.WriteString(styles.Current().ButtonHover.Render(noBtn))
 	} else if p.fileOpButtonFocus == 2 {
-		sb.WriteString(styles.ButtonFocused.Render(noBtn))
+		sb.WriteString(styles.Current().ButtonFocused.Render(noBtn))
 	} else {
-		sb.WriteString(styles.Button.Render(noBtn))
+		sb.WriteString(styles.Current().Button.Render(noBtn))
 	}
 	noWidth := len(noBtn) + 4
 	p.mouseHandler.HitMap.AddRect(regionFileOpCancel, baseX, 0, noWidth, 1, nil)
 
-	return styles.ModalTitle.Render(sb.String())
+	return styles.Current().ModalTitle.Render(sb.String())
 }
 
 // renderFileOpSuggestions renders the path suggestion dropdown.
@@ -409,9 +410,9 @@ func (p *Plugin) renderFileOpSuggestions() string {
 	for i, suggestion := range p.fileOpSuggestions {
 		line := " " + suggestion
 		if i == p.fileOpSuggestionIdx {
-			line = styles.ListItemSelected.Render(line)
+			line = selectedItemStyle.Render(line)
 		} else {
-			line = styles.Muted.Render(line)
+			line = styles.Current().Muted.Render(line)
 		}
 		lines = append(lines, line)
 
@@ -427,14 +428,14 @@ func (p *Plugin) renderTreePane(visibleHeight int) string {
 	var sb strings.Builder
 
 	// Header with sort mode and ignored indicator
-	header := styles.Title.Render("Files")
+	header := styles.Current().Title.Render("Files")
 	sb.WriteString(header)
 	if p.tree != nil {
 		sb.WriteString("  ")
-		sb.WriteString(styles.Muted.Render("[" + p.tree.SortMode.Label() + "]"))
+		sb.WriteString(styles.Current().Muted.Render("[" + p.tree.SortMode.Label() + "]"))
 		if !p.showIgnored {
 			sb.WriteString(" ")
-			sb.WriteString(styles.Muted.Render("[ignored: hidden]"))
+			sb.WriteString(styles.Current().Muted.Render("[ignored: hidden]"))
 		}
 	}
 	sb.WriteString("\n")
@@ -454,14 +455,14 @@ func (p *Plugin) renderTreePane(visibleHeight int) string {
 			return p.renderSearchResults(&sb, visibleHeight)
 		} else if p.searchQuery != "" {
 			// Show "no matches" when query exists but no results
-			sb.WriteString(styles.Muted.Render("No matching files"))
+			sb.WriteString(styles.Current().Muted.Render("No matching files"))
 			return sb.String()
 		}
 		// Empty query - fall through to show full tree
 	}
 
 	if p.tree == nil || p.tree.Len() == 0 {
-		sb.WriteString(styles.Muted.Render("No files"))
+		sb.WriteString(styles.Current().Muted.Render("No files"))
 		return sb.String()
 	}
 
@@ -532,13 +533,13 @@ func (p *Plugin) renderSearchResults(sb *strings.Builder, visibleHeight int) str
 			if len(displayPath) < maxWidth {
 				displayPath += strings.Repeat(" ", maxWidth-len(displayPath))
 			}
-			resultSB.WriteString(styles.ListItemSelected.Render(displayPath))
+			resultSB.WriteString(selectedItemStyle.Render(displayPath))
 		} else {
 			// Render with fuzzy match highlighting (all items are files from cache)
 			if len(match.MatchRanges) > 0 && len(match.Path) <= maxWidth-2 {
 				resultSB.WriteString(p.highlightFuzzyMatch(displayPath, match.MatchRanges))
 			} else {
-				resultSB.WriteString(styles.FileBrowserFile.Render(displayPath))
+				resultSB.WriteString(styles.Current().FileBrowserFile.Render(displayPath))
 			}
 		}
 
@@ -563,18 +564,18 @@ func (p *Plugin) renderTreeNode(node *FileNode, selected bool, maxWidth int) str
 	// Indentation
 	indent := strings.Repeat("  ", node.Depth)
 
-	// Icon for directories
-	icon := "  "
+	// Icon for directories and, with Nerd Font icons, file types
+	icon := icons.Current().FileIcon(node.Name) + " "
 	if node.IsDir {
 		if node.IsExpanded {
-			icon = "> "
+			icon = icons.Get(icons.DirOpen) + " "
 		} else {
-			icon = "+ "
+			icon = icons.Get(icons.DirClosed) + " "
 		}
 	}
 
 	// Calculate available width for name (after indent and icon)
-	prefixLen := len(indent) + len(icon)
+	prefixLen := len(indent) + lipgloss.Width(icon)
 	availableWidth := maxWidth - prefixLen
 	if availableWidth < 3 {
 		availableWidth = 3
@@ -589,23 +590,23 @@ func (p *Plugin) renderTreeNode(node *FileNode, selected bool, maxWidth int) str
 	// Name styling
 	var name string
 	if node.IsDir {
-		name = styles.FileBrowserDir.Render(displayName)
+		name = styles.Current().FileBrowserDir.Render(displayName)
 	} else if node.IsIgnored {
-		name = styles.FileBrowserIgnored.Render(displayName)
+		name = styles.Current().FileBrowserIgnored.Render(displayName)
 	} else {
-		name = styles.FileBrowserFile.Render(displayName)
+		name = styles.Current().FileBrowserFile.Render(displayName)
 	}
 
-	line := fmt.Sprintf("%s%s%s", indent, styles.FileBrowserIcon.Render(icon), name)
+	line := fmt.Sprintf("%s%s%s", indent, styles.Current().FileBrowserIcon.Render(icon), name)
 
 	if selected {
 		// Build plain text version for full-width highlight
 		plainLine := indent + icon + displayName
 		// Pad to full width
-		if len(plainLine) < maxWidth {
-			plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
+		if w := lipgloss.Width(plainLine); w < maxWidth {
+			plainLine += strings.Repeat(" ", maxWidth-w)
 		}
-		return styles.ListItemSelected.Render(plainLine)
+		return selectedItemStyle.Render(plainLine)
 	}
 	return line
 }
@@ -640,7 +641,7 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 			header += " [rendered]"
 		}
 	}
-	sb.WriteString(styles.Title.Render(header))
+	sb.WriteString(styles.Current().Title.Render(header))
 
 	// Metadata line (size, mod time, permissions)
 	if p.previewFile != "" && p.previewSize > 0 {
@@ -650,7 +651,7 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 			p.previewMode.String(),
 		)
 		sb.WriteString("  ")
-		sb.WriteString(styles.Muted.Render(meta))
+		sb.WriteString(styles.Current().Muted.Render(meta))
 	}
 	if tabLine == "" {
 		sb.WriteString("\n\n")
@@ -659,12 +660,12 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 	}
 
 	if p.previewFile == "" {
-		sb.WriteString(styles.Muted.Render("Select a file to preview"))
+		sb.WriteString(styles.Current().Muted.Render("Select a file to preview"))
 		return sb.String()
 	}
 
 	if p.previewError != nil {
-		sb.WriteString(styles.StatusDeleted.Render(p.previewError.Error()))
+		sb.WriteString(styles.Current().StatusDeleted.Render(p.previewError.Error()))
 		return sb.String()
 	}
 
@@ -675,7 +676,7 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 	}
 
 	if p.isBinary {
-		sb.WriteString(styles.Muted.Render("Binary file"))
+		sb.WriteString(styles.Current().Muted.Render("Binary file"))
 		return sb.String()
 	}
 
@@ -832,7 +833,7 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 					}
 					if showLineNumbers {
 						if wi == 0 {
-							lineNum := styles.FileBrowserLineNumber.Render(fmt.Sprintf("%4d ", i+1))
+							lineNum := styles.Current().FileBrowserLineNumber.Render(fmt.Sprintf("%4d ", i+1))
 							sb.WriteString(lineNum)
 						} else {
 							sb.WriteString(lineNumPad)
@@ -850,7 +851,7 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 
 				// Render with or without line numbers
 				if showLineNumbers {
-					lineNum := styles.FileBrowserLineNumber.Render(fmt.Sprintf("%4d ", i+1))
+					lineNum := styles.Current().FileBrowserLineNumber.Render(fmt.Sprintf("%4d ", i+1))
 					sb.WriteString(lineNum)
 				}
 				sb.WriteString(line)
@@ -867,7 +868,7 @@ func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 	}
 
 	if p.isTruncated {
-		sb.WriteString(styles.Muted.Render("... (file truncated)"))
+		sb.WriteString(styles.Current().Muted.Render("... (file truncated)"))
 	}
 
 	return sb.String()
@@ -1082,9 +1083,9 @@ func (p *Plugin) highlightLineMatches(lineNo int) string {
 		// Apply highlight style (current match vs other matches)
 		matchText := rawLine[m.startCol:m.endCol]
 		if m.matchIdx == p.contentSearchCursor {
-			result.WriteString(styles.SearchMatchCurrent.Render(matchText))
+			result.WriteString(styles.Current().SearchMatchCurrent.Render(matchText))
 		} else {
-			result.WriteString(styles.SearchMatch.Render(matchText))
+			result.WriteString(styles.Current().SearchMatch.Render(matchText))
 		}
 		lastEnd = m.endCol
 	}
@@ -1150,12 +1151,12 @@ func (p *Plugin) renderQuickOpenModalContent() string {
 	// Header with search input
 	cursor := "█"
 	header := fmt.Sprintf("Quick Open: %s%s", p.quickOpenQuery, cursor)
-	sb.WriteString(styles.ModalTitle.Render(header))
+	sb.WriteString(styles.Current().ModalTitle.Render(header))
 	sb.WriteString("\n\n")
 
 	// Error message if scan was limited
 	if p.quickOpenError != "" {
-		sb.WriteString(styles.Muted.Render("⚠ " + p.quickOpenError))
+		sb.WriteString(styles.Current().Muted.Render("⚠ " + p.quickOpenError))
 		sb.WriteString("\n")
 	}
 
@@ -1172,9 +1173,9 @@ func (p *Plugin) renderQuickOpenModalContent() string {
 
 	if len(p.quickOpenMatches) == 0 {
 		if p.quickOpenQuery != "" {
-			sb.WriteString(styles.Muted.Render("No matches"))
+			sb.WriteString(styles.Current().Muted.Render("No matches"))
 		} else {
-			sb.WriteString(styles.Muted.Render("Type to search files..."))
+			sb.WriteString(styles.Current().Muted.Render("Type to search files..."))
 		}
 	} else {
 		// Determine visible range (scroll if cursor out of view)
@@ -1204,9 +1205,9 @@ func (p *Plugin) renderQuickOpenModalContent() string {
 			line := p.renderQuickOpenMatch(match, modalWidth-4)
 
 			if isSelected {
-				sb.WriteString(styles.QuickOpenItemSelected.Render("> " + line))
+				sb.WriteString(styles.Current().QuickOpenItemSelected.Render("> " + line))
 			} else {
-				sb.WriteString(styles.QuickOpenItem.Render("  " + line))
+				sb.WriteString(styles.Current().QuickOpenItem.Render("  " + line))
 			}
 
 			if i < end-1 {
@@ -1217,14 +1218,14 @@ func (p *Plugin) renderQuickOpenModalContent() string {
 
 	// Footer with match count
 	if len(p.quickOpenMatches) > 0 {
-		sb.WriteString(fmt.Sprintf("\n\n%s", styles.Muted.Render(fmt.Sprintf("(%d/%d)", p.quickOpenCursor+1, len(p.quickOpenMatches)))))
+		sb.WriteString(fmt.Sprintf("\n\n%s", styles.Current().Muted.Render(fmt.Sprintf("(%d/%d)", p.quickOpenCursor+1, len(p.quickOpenMatches)))))
 	} else if len(p.quickOpenFiles) > 0 {
-		sb.WriteString(fmt.Sprintf("\n\n%s", styles.Muted.Render(fmt.Sprintf("(%d files)", len(p.quickOpenFiles)))))
+		sb.WriteString(fmt.Sprintf("\n\n%s", styles.Current().Muted.Render(fmt.Sprintf("(%d files)", len(p.quickOpenFiles)))))
 	}
 
 	// Wrap in modal box (centering handled by overlayModal)
 	content := sb.String()
-	return styles.ModalBox.
+	return styles.Current().ModalBox.
 		Width(modalWidth).
 		Render(content)
 }
@@ -1271,7 +1272,7 @@ func (p *Plugin) highlightFuzzyMatch(text string, ranges []MatchRange) string {
 		}
 
 		// Add highlighted match
-		result.WriteString(styles.FuzzyMatchChar.Render(text[r.Start:r.End]))
+		result.WriteString(styles.Current().FuzzyMatchChar.Render(text[r.Start:r.End]))
 		lastEnd = r.End
 	}
 
@@ -1301,7 +1302,7 @@ func (p *Plugin) renderImagePreview() string {
 	// Render image
 	result, err := p.imageRenderer.Render(fullPath, contentWidth, contentHeight)
 	if err != nil {
-		return styles.Muted.Render(fmt.Sprintf("Image error: %v", err))
+		return styles.Current().Muted.Render(fmt.Sprintf("Image error: %v", err))
 	}
 
 	// Cache result for resize detection
@@ -1320,9 +1321,9 @@ func (p *Plugin) renderImagePreview() string {
 		hint := "Preview in: " + image.SupportedTerminals()
 
 		return lipgloss.JoinVertical(lipgloss.Center,
-			styles.Muted.Render(msg),
+			styles.Current().Muted.Render(msg),
 			"",
-			styles.Muted.Render(hint),
+			styles.Current().Muted.Render(hint),
 		)
 	}
 
@@ -1333,5 +1334,5 @@ func (p *Plugin) renderImagePreview() string {
 func (p *Plugin) renderLineJumpBar() string {
 	cursor := "█"
 	inputLine := fmt.Sprintf(" :%s%s", p.lineJumpBuffer, cursor)
-	return styles.ModalTitle.Render(inputLine)
+	return styles.Current().ModalTitle.Render(inputLine)
 }
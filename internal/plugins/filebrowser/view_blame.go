@@ -95,7 +95,7 @@ func (p *Plugin) blameHeaderSection() modal.Section {
 // blameLoadingSection shows loading state.
 func (p *Plugin) blameLoadingSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		content := styles.Muted.Render("Loading blame data...")
+		content := styles.Current().Muted.Render("Loading blame data...")
 		return modal.RenderedSection{Content: content}
 	}, nil)
 }
@@ -106,7 +106,7 @@ func (p *Plugin) blameErrorSection() modal.Section {
 		if p.blameState == nil || p.blameState.Error == nil {
 			return modal.RenderedSection{}
 		}
-		content := styles.StatusDeleted.Render(fmt.Sprintf("Error: %v", p.blameState.Error))
+		content := styles.Current().StatusDeleted.Render(fmt.Sprintf("Error: %v", p.blameState.Error))
 		return modal.RenderedSection{Content: content}
 	}, nil)
 }
@@ -114,7 +114,7 @@ func (p *Plugin) blameErrorSection() modal.Section {
 // blameEmptySection shows empty state.
 func (p *Plugin) blameEmptySection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		content := styles.Muted.Render("No blame data available")
+		content := styles.Current().Muted.Render("No blame data available")
 		return modal.RenderedSection{Content: content}
 	}, nil)
 }
@@ -202,7 +202,7 @@ func (p *Plugin) renderBlameLine(line BlameLine, hashW, authorW, dateW, lineNoW,
 
 	// Style metadata with age color
 	metaStyle := lipgloss.NewStyle().Foreground(metaColor)
-	lineNoStyle := styles.FileBrowserLineNumber
+	lineNoStyle := styles.Current().FileBrowserLineNumber
 
 	// Build the line
 	var lineStr string
@@ -214,7 +214,7 @@ func (p *Plugin) renderBlameLine(line BlameLine, hashW, authorW, dateW, lineNoW,
 		if len(fullLine) < hashW+authorW+dateW+lineNoW+contentW+7 {
 			fullLine += strings.Repeat(" ", hashW+authorW+dateW+lineNoW+contentW+7-len(fullLine))
 		}
-		lineStr = styles.ListItemSelected.Render(fullLine)
+		lineStr = selectedItemStyle.Render(fullLine)
 	} else {
 		lineStr = fmt.Sprintf("%s %s %s %s | %s",
 			metaStyle.Render(hash),
@@ -231,26 +231,26 @@ func (p *Plugin) renderBlameLine(line BlameLine, hashW, authorW, dateW, lineNoW,
 // Recent commits are brighter, older commits are more muted.
 func getBlameAgeColor(commitTime time.Time) lipgloss.Color {
 	if commitTime.IsZero() {
-		return styles.TextMuted
+		return styles.Current().TextMuted
 	}
 
 	age := time.Since(commitTime)
 
 	switch {
 	case age < ageOneDay:
-		return styles.Success
+		return styles.Current().Success
 	case age < ageOneWeek:
-		return styles.BlameAge1
+		return styles.Current().BlameAge1
 	case age < ageOneMonth:
-		return styles.BlameAge2
+		return styles.Current().BlameAge2
 	case age < 3*ageOneMonth:
-		return styles.BlameAge3
+		return styles.Current().BlameAge3
 	case age < 6*ageOneMonth:
-		return styles.BlameAge4
+		return styles.Current().BlameAge4
 	case age < ageOneYear:
-		return styles.BlameAge5
+		return styles.Current().BlameAge5
 	default:
-		return styles.TextMuted
+		return styles.Current().TextMuted
 	}
 }
 
@@ -66,13 +66,13 @@ func (p *Plugin) infoModalDetailsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		path := p.infoTargetPath()
 		if path == "" {
-			return modal.RenderedSection{Content: styles.Muted.Render("No file selected")}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No file selected")}
 		}
 
 		fullPath := filepath.Join(p.ctx.WorkDir, path)
 		info, err := os.Stat(fullPath)
 		if err != nil {
-			return modal.RenderedSection{Content: styles.StatusDeleted.Render("Error reading file: " + err.Error())}
+			return modal.RenderedSection{Content: styles.Current().StatusDeleted.Render("Error reading file: " + err.Error())}
 		}
 
 		isDir := info.IsDir()
@@ -101,8 +101,8 @@ func (p *Plugin) infoModalDetailsSection() modal.Section {
 		modTime := info.ModTime().Format("Jan 2, 2006 at 15:04")
 		perms := info.Mode().String()
 
-		labelStyle := styles.Muted.Width(12).Align(lipgloss.Right).MarginRight(2)
-		valueStyle := lipgloss.NewStyle().Foreground(styles.TextPrimary)
+		labelStyle := styles.Current().Muted.Width(12).Align(lipgloss.Right).MarginRight(2)
+		valueStyle := lipgloss.NewStyle().Foreground(styles.Current().TextPrimary)
 
 		fields := []struct{ label, value string }{
 			{"Kind:", kind},
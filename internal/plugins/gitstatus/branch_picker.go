@@ -142,7 +142,7 @@ func (p *Plugin) branchPickerWidthForContent() int {
 func (p *Plugin) branchPickerListSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		if len(p.branches) == 0 {
-			return modal.RenderedSection{Content: styles.Muted.Render("  Loading branches...")}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("  Loading branches...")}
 		}
 
 		maxVisible := p.branchPickerMaxVisible()
@@ -181,7 +181,7 @@ func (p *Plugin) branchPickerListSection() modal.Section {
 
 		content := sb.String()
 		if len(p.branches) > maxVisible {
-			content += "\n\n" + styles.Muted.Render(fmt.Sprintf("  %d/%d branches", p.branchCursor+1, len(p.branches)))
+			content += "\n\n" + styles.Current().Muted.Render(fmt.Sprintf("  %d/%d branches", p.branchCursor+1, len(p.branches)))
 		}
 
 		return modal.RenderedSection{
@@ -217,7 +217,7 @@ func (p *Plugin) branchPickerListUpdate(msg tea.Msg, focusID string) (string, te
 
 func (p *Plugin) branchPickerHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		return modal.RenderedSection{Content: styles.Muted.Render("  Enter to switch, j/k to navigate, Esc to cancel")}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render("  Enter to switch, j/k to navigate, Esc to cancel")}
 	}, nil)
 }
 
@@ -302,13 +302,13 @@ func (p *Plugin) renderBranchLine(branch *Branch, selected, hovered bool) string
 	trackingInfo := branch.FormatTrackingInfo()
 	trackingInfoPlain := trackingInfo
 	if trackingInfo != "" {
-		trackingInfo = " " + styles.StatusModified.Render(trackingInfo)
+		trackingInfo = " " + styles.Current().StatusModified.Render(trackingInfo)
 	}
 
 	// Upstream indicator
 	upstream := ""
 	if branch.Upstream != "" {
-		upstream = styles.Muted.Render(" → " + branch.Upstream)
+		upstream = styles.Current().Muted.Render(" → " + branch.Upstream)
 	}
 
 	// Build plain line for selected/hovered states (need consistent width)
@@ -325,19 +325,19 @@ func (p *Plugin) renderBranchLine(branch *Branch, selected, hovered bool) string
 	}
 
 	if selected {
-		return styles.ListItemSelected.Render(buildPlainLine())
+		return styles.Current().ListItemSelected.Render(buildPlainLine())
 	}
 
 	if hovered {
 		// Use a hover style - slightly highlighted background
-		return styles.ListItemSelected.Render(buildPlainLine())
+		return styles.Current().ListItemSelected.Render(buildPlainLine())
 	}
 
 	// Style based on current branch
-	nameStyle := styles.Body
+	nameStyle := styles.Current().Body
 	if branch.IsCurrent {
-		nameStyle = styles.StatusStaged
+		nameStyle = styles.Current().StatusStaged
 	}
 
-	return styles.ListItemNormal.Render(fmt.Sprintf("%s%s%s%s", indicator, nameStyle.Render(name), trackingInfo, upstream))
+	return styles.Current().ListItemNormal.Render(fmt.Sprintf("%s%s%s%s", indicator, nameStyle.Render(name), trackingInfo, upstream))
 }
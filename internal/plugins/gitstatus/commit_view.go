@@ -73,13 +73,13 @@ func (p *Plugin) commitHeaderSection() modal.Section {
 		if p.commitAmend {
 			titleText = " Amend "
 		}
-		title := styles.Title.Render(titleText)
+		title := styles.Current().Title.Render(titleText)
 
 		statsStr := ""
 		if fileCount > 0 {
 			statsStr = fmt.Sprintf("[%d: +%d -%d]", fileCount, additions, deletions)
 		}
-		statsRendered := styles.Muted.Render(statsStr)
+		statsRendered := styles.Current().Muted.Render(statsStr)
 
 		padding := contentWidth - lipgloss.Width(title) - lipgloss.Width(statsStr)
 		if padding < 1 {
@@ -87,7 +87,7 @@ func (p *Plugin) commitHeaderSection() modal.Section {
 		}
 
 		line := title + strings.Repeat(" ", padding) + statsRendered
-		sep := styles.Muted.Render(strings.Repeat("─", contentWidth))
+		sep := styles.Current().Muted.Render(strings.Repeat("─", contentWidth))
 
 		return modal.RenderedSection{Content: line + "\n" + sep}
 	}, nil)
@@ -99,14 +99,14 @@ func (p *Plugin) commitStagedSection() modal.Section {
 
 		fileCount := len(p.tree.Staged)
 		if p.commitAmend && fileCount == 0 {
-			sb.WriteString(styles.Muted.Render("Message-only amend (no staged changes)"))
+			sb.WriteString(styles.Current().Muted.Render("Message-only amend (no staged changes)"))
 			return modal.RenderedSection{Content: sb.String()}
 		}
 
 		if p.commitAmend {
-			sb.WriteString(styles.StatusStaged.Render(fmt.Sprintf("Staged (%d) — will be added to amended commit", fileCount)))
+			sb.WriteString(styles.Current().StatusStaged.Render(fmt.Sprintf("Staged (%d) — will be added to amended commit", fileCount)))
 		} else {
-			sb.WriteString(styles.StatusStaged.Render(fmt.Sprintf("Staged (%d)", fileCount)))
+			sb.WriteString(styles.Current().StatusStaged.Render(fmt.Sprintf("Staged (%d)", fileCount)))
 		}
 		sb.WriteString("\n")
 
@@ -121,11 +121,11 @@ func (p *Plugin) commitStagedSection() modal.Section {
 		for i, entry := range p.tree.Staged {
 			if i >= maxFiles {
 				remaining := len(p.tree.Staged) - maxFiles
-				sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ... +%d more", remaining)))
+				sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ... +%d more", remaining)))
 				break
 			}
 
-			status := styles.StatusStaged.Render(string(entry.Status))
+			status := styles.Current().StatusStaged.Render(string(entry.Status))
 
 			path := entry.Path
 			maxPathWidth := contentWidth - 18
@@ -138,8 +138,8 @@ func (p *Plugin) commitStagedSection() modal.Section {
 
 			stats := ""
 			if entry.DiffStats.Additions > 0 || entry.DiffStats.Deletions > 0 {
-				addStr := styles.DiffAdd.Render(fmt.Sprintf("+%d", entry.DiffStats.Additions))
-				delStr := styles.DiffRemove.Render(fmt.Sprintf("-%d", entry.DiffStats.Deletions))
+				addStr := styles.Current().DiffAdd.Render(fmt.Sprintf("+%d", entry.DiffStats.Additions))
+				delStr := styles.Current().DiffRemove.Render(fmt.Sprintf("-%d", entry.DiffStats.Deletions))
 				stats = fmt.Sprintf(" %s %s", addStr, delStr)
 			}
 
@@ -157,14 +157,14 @@ func (p *Plugin) commitStatusSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		lines := make([]string, 0, 2)
 		if p.commitError != "" {
-			lines = append(lines, styles.StatusDeleted.Render("✗ "+p.commitError))
+			lines = append(lines, styles.Current().StatusDeleted.Render("✗ "+p.commitError))
 		}
 		if p.commitInProgress {
 			progressText := "Committing..."
 			if p.commitAmend {
 				progressText = "Amending..."
 			}
-			lines = append(lines, styles.Muted.Render(progressText))
+			lines = append(lines, styles.Current().Muted.Render(progressText))
 		}
 		return modal.RenderedSection{Content: strings.Join(lines, "\n")}
 	}, nil)
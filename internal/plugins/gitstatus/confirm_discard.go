@@ -30,9 +30,9 @@ func (p *Plugin) buildDiscardModal() {
 	// Determine warning message
 	var warningMsg string
 	if entry.Status == StatusUntracked {
-		warningMsg = styles.StatusDeleted.Render("This will permanently delete the file!")
+		warningMsg = styles.Current().StatusDeleted.Render("This will permanently delete the file!")
 	} else {
-		warningMsg = styles.Muted.Render("This will revert to the last committed state.")
+		warningMsg = styles.Current().Muted.Render("This will revert to the last committed state.")
 	}
 
 	// Calculate modal width based on path length
@@ -49,7 +49,7 @@ func (p *Plugin) buildDiscardModal() {
 		modal.WithWidth(modalWidth),
 	).
 		AddSection(modal.Text(fmt.Sprintf("Discard %s changes to:", statusLabel))).
-		AddSection(modal.Text(styles.Subtitle.Render(entry.Path))).
+		AddSection(modal.Text(styles.Current().Subtitle.Render(entry.Path))).
 		AddSection(modal.Spacer()).
 		AddSection(modal.Text(warningMsg)).
 		AddSection(modal.Spacer()).
@@ -34,15 +34,15 @@ func (p *Plugin) buildStashPopModal() {
 	}
 
 	sections := []modal.Section{
-		modal.Text(styles.Subtitle.Render(stash.Ref)),
+		modal.Text(styles.Current().Subtitle.Render(stash.Ref)),
 	}
 	if msg != "" {
-		sections = append(sections, modal.Text(styles.Muted.Render(msg)))
+		sections = append(sections, modal.Text(styles.Current().Muted.Render(msg)))
 	}
 	sections = append(sections,
 		modal.Spacer(),
-		modal.Text(lipgloss.NewStyle().Foreground(styles.Warning).Bold(true).Render("Warning: ")+"This may cause merge conflicts."),
-		modal.Text(styles.Muted.Render("The stash will be removed if successful.")),
+		modal.Text(lipgloss.NewStyle().Foreground(styles.Current().Warning).Bold(true).Render("Warning: ")+"This may cause merge conflicts."),
+		modal.Text(styles.Current().Muted.Render("The stash will be removed if successful.")),
 		modal.Spacer(),
 		modal.Buttons(
 			modal.Btn(" Pop ", "pop", modal.BtnDanger()),
@@ -307,6 +307,55 @@ func computeWordDiffs(hunk *Hunk) {
 	}
 }
 
+// DiffStrings returns the lines of a diff replacing oldText with newText,
+// such as an editor's search and replace. Leading and trailing lines both
+// share become context; changed lines are paired in order for word-level
+// highlighting.
+func DiffStrings(oldText, newText string) []DiffLine {
+	oldLines := strings.Split(oldText, "\n")
+	newLines := strings.Split(newText, "\n")
+
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+	removed := oldLines[prefix : len(oldLines)-suffix]
+	added := newLines[prefix : len(newLines)-suffix]
+
+	lines := make([]DiffLine, 0, len(oldLines)+len(added))
+	for i, l := range oldLines[:prefix] {
+		lines = append(lines, DiffLine{Type: LineContext, OldLineNo: i + 1, NewLineNo: i + 1, Content: l})
+	}
+	for i, l := range removed {
+		line := DiffLine{Type: LineRemove, OldLineNo: prefix + i + 1, Content: l}
+		if i < len(added) {
+			line.WordDiff = computeWordSegments(tokenize(l), tokenize(added[i]), false)
+		}
+		lines = append(lines, line)
+	}
+	for i, l := range added {
+		line := DiffLine{Type: LineAdd, NewLineNo: prefix + i + 1, Content: l}
+		if i < len(removed) {
+			line.WordDiff = computeWordSegments(tokenize(l), tokenize(removed[i]), true)
+		}
+		lines = append(lines, line)
+	}
+	for i, l := range oldLines[len(oldLines)-suffix:] {
+		lines = append(lines, DiffLine{
+			Type:      LineContext,
+			OldLineNo: len(oldLines) - suffix + i + 1,
+			NewLineNo: len(newLines) - suffix + i + 1,
+			Content:   l,
+		})
+	}
+	return lines
+}
+
 // tokenize splits a line into words and whitespace tokens.
 func tokenize(s string) []string {
 	var tokens []string
@@ -187,6 +187,32 @@ func TestTokenize(t *testing.T) {
 	}
 }
 
+func TestDiffStrings(t *testing.T) {
+	lines := DiffStrings("func a() {\n\treturn 1\n}", "func a() {\n\treturn 2\n}")
+	wantTypes := []LineType{LineContext, LineRemove, LineAdd, LineContext}
+	if len(lines) != len(wantTypes) {
+		t.Fatalf("got %d lines, want %d: %+v", len(lines), len(wantTypes), lines)
+	}
+	for i, want := range wantTypes {
+		if lines[i].Type != want {
+			t.Errorf("line %d type = %v, want %v", i, lines[i].Type, want)
+		}
+	}
+	if lines[3].OldLineNo != 3 || lines[3].NewLineNo != 3 {
+		t.Errorf("trailing context line numbers = %d,%d, want 3,3", lines[3].OldLineNo, lines[3].NewLineNo)
+	}
+
+	var changed []string
+	for _, seg := range lines[2].WordDiff {
+		if seg.IsChange {
+			changed = append(changed, seg.Text)
+		}
+	}
+	if len(changed) != 1 || changed[0] != "2" {
+		t.Errorf("changed tokens = %q, want [\"2\"]", changed)
+	}
+}
+
 func TestParsedDiff_TotalLines(t *testing.T) {
 	// No trailing newline
 	diff := `--- a/file.txt
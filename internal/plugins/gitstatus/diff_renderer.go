@@ -16,31 +16,25 @@ const (
 	DiffViewSideBySide                     // Side-by-side split view
 )
 
-// Additional styles for enhanced diff rendering
-var (
-	wordDiffAddStyle = lipgloss.NewStyle().
-				Foreground(styles.Success).
-				Background(styles.DiffAddBg).
-				Bold(true)
-
-	wordDiffRemoveStyle = lipgloss.NewStyle().
-				Foreground(styles.Error).
-				Background(styles.DiffRemoveBg).
-				Bold(true)
-
-	hunkHeaderStyle = lipgloss.NewStyle().
-			Foreground(styles.Info).
-			Background(styles.BgSecondary).
-			Bold(true)
-
-	sideBySideBorder = lipgloss.NewStyle().
-				Foreground(styles.BorderNormal)
-
-	fileHeaderStyle = lipgloss.NewStyle().
-			Foreground(styles.TextPrimary).
-			Background(styles.BgTertiary).
-			Bold(true)
-)
+// Additional styles for enhanced diff rendering. Built per render so theme
+// switches apply.
+func hunkHeaderStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(styles.Current().Info).
+		Background(styles.Current().BgSecondary).
+		Bold(true)
+}
+
+func sideBySideBorder() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Current().BorderNormal)
+}
+
+func fileHeaderStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(styles.Current().TextPrimary).
+		Background(styles.Current().BgTertiary).
+		Bold(true)
+}
 
 // RenderLineDiff renders a parsed diff in unified line-by-line format with line numbers.
 // horizontalOffset scrolls the content horizontally (0 = no scroll).
@@ -49,9 +43,9 @@ var (
 func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled bool) string {
 	if diff == nil || diff.Binary {
 		if diff != nil && diff.Binary {
-			return styles.Muted.Render(" Binary file differs")
+			return styles.Current().Muted.Render(" Binary file differs")
 		}
-		return styles.Muted.Render(" No diff content")
+		return styles.Current().Muted.Render(" No diff content")
 	}
 
 	var sb strings.Builder
@@ -66,7 +60,7 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 	}
 
 	lineNoStyle := lipgloss.NewStyle().
-		Foreground(styles.TextMuted).
+		Foreground(styles.Current().TextMuted).
 		Width(lineNoWidth).
 		Align(lipgloss.Right)
 
@@ -86,7 +80,7 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 				// Render hunk header
 				header := truncateLine(fmt.Sprintf("@@ -%d,%d +%d,%d @@%s",
 					hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount, hunk.Header), contentWidth)
-				sb.WriteString(hunkHeaderStyle.Render(header))
+				sb.WriteString(hunkHeaderStyle().Render(header))
 				sb.WriteString("\n")
 				rendered++
 				isFirstHunk = false
@@ -100,7 +94,7 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 			// Render hunk header
 			header := truncateLine(fmt.Sprintf("@@ -%d,%d +%d,%d @@%s",
 				hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount, hunk.Header), contentWidth)
-			sb.WriteString(hunkHeaderStyle.Render(header))
+			sb.WriteString(hunkHeaderStyle().Render(header))
 			sb.WriteString("\n")
 			rendered++
 			isFirstHunk = false
@@ -179,9 +173,9 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled bool) string {
 	if diff == nil || diff.Binary {
 		if diff != nil && diff.Binary {
-			return styles.Muted.Render(" Binary file differs")
+			return styles.Current().Muted.Render(" Binary file differs")
 		}
-		return styles.Muted.Render(" No diff content")
+		return styles.Current().Muted.Render(" No diff content")
 	}
 
 	var sb strings.Builder
@@ -194,7 +188,7 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 	contentWidth := panelWidth - lineNoWidth - 2
 
 	lineNoStyle := lipgloss.NewStyle().
-		Foreground(styles.TextMuted).
+		Foreground(styles.Current().TextMuted).
 		Width(lineNoWidth).
 		Align(lipgloss.Right)
 
@@ -213,7 +207,7 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 			}
 			header := fmt.Sprintf("@@ -%d,%d +%d,%d @@",
 				hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount)
-			sb.WriteString(hunkHeaderStyle.Render(padRight(header, width-1)))
+			sb.WriteString(hunkHeaderStyle().Render(padRight(header, width-1)))
 			sb.WriteString("\n")
 			rendered++
 			isFirstHunk = false
@@ -241,10 +235,10 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 				}
 				if wrapEnabled {
 					// Pass large width to avoid premature truncation; caller wraps via lipgloss.Width()
-					leftRendered = renderSideBySideContent(pair.left.Content, pair.left.Type, contentWidth*10, highlighter)
+					leftRendered = renderSideBySidePairContent(pair.left, contentWidth*10, highlighter)
 				} else {
 					// Highlight full content first to preserve syntax context, then apply offset
-					leftRendered = renderSideBySideContent(pair.left.Content, pair.left.Type, contentWidth+horizontalOffset, highlighter)
+					leftRendered = renderSideBySidePairContent(pair.left, contentWidth+horizontalOffset, highlighter)
 					if horizontalOffset > 0 {
 						leftRendered = truncateLeftCached(leftRendered, horizontalOffset)
 					}
@@ -260,10 +254,10 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 				}
 				if wrapEnabled {
 					// Pass large width to avoid premature truncation; caller wraps via lipgloss.Width()
-					rightRendered = renderSideBySideContent(pair.right.Content, pair.right.Type, contentWidth*10, highlighter)
+					rightRendered = renderSideBySidePairContent(pair.right, contentWidth*10, highlighter)
 				} else {
 					// Highlight full content first to preserve syntax context, then apply offset
-					rightRendered = renderSideBySideContent(pair.right.Content, pair.right.Type, contentWidth+horizontalOffset, highlighter)
+					rightRendered = renderSideBySidePairContent(pair.right, contentWidth+horizontalOffset, highlighter)
 					if horizontalOffset > 0 {
 						rightRendered = truncateLeftCached(rightRendered, horizontalOffset)
 					}
@@ -282,7 +276,7 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 					maxH = len(rightLines)
 				}
 				lineNoPad := strings.Repeat(" ", lineNoWidth)
-				sep := sideBySideBorder.Render(" │ ")
+				sep := sideBySideBorder().Render(" │ ")
 				for vi := 0; vi < maxH; vi++ {
 					if rendered >= maxLines {
 						break
@@ -322,7 +316,7 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 					rightRendered)
 
 				sb.WriteString(leftPanel)
-				sb.WriteString(sideBySideBorder.Render(" │ "))
+				sb.WriteString(sideBySideBorder().Render(" │ "))
 				sb.WriteString(rightPanel)
 				sb.WriteString("\n")
 				rendered++
@@ -415,35 +409,22 @@ func renderDiffContent(line DiffLine, maxWidth int, highlighter *SyntaxHighlight
 	var baseStyle lipgloss.Style
 	switch line.Type {
 	case LineAdd:
-		baseStyle = styles.DiffAdd
+		baseStyle = styles.Current().DiffAdd
 	case LineRemove:
-		baseStyle = styles.DiffRemove
+		baseStyle = styles.Current().DiffRemove
 	default:
-		baseStyle = styles.DiffContext
+		baseStyle = styles.Current().DiffContext
 	}
 
 	// If we have word diff data, use it (word diff takes priority over syntax)
 	if len(line.WordDiff) > 0 {
-		var sb strings.Builder
-		for _, segment := range line.WordDiff {
-			if segment.IsChange {
-				if line.Type == LineAdd {
-					sb.WriteString(wordDiffAddStyle.Render(segment.Text))
-				} else {
-					sb.WriteString(wordDiffRemoveStyle.Render(segment.Text))
-				}
-			} else {
-				sb.WriteString(baseStyle.Render(segment.Text))
-			}
-		}
-		content := sb.String()
 		// Truncate if needed (accounting for ANSI codes is complex, so just truncate raw)
 		if lipgloss.Width(line.Content) > maxWidth && maxWidth > 3 {
 			// Re-render truncated
 			truncated := truncateLine(line.Content, maxWidth)
 			return baseStyle.Render(truncated)
 		}
-		return content
+		return renderWordDiff(line.WordDiff, line.Type)
 	}
 
 	// Apply syntax highlighting if available
@@ -474,24 +455,66 @@ func renderDiffContent(line DiffLine, maxWidth int, highlighter *SyntaxHighlight
 	style := baseStyle
 	switch line.Type {
 	case LineAdd:
-		style = style.Background(styles.DiffAddBg)
+		style = style.Background(styles.Current().DiffAddBg)
 	case LineRemove:
-		style = style.Background(styles.DiffRemoveBg)
+		style = style.Background(styles.Current().DiffRemoveBg)
 	}
 	return style.Render(content)
 }
 
+// RenderDiffLine renders a single diff line with its +/- marker and
+// word-level highlighting, for callers outside the diff viewer.
+func RenderDiffLine(line DiffLine, maxWidth int) string {
+	marker := " "
+	switch line.Type {
+	case LineAdd:
+		marker = styles.Current().DiffAdd.Render("+")
+	case LineRemove:
+		marker = styles.Current().DiffRemove.Render("-")
+	}
+	return marker + renderDiffContent(line, maxWidth-1, nil)
+}
+
+// renderWordDiff renders a line's word segments. Changed tokens use the
+// word-level diff style; the rest keep the line's diff style and background.
+func renderWordDiff(segments []WordSegment, lineType LineType) string {
+	snap := styles.Current()
+	base, word := snap.DiffAdd.Background(snap.DiffAddBg), snap.DiffAddWord
+	if lineType == LineRemove {
+		base, word = snap.DiffRemove.Background(snap.DiffRemoveBg), snap.DiffRemoveWord
+	}
+
+	var sb strings.Builder
+	for _, segment := range segments {
+		if segment.IsChange {
+			sb.WriteString(word.Render(segment.Text))
+		} else {
+			sb.WriteString(base.Render(segment.Text))
+		}
+	}
+	return sb.String()
+}
+
+// renderSideBySidePairContent renders one side of a side-by-side pair,
+// using word-level highlighting when the line has it and fits.
+func renderSideBySidePairContent(line *DiffLine, maxWidth int, highlighter *SyntaxHighlighter) string {
+	if len(line.WordDiff) > 0 && lipgloss.Width(line.Content) <= maxWidth {
+		return renderWordDiff(line.WordDiff, line.Type)
+	}
+	return renderSideBySideContent(line.Content, line.Type, maxWidth, highlighter)
+}
+
 // renderSideBySideContent renders content for side-by-side view with syntax highlighting.
 // Returns styled content that should then be padded with padToWidth for alignment.
 func renderSideBySideContent(content string, lineType LineType, maxWidth int, highlighter *SyntaxHighlighter) string {
 	var baseStyle lipgloss.Style
 	switch lineType {
 	case LineAdd:
-		baseStyle = styles.DiffAdd
+		baseStyle = styles.Current().DiffAdd
 	case LineRemove:
-		baseStyle = styles.DiffRemove
+		baseStyle = styles.Current().DiffRemove
 	default:
-		baseStyle = styles.DiffContext
+		baseStyle = styles.Current().DiffContext
 	}
 
 	// Truncate content first to fit maxWidth
@@ -511,9 +534,9 @@ func renderSideBySideContent(content string, lineType LineType, maxWidth int, hi
 	style := baseStyle
 	switch lineType {
 	case LineAdd:
-		style = style.Background(styles.DiffAddBg)
+		style = style.Background(styles.Current().DiffAddBg)
 	case LineRemove:
-		style = style.Background(styles.DiffRemoveBg)
+		style = style.Background(styles.Current().DiffRemoveBg)
 	}
 	return style.MaxWidth(maxWidth).Render(content)
 }
@@ -524,11 +547,11 @@ func renderSyntaxHighlighted(content string, lineType LineType, highlighter *Syn
 	getBaseStyle := func() lipgloss.Style {
 		switch lineType {
 		case LineAdd:
-			return styles.DiffAdd.Background(styles.DiffAddBg)
+			return styles.Current().DiffAdd.Background(styles.Current().DiffAddBg)
 		case LineRemove:
-			return styles.DiffRemove.Background(styles.DiffRemoveBg)
+			return styles.Current().DiffRemove.Background(styles.Current().DiffRemoveBg)
 		default:
-			return styles.DiffContext
+			return styles.Current().DiffContext
 		}
 	}
 
@@ -557,16 +580,16 @@ func blendSyntaxWithDiff(syntaxStyle lipgloss.Style, lineType LineType) lipgloss
 	switch lineType {
 	case LineAdd:
 		// Keep syntax foreground, add green background for diff indication
-		return syntaxStyle.Background(styles.DiffAddBg)
+		return syntaxStyle.Background(styles.Current().DiffAddBg)
 	case LineRemove:
 		// Keep syntax foreground, add red background for diff indication
-		return syntaxStyle.Background(styles.DiffRemoveBg)
+		return syntaxStyle.Background(styles.Current().DiffRemoveBg)
 	default:
 		// Context lines: use syntax color if available, otherwise muted
 		fg := syntaxStyle.GetForeground()
 		_, isNoColor := fg.(lipgloss.NoColor)
 		if isNoColor {
-			return styles.DiffContext
+			return styles.Current().DiffContext
 		}
 		return syntaxStyle
 	}
@@ -665,14 +688,14 @@ func RenderFileHeader(filename, stats string, width int) string {
 	fill := strings.Repeat("─", fillWidth)
 
 	header := prefix + filename + suffix + fill
-	return fileHeaderStyle.Width(width).Render(header)
+	return fileHeaderStyle().Width(width).Render(header)
 }
 
 // RenderMultiFileDiff renders a multi-file diff with file headers.
 // Returns the rendered content and updates file position info.
 func RenderMultiFileDiff(mfd *MultiFileDiff, mode DiffViewMode, width, startLine, maxLines, horizontalOffset int, wrapEnabled bool) string {
 	if mfd == nil || len(mfd.Files) == 0 {
-		return styles.Muted.Render(" No diff content")
+		return styles.Current().Muted.Render(" No diff content")
 	}
 
 	var sb strings.Builder
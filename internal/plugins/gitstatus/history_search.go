@@ -122,30 +122,30 @@ func (p *Plugin) renderHistorySearchModal(width int) string {
 	}
 
 	header := prefix + query + cursor
-	sb.WriteString(styles.ModalTitle.Render(header))
+	sb.WriteString(styles.Current().ModalTitle.Render(header))
 	sb.WriteString("\n")
 
 	// Options bar
 	var opts []string
 	if state.UseRegex {
-		opts = append(opts, styles.BarChipActive.Render(".*"))
+		opts = append(opts, styles.Current().BarChipActive.Render(".*"))
 	} else {
-		opts = append(opts, styles.BarChip.Render(".*"))
+		opts = append(opts, styles.Current().BarChip.Render(".*"))
 	}
 	if state.CaseSensitive {
-		opts = append(opts, styles.BarChipActive.Render("Aa"))
+		opts = append(opts, styles.Current().BarChipActive.Render("Aa"))
 	} else {
-		opts = append(opts, styles.BarChip.Render("Aa"))
+		opts = append(opts, styles.Current().BarChip.Render("Aa"))
 	}
 	sb.WriteString(strings.Join(opts, " "))
 	sb.WriteString("\n\n")
 
 	// Status line
 	if state.Query == "" {
-		sb.WriteString(styles.Muted.Render("Type to search commits..."))
+		sb.WriteString(styles.Current().Muted.Render("Type to search commits..."))
 		sb.WriteString("\n")
 	} else if len(state.Matches) == 0 {
-		sb.WriteString(styles.Muted.Render("No matches found"))
+		sb.WriteString(styles.Current().Muted.Render("No matches found"))
 		sb.WriteString("\n")
 	} else {
 		// Match count header
@@ -155,7 +155,7 @@ func (p *Plugin) renderHistorySearchModal(width int) string {
 		} else {
 			matchInfo = formatInt(len(state.Matches)) + " matches"
 		}
-		sb.WriteString(styles.Muted.Render(matchInfo))
+		sb.WriteString(styles.Current().Muted.Render(matchInfo))
 		sb.WriteString("\n\n")
 
 		// Display matches (up to 8)
@@ -175,13 +175,13 @@ func (p *Plugin) renderHistorySearchModal(width int) string {
 
 			// Cursor indicator
 			if i == state.Cursor {
-				sb.WriteString(styles.ListCursor.Render("▸ "))
+				sb.WriteString(styles.Current().ListCursor.Render("▸ "))
 			} else {
 				sb.WriteString("  ")
 			}
 
 			// Short hash (muted)
-			sb.WriteString(styles.Subtle.Render(c.ShortHash))
+			sb.WriteString(styles.Current().Subtle.Render(c.ShortHash))
 			sb.WriteString(" ")
 
 			// Subject (truncate to fit)
@@ -191,7 +191,7 @@ func (p *Plugin) renderHistorySearchModal(width int) string {
 				subject = subject[:subjectWidth-3] + "..."
 			}
 			if i == state.Cursor {
-				sb.WriteString(styles.ListItemSelected.Render(subject))
+				sb.WriteString(styles.Current().ListItemSelected.Render(subject))
 			} else {
 				sb.WriteString(subject)
 			}
@@ -202,7 +202,7 @@ func (p *Plugin) renderHistorySearchModal(width int) string {
 		if len(state.Matches) > maxVisible {
 			remaining := len(state.Matches) - scrollOff - maxVisible
 			if remaining > 0 {
-				sb.WriteString(styles.Muted.Render("  ↓ " + formatInt(remaining) + " more"))
+				sb.WriteString(styles.Current().Muted.Render("  ↓ " + formatInt(remaining) + " more"))
 				sb.WriteString("\n")
 			}
 		}
@@ -210,10 +210,10 @@ func (p *Plugin) renderHistorySearchModal(width int) string {
 
 	sb.WriteString("\n")
 	// Hint
-	sb.WriteString(styles.Muted.Render("j/k nav · enter select · alt+r regex · esc cancel"))
+	sb.WriteString(styles.Current().Muted.Render("j/k nav · enter select · alt+r regex · esc cancel"))
 
 	content := sb.String()
-	return styles.ModalBox.Width(modalWidth).Render(content)
+	return styles.Current().ModalBox.Width(modalWidth).Render(content)
 }
 
 // formatInt converts int to string without importing strconv in view logic.
@@ -374,7 +374,7 @@ func (p *Plugin) renderPathFilterModal(width int) string {
 	var sb strings.Builder
 
 	// Title
-	sb.WriteString(styles.ModalTitle.Render("Filter by Path"))
+	sb.WriteString(styles.Current().ModalTitle.Render("Filter by Path"))
 	sb.WriteString("\n\n")
 
 	// Input with cursor
@@ -391,12 +391,12 @@ func (p *Plugin) renderPathFilterModal(width int) string {
 	sb.WriteString("\n\n")
 
 	// Hint
-	sb.WriteString(styles.Muted.Render("Examples: *.go, internal/, README.md"))
+	sb.WriteString(styles.Current().Muted.Render("Examples: *.go, internal/, README.md"))
 	sb.WriteString("\n")
-	sb.WriteString(styles.Muted.Render("enter apply · esc cancel"))
+	sb.WriteString(styles.Current().Muted.Render("enter apply · esc cancel"))
 
 	content := sb.String()
-	return styles.ModalBox.Width(modalWidth).Render(content)
+	return styles.Current().ModalBox.Width(modalWidth).Render(content)
 }
 
 // jumpToSearchMatch moves cursor to the current search match.
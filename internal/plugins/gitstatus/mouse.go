@@ -3,6 +3,7 @@ package gitstatus
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
 )
 
@@ -335,30 +336,46 @@ func (p *Plugin) handleMouseDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 	if p.mouseHandler.DragRegion() == regionPaneDivider {
 		// Calculate new sidebar width based on drag
 		startValue := p.mouseHandler.DragStartValue()
-		newWidth := startValue + action.DragDX
-
-		// Clamp to reasonable bounds (match calculatePaneWidths logic)
-		available := p.width - 5 - dividerWidth
-		minWidth := 25
-		maxWidth := available - 40 // Leave at least 40 for diff
-		if maxWidth < minWidth {
-			maxWidth = minWidth
-		}
-		if newWidth < minWidth {
-			newWidth = minWidth
-		}
-		if newWidth > maxWidth {
-			newWidth = maxWidth
-		}
-
-		p.sidebarWidth = newWidth
-		p.diffPaneWidth = available - p.sidebarWidth
+		p.setSidebarWidth(startValue + action.DragDX)
 		return p, nil
 	}
 
 	return p, nil
 }
 
+// setSidebarWidth clamps and applies a sidebar width (match calculatePaneWidths logic).
+func (p *Plugin) setSidebarWidth(newWidth int) {
+	available := p.width - 5 - dividerWidth
+	minWidth := 25
+	maxWidth := available - 40 // Leave at least 40 for diff
+	if maxWidth < minWidth {
+		maxWidth = minWidth
+	}
+	if newWidth < minWidth {
+		newWidth = minWidth
+	}
+	if newWidth > maxWidth {
+		newWidth = maxWidth
+	}
+
+	p.sidebarWidth = newWidth
+	p.diffPaneWidth = available - p.sidebarWidth
+}
+
+// CanResizePane implements plugin.PaneResizer.
+func (p *Plugin) CanResizePane() bool {
+	return p.viewMode == ViewModeStatus && p.sidebarVisible && p.width > 0 &&
+		!p.inNoRepoMode() && !p.ConsumesTextInput()
+}
+
+// ResizePane implements plugin.PaneResizer.
+func (p *Plugin) ResizePane(delta int) (int, tea.Cmd) {
+	available := p.width - 5 - dividerWidth
+	p.setSidebarWidth(p.sidebarWidth + delta*plugin.PaneResizeStep(available))
+	_ = state.SetGitStatusSidebarWidth(p.sidebarWidth)
+	return p.sidebarWidth * 100 / max(available, 1), nil
+}
+
 // handleMouseDragEnd handles the end of a drag operation (saves pane width).
 func (p *Plugin) handleMouseDragEnd() (*Plugin, tea.Cmd) {
 	// Save the current sidebar width to state
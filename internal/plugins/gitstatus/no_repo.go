@@ -110,20 +110,20 @@ func (p *Plugin) renderNoRepoView() string {
 	}
 
 	var sb strings.Builder
-	sb.WriteString(styles.Title.Render("Git"))
+	sb.WriteString(styles.Current().Title.Render("Git"))
 	sb.WriteString("\n\n")
-	sb.WriteString(styles.Muted.Render("No git repository found in this project."))
+	sb.WriteString(styles.Current().Muted.Render("No git repository found in this project."))
 	sb.WriteString("\n")
 	if p.repoInitInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Initializing repository..."))
+		sb.WriteString(styles.Current().StatusInProgress.Render("Initializing repository..."))
 	} else {
-		sb.WriteString(styles.Muted.Render("Press "))
-		sb.WriteString(styles.Title.Render("i"))
-		sb.WriteString(styles.Muted.Render(" to initialize one."))
+		sb.WriteString(styles.Current().Muted.Render("Press "))
+		sb.WriteString(styles.Current().Title.Render("i"))
+		sb.WriteString(styles.Current().Muted.Render(" to initialize one."))
 		sb.WriteString("\n")
-		sb.WriteString(styles.Muted.Render("Press "))
-		sb.WriteString(styles.Title.Render("r"))
-		sb.WriteString(styles.Muted.Render(" to re-check."))
+		sb.WriteString(styles.Current().Muted.Render("Press "))
+		sb.WriteString(styles.Current().Title.Render("r"))
+		sb.WriteString(styles.Current().Muted.Render(" to re-check."))
 	}
 
 	panelHeight := p.height
@@ -1350,7 +1350,7 @@ func (p *Plugin) initCommitTextarea() {
 	p.commitMessage.SetValue("") // Ensure empty
 	p.commitMessage.Placeholder = "Type your commit message..."
 	// Make placeholder more visible (default color 240 is too dim)
-	p.commitMessage.FocusedStyle.Placeholder = lipgloss.NewStyle().Foreground(styles.TextSecondary)
+	p.commitMessage.FocusedStyle.Placeholder = lipgloss.NewStyle().Foreground(styles.Current().TextSecondary)
 	p.commitMessage.Focus()
 	p.commitMessage.CharLimit = 0
 	// Size for modal: modalWidth - 6 (border+padding) - 2 (textarea internal padding)
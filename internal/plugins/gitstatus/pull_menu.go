@@ -118,7 +118,7 @@ func (p *Plugin) pullConflictSummarySection() modal.Section {
 		if p.pullConflictType == "rebase" {
 			conflictLabel = "Rebase"
 		}
-		content := styles.Muted.Render(fmt.Sprintf("%s produced conflicts in %d file(s):", conflictLabel, len(p.pullConflictFiles)))
+		content := styles.Current().Muted.Render(fmt.Sprintf("%s produced conflicts in %d file(s):", conflictLabel, len(p.pullConflictFiles)))
 		return modal.RenderedSection{Content: content}
 	}, nil)
 }
@@ -126,17 +126,17 @@ func (p *Plugin) pullConflictSummarySection() modal.Section {
 func (p *Plugin) pullConflictFilesSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		if len(p.pullConflictFiles) == 0 {
-			return modal.RenderedSection{Content: styles.Muted.Render("No conflicted files detected.")}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No conflicted files detected.")}
 		}
 
 		var sb strings.Builder
 		maxFiles := 8
 		for i, f := range p.pullConflictFiles {
 			if i >= maxFiles {
-				sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ... and %d more", len(p.pullConflictFiles)-maxFiles)))
+				sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf("  ... and %d more", len(p.pullConflictFiles)-maxFiles)))
 				break
 			}
-			sb.WriteString(styles.StatusModified.Render("  U " + f))
+			sb.WriteString(styles.Current().StatusModified.Render("  U " + f))
 			if i < len(p.pullConflictFiles)-1 {
 				sb.WriteString("\n")
 			}
@@ -147,7 +147,7 @@ func (p *Plugin) pullConflictFilesSection() modal.Section {
 
 func (p *Plugin) pullConflictResolutionSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		content := styles.Muted.Render("Resolve conflicts in your editor, then commit.")
+		content := styles.Current().Muted.Render("Resolve conflicts in your editor, then commit.")
 		return modal.RenderedSection{Content: content}
 	}, nil)
 }
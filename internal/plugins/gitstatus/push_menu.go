@@ -53,7 +53,7 @@ func (p *Plugin) clearPushMenuModal() {
 func (p *Plugin) pushMenuHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		return modal.RenderedSection{
-			Content: styles.Muted.Render("p/f/u shortcut · Enter to select · Esc to cancel"),
+			Content: styles.Current().Muted.Render("p/f/u shortcut · Enter to select · Esc to cancel"),
 		}
 	}, nil)
 }
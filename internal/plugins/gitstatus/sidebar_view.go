@@ -120,7 +120,7 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 	currentY := 3
 
 	// Header with branch name (truncated to fit sidebar)
-	header := styles.Title.Render("Git")
+	header := styles.Current().Title.Render("Git")
 	if p.pushStatus != nil {
 		if p.pushStatus.CurrentBranch != "" {
 			branch := p.pushStatus.CurrentBranch
@@ -129,9 +129,9 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 			if maxLen > 0 && len(branch) > maxLen {
 				branch = branch[:maxLen-1] + "…"
 			}
-			header += " " + styles.Muted.Render(branch)
+			header += " " + styles.Current().Muted.Render(branch)
 		} else if p.pushStatus.DetachedHead {
-			header += " " + styles.Muted.Render("(detached)")
+			header += " " + styles.Current().Muted.Render("(detached)")
 		}
 	}
 	sb.WriteString(header)
@@ -139,7 +139,7 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 
 	entries := p.tree.AllEntries()
 	if len(entries) == 0 {
-		sb.WriteString(styles.Muted.Render("Working tree clean"))
+		sb.WriteString(styles.Current().Muted.Render("Working tree clean"))
 		sb.WriteString("\n")
 		currentY++
 	} else {
@@ -201,33 +201,33 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 	// Separator
 	sb.WriteString("\n")
 	currentY++
-	sb.WriteString(styles.Muted.Render(strings.Repeat("─", p.sidebarWidth-4)))
+	sb.WriteString(styles.Current().Muted.Render(strings.Repeat("─", p.sidebarWidth-4)))
 	sb.WriteString("\n")
 	currentY++
 
 	// Remote operation status (push/fetch/pull)
 	if p.pushInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Pushing..."))
+		sb.WriteString(styles.Current().StatusInProgress.Render("Pushing..."))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.fetchInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Fetching..."))
+		sb.WriteString(styles.Current().StatusInProgress.Render("Fetching..."))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pullInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Pulling..."))
+		sb.WriteString(styles.Current().StatusInProgress.Render("Pulling..."))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pushSuccess {
-		sb.WriteString(styles.StatusStaged.Render("✓ Pushed"))
+		sb.WriteString(styles.Current().StatusStaged.Render("✓ Pushed"))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.fetchSuccess {
-		sb.WriteString(styles.StatusStaged.Render("✓ Fetched"))
+		sb.WriteString(styles.Current().StatusStaged.Render("✓ Fetched"))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pullSuccess {
-		sb.WriteString(styles.StatusStaged.Render("✓ Pulled"))
+		sb.WriteString(styles.Current().StatusStaged.Render("✓ Pulled"))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pushError != "" {
@@ -237,7 +237,7 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 		if len(errMsg) > maxLen && maxLen > 3 {
 			errMsg = errMsg[:maxLen-3] + "..."
 		}
-		sb.WriteString(styles.StatusDeleted.Render("✗ " + errMsg))
+		sb.WriteString(styles.Current().StatusDeleted.Render("✗ " + errMsg))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.fetchError != "" {
@@ -246,7 +246,7 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 		if len(errMsg) > maxLen && maxLen > 3 {
 			errMsg = errMsg[:maxLen-3] + "..."
 		}
-		sb.WriteString(styles.StatusDeleted.Render("✗ " + errMsg))
+		sb.WriteString(styles.Current().StatusDeleted.Render("✗ " + errMsg))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pullError != "" {
@@ -255,7 +255,7 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 		if len(errMsg) > maxLen && maxLen > 3 {
 			errMsg = errMsg[:maxLen-3] + "..."
 		}
-		sb.WriteString(styles.StatusDeleted.Render("✗ " + errMsg))
+		sb.WriteString(styles.Current().StatusDeleted.Render("✗ " + errMsg))
 		sb.WriteString("\n")
 		currentY++
 	}
@@ -272,12 +272,12 @@ func (p *Plugin) renderSidebarSection(title string, entries []*FileEntry, lineNu
 	var sb strings.Builder
 
 	// Section header with color based on type
-	headerStyle := styles.Subtitle
+	headerStyle := styles.Current().Subtitle
 	switch title {
 	case "Staged":
-		headerStyle = styles.StatusStaged
+		headerStyle = styles.Current().StatusStaged
 	case "Modified":
-		headerStyle = styles.StatusModified
+		headerStyle = styles.Current().StatusModified
 	}
 
 	sb.WriteString(headerStyle.Render(fmt.Sprintf("%s (%d)", title, len(entries))))
@@ -316,17 +316,17 @@ func (p *Plugin) renderSidebarEntry(entry *FileEntry, selected bool, maxWidth in
 	var statusStyle lipgloss.Style
 	switch entry.Status {
 	case StatusModified:
-		statusStyle = styles.StatusModified
+		statusStyle = styles.Current().StatusModified
 	case StatusAdded:
-		statusStyle = styles.StatusStaged
+		statusStyle = styles.Current().StatusStaged
 	case StatusDeleted:
-		statusStyle = styles.StatusDeleted
+		statusStyle = styles.Current().StatusDeleted
 	case StatusRenamed:
-		statusStyle = styles.StatusStaged
+		statusStyle = styles.Current().StatusStaged
 	case StatusUntracked:
-		statusStyle = styles.StatusUntracked
+		statusStyle = styles.Current().StatusUntracked
 	default:
-		statusStyle = styles.Muted
+		statusStyle = styles.Current().Muted
 	}
 
 	status := statusStyle.Render(string(entry.Status))
@@ -358,10 +358,10 @@ func (p *Plugin) renderSidebarEntry(entry *FileEntry, selected bool, maxWidth in
 			if len(plainLine) < maxWidth {
 				plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
 			}
-			return styles.ListItemSelected.Render(plainLine)
+			return styles.Current().ListItemSelected.Render(plainLine)
 		}
 
-		return styles.ListItemNormal.Render(fmt.Sprintf("%s %s%s %s", status, indicator, displayName, styles.Muted.Render(countStr)))
+		return styles.Current().ListItemNormal.Render(fmt.Sprintf("%s %s%s %s", status, indicator, displayName, styles.Current().Muted.Render(countStr)))
 	}
 
 	// Path - truncate if needed
@@ -376,10 +376,10 @@ func (p *Plugin) renderSidebarEntry(entry *FileEntry, selected bool, maxWidth in
 		if len(plainLine) < maxWidth {
 			plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
 		}
-		return styles.ListItemSelected.Render(plainLine)
+		return styles.Current().ListItemSelected.Render(plainLine)
 	}
 
-	return styles.ListItemNormal.Render(fmt.Sprintf("%s %s", status, path))
+	return styles.Current().ListItemNormal.Render(fmt.Sprintf("%s %s", status, path))
 }
 
 // renderRecentCommits renders the recent commits section in the sidebar.
@@ -399,19 +399,19 @@ func (p *Plugin) renderRecentCommits(currentY *int, maxVisible int) string {
 			filterParts = append(filterParts, "path:"+truncateStr(p.historyFilterPath, 10))
 		}
 		if len(filterParts) > 0 {
-			header = fmt.Sprintf("Commits %s", styles.StatusModified.Render("["+strings.Join(filterParts, ", ")+"]"))
+			header = fmt.Sprintf("Commits %s", styles.Current().StatusModified.Render("["+strings.Join(filterParts, ", ")+"]"))
 		}
 	} else if p.pushStatus != nil {
 		status := p.pushStatus.FormatAheadBehind()
 		if status != "" {
-			header = fmt.Sprintf("Recent Commits %s", styles.StatusModified.Render(status))
+			header = fmt.Sprintf("Recent Commits %s", styles.Current().StatusModified.Render(status))
 		}
 	}
 	// Add graph indicator if enabled
 	if p.showCommitGraph {
-		header += " " + styles.Muted.Render("[graph]")
+		header += " " + styles.Current().Muted.Render("[graph]")
 	}
-	headerLine := styles.Title.Render(header)
+	headerLine := styles.Current().Title.Render(header)
 	headerWidth := p.sidebarWidth - 4
 	if headerWidth > 0 {
 		headerLine = truncateStyledLine(headerLine, headerWidth)
@@ -428,9 +428,9 @@ func (p *Plugin) renderRecentCommits(currentY *int, maxVisible int) string {
 
 	if len(commits) == 0 {
 		if p.historyFilterActive {
-			sb.WriteString(styles.Muted.Render("No matching commits"))
+			sb.WriteString(styles.Current().Muted.Render("No matching commits"))
 		} else {
-			sb.WriteString(styles.Muted.Render("No commits"))
+			sb.WriteString(styles.Current().Muted.Render("No commits"))
 		}
 		return sb.String()
 	}
@@ -485,13 +485,13 @@ func (p *Plugin) renderRecentCommits(currentY *int, maxVisible int) string {
 		// Push indicator: ↑ for unpushed, nothing for pushed
 		var indicator string
 		if !commit.Pushed {
-			indicator = styles.StatusModified.Render("↑") + " "
+			indicator = styles.Current().StatusModified.Render("↑") + " "
 		} else {
 			indicator = "  " // Two spaces to align with indicator
 		}
 
 		// Format: "[graph] ↑ abc1234 commit message..."
-		hash := styles.Code.Render(commit.Hash[:7])
+		hash := styles.Current().Code.Render(commit.Hash[:7])
 		msgWidth := maxWidth - 12 - graphVisualWidth // indicator + hash + space + graph
 		if msgWidth < 10 {
 			msgWidth = 10
@@ -521,14 +521,14 @@ func (p *Plugin) renderRecentCommits(currentY *int, maxVisible int) string {
 			if lineWidth < maxWidth {
 				plainLine += strings.Repeat(" ", maxWidth-lineWidth)
 			}
-			commitsSB.WriteString(styles.ListItemSelected.Render(plainLine))
+			commitsSB.WriteString(styles.Current().ListItemSelected.Render(plainLine))
 		} else {
 			line := fmt.Sprintf("%s%s%s %s", graphStr, indicator, hash, msg)
 			lineWidth := lipgloss.Width(line)
 			if lineWidth < maxWidth {
 				line += strings.Repeat(" ", maxWidth-lineWidth)
 			}
-			commitsSB.WriteString(styles.ListItemNormal.Render(line))
+			commitsSB.WriteString(styles.Current().ListItemNormal.Render(line))
 		}
 		*currentY++
 		if i < endIdx-1 {
@@ -553,19 +553,19 @@ func (p *Plugin) renderRecentCommits(currentY *int, maxVisible int) string {
 // renderGraphLine formats a GraphLine to a styled fixed-width string.
 func (p *Plugin) renderGraphLine(gl GraphLine, width int) string {
 	var sb strings.Builder
-	commitStyle := lipgloss.NewStyle().Foreground(styles.Accent)
+	commitStyle := lipgloss.NewStyle().Foreground(styles.Current().Accent)
 	for _, ch := range gl.Chars {
 		switch ch {
 		case '*':
 			sb.WriteString(commitStyle.Render("●"))
 		case '|':
-			sb.WriteString(styles.Muted.Render("│"))
+			sb.WriteString(styles.Current().Muted.Render("│"))
 		case '\\':
-			sb.WriteString(styles.Muted.Render("╲"))
+			sb.WriteString(styles.Current().Muted.Render("╲"))
 		case '/':
-			sb.WriteString(styles.Muted.Render("╱"))
+			sb.WriteString(styles.Current().Muted.Render("╱"))
 		case '_':
-			sb.WriteString(styles.Muted.Render("─"))
+			sb.WriteString(styles.Current().Muted.Render("─"))
 		default:
 			sb.WriteRune(ch)
 		}
@@ -650,21 +650,21 @@ func (p *Plugin) renderDiffPane(visibleHeight int) string {
 			if clipInfo.HasMoreRight {
 				rightArrow = "▶"
 			}
-			scrollIndicator = " " + styles.Muted.Render(leftArrow+rightArrow)
+			scrollIndicator = " " + styles.Current().Muted.Render(leftArrow+rightArrow)
 		}
 	}
 
 	header = fmt.Sprintf("%s [%s]%s", header, viewModeStr, scrollIndicator)
-	sb.WriteString(styles.Title.Render(header))
+	sb.WriteString(styles.Current().Title.Render(header))
 	sb.WriteString("\n\n")
 
 	if p.selectedDiffFile == "" {
-		sb.WriteString(styles.Muted.Render("Select a file to view diff"))
+		sb.WriteString(styles.Current().Muted.Render("Select a file to view diff"))
 		return sb.String()
 	}
 
 	if p.diffPaneParsedDiff == nil {
-		sb.WriteString(styles.Muted.Render("Loading diff..."))
+		sb.WriteString(styles.Current().Muted.Render("Loading diff..."))
 		return sb.String()
 	}
 
@@ -703,7 +703,7 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 
 	c := p.previewCommit
 	if c == nil {
-		sb.WriteString(styles.Muted.Render("Loading commit..."))
+		sb.WriteString(styles.Current().Muted.Render("Loading commit..."))
 		return sb.String()
 	}
 
@@ -721,16 +721,16 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 
 	// Commit hash badge style
 	hashBadge := lipgloss.NewStyle().
-		Foreground(styles.Accent).
-		Background(styles.BgSecondary).
+		Foreground(styles.Current().Accent).
+		Background(styles.Current().BgSecondary).
 		Padding(0, 1).
 		Bold(true)
 
 	labelStyle := lipgloss.NewStyle().
-		Foreground(styles.TextMuted)
+		Foreground(styles.Current().TextMuted)
 
 	// Header with styled commit hash
-	sb.WriteString(styles.Title.Render("Commit "))
+	sb.WriteString(styles.Current().Title.Render("Commit "))
 	sb.WriteString(hashBadge.Render(c.ShortHash))
 	sb.WriteString("\n\n")
 	currentY += 2 // header line + blank line from \n\n
@@ -741,13 +741,13 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 		authorStr = authorStr[:maxWidth-15] + "..."
 	}
 	sb.WriteString(labelStyle.Render("󰀄 ")) // Author icon
-	sb.WriteString(styles.Body.Render(authorStr))
+	sb.WriteString(styles.Current().Body.Render(authorStr))
 	sb.WriteString("\n")
 	currentY++
 
 	// Date with icon-like prefix
 	sb.WriteString(labelStyle.Render("󰃰 ")) // Calendar icon
-	sb.WriteString(styles.Muted.Render(RelativeTime(c.Date)))
+	sb.WriteString(styles.Current().Muted.Render(RelativeTime(c.Date)))
 	sb.WriteString("\n\n")
 	currentY += 2 // date + blank line
 
@@ -757,7 +757,7 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 		subject = subject[:maxWidth-5] + "..."
 	}
 	subjectStyle := lipgloss.NewStyle().
-		Foreground(styles.TextPrimary).
+		Foreground(styles.Current().TextPrimary).
 		Bold(true)
 	sb.WriteString(subjectStyle.Render(subject))
 	sb.WriteString("\n")
@@ -771,7 +771,7 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 		maxBodyLines := 3
 		for i, line := range bodyLines {
 			if i >= maxBodyLines {
-				sb.WriteString(styles.Muted.Render("..."))
+				sb.WriteString(styles.Current().Muted.Render("..."))
 				sb.WriteString("\n")
 				currentY++
 				break
@@ -779,7 +779,7 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 			if len(line) > maxWidth-2 {
 				line = line[:maxWidth-5] + "..."
 			}
-			sb.WriteString(styles.Muted.Render(line))
+			sb.WriteString(styles.Current().Muted.Render(line))
 			sb.WriteString("\n")
 			currentY++
 		}
@@ -788,7 +788,7 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 	// Separator with subtle styling
 	sb.WriteString("\n")
 	currentY++
-	separator := lipgloss.NewStyle().Foreground(styles.BorderNormal)
+	separator := lipgloss.NewStyle().Foreground(styles.Current().BorderNormal)
 	sb.WriteString(separator.Render(strings.Repeat("─", maxWidth)))
 	sb.WriteString("\n")
 	currentY++
@@ -796,11 +796,11 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 	// Files header with stats
 	statsLine := fmt.Sprintf("Files (%d)", len(c.Files))
 	if c.Stats.Additions > 0 || c.Stats.Deletions > 0 {
-		addStr := styles.DiffAdd.Render(fmt.Sprintf("+%d", c.Stats.Additions))
-		delStr := styles.DiffRemove.Render(fmt.Sprintf("-%d", c.Stats.Deletions))
+		addStr := styles.Current().DiffAdd.Render(fmt.Sprintf("+%d", c.Stats.Additions))
+		delStr := styles.Current().DiffRemove.Render(fmt.Sprintf("-%d", c.Stats.Deletions))
 		statsLine = fmt.Sprintf("Files (%d)  %s %s", len(c.Files), addStr, delStr)
 	}
-	sb.WriteString(styles.Subtitle.Render(statsLine))
+	sb.WriteString(styles.Current().Subtitle.Render(statsLine))
 	sb.WriteString("\n")
 	currentY++
 
@@ -820,7 +820,7 @@ func (p *Plugin) renderCommitPreview(visibleHeight int) string {
 
 	// Files list with cursor
 	if len(c.Files) == 0 {
-		sb.WriteString(styles.Muted.Render("No files changed"))
+		sb.WriteString(styles.Current().Muted.Render("No files changed"))
 	} else {
 		start := p.previewCommitScroll
 		if start >= len(c.Files) {
@@ -854,15 +854,15 @@ func (p *Plugin) renderCommitPreviewFile(file CommitFile, selected bool, maxWidt
 	var statusStyle lipgloss.Style
 	switch file.Status {
 	case StatusModified:
-		statusStyle = styles.StatusModified
+		statusStyle = styles.Current().StatusModified
 	case StatusAdded:
-		statusStyle = styles.StatusStaged
+		statusStyle = styles.Current().StatusStaged
 	case StatusDeleted:
-		statusStyle = styles.StatusDeleted
+		statusStyle = styles.Current().StatusDeleted
 	case StatusRenamed:
-		statusStyle = styles.StatusStaged
+		statusStyle = styles.Current().StatusStaged
 	default:
-		statusStyle = styles.Muted
+		statusStyle = styles.Current().Muted
 	}
 	status := statusStyle.Render(string(file.Status))
 
@@ -878,10 +878,10 @@ func (p *Plugin) renderCommitPreviewFile(file CommitFile, selected bool, maxWidt
 		if len(plainLine) < maxWidth {
 			plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
 		}
-		return styles.ListItemSelected.Render(plainLine)
+		return styles.Current().ListItemSelected.Render(plainLine)
 	}
 
-	return styles.ListItemNormal.Render(fmt.Sprintf("%s %s", status, path))
+	return styles.Current().ListItemNormal.Render(fmt.Sprintf("%s %s", status, path))
 }
 
 // truncateStr truncates a string to maxLen characters with ellipsis.
@@ -41,7 +41,7 @@ func (p *Plugin) renderDiffModal() string {
 			if clipInfo.HasMoreRight {
 				rightArrow = "▶"
 			}
-			scrollIndicator = " " + styles.Muted.Render(leftArrow+rightArrow)
+			scrollIndicator = " " + styles.Current().Muted.Render(leftArrow+rightArrow)
 		}
 	}
 
@@ -51,14 +51,14 @@ func (p *Plugin) renderDiffModal() string {
 	p.mouseHandler.HitMap.AddRect(regionDiffBack, 2, 1, backWidth, 1, nil)
 	sb.WriteString(breadcrumb)
 	sb.WriteString("\n")
-	sb.WriteString(styles.Muted.Render(strings.Repeat("━", contentWidth)))
+	sb.WriteString(styles.Current().Muted.Render(strings.Repeat("━", contentWidth)))
 	sb.WriteString("\n")
 
 	// Content
 	if p.diffContent == "" && !p.diffLoaded {
-		sb.WriteString(styles.Muted.Render("Loading diff..."))
+		sb.WriteString(styles.Current().Muted.Render("Loading diff..."))
 	} else if p.diffContent == "" && p.diffLoaded {
-		sb.WriteString(styles.Muted.Render("No changes"))
+		sb.WriteString(styles.Current().Muted.Render("No changes"))
 	} else {
 		// Visible lines = pane height - header (2 lines)
 		visibleLines := paneHeight - 2
@@ -75,7 +75,7 @@ func (p *Plugin) renderDiffModal() string {
 			if parsed != nil {
 				sb.WriteString(RenderSideBySide(parsed, contentWidth, p.diffScroll, visibleLines, p.diffHorizOff, highlighter, p.diffWrapEnabled))
 			} else {
-				sb.WriteString(styles.Muted.Render("Unable to parse diff for side-by-side view"))
+				sb.WriteString(styles.Current().Muted.Render("Unable to parse diff for side-by-side view"))
 			}
 		} else {
 			// Unified view
@@ -105,7 +105,7 @@ func (p *Plugin) renderDiffModal() string {
 
 // wrapDiffContent wraps diff content with panel border.
 func (p *Plugin) wrapDiffContent(content string, paneHeight int) string {
-	return styles.PanelActive.
+	return styles.Current().PanelActive.
 		Width(p.width - 2).
 		Height(paneHeight).
 		Render(content)
@@ -187,7 +187,7 @@ func (p *Plugin) renderFullDiffContent(visibleHeight int) string {
 			if clipInfo.HasMoreRight {
 				rightArrow = "▶"
 			}
-			scrollIndicator = " " + styles.Muted.Render(leftArrow+rightArrow)
+			scrollIndicator = " " + styles.Current().Muted.Render(leftArrow+rightArrow)
 		}
 	}
 
@@ -198,9 +198,9 @@ func (p *Plugin) renderFullDiffContent(visibleHeight int) string {
 
 	if p.diffContent == "" && p.diffRaw == "" {
 		if p.diffLoaded {
-			sb.WriteString(styles.Muted.Render("No changes"))
+			sb.WriteString(styles.Current().Muted.Render("No changes"))
 		} else {
-			sb.WriteString(styles.Muted.Render("Loading diff..."))
+			sb.WriteString(styles.Current().Muted.Render("Loading diff..."))
 		}
 		return sb.String()
 	}
@@ -257,33 +257,33 @@ func (p *Plugin) renderDiffLine(line string) string {
 
 	switch {
 	case strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "+++"):
-		return styles.DiffAdd.Background(styles.DiffAddBg).Render(line)
+		return styles.Current().DiffAdd.Background(styles.Current().DiffAddBg).Render(line)
 	case strings.HasPrefix(line, "-") && !strings.HasPrefix(line, "---"):
-		return styles.DiffRemove.Background(styles.DiffRemoveBg).Render(line)
+		return styles.Current().DiffRemove.Background(styles.Current().DiffRemoveBg).Render(line)
 	case strings.HasPrefix(line, "@@"):
-		return styles.DiffHeader.Render(line)
+		return styles.Current().DiffHeader.Render(line)
 	case strings.HasPrefix(line, "diff "), strings.HasPrefix(line, "index "):
-		return styles.DiffHeader.Render(line)
+		return styles.Current().DiffHeader.Render(line)
 	case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
-		return styles.DiffHeader.Render(line)
+		return styles.Current().DiffHeader.Render(line)
 	default:
-		return styles.DiffContext.Render(line)
+		return styles.Current().DiffContext.Render(line)
 	}
 }
 
 // renderDiffBreadcrumb renders the breadcrumb navigation bar for the diff view.
 // Returns the rendered string and the visible width of the clickable "← Back" area.
 func (p *Plugin) renderDiffBreadcrumb(maxWidth int, scrollIndicator string) (string, int) {
-	back := styles.Link.Render("← Back")
+	back := styles.Current().Link.Render("← Back")
 	backWidth := lipgloss.Width(back)
-	sep := styles.Muted.Render(" · ")
+	sep := styles.Current().Muted.Render(" · ")
 	sepWidth := lipgloss.Width(sep)
 
 	viewModeStr := "unified"
 	if p.diffViewMode == DiffViewSideBySide {
 		viewModeStr = "side-by-side"
 	}
-	modePart := styles.Muted.Render("[" + viewModeStr + "]")
+	modePart := styles.Current().Muted.Render("[" + viewModeStr + "]")
 	modeWidth := lipgloss.Width(modePart) + lipgloss.Width(scrollIndicator)
 
 	// Budget for the middle content (commit info + filename)
@@ -308,7 +308,7 @@ func (p *Plugin) renderDiffBreadcrumb(maxWidth int, scrollIndicator string) (str
 		if len(commitInfo) > maxCommitWidth {
 			commitInfo = commitInfo[:maxCommitWidth-1] + "…"
 		}
-		commitPart = styles.Muted.Render(commitInfo)
+		commitPart = styles.Current().Muted.Render(commitInfo)
 		commitWidth = lipgloss.Width(commitPart)
 	}
 
@@ -321,7 +321,7 @@ func (p *Plugin) renderDiffBreadcrumb(maxWidth int, scrollIndicator string) (str
 	if len(fileName) > fileBudget {
 		fileName = truncateDiffPath(fileName, fileBudget)
 	}
-	filePart := styles.Title.Render(fileName)
+	filePart := styles.Current().Title.Render(fileName)
 
 	// Assemble
 	var sb strings.Builder
@@ -130,7 +130,7 @@ func (p *Plugin) infoModalDetailsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		note := p.infoModalNote
 		if note == nil {
-			return modal.RenderedSection{Content: styles.Muted.Render("No note selected")}
+			return modal.RenderedSection{Content: styles.Current().Muted.Render("No note selected")}
 		}
 
 		// Get note title
@@ -167,8 +167,8 @@ func (p *Plugin) infoModalDetailsSection() modal.Section {
 			archivedStr = "Yes"
 		}
 
-		labelStyle := styles.Muted.Width(10).Align(lipgloss.Right).MarginRight(2)
-		valueStyle := lipgloss.NewStyle().Foreground(styles.TextPrimary)
+		labelStyle := styles.Current().Muted.Width(10).Align(lipgloss.Right).MarginRight(2)
+		valueStyle := lipgloss.NewStyle().Foreground(styles.Current().TextPrimary)
 
 		fields := []struct{ label, value string }{
 			{"Title:", title},
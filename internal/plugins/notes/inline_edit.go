@@ -356,9 +356,9 @@ func (p *Plugin) renderInlineEditorContent(visibleHeight int) string {
 		}
 	}
 	header := fmt.Sprintf("Editing: %s", noteTitle)
-	sb.WriteString(styles.Title.Render(header))
+	sb.WriteString(styles.Current().Title.Render(header))
 	sb.WriteString("  ")
-	sb.WriteString(styles.Muted.Render("(Ctrl+\\ or ESC ESC to exit)"))
+	sb.WriteString(styles.Current().Muted.Render("(Ctrl+\\ or ESC ESC to exit)"))
 	sb.WriteString("\n")
 
 	// Calculate content height (account for header)
@@ -387,12 +387,12 @@ func (p *Plugin) renderExitConfirmation(visibleHeight int) string {
 
 	var sb strings.Builder
 
-	sb.WriteString(styles.Title.Render("Exit editor?"))
+	sb.WriteString(styles.Current().Title.Render("Exit editor?"))
 	sb.WriteString("\n\n")
 
 	for i, opt := range options {
 		if i == p.exitConfirmSelection {
-			sb.WriteString(styles.ListItemSelected.Render("> " + opt))
+			sb.WriteString(styles.Current().ListItemSelected.Render("> " + opt))
 		} else {
 			sb.WriteString("  " + opt)
 		}
@@ -400,7 +400,7 @@ func (p *Plugin) renderExitConfirmation(visibleHeight int) string {
 	}
 
 	sb.WriteString("\n")
-	sb.WriteString(styles.Muted.Render("[j/k to select, Enter to confirm, Esc to cancel]"))
+	sb.WriteString(styles.Current().Muted.Render("[j/k to select, Enter to confirm, Esc to cancel]"))
 
 	return sb.String()
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/charmbracelet/x/ansi"
 	"github.com/wilbur182/forge/internal/app"
 	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
 )
 
@@ -349,9 +350,12 @@ func (p *Plugin) handleMouseDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 // handleDividerDrag handles dragging the pane divider to resize.
 func (p *Plugin) handleDividerDrag(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 	startValue := p.mouseHandler.DragStartValue()
-	newWidth := startValue + action.DragDX
+	p.setListWidth(startValue + action.DragDX)
+	return p, nil
+}
 
-	// Clamp to reasonable bounds
+// setListWidth clamps and applies a list pane width.
+func (p *Plugin) setListWidth(newWidth int) {
 	available := p.width - dividerWidth
 	minWidth := 20
 	maxWidth := available - 40 // Leave at least 40 for editor
@@ -365,7 +369,26 @@ func (p *Plugin) handleDividerDrag(action mouse.MouseAction) (*Plugin, tea.Cmd)
 	}
 
 	p.listWidth = newWidth
-	return p, nil
+}
+
+// CanResizePane implements plugin.PaneResizer.
+func (p *Plugin) CanResizePane() bool {
+	if p.width == 0 || p.ConsumesTextInput() {
+		return false
+	}
+	switch p.FocusContext() {
+	case "notes-list", "notes-preview":
+		return true
+	}
+	return false
+}
+
+// ResizePane implements plugin.PaneResizer.
+func (p *Plugin) ResizePane(delta int) (int, tea.Cmd) {
+	available := p.width - dividerWidth
+	p.setListWidth(p.listWidth + delta*plugin.PaneResizeStep(available))
+	_ = state.SetNotesListWidth(p.listWidth)
+	return p.listWidth * 100 / max(available, 1), nil
 }
 
 // handleEditorSelectionDrag handles drag-to-select in the editor pane.
@@ -254,10 +254,10 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	ta.FocusedStyle = textarea.Style{
 		Base:             lipgloss.NewStyle(),
 		CursorLine:       lipgloss.NewStyle(),
-		CursorLineNumber: styles.Muted,
-		EndOfBuffer:      styles.Muted,
-		LineNumber:       styles.Muted,
-		Placeholder:      styles.Muted,
+		CursorLineNumber: styles.Current().Muted,
+		EndOfBuffer:      styles.Current().Muted,
+		LineNumber:       styles.Current().Muted,
+		Placeholder:      styles.Current().Muted,
 		Prompt:           lipgloss.NewStyle(),
 		Text:             lipgloss.NewStyle(),
 	}
@@ -104,17 +104,17 @@ func (p *Plugin) renderListPane(height int) string {
 	totalCount := len(p.notes)
 
 	// Header: "Notes (filter)" with count
-	sb.WriteString(styles.Title.Render("Notes"))
+	sb.WriteString(styles.Current().Title.Render("Notes"))
 
 	// Show filter indicator
 	filterLabel := p.viewFilter.String()
-	sb.WriteString(styles.Muted.Render(" [" + filterLabel + "]"))
+	sb.WriteString(styles.Current().Muted.Render(" [" + filterLabel + "]"))
 
 	// Show count
 	if p.searchQuery != "" {
-		sb.WriteString(styles.Muted.Render(fmt.Sprintf(" (%d/%d)", noteCount, totalCount)))
+		sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf(" (%d/%d)", noteCount, totalCount)))
 	} else {
-		sb.WriteString(styles.Muted.Render(fmt.Sprintf(" (%d)", noteCount)))
+		sb.WriteString(styles.Current().Muted.Render(fmt.Sprintf(" (%d)", noteCount)))
 	}
 	sb.WriteString("\n")
 
@@ -137,16 +137,16 @@ func (p *Plugin) renderListPane(height int) string {
 		if p.searchQuery != "" {
 			// No matches for search - show create prompt
 			sb.WriteString("\n")
-			sb.WriteString(styles.Muted.Render("No matches"))
+			sb.WriteString(styles.Current().Muted.Render("No matches"))
 			sb.WriteString("\n\n")
-			sb.WriteString(styles.Subtle.Render("Press "))
-			sb.WriteString(styles.Code.Render("Enter"))
-			sb.WriteString(styles.Subtle.Render(" to create"))
+			sb.WriteString(styles.Current().Subtle.Render("Press "))
+			sb.WriteString(styles.Current().Code.Render("Enter"))
+			sb.WriteString(styles.Current().Subtle.Render(" to create"))
 		} else {
 			sb.WriteString("\n")
-			sb.WriteString(styles.Muted.Render("No notes"))
+			sb.WriteString(styles.Current().Muted.Render("No notes"))
 			sb.WriteString("\n")
-			sb.WriteString(styles.Subtle.Render("n=new"))
+			sb.WriteString(styles.Current().Subtle.Render("n=new"))
 		}
 		return sb.String()
 	}
@@ -265,7 +265,7 @@ func (p *Plugin) renderPreviewContent(height, width int) string {
 				}
 				if wi == 0 {
 					lineNum := fmt.Sprintf("%*d", lineNumWidth, i+1)
-					sb.WriteString(styles.Muted.Render(lineNum + " "))
+					sb.WriteString(styles.Current().Muted.Render(lineNum + " "))
 				} else {
 					sb.WriteString(lineNumPad)
 				}
@@ -294,7 +294,7 @@ func (p *Plugin) renderPreviewContent(height, width int) string {
 					}
 					sb.WriteString(wl)
 				} else {
-					sb.WriteString(styles.Body.Render(wl))
+					sb.WriteString(styles.Current().Body.Render(wl))
 				}
 
 				if visualLinesRendered < height-1 {
@@ -304,7 +304,7 @@ func (p *Plugin) renderPreviewContent(height, width int) string {
 			}
 		} else {
 			lineNum := fmt.Sprintf("%*d", lineNumWidth, i+1)
-			sb.WriteString(styles.Muted.Render(lineNum + " "))
+			sb.WriteString(styles.Current().Muted.Render(lineNum + " "))
 
 			displayLine := line
 			if len(displayLine) > maxLineWidth {
@@ -316,7 +316,7 @@ func (p *Plugin) renderPreviewContent(height, width int) string {
 				displayLine = ui.InjectCharacterRangeBackground(displayLine, startCol, endCol)
 				sb.WriteString(displayLine)
 			} else {
-				sb.WriteString(styles.Body.Render(displayLine))
+				sb.WriteString(styles.Current().Body.Render(displayLine))
 			}
 
 			if visualLinesRendered < height-1 {
@@ -330,7 +330,7 @@ func (p *Plugin) renderPreviewContent(height, width int) string {
 	for visualLinesRendered < height {
 		sb.WriteString("\n")
 		lineNum := fmt.Sprintf("%*s", lineNumWidth, "~")
-		sb.WriteString(styles.Muted.Render(lineNum + " "))
+		sb.WriteString(styles.Current().Muted.Render(lineNum + " "))
 		visualLinesRendered++
 	}
 
@@ -384,7 +384,7 @@ func (p *Plugin) renderEditorStatusHeader(width int) string {
 	createdStr := p.editorNote.CreatedAt.Format("Jan 2, 2006")
 	updatedStr := p.editorNote.UpdatedAt.Format("Jan 2, 2006")
 	rightText := fmt.Sprintf("Created: %s | Updated: %s", createdStr, updatedStr)
-	rightPart := styles.Muted.Render(rightText)
+	rightPart := styles.Current().Muted.Render(rightText)
 	rightWidth := lipgloss.Width(rightPart)
 
 	// Left side: save state + optional preview indicator
@@ -422,12 +422,12 @@ func (p *Plugin) renderEditorStatusHeader(width int) string {
 	if p.editorDirty {
 		// Re-apply styling after truncation
 		if strings.HasPrefix(leftText, "Unsaved") {
-			leftPart = styles.StatusModified.Render(leftText)
+			leftPart = styles.Current().StatusModified.Render(leftText)
 		} else {
-			leftPart = styles.Muted.Render(leftText)
+			leftPart = styles.Current().Muted.Render(leftText)
 		}
 	} else {
-		leftPart = styles.Muted.Render(leftText)
+		leftPart = styles.Current().Muted.Render(leftText)
 	}
 
 	// Use lipgloss.PlaceHorizontal to properly position the right part,
@@ -454,13 +454,13 @@ func (p *Plugin) renderEditorStatusHeader(width int) string {
 // renderEditorPlaceholder shows when no note is selected.
 func (p *Plugin) renderEditorPlaceholder(height int) string {
 	var sb strings.Builder
-	sb.WriteString(styles.Muted.Render("No note selected"))
+	sb.WriteString(styles.Current().Muted.Render("No note selected"))
 	sb.WriteString("\n\n")
-	sb.WriteString(styles.Subtle.Render("Select a note from the list"))
+	sb.WriteString(styles.Current().Subtle.Render("Select a note from the list"))
 	sb.WriteString("\n")
-	sb.WriteString(styles.Subtle.Render("or press "))
-	sb.WriteString(styles.Code.Render("n"))
-	sb.WriteString(styles.Subtle.Render(" to create new"))
+	sb.WriteString(styles.Current().Subtle.Render("or press "))
+	sb.WriteString(styles.Current().Code.Render("n"))
+	sb.WriteString(styles.Current().Subtle.Render(" to create new"))
 	return sb.String()
 }
 
@@ -496,30 +496,30 @@ func (p *Plugin) ensurePreviewCursorVisibleWithHeight(viewHeight int) {
 // renderInitMessage shows when td is not initialized.
 func (p *Plugin) renderInitMessage() string {
 	var sb strings.Builder
-	sb.WriteString(styles.Title.Render("Notes"))
+	sb.WriteString(styles.Current().Title.Render("Notes"))
 	sb.WriteString("\n\n")
-	sb.WriteString(styles.Muted.Render("Notes plugin requires td initialization."))
+	sb.WriteString(styles.Current().Muted.Render("Notes plugin requires td initialization."))
 	sb.WriteString("\n")
-	sb.WriteString(styles.Code.Render("Run 'td init' in this project."))
+	sb.WriteString(styles.Current().Code.Render("Run 'td init' in this project."))
 	return sb.String()
 }
 
 // renderLoading shows a loading indicator.
 func (p *Plugin) renderLoading() string {
 	var sb strings.Builder
-	sb.WriteString(styles.Title.Render("Notes"))
+	sb.WriteString(styles.Current().Title.Render("Notes"))
 	sb.WriteString("\n\n")
-	sb.WriteString(styles.Muted.Render("Loading notes..."))
+	sb.WriteString(styles.Current().Muted.Render("Loading notes..."))
 	return sb.String()
 }
 
 // renderError shows an error message.
 func (p *Plugin) renderError() string {
 	var sb strings.Builder
-	sb.WriteString(styles.Title.Render("Notes"))
+	sb.WriteString(styles.Current().Title.Render("Notes"))
 	sb.WriteString("\n\n")
-	sb.WriteString(styles.StatusDeleted.Render("Error: "))
-	sb.WriteString(styles.Muted.Render(p.loadErr.Error()))
+	sb.WriteString(styles.Current().StatusDeleted.Render("Error: "))
+	sb.WriteString(styles.Current().Muted.Render(p.loadErr.Error()))
 	return sb.String()
 }
 
@@ -528,14 +528,14 @@ func (p *Plugin) renderSearchInput() string {
 	var sb strings.Builder
 
 	// Prefix: "/" to indicate search mode
-	sb.WriteString(styles.Muted.Render("/"))
+	sb.WriteString(styles.Current().Muted.Render("/"))
 
 	// Query text
-	sb.WriteString(styles.Body.Render(p.searchQuery))
+	sb.WriteString(styles.Current().Body.Render(p.searchQuery))
 
 	// Cursor (only when in search mode)
 	if p.searchMode {
-		sb.WriteString(styles.ListCursor.Render("_"))
+		sb.WriteString(styles.Current().ListCursor.Render("_"))
 	}
 
 	return sb.String()
@@ -555,23 +555,23 @@ func (p *Plugin) renderNoteRow(note Note, selected bool, maxWidth int) string {
 	// Status icon only for archived/deleted notes (no placeholder for active)
 	hasStatusIcon := note.DeletedAt != nil || note.Archived
 	if note.DeletedAt != nil {
-		prefix.WriteString(styles.StatusDeletedNote.Render(iconDeleted))
+		prefix.WriteString(styles.Current().StatusDeletedNote.Render(iconDeleted))
 		prefix.WriteString(" ")
 	} else if note.Archived {
-		prefix.WriteString(styles.StatusArchived.Render(iconArchived))
+		prefix.WriteString(styles.Current().StatusArchived.Render(iconArchived))
 		prefix.WriteString(" ")
 	}
 
 	// Cursor indicator
 	if selected {
-		prefix.WriteString(styles.ListCursor.Render("> "))
+		prefix.WriteString(styles.Current().ListCursor.Render("> "))
 	} else {
 		prefix.WriteString("  ")
 	}
 
 	// Pin badge
 	if note.Pinned {
-		prefix.WriteString(styles.StatusModified.Render("* "))
+		prefix.WriteString(styles.Current().StatusModified.Render("* "))
 	}
 
 	prefixStr := prefix.String()
@@ -627,11 +627,11 @@ func (p *Plugin) renderNoteRow(note Note, selected bool, maxWidth int) string {
 		if len(plainRow) < maxWidth {
 			plainRow += strings.Repeat(" ", maxWidth-len(plainRow))
 		}
-		return styles.ListItemSelected.Render(plainRow)
+		return styles.Current().ListItemSelected.Render(plainRow)
 	}
 
 	// Regular row with styled components
-	return prefixStr + styles.Body.Render(title)
+	return prefixStr + styles.Current().Body.Render(title)
 }
 
 // ensureCursorVisibleForList adjusts scrollOff for a list of given size.
@@ -216,22 +216,22 @@ func (m *NotInstalledModel) renderPitch() string {
 	// Use theme-aware styles
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(styles.TextHighlight)
+		Foreground(styles.Current().TextHighlight)
 
-	mutedStyle := styles.Muted
+	mutedStyle := styles.Current().Muted
 
 	textStyle := lipgloss.NewStyle().
-		Foreground(styles.TextSecondary)
+		Foreground(styles.Current().TextSecondary)
 
 	bulletStyle := lipgloss.NewStyle().
-		Foreground(styles.TextPrimary)
+		Foreground(styles.Current().TextPrimary)
 
-	linkStyle := styles.Link
+	linkStyle := styles.Current().Link
 
 	codeBoxStyle := lipgloss.NewStyle().
-		Foreground(styles.Success).
+		Foreground(styles.Current().Success).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.BorderNormal).
+		BorderForeground(styles.Current().BorderNormal).
 		Padding(0, 1)
 
 	// Build content
@@ -335,6 +335,21 @@ func (a *Agent) CheckRunaway() bool {
 // StartAgent creates a tmux session and starts an agent for a worktree.
 // If a session already exists, it reconnects to it instead of failing.
 func (p *Plugin) StartAgent(wt *Worktree, agentType AgentType) tea.Cmd {
+	return p.startAgent(wt, agentType, false)
+}
+
+// ResumeAgent starts an agent with its resume command so it continues the
+// most recent session in the worktree. Falls back to a fresh start for agents
+// without a resume command.
+func (p *Plugin) ResumeAgent(wt *Worktree, agentType AgentType) tea.Cmd {
+	return p.startAgent(wt, agentType, true)
+}
+
+// startAgent implements StartAgent and ResumeAgent.
+func (p *Plugin) startAgent(wt *Worktree, agentType AgentType, resume bool) tea.Cmd {
+	if wt.Host != "" {
+		return p.startRemoteAgent(wt, agentType, resume)
+	}
 	epoch := p.ctx.Epoch // Capture epoch for stale detection
 	return func() tea.Msg {
 		sessionName := tmuxSessionPrefix + sanitizeName(wt.Name)
@@ -375,8 +390,8 @@ func (p *Plugin) StartAgent(wt *Worktree, agentType AgentType) tea.Cmd {
 		envCmd := fmt.Sprintf("export TD_SESSION_ID=%s", shellQuote(sessionName))
 		_ = exec.Command("tmux", "send-keys", "-t", sessionName, envCmd, "Enter").Run()
 
-		// Apply environment isolation to prevent conflicts (GOWORK, etc.)
-		envOverrides := BuildEnvOverrides(p.ctx.WorkDir)
+		// Apply environment isolation (GOWORK, etc.) and the worktree's .forge-env
+		envOverrides := BuildWorktreeEnvOverrides(p.ctx.WorkDir, wt.Path)
 		if envCmd := GenerateSingleEnvCommand(envOverrides); envCmd != "" {
 			_ = exec.Command("tmux", "send-keys", "-t", sessionName, envCmd, "Enter").Run()
 		}
@@ -391,7 +406,13 @@ func (p *Plugin) StartAgent(wt *Worktree, agentType AgentType) tea.Cmd {
 		time.Sleep(100 * time.Millisecond)
 
 		// Get the agent command with optional task context
-		agentCmd := p.getAgentCommandWithContext(agentType, wt)
+		agentCmd := ""
+		if resume {
+			agentCmd = AgentResumeCommands[agentType]
+		}
+		if agentCmd == "" {
+			agentCmd = p.getAgentCommandWithContext(agentType, wt)
+		}
 
 		// Send the agent command to start it
 		sendCmd := exec.Command("tmux", "send-keys", "-t", sessionName, agentCmd, "Enter")
@@ -414,6 +435,14 @@ func (p *Plugin) StartAgent(wt *Worktree, agentType AgentType) tea.Cmd {
 	}
 }
 
+// restartAgentType returns the agent type used when restarting wt's agent.
+func restartAgentType(wt *Worktree) AgentType {
+	if wt.ChosenAgentType != "" {
+		return wt.ChosenAgentType
+	}
+	return AgentClaude
+}
+
 // getAgentCommand returns the command to start an agent.
 func getAgentCommand(agentType AgentType) string {
 	if cmd, ok := AgentCommands[agentType]; ok {
@@ -533,6 +562,9 @@ func (p *Plugin) getAgentCommandWithContext(agentType AgentType, wt *Worktree) s
 // StartAgentWithOptions creates a tmux session and starts an agent with options.
 // If a session already exists, it reconnects to it instead of failing.
 func (p *Plugin) StartAgentWithOptions(wt *Worktree, agentType AgentType, skipPerms bool, prompt *Prompt) tea.Cmd {
+	if wt.Host != "" {
+		return p.startRemoteAgent(wt, agentType, false)
+	}
 	epoch := p.ctx.Epoch // Capture epoch for stale detection
 	return func() tea.Msg {
 		sessionName := tmuxSessionPrefix + sanitizeName(wt.Name)
@@ -573,8 +605,8 @@ func (p *Plugin) StartAgentWithOptions(wt *Worktree, agentType AgentType, skipPe
 		tdEnvCmd := fmt.Sprintf("export TD_SESSION_ID=%s", shellQuote(sessionName))
 		_ = exec.Command("tmux", "send-keys", "-t", sessionName, tdEnvCmd, "Enter").Run()
 
-		// Apply environment isolation to prevent conflicts (GOWORK, etc.)
-		envOverrides := BuildEnvOverrides(p.ctx.WorkDir)
+		// Apply environment isolation (GOWORK, etc.) and the worktree's .forge-env
+		envOverrides := BuildWorktreeEnvOverrides(p.ctx.WorkDir, wt.Path)
 		if envCmd := GenerateSingleEnvCommand(envOverrides); envCmd != "" {
 			_ = exec.Command("tmux", "send-keys", "-t", sessionName, envCmd, "Enter").Run()
 		}
@@ -612,6 +644,15 @@ func (p *Plugin) StartAgentWithOptions(wt *Worktree, agentType AgentType, skipPe
 	}
 }
 
+// startCreatedAgent starts the agent chosen in the create modal, or attaches
+// to the worktree directory when none was chosen.
+func (p *Plugin) startCreatedAgent(wt *Worktree, agentType AgentType, skipPerms bool, prompt *Prompt) tea.Cmd {
+	if agentType != AgentNone && agentType != "" {
+		return p.StartAgentWithOptions(wt, agentType, skipPerms, prompt)
+	}
+	return p.AttachToWorktreeDir(wt)
+}
+
 // AttachToWorktreeDir creates a tmux session in the worktree directory and attaches to it.
 func (p *Plugin) AttachToWorktreeDir(wt *Worktree) tea.Cmd {
 	sessionName := tmuxSessionPrefix + sanitizeName(wt.Name)
@@ -726,6 +767,7 @@ func (p *Plugin) scheduleAgentPoll(worktreeName string, delay time.Duration) tea
 	// Capture current generation for this worktree
 	gen := p.pollGeneration[worktreeName]
 	stagger := staggerOffset(worktreeName)
+	delay = p.remotePollDelay(worktreeName, delay)
 	return tea.Tick(delay+stagger, func(t time.Time) tea.Msg {
 		return pollAgentMsg{WorkspaceName: worktreeName, Generation: gen}
 	})
@@ -764,6 +806,9 @@ func (p *Plugin) handlePollAgent(worktreeName string) tea.Cmd {
 			return AgentStoppedMsg{WorkspaceName: worktreeName}
 		}
 	}
+	if wt.Host != "" {
+		return p.pollRemoteAgent(wt)
+	}
 
 	// Capture session name and worktree path before spawning goroutine
 	sessionName := wt.Agent.TmuxSession
@@ -854,9 +899,12 @@ func (p *Plugin) handlePollAgent(worktreeName string) tea.Cmd {
 		//   - session files: active vs waiting (reliable, tmux patterns are noisy for this)
 		// Session file detection ALWAYS runs (even when output unchanged) because the agent
 		// may finish while tmux output stays the same (td-2fca7d v8).
+		// Configured per-agent status patterns beat both: the user told us what this
+		// agent's pane looks like in each state.
 		status := currentStatus
 		waitingFor := ""
 		if !interactiveCapture {
+			patternStatus, patterned := classifyAgentOutput(agentType, output)
 			if outputChanged {
 				// Tmux pattern detection only when output changes (same output = same patterns).
 				status = detectStatus(output)
@@ -864,9 +912,18 @@ func (p *Plugin) handlePollAgent(worktreeName string) tea.Cmd {
 					waitingFor = extractPrompt(output)
 				}
 			}
-			// Session file check runs every poll — mtime changes independently of tmux output.
-			// Only override active/waiting; preserve tmux-detected thinking/done/error.
-			if status == StatusActive || status == StatusWaiting {
+			if patterned {
+				status = patternStatus
+				waitingFor = ""
+				if status == StatusWaiting {
+					waitingFor = extractPrompt(output)
+					if waitingFor == "" {
+						waitingFor = "Waiting for input"
+					}
+				}
+			} else if status == StatusActive || status == StatusWaiting {
+				// Session file check runs every poll — mtime changes independently of tmux output.
+				// Only override active/waiting; preserve tmux-detected thinking/done/error.
 				if sessionStatus, ok := detectAgentSessionStatus(agentType, wtPath); ok {
 					prevStatus := status
 					status = sessionStatus
@@ -1245,7 +1302,7 @@ func (p *Plugin) Approve(wt *Worktree) tea.Cmd {
 		}
 
 		// Send "y" followed by Enter
-		cmd := exec.Command("tmux", "send-keys", "-t", wt.Agent.TmuxSession, "y", "Enter")
+		cmd := p.tmuxCmd(wt, "send-keys", "-t", wt.Agent.TmuxSession, "y", "Enter")
 		err := cmd.Run()
 
 		return ApproveResultMsg{
@@ -1262,7 +1319,7 @@ func (p *Plugin) Reject(wt *Worktree) tea.Cmd {
 			return RejectResultMsg{WorkspaceName: wt.Name, Err: fmt.Errorf("no agent running")}
 		}
 
-		cmd := exec.Command("tmux", "send-keys", "-t", wt.Agent.TmuxSession, "n", "Enter")
+		cmd := p.tmuxCmd(wt, "send-keys", "-t", wt.Agent.TmuxSession, "n", "Enter")
 		err := cmd.Run()
 
 		return RejectResultMsg{
@@ -1296,13 +1353,13 @@ func (p *Plugin) SendText(wt *Worktree, text string) tea.Cmd {
 		}
 
 		// Use -l to send literal text (no key name lookup)
-		cmd := exec.Command("tmux", "send-keys", "-l", "-t", wt.Agent.TmuxSession, text)
+		cmd := p.tmuxCmd(wt, "send-keys", "-l", "-t", wt.Agent.TmuxSession, text)
 		if err := cmd.Run(); err != nil {
 			return SendTextResultMsg{Err: err}
 		}
 
 		// Send Enter separately
-		cmd = exec.Command("tmux", "send-keys", "-t", wt.Agent.TmuxSession, "Enter")
+		cmd = p.tmuxCmd(wt, "send-keys", "-t", wt.Agent.TmuxSession, "Enter")
 		err := cmd.Run()
 
 		return SendTextResultMsg{
@@ -1318,6 +1375,9 @@ func (p *Plugin) AttachToSession(wt *Worktree) tea.Cmd {
 	if wt.Agent == nil {
 		return nil
 	}
+	if wt.Host != "" {
+		return p.attachRemoteSession(wt)
+	}
 
 	sessionName := wt.Agent.TmuxSession
 	target := wt.Agent.TmuxPane
@@ -1333,6 +1393,9 @@ func (p *Plugin) AttachToSession(wt *Worktree) tea.Cmd {
 
 // StopAgent stops an agent running in a worktree.
 func (p *Plugin) StopAgent(wt *Worktree) tea.Cmd {
+	if wt.Host != "" && wt.Agent != nil {
+		return p.stopRemoteAgent(wt)
+	}
 	return func() tea.Msg {
 		if wt.Agent == nil {
 			return AgentStoppedMsg{WorkspaceName: wt.Name}
@@ -1376,8 +1439,9 @@ func (p *Plugin) detectOrphanedWorktrees() {
 			}
 			continue
 		}
-		// Skip if agent is connected
-		if wt.Agent != nil {
+		// Skip if agent is connected; remote sessions are checked by
+		// reconnectRemoteAgents instead of the local tmux server
+		if wt.Agent != nil || wt.Host != "" {
 			wt.IsOrphaned = false
 			continue
 		}
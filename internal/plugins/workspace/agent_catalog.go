@@ -0,0 +1,127 @@
+package workspace
+
+import (
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/config"
+)
+
+// Per-agent regexes matched against the last lines of an agent's output.
+// A match classifies the pane as waiting for input, working, or errored.
+// Populated from config.
+var (
+	agentIdlePatterns    = map[AgentType]*regexp.Regexp{}
+	agentWorkingPatterns = map[AgentType]*regexp.Regexp{}
+	agentErrorPatterns   = map[AgentType]*regexp.Regexp{}
+)
+
+// idlePatternLines is how many trailing output lines status patterns see.
+const idlePatternLines = 5
+
+// isReservedAgentType reports whether id is used internally and can't be
+// defined by the agent catalog.
+func isReservedAgentType(id AgentType) bool {
+	return id == AgentNone || id == AgentShell || id == AgentCustom
+}
+
+// agentCatalogOnce applies the configured catalog once per process. The
+// agent tables are read without locks by poll goroutines, so they must
+// not change after the first Init, e.g. on a project switch.
+var agentCatalogOnce sync.Once
+
+// loadAgentCatalog applies defs to the agent tables on the first call and
+// ignores later ones.
+func loadAgentCatalog(defs []config.AgentDefinition) {
+	agentCatalogOnce.Do(func() { applyAgentCatalog(defs) })
+}
+
+// applyAgentCatalog merges agents defined in config into the built-in agent
+// tables. An entry whose ID matches a built-in agent overrides only the fields
+// it sets; new entries are offered before "None" in the agent pickers.
+// Invalid entries are logged and skipped.
+func applyAgentCatalog(defs []config.AgentDefinition) {
+	for _, def := range defs {
+		id := AgentType(strings.TrimSpace(def.ID))
+		if id == "" || isReservedAgentType(id) {
+			slog.Warn("workspace: ignoring agent with empty or reserved id", "id", def.ID)
+			continue
+		}
+		_, known := AgentCommands[id]
+		if def.Command == "" && !known {
+			slog.Warn("workspace: ignoring agent without command", "id", id)
+			continue
+		}
+
+		if def.Command != "" {
+			AgentCommands[id] = def.Command
+		}
+		if def.Name != "" {
+			AgentDisplayNames[id] = def.Name
+		} else if AgentDisplayNames[id] == "" {
+			AgentDisplayNames[id] = string(id)
+		}
+		if shellAgentAbbreviations[id] == "" {
+			shellAgentAbbreviations[id] = AgentDisplayNames[id]
+		}
+		if def.ResumeCommand != "" {
+			AgentResumeCommands[id] = def.ResumeCommand
+		}
+		if def.SkipPermissionsFlag != "" {
+			SkipPermissionsFlags[id] = def.SkipPermissionsFlag
+		}
+		compileStatusPattern(agentIdlePatterns, id, "idle", def.IdlePattern)
+		compileStatusPattern(agentWorkingPatterns, id, "working", def.WorkingPattern)
+		compileStatusPattern(agentErrorPatterns, id, "error", def.ErrorPattern)
+
+		if !slices.Contains(AgentTypeOrder, id) {
+			noneIdx := slices.Index(AgentTypeOrder, AgentNone)
+			if noneIdx < 0 {
+				noneIdx = len(AgentTypeOrder)
+			}
+			AgentTypeOrder = slices.Insert(AgentTypeOrder, noneIdx, id)
+		}
+		if !slices.Contains(ShellAgentOrder, id) {
+			ShellAgentOrder = append(ShellAgentOrder, id)
+		}
+	}
+}
+
+// compileStatusPattern compiles a multi-line status regex into table.
+// Empty patterns are skipped; invalid ones are logged and skipped.
+func compileStatusPattern(table map[AgentType]*regexp.Regexp, id AgentType, kind, pattern string) {
+	if pattern == "" {
+		return
+	}
+	re, err := regexp.Compile("(?m)" + pattern)
+	if err != nil {
+		slog.Warn("workspace: invalid agent "+kind+" pattern", "id", id, "err", err)
+		return
+	}
+	table[id] = re
+}
+
+// classifyAgentOutput applies the agent's configured status patterns to the
+// tail of its output. Error wins over waiting, and waiting over working, so a
+// prompt printed under a spinner line still reads as waiting. ok is false when
+// no pattern matches, leaving status to the built-in heuristics.
+func classifyAgentOutput(agentType AgentType, output string) (status WorktreeStatus, ok bool) {
+	idle, working, failed := agentIdlePatterns[agentType], agentWorkingPatterns[agentType], agentErrorPatterns[agentType]
+	if idle == nil && working == nil && failed == nil {
+		return 0, false
+	}
+	tail := ansi.Strip(extractLastNLines(tailUTF8Safe(output, statusCheckBytes), idlePatternLines))
+	switch {
+	case failed != nil && failed.MatchString(tail):
+		return StatusError, true
+	case idle != nil && idle.MatchString(tail):
+		return StatusWaiting, true
+	case working != nil && working.MatchString(tail):
+		return StatusActive, true
+	}
+	return 0, false
+}
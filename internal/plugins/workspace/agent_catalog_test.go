@@ -0,0 +1,107 @@
+package workspace
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+)
+
+// restoreAgentTables snapshots the package-level agent tables and restores
+// them when the test ends.
+func restoreAgentTables(t *testing.T) {
+	t.Helper()
+	commands := maps.Clone(AgentCommands)
+	names := maps.Clone(AgentDisplayNames)
+	abbrevs := maps.Clone(shellAgentAbbreviations)
+	resume := maps.Clone(AgentResumeCommands)
+	flags := maps.Clone(SkipPermissionsFlags)
+	idle := maps.Clone(agentIdlePatterns)
+	working := maps.Clone(agentWorkingPatterns)
+	failed := maps.Clone(agentErrorPatterns)
+	order := slices.Clone(AgentTypeOrder)
+	shellOrder := slices.Clone(ShellAgentOrder)
+	t.Cleanup(func() {
+		AgentCommands, AgentDisplayNames, shellAgentAbbreviations = commands, names, abbrevs
+		AgentResumeCommands, SkipPermissionsFlags, agentIdlePatterns = resume, flags, idle
+		agentWorkingPatterns, agentErrorPatterns = working, failed
+		AgentTypeOrder, ShellAgentOrder = order, shellOrder
+	})
+}
+
+func TestApplyAgentCatalog(t *testing.T) {
+	restoreAgentTables(t)
+
+	applyAgentCatalog([]config.AgentDefinition{
+		{ID: "amp", Name: "Amp", Command: "amp", ResumeCommand: "amp --continue", SkipPermissionsFlag: "--yes", IdlePattern: `^amp> ?$`},
+		{ID: "claude", Command: "claude --model opus"},
+		{ID: "shell", Command: "bash"},
+		{ID: "nocmd"},
+		{ID: "badre", Command: "badre", IdlePattern: "("},
+	})
+	// Applying twice (project switch) must not duplicate picker entries
+	applyAgentCatalog([]config.AgentDefinition{{ID: "amp", Command: "amp"}})
+
+	amp := AgentType("amp")
+	if AgentCommands[amp] != "amp" || AgentDisplayNames[amp] != "Amp" || AgentResumeCommands[amp] != "amp --continue" || SkipPermissionsFlags[amp] != "--yes" {
+		t.Errorf("amp not registered: cmd=%q name=%q", AgentCommands[amp], AgentDisplayNames[amp])
+	}
+	if AgentCommands[AgentClaude] != "claude --model opus" || AgentDisplayNames[AgentClaude] != "Claude Code" {
+		t.Errorf("claude override = %q / %q", AgentCommands[AgentClaude], AgentDisplayNames[AgentClaude])
+	}
+	if AgentCommands[AgentShell] != "" || AgentCommands["nocmd"] != "" {
+		t.Error("reserved and command-less entries should be ignored")
+	}
+	if AgentCommands["badre"] != "badre" || agentIdlePatterns["badre"] != nil {
+		t.Error("an invalid idle pattern should be dropped without dropping the agent")
+	}
+
+	if n := len(AgentTypeOrder); AgentTypeOrder[n-1] != AgentNone || AgentTypeOrder[n-3] != amp {
+		t.Errorf("order = %v, want custom agents before None", AgentTypeOrder)
+	}
+	count := 0
+	for _, at := range ShellAgentOrder {
+		if at == amp {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("amp appears %d times in shell order, want 1", count)
+	}
+}
+
+func TestClassifyAgentOutput(t *testing.T) {
+	restoreAgentTables(t)
+	applyAgentCatalog([]config.AgentDefinition{{
+		ID:             "amp",
+		Command:        "amp",
+		IdlePattern:    `^amp> ?$`,
+		WorkingPattern: `esc to interrupt`,
+		ErrorPattern:   `^Error: `,
+	}})
+
+	tests := []struct {
+		name   string
+		agent  AgentType
+		output string
+		want   WorktreeStatus
+		ok     bool
+	}{
+		{"prompt at bottom", "amp", "working...\ndone\n\x1b[32mamp> \x1b[0m\n", StatusWaiting, true},
+		{"prompt scrolled out", "amp", "amp> \n1\n2\n3\n4\n5\n6\n", 0, false},
+		{"working", "amp", "editing main.go\n(esc to interrupt)\n", StatusActive, true},
+		{"prompt beats working", "amp", "(esc to interrupt)\namp> \n", StatusWaiting, true},
+		{"error beats prompt", "amp", "Error: rate limited\namp> \n", StatusError, true},
+		{"no match", "amp", "thinking about it\n", 0, false},
+		{"agent without patterns", AgentClaude, "amp> ", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := classifyAgentOutput(tt.agent, tt.output)
+			if ok != tt.ok || (ok && got != tt.want) {
+				t.Errorf("classifyAgentOutput() = %v, %v; want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
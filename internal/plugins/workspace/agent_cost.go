@@ -0,0 +1,127 @@
+package workspace
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/format"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+const (
+	// agentCostInterval is how often per-worktree agent spend is recomputed.
+	agentCostInterval = 30 * time.Second
+	// agentCostInitialDelay gives the first worktree refresh time to land.
+	agentCostInitialDelay = 2 * time.Second
+)
+
+// AgentCost is the cumulative agent usage recorded in one worktree.
+type AgentCost struct {
+	Tokens   int
+	Cost     float64 // Estimated USD; 0 when no adapter prices the sessions
+	Sessions int
+}
+
+// AgentCostsMsg delivers agent spend keyed by worktree name.
+type AgentCostsMsg struct {
+	Epoch uint64
+	Costs map[string]*AgentCost
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m AgentCostsMsg) GetEpoch() uint64 { return m.Epoch }
+
+// agentCostTickMsg triggers a periodic agent spend refresh.
+type agentCostTickMsg struct {
+	Epoch uint64
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m agentCostTickMsg) GetEpoch() uint64 { return m.Epoch }
+
+// scheduleAgentCosts schedules the next agent spend refresh.
+func (p *Plugin) scheduleAgentCosts(delay time.Duration) tea.Cmd {
+	if p.ctx == nil || len(p.ctx.Adapters) == 0 {
+		return nil
+	}
+	epoch := p.ctx.Epoch
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return agentCostTickMsg{Epoch: epoch}
+	})
+}
+
+// refreshAgentCosts sums the sessions every adapter reports for each
+// worktree directory. Adapters match sessions by their working directory,
+// so this counts every agent that ran in the worktree.
+func (p *Plugin) refreshAgentCosts() tea.Cmd {
+	if p.ctx == nil || len(p.ctx.Adapters) == 0 {
+		return nil
+	}
+	type target struct{ name, path string }
+	var targets []target
+	for _, wt := range p.worktrees {
+		if wt.IsMain || wt.IsMissing || wt.Host != "" {
+			continue
+		}
+		targets = append(targets, target{wt.Name, wt.Path})
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	adapters := make([]adapter.Adapter, 0, len(p.ctx.Adapters))
+	for _, a := range p.ctx.Adapters {
+		if a.Capabilities().Has(adapter.CapSessions) {
+			adapters = append(adapters, a)
+		}
+	}
+	epoch := p.ctx.Epoch
+
+	return func() tea.Msg {
+		costs := make(map[string]*AgentCost, len(targets))
+		for _, t := range targets {
+			var sessions []adapter.Session
+			for _, a := range adapters {
+				s, err := a.Sessions(t.path)
+				if err != nil {
+					continue
+				}
+				sessions = append(sessions, s...)
+			}
+			if c := sumAgentCost(sessions); c.Sessions > 0 {
+				costs[t.name] = c
+			}
+		}
+		return AgentCostsMsg{Epoch: epoch, Costs: costs}
+	}
+}
+
+// sumAgentCost totals token usage and estimated cost across sessions.
+func sumAgentCost(sessions []adapter.Session) *AgentCost {
+	c := &AgentCost{}
+	for _, s := range sessions {
+		c.Tokens += s.TotalTokens
+		c.Cost += s.EstCost
+		c.Sessions++
+	}
+	return c
+}
+
+// agentCostStyle colors the spend badge in unselected sidebar rows. Built
+// per render so theme switches apply.
+func agentCostStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Current().Warning)
+}
+
+// agentCostBadge formats a worktree's agent spend as cost when priced,
+// falling back to a token count.
+func agentCostBadge(c *AgentCost) string {
+	if c == nil || (c.Tokens == 0 && c.Cost == 0) {
+		return ""
+	}
+	if c.Cost > 0 {
+		return format.Cost(c.Cost)
+	}
+	return format.Compact(int64(c.Tokens), false) + " tok"
+}
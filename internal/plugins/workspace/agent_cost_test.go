@@ -0,0 +1,80 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/adapter"
+	"github.com/wilbur182/forge/internal/adapter/testutil"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+// pathAdapter returns sessions keyed by the directory it is asked about.
+type pathAdapter struct {
+	*testutil.FakeAdapter
+	byPath map[string][]adapter.Session
+}
+
+func (a *pathAdapter) Capabilities() adapter.CapabilitySet {
+	return adapter.CapabilitySet{adapter.CapSessions: true}
+}
+
+func (a *pathAdapter) Sessions(path string) ([]adapter.Session, error) {
+	return a.byPath[path], nil
+}
+
+func TestRefreshAgentCosts(t *testing.T) {
+	fake := &pathAdapter{
+		FakeAdapter: testutil.NewFakeAdapter("fake"),
+		byPath: map[string][]adapter.Session{
+			"/wt/auth": {
+				{ID: "a", TotalTokens: 1000, EstCost: 0.25},
+				{ID: "b", TotalTokens: 500, EstCost: 0.50},
+			},
+			"/wt/docs": {{ID: "c", TotalTokens: 12000}},
+			"/repo":    {{ID: "d", TotalTokens: 99, EstCost: 9}},
+		},
+	}
+	p := New()
+	p.ctx = &plugin.Context{Adapters: map[string]adapter.Adapter{"fake": fake}}
+	p.worktrees = []*Worktree{
+		{Name: "main", Path: "/repo", IsMain: true},
+		{Name: "auth", Path: "/wt/auth"},
+		{Name: "docs", Path: "/wt/docs"},
+		{Name: "idle", Path: "/wt/idle"},
+	}
+
+	msg := p.refreshAgentCosts()().(AgentCostsMsg)
+	if _, ok := msg.Costs["main"]; ok {
+		t.Error("the main worktree should not be attributed")
+	}
+	if _, ok := msg.Costs["idle"]; ok {
+		t.Error("worktrees without sessions should have no entry")
+	}
+	auth := msg.Costs["auth"]
+	if auth == nil || auth.Tokens != 1500 || auth.Cost != 0.75 || auth.Sessions != 2 {
+		t.Fatalf("auth cost = %+v", auth)
+	}
+
+	p.Update(msg)
+	if p.worktrees[1].AgentCost != auth {
+		t.Error("costs should be attached to worktrees")
+	}
+	if got := agentCostBadge(p.worktrees[1].AgentCost); got != "$0.75" {
+		t.Errorf("cost badge = %q, want $0.75", got)
+	}
+	if got := agentCostBadge(p.worktrees[2].AgentCost); got != "12.0k tok" {
+		t.Errorf("unpriced badge = %q, want token count", got)
+	}
+	if got := agentCostBadge(nil); got != "" {
+		t.Errorf("nil badge = %q", got)
+	}
+}
+
+func TestRefreshAgentCostsWithoutAdapters(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{}
+	p.worktrees = []*Worktree{{Name: "auth", Path: "/wt/auth"}}
+	if p.refreshAgentCosts() != nil || p.scheduleAgentCosts(agentCostInterval) != nil {
+		t.Error("no adapters means nothing to attribute")
+	}
+}
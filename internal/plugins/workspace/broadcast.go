@@ -0,0 +1,143 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+// BroadcastTarget is a running agent offered by the broadcast modal.
+type BroadcastTarget struct {
+	WorkspaceName string
+	TmuxSession   string
+	Host          string // Remote host; empty for local agents
+	Selected      bool
+}
+
+// BroadcastState holds the state for the broadcast modal.
+type BroadcastState struct {
+	Targets []*BroadcastTarget
+	Input   textinput.Model
+	Err     string
+}
+
+// BroadcastSentMsg reports which agents received a broadcast prompt.
+type BroadcastSentMsg struct {
+	Sent   []string // Worktree names
+	Failed []string // "name: error"
+}
+
+// openBroadcast opens the broadcast modal with every running worktree agent
+// selected.
+func (p *Plugin) openBroadcast() tea.Cmd {
+	var targets []*BroadcastTarget
+	for _, wt := range p.worktrees {
+		if wt.Agent != nil && wt.Agent.TmuxSession != "" {
+			targets = append(targets, &BroadcastTarget{
+				WorkspaceName: wt.Name,
+				TmuxSession:   wt.Agent.TmuxSession,
+				Host:          wt.Host,
+				Selected:      true,
+			})
+		}
+	}
+	if len(targets) == 0 {
+		return appmsg.ShowToast("No running agents to broadcast to", 2*time.Second)
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = "run the tests"
+	ti.CharLimit = 0
+	ti.Focus()
+
+	p.broadcastState = &BroadcastState{Targets: targets, Input: ti}
+	p.clearBroadcastModal()
+	p.viewMode = ViewModeBroadcast
+	return textinput.Blink
+}
+
+// closeBroadcast closes the broadcast modal.
+func (p *Plugin) closeBroadcast() {
+	p.broadcastState = nil
+	p.clearBroadcastModal()
+	p.viewMode = ViewModeList
+}
+
+// toggleAll selects every target, or none if all are already selected.
+func (s *BroadcastState) toggleAll() {
+	all := true
+	for _, t := range s.Targets {
+		all = all && t.Selected
+	}
+	for _, t := range s.Targets {
+		t.Selected = !all
+	}
+}
+
+// selected returns the targets checked in the modal.
+func (s *BroadcastState) selected() []*BroadcastTarget {
+	var targets []*BroadcastTarget
+	for _, t := range s.Targets {
+		if t.Selected {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// sendBroadcast types the prompt into each selected agent's tmux session
+// and presses Enter.
+func (p *Plugin) sendBroadcast() tea.Cmd {
+	s := p.broadcastState
+	if s == nil {
+		return nil
+	}
+	text := strings.TrimSpace(s.Input.Value())
+	if text == "" {
+		s.Err = "Enter a prompt to send"
+		return nil
+	}
+	targets := s.selected()
+	if len(targets) == 0 {
+		s.Err = "Select at least one agent"
+		return nil
+	}
+	p.closeBroadcast()
+
+	return func() tea.Msg {
+		var msg BroadcastSentMsg
+		for _, t := range targets {
+			var err error
+			if t.Host != "" {
+				remote := &Worktree{Host: t.Host}
+				err = p.tmuxCmd(remote, "send-keys", "-l", "-t", t.TmuxSession, text).Run()
+				if err == nil {
+					err = p.tmuxCmd(remote, "send-keys", "-t", t.TmuxSession, "Enter").Run()
+				}
+			} else {
+				err = sendLiteralToTmux(t.TmuxSession, text)
+				if err == nil {
+					err = sendKeyToTmux(t.TmuxSession, "Enter")
+				}
+			}
+			if err != nil {
+				msg.Failed = append(msg.Failed, fmt.Sprintf("%s: %v", t.WorkspaceName, err))
+				continue
+			}
+			msg.Sent = append(msg.Sent, t.WorkspaceName)
+		}
+		return msg
+	}
+}
+
+// broadcastResultToast summarizes a broadcast for the user.
+func broadcastResultToast(msg BroadcastSentMsg) tea.Cmd {
+	if len(msg.Failed) > 0 {
+		return appmsg.ShowToast(fmt.Sprintf("Sent to %d agent(s); failed: %s", len(msg.Sent), strings.Join(msg.Failed, ", ")), 4*time.Second)
+	}
+	return appmsg.ShowToast(fmt.Sprintf("Sent to %d agent(s)", len(msg.Sent)), 2*time.Second)
+}
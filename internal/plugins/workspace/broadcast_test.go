@@ -0,0 +1,76 @@
+package workspace
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func broadcastTestPlugin() *Plugin {
+	p := New()
+	p.width, p.height = 100, 40
+	p.worktrees = []*Worktree{
+		{Name: "exp-a", Agent: &Agent{TmuxSession: "forge-ws-nonexistent-a"}},
+		{Name: "idle"},
+		{Name: "exp-b", Agent: &Agent{TmuxSession: "forge-ws-nonexistent-b"}},
+	}
+	return p
+}
+
+func TestOpenBroadcast_SelectsRunningAgents(t *testing.T) {
+	p := broadcastTestPlugin()
+	p.openBroadcast()
+
+	s := p.broadcastState
+	if p.viewMode != ViewModeBroadcast || s == nil {
+		t.Fatal("expected broadcast modal to open")
+	}
+	if len(s.Targets) != 2 || s.Targets[0].WorkspaceName != "exp-a" || s.Targets[1].WorkspaceName != "exp-b" {
+		t.Fatalf("targets = %+v, want the two running agents", s.Targets)
+	}
+	if len(s.selected()) != 2 {
+		t.Error("running agents should be preselected")
+	}
+
+	p.handleBroadcastKeys(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if len(s.selected()) != 0 {
+		t.Error("ctrl+a should deselect all when all are selected")
+	}
+	p.handleBroadcastKeys(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if len(s.selected()) != 2 {
+		t.Error("ctrl+a should select all")
+	}
+}
+
+func TestOpenBroadcast_NoAgents(t *testing.T) {
+	p := New()
+	p.worktrees = []*Worktree{{Name: "idle"}}
+	if p.openBroadcast() == nil || p.viewMode == ViewModeBroadcast {
+		t.Error("expected a toast and no modal without running agents")
+	}
+}
+
+func TestSendBroadcast_Validates(t *testing.T) {
+	p := broadcastTestPlugin()
+	p.openBroadcast()
+
+	if p.sendBroadcast() != nil || p.broadcastState.Err == "" {
+		t.Error("empty prompt should be rejected")
+	}
+
+	p.broadcastState.Input.SetValue("run the tests")
+	p.broadcastState.toggleAll() // deselect all
+	if p.sendBroadcast() != nil || p.broadcastState.Err == "" {
+		t.Error("no selected agents should be rejected")
+	}
+
+	p.broadcastState.Targets[1].Selected = true
+	cmd := p.sendBroadcast()
+	if cmd == nil || p.viewMode != ViewModeList || p.broadcastState != nil {
+		t.Fatal("expected send command and the modal to close")
+	}
+	msg, ok := cmd().(BroadcastSentMsg)
+	if !ok || len(msg.Sent)+len(msg.Failed) != 1 {
+		t.Errorf("result = %+v, want exactly one target attempted", msg)
+	}
+}
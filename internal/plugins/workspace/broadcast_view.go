@@ -0,0 +1,81 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureBroadcastModal builds/rebuilds the broadcast modal.
+func (p *Plugin) ensureBroadcastModal() {
+	s := p.broadcastState
+	if s == nil {
+		return
+	}
+
+	modalW := 70
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if p.broadcastModal != nil && p.broadcastModalWidth == modalW {
+		return
+	}
+	p.broadcastModalWidth = modalW
+
+	m := modal.New("Broadcast to Agents",
+		modal.WithWidth(modalW),
+		modal.WithPrimaryAction(broadcastSendButtonID),
+		modal.WithHints(false),
+	)
+	m.AddSection(modal.InputWithLabel(broadcastInputID, "Prompt", &s.Input))
+	m.AddSection(p.broadcastErrorSection())
+	m.AddSection(modal.Spacer())
+	for i, t := range s.Targets {
+		m.AddSection(modal.Checkbox(fmt.Sprintf("%s%d", broadcastItemPfx, i), t.WorkspaceName, &t.Selected))
+	}
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Buttons(
+		modal.Btn(" Send ", broadcastSendButtonID, modal.BtnPrimary()),
+		modal.Btn(" Cancel ", broadcastCancelButtonID),
+	))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("Enter: send   Tab: agents   Space: toggle   Ctrl+A: toggle all   Esc: cancel")))
+	p.broadcastModal = m
+}
+
+// clearBroadcastModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearBroadcastModal() {
+	p.broadcastModal = nil
+	p.broadcastModalWidth = 0
+}
+
+// broadcastErrorSection shows why the prompt wasn't sent.
+func (p *Plugin) broadcastErrorSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if p.broadcastState == nil || p.broadcastState.Err == "" {
+			return modal.RenderedSection{}
+		}
+		return modal.RenderedSection{Content: lipgloss.NewStyle().Foreground(styles.Current().Error).Render(p.broadcastState.Err)}
+	}, nil)
+}
+
+// renderBroadcastModal renders the broadcast modal with dimmed background.
+func (p *Plugin) renderBroadcastModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureBroadcastModal()
+	if p.broadcastModal == nil {
+		return background
+	}
+
+	modalContent := p.broadcastModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
@@ -0,0 +1,266 @@
+package workspace
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/app"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+// defaultBulkNameTemplate names bulk-created worktrees like the create modal
+// does for a single linked task.
+const defaultBulkNameTemplate = "{{id}}-{{title}}"
+
+// bulkTitleMaxRunes caps the {{title}} slug so branch names stay readable.
+const bulkTitleMaxRunes = 40
+
+// BulkTask is a td task offered by the bulk create modal.
+type BulkTask struct {
+	Task
+	Selected bool
+}
+
+// BulkCreateState holds the state for the bulk create modal.
+type BulkCreateState struct {
+	Tasks       []*BulkTask
+	NameInput   textinput.Model // Name template with {{id}} and {{title}}
+	AgentIdx    int             // Index into AgentTypeOrder
+	StartAgents bool
+	Loading     bool
+	InProgress  bool
+	Err         string
+}
+
+// BulkTasksLoadedMsg delivers the open td tasks for the bulk create modal.
+type BulkTasksLoadedMsg struct {
+	Tasks []Task
+	Err   error
+}
+
+// BulkCreateDoneMsg reports the worktrees created from a task list.
+type BulkCreateDoneMsg struct {
+	Created     []*Worktree
+	Failed      []string           // "task-id: error"
+	Prompts     map[string]*Prompt // Worktree name -> task prompt
+	AgentType   AgentType
+	StartAgents bool
+}
+
+// openBulkCreate opens the bulk create modal and loads open td tasks.
+func (p *Plugin) openBulkCreate() tea.Cmd {
+	nameInput := textinput.New()
+	nameInput.SetValue(defaultBulkNameTemplate)
+	nameInput.Focus()
+
+	p.bulkCreateState = &BulkCreateState{
+		NameInput:   nameInput,
+		AgentIdx:    p.agentTypeIndex(AgentClaude),
+		StartAgents: true,
+		Loading:     true,
+	}
+	p.clearBulkCreateModal()
+	p.viewMode = ViewModeBulkCreate
+	return tea.Batch(textinput.Blink, p.loadBulkTasks())
+}
+
+// closeBulkCreate closes the bulk create modal.
+func (p *Plugin) closeBulkCreate() {
+	p.bulkCreateState = nil
+	p.clearBulkCreateModal()
+	p.viewMode = ViewModeList
+}
+
+// loadBulkTasks fetches the tasks that can be turned into worktrees.
+func (p *Plugin) loadBulkTasks() tea.Cmd {
+	workDir := p.ctx.WorkDir
+	return func() tea.Msg {
+		cmd := exec.Command("td", "list", "--json", "--status", "open,in_progress", "--limit", "500")
+		cmd.Dir = workDir
+		output, err := cmd.Output()
+		if err != nil {
+			return BulkTasksLoadedMsg{Err: fmt.Errorf("td list: %w", err)}
+		}
+		tasks, err := parseTDJSON(output)
+		return BulkTasksLoadedMsg{Tasks: tasks, Err: err}
+	}
+}
+
+// applyBulkTasks fills the modal with the loaded tasks.
+func (p *Plugin) applyBulkTasks(msg BulkTasksLoadedMsg) {
+	s := p.bulkCreateState
+	if s == nil {
+		return
+	}
+	s.Loading = false
+	if msg.Err != nil {
+		s.Err = msg.Err.Error()
+		return
+	}
+	for _, t := range msg.Tasks {
+		s.Tasks = append(s.Tasks, &BulkTask{Task: t})
+	}
+	if len(s.Tasks) == 0 {
+		s.Err = "No open tasks"
+	}
+	p.clearBulkCreateModal()
+}
+
+// toggleAll selects every task, or none if all are already selected.
+func (s *BulkCreateState) toggleAll() {
+	all := true
+	for _, t := range s.Tasks {
+		all = all && t.Selected
+	}
+	for _, t := range s.Tasks {
+		t.Selected = !all
+	}
+}
+
+// selected returns the tasks checked in the modal.
+func (s *BulkCreateState) selected() []Task {
+	var tasks []Task
+	for _, t := range s.Tasks {
+		if t.Selected {
+			tasks = append(tasks, t.Task)
+		}
+	}
+	return tasks
+}
+
+// expandBulkNameTemplate builds a branch name for a task. {{id}} expands to
+// the task ID and {{title}} to a branch-safe slug of its title.
+func expandBulkNameTemplate(template string, task Task) string {
+	title := SanitizeBranchName(strings.ToLower(task.Title))
+	if runes := []rune(title); len(runes) > bulkTitleMaxRunes {
+		title = string(runes[:bulkTitleMaxRunes])
+	}
+	title = strings.Trim(title, "-")
+
+	name := strings.NewReplacer("{{id}}", task.ID, "{{title}}", title).Replace(template)
+	name = SanitizeBranchName(name)
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	return strings.Trim(name, "-/")
+}
+
+// submitBulkCreate validates the modal and creates one worktree per
+// selected task.
+func (p *Plugin) submitBulkCreate() tea.Cmd {
+	s := p.bulkCreateState
+	if s == nil || s.Loading || s.InProgress {
+		return nil
+	}
+	template := strings.TrimSpace(s.NameInput.Value())
+	if !strings.Contains(template, "{{id}}") && !strings.Contains(template, "{{title}}") {
+		s.Err = "Name template must contain {{id}} or {{title}}"
+		return nil
+	}
+	tasks := s.selected()
+	if len(tasks) == 0 {
+		s.Err = "Select at least one task"
+		return nil
+	}
+
+	names := make([]string, len(tasks))
+	seen := make(map[string]bool, len(tasks))
+	for i, t := range tasks {
+		names[i] = expandBulkNameTemplate(template, t)
+		if valid, errs, _ := ValidateBranchName(names[i]); !valid {
+			s.Err = fmt.Sprintf("%s: %s", t.ID, strings.Join(errs, ", "))
+			return nil
+		}
+		if seen[names[i]] {
+			s.Err = fmt.Sprintf("Template gives two tasks the name %q", names[i])
+			return nil
+		}
+		seen[names[i]] = true
+	}
+
+	agentType := AgentNone
+	if s.AgentIdx >= 0 && s.AgentIdx < len(AgentTypeOrder) {
+		agentType = AgentTypeOrder[s.AgentIdx]
+	}
+	startAgents := s.StartAgents && agentType != AgentNone
+	s.Err = ""
+	s.InProgress = true
+
+	return func() tea.Msg {
+		// Sequential: concurrent `git worktree add` calls contend on the repo lock
+		msg := BulkCreateDoneMsg{AgentType: agentType, StartAgents: startAgents, Prompts: make(map[string]*Prompt)}
+		for i, t := range tasks {
+			wt, err := p.doCreateWorktree(names[i], "", t.ID, t.Title, agentType)
+			if err != nil {
+				msg.Failed = append(msg.Failed, fmt.Sprintf("%s: %v", t.ID, err))
+				continue
+			}
+			msg.Created = append(msg.Created, wt)
+			msg.Prompts[wt.Name] = bulkTaskPrompt(t)
+		}
+		return msg
+	}
+}
+
+// bulkTaskPrompt builds the prompt a bulk-created agent starts with: the
+// task's title and description from the td list.
+func bulkTaskPrompt(t Task) *Prompt {
+	body := fmt.Sprintf("Task %s: %s", t.ID, t.Title)
+	if desc := strings.TrimSpace(t.Description); desc != "" {
+		body += "\n\n" + desc
+	}
+	return &Prompt{Name: "bulk-create", TicketMode: TicketRequired, Body: body}
+}
+
+// applyBulkCreated adds the new worktrees to the list and starts their
+// agents, each prompted with its task's description.
+func (p *Plugin) applyBulkCreated(msg BulkCreateDoneMsg) tea.Cmd {
+	p.closeBulkCreate()
+	if len(msg.Created) == 0 {
+		return bulkCreateErrorToast("Bulk create failed: " + strings.Join(msg.Failed, "; "))
+	}
+
+	var cmds []tea.Cmd
+	for _, wt := range msg.Created {
+		p.worktrees = append(p.worktrees, wt)
+		cmds = append(cmds, app.WorktreesChanged(wt.Path, false))
+
+		prompt := msg.Prompts[wt.Name]
+		hookCmd := p.startPostCreateHooks(wt, msg.AgentType, false, prompt)
+		switch {
+		case hookCmd != nil:
+			wt.Hooks.noAgent = !msg.StartAgents
+			cmds = append(cmds, hookCmd)
+		case msg.StartAgents:
+			cmds = append(cmds, p.StartAgentWithOptions(wt, msg.AgentType, false, prompt))
+		}
+	}
+
+	p.shellSelected = false
+	p.selectedIdx = len(p.worktrees) - len(msg.Created)
+	p.previewOffset = 0
+	p.autoScrollOutput = true
+	p.resetScrollBaseLineCount()
+	p.saveSelectionState()
+	p.ensureVisible()
+	cmds = append(cmds, p.loadSelectedContent())
+
+	toast := fmt.Sprintf("Created %d worktree(s)", len(msg.Created))
+	if len(msg.Failed) > 0 {
+		cmds = append(cmds, bulkCreateErrorToast(fmt.Sprintf("%s; failed: %s", toast, strings.Join(msg.Failed, "; "))))
+	} else {
+		cmds = append(cmds, appmsg.ShowToast(toast, 2*time.Second))
+	}
+	return tea.Batch(cmds...)
+}
+
+// bulkCreateErrorToast shows a bulk create failure as an error toast.
+func bulkCreateErrorToast(message string) tea.Cmd {
+	return func() tea.Msg {
+		return appmsg.ToastMsg{Message: message, Duration: 5 * time.Second, IsError: true}
+	}
+}
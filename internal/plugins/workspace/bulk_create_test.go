@@ -0,0 +1,141 @@
+package workspace
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestExpandBulkNameTemplate(t *testing.T) {
+	task := Task{ID: "td-a1b2", Title: "Add user auth: OAuth?"}
+	tests := []struct {
+		template string
+		task     Task
+		want     string
+	}{
+		{defaultBulkNameTemplate, task, "td-a1b2-add-user-auth-oauth"},
+		{"feature/{{title}}", task, "feature/add-user-auth-oauth"},
+		{"{{id}}", task, "td-a1b2"},
+		{defaultBulkNameTemplate, Task{ID: "td-c3"}, "td-c3"},
+		{"{{title}}", Task{ID: "td-d4", Title: strings.Repeat("word ", 20)}, "word-word-word-word-word-word-word-word"},
+	}
+	for _, tt := range tests {
+		if got := expandBulkNameTemplate(tt.template, tt.task); got != tt.want {
+			t.Errorf("expandBulkNameTemplate(%q, %q) = %q, want %q", tt.template, tt.task.Title, got, tt.want)
+		}
+	}
+}
+
+func TestBulkCreateState_ToggleAll(t *testing.T) {
+	s := &BulkCreateState{Tasks: []*BulkTask{{Task: Task{ID: "a"}, Selected: true}, {Task: Task{ID: "b"}}}}
+	s.toggleAll()
+	if len(s.selected()) != 2 {
+		t.Fatalf("toggleAll with a partial selection should select all, got %d", len(s.selected()))
+	}
+	s.toggleAll()
+	if len(s.selected()) != 0 {
+		t.Fatalf("toggleAll with everything selected should clear, got %d", len(s.selected()))
+	}
+}
+
+func TestSubmitBulkCreate_Validation(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{}
+	p.openBulkCreate()
+	p.applyBulkTasks(BulkTasksLoadedMsg{Tasks: []Task{{ID: "td-1", Title: "Same"}, {ID: "td-2", Title: "Same"}}})
+	s := p.bulkCreateState
+
+	if cmd := p.submitBulkCreate(); cmd != nil || s.Err != "Select at least one task" {
+		t.Errorf("empty selection: err = %q", s.Err)
+	}
+	s.toggleAll()
+	s.NameInput.SetValue("{{title}}")
+	if cmd := p.submitBulkCreate(); cmd != nil || !strings.Contains(s.Err, "two tasks") {
+		t.Errorf("duplicate names: err = %q", s.Err)
+	}
+	s.NameInput.SetValue("fixed-name")
+	if cmd := p.submitBulkCreate(); cmd != nil || !strings.Contains(s.Err, "must contain") {
+		t.Errorf("template without placeholders: err = %q", s.Err)
+	}
+	if s.InProgress {
+		t.Error("failed validation must not start creation")
+	}
+}
+
+func TestBulkCreate_CreatesLinkedWorktrees(t *testing.T) {
+	repo, _ := newJanitorTestRepo(t)
+	p := New()
+	p.ctx = &plugin.Context{
+		WorkDir: repo,
+		Logger:  slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+	}
+	p.openBulkCreate()
+	p.applyBulkTasks(BulkTasksLoadedMsg{Tasks: []Task{{ID: "td-1", Title: "First task"}, {ID: "td-2", Title: "Second"}, {ID: "td-3", Title: "Skipped"}}})
+	s := p.bulkCreateState
+	s.Tasks[0].Selected = true
+	s.Tasks[1].Selected = true
+	s.AgentIdx = p.agentTypeIndex(AgentNone)
+
+	cmd := p.submitBulkCreate()
+	if cmd == nil {
+		t.Fatalf("submit failed: %s", s.Err)
+	}
+	done, ok := cmd().(BulkCreateDoneMsg)
+	if !ok || len(done.Created) != 2 || len(done.Failed) != 0 {
+		t.Fatalf("done = %+v", done)
+	}
+	if done.StartAgents {
+		t.Error("agents should not start when None is selected")
+	}
+	for i, want := range []string{"td-1-first-task", "td-2-second"} {
+		wt := done.Created[i]
+		taskID := s.Tasks[i].ID
+		if wt.Branch != want || wt.TaskID != taskID {
+			t.Errorf("created[%d] = %q/%q, want %q/%q", i, wt.Branch, wt.TaskID, want, taskID)
+		}
+		if got := loadTaskLink(wt.Path); got != taskID {
+			t.Errorf("%s linked task = %q, want %q", want, got, taskID)
+		}
+		if _, err := os.Stat(filepath.Join(filepath.Dir(repo), want)); err != nil {
+			t.Errorf("worktree dir missing: %v", err)
+		}
+		if pr := done.Prompts[wt.Name]; pr == nil || !strings.Contains(pr.Body, s.Tasks[i].Title) {
+			t.Errorf("%s prompt = %+v, want the task title", want, pr)
+		}
+	}
+
+	before := len(p.worktrees)
+	p.applyBulkCreated(done)
+	if p.viewMode != ViewModeList || p.bulkCreateState != nil {
+		t.Error("modal should close after creation")
+	}
+	if len(p.worktrees) != before+2 || p.selectedIdx != before {
+		t.Errorf("worktrees = %d, selected = %d; want first new worktree selected", len(p.worktrees), p.selectedIdx)
+	}
+}
+
+func TestBulkTaskPrompt_SentToAgent(t *testing.T) {
+	task := Task{ID: "td-1", Title: "Add login", Description: "Use `OAuth` and keep $SESSION.\n"}
+	prompt := bulkTaskPrompt(task)
+	if prompt.Body != "Task td-1: Add login\n\nUse `OAuth` and keep $SESSION." {
+		t.Errorf("body = %q", prompt.Body)
+	}
+
+	p := New()
+	wt := &Worktree{Name: "td-1-add-login", Path: t.TempDir(), TaskID: task.ID}
+	cmd := p.buildAgentCommand(AgentClaude, wt, false, prompt)
+	if !strings.Contains(cmd, ".forge-start.sh") {
+		t.Fatalf("expected a launcher carrying the prompt, got %q", cmd)
+	}
+	script, err := os.ReadFile(filepath.Join(wt.Path, ".forge-start.sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(script), prompt.Body) {
+		t.Errorf("launcher does not pass the task description:\n%s", script)
+	}
+}
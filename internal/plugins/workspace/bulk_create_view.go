@@ -0,0 +1,100 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureBulkCreateModal builds/rebuilds the bulk create modal.
+func (p *Plugin) ensureBulkCreateModal() {
+	s := p.bulkCreateState
+	if s == nil {
+		return
+	}
+
+	modalW := 80
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if p.bulkCreateModal != nil && p.bulkCreateModalWidth == modalW {
+		return
+	}
+	p.bulkCreateModalWidth = modalW
+
+	m := modal.New("Create Worktrees from Tasks",
+		modal.WithWidth(modalW),
+		modal.WithPrimaryAction(bulkCreateButtonID),
+		modal.WithHints(false),
+	)
+	m.AddSection(modal.InputWithLabel(bulkNameInputID, "Name template ({{id}}, {{title}})", &s.NameInput))
+	m.AddSection(p.bulkCreateStatusSection())
+	m.AddSection(modal.Spacer())
+	labelW := modalW - 12
+	for i, t := range s.Tasks {
+		label := truncateString(t.ID+"  "+t.Title, labelW)
+		m.AddSection(modal.Checkbox(fmt.Sprintf("%s%d", bulkTaskItemPfx, i), label, &t.Selected))
+	}
+	m.AddSection(modal.Spacer())
+
+	items := make([]modal.ListItem, len(AgentTypeOrder))
+	for i, at := range AgentTypeOrder {
+		items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", bulkAgentItemPfx, i), Label: AgentDisplayNames[at]}
+	}
+	m.AddSection(modal.Text("Agent"))
+	m.AddSection(modal.List(bulkAgentListID, items, &s.AgentIdx, modal.WithMaxVisible(len(items))))
+	m.AddSection(modal.Checkbox(bulkStartAgentsID, "Start agents with their task as the prompt", &s.StartAgents))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Buttons(
+		modal.Btn(" Create ", bulkCreateButtonID, modal.BtnPrimary()),
+		modal.Btn(" Cancel ", bulkCancelButtonID),
+	))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("Tab: next   Space: toggle   Ctrl+A: toggle all tasks   Enter: create   Esc: cancel")))
+	p.bulkCreateModal = m
+}
+
+// clearBulkCreateModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearBulkCreateModal() {
+	p.bulkCreateModal = nil
+	p.bulkCreateModalWidth = 0
+}
+
+// bulkCreateStatusSection shows loading, progress, or why creation failed.
+func (p *Plugin) bulkCreateStatusSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		s := p.bulkCreateState
+		switch {
+		case s == nil:
+			return modal.RenderedSection{}
+		case s.Loading:
+			return modal.RenderedSection{Content: dimText("Loading tasks...")}
+		case s.InProgress:
+			return modal.RenderedSection{Content: dimText(fmt.Sprintf("Creating %d worktree(s)...", len(s.selected())))}
+		case s.Err != "":
+			return modal.RenderedSection{Content: lipgloss.NewStyle().Foreground(styles.Current().Error).Width(contentWidth).Render(s.Err)}
+		}
+		return modal.RenderedSection{}
+	}, nil)
+}
+
+// renderBulkCreateModal renders the bulk create modal with dimmed background.
+func (p *Plugin) renderBulkCreateModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureBulkCreateModal()
+	if p.bulkCreateModal == nil {
+		return background
+	}
+
+	modalContent := p.bulkCreateModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
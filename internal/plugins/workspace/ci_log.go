@@ -0,0 +1,492 @@
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+const (
+	// ciLogPollInterval is how often the CI tab refreshes a run that has not
+	// finished yet.
+	ciLogPollInterval = 10 * time.Second
+
+	// ciRerunDelay gives GitHub time to register a re-run before reloading.
+	ciRerunDelay = 3 * time.Second
+
+	// ciLogMaxLines caps the lines kept per step; earlier lines are dropped.
+	ciLogMaxLines = 2000
+)
+
+// CIRun is the newest GitHub Actions run for a worktree's branch.
+type CIRun struct {
+	ID         int64  `json:"databaseId"`
+	Workflow   string `json:"workflowName"`
+	Title      string `json:"displayTitle"`
+	Status     string `json:"status"`     // queued, in_progress, completed, ...
+	Conclusion string `json:"conclusion"` // success, failure, cancelled, ... ("" until completed)
+	URL        string `json:"url"`
+}
+
+// Completed reports whether the run has finished.
+func (r *CIRun) Completed() bool {
+	return r.Status == "completed"
+}
+
+// Failed reports whether the run finished unsuccessfully.
+func (r *CIRun) Failed() bool {
+	return r.Completed() && r.Conclusion != "success" && r.Conclusion != "skipped" && r.Conclusion != "neutral"
+}
+
+// ciStep is one job step of a run, with its log lines once the run has
+// completed.
+type ciStep struct {
+	Job        string
+	Name       string
+	Status     string
+	Conclusion string
+	Lines      []string
+}
+
+// Failed reports whether the step failed or logged an error.
+func (s *ciStep) Failed() bool {
+	return s.Conclusion == "failure" || s.Conclusion == "timed_out"
+}
+
+// ciLogState caches the CI tab content for one worktree.
+type ciLogState struct {
+	WorkspaceName string
+	Branch        string
+	Run           *CIRun
+	Steps         []ciStep
+	Err           error
+	FetchedAt     time.Time
+}
+
+// CILogMsg delivers the latest run and its logs for a worktree.
+type CILogMsg struct {
+	Epoch         uint64
+	WorkspaceName string
+	Branch        string
+	Run           *CIRun
+	Steps         []ciStep
+	Err           error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m CILogMsg) GetEpoch() uint64 { return m.Epoch }
+
+// ciLogTickMsg triggers a CI tab refresh while a run is in progress.
+type ciLogTickMsg struct {
+	Epoch uint64
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m ciLogTickMsg) GetEpoch() uint64 { return m.Epoch }
+
+// CIRerunMsg reports the result of re-running a workflow run.
+type CIRerunMsg struct {
+	WorkspaceName string
+	RunID         int64
+	FailedOnly    bool
+	Err           error
+}
+
+// loadCILogIfNeeded fetches CI logs for the selected worktree unless a
+// recent copy is cached.
+func (p *Plugin) loadCILogIfNeeded() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil || wt.IsMain || p.shellSelected || p.ciLoading {
+		return nil
+	}
+	if p.ciLog != nil && p.ciLog.WorkspaceName == wt.Name && time.Since(p.ciLog.FetchedAt) < ciLogPollInterval {
+		return nil
+	}
+	return p.fetchCILog(wt)
+}
+
+// fetchCILog looks up the newest run for wt's branch and loads its jobs and,
+// once complete, its logs.
+func (p *Plugin) fetchCILog(wt *Worktree) tea.Cmd {
+	if _, err := exec.LookPath("gh"); err != nil {
+		p.ciLog = &ciLogState{WorkspaceName: wt.Name, Err: fmt.Errorf("gh CLI not found"), FetchedAt: time.Now()}
+		return nil
+	}
+	p.ciLoading = true
+	epoch := p.ctx.Epoch
+	name, branch := wt.Name, wt.Branch
+	// gh resolves the repository from its working directory; remote
+	// worktrees share the local checkout's repository.
+	dir, local := p.ctx.WorkDir, wt.Host == ""
+	if local {
+		dir = wt.Path
+	}
+	return func() tea.Msg {
+		if local {
+			if upstream := upstreamBranch(dir); upstream != "" {
+				branch = upstream
+			}
+		}
+		run, steps, err := loadCIRun(dir, branch)
+		return CILogMsg{Epoch: epoch, WorkspaceName: name, Branch: branch, Run: run, Steps: steps, Err: err}
+	}
+}
+
+// loadCIRun runs the gh commands behind fetchCILog. A nil run with a nil
+// error means the branch has no workflow runs.
+func loadCIRun(dir, branch string) (*CIRun, []ciStep, error) {
+	out, err := runGH(dir, "run", "list", "--branch", branch, "--limit", "1",
+		"--json", "databaseId,workflowName,displayTitle,status,conclusion,url")
+	if err != nil {
+		return nil, nil, err
+	}
+	var runs []CIRun
+	if err := json.Unmarshal(out, &runs); err != nil {
+		return nil, nil, fmt.Errorf("parse run list: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil, nil, nil
+	}
+	run := &runs[0]
+	id := strconv.FormatInt(run.ID, 10)
+
+	out, err = runGH(dir, "run", "view", id, "--json", "jobs")
+	if err != nil {
+		return run, nil, err
+	}
+	steps, err := parseCIJobs(out)
+	if err != nil {
+		return run, nil, err
+	}
+
+	// gh only serves logs for completed runs; until then the step list
+	// shows progress.
+	if !run.Completed() {
+		return run, steps, nil
+	}
+	out, err = runGH(dir, "run", "view", id, "--log")
+	if err != nil {
+		return run, steps, err
+	}
+	return run, mergeCILog(steps, out), nil
+}
+
+// runGH runs gh in dir and folds stderr into the returned error.
+func runGH(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("gh", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		errMsg := strings.TrimSpace(stderr.String())
+		if errMsg == "" {
+			errMsg = err.Error()
+		}
+		return nil, fmt.Errorf("gh %s: %s", strings.Join(args[:2], " "), errMsg)
+	}
+	return out, nil
+}
+
+// parseCIJobs decodes `gh run view --json jobs` into steps in run order.
+func parseCIJobs(data []byte) ([]ciStep, error) {
+	var resp struct {
+		Jobs []struct {
+			Name  string `json:"name"`
+			Steps []struct {
+				Name       string `json:"name"`
+				Status     string `json:"status"`
+				Conclusion string `json:"conclusion"`
+			} `json:"steps"`
+		} `json:"jobs"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parse jobs: %w", err)
+	}
+	var steps []ciStep
+	for _, job := range resp.Jobs {
+		for _, s := range job.Steps {
+			steps = append(steps, ciStep{Job: job.Name, Name: s.Name, Status: s.Status, Conclusion: s.Conclusion})
+		}
+	}
+	return steps, nil
+}
+
+// mergeCILog attaches `gh run view --log` output to steps. Each log line is
+// "job<TAB>step<TAB>timestamp message". Steps that only appear in the log
+// are appended, and a step that logged an error is marked failed.
+func mergeCILog(steps []ciStep, log []byte) []ciStep {
+	index := make(map[string]int, len(steps))
+	for i, s := range steps {
+		index[s.Job+"\t"+s.Name] = i
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(log))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		job, rest, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok {
+			continue
+		}
+		step, text, ok := strings.Cut(rest, "\t")
+		if !ok {
+			continue
+		}
+		key := job + "\t" + step
+		i, ok := index[key]
+		if !ok {
+			i = len(steps)
+			index[key] = i
+			steps = append(steps, ciStep{Job: job, Name: step, Status: "completed"})
+		}
+		text = stripCITimestamp(text)
+		if strings.HasPrefix(text, "##[error]") && steps[i].Conclusion == "" {
+			steps[i].Conclusion = "failure"
+		}
+		steps[i].Lines = append(steps[i].Lines, text)
+		if len(steps[i].Lines) > ciLogMaxLines {
+			steps[i].Lines = steps[i].Lines[len(steps[i].Lines)-ciLogMaxLines:]
+		}
+	}
+	return steps
+}
+
+// stripCITimestamp removes the RFC 3339 timestamp (and any BOM) GitHub
+// prefixes to every log line.
+func stripCITimestamp(text string) string {
+	text = strings.TrimPrefix(text, "\ufeff")
+	ts, rest, ok := strings.Cut(text, " ")
+	if !ok {
+		return text
+	}
+	if _, err := time.Parse(time.RFC3339Nano, ts); err != nil {
+		return text
+	}
+	return rest
+}
+
+// applyCILog stores a CILogMsg and schedules the next refresh while the run
+// is still going.
+func (p *Plugin) applyCILog(msg CILogMsg) tea.Cmd {
+	p.ciLoading = false
+	p.ciLog = &ciLogState{
+		WorkspaceName: msg.WorkspaceName,
+		Branch:        msg.Branch,
+		Run:           msg.Run,
+		Steps:         msg.Steps,
+		Err:           msg.Err,
+		FetchedAt:     time.Now(),
+	}
+	if msg.Run != nil && !msg.Run.Completed() {
+		return p.scheduleCILogPoll(ciLogPollInterval)
+	}
+	return nil
+}
+
+// scheduleCILogPoll schedules a CI tab refresh unless one is pending.
+func (p *Plugin) scheduleCILogPoll(delay time.Duration) tea.Cmd {
+	if p.ciPollScheduled {
+		return nil
+	}
+	p.ciPollScheduled = true
+	epoch := p.ctx.Epoch
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return ciLogTickMsg{Epoch: epoch}
+	})
+}
+
+// handleCILogTick refreshes the CI tab if it is still showing. The poll
+// stops when the user leaves the tab and restarts when they return.
+func (p *Plugin) handleCILogTick() tea.Cmd {
+	p.ciPollScheduled = false
+	if p.previewTab != PreviewTabCI {
+		return nil
+	}
+	wt := p.selectedWorktree()
+	if wt == nil || wt.IsMain || p.shellSelected || p.ciLoading {
+		return nil
+	}
+	return p.fetchCILog(wt)
+}
+
+// reloadCILog refetches the selected worktree's CI logs now.
+func (p *Plugin) reloadCILog() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil || wt.IsMain || p.shellSelected || p.ciLoading {
+		return nil
+	}
+	return p.fetchCILog(wt)
+}
+
+// toggleCIFolding switches between folding passing steps and showing all.
+func (p *Plugin) toggleCIFolding() {
+	p.ciShowAllSteps = !p.ciShowAllSteps
+	p.previewOffset = 0
+	p.autoScrollOutput = true
+}
+
+// rerunCI re-runs the shown run: only the failed jobs when it failed, the
+// whole run otherwise.
+func (p *Plugin) rerunCI() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil || p.ciLog == nil || p.ciLog.WorkspaceName != wt.Name || p.ciLog.Run == nil {
+		return appmsg.ShowToast("No CI run to re-run", 2*time.Second)
+	}
+	run := p.ciLog.Run
+	if !run.Completed() {
+		return appmsg.ShowToast("Run is still in progress", 2*time.Second)
+	}
+	name, dir := wt.Name, p.ctx.WorkDir
+	if wt.Host == "" {
+		dir = wt.Path
+	}
+	failedOnly := run.Failed()
+	return func() tea.Msg {
+		args := []string{"run", "rerun", strconv.FormatInt(run.ID, 10)}
+		if failedOnly {
+			args = append(args, "--failed")
+		}
+		_, err := runGH(dir, args...)
+		return CIRerunMsg{WorkspaceName: name, RunID: run.ID, FailedOnly: failedOnly, Err: err}
+	}
+}
+
+// applyCIRerun reports a re-run and reloads the tab once GitHub has
+// queued it.
+func (p *Plugin) applyCIRerun(msg CIRerunMsg) tea.Cmd {
+	if msg.Err != nil {
+		return func() tea.Msg {
+			return appmsg.ToastMsg{Message: msg.Err.Error(), Duration: 4 * time.Second, IsError: true}
+		}
+	}
+	if p.ciLog != nil && p.ciLog.WorkspaceName == msg.WorkspaceName {
+		p.ciLog.FetchedAt = time.Time{}
+	}
+	what := "all jobs"
+	if msg.FailedOnly {
+		what = "failed jobs"
+	}
+	return tea.Batch(
+		appmsg.ShowToast(fmt.Sprintf("Re-running %s of run %d", what, msg.RunID), 3*time.Second),
+		p.scheduleCILogPoll(ciRerunDelay),
+	)
+}
+
+// ciErrorStyle highlights gh errors and ##[error] log lines. Built per
+// render so theme switches apply.
+func ciErrorStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Current().Error)
+}
+
+// ciStatusStyle colors a run or step state.
+func ciStatusStyle(status, conclusion string) (string, lipgloss.Style) {
+	style := lipgloss.NewStyle()
+	switch {
+	case status != "" && status != "completed":
+		return "●", style.Foreground(styles.Current().Warning)
+	case conclusion == "success":
+		return "✓", style.Foreground(styles.Current().Success)
+	case conclusion == "skipped" || conclusion == "neutral" || conclusion == "cancelled":
+		return "-", style.Foreground(styles.Current().TextMuted)
+	case conclusion == "":
+		return "·", style.Foreground(styles.Current().TextMuted)
+	default:
+		return "✗", style.Foreground(styles.Current().Error)
+	}
+}
+
+// ciBodyLines renders the step list. Unless every step is shown, steps
+// that did not fail collapse to their header line.
+func (p *Plugin) ciBodyLines(steps []ciStep) []string {
+	var lines []string
+	for i := range steps {
+		s := &steps[i]
+		icon, style := ciStatusStyle(s.Status, s.Conclusion)
+		expanded := len(s.Lines) > 0 && (p.ciShowAllSteps || s.Failed())
+		marker := "▸"
+		if expanded {
+			marker = "▾"
+		}
+		header := fmt.Sprintf("%s %s %s › %s", marker, style.Render(icon), s.Job, s.Name)
+		if !expanded && len(s.Lines) > 0 {
+			header += dimText(fmt.Sprintf(" (%d lines)", len(s.Lines)))
+		}
+		lines = append(lines, header)
+		if !expanded {
+			continue
+		}
+		for _, l := range s.Lines {
+			if strings.HasPrefix(l, "##[error]") {
+				l = ciErrorStyle().Render(strings.TrimPrefix(l, "##[error]"))
+			}
+			lines = append(lines, "    "+l)
+		}
+	}
+	return lines
+}
+
+// renderCIContent renders the CI tab: a run summary and its step logs,
+// anchored to the bottom like agent output.
+func (p *Plugin) renderCIContent(width, height int) string {
+	wt := p.selectedWorktree()
+	if wt == nil {
+		return dimText("No worktree selected")
+	}
+	ci := p.ciLog
+	if ci == nil || ci.WorkspaceName != wt.Name {
+		return dimText("Loading CI runs...")
+	}
+	if ci.Run == nil {
+		if ci.Err != nil {
+			return ciErrorStyle().Render(ci.Err.Error())
+		}
+		return dimText(fmt.Sprintf("No workflow runs for branch %s", ci.Branch))
+	}
+
+	run := ci.Run
+	icon, style := ciStatusStyle(run.Status, run.Conclusion)
+	state := run.Conclusion
+	if !run.Completed() {
+		state = strings.ReplaceAll(run.Status, "_", " ")
+	}
+	foldHint := "[f] show all"
+	if p.ciShowAllSteps {
+		foldHint = "[f] fold passing"
+	}
+	header := []string{
+		lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s #%d", run.Workflow, run.ID)) + "  " +
+			style.Render(icon+" "+state) + "  " + dimText(foldHint+" • R re-run • r reload"),
+		dimText(run.Title),
+		strings.Repeat("─", min(width-4, 60)),
+	}
+	if ci.Err != nil {
+		header = append(header, ciErrorStyle().Render(ci.Err.Error()))
+	}
+
+	body := p.ciBodyLines(ci.Steps)
+	if !run.Completed() {
+		body = append(body, "", dimText(fmt.Sprintf("Logs appear when the run completes • refreshing every %s", ciLogPollInterval)))
+	}
+
+	visible := height - len(header)
+	if visible < 1 {
+		return strings.Join(header, "\n")
+	}
+	maxOffset := max(len(body)-visible, 0)
+	if p.previewOffset > maxOffset {
+		p.previewOffset = maxOffset
+	}
+	start := max(len(body)-visible-p.previewOffset, 0)
+	end := min(start+visible, len(body))
+	return strings.Join(append(header, body[start:end]...), "\n")
+}
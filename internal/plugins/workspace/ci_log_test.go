@@ -0,0 +1,117 @@
+package workspace
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+const ciJobsJSON = `{"jobs":[
+  {"name":"build","steps":[
+    {"name":"Set up job","status":"completed","conclusion":"success"},
+    {"name":"Run tests","status":"completed","conclusion":"failure"}
+  ]},
+  {"name":"lint","steps":[
+    {"name":"golangci","status":"completed","conclusion":"success"}
+  ]}
+]}`
+
+const ciLogOutput = "build\tSet up job\t2025-01-02T03:04:05.1234567Z Runner ready\n" +
+	"build\tRun tests\t\ufeff2025-01-02T03:04:06.0000000Z --- FAIL: TestThing\n" +
+	"build\tRun tests\t2025-01-02T03:04:07.0000000Z ##[error]Process completed with exit code 1.\n" +
+	"lint\tgolangci\t2025-01-02T03:04:08.0000000Z 0 issues\n" +
+	"lint\tPost cleanup\t2025-01-02T03:04:09.0000000Z done\n"
+
+func TestParseAndMergeCILog(t *testing.T) {
+	steps, err := parseCIJobs([]byte(ciJobsJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(steps))
+	}
+
+	steps = mergeCILog(steps, []byte(ciLogOutput))
+	if len(steps) != 4 {
+		t.Fatalf("log-only step should be appended, got %d steps", len(steps))
+	}
+	if got := steps[1].Lines; len(got) != 2 || got[0] != "--- FAIL: TestThing" {
+		t.Errorf("timestamps should be stripped, got %q", got)
+	}
+	if !steps[1].Failed() || steps[0].Failed() {
+		t.Error("only Run tests should be failed")
+	}
+	if steps[3].Job != "lint" || steps[3].Name != "Post cleanup" {
+		t.Errorf("unexpected appended step %+v", steps[3])
+	}
+}
+
+func TestMergeCILogMarksErrorSteps(t *testing.T) {
+	steps := mergeCILog(nil, []byte("build\tDeploy\t2025-01-02T03:04:05Z ##[error]boom\n"))
+	if len(steps) != 1 || !steps[0].Failed() {
+		t.Errorf("a step logging ##[error] without a conclusion should be failed: %+v", steps)
+	}
+}
+
+func TestCIBodyFoldsPassingSteps(t *testing.T) {
+	steps, _ := parseCIJobs([]byte(ciJobsJSON))
+	steps = mergeCILog(steps, []byte(ciLogOutput))
+	p := New()
+
+	folded := strings.Join(p.ciBodyLines(steps), "\n")
+	if strings.Contains(folded, "Runner ready") {
+		t.Error("passing step logs should be folded")
+	}
+	if !strings.Contains(folded, "TestThing") || !strings.Contains(folded, "(1 lines)") {
+		t.Errorf("failed step should be expanded and folded steps counted:\n%s", folded)
+	}
+
+	p.toggleCIFolding()
+	all := strings.Join(p.ciBodyLines(steps), "\n")
+	if !strings.Contains(all, "Runner ready") {
+		t.Error("show-all should expand passing steps")
+	}
+}
+
+func TestRenderCIContentStates(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{}
+	p.worktrees = []*Worktree{{Name: "feat", Branch: "feat"}}
+
+	if got := p.renderCIContent(80, 20); !strings.Contains(got, "Loading") {
+		t.Errorf("expected loading state, got %q", got)
+	}
+
+	p.applyCILog(CILogMsg{WorkspaceName: "feat", Branch: "feat"})
+	if got := p.renderCIContent(80, 20); !strings.Contains(got, "No workflow runs for branch feat") {
+		t.Errorf("expected empty state, got %q", got)
+	}
+
+	run := &CIRun{ID: 42, Workflow: "CI", Status: "in_progress"}
+	if cmd := p.applyCILog(CILogMsg{WorkspaceName: "feat", Run: run}); cmd == nil || !p.ciPollScheduled {
+		t.Error("an in-progress run should schedule a refresh")
+	}
+	got := p.renderCIContent(80, 20)
+	if !strings.Contains(got, "CI #42") || !strings.Contains(got, "in progress") {
+		t.Errorf("unexpected header: %q", got)
+	}
+	if cmd := p.scheduleCILogPoll(time.Second); cmd != nil {
+		t.Error("only one refresh should be pending at a time")
+	}
+}
+
+func TestCyclePreviewTabReachesCI(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{}
+	p.previewTab = PreviewTabTask
+	p.cyclePreviewTab(1)
+	if p.previewTab != PreviewTabCI {
+		t.Fatalf("expected CI tab after Task, got %d", p.previewTab)
+	}
+	p.cyclePreviewTab(1)
+	if p.previewTab != PreviewTabOutput {
+		t.Errorf("expected wrap to Output, got %d", p.previewTab)
+	}
+}
@@ -75,6 +75,66 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "cancel", Name: "Cancel", Description: "Cancel PR fetch", Context: "workspace-fetch-pr", Priority: 1},
 			{ID: "fetch", Name: "Fetch", Description: "Fetch selected PR", Context: "workspace-fetch-pr", Priority: 2},
 		}
+	case ViewModeRebase:
+		ctx := p.rebaseContext()
+		cmds := []plugin.Command{
+			{ID: "cancel", Name: "Close", Description: "Close rebase workflow", Context: ctx, Priority: 1},
+		}
+		if p.rebaseState != nil && p.rebaseState.Step == RebaseStepConflicts {
+			cmds = append(cmds,
+				plugin.Command{ID: "open-conflict", Name: "Open", Description: "Open conflicted file in file browser", Context: ctx, Priority: 2},
+				plugin.Command{ID: "continue-rebase", Name: "Continue", Description: "Stage resolved files and continue", Context: ctx, Priority: 3},
+				plugin.Command{ID: "abort-rebase", Name: "Abort", Description: "Abort rebase", Context: ctx, Priority: 4},
+			)
+		} else if p.rebaseState != nil && p.rebaseState.Step == RebaseStepPreview {
+			cmds = append(cmds, plugin.Command{ID: "start-rebase", Name: "Rebase", Description: "Start rebase", Context: ctx, Priority: 2})
+		}
+		return cmds
+	case ViewModeJanitor:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Close cleanup", Context: "workspace-cleanup", Priority: 1},
+			{ID: "delete-selected", Name: "Delete", Description: "Delete selected worktrees", Context: "workspace-cleanup", Priority: 2},
+			{ID: "toggle-all", Name: "All", Description: "Toggle all worktrees", Context: "workspace-cleanup", Priority: 3},
+		}
+	case ViewModeBroadcast:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Close without sending", Context: "workspace-broadcast", Priority: 1},
+			{ID: "send", Name: "Send", Description: "Send prompt to selected agents", Context: "workspace-broadcast", Priority: 2},
+			{ID: "toggle-all", Name: "All", Description: "Toggle all agents", Context: "workspace-broadcast", Priority: 3},
+		}
+	case ViewModePorts:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Close", Description: "Close ports", Context: "workspace-ports", Priority: 1},
+			{ID: "open-port", Name: "Open", Description: "Open port in browser", Context: "workspace-ports", Priority: 2},
+			{ID: "kill-port", Name: "Kill", Description: "Stop the listening process", Context: "workspace-ports", Priority: 3},
+		}
+	case ViewModeDiscover:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Close", Description: "Close session discovery", Context: "workspace-discover", Priority: 1},
+			{ID: "attach-session", Name: "Attach", Description: "Attach to the tmux session", Context: "workspace-discover", Priority: 2},
+			{ID: "adopt-session", Name: "Adopt", Description: "Add the session to the sidebar as a shell", Context: "workspace-discover", Priority: 3},
+		}
+	case ViewModeRemoteCreate:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Close without creating", Context: "workspace-remote-create", Priority: 1},
+			{ID: "create", Name: "Create", Description: "Create the workspace on the host", Context: "workspace-remote-create", Priority: 2},
+		}
+	case ViewModeBulkCreate:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Close without creating", Context: "workspace-bulk-create", Priority: 1},
+			{ID: "create", Name: "Create", Description: "Create a worktree per selected task", Context: "workspace-bulk-create", Priority: 2},
+			{ID: "toggle-all", Name: "All", Description: "Toggle all tasks", Context: "workspace-bulk-create", Priority: 3},
+		}
+	case ViewModeEnvEditor:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Close without saving", Context: "workspace-env-editor", Priority: 1},
+			{ID: "save", Name: "Save", Description: "Save .forge-env", Context: "workspace-env-editor", Priority: 2},
+		}
+	case ViewModeRepoSwitcher:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Close repo switcher", Context: "workspace-repo-switcher", Priority: 1},
+			{ID: "select", Name: "Switch", Description: "Manage the selected repo's workspaces", Context: "workspace-repo-switcher", Priority: 2},
+		}
 	case ViewModeFilePicker:
 		return []plugin.Command{
 			{ID: "cancel", Name: "Cancel", Description: "Close file picker", Context: "workspace-file-picker", Priority: 1},
@@ -87,6 +147,16 @@ func (p *Plugin) Commands() []plugin.Command {
 			viewToggleName = "List"
 		}
 
+		// Playback controls replace the usual commands while a replay is shown
+		if p.replayActive() {
+			return []plugin.Command{
+				{ID: "close-replay", Name: "Close", Description: "Return to live output", Context: "workspace-replay", Priority: 1},
+				{ID: "play-pause", Name: "Play", Description: "Play or pause the replay", Context: "workspace-replay", Priority: 2},
+				{ID: "step-forward", Name: "Step", Description: "Show the next frame", Context: "workspace-replay", Priority: 3},
+				{ID: "faster", Name: "Faster", Description: "Increase playback speed", Context: "workspace-replay", Priority: 4},
+			}
+		}
+
 		// Return different commands based on active pane
 		if p.activePane == PanePreview {
 			// Preview pane commands
@@ -101,6 +171,13 @@ func (p *Plugin) Commands() []plugin.Command {
 					plugin.Command{ID: "prev-tab", Name: "Tab←", Description: "Previous preview tab", Context: "workspace-preview", Priority: 3},
 					plugin.Command{ID: "next-tab", Name: "Tab→", Description: "Next preview tab", Context: "workspace-preview", Priority: 4},
 				)
+				if p.previewTab == PreviewTabOutput {
+					splitName := "Split"
+					if p.splitPreview {
+						splitName = "Unsplit"
+					}
+					cmds = append(cmds, plugin.Command{ID: "toggle-split-preview", Name: splitName, Description: "Show live diff next to agent output", Context: "workspace-preview", Priority: 5})
+				}
 				// Add diff view toggle when on Diff tab
 				if p.previewTab == PreviewTabDiff {
 					diffViewName := "Split"
@@ -117,6 +194,16 @@ func (p *Plugin) Commands() []plugin.Command {
 						)
 					}
 				}
+				if p.previewTab == PreviewTabCI {
+					foldName := "Unfold"
+					if p.ciShowAllSteps {
+						foldName = "Fold"
+					}
+					cmds = append(cmds,
+						plugin.Command{ID: "toggle-ci-folding", Name: foldName, Description: "Fold or show passing CI steps", Context: "workspace-preview", Priority: 5},
+						plugin.Command{ID: "rerun-ci", Name: "Re-run", Description: "Re-run the CI workflow (failed jobs only if it failed)", Context: "workspace-preview", Priority: 6},
+					)
+				}
 			}
 			// Also show agent commands in preview pane
 			wt := p.selectedWorktree()
@@ -169,6 +256,23 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "toggle-view", Name: viewToggleName, Description: "Toggle list/kanban view", Context: "workspace-list", Priority: 3},
 			{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Context: "workspace-list", Priority: 4},
 			{ID: "refresh", Name: "Refresh", Description: "Refresh workspace list", Context: "workspace-list", Priority: 5},
+			{ID: "cleanup", Name: "Cleanup", Description: "Clean up merged and stale worktrees", Context: "workspace-list", Priority: 18},
+			{ID: "broadcast", Name: "Broadcast", Description: "Send a prompt to several running agents", Context: "workspace-list", Priority: 21},
+			{ID: "ports", Name: "Ports", Description: "Show processes listening in the worktree", Context: "workspace-list", Priority: 22},
+			{ID: "discover-sessions", Name: "Discover", Description: "Attach to or adopt existing tmux sessions", Context: "workspace-list", Priority: 23},
+			{ID: "toggle-recording", Name: "Record", Description: "Start or stop recording the agent pane", Context: "workspace-list", Priority: 24},
+			{ID: "replay", Name: "Replay", Description: "Play back the latest recording", Context: "workspace-list", Priority: 25},
+			{ID: "toggle-split-preview", Name: "Split", Description: "Show live diff next to agent output", Context: "workspace-list", Priority: 26},
+			{ID: "bulk-create", Name: "Bulk", Description: "Create one worktree per td task", Context: "workspace-list", Priority: 29},
+		}
+		if len(p.undoStack) > 0 {
+			cmds = append(cmds, plugin.Command{ID: "undo-delete", Name: "Undo", Description: "Restore the last deleted workspace or shell", Context: "workspace-list", Priority: 28})
+		}
+		if len(p.remoteHosts()) > 0 {
+			cmds = append(cmds, plugin.Command{ID: "new-remote-workspace", Name: "Remote", Description: "Create workspace on a remote host", Context: "workspace-list", Priority: 27})
+		}
+		if p.multiRepo() {
+			cmds = append(cmds, plugin.Command{ID: "switch-repo", Name: "Repo", Description: "Switch repo", Context: "workspace-list", Priority: 19})
 		}
 
 		// Shell-specific commands when shell is selected
@@ -216,6 +320,8 @@ func (p *Plugin) Commands() []plugin.Command {
 				plugin.Command{ID: "push", Name: "Push", Description: "Push branch to remote", Context: "workspace-list", Priority: 6},
 				plugin.Command{ID: "merge-workflow", Name: "Merge", Description: "Start merge workflow", Context: "workspace-list", Priority: 7},
 				plugin.Command{ID: "open-in-git", Name: "Git", Description: "Open in Git tab", Context: "workspace-list", Priority: 16},
+				plugin.Command{ID: "rebase-workflow", Name: "Rebase", Description: "Start rebase workflow", Context: "workspace-list", Priority: 17},
+				plugin.Command{ID: "edit-env", Name: "Env", Description: "Edit worktree environment (.forge-env)", Context: "workspace-list", Priority: 20},
 			)
 			// Task linking
 			if wt.TaskID != "" {
@@ -262,9 +368,30 @@ func (p *Plugin) FocusContext() string {
 		return "workspace-type-selector"
 	case ViewModeFetchPR:
 		return "workspace-fetch-pr"
+	case ViewModeRebase:
+		return p.rebaseContext()
+	case ViewModeJanitor:
+		return "workspace-cleanup"
+	case ViewModeRepoSwitcher:
+		return "workspace-repo-switcher"
+	case ViewModeEnvEditor:
+		return "workspace-env-editor"
+	case ViewModeBroadcast:
+		return "workspace-broadcast"
+	case ViewModePorts:
+		return "workspace-ports"
+	case ViewModeDiscover:
+		return "workspace-discover"
+	case ViewModeRemoteCreate:
+		return "workspace-remote-create"
+	case ViewModeBulkCreate:
+		return "workspace-bulk-create"
 	case ViewModeFilePicker:
 		return "workspace-file-picker"
 	default:
+		if p.replayActive() {
+			return "workspace-replay"
+		}
 		if p.activePane == PanePreview {
 			return "workspace-preview"
 		}
@@ -283,7 +410,11 @@ func (p *Plugin) ConsumesTextInput() bool {
 		ViewModePromptPicker,
 		ViewModeRenameShell,
 		ViewModeTypeSelector,
-		ViewModeFetchPR:
+		ViewModeFetchPR,
+		ViewModeEnvEditor,
+		ViewModeBroadcast,
+		ViewModeRemoteCreate,
+		ViewModeBulkCreate:
 		return true
 	default:
 		return false
@@ -0,0 +1,126 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/event"
+	"github.com/wilbur182/forge/internal/notify"
+)
+
+// completionMarkerTail bounds how much of the newest output is scanned for
+// completion markers; captures can be megabytes of scrollback.
+const completionMarkerTail = 4096
+
+// isWorkingStatus reports whether the agent is busy.
+func isWorkingStatus(s WorktreeStatus) bool {
+	return s == StatusActive || s == StatusThinking
+}
+
+// isSettledStatus reports whether the agent stopped working: waiting for
+// input, finished, or errored.
+func isSettledStatus(s WorktreeStatus) bool {
+	return s == StatusWaiting || s == StatusDone || s == StatusError
+}
+
+// newCompletionMarker returns the first configured marker that appears in
+// the tail of output but did not in the previous capture. Empty output
+// (unchanged poll) leaves the marker state alone.
+func (p *Plugin) newCompletionMarker(name, output string) string {
+	if output == "" || p.ctx == nil || p.ctx.Config == nil {
+		return ""
+	}
+	tail := output[max(0, len(output)-completionMarkerTail):]
+	found := ""
+	for _, m := range p.ctx.Config.Plugins.Workspace.CompletionMarkers {
+		if m != "" && strings.Contains(tail, m) {
+			found = m
+			break
+		}
+	}
+	prev := p.seenMarkers[name]
+	if found == "" {
+		delete(p.seenMarkers, name)
+		return ""
+	}
+	p.seenMarkers[name] = found
+	if found == prev {
+		return ""
+	}
+	return found
+}
+
+// publishMergeCompleted publishes an event-bus event for the merge in
+// progress once its branch has landed on the target.
+func (p *Plugin) publishMergeCompleted() {
+	if p.ctx == nil || p.ctx.EventBus == nil || p.mergeState == nil || p.mergeState.Worktree == nil {
+		return
+	}
+	s := p.mergeState
+	c := event.MergeCompletion{
+		Workspace: s.Worktree.Name,
+		Branch:    s.Worktree.Branch,
+		Target:    s.TargetBranch,
+		Direct:    s.UseDirectMerge,
+		PRURL:     s.PRURL,
+	}
+	p.ctx.EventBus.Publish(event.TopicWorkspace, event.NewEvent(event.TypeMergeCompleted, event.TopicWorkspace, c))
+}
+
+// checkAgentCompletion detects an agent finishing (working → waiting, done,
+// or error, or a new completion marker in output), publishes an event-bus
+// event, and sends a desktop notification unless the output is on screen.
+func (p *Plugin) checkAgentCompletion(wt *Worktree, prev WorktreeStatus, output string) tea.Cmd {
+	marker := p.newCompletionMarker(wt.Name, output)
+	if marker == "" && !(isWorkingStatus(prev) && isSettledStatus(wt.Status)) {
+		return nil
+	}
+
+	c := event.AgentCompletion{Workspace: wt.Name, Status: wt.Status.String(), Marker: marker}
+	if wt.Agent != nil {
+		c.Agent = string(wt.Agent.Type)
+	}
+	if p.ctx != nil && p.ctx.EventBus != nil {
+		p.ctx.EventBus.Publish(event.TopicWorkspace, event.NewEvent(event.TypeAgentCompleted, event.TopicWorkspace, c))
+		if wt.Status == StatusError {
+			p.ctx.EventBus.Publish(event.TopicWorkspace, event.NewEvent(event.TypeSessionError, event.TopicWorkspace, c))
+		}
+	}
+
+	if p.ctx == nil || p.ctx.Config == nil || !p.ctx.Config.Plugins.Workspace.CompletionNotify {
+		return nil
+	}
+	if p.focused && p.outputVisibleForUnfocused(wt.Name) {
+		return nil // user is already watching this agent
+	}
+	title, body := completionNotification(c)
+	return func() tea.Msg {
+		_ = notify.Desktop(title, body)
+		return nil
+	}
+}
+
+// completionNotification returns the notification title and body.
+func completionNotification(c event.AgentCompletion) (string, string) {
+	agent := "Agent"
+	if c.Agent != "" {
+		agent = c.Agent
+		if name := AgentDisplayNames[AgentType(c.Agent)]; name != "" {
+			agent = name
+		}
+	}
+
+	var state string
+	switch {
+	case c.Marker != "":
+		state = fmt.Sprintf("printed %q", c.Marker)
+	case c.Status == StatusWaiting.String():
+		state = "is waiting for input"
+	case c.Status == StatusError.String():
+		state = "stopped with an error"
+	default:
+		state = "finished"
+	}
+	return "forge: " + c.Workspace, agent + " " + state
+}
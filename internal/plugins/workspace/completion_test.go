@@ -0,0 +1,110 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/event"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func newCompletionTestPlugin(t *testing.T) (*Plugin, <-chan event.Event) {
+	t.Helper()
+	cfg := config.Default()
+	cfg.Plugins.Workspace.CompletionNotify = false
+	cfg.Plugins.Workspace.CompletionMarkers = []string{"ALL DONE"}
+	bus := event.New()
+	p := New()
+	p.ctx = &plugin.Context{Config: cfg, EventBus: bus}
+	return p, bus.Subscribe(event.TopicWorkspace)
+}
+
+func TestCheckAgentCompletion_StatusTransition(t *testing.T) {
+	p, events := newCompletionTestPlugin(t)
+	wt := &Worktree{Name: "feat", Agent: &Agent{Type: AgentClaude}, Status: StatusWaiting}
+
+	p.checkAgentCompletion(wt, StatusActive, "")
+	select {
+	case e := <-events:
+		c, ok := e.Data.(event.AgentCompletion)
+		if e.Type != event.TypeAgentCompleted || !ok || c.Workspace != "feat" || c.Status != "waiting" || c.Agent != "claude" {
+			t.Errorf("unexpected event %+v", e)
+		}
+	default:
+		t.Fatal("expected completion event")
+	}
+
+	// Staying settled, or settling from a non-working state, is not a completion
+	p.checkAgentCompletion(wt, StatusWaiting, "")
+	wt.Status = StatusDone
+	p.checkAgentCompletion(wt, StatusPaused, "")
+	if len(events) != 0 {
+		t.Errorf("unexpected events: %d", len(events))
+	}
+}
+
+func TestCheckAgentCompletion_MarkerFiresOnce(t *testing.T) {
+	p, events := newCompletionTestPlugin(t)
+	wt := &Worktree{Name: "feat", Status: StatusActive}
+
+	p.checkAgentCompletion(wt, StatusActive, "running tests\nALL DONE\n")
+	p.checkAgentCompletion(wt, StatusActive, "running tests\nALL DONE\n$ ")
+	p.checkAgentCompletion(wt, StatusActive, "") // unchanged poll keeps state
+	if len(events) != 1 {
+		t.Fatalf("marker events = %d, want 1", len(events))
+	}
+	if c := (<-events).Data.(event.AgentCompletion); c.Marker != "ALL DONE" {
+		t.Errorf("marker = %q", c.Marker)
+	}
+
+	// Marker scrolls away and reappears: a new completion
+	p.checkAgentCompletion(wt, StatusActive, "next task")
+	p.checkAgentCompletion(wt, StatusActive, "ALL DONE")
+	if len(events) != 1 {
+		t.Errorf("reappearing marker events = %d, want 1", len(events))
+	}
+}
+
+func TestCheckAgentCompletion_SessionError(t *testing.T) {
+	p, events := newCompletionTestPlugin(t)
+	wt := &Worktree{Name: "feat", Status: StatusError}
+
+	p.checkAgentCompletion(wt, StatusThinking, "")
+	var types []event.Type
+	for len(events) > 0 {
+		types = append(types, (<-events).Type)
+	}
+	if len(types) != 2 || types[0] != event.TypeAgentCompleted || types[1] != event.TypeSessionError {
+		t.Errorf("event types = %v, want completion then session error", types)
+	}
+}
+
+func TestPublishMergeCompleted(t *testing.T) {
+	p, events := newCompletionTestPlugin(t)
+	p.mergeState = &MergeWorkflowState{
+		Worktree:       &Worktree{Name: "feat", Branch: "feat-x"},
+		TargetBranch:   "main",
+		UseDirectMerge: true,
+	}
+
+	p.publishMergeCompleted()
+	if len(events) != 1 {
+		t.Fatalf("merge events = %d, want 1", len(events))
+	}
+	e := <-events
+	c, ok := e.Data.(event.MergeCompletion)
+	if e.Type != event.TypeMergeCompleted || !ok || c.Workspace != "feat" || c.Branch != "feat-x" || c.Target != "main" || !c.Direct {
+		t.Errorf("unexpected event %+v", e)
+	}
+}
+
+func TestCompletionNotification(t *testing.T) {
+	title, body := completionNotification(event.AgentCompletion{Workspace: "feat", Agent: "claude", Status: "waiting"})
+	if title != "forge: feat" || body != "Claude Code is waiting for input" {
+		t.Errorf("got %q / %q", title, body)
+	}
+	_, body = completionNotification(event.AgentCompletion{Workspace: "feat", Marker: "ALL DONE", Status: "active"})
+	if body != `Agent printed "ALL DONE"` {
+		t.Errorf("marker body = %q", body)
+	}
+}
@@ -157,9 +157,9 @@ func (p *Plugin) createNameLabelSection() modal.Section {
 		nameValue := p.createNameInput.Value()
 		if nameValue != "" {
 			if p.branchNameValid {
-				label = "Name: " + lipgloss.NewStyle().Foreground(styles.Success).Render("✓")
+				label = "Name: " + lipgloss.NewStyle().Foreground(styles.Current().Success).Render("✓")
 			} else {
-				label = "Name: " + lipgloss.NewStyle().Foreground(styles.Error).Render("✗")
+				label = "Name: " + lipgloss.NewStyle().Foreground(styles.Current().Error).Render("✗")
 			}
 		}
 		return modal.RenderedSection{Content: label}
@@ -175,7 +175,7 @@ func (p *Plugin) createNameErrorsSection() modal.Section {
 
 		var lines []string
 		if len(p.branchNameErrors) > 0 {
-			errorStyle := lipgloss.NewStyle().Foreground(styles.Error)
+			errorStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 			lines = append(lines, errorStyle.Render("  ⚠ "+strings.Join(p.branchNameErrors, ", ")))
 		}
 		if p.branchNameSanitized != "" && p.branchNameSanitized != nameValue {
@@ -224,7 +224,7 @@ func (p *Plugin) createBranchDropdownSection() modal.Section {
 				}
 				line := prefix + branch
 				if i == p.branchIdx {
-					line = lipgloss.NewStyle().Foreground(styles.Primary).Render(line)
+					line = lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(line)
 				} else {
 					line = dimText(line)
 				}
@@ -422,7 +422,7 @@ func (p *Plugin) createTaskSection() modal.Section {
 					}
 					line := fmt.Sprintf("%s%s  %s", prefix, task.ID, title)
 					if i == p.taskSearchIdx {
-						line = lipgloss.NewStyle().Foreground(styles.Primary).Render(line)
+						line = lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(line)
 					} else {
 						line = dimText(line)
 					}
@@ -489,7 +489,7 @@ func (p *Plugin) createErrorSection() modal.Section {
 		if p.createError == "" {
 			return modal.RenderedSection{}
 		}
-		errStyle := lipgloss.NewStyle().Foreground(styles.Error)
+		errStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 		return modal.RenderedSection{Content: errStyle.Render("Error: " + p.createError)}
 	}, nil)
 }
@@ -15,7 +15,7 @@ func (p *Plugin) loadSelectedDiff() tea.Cmd {
 		return nil
 	}
 
-	cmds := []tea.Cmd{p.loadDiff(wt.Path, wt.Name)}
+	cmds := []tea.Cmd{p.loadWorktreeDiff(wt)}
 
 	// Also load task details if Task tab is active
 	if p.previewTab == PreviewTabTask && wt.TaskID != "" {
@@ -25,6 +25,14 @@ func (p *Plugin) loadSelectedDiff() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// loadWorktreeDiff loads wt's diff locally or over SSH for remote worktrees.
+func (p *Plugin) loadWorktreeDiff(wt *Worktree) tea.Cmd {
+	if wt.Host != "" {
+		return p.loadRemoteDiff(wt)
+	}
+	return p.loadDiff(wt.Path, wt.Name)
+}
+
 // loadDiff returns a command to load diff for a worktree.
 func (p *Plugin) loadDiff(path, name string) tea.Cmd {
 	epoch := p.ctx.Epoch // Capture epoch for stale detection
@@ -0,0 +1,194 @@
+package workspace
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/app"
+)
+
+// tmuxSessionListFormat is the list-sessions format parsed by parseTmuxSessionList.
+const tmuxSessionListFormat = "#{session_name}\t#{session_windows}\t#{session_attached}\t#{session_created}\t#{pane_current_path}"
+
+// TmuxSessionInfo describes a running tmux session.
+type TmuxSessionInfo struct {
+	Name     string
+	Windows  int
+	Attached bool // Another client is attached
+	Created  time.Time
+	Path     string // Working directory of the active pane
+}
+
+// TmuxSessionsDiscoveredMsg carries the tmux sessions forge does not manage.
+type TmuxSessionsDiscoveredMsg struct {
+	Sessions []TmuxSessionInfo
+	Err      error
+}
+
+// TmuxSessionDetachedMsg signals the user detached from a discovered session.
+type TmuxSessionDetachedMsg struct {
+	Err error
+}
+
+// DiscoverState holds the state for the discover sessions modal.
+type DiscoverState struct {
+	Sessions []TmuxSessionInfo
+	Idx      int
+	Loading  bool
+}
+
+// listTmuxSessions returns every session on the default tmux server.
+func listTmuxSessions() ([]TmuxSessionInfo, error) {
+	if !isTmuxInstalled() {
+		return nil, fmt.Errorf("tmux not installed: %s", getTmuxInstallInstructions())
+	}
+	out, err := exec.Command("tmux", "list-sessions", "-F", tmuxSessionListFormat).Output()
+	if err != nil {
+		// list-sessions fails when no server is running, which just means no sessions
+		return nil, nil
+	}
+	return parseTmuxSessionList(out), nil
+}
+
+// parseTmuxSessionList parses list-sessions output in tmuxSessionListFormat.
+func parseTmuxSessionList(out []byte) []TmuxSessionInfo {
+	var sessions []TmuxSessionInfo
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) < 5 || fields[0] == "" {
+			continue
+		}
+		info := TmuxSessionInfo{Name: fields[0], Path: fields[4]}
+		info.Windows, _ = strconv.Atoi(fields[1])
+		// session_attached is a client count in newer tmux versions
+		if n, _ := strconv.Atoi(fields[2]); n > 0 {
+			info.Attached = true
+		}
+		if secs, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+			info.Created = time.Unix(secs, 0)
+		}
+		sessions = append(sessions, info)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Name < sessions[j].Name })
+	return sessions
+}
+
+// isForgeTmuxSession reports whether a session name follows forge's own
+// workspace or shell naming, regardless of which project created it.
+func isForgeTmuxSession(name string) bool {
+	return strings.HasPrefix(name, tmuxSessionPrefix) || strings.HasPrefix(name, shellSessionPrefix) ||
+		strings.HasPrefix(name, shellTrashPrefix)
+}
+
+// foreignTmuxSessions filters out forge-created sessions and ones already
+// adopted as shells.
+func (p *Plugin) foreignTmuxSessions(all []TmuxSessionInfo) []TmuxSessionInfo {
+	var result []TmuxSessionInfo
+	for _, s := range all {
+		if isForgeTmuxSession(s.Name) || p.findShellByName(s.Name) != nil {
+			continue
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+// loadDiscoveredSessions lists tmux sessions asynchronously.
+func (p *Plugin) loadDiscoveredSessions() tea.Cmd {
+	return func() tea.Msg {
+		sessions, err := listTmuxSessions()
+		return TmuxSessionsDiscoveredMsg{Sessions: sessions, Err: err}
+	}
+}
+
+// openDiscover opens the discover sessions modal and starts a scan.
+func (p *Plugin) openDiscover() tea.Cmd {
+	p.discoverState = &DiscoverState{Loading: true}
+	p.clearDiscoverModal()
+	p.viewMode = ViewModeDiscover
+	return p.loadDiscoveredSessions()
+}
+
+// closeDiscover closes the discover sessions modal.
+func (p *Plugin) closeDiscover() {
+	p.discoverState = nil
+	p.clearDiscoverModal()
+	p.viewMode = ViewModeList
+}
+
+// applyDiscoveredSessions stores scan results in the open modal.
+func (p *Plugin) applyDiscoveredSessions(msg TmuxSessionsDiscoveredMsg) tea.Cmd {
+	if p.discoverState == nil {
+		return nil
+	}
+	if msg.Err != nil {
+		p.closeDiscover()
+		return func() tea.Msg {
+			return app.ToastMsg{Message: msg.Err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	p.discoverState.Loading = false
+	p.discoverState.Sessions = p.foreignTmuxSessions(msg.Sessions)
+	p.clearDiscoverModal()
+	return nil
+}
+
+// selectedDiscoveredSession returns the session highlighted in the modal.
+func (p *Plugin) selectedDiscoveredSession() (TmuxSessionInfo, bool) {
+	s := p.discoverState
+	if s == nil || s.Idx < 0 || s.Idx >= len(s.Sessions) {
+		return TmuxSessionInfo{}, false
+	}
+	return s.Sessions[s.Idx], true
+}
+
+// attachDiscoveredSession attaches to the highlighted session. The modal
+// stays open and is rescanned after detaching.
+func (p *Plugin) attachDiscoveredSession() tea.Cmd {
+	s, ok := p.selectedDiscoveredSession()
+	if !ok {
+		return nil
+	}
+	return p.attachWithResize(s.Name, s.Name, s.Name, func(err error) tea.Msg {
+		return TmuxSessionDetachedMsg{Err: err}
+	})
+}
+
+// adoptDiscoveredSession adds the highlighted session to the sidebar as a
+// shell. Adopted shells are persisted in the manifest and are released
+// rather than killed when deleted.
+func (p *Plugin) adoptDiscoveredSession() tea.Cmd {
+	s, ok := p.selectedDiscoveredSession()
+	if !ok {
+		return nil
+	}
+	p.closeDiscover()
+	return func() tea.Msg {
+		if !sessionExists(s.Name) {
+			return ShellCreatedMsg{SessionName: s.Name, Err: fmt.Errorf("tmux session %q no longer exists", s.Name)}
+		}
+		return ShellCreatedMsg{
+			SessionName: s.Name,
+			DisplayName: s.Name,
+			PaneID:      getPaneID(s.Name),
+			Adopted:     true,
+		}
+	}
+}
+
+// discoverLabel renders a session row for the discover modal.
+func discoverLabel(s TmuxSessionInfo) string {
+	detail := fmt.Sprintf("%d win", s.Windows)
+	if s.Attached {
+		detail += ", attached"
+	}
+	if s.Path != "" {
+		detail += "  " + s.Path
+	}
+	return s.Name + "  " + dimText(detail)
+}
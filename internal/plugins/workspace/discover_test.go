@@ -0,0 +1,86 @@
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseTmuxSessionList(t *testing.T) {
+	out := []byte("work\t3\t1\t1700000000\t/home/me/work\nsidecar-sh-proj-1\t1\t0\t1700000100\t/tmp\nbad line\nalpha\t1\t0\tx\t\n")
+	got := parseTmuxSessionList(out)
+	if len(got) != 3 {
+		t.Fatalf("got %d sessions, want 3: %+v", len(got), got)
+	}
+	if got[0].Name != "alpha" || got[0].Windows != 1 || got[0].Attached || !got[0].Created.IsZero() {
+		t.Errorf("alpha = %+v", got[0])
+	}
+	want := TmuxSessionInfo{Name: "work", Windows: 3, Attached: true, Created: time.Unix(1700000000, 0), Path: "/home/me/work"}
+	if got[2] != want {
+		t.Errorf("work = %+v, want %+v", got[2], want)
+	}
+}
+
+func TestForeignTmuxSessions(t *testing.T) {
+	p := New()
+	p.shells = []*ShellSession{{Name: "dev", TmuxName: "dev", Adopted: true}}
+
+	all := []TmuxSessionInfo{
+		{Name: "dev"},
+		{Name: tmuxSessionPrefix + "feature"},
+		{Name: shellSessionPrefix + "other-project-2"},
+		{Name: "notes"},
+	}
+	got := p.foreignTmuxSessions(all)
+	if len(got) != 1 || got[0].Name != "notes" {
+		t.Errorf("foreign = %+v, want only notes", got)
+	}
+}
+
+func TestDiscoverModal(t *testing.T) {
+	p := New()
+	p.width, p.height = 100, 40
+
+	p.openDiscover()
+	if p.viewMode != ViewModeDiscover || p.discoverState == nil || !p.discoverState.Loading {
+		t.Fatal("expected discover modal to open in loading state")
+	}
+	p.Update(TmuxSessionsDiscoveredMsg{Sessions: []TmuxSessionInfo{{Name: "api"}, {Name: "web"}}})
+	if p.discoverState.Loading || len(p.discoverState.Sessions) != 2 {
+		t.Fatalf("state = %+v", p.discoverState)
+	}
+
+	if got := p.View(p.width, p.height); got == "" {
+		t.Error("expected modal to render")
+	}
+	p.handleDiscoverKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	if s, ok := p.selectedDiscoveredSession(); !ok || s.Name != "web" {
+		t.Errorf("selected = %+v, want web", s)
+	}
+
+	p.handleDiscoverKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.viewMode != ViewModeList || p.discoverState != nil {
+		t.Error("esc should close the discover modal")
+	}
+}
+
+func TestAdoptedShellIsReleasedNotKilled(t *testing.T) {
+	p := New()
+	p.Update(ShellCreatedMsg{SessionName: "dev", DisplayName: "dev", Adopted: true})
+	if len(p.shells) != 1 || !p.shells[0].Adopted {
+		t.Fatalf("shells = %+v, want one adopted shell", p.shells)
+	}
+	if def := shellToDefinition(p.shells[0]); !def.Adopted {
+		t.Error("adopted flag should persist to the manifest")
+	}
+
+	p.deleteConfirmShell = p.shells[0]
+	cmd := p.executeShellDelete()
+	if cmd == nil {
+		t.Fatal("expected a command")
+	}
+	if msg, ok := cmd().(ShellKilledMsg); !ok || msg.SessionName != "dev" {
+		t.Errorf("msg = %#v, want ShellKilledMsg for dev", msg)
+	}
+}
@@ -0,0 +1,77 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureDiscoverModal builds/rebuilds the discover sessions modal.
+func (p *Plugin) ensureDiscoverModal() {
+	s := p.discoverState
+	if s == nil {
+		return
+	}
+
+	modalW := 70
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	if p.discoverModal != nil && p.discoverModalWidth == modalW {
+		return
+	}
+	p.discoverModalWidth = modalW
+	if s.Idx >= len(s.Sessions) {
+		s.Idx = max(len(s.Sessions)-1, 0)
+	}
+
+	m := modal.New("Discover tmux Sessions",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	)
+	switch {
+	case s.Loading:
+		m.AddSection(modal.Text(dimText("Scanning tmux sessions...")))
+	case len(s.Sessions) == 0:
+		m.AddSection(modal.Text("No tmux sessions outside forge were found."))
+	default:
+		items := make([]modal.ListItem, len(s.Sessions))
+		for i, sess := range s.Sessions {
+			items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", discoverItemPfx, i), Label: discoverLabel(sess)}
+		}
+		m.AddSection(modal.List(discoverListID, items, &s.Idx, modal.WithMaxVisible(min(len(items), 10))))
+	}
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Buttons(
+		modal.Btn(" Attach ", discoverAttachButtonID, modal.BtnPrimary()),
+		modal.Btn(" Adopt as Shell ", discoverAdoptButtonID),
+		modal.Btn(" Close ", discoverCloseButtonID),
+	))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("↑/↓: select   Enter/a: attach   s: adopt as shell   Esc: close")))
+	p.discoverModal = m
+}
+
+// clearDiscoverModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearDiscoverModal() {
+	p.discoverModal = nil
+	p.discoverModalWidth = 0
+}
+
+// renderDiscoverModal renders the discover sessions modal with dimmed background.
+func (p *Plugin) renderDiscoverModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureDiscoverModal()
+	if p.discoverModal == nil {
+		return background
+	}
+
+	modalContent := p.discoverModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
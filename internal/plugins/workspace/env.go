@@ -10,6 +10,10 @@ import (
 // worktreeEnvFile is the name of the per-repo environment override file.
 const worktreeEnvFile = ".worktree-env"
 
+// worktreeLocalEnvFile is the name of the per-worktree environment file,
+// applied on top of the repo's .worktree-env when launching an agent.
+const worktreeLocalEnvFile = ".forge-env"
+
 // DefaultEnvOverrides contains environment variables to clear/override for worktree isolation.
 // These prevent common issues when running commands in worktrees.
 var DefaultEnvOverrides = map[string]string{
@@ -31,8 +35,12 @@ var DefaultEnvOverrides = map[string]string{
 // parseWorktreeEnvFile reads a .worktree-env file and returns key=value pairs.
 // Returns empty map if file doesn't exist. Skips comments (lines starting with #).
 func parseWorktreeEnvFile(mainRepoPath string) (map[string]string, error) {
-	envPath := filepath.Join(mainRepoPath, worktreeEnvFile)
+	return parseEnvFile(filepath.Join(mainRepoPath, worktreeEnvFile))
+}
 
+// parseEnvFile reads a KEY=VALUE file. Returns empty map if the file
+// doesn't exist.
+func parseEnvFile(envPath string) (map[string]string, error) {
 	file, err := os.Open(envPath)
 	if os.IsNotExist(err) {
 		return map[string]string{}, nil
@@ -53,24 +61,8 @@ func parseWorktreeEnvFile(mainRepoPath string) (map[string]string, error) {
 			continue
 		}
 
-		// Parse KEY=VALUE
-		idx := strings.Index(line, "=")
-		if idx == -1 {
-			continue // Skip malformed lines
-		}
-
-		key := strings.TrimSpace(line[:idx])
-		value := strings.TrimSpace(line[idx+1:])
-
-		// Remove surrounding quotes if present
-		if len(value) >= 2 {
-			if (value[0] == '"' && value[len(value)-1] == '"') ||
-				(value[0] == '\'' && value[len(value)-1] == '\'') {
-				value = value[1 : len(value)-1]
-			}
-		}
-
-		if key != "" {
+		// Parse KEY=VALUE, skipping malformed lines
+		if key, value, ok := parseEnvLine(line); ok {
 			overrides[key] = value
 		}
 	}
@@ -78,6 +70,24 @@ func parseWorktreeEnvFile(mainRepoPath string) (map[string]string, error) {
 	return overrides, scanner.Err()
 }
 
+// parseEnvLine parses a KEY=VALUE line, removing surrounding quotes from
+// the value. ok is false for malformed lines.
+func parseEnvLine(line string) (key, value string, ok bool) {
+	idx := strings.Index(line, "=")
+	if idx == -1 {
+		return "", "", false
+	}
+	key = strings.TrimSpace(line[:idx])
+	value = strings.TrimSpace(line[idx+1:])
+	if len(value) >= 2 {
+		if (value[0] == '"' && value[len(value)-1] == '"') ||
+			(value[0] == '\'' && value[len(value)-1] == '\'') {
+			value = value[1 : len(value)-1]
+		}
+	}
+	return key, value, key != ""
+}
+
 // BuildEnvOverrides returns the combined environment overrides for a worktree.
 // User overrides from .worktree-env take precedence over defaults.
 func BuildEnvOverrides(mainRepoPath string) map[string]string {
@@ -98,6 +108,28 @@ func BuildEnvOverrides(mainRepoPath string) map[string]string {
 	return result
 }
 
+// BuildWorktreeEnvOverrides returns the environment for an agent launched
+// in a worktree: BuildEnvOverrides plus the worktree's .forge-env, which
+// takes precedence. Keys that aren't valid variable names are dropped, as
+// they end up in shell export commands.
+func BuildWorktreeEnvOverrides(mainRepoPath, worktreePath string) map[string]string {
+	result := BuildEnvOverrides(mainRepoPath)
+	if worktreePath != "" {
+		local, err := parseEnvFile(filepath.Join(worktreePath, worktreeLocalEnvFile))
+		if err == nil {
+			for k, v := range local {
+				result[k] = v
+			}
+		}
+	}
+	for k := range result {
+		if !envKeyPattern.MatchString(k) {
+			delete(result, k)
+		}
+	}
+	return result
+}
+
 // GenerateExportCommands generates shell commands to apply environment overrides.
 // Empty values generate unset commands, non-empty generate export commands.
 func GenerateExportCommands(overrides map[string]string) []string {
@@ -0,0 +1,109 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+// envKeyPattern matches valid shell environment variable names.
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvEditorState holds the state for the per-worktree env editor modal.
+type EnvEditorState struct {
+	Worktree *Worktree
+	Text     textarea.Model
+	Err      string
+}
+
+// openEnvEditor opens the env editor for the selected worktree, loaded with
+// its current .forge-env.
+func (p *Plugin) openEnvEditor() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil {
+		return nil
+	}
+	if wt.IsMissing {
+		return appmsg.ShowToast("Worktree folder is missing", 2*time.Second)
+	}
+	data, err := os.ReadFile(filepath.Join(wt.Path, worktreeLocalEnvFile))
+	if err != nil && !os.IsNotExist(err) {
+		return appmsg.ShowToast("Read "+worktreeLocalEnvFile+" failed: "+err.Error(), 3*time.Second)
+	}
+
+	ta := textarea.New()
+	ta.Placeholder = "API_KEY=...\nFEATURE_FLAG=1"
+	ta.ShowLineNumbers = false
+	ta.CharLimit = 0
+	ta.SetValue(strings.TrimRight(string(data), "\n"))
+	ta.Focus()
+
+	p.envEditor = &EnvEditorState{Worktree: wt, Text: ta}
+	p.clearEnvEditorModal()
+	p.viewMode = ViewModeEnvEditor
+	return textarea.Blink
+}
+
+// closeEnvEditor closes the env editor without saving.
+func (p *Plugin) closeEnvEditor() {
+	p.envEditor = nil
+	p.clearEnvEditorModal()
+	p.viewMode = ViewModeList
+}
+
+// saveEnvEditor validates and writes the worktree's .forge-env. An empty
+// editor removes the file. The file is private since it often holds keys.
+func (p *Plugin) saveEnvEditor() tea.Cmd {
+	s := p.envEditor
+	if s == nil {
+		return nil
+	}
+	content := strings.TrimSpace(s.Text.Value())
+	if err := validateEnvFile(content); err != nil {
+		s.Err = err.Error()
+		return nil
+	}
+
+	path := filepath.Join(s.Worktree.Path, worktreeLocalEnvFile)
+	name := s.Worktree.Name
+	var err error
+	if content == "" {
+		if err = os.Remove(path); os.IsNotExist(err) {
+			err = nil
+		}
+	} else {
+		err = os.WriteFile(path, []byte(content+"\n"), 0600)
+	}
+	if err != nil {
+		s.Err = err.Error()
+		return nil
+	}
+	p.closeEnvEditor()
+	return appmsg.ShowToast(fmt.Sprintf("Saved env for %s (applies on next agent start)", name), 2*time.Second)
+}
+
+// validateEnvFile checks that every non-comment line is KEY=VALUE with a
+// valid variable name.
+func validateEnvFile(content string) error {
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, _, ok := parseEnvLine(line)
+		if !ok {
+			return fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+		if !envKeyPattern.MatchString(key) {
+			return fmt.Errorf("line %d: invalid variable name %q", i+1, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func envEditorTestPlugin(t *testing.T) (*Plugin, string) {
+	t.Helper()
+	dir := t.TempDir()
+	p := New()
+	p.width, p.height = 100, 40
+	p.worktrees = []*Worktree{{Name: "feat", Path: dir}}
+	return p, dir
+}
+
+func TestEnvEditor_SaveAndRemove(t *testing.T) {
+	p, dir := envEditorTestPlugin(t)
+	path := filepath.Join(dir, worktreeLocalEnvFile)
+
+	p.openEnvEditor()
+	if p.viewMode != ViewModeEnvEditor || p.envEditor == nil {
+		t.Fatal("expected env editor to open")
+	}
+	p.envEditor.Text.SetValue("# keys\nAPI_KEY=abc\n")
+	p.handleEnvEditorKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	if p.viewMode != ViewModeList || p.envEditor != nil {
+		t.Error("expected editor to close after save")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("env file not written: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("env file mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	// Reopening loads the saved content; clearing it removes the file
+	p.openEnvEditor()
+	if got := p.envEditor.Text.Value(); got != "# keys\nAPI_KEY=abc" {
+		t.Errorf("loaded %q", got)
+	}
+	p.envEditor.Text.SetValue("")
+	p.saveEnvEditor()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("empty env should remove the file")
+	}
+}
+
+func TestEnvEditor_RejectsInvalidLines(t *testing.T) {
+	p, dir := envEditorTestPlugin(t)
+	p.openEnvEditor()
+	p.envEditor.Text.SetValue("GOOD=1\nBAD-NAME=2")
+	p.saveEnvEditor()
+
+	if p.envEditor == nil || p.envEditor.Err == "" {
+		t.Fatal("expected a validation error and the editor to stay open")
+	}
+	if _, err := os.Stat(filepath.Join(dir, worktreeLocalEnvFile)); !os.IsNotExist(err) {
+		t.Error("invalid env should not be written")
+	}
+
+	p.handleEnvEditorKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.viewMode != ViewModeList || p.envEditor != nil {
+		t.Error("esc should close the editor")
+	}
+}
@@ -0,0 +1,77 @@
+package workspace
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureEnvEditorModal builds/rebuilds the env editor modal.
+func (p *Plugin) ensureEnvEditorModal() {
+	s := p.envEditor
+	if s == nil {
+		return
+	}
+
+	modalW := 70
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if p.envEditorModal != nil && p.envEditorModalWidth == modalW {
+		return
+	}
+	p.envEditorModalWidth = modalW
+
+	m := modal.New("Environment: "+s.Worktree.Name,
+		modal.WithWidth(modalW),
+		modal.WithPrimaryAction(envSaveButtonID),
+		modal.WithHints(false),
+	)
+	m.AddSection(modal.Text(dimText("KEY=VALUE per line, # for comments. Exported in the agent's tmux session.")))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Textarea(envTextareaID, &s.Text, 10))
+	m.AddSection(p.envEditorErrorSection())
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Buttons(
+		modal.Btn(" Save ", envSaveButtonID, modal.BtnPrimary()),
+		modal.Btn(" Cancel ", envCancelButtonID),
+	))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("Ctrl+S: save   Tab: buttons   Esc: cancel")))
+	p.envEditorModal = m
+}
+
+// clearEnvEditorModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearEnvEditorModal() {
+	p.envEditorModal = nil
+	p.envEditorModalWidth = 0
+}
+
+// envEditorErrorSection shows the last validation or write error.
+func (p *Plugin) envEditorErrorSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if p.envEditor == nil || p.envEditor.Err == "" {
+			return modal.RenderedSection{}
+		}
+		return modal.RenderedSection{Content: lipgloss.NewStyle().Foreground(styles.Current().Error).Render(p.envEditor.Err)}
+	}, nil)
+}
+
+// renderEnvEditorModal renders the env editor modal with dimmed background.
+func (p *Plugin) renderEnvEditorModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureEnvEditorModal()
+	if p.envEditorModal == nil {
+		return background
+	}
+
+	modalContent := p.envEditorModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
@@ -143,6 +143,29 @@ func TestBuildEnvOverrides(t *testing.T) {
 	}
 }
 
+func TestBuildWorktreeEnvOverrides(t *testing.T) {
+	repo, wt := t.TempDir(), t.TempDir()
+	if err := os.WriteFile(filepath.Join(repo, worktreeEnvFile), []byte("SHARED=repo\nAPI_KEY=repo"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(wt, worktreeLocalEnvFile), []byte("API_KEY=wt\nX;touch /tmp/pwned=1\nBAD-KEY=1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := BuildWorktreeEnvOverrides(repo, wt)
+	if result["API_KEY"] != "wt" {
+		t.Errorf("worktree .forge-env should take precedence: API_KEY=%q", result["API_KEY"])
+	}
+	if result["SHARED"] != "repo" || result["GOWORK"] != "off" {
+		t.Errorf("repo and default overrides should remain: %v", result)
+	}
+	for k := range result {
+		if !envKeyPattern.MatchString(k) {
+			t.Errorf("invalid key %q should be dropped", k)
+		}
+	}
+}
+
 func TestGenerateExportCommands(t *testing.T) {
 	overrides := map[string]string{
 		"GOWORK":  "off",
@@ -51,7 +51,7 @@ func (p *Plugin) fetchPRContentSection() modal.Section {
 		}
 
 		if p.fetchPRError != "" {
-			errStyle := lipgloss.NewStyle().Foreground(styles.Error)
+			errStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 			lines = append(lines, errStyle.Render(p.fetchPRError))
 			return modal.RenderedSection{Content: strings.Join(lines, "\n")}
 		}
@@ -66,7 +66,7 @@ func (p *Plugin) fetchPRContentSection() modal.Section {
 		filterStyle = filterStyle.Width(inputW)
 		filterDisplay := p.fetchPRFilter
 		if filterDisplay == "" {
-			filterDisplay = lipgloss.NewStyle().Foreground(styles.Muted.GetForeground()).Render("type to filter...")
+			filterDisplay = lipgloss.NewStyle().Foreground(styles.Current().Muted.GetForeground()).Render("type to filter...")
 		}
 		lines = append(lines, filterStyle.Render(filterDisplay))
 
@@ -124,7 +124,7 @@ func (p *Plugin) fetchPRContentSection() modal.Section {
 				}
 
 				if i == p.fetchPRCursor {
-					lines = append(lines, lipgloss.NewStyle().Foreground(styles.Primary).Render(line))
+					lines = append(lines, lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(line))
 				} else {
 					lines = append(lines, dimText(line))
 				}
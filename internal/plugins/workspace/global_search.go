@@ -0,0 +1,66 @@
+package workspace
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/palette"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+// GlobalSearch contributes worktree name, branch, and linked task matches to
+// the app-wide search.
+func (p *Plugin) GlobalSearch(query string) func() []plugin.SearchResult {
+	if len(p.worktrees) == 0 || strings.TrimSpace(query) == "" {
+		return nil
+	}
+	type entry struct{ name, branch, task string }
+	entries := make([]entry, 0, len(p.worktrees))
+	for _, wt := range p.worktrees {
+		entries = append(entries, entry{wt.Name, wt.Branch, wt.TaskTitle})
+	}
+	return func() []plugin.SearchResult {
+		var results []plugin.SearchResult
+		for _, e := range entries {
+			nameScore, _ := palette.FuzzyMatch(query, e.name)
+			branchScore, _ := palette.FuzzyMatch(query, e.branch)
+			taskScore, _ := palette.FuzzyMatch(query, e.task)
+			score := max(nameScore*3, branchScore*2, taskScore)
+			if score == 0 {
+				continue
+			}
+			detail := e.branch
+			if e.task != "" {
+				detail += " · " + e.task
+			}
+			results = append(results, plugin.SearchResult{
+				Kind:     plugin.SearchKindWorktree,
+				Title:    e.name,
+				Detail:   detail,
+				Score:    score,
+				PluginID: pluginID,
+				Open:     SelectWorktreeMsg{Name: e.name},
+			})
+		}
+		return results
+	}
+}
+
+// selectWorktreeByName moves the selection to the named worktree.
+func (p *Plugin) selectWorktreeByName(name string) tea.Cmd {
+	for i, wt := range p.worktrees {
+		if wt.Name != name {
+			continue
+		}
+		p.viewMode = ViewModeList
+		p.shellSelected = false
+		p.selectedIdx = i
+		p.previewOffset = 0
+		p.autoScrollOutput = true
+		p.resetScrollBaseLineCount()
+		p.saveSelectionState()
+		p.ensureVisible()
+		return p.loadSelectedContent()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package workspace
+
+import (
+	"testing"
+)
+
+func TestGlobalSearch(t *testing.T) {
+	p := &Plugin{
+		worktrees: []*Worktree{
+			{Name: "auth-refactor", Branch: "feature/auth"},
+			{Name: "billing", Branch: "fix/invoices", TaskTitle: "Fix auth token refresh"},
+			{Name: "docs", Branch: "docs/readme"},
+		},
+	}
+
+	results := p.GlobalSearch("auth")()
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	names := map[string]bool{}
+	for _, r := range results {
+		names[r.Title] = true
+		if open, ok := r.Open.(SelectWorktreeMsg); !ok || open.Name != r.Title {
+			t.Errorf("result %q has Open %#v", r.Title, r.Open)
+		}
+	}
+	if !names["auth-refactor"] || !names["billing"] {
+		t.Errorf("unexpected results %v", names)
+	}
+
+	if (&Plugin{}).GlobalSearch("auth") != nil {
+		t.Error("expected nil search without worktrees")
+	}
+}
@@ -0,0 +1,241 @@
+package workspace
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+// hookOutputMaxLines caps the retained output of a hook run.
+const hookOutputMaxLines = 500
+
+// HookRun tracks the post-create hooks of one worktree.
+type HookRun struct {
+	Commands   []string
+	Current    int      // index of the running (or failed) command
+	Output     []string // combined stdout/stderr, newest last
+	Running    bool
+	Err        error // set when a hook failed
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	// Agent start requested in the create modal, deferred until the hooks pass
+	agentType AgentType
+	skipPerms bool
+	prompt    *Prompt
+	noAgent   bool // Bulk-created without an agent: nothing to start or attach
+}
+
+// Blocking reports whether the run is holding back the agent start: still
+// running, or stopped on a failing hook.
+func (r *HookRun) Blocking() bool {
+	return r != nil && (r.Running || r.Err != nil)
+}
+
+// appendLine adds an output line, dropping the oldest past the cap.
+func (r *HookRun) appendLine(line string) {
+	r.Output = append(r.Output, line)
+	if over := len(r.Output) - hookOutputMaxLines; over > 0 {
+		r.Output = append(r.Output[:0], r.Output[over:]...)
+	}
+}
+
+// hookEvent is sent by the runner goroutine for each output line and once
+// when the run finishes.
+type hookEvent struct {
+	index int
+	line  string
+	done  bool
+	err   error
+}
+
+// HookOutputMsg delivers one line of hook output.
+type HookOutputMsg struct {
+	Epoch         uint64
+	WorkspaceName string
+	Index         int
+	Line          string
+	events        <-chan hookEvent
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m HookOutputMsg) GetEpoch() uint64 { return m.Epoch }
+
+// HookDoneMsg reports that a worktree's hooks finished. Err is set when a
+// hook failed; the remaining hooks were skipped.
+type HookDoneMsg struct {
+	Epoch         uint64
+	WorkspaceName string
+	Err           error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m HookDoneMsg) GetEpoch() uint64 { return m.Epoch }
+
+// postCreateHooks returns the configured post-create hook commands.
+func (p *Plugin) postCreateHooks() []string {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return nil
+	}
+	var hooks []string
+	for _, h := range p.ctx.Config.Plugins.Workspace.PostCreateHooks {
+		if strings.TrimSpace(h) != "" {
+			hooks = append(hooks, h)
+		}
+	}
+	return hooks
+}
+
+// startPostCreateHooks runs the configured hooks in a new worktree and
+// records the agent start to perform once they pass. Returns nil when no
+// hooks are configured, in which case the caller starts the agent itself.
+func (p *Plugin) startPostCreateHooks(wt *Worktree, agentType AgentType, skipPerms bool, prompt *Prompt) tea.Cmd {
+	hooks := p.postCreateHooks()
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	run := &HookRun{
+		Commands:  hooks,
+		Running:   true,
+		StartedAt: time.Now(),
+		agentType: agentType,
+		skipPerms: skipPerms,
+		prompt:    prompt,
+	}
+	p.hookRuns[wt.Name] = run
+	wt.Hooks = run
+
+	epoch := p.ctx.Epoch
+	name := wt.Name
+	dir := wt.Path
+	env := p.setupEnv(wt.Path, wt.Branch)
+	return func() tea.Msg {
+		events := make(chan hookEvent, 64)
+		go runHooks(hooks, dir, env, events)
+		return nextHookEvent(epoch, name, events)
+	}
+}
+
+// listenForHookEvents waits for the next event from a hook runner.
+func listenForHookEvents(epoch uint64, name string, events <-chan hookEvent) tea.Cmd {
+	return func() tea.Msg {
+		return nextHookEvent(epoch, name, events)
+	}
+}
+
+// nextHookEvent converts the next runner event into a message.
+func nextHookEvent(epoch uint64, name string, events <-chan hookEvent) tea.Msg {
+	evt, ok := <-events
+	if !ok || evt.done {
+		return HookDoneMsg{Epoch: epoch, WorkspaceName: name, Err: evt.err}
+	}
+	return HookOutputMsg{Epoch: epoch, WorkspaceName: name, Index: evt.index, Line: evt.line, events: events}
+}
+
+// runHooks runs each command in order through bash, stopping at the first
+// failure, and closes events when done.
+func runHooks(commands []string, dir string, env []string, events chan<- hookEvent) {
+	defer close(events)
+	for i, command := range commands {
+		events <- hookEvent{index: i, line: "$ " + command}
+		if err := runHook(i, command, dir, env, events); err != nil {
+			events <- hookEvent{index: i, done: true, err: fmt.Errorf("%s: %w", command, err)}
+			return
+		}
+	}
+	events <- hookEvent{done: true}
+}
+
+// runHook runs one command, streaming combined output line by line.
+func runHook(index int, command, dir string, env []string, events chan<- hookEvent) error {
+	pr, pw := io.Pipe()
+	cmd := exec.Command("bash", "-c", command)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+	if err := cmd.Start(); err != nil {
+		_ = pw.Close()
+		return err
+	}
+
+	waitErr := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		_ = pw.Close()
+		waitErr <- err
+	}()
+
+	scanner := bufio.NewScanner(pr)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		// Progress bars redraw with \r; keep only the final state of the line
+		line := strings.TrimRight(scanner.Text(), "\r")
+		line = line[strings.LastIndex(line, "\r")+1:]
+		events <- hookEvent{index: index, line: line}
+	}
+	// Keep draining so the command never blocks on a full pipe
+	_, _ = io.Copy(io.Discard, pr)
+	return <-waitErr
+}
+
+// hookBadge returns the list badge for a running or failed hook run.
+func hookBadge(r *HookRun) string {
+	switch {
+	case !r.Blocking():
+		return ""
+	case r.Running:
+		return fmt.Sprintf("⟳ hooks %d/%d", r.Current+1, len(r.Commands))
+	}
+	return "✗ hook failed"
+}
+
+// styledHookBadge renders hookBadge with the status color.
+func styledHookBadge(r *HookRun) string {
+	badge := hookBadge(r)
+	if badge == "" {
+		return ""
+	}
+	if r.Running {
+		return styles.Current().StatusModified.Render(badge)
+	}
+	return styles.Current().StatusDeleted.Render(badge)
+}
+
+// renderHookOutput renders the hook run status and the tail of its output.
+func renderHookOutput(r *HookRun, width, height int) string {
+	var out []string
+	switch {
+	case r.Running:
+		out = append(out, styles.Current().StatusModified.Render(fmt.Sprintf("Running post-create hook %d/%d", r.Current+1, len(r.Commands))))
+	case r.Err != nil:
+		out = append(out, styles.Current().StatusDeleted.Render("Post-create hook failed: "+r.Err.Error()),
+			dimText("Press 's' to start an agent anyway"))
+	default:
+		out = append(out, styles.Current().StatusCompleted.Render("Post-create hooks finished"))
+	}
+	for i := range out {
+		out[i] = ansi.Truncate(out[i], width, "…")
+	}
+	height -= len(out)
+	if height <= 0 {
+		return strings.Join(out, "\n")
+	}
+
+	lines := r.Output
+	if len(lines) > height {
+		lines = lines[len(lines)-height:]
+	}
+	for _, line := range lines {
+		out = append(out, ansi.Truncate(line, width, "…"))
+	}
+	return strings.Join(out, "\n")
+}
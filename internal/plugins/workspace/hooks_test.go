@@ -0,0 +1,83 @@
+package workspace
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestRunHooks_StreamsAndStopsOnFailure(t *testing.T) {
+	events := make(chan hookEvent, 64)
+	runHooks([]string{"echo one; echo two >&2", "printf 'a\\rb\\n'; exit 3", "echo never"}, t.TempDir(), os.Environ(), events)
+
+	var lines []string
+	var done *hookEvent
+	for evt := range events {
+		if evt.done {
+			done = &evt
+			continue
+		}
+		lines = append(lines, evt.line)
+	}
+
+	want := []string{"$ echo one; echo two >&2", "one", "two", "$ printf 'a\\rb\\n'; exit 3", "b"}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("lines = %q, want %q", lines, want)
+	}
+	if done == nil || done.err == nil || done.index != 1 {
+		t.Fatalf("done = %+v, want failure of hook 1", done)
+	}
+	if !strings.HasPrefix(done.err.Error(), "printf") {
+		t.Errorf("error should name the failing command: %v", done.err)
+	}
+}
+
+func TestHookRun_OutputCap(t *testing.T) {
+	r := &HookRun{}
+	for i := 0; i < hookOutputMaxLines+10; i++ {
+		r.appendLine("x")
+	}
+	if len(r.Output) != hookOutputMaxLines {
+		t.Errorf("output len = %d, want %d", len(r.Output), hookOutputMaxLines)
+	}
+}
+
+func TestHookDoneMsg_FailureBlocksAgent(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{Logger: slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))}
+	run := &HookRun{Commands: []string{"npm install"}, Running: true, agentType: AgentClaude}
+	wt := &Worktree{Name: "feat", Path: t.TempDir(), Hooks: run}
+	p.worktrees = []*Worktree{wt}
+	p.hookRuns["feat"] = run
+
+	if got := hookBadge(run); got != "⟳ hooks 1/1" {
+		t.Errorf("running badge = %q", got)
+	}
+	p.Update(HookOutputMsg{WorkspaceName: "feat", Line: "added 10 packages", events: make(chan hookEvent)})
+	if len(run.Output) != 1 {
+		t.Errorf("output not recorded: %q", run.Output)
+	}
+
+	p.Update(HookDoneMsg{WorkspaceName: "feat", Err: errors.New("npm install: exit status 1")})
+	if run.Running || !run.Blocking() || hookBadge(run) != "✗ hook failed" {
+		t.Errorf("failed run state = %+v badge %q", run, hookBadge(run))
+	}
+	if wt.Agent != nil {
+		t.Error("agent should not start after a failed hook")
+	}
+	if !strings.Contains(renderHookOutput(run, 80, 10), "added 10 packages") {
+		t.Error("preview should show hook output")
+	}
+
+	// The run survives a worktree refresh
+	p.initialReconnectDone = true
+	p.stateRestored = true
+	p.Update(RefreshDoneMsg{Worktrees: []*Worktree{{Name: "feat", Path: wt.Path}}})
+	if p.worktrees[0].Hooks != run {
+		t.Error("hook run lost on refresh")
+	}
+}
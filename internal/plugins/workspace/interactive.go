@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 	app "github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/config"
 	"github.com/wilbur182/forge/internal/features"
 	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/tty"
@@ -143,6 +145,46 @@ func (p *Plugin) getInteractivePasteKey() string {
 	return defaultPasteKey
 }
 
+// interactiveKeys returns the configured capture and passthrough keys for
+// the agent type of the session in interactive mode.
+func (p *Plugin) interactiveKeys() config.InteractiveKeys {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return config.InteractiveKeys{}
+	}
+	return p.ctx.Config.Plugins.Workspace.InteractiveKeysFor(string(p.interactiveAgentType()))
+}
+
+// interactiveAgentType returns the agent type of the selected shell or
+// worktree. Plain shells are AgentShell.
+func (p *Plugin) interactiveAgentType() AgentType {
+	if p.shellSelected {
+		if idx := p.selectedShellIdx; idx >= 0 && idx < len(p.shells) && p.shells[idx].ChosenAgent != AgentNone {
+			return p.shells[idx].ChosenAgent
+		}
+		return AgentShell
+	}
+	if wt := p.selectedWorktree(); wt != nil && wt.Agent != nil {
+		return wt.Agent.Type
+	}
+	return AgentNone
+}
+
+// passesThrough reports whether one of interactive mode's own keys is
+// configured to go to the agent instead.
+func (p *Plugin) passesThrough(key string) bool {
+	return slices.Contains(p.interactiveKeys().Passthrough, key)
+}
+
+// CapturesKey reports whether key is configured to be handled by forge
+// instead of the agent in interactive mode. The exit key is never
+// captured, so interactive mode can always be left.
+func (p *Plugin) CapturesKey(key string) bool {
+	if p.viewMode != ViewModeInteractive || key == p.getInteractiveExitKey() {
+		return false
+	}
+	return slices.Contains(p.interactiveKeys().Capture, key)
+}
+
 // isSessionDeadError checks if an error indicates the tmux session/pane is gone.
 func isSessionDeadError(err error) bool {
 	if err == nil {
@@ -443,6 +485,11 @@ func (p *Plugin) enterInteractiveMode() tea.Cmd {
 		if wt == nil || wt.Agent == nil {
 			return nil
 		}
+		if wt.Host != "" {
+			return func() tea.Msg {
+				return app.ToastMsg{Message: "Interactive mode is local only; press t to attach over SSH", Duration: 3 * time.Second}
+			}
+		}
 		sessionName = wt.Agent.TmuxSession
 		paneID = wt.Agent.TmuxPane
 	}
@@ -767,7 +814,7 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 	}
 
 	// Attach shortcut: exit interactive and attach to full session (td-fd68d1)
-	if msg.String() == p.getInteractiveAttachKey() {
+	if msg.String() == p.getInteractiveAttachKey() && !p.passesThrough(msg.String()) {
 		p.exitInteractiveMode()
 		// Attach to the appropriate session
 		if p.shellSelected {
@@ -785,7 +832,8 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 
 	// Secondary exit: Double-Escape with 150ms delay
 	// Per spec: first Escape is delayed to detect double-press
-	if msg.Type == tea.KeyEscape {
+	// Skipped when esc passes through, so it reaches the agent at once
+	if msg.Type == tea.KeyEscape && !p.passesThrough("esc") {
 		if p.interactiveState.EscapePressed {
 			// Second Escape within window: exit interactive mode
 			p.interactiveState.EscapePressed = false
@@ -879,11 +927,11 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 		pendingEscape = true
 	}
 
-	if msg.String() == p.getInteractiveCopyKey() {
+	if msg.String() == p.getInteractiveCopyKey() && !p.passesThrough(msg.String()) {
 		return p.copyInteractiveSelectionCmd()
 	}
 
-	if msg.String() == p.getInteractivePasteKey() {
+	if msg.String() == p.getInteractivePasteKey() && !p.passesThrough(msg.String()) {
 		p.interactiveState.LastKeyTime = time.Now()
 		if p.previewOffset > 0 {
 			p.previewOffset = 0
@@ -1266,8 +1314,8 @@ func cursorStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
 		Reverse(true).
 		Bold(true).
-		Background(styles.Primary).
-		Foreground(styles.BgPrimary)
+		Background(styles.Current().Primary).
+		Foreground(styles.Current().BgPrimary)
 }
 
 // getCursorPosition returns the cached cursor position for rendering (td-648af4).
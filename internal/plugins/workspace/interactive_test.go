@@ -1046,10 +1046,10 @@ func TestHandleInteractiveKeys_CancelsPendingEscapeForMouseSequence(t *testing.T
 	p := &Plugin{
 		viewMode: ViewModeInteractive,
 		interactiveState: &InteractiveState{
-			Active:          true,
-			TargetSession:   "test-session",
-			EscapePressed:   true, // ESC arrived first (split-read)
-			EscapeTime:      time.Now(),
+			Active:        true,
+			TargetSession: "test-session",
+			EscapePressed: true, // ESC arrived first (split-read)
+			EscapeTime:    time.Now(),
 		},
 	}
 
@@ -1207,3 +1207,50 @@ func TestCalculatePreviewDimensions_WidthConsistency(t *testing.T) {
 		})
 	}
 }
+
+// TestInteractiveKeys_PerAgentType tests the capture and passthrough lists
+// of the selected session's agent type.
+func TestInteractiveKeys_PerAgentType(t *testing.T) {
+	cfg := config.Default()
+	cfg.Plugins.Workspace.InteractiveKeys = map[string]config.InteractiveKeys{
+		"*":     {Capture: []string{"ctrl+k"}},
+		"shell": {Capture: []string{"`", defaultExitKey}, Passthrough: []string{"esc"}},
+	}
+	p := &Plugin{
+		ctx:                 &plugin.Context{Config: cfg},
+		viewMode:            ViewModeInteractive,
+		shellSelected:       true,
+		shells:              []*ShellSession{{Name: "Shell 1", TmuxName: "test"}},
+		shellPollGeneration: map[string]int{},
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test",
+		},
+	}
+
+	if !p.CapturesKey("`") || p.CapturesKey("ctrl+k") {
+		t.Error("plain shell should capture ` only")
+	}
+	if p.CapturesKey(defaultExitKey) {
+		t.Error("the exit key should never be captured")
+	}
+
+	// esc goes straight to the shell, without waiting for a second esc
+	if cmd := p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyEscape}); cmd == nil || p.interactiveState.EscapePressed {
+		t.Error("esc should pass through to the shell")
+	}
+
+	// Agent types without their own entry use "*"
+	p.shells[0].ChosenAgent = AgentClaude
+	if p.CapturesKey("`") || !p.CapturesKey("ctrl+k") {
+		t.Error("claude shell should use the * entry")
+	}
+	if p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyEscape}); !p.interactiveState.EscapePressed {
+		t.Error("esc should wait for double-escape without passthrough")
+	}
+
+	p.viewMode = ViewModeList
+	if p.CapturesKey("ctrl+k") {
+		t.Error("keys are only captured in interactive mode")
+	}
+}
@@ -0,0 +1,331 @@
+package workspace
+
+import (
+	"fmt"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// staleWorktreeAge is how long a worktree can go without activity
+	// before the cleanup view lists it as stale.
+	staleWorktreeAge = 14 * 24 * time.Hour
+
+	// diskUsageTTL is how long a disk usage scan is reused before rescanning.
+	diskUsageTTL = 10 * time.Minute
+)
+
+// Cleanup reasons shown next to each candidate.
+const (
+	janitorReasonMerged  = "merged"
+	janitorReasonStale   = "stale"
+	janitorReasonMissing = "folder missing"
+)
+
+// DiskUsage is the result of scanning a worktree directory.
+type DiskUsage struct {
+	Bytes        int64
+	LastActivity time.Time // Newest file modification or commit
+	ScannedAt    time.Time
+}
+
+// DiskUsageMsg delivers a worktree's disk usage scan.
+type DiskUsageMsg struct {
+	Epoch         uint64 // Epoch when request was issued (for stale detection)
+	WorkspaceName string
+	Usage         *DiskUsage
+	Err           error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m DiskUsageMsg) GetEpoch() uint64 { return m.Epoch }
+
+// JanitorCandidate is a worktree the cleanup view offers to delete.
+type JanitorCandidate struct {
+	Worktree *Worktree // Snapshot taken when the cleanup view opened
+	Reasons  []string  // cleanupReason* values
+	Dirty    bool      // Uncommitted or untracked changes would be lost
+	Selected bool
+}
+
+// JanitorState holds the state for the cleanup modal.
+type JanitorState struct {
+	Loading        bool
+	Candidates     []*JanitorCandidate
+	DeleteBranches bool // Also delete each worktree's local branch
+	Deleting       bool
+}
+
+// JanitorCandidatesMsg delivers the worktrees eligible for cleanup.
+type JanitorCandidatesMsg struct {
+	Epoch      uint64
+	Candidates []*JanitorCandidate
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m JanitorCandidatesMsg) GetEpoch() uint64 { return m.Epoch }
+
+// JanitorDoneMsg signals that the selected worktrees were deleted.
+type JanitorDoneMsg struct {
+	Deleted  []string // Worktree names
+	Paths    []string
+	Warnings []string
+}
+
+// lastActivity returns the most recent sign of work in the worktree.
+func (wt *Worktree) lastActivity() time.Time {
+	latest := wt.UpdatedAt
+	if wt.Usage != nil && wt.Usage.LastActivity.After(latest) {
+		latest = wt.Usage.LastActivity
+	}
+	if wt.Agent != nil && wt.Agent.LastOutput.After(latest) {
+		latest = wt.Agent.LastOutput
+	}
+	return latest
+}
+
+// loadDiskUsage scans a worktree's disk usage unless a recent scan exists
+// or one is already running.
+func (p *Plugin) loadDiskUsage(wt *Worktree) tea.Cmd {
+	if u := p.diskUsage[wt.Name]; (u != nil && time.Since(u.ScannedAt) < diskUsageTTL) || p.diskScanning[wt.Name] {
+		return nil
+	}
+	p.diskScanning[wt.Name] = true
+	epoch := p.ctx.Epoch
+	name, path := wt.Name, wt.Path
+	return func() tea.Msg {
+		usage, err := scanDiskUsage(path)
+		return DiskUsageMsg{Epoch: epoch, WorkspaceName: name, Usage: usage, Err: err}
+	}
+}
+
+// setDiskUsage records a scan and attaches it to the named worktree.
+func (p *Plugin) setDiskUsage(name string, usage *DiskUsage) {
+	p.diskUsage[name] = usage
+	if wt := p.findWorktree(name); wt != nil {
+		wt.Usage = usage
+	}
+}
+
+// scanDiskUsage totals file sizes under path (symlinks are not followed)
+// and finds the newest file modification or commit.
+func scanDiskUsage(path string) (*DiskUsage, error) {
+	usage := &DiskUsage{ScannedAt: time.Now()}
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // unreadable entries are skipped, not fatal
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			usage.Bytes += info.Size()
+		}
+		if info.ModTime().After(usage.LastActivity) {
+			usage.LastActivity = info.ModTime()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if out, err := gitOutput(path, "log", "-1", "--format=%ct"); err == nil {
+		if sec, err := strconv.ParseInt(out, 10, 64); err == nil {
+			if t := time.Unix(sec, 0); t.After(usage.LastActivity) {
+				usage.LastActivity = t
+			}
+		}
+	}
+	return usage, nil
+}
+
+// startJanitor opens the cleanup modal and looks for candidates.
+func (p *Plugin) startJanitor() tea.Cmd {
+	p.janitorState = &JanitorState{Loading: true, DeleteBranches: true}
+	p.clearJanitorModal()
+	p.viewMode = ViewModeJanitor
+
+	epoch := p.ctx.Epoch
+	workDir := p.ctx.WorkDir
+	// Snapshot worktrees so the scan can fill in Usage off the UI goroutine
+	worktrees := make([]*Worktree, 0, len(p.worktrees))
+	for _, wt := range p.worktrees {
+		if !wt.IsMain {
+			snapshot := *wt
+			worktrees = append(worktrees, &snapshot)
+		}
+	}
+	return func() tea.Msg {
+		return JanitorCandidatesMsg{Epoch: epoch, Candidates: findJanitorCandidates(workDir, worktrees, time.Now())}
+	}
+}
+
+// cancelJanitor closes the cleanup modal.
+func (p *Plugin) cancelJanitor() {
+	p.janitorState = nil
+	p.clearJanitorModal()
+	p.viewMode = ViewModeList
+}
+
+// findJanitorCandidates returns worktrees that are merged into their base
+// branch, inactive for staleWorktreeAge, or missing on disk. Merged and
+// missing worktrees are preselected unless they have uncommitted changes
+// or a running agent. Worktrees without a recent disk usage scan are
+// scanned and their Usage updated, so callers pass snapshots.
+func findJanitorCandidates(workDir string, worktrees []*Worktree, now time.Time) []*JanitorCandidate {
+	var candidates []*JanitorCandidate
+	for _, wt := range worktrees {
+		c := &JanitorCandidate{Worktree: wt}
+		if wt.IsMissing {
+			c.Reasons = append(c.Reasons, janitorReasonMissing)
+		} else {
+			if wt.Usage == nil || now.Sub(wt.Usage.ScannedAt) >= diskUsageTTL {
+				if usage, err := scanDiskUsage(wt.Path); err == nil {
+					wt.Usage = usage
+				}
+			}
+			if (wt.PR != nil && wt.PR.State == "MERGED") || branchMerged(workDir, wt.Branch, resolveBaseBranch(wt)) {
+				c.Reasons = append(c.Reasons, janitorReasonMerged)
+			}
+			if last := wt.lastActivity(); !last.IsZero() && now.Sub(last) >= staleWorktreeAge {
+				c.Reasons = append(c.Reasons, janitorReasonStale)
+			}
+			c.Dirty = hasUncommittedChanges(wt.Path)
+		}
+		if len(c.Reasons) == 0 {
+			continue
+		}
+		c.Selected = c.Reasons[0] != janitorReasonStale && !c.Dirty && wt.Agent == nil
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// branchMerged reports whether every commit on branch is already in base.
+// A branch still sitting exactly on base's tip is not considered merged.
+func branchMerged(workDir, branch, base string) bool {
+	if branch == "" || base == "" || branch == base {
+		return false
+	}
+	tip, err := gitOutput(workDir, "rev-parse", "--verify", "refs/heads/"+branch)
+	if err != nil {
+		return false
+	}
+	baseTip, err := gitOutput(workDir, "rev-parse", "--verify", base)
+	if err != nil || tip == baseTip {
+		return false
+	}
+	cmd := exec.Command("git", "merge-base", "--is-ancestor", tip, baseTip)
+	cmd.Dir = workDir
+	return cmd.Run() == nil
+}
+
+// hasUncommittedChanges reports whether the worktree has staged, unstaged,
+// or untracked changes.
+func hasUncommittedChanges(path string) bool {
+	out, err := gitOutput(path, "status", "--porcelain")
+	return err == nil && out != ""
+}
+
+// executeJanitor deletes the selected worktrees, their tmux sessions, and
+// optionally their local branches.
+func (p *Plugin) executeJanitor() tea.Cmd {
+	s := p.janitorState
+	if s == nil || s.Loading || s.Deleting {
+		return nil
+	}
+	var targets []*Worktree
+	for _, c := range s.Candidates {
+		if c.Selected {
+			targets = append(targets, c.Worktree)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	s.Deleting = true
+	p.clearJanitorModal()
+
+	// Kill tmux sessions before deleting worktrees
+	for _, wt := range targets {
+		sessionName := tmuxSessionPrefix + sanitizeName(wt.Name)
+		if sessionExists(sessionName) {
+			_ = exec.Command("tmux", "kill-session", "-t", sessionName).Run()
+		}
+		delete(p.managedSessions, sessionName)
+		globalPaneCache.remove(sessionName)
+	}
+
+	workDir := p.ctx.WorkDir
+	deleteBranches := s.DeleteBranches
+	return func() tea.Msg {
+		var msg JanitorDoneMsg
+		for _, wt := range targets {
+			if err := doDeleteWorktree(workDir, wt.Path, wt.IsMissing); err != nil {
+				msg.Warnings = append(msg.Warnings, fmt.Sprintf("%s: %v", wt.Name, err))
+				continue
+			}
+			msg.Deleted = append(msg.Deleted, wt.Name)
+			msg.Paths = append(msg.Paths, wt.Path)
+			if deleteBranches && wt.Branch != "" {
+				if err := deleteBranch(workDir, wt.Branch); err != nil {
+					msg.Warnings = append(msg.Warnings, fmt.Sprintf("%s branch: %v", wt.Name, err))
+				}
+			}
+		}
+		return msg
+	}
+}
+
+// selection returns the number of selected candidates and their
+// combined disk usage.
+func (s *JanitorState) selection() (count int, bytes int64) {
+	for _, c := range s.Candidates {
+		if c.Selected {
+			count++
+			if c.Worktree.Usage != nil {
+				bytes += c.Worktree.Usage.Bytes
+			}
+		}
+	}
+	return count, bytes
+}
+
+// formatBytes formats a byte count in human-readable form.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// janitorLabel renders a candidate's checkbox label.
+func janitorLabel(c *JanitorCandidate) string {
+	parts := []string{c.Worktree.Name, strings.Join(c.Reasons, ", ")}
+	if c.Worktree.Usage != nil {
+		parts = append(parts, formatBytes(c.Worktree.Usage.Bytes))
+	}
+	if age := formatRelativeTime(c.Worktree.lastActivity()); age != "" {
+		parts = append(parts, age)
+	}
+	if c.Dirty {
+		parts = append(parts, "⚠ uncommitted")
+	}
+	if c.Worktree.Agent != nil {
+		parts = append(parts, "agent running")
+	}
+	return strings.Join(parts, "  ")
+}
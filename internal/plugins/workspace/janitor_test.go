@@ -0,0 +1,155 @@
+package workspace
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+// newJanitorTestRepo creates a repo with three worktrees: "merged" (its
+// commit is in main), "fresh" (unmerged commit), and "dirty" (merged, with
+// an untracked file).
+func newJanitorTestRepo(t *testing.T) (string, map[string]*Worktree) {
+	t.Helper()
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	run := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(dir, file string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, file), []byte(file+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		run(dir, "add", ".")
+		run(dir, "commit", "-m", file)
+	}
+
+	if err := os.MkdirAll(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+	run(repo, "init", "-b", "main")
+	run(repo, "config", "user.email", "test@test.com")
+	run(repo, "config", "user.name", "Test")
+	commit(repo, "base.txt")
+
+	worktrees := make(map[string]*Worktree)
+	for _, name := range []string{"merged", "fresh", "dirty"} {
+		path := filepath.Join(root, name)
+		run(repo, "worktree", "add", "-b", name, path)
+		commit(path, name+".txt")
+		worktrees[name] = &Worktree{Name: name, Path: path, Branch: name, BaseBranch: "main"}
+	}
+	run(repo, "merge", "--no-edit", "merged", "dirty")
+	if err := os.WriteFile(filepath.Join(root, "dirty", "notes.txt"), []byte("wip\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return repo, worktrees
+}
+
+func TestFindJanitorCandidates(t *testing.T) {
+	repo, wts := newJanitorTestRepo(t)
+	missing := &Worktree{Name: "gone", Path: "/nonexistent", Branch: "gone", IsMissing: true}
+
+	candidates := findJanitorCandidates(repo, []*Worktree{wts["merged"], wts["fresh"], wts["dirty"], missing}, time.Now())
+	got := make(map[string]*JanitorCandidate)
+	for _, c := range candidates {
+		got[c.Worktree.Name] = c
+	}
+
+	if _, ok := got["fresh"]; ok {
+		t.Error("unmerged, recently active worktree should not be a candidate")
+	}
+	if c := got["merged"]; c == nil || c.Reasons[0] != janitorReasonMerged || !c.Selected || c.Dirty {
+		t.Errorf("merged candidate = %+v", c)
+	}
+	if c := got["dirty"]; c == nil || !c.Dirty || c.Selected {
+		t.Errorf("dirty candidate should be listed but not preselected: %+v", c)
+	}
+	if c := got["gone"]; c == nil || c.Reasons[0] != janitorReasonMissing || !c.Selected {
+		t.Errorf("missing candidate = %+v", c)
+	}
+	if u := wts["merged"].Usage; u == nil || u.Bytes == 0 || u.LastActivity.IsZero() {
+		t.Errorf("disk usage not scanned: %+v", u)
+	}
+
+	// Inactive worktrees are listed as stale but not preselected
+	later := time.Now().Add(staleWorktreeAge + time.Hour)
+	candidates = findJanitorCandidates(repo, []*Worktree{wts["fresh"]}, later)
+	if len(candidates) != 1 || candidates[0].Reasons[0] != janitorReasonStale || candidates[0].Selected {
+		t.Errorf("stale candidates = %+v", candidates)
+	}
+}
+
+func TestJanitorDeletesWorktreesAndBranches(t *testing.T) {
+	repo, wts := newJanitorTestRepo(t)
+	p := New()
+	p.worktrees = []*Worktree{wts["merged"], wts["fresh"]}
+	p.janitorState = &JanitorState{
+		DeleteBranches: true,
+		Candidates:     []*JanitorCandidate{{Worktree: wts["merged"], Selected: true}},
+	}
+	p.viewMode = ViewModeJanitor
+	p.ctx = &plugin.Context{WorkDir: repo}
+
+	cmd := p.executeJanitor()
+	if cmd == nil {
+		t.Fatal("expected delete command")
+	}
+	done, ok := cmd().(JanitorDoneMsg)
+	if !ok || len(done.Deleted) != 1 || len(done.Warnings) != 0 {
+		t.Fatalf("done = %+v", done)
+	}
+	if _, err := os.Stat(wts["merged"].Path); !os.IsNotExist(err) {
+		t.Error("worktree directory should be removed")
+	}
+	if branchExists(repo, "merged") {
+		t.Error("branch should be deleted")
+	}
+
+	p.Update(done)
+	if p.viewMode != ViewModeList || len(p.worktrees) != 1 || p.worktrees[0].Name != "fresh" {
+		t.Errorf("after cleanup: mode=%v worktrees=%d", p.viewMode, len(p.worktrees))
+	}
+}
+
+func TestJanitorKeys_ToggleAll(t *testing.T) {
+	p := New()
+	p.viewMode = ViewModeJanitor
+	p.janitorState = &JanitorState{Candidates: []*JanitorCandidate{
+		{Worktree: &Worktree{Name: "a"}, Selected: true},
+		{Worktree: &Worktree{Name: "b"}},
+	}}
+
+	p.handleJanitorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if n, _ := p.janitorState.selection(); n != 2 {
+		t.Errorf("selected = %d, want all", n)
+	}
+	p.handleJanitorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if n, _ := p.janitorState.selection(); n != 0 {
+		t.Errorf("selected = %d, want none", n)
+	}
+
+	p.handleJanitorKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.janitorState != nil || p.viewMode != ViewModeList {
+		t.Error("esc should close the cleanup view")
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for n, want := range map[int64]string{512: "512B", 2048: "2.0KB", 5 << 30: "5.0GB"} {
+		if got := formatBytes(n); got != want {
+			t.Errorf("formatBytes(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureJanitorModal builds/rebuilds the cleanup modal.
+func (p *Plugin) ensureJanitorModal() {
+	s := p.janitorState
+	if s == nil {
+		return
+	}
+
+	modalW := 76
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist, width changed, or content changed
+	key := fmt.Sprintf("%t/%t/%d", s.Loading, s.Deleting, len(s.Candidates))
+	if p.janitorModal != nil && p.janitorModalWidth == modalW && p.janitorModalKey == key {
+		return
+	}
+	p.janitorModalWidth = modalW
+	p.janitorModalKey = key
+
+	m := modal.New("Clean Up Worktrees",
+		modal.WithWidth(modalW),
+		modal.WithVariant(modal.VariantDanger),
+		modal.WithHints(false),
+	)
+
+	switch {
+	case s.Loading:
+		m.AddSection(modal.Text("Checking worktrees for merged and stale branches..."))
+	case s.Deleting:
+		m.AddSection(modal.Text("Deleting selected worktrees..."))
+	case len(s.Candidates) == 0:
+		m.AddSection(modal.Text("No merged, stale, or missing worktrees found."))
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Buttons(modal.Btn(" Close ", janitorCancelButtonID)))
+	default:
+		m.AddSection(modal.Text(dimText(fmt.Sprintf("Merged into their base branch, or idle for %d+ days:", int(staleWorktreeAge.Hours()/24)))))
+		m.AddSection(modal.Spacer())
+		for i, c := range s.Candidates {
+			m.AddSection(modal.Checkbox(fmt.Sprintf("%s%d", janitorItemPfx, i), janitorLabel(c), &c.Selected))
+		}
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Checkbox(janitorBranchesID, "Also delete local branches", &s.DeleteBranches))
+		m.AddSection(modal.Spacer())
+		m.AddSection(p.janitorSummarySection())
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Buttons(
+			modal.Btn(" Delete Selected ", janitorDeleteButtonID, modal.BtnDanger()),
+			modal.Btn(" Cancel ", janitorCancelButtonID),
+		))
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Text(dimText("Space: toggle   a: toggle all   D: delete selected   Esc: cancel")))
+	}
+
+	p.janitorModal = m
+}
+
+// clearJanitorModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearJanitorModal() {
+	p.janitorModal = nil
+	p.janitorModalWidth = 0
+	p.janitorModalKey = ""
+}
+
+// janitorSummarySection shows how many worktrees are selected and the disk
+// space deleting them frees. Rendered each frame so toggles update it.
+func (p *Plugin) janitorSummarySection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if p.janitorState == nil {
+			return modal.RenderedSection{}
+		}
+		count, bytes := p.janitorState.selection()
+		text := fmt.Sprintf("%d selected, frees %s (tmux sessions are killed too)", count, formatBytes(bytes))
+		return modal.RenderedSection{Content: lipgloss.NewStyle().Bold(true).Render(text)}
+	}, nil)
+}
+
+// renderJanitorModal renders the cleanup modal with dimmed background.
+func (p *Plugin) renderJanitorModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureJanitorModal()
+	if p.janitorModal == nil {
+		return background
+	}
+
+	modalContent := p.janitorModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
@@ -1,34 +1,113 @@
 package workspace
 
-// kanbanColumnOrder defines the order of columns in kanban view.
-var kanbanColumnOrder = []WorktreeStatus{StatusActive, StatusThinking, StatusWaiting, StatusDone, StatusPaused}
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/config"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+// Actions run when a card is dragged onto a kanban column.
+const (
+	kanbanDropStartAgent     = "start-agent"
+	kanbanDropStopAgent      = "stop-agent"
+	kanbanDropMerge          = "merge"
+	kanbanDropReadyForReview = "ready-for-review"
+)
 
 const kanbanShellColumnIndex = 0
 
-func kanbanColumnCount() int {
-	return len(kanbanColumnOrder) + 1 // Shells column + worktree columns
+// kanbanColumn is a worktree column on the kanban board. A worktree goes in
+// the first column whose rules all match; empty rules match anything.
+type kanbanColumn struct {
+	Title    string
+	Color    lipgloss.Color
+	Statuses []string // WorktreeStatus names
+	PR       []string // PR states: open, draft, merged, closed, none
+	OnDrop   string   // kanbanDrop* action, or "" for none
 }
 
-func kanbanStatusForColumn(col int) (WorktreeStatus, bool) {
-	if col <= kanbanShellColumnIndex {
-		return 0, false
+// matches reports whether the worktree belongs in the column.
+func (c kanbanColumn) matches(wt *Worktree) bool {
+	if len(c.Statuses) > 0 && !slices.Contains(c.Statuses, wt.Status.String()) {
+		return false
 	}
-	idx := col - 1
-	if idx < 0 || idx >= len(kanbanColumnOrder) {
-		return 0, false
+	return len(c.PR) == 0 || slices.Contains(c.PR, kanbanPRState(wt.PR))
+}
+
+// kanbanPRState returns the PR state name used by column rules.
+func kanbanPRState(pr *PRStatus) string {
+	switch {
+	case pr == nil:
+		return "none"
+	case pr.State == "OPEN" && pr.IsDraft:
+		return "draft"
+	default:
+		return strings.ToLower(pr.State)
+	}
+}
+
+// defaultKanbanColumns returns the built-in status columns. Colors are read
+// at call time so theme changes apply.
+func defaultKanbanColumns() []kanbanColumn {
+	return []kanbanColumn{
+		{Title: "● Active", Color: styles.Current().StatusCompleted.GetForeground().(lipgloss.Color), Statuses: []string{"active"}, OnDrop: kanbanDropStartAgent},
+		{Title: "◐ Thinking", Color: styles.Current().Primary, Statuses: []string{"thinking"}},
+		{Title: "⧗ Waiting", Color: styles.Current().StatusModified.GetForeground().(lipgloss.Color), Statuses: []string{"waiting"}},
+		{Title: "✓ Ready", Color: styles.Current().Secondary, Statuses: []string{"done"}},
+		// Error worktrees are grouped with paused since they require user intervention
+		{Title: "⏸ Paused", Color: styles.Current().TextMuted, Statuses: []string{"paused", "error"}, OnDrop: kanbanDropStopAgent},
+	}
+}
+
+// kanbanColumns returns the configured worktree columns, or the defaults.
+func (p *Plugin) kanbanColumns() []kanbanColumn {
+	if p.ctx == nil || p.ctx.Config == nil || len(p.ctx.Config.Plugins.Workspace.KanbanColumns) == 0 {
+		return defaultKanbanColumns()
+	}
+	return kanbanColumnsFromConfig(p.ctx.Config.Plugins.Workspace.KanbanColumns)
+}
+
+// kanbanColumnsFromConfig converts configured columns, normalizing rule
+// values to lower case.
+func kanbanColumnsFromConfig(cfg []config.KanbanColumn) []kanbanColumn {
+	columns := make([]kanbanColumn, len(cfg))
+	for i, c := range cfg {
+		color := styles.Current().TextMuted
+		if c.Color != "" {
+			color = lipgloss.Color(c.Color)
+		}
+		columns[i] = kanbanColumn{Title: c.Name, Color: color, OnDrop: c.OnDrop}
+		for _, s := range c.Statuses {
+			columns[i].Statuses = append(columns[i].Statuses, strings.ToLower(s))
+		}
+		for _, s := range c.PR {
+			columns[i].PR = append(columns[i].PR, strings.ToLower(s))
+		}
 	}
-	return kanbanColumnOrder[idx], true
+	return columns
 }
 
-func (p *Plugin) kanbanColumnItemCount(col int, columns map[WorktreeStatus][]*Worktree) int {
+func (p *Plugin) kanbanColumnCount() int {
+	return len(p.kanbanColumns()) + 1 // Shells column + worktree columns
+}
+
+func (p *Plugin) kanbanColumnItemCount(col int, columns [][]*Worktree) int {
 	if col == kanbanShellColumnIndex {
 		return len(p.shells)
 	}
-	status, ok := kanbanStatusForColumn(col)
-	if !ok {
+	if col-1 < 0 || col-1 >= len(columns) {
 		return 0
 	}
-	return len(columns[status])
+	return len(columns[col-1])
 }
 
 func (p *Plugin) kanbanShellAt(row int) *ShellSession {
@@ -38,42 +117,30 @@ func (p *Plugin) kanbanShellAt(row int) *ShellSession {
 	return p.shells[row]
 }
 
-// getKanbanColumns returns worktrees grouped by status for kanban view.
-// StatusError worktrees are grouped with StatusPaused since they require user intervention.
-func (p *Plugin) getKanbanColumns() map[WorktreeStatus][]*Worktree {
-	columns := map[WorktreeStatus][]*Worktree{
-		StatusActive:   {},
-		StatusThinking: {},
-		StatusWaiting:  {},
-		StatusDone:     {},
-		StatusPaused:   {},
+// getKanbanColumns returns worktrees grouped by kanban column, indexed like
+// kanbanColumns(). Worktrees matching no column go in the last one.
+func (p *Plugin) getKanbanColumns() [][]*Worktree {
+	defs := p.kanbanColumns()
+	columns := make([][]*Worktree, len(defs))
+	if len(defs) == 0 {
+		return columns
 	}
 	for _, wt := range p.worktrees {
-		status := wt.Status
-		// Group error worktrees with paused since they require user intervention
-		if status == StatusError {
-			status = StatusPaused
+		idx := len(defs) - 1
+		for i, c := range defs {
+			if c.matches(wt) {
+				idx = i
+				break
+			}
 		}
-		columns[status] = append(columns[status], wt)
+		columns[idx] = append(columns[idx], wt)
 	}
 	return columns
 }
 
 // selectedKanbanWorktree returns the worktree at the current kanban position.
 func (p *Plugin) selectedKanbanWorktree() *Worktree {
-	columns := p.getKanbanColumns()
-	if p.kanbanCol == kanbanShellColumnIndex {
-		return nil
-	}
-	status, ok := kanbanStatusForColumn(p.kanbanCol)
-	if !ok {
-		return nil
-	}
-	items := columns[status]
-	if p.kanbanRow < 0 || p.kanbanRow >= len(items) {
-		return nil
-	}
-	return items[p.kanbanRow]
+	return p.getKanbanWorktree(p.kanbanCol, p.kanbanRow)
 }
 
 // syncKanbanToList syncs the kanban selection to the list selectedIdx.
@@ -124,8 +191,8 @@ func (p *Plugin) moveKanbanColumn(delta int) {
 	if newCol < 0 {
 		newCol = 0
 	}
-	if newCol >= kanbanColumnCount() {
-		newCol = kanbanColumnCount() - 1
+	if newCol >= p.kanbanColumnCount() {
+		newCol = p.kanbanColumnCount() - 1
 	}
 
 	if newCol != p.kanbanCol {
@@ -163,14 +230,10 @@ func (p *Plugin) moveKanbanRow(delta int) {
 // getKanbanWorktree returns the worktree at the given Kanban coordinates.
 func (p *Plugin) getKanbanWorktree(col, row int) *Worktree {
 	columns := p.getKanbanColumns()
-	if col == kanbanShellColumnIndex {
+	if col <= kanbanShellColumnIndex || col-1 >= len(columns) {
 		return nil
 	}
-	status, ok := kanbanStatusForColumn(col)
-	if !ok {
-		return nil
-	}
-	items := columns[status]
+	items := columns[col-1]
 	if row >= 0 && row < len(items) {
 		return items[row]
 	}
@@ -197,8 +260,7 @@ func (p *Plugin) syncListToKanban() {
 	}
 
 	columns := p.getKanbanColumns()
-	for colIdx, status := range kanbanColumnOrder {
-		items := columns[status]
+	for colIdx, items := range columns {
 		for rowIdx, item := range items {
 			if item.Name == wt.Name {
 				p.kanbanCol = colIdx + 1
@@ -212,3 +274,67 @@ func (p *Plugin) syncListToKanban() {
 	p.kanbanCol = 0
 	p.kanbanRow = 0
 }
+
+// kanbanDrag tracks a worktree card being dragged between kanban columns.
+type kanbanDrag struct {
+	Worktree string
+	FromCol  int
+	ToCol    int
+}
+
+// KanbanPRReadyMsg reports the result of marking a draft PR ready for review.
+type KanbanPRReadyMsg struct {
+	WorkspaceName string
+	Err           error
+}
+
+// dropKanbanCard runs the target column's drop action on the dragged
+// worktree. Columns are derived from worktree state, so the card only moves
+// once the action changes that state.
+func (p *Plugin) dropKanbanCard(drag kanbanDrag) tea.Cmd {
+	columns := p.kanbanColumns()
+	if drag.ToCol == drag.FromCol || drag.ToCol <= kanbanShellColumnIndex || drag.ToCol-1 >= len(columns) {
+		return nil
+	}
+	wt := p.findWorktree(drag.Worktree)
+	if wt == nil {
+		return nil
+	}
+	col := columns[drag.ToCol-1]
+	switch col.OnDrop {
+	case kanbanDropStartAgent:
+		if wt.Agent != nil {
+			return appmsg.ShowToast("Agent already running in "+wt.Name, 2*time.Second)
+		}
+		agentType := wt.ChosenAgentType
+		if agentType == AgentNone {
+			agentType = AgentClaude
+		}
+		return p.StartAgent(wt, agentType)
+	case kanbanDropStopAgent:
+		if wt.Agent == nil {
+			return appmsg.ShowToast("No agent running in "+wt.Name, 2*time.Second)
+		}
+		return p.StopAgent(wt)
+	case kanbanDropMerge:
+		return p.startMergeWorkflow(wt)
+	case kanbanDropReadyForReview:
+		return markPRReady(wt.Name, wt.Path)
+	}
+	return appmsg.ShowToast(fmt.Sprintf("Nothing to do when dropped on %q", col.Title), 2*time.Second)
+}
+
+// markPRReady marks the worktree branch's draft PR as ready for review.
+func markPRReady(name, wtPath string) tea.Cmd {
+	return func() tea.Msg {
+		cmd := exec.Command("gh", "pr", "ready")
+		cmd.Dir = wtPath
+		if out, err := cmd.CombinedOutput(); err != nil {
+			if msg := strings.TrimSpace(string(out)); msg != "" {
+				err = errors.New(msg)
+			}
+			return KanbanPRReadyMsg{WorkspaceName: name, Err: err}
+		}
+		return KanbanPRReadyMsg{WorkspaceName: name}
+	}
+}
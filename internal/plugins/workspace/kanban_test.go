@@ -1,7 +1,11 @@
 package workspace
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
 )
 
 func TestGetKanbanColumns(t *testing.T) {
@@ -16,20 +20,21 @@ func TestGetKanbanColumns(t *testing.T) {
 		},
 	}
 
+	// Default columns: Active=0, Thinking=1, Waiting=2, Done=3, Paused=4
 	columns := p.getKanbanColumns()
 
-	if len(columns[StatusActive]) != 2 {
-		t.Errorf("expected 2 active worktrees, got %d", len(columns[StatusActive]))
+	if len(columns[0]) != 2 {
+		t.Errorf("expected 2 active worktrees, got %d", len(columns[0]))
 	}
-	if len(columns[StatusWaiting]) != 1 {
-		t.Errorf("expected 1 waiting worktree, got %d", len(columns[StatusWaiting]))
+	if len(columns[2]) != 1 {
+		t.Errorf("expected 1 waiting worktree, got %d", len(columns[2]))
 	}
-	if len(columns[StatusDone]) != 1 {
-		t.Errorf("expected 1 done worktree, got %d", len(columns[StatusDone]))
+	if len(columns[3]) != 1 {
+		t.Errorf("expected 1 done worktree, got %d", len(columns[3]))
 	}
 	// Paused should include both StatusPaused and StatusError worktrees
-	if len(columns[StatusPaused]) != 2 {
-		t.Errorf("expected 2 paused worktrees (1 paused + 1 error), got %d", len(columns[StatusPaused]))
+	if len(columns[4]) != 2 {
+		t.Errorf("expected 2 paused worktrees (1 paused + 1 error), got %d", len(columns[4]))
 	}
 }
 
@@ -40,9 +45,9 @@ func TestGetKanbanColumnsEmpty(t *testing.T) {
 
 	columns := p.getKanbanColumns()
 
-	for _, status := range kanbanColumnOrder {
-		if len(columns[status]) != 0 {
-			t.Errorf("expected empty column for %v, got %d items", status, len(columns[status]))
+	for i, items := range columns {
+		if len(items) != 0 {
+			t.Errorf("expected empty column %d, got %d items", i, len(items))
 		}
 	}
 }
@@ -172,10 +177,10 @@ func TestMoveKanbanColumn(t *testing.T) {
 	}
 
 	// Move to far right
-	p.kanbanCol = kanbanColumnCount() - 1
+	p.kanbanCol = p.kanbanColumnCount() - 1
 	p.moveKanbanColumn(1)
-	if p.kanbanCol != kanbanColumnCount()-1 {
-		t.Errorf("expected kanbanCol=%d at right boundary, got %d", kanbanColumnCount()-1, p.kanbanCol)
+	if p.kanbanCol != p.kanbanColumnCount()-1 {
+		t.Errorf("expected kanbanCol=%d at right boundary, got %d", p.kanbanColumnCount()-1, p.kanbanCol)
 	}
 }
 
@@ -355,3 +360,68 @@ func TestSyncKanbanToListShells(t *testing.T) {
 		t.Errorf("expected selectedShellIdx=0, got %d", p.selectedShellIdx)
 	}
 }
+
+func TestGetKanbanColumnsConfigured(t *testing.T) {
+	cfg := config.Default()
+	cfg.Plugins.Workspace.KanbanColumns = []config.KanbanColumn{
+		{Name: "Review", PR: []string{"OPEN", "draft"}},
+		{Name: "Working", Statuses: []string{"active", "thinking"}, OnDrop: kanbanDropStartAgent},
+		{Name: "Shipped", PR: []string{"merged"}},
+		{Name: "Idle", Statuses: []string{"paused"}, PR: []string{"none"}, OnDrop: kanbanDropStopAgent},
+	}
+	p := &Plugin{
+		ctx: &plugin.Context{Config: cfg},
+		worktrees: []*Worktree{
+			{Name: "pr", Status: StatusActive, PR: &PRStatus{State: "OPEN", IsDraft: true}},
+			{Name: "busy", Status: StatusThinking},
+			{Name: "merged", Status: StatusPaused, PR: &PRStatus{State: "MERGED"}},
+			{Name: "idle", Status: StatusPaused},
+			{Name: "waiting", Status: StatusWaiting}, // Matches nothing, falls into the last column
+		},
+	}
+
+	if got := p.kanbanColumnCount(); got != 5 {
+		t.Fatalf("kanbanColumnCount = %d, want 5 (shells + 4)", got)
+	}
+	columns := p.getKanbanColumns()
+	want := [][]string{{"pr"}, {"busy"}, {"merged"}, {"idle", "waiting"}}
+	for i, names := range want {
+		var got []string
+		for _, wt := range columns[i] {
+			got = append(got, wt.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(names, ",") {
+			t.Errorf("column %d = %v, want %v", i, got, names)
+		}
+	}
+
+	p.syncListToKanban()
+	if p.kanbanCol != 1 || p.kanbanRow != 0 {
+		t.Errorf("kanban position = (%d, %d), want the Review column", p.kanbanCol, p.kanbanRow)
+	}
+}
+
+func TestDropKanbanCard(t *testing.T) {
+	p := &Plugin{
+		worktrees: []*Worktree{
+			{Name: "wt1", Status: StatusPaused},
+		},
+	}
+
+	// Default columns: Shells=0, Active=1, Thinking=2, Waiting=3, Done=4, Paused=5
+	if cmd := p.dropKanbanCard(kanbanDrag{Worktree: "wt1", FromCol: 5, ToCol: 5}); cmd != nil {
+		t.Error("dropping on the same column should do nothing")
+	}
+	if cmd := p.dropKanbanCard(kanbanDrag{Worktree: "wt1", FromCol: 5, ToCol: kanbanShellColumnIndex}); cmd != nil {
+		t.Error("worktrees can't be dropped on the shells column")
+	}
+	if cmd := p.dropKanbanCard(kanbanDrag{Worktree: "missing", FromCol: 5, ToCol: 1}); cmd != nil {
+		t.Error("unknown worktree should be ignored")
+	}
+
+	// Paused stops the agent; without one the user gets a toast instead
+	p.worktrees[0].Status = StatusActive
+	if cmd := p.dropKanbanCard(kanbanDrag{Worktree: "wt1", FromCol: 1, ToCol: 5}); cmd == nil {
+		t.Error("expected feedback when there is no agent to stop")
+	}
+}
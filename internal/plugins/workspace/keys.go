@@ -40,6 +40,24 @@ func (p *Plugin) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return p.handleRenameShellKeys(msg)
 	case ViewModeFetchPR:
 		return p.handleFetchPRKeys(msg)
+	case ViewModeRebase:
+		return p.handleRebaseKeys(msg)
+	case ViewModeJanitor:
+		return p.handleJanitorKeys(msg)
+	case ViewModeRepoSwitcher:
+		return p.handleRepoSwitcherKeys(msg)
+	case ViewModeEnvEditor:
+		return p.handleEnvEditorKeys(msg)
+	case ViewModeBroadcast:
+		return p.handleBroadcastKeys(msg)
+	case ViewModePorts:
+		return p.handlePortsKeys(msg)
+	case ViewModeDiscover:
+		return p.handleDiscoverKeys(msg)
+	case ViewModeRemoteCreate:
+		return p.handleRemoteCreateKeys(msg)
+	case ViewModeBulkCreate:
+		return p.handleBulkCreateKeys(msg)
 	case ViewModeFilePicker:
 		return p.handleFilePickerKeys(msg)
 	case ViewModeInteractive:
@@ -98,6 +116,367 @@ func (p *Plugin) executeTypeSelectorConfirm() tea.Cmd {
 	return p.openCreateModal()
 }
 
+// handleRebaseKeys handles keys in the rebase workflow modal.
+func (p *Plugin) handleRebaseKeys(msg tea.KeyMsg) tea.Cmd {
+	if p.rebaseState == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensureRebaseModal()
+	if p.rebaseModal == nil {
+		return nil
+	}
+
+	if p.rebaseState.Step == RebaseStepConflicts {
+		switch msg.String() {
+		case "c":
+			return p.handleRebaseAction(rebaseContinueButtonID)
+		case "a":
+			return p.handleRebaseAction(rebaseAbortButtonID)
+		}
+	}
+
+	action, cmd := p.rebaseModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleRebaseAction(action))
+}
+
+// handleRebaseAction advances the rebase workflow for a modal action
+// (from keyboard or mouse).
+func (p *Plugin) handleRebaseAction(action string) tea.Cmd {
+	s := p.rebaseState
+	if s == nil || action == "" || s.Step == RebaseStepRunning {
+		return nil // git is running: keep the modal up until it reports back
+	}
+
+	switch {
+	case action == "cancel" || action == rebaseCloseButtonID:
+		p.cancelRebaseWorkflow()
+		return nil
+
+	case action == rebaseTargetListID || strings.HasPrefix(action, rebaseTargetItemPfx):
+		if s.Step != RebaseStepTarget || s.TargetIdx < 0 || s.TargetIdx >= len(s.Targets) {
+			return nil
+		}
+		s.Target = s.Targets[s.TargetIdx]
+		s.Preview = nil
+		s.Step = RebaseStepPreview
+		return p.loadRebasePreview(s.Worktree, s.Target)
+
+	case action == rebaseStartButtonID:
+		if s.Step != RebaseStepPreview || s.Preview == nil {
+			return nil
+		}
+		s.Step = RebaseStepRunning
+		return p.runRebase(s.Worktree, s.Target)
+
+	case action == rebaseFileListID || strings.HasPrefix(action, rebaseFileItemPfx):
+		if s.Step != RebaseStepConflicts || s.FileIdx < 0 || s.FileIdx >= len(s.Conflicts) {
+			return nil
+		}
+		wt, file := s.Worktree, s.Conflicts[s.FileIdx]
+		p.cancelRebaseWorkflow()
+		return p.openConflictInFileBrowser(wt, file)
+
+	case action == rebaseContinueButtonID:
+		if s.Step != RebaseStepConflicts {
+			return nil
+		}
+		s.Step = RebaseStepRunning
+		return p.continueRebase(s.Worktree, s.Conflicts)
+
+	case action == rebaseAbortButtonID:
+		if s.Step != RebaseStepConflicts {
+			return nil
+		}
+		s.Step = RebaseStepRunning
+		return p.abortRebase(s.Worktree)
+	}
+	return nil
+}
+
+// handleJanitorKeys handles keys in the cleanup modal.
+func (p *Plugin) handleJanitorKeys(msg tea.KeyMsg) tea.Cmd {
+	s := p.janitorState
+	if s == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensureJanitorModal()
+	if p.janitorModal == nil {
+		return nil
+	}
+
+	switch msg.String() {
+	case "D":
+		return p.handleJanitorAction(janitorDeleteButtonID)
+	case "a":
+		// Select all, or clear the selection when everything is selected
+		count, _ := s.selection()
+		for _, c := range s.Candidates {
+			c.Selected = count < len(s.Candidates)
+		}
+		return nil
+	case "j", "down":
+		p.janitorModal.HandleKey(tea.KeyMsg{Type: tea.KeyTab})
+		return nil
+	case "k", "up":
+		p.janitorModal.HandleKey(tea.KeyMsg{Type: tea.KeyShiftTab})
+		return nil
+	}
+
+	// Checkboxes toggle themselves on space/enter
+	action, cmd := p.janitorModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleJanitorAction(action))
+}
+
+// handleJanitorAction handles a cleanup modal button action.
+func (p *Plugin) handleJanitorAction(action string) tea.Cmd {
+	if p.janitorState == nil || p.janitorState.Deleting {
+		return nil
+	}
+	switch action {
+	case "cancel", janitorCancelButtonID:
+		p.cancelJanitor()
+	case janitorDeleteButtonID:
+		return p.executeJanitor()
+	}
+	return nil
+}
+
+// handleRepoSwitcherKeys handles keys in the repo switcher modal.
+func (p *Plugin) handleRepoSwitcherKeys(msg tea.KeyMsg) tea.Cmd {
+	p.ensureRepoModal()
+	if p.repoModal == nil {
+		p.closeRepoSwitcher()
+		return nil
+	}
+	action, cmd := p.repoModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleRepoAction(action))
+}
+
+// handleRepoAction switches to the selected repo or closes the switcher
+// (from keyboard or mouse).
+func (p *Plugin) handleRepoAction(action string) tea.Cmd {
+	switch {
+	case action == "cancel":
+		p.closeRepoSwitcher()
+	case action == repoListID || strings.HasPrefix(action, repoItemPfx):
+		if p.repoIdx < 0 || p.repoIdx >= len(p.repoEntries) {
+			return nil
+		}
+		path := p.repoEntries[p.repoIdx].Path
+		p.closeRepoSwitcher()
+		return p.switchRepo(path)
+	}
+	return nil
+}
+
+// handleEnvEditorKeys handles keys in the env editor modal.
+func (p *Plugin) handleEnvEditorKeys(msg tea.KeyMsg) tea.Cmd {
+	p.ensureEnvEditorModal()
+	if p.envEditorModal == nil {
+		p.closeEnvEditor()
+		return nil
+	}
+	if msg.String() == "ctrl+s" {
+		return p.saveEnvEditor()
+	}
+	focusID := p.envEditorModal.FocusedID()
+	action, cmd := p.envEditorModal.HandleKey(msg)
+	if action == envSaveButtonID && focusID == envTextareaID {
+		return cmd // enter inserts a newline in the textarea
+	}
+	return tea.Batch(cmd, p.handleEnvEditorAction(action))
+}
+
+// handleEnvEditorAction saves or cancels the env editor (from keyboard or
+// mouse).
+func (p *Plugin) handleEnvEditorAction(action string) tea.Cmd {
+	switch action {
+	case "cancel", envCancelButtonID:
+		p.closeEnvEditor()
+	case envSaveButtonID:
+		return p.saveEnvEditor()
+	}
+	return nil
+}
+
+// handleBroadcastKeys handles keys in the broadcast modal.
+func (p *Plugin) handleBroadcastKeys(msg tea.KeyMsg) tea.Cmd {
+	s := p.broadcastState
+	if s == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensureBroadcastModal()
+	if p.broadcastModal == nil {
+		return nil
+	}
+	if msg.String() == "ctrl+a" {
+		s.toggleAll()
+		return nil
+	}
+	action, cmd := p.broadcastModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleBroadcastAction(action))
+}
+
+// handleBulkCreateKeys handles keys in the bulk create modal.
+func (p *Plugin) handleBulkCreateKeys(msg tea.KeyMsg) tea.Cmd {
+	s := p.bulkCreateState
+	if s == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensureBulkCreateModal()
+	if p.bulkCreateModal == nil {
+		return nil
+	}
+	if msg.String() == "ctrl+a" {
+		s.toggleAll()
+		return nil
+	}
+	action, cmd := p.bulkCreateModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleBulkCreateAction(action))
+}
+
+// handleBulkCreateAction creates the worktrees or closes the modal (from
+// keyboard or mouse).
+func (p *Plugin) handleBulkCreateAction(action string) tea.Cmd {
+	switch action {
+	case "cancel", bulkCancelButtonID:
+		if p.bulkCreateState != nil && !p.bulkCreateState.InProgress {
+			p.closeBulkCreate()
+		}
+	case bulkCreateButtonID:
+		return p.submitBulkCreate()
+	}
+	return nil
+}
+
+// handleBroadcastAction sends or cancels the broadcast (from keyboard or
+// mouse).
+func (p *Plugin) handleBroadcastAction(action string) tea.Cmd {
+	switch action {
+	case "cancel", broadcastCancelButtonID:
+		p.closeBroadcast()
+	case broadcastSendButtonID:
+		return p.sendBroadcast()
+	}
+	return nil
+}
+
+// handlePortsKeys handles keys in the ports modal.
+func (p *Plugin) handlePortsKeys(msg tea.KeyMsg) tea.Cmd {
+	if p.portsState == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensurePortsModal()
+	if p.portsModal == nil {
+		return nil
+	}
+	if p.portsState.ConfirmKill {
+		switch msg.String() {
+		case "y", "x":
+			return p.handlePortsAction(portsConfirmKillButtonID)
+		case "n":
+			return p.handlePortsAction(portsCancelKillButtonID)
+		}
+	} else {
+		switch msg.String() {
+		case "o":
+			return p.handlePortsAction(portsOpenButtonID)
+		case "x":
+			return p.handlePortsAction(portsKillButtonID)
+		}
+	}
+	action, cmd := p.portsModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handlePortsAction(action))
+}
+
+// handlePortsAction opens or kills the selected port, or closes the modal
+// (from keyboard or mouse).
+func (p *Plugin) handlePortsAction(action string) tea.Cmd {
+	if p.portsState != nil && p.portsState.ConfirmKill {
+		switch action {
+		case portsConfirmKillButtonID:
+			return p.killSelectedPort()
+		case "cancel", portsCancelKillButtonID:
+			p.cancelKillPort()
+		}
+		return nil
+	}
+	switch {
+	case action == "cancel" || action == portsCloseButtonID:
+		p.closePorts()
+	case action == portsOpenButtonID || strings.HasPrefix(action, portsItemPfx):
+		return p.openSelectedPort()
+	case action == portsKillButtonID:
+		p.confirmKillPort()
+	}
+	return nil
+}
+
+// handleDiscoverKeys handles keys in the discover sessions modal.
+func (p *Plugin) handleDiscoverKeys(msg tea.KeyMsg) tea.Cmd {
+	if p.discoverState == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensureDiscoverModal()
+	if p.discoverModal == nil {
+		return nil
+	}
+	switch msg.String() {
+	case "a":
+		return p.handleDiscoverAction(discoverAttachButtonID)
+	case "s":
+		return p.handleDiscoverAction(discoverAdoptButtonID)
+	}
+	action, cmd := p.discoverModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleDiscoverAction(action))
+}
+
+// handleDiscoverAction attaches to or adopts the selected session, or closes
+// the modal (from keyboard or mouse).
+func (p *Plugin) handleDiscoverAction(action string) tea.Cmd {
+	switch {
+	case action == "cancel" || action == discoverCloseButtonID:
+		p.closeDiscover()
+	case action == discoverAttachButtonID || strings.HasPrefix(action, discoverItemPfx):
+		return p.attachDiscoveredSession()
+	case action == discoverAdoptButtonID:
+		return p.adoptDiscoveredSession()
+	}
+	return nil
+}
+
+// handleRemoteCreateKeys handles keys in the remote worktree modal.
+func (p *Plugin) handleRemoteCreateKeys(msg tea.KeyMsg) tea.Cmd {
+	if p.remoteCreateState == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+	p.ensureRemoteCreateModal()
+	if p.remoteCreateModal == nil {
+		return nil
+	}
+	action, cmd := p.remoteCreateModal.HandleKey(msg)
+	return tea.Batch(cmd, p.handleRemoteCreateAction(action))
+}
+
+// handleRemoteCreateAction creates the remote worktree or closes the modal
+// (from keyboard or mouse).
+func (p *Plugin) handleRemoteCreateAction(action string) tea.Cmd {
+	switch action {
+	case "cancel", remoteCancelButtonID:
+		p.closeRemoteCreate()
+	case remoteCreateButtonID, remoteNameInputID, remoteBaseInputID:
+		return p.submitRemoteCreate()
+	}
+	return nil
+}
+
 // handleFetchPRKeys handles keys in the fetch PR modal.
 func (p *Plugin) handleFetchPRKeys(msg tea.KeyMsg) tea.Cmd {
 	p.ensureFetchPRModal()
@@ -275,7 +654,7 @@ func (p *Plugin) handleAgentChoiceKeys(msg tea.KeyMsg) tea.Cmd {
 		p.viewMode = ViewModeList
 		p.clearAgentChoiceModal()
 		return nil
-	case agentChoiceActionID, agentChoiceConfirmID, "agent-choice-attach", "agent-choice-restart":
+	case agentChoiceActionID, agentChoiceConfirmID, "agent-choice-attach", "agent-choice-restart", "agent-choice-resume":
 		return p.executeAgentChoice()
 	}
 
@@ -295,11 +674,12 @@ func (p *Plugin) executeAgentChoice() tea.Cmd {
 		// Attach to existing session
 		return p.AttachToSession(wt)
 	}
-	// Restart agent: stop first, then start
+	// Restart (1) or resume (2) agent: stop first, then start
+	resume := idx == 2
 	return tea.Sequence(
 		p.StopAgent(wt),
 		func() tea.Msg {
-			return restartAgentMsg{worktree: wt}
+			return restartAgentMsg{worktree: wt, resume: resume}
 		},
 	)
 }
@@ -343,6 +723,18 @@ func (p *Plugin) executeDelete() tea.Cmd {
 		return nil
 	}
 
+	if wt.Host != "" {
+		deleteBranch := p.deleteLocalBranchOpt
+		delete(p.managedSessions, tmuxSessionPrefix+sanitizeName(wt.Name))
+		p.viewMode = ViewModeList
+		p.clearConfirmDeleteModal()
+		p.diffContent = ""
+		p.diffRaw = ""
+		p.cachedTaskID = ""
+		p.cachedTask = nil
+		return p.deleteRemoteWorktree(wt, deleteBranch)
+	}
+
 	name := wt.Name
 	path := wt.Path
 	branch := wt.Branch
@@ -350,6 +742,7 @@ func (p *Plugin) executeDelete() tea.Cmd {
 	deleteLocal := p.deleteLocalBranchOpt
 	deleteRemote := p.deleteRemoteBranchOpt && p.deleteHasRemote
 	workDir := p.ctx.WorkDir
+	undoable := p.undoWindow() > 0 && !isMissing
 
 	// Kill tmux session if it exists (before deleting worktree)
 	sessionName := tmuxSessionPrefix + sanitizeName(name)
@@ -370,12 +763,20 @@ func (p *Plugin) executeDelete() tea.Cmd {
 	p.cachedTask = nil
 
 	return func() tea.Msg {
+		// Move the worktree to the trash so the delete can be undone
+		if undoable {
+			entry, warnings, err := trashWorktree(workDir, path, name, branch, deleteLocal, deleteRemote)
+			if err == nil {
+				return DeleteDoneMsg{Name: name, Path: path, Warnings: warnings, Undo: entry}
+			}
+		}
+
 		var warnings []string
 
 		// Delete the worktree first
 		err := doDeleteWorktree(workDir, path, isMissing)
 		if err != nil {
-			return DeleteDoneMsg{Name: name, Err: err}
+			return DeleteDoneMsg{Name: name, Path: path, Err: err}
 		}
 
 		// Delete local branch if requested
@@ -392,7 +793,7 @@ func (p *Plugin) executeDelete() tea.Cmd {
 			}
 		}
 
-		return DeleteDoneMsg{Name: name, Err: nil, Warnings: warnings}
+		return DeleteDoneMsg{Name: name, Path: path, Err: nil, Warnings: warnings}
 	}
 }
 
@@ -452,11 +853,32 @@ func (p *Plugin) executeShellDelete() tea.Cmd {
 	}
 
 	sessionName := shell.TmuxName
+	adopted := shell.Adopted
 
 	// Clear modal state
 	p.viewMode = ViewModeList
 	p.clearConfirmDeleteShellModal()
 
+	if p.undoWindow() > 0 {
+		workDir := p.ctx.WorkDir
+		kill := p.killShellSessionByName(sessionName)
+		return func() tea.Msg {
+			entry, err := trashShell(workDir, shell)
+			if err != nil {
+				if adopted || kill == nil {
+					return ShellKilledMsg{SessionName: sessionName}
+				}
+				return kill()
+			}
+			globalPaneCache.remove(sessionName)
+			return ShellKilledMsg{SessionName: sessionName, Undo: entry}
+		}
+	}
+
+	if adopted {
+		// Adopted sessions predate forge: stop tracking them but leave them running
+		return func() tea.Msg { return ShellKilledMsg{SessionName: sessionName} }
+	}
 	return p.killShellSessionByName(sessionName)
 }
 
@@ -478,6 +900,13 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 	// Clear any deletion warnings on key interaction
 	p.deleteWarnings = nil
 
+	// Playback controls take precedence while a replay is shown
+	if p.replay != nil {
+		if cmd, handled := p.handleReplayKeys(msg); handled {
+			return cmd
+		}
+	}
+
 	switch msg.String() {
 	case "j", "down":
 		if p.viewMode == ViewModeKanban {
@@ -733,7 +1162,15 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 		if p.activePane == PanePreview && p.previewTab == PreviewTabDiff {
 			return p.openFilePicker()
 		}
+		// Fold or unfold passing steps on the CI tab
+		if p.activePane == PanePreview && p.previewTab == PreviewTabCI {
+			p.toggleCIFolding()
+		}
 	case "r":
+		// On the CI tab, reload the run logs as well
+		if p.activePane == PanePreview && p.previewTab == PreviewTabCI {
+			return tea.Batch(func() tea.Msg { return RefreshMsg{} }, p.reloadCILog())
+		}
 		return func() tea.Msg { return RefreshMsg{} }
 	case "i":
 		// Legacy shortcut for interactive mode (enter is now primary)
@@ -832,6 +1269,10 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			}
 		}
 	case "R":
+		// Re-run the workflow shown on the CI tab
+		if p.activePane == PanePreview && p.previewTab == PreviewTabCI && !p.shellSelected {
+			return p.rerunCI()
+		}
 		// Rename selected shell session
 		if p.shellSelected && p.selectedShellIdx >= 0 && p.selectedShellIdx < len(p.shells) {
 			shell := p.shells[p.selectedShellIdx]
@@ -900,6 +1341,48 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 		if wt != nil {
 			return p.startMergeWorkflow(wt)
 		}
+	case "B":
+		// Start rebase workflow
+		wt := p.selectedWorktree()
+		if wt != nil && !p.shellSelected {
+			return p.startRebaseWorkflow(wt)
+		}
+	case "C":
+		// Open stale worktree cleanup
+		return p.startJanitor()
+	case "P":
+		// Switch which repo's workspaces are managed
+		return p.openRepoSwitcher()
+	case "e":
+		// Edit the selected worktree's .forge-env
+		return p.openEnvEditor()
+	case "b":
+		// Send one prompt to several running agents
+		return p.openBroadcast()
+	case "L":
+		// List processes listening on ports in the selected worktree
+		return p.openPorts()
+	case "A":
+		// Discover tmux sessions not created by forge
+		return p.openDiscover()
+	case "V":
+		// Start/stop recording the agent pane
+		return p.toggleRecording()
+	case "W":
+		// Replay the latest recording in the preview pane
+		return p.openReplay()
+	case "|":
+		// Show the live diff next to agent output on the Output tab
+		return p.toggleSplitPreview()
+	case "u":
+		// Restore the most recently deleted workspace or shell
+		return p.undoLastDelete()
+	case "H":
+		// Create a worktree on a configured SSH host
+		return p.openRemoteCreate()
+	case "M":
+		// Create one worktree per selected td task
+		return p.openBulkCreate()
 	case "O":
 		// Open selected worktree in git tab - switch to worktree and focus git plugin
 		wt := p.selectedWorktree()
@@ -82,6 +82,12 @@ type MergeWorkflowState struct {
 	TargetBranchOption int      // Selected index in branch list
 	TargetBranches     []string // Available branches for selection
 
+	// Conflict pre-check (git merge-tree dry run against the target branch)
+	ConflictCheckTarget  string   // Target branch the latest check ran against
+	ConflictCheckRunning bool     // True while the dry run is in flight
+	ConflictFiles        []string // Files predicted to conflict
+	ConflictCheckErr     error    // Set if the dry run could not be performed
+
 	// Merge method selection
 	UseDirectMerge    bool // true = direct merge to base, false = PR workflow
 	MergeMethodOption int  // 0 = Create PR (default), 1 = Direct merge
@@ -257,6 +263,9 @@ func (p *Plugin) startMergeWorkflow(wt *Worktree) tea.Cmd {
 	if wt == nil {
 		return nil
 	}
+	if wt.Host != "" {
+		return msg.ShowToast("Merge remote workspaces on "+wt.Host+"; the merge workflow runs locally", 3*time.Second)
+	}
 
 	// Check for uncommitted changes before proceeding
 	return p.checkUncommittedChanges(wt)
@@ -282,8 +291,11 @@ func (p *Plugin) proceedToMergeWorkflow(wt *Worktree) tea.Cmd {
 
 	p.viewMode = ViewModeMerge
 
-	// Load diff summary for review
-	return p.loadMergeDiff(wt)
+	// Load diff summary for review alongside a conflict pre-check
+	return tea.Batch(
+		p.loadMergeDiff(wt),
+		p.runMergeConflictCheck(p.mergeState.TargetBranch),
+	)
 }
 
 // loadMergeDiff loads the diff file summary for the merge workflow.
@@ -835,20 +847,8 @@ type LocalBranchesMsg struct {
 // loadLocalBranches fetches local branch names for the target branch picker.
 func (p *Plugin) loadLocalBranches(wt *Worktree) tea.Cmd {
 	return func() tea.Msg {
-		cmd := exec.Command("git", "branch", "--list", "--format=%(refname:short)")
-		cmd.Dir = wt.Path
-		output, err := cmd.Output()
-		if err != nil {
-			return LocalBranchesMsg{Err: err}
-		}
-		var branches []string
-		for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
-			line = strings.TrimSpace(line)
-			if line != "" && line != wt.Branch {
-				branches = append(branches, line)
-			}
-		}
-		return LocalBranchesMsg{Branches: branches}
+		branches, err := listLocalBranches(wt)
+		return LocalBranchesMsg{Branches: branches, Err: err}
 	}
 }
 
@@ -878,6 +878,10 @@ func (p *Plugin) advanceMergeStep() tea.Cmd {
 		p.mergeState.StepStatus[MergeStepMergeMethod] = "running"
 		p.mergeState.MergeMethodOption = 0
 		p.mergeState.UseDirectMerge = false
+		// Re-run the pre-check if a different target was picked
+		if p.mergeState.TargetBranch != p.mergeState.ConflictCheckTarget {
+			return p.runMergeConflictCheck(p.mergeState.TargetBranch)
+		}
 		return nil
 
 	case MergeStepMergeMethod:
@@ -903,6 +907,7 @@ func (p *Plugin) advanceMergeStep() tea.Cmd {
 	case MergeStepDirectMerge:
 		// Direct merge completed, go to confirmation
 		p.mergeState.StepStatus[MergeStepDirectMerge] = "done"
+		p.publishMergeCompleted()
 		p.mergeState.Step = MergeStepPostMergeConfirmation
 		p.mergeState.StepStatus[MergeStepPostMergeConfirmation] = "running"
 		// Initialize default checkbox values
@@ -940,6 +945,7 @@ func (p *Plugin) advanceMergeStep() tea.Cmd {
 	case MergeStepWaitingMerge:
 		// Mark WaitingMerge as done, go to confirmation step
 		p.mergeState.StepStatus[MergeStepWaitingMerge] = "done"
+		p.publishMergeCompleted()
 		p.mergeState.Step = MergeStepPostMergeConfirmation
 		p.mergeState.StepStatus[MergeStepPostMergeConfirmation] = "running"
 
@@ -0,0 +1,121 @@
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MergeConflictCheckMsg carries the result of a merge-tree dry run.
+type MergeConflictCheckMsg struct {
+	WorkspaceName string
+	Target        string   // Target branch the check was run against
+	Files         []string // Paths predicted to conflict (empty = clean merge)
+	Err           error    // Non-nil if the check could not be run
+}
+
+// errMergeTreeUnsupported indicates the installed git lacks merge-tree --write-tree.
+var errMergeTreeUnsupported = errors.New("git 2.38+ required for conflict pre-check")
+
+// runMergeConflictCheck starts an async conflict pre-check against target.
+// Results for a stale target are ignored when they arrive.
+func (p *Plugin) runMergeConflictCheck(target string) tea.Cmd {
+	if p.mergeState == nil || p.mergeState.Worktree == nil || target == "" {
+		return nil
+	}
+	wt := p.mergeState.Worktree
+	p.mergeState.ConflictCheckTarget = target
+	p.mergeState.ConflictCheckRunning = true
+	p.mergeState.ConflictFiles = nil
+	p.mergeState.ConflictCheckErr = nil
+
+	return func() tea.Msg {
+		files, err := predictMergeConflicts(wt.Path, target)
+		return MergeConflictCheckMsg{
+			WorkspaceName: wt.Name,
+			Target:        target,
+			Files:         files,
+			Err:           err,
+		}
+	}
+}
+
+// predictMergeConflicts dry-runs merging HEAD into target with git merge-tree
+// and returns the paths that would conflict. Nothing in the worktree or the
+// index is touched. The remote-tracking branch is preferred since that is
+// what the merge workflow merges into.
+func predictMergeConflicts(workdir, target string) ([]string, error) {
+	ref := target
+	if remote := "origin/" + target; gitRefExists(workdir, "refs/remotes/"+remote) {
+		ref = remote
+	}
+
+	cmd := exec.Command("git", "merge-tree", "--write-tree", "--no-messages", ref, "HEAD")
+	cmd.Dir = workdir
+	output, err := cmd.Output()
+	if err == nil {
+		return nil, nil
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return nil, err
+	}
+	switch exitErr.ExitCode() {
+	case 1:
+		// Exit status 1 means the merge has conflicts
+		return parseMergeTreeConflicts(output), nil
+	case 129:
+		return nil, errMergeTreeUnsupported
+	default:
+		msg := strings.TrimSpace(string(exitErr.Stderr))
+		if msg == "" {
+			msg = err.Error()
+		}
+		return nil, fmt.Errorf("merge-tree: %s", msg)
+	}
+}
+
+// gitRefExists reports whether ref resolves in the repository at workdir.
+func gitRefExists(workdir, ref string) bool {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", ref)
+	cmd.Dir = workdir
+	return cmd.Run() == nil
+}
+
+// parseMergeTreeConflicts extracts conflicted paths from merge-tree --write-tree
+// output. The first line is the result tree OID, followed by one
+// "<mode> <object> <stage>\t<path>" line per conflicted stage; a path appears
+// once per stage so duplicates are collapsed.
+func parseMergeTreeConflicts(output []byte) []string {
+	var files []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	first := true
+	for scanner.Scan() {
+		line := scanner.Text()
+		if first {
+			first = false
+			continue
+		}
+		if line == "" {
+			// Blank line separates conflicted files from informational messages
+			break
+		}
+		path := line
+		if _, after, ok := strings.Cut(line, "\t"); ok {
+			path = after
+		}
+		if !seen[path] {
+			seen[path] = true
+			files = append(files, path)
+		}
+	}
+	return files
+}
@@ -0,0 +1,60 @@
+package workspace
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMergeTreeConflicts(t *testing.T) {
+	out := []byte("3f1c0a\n100644 aaa 1\tshared.txt\n100644 bbb 2\tshared.txt\n100644 ccc 3\tshared.txt\n100644 ddd 2\tdir/other.go\n\nAuto-merging shared.txt\n")
+	got := parseMergeTreeConflicts(out)
+	want := []string{"shared.txt", "dir/other.go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("files = %v, want %v", got, want)
+	}
+	if got := parseMergeTreeConflicts([]byte("3f1c0a\n")); len(got) != 0 {
+		t.Errorf("clean merge = %v, want none", got)
+	}
+}
+
+func TestPredictMergeConflicts(t *testing.T) {
+	dir := newRebaseTestRepo(t)
+
+	files, err := predictMergeConflicts(dir, "main")
+	if err == errMergeTreeUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(files, []string{"shared.txt"}) {
+		t.Errorf("files = %v, want [shared.txt]", files)
+	}
+
+	// Merging a branch into itself never conflicts
+	files, err = predictMergeConflicts(dir, "feature")
+	if err != nil || len(files) != 0 {
+		t.Errorf("self merge = %v, %v; want clean", files, err)
+	}
+}
+
+func TestMergeConflictCheckMsgIgnoresStaleTarget(t *testing.T) {
+	p := New()
+	wt := &Worktree{Name: "feat", Path: "/tmp/feat"}
+	p.mergeState = &MergeWorkflowState{
+		Worktree:             wt,
+		StepStatus:           make(map[MergeWorkflowStep]string),
+		ConflictCheckTarget:  "develop",
+		ConflictCheckRunning: true,
+	}
+
+	p.Update(MergeConflictCheckMsg{WorkspaceName: "feat", Target: "main", Files: []string{"a.go"}})
+	if !p.mergeState.ConflictCheckRunning || len(p.mergeState.ConflictFiles) != 0 {
+		t.Error("result for an old target should be ignored")
+	}
+
+	p.Update(MergeConflictCheckMsg{WorkspaceName: "feat", Target: "develop", Files: []string{"b.go"}})
+	if p.mergeState.ConflictCheckRunning || !reflect.DeepEqual(p.mergeState.ConflictFiles, []string{"b.go"}) {
+		t.Errorf("state = %+v, want conflicts recorded", p.mergeState)
+	}
+}
@@ -111,8 +111,10 @@ type DeleteWorktreeMsg struct {
 // DeleteDoneMsg signals worktree deletion completed.
 type DeleteDoneMsg struct {
 	Name     string
+	Path     string
 	Err      error
-	Warnings []string // Non-fatal warnings (e.g., branch deletion failures)
+	Warnings []string   // Non-fatal warnings (e.g., branch deletion failures)
+	Undo     *undoEntry // Set when the worktree was moved to the trash
 }
 
 // RemoteCheckDoneMsg signals remote branch existence check completed.
@@ -152,6 +154,7 @@ type TaskLinkedMsg struct {
 	WorkspaceName string
 	TaskID       string
 	Err          error
+	Auto         bool // Linked from the branch name rather than by the user
 }
 
 // Task represents a TD task for linking.
@@ -186,6 +189,7 @@ type TaskDetailsLoadedMsg struct {
 // restartAgentMsg signals that an agent should be restarted after stopping.
 type restartAgentMsg struct {
 	worktree *Worktree
+	resume   bool // Start with the agent's resume command
 }
 
 // CommitStatusLoadedMsg delivers commit status info for the diff view header.
@@ -206,6 +210,12 @@ type OpenCreateModalWithTaskMsg struct {
 	TaskTitle string
 }
 
+// SelectWorktreeMsg selects a worktree by name.
+// Sent from the app-wide search when a worktree result is opened.
+type SelectWorktreeMsg struct {
+	Name string
+}
+
 // ResumeConversationMsg requests resuming a conversation in a new shell or worktree.
 // Sent from conversations plugin when user presses O key.
 type ResumeConversationMsg struct {
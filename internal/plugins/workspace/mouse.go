@@ -1,11 +1,14 @@
 package workspace
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/wilbur182/forge/internal/mouse"
+	"github.com/wilbur182/forge/internal/plugin"
 	"github.com/wilbur182/forge/internal/state"
 )
 
@@ -26,7 +29,7 @@ func isBackgroundRegion(regionID string) bool {
 	case regionSidebar, regionPreviewPane, regionPaneDivider,
 		regionWorktreeItem, regionPreviewTab,
 		regionCreateWorktreeButton, regionShellsPlusButton, regionWorkspacesPlusButton,
-		regionKanbanCard, regionKanbanColumn, regionViewToggle:
+		regionRepoName, regionKanbanCard, regionKanbanColumn, regionViewToggle:
 		return true
 	default:
 		return false
@@ -76,6 +79,70 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) tea.Cmd {
 		return p.handleMergeModalMouse(msg)
 	}
 
+	if p.viewMode == ViewModeRebase {
+		return p.handleRebaseModalMouse(msg)
+	}
+
+	if p.viewMode == ViewModeJanitor {
+		return p.handleJanitorModalMouse(msg)
+	}
+
+	if p.viewMode == ViewModeRepoSwitcher {
+		p.ensureRepoModal()
+		if p.repoModal == nil {
+			return nil
+		}
+		return p.handleRepoAction(p.repoModal.HandleMouse(msg, p.mouseHandler))
+	}
+
+	if p.viewMode == ViewModeBroadcast {
+		p.ensureBroadcastModal()
+		if p.broadcastModal == nil {
+			return nil
+		}
+		return p.handleBroadcastAction(p.broadcastModal.HandleMouse(msg, p.mouseHandler))
+	}
+
+	if p.viewMode == ViewModeBulkCreate {
+		p.ensureBulkCreateModal()
+		if p.bulkCreateModal == nil {
+			return nil
+		}
+		return p.handleBulkCreateAction(p.bulkCreateModal.HandleMouse(msg, p.mouseHandler))
+	}
+
+	if p.viewMode == ViewModeRemoteCreate {
+		p.ensureRemoteCreateModal()
+		if p.remoteCreateModal == nil {
+			return nil
+		}
+		return p.handleRemoteCreateAction(p.remoteCreateModal.HandleMouse(msg, p.mouseHandler))
+	}
+
+	if p.viewMode == ViewModeDiscover {
+		p.ensureDiscoverModal()
+		if p.discoverModal == nil {
+			return nil
+		}
+		return p.handleDiscoverAction(p.discoverModal.HandleMouse(msg, p.mouseHandler))
+	}
+
+	if p.viewMode == ViewModePorts {
+		p.ensurePortsModal()
+		if p.portsModal == nil {
+			return nil
+		}
+		return p.handlePortsAction(p.portsModal.HandleMouse(msg, p.mouseHandler))
+	}
+
+	if p.viewMode == ViewModeEnvEditor {
+		p.ensureEnvEditorModal()
+		if p.envEditorModal == nil {
+			return nil
+		}
+		return p.handleEnvEditorAction(p.envEditorModal.HandleMouse(msg, p.mouseHandler))
+	}
+
 	if p.viewMode == ViewModeCommitForMerge {
 		return p.handleCommitForMergeModalMouse(msg)
 	}
@@ -309,7 +376,7 @@ func (p *Plugin) handleAgentChoiceModalMouse(msg tea.MouseMsg) tea.Cmd {
 		p.viewMode = ViewModeList
 		p.clearAgentChoiceModal()
 		return nil
-	case agentChoiceActionID, agentChoiceConfirmID, "agent-choice-attach", "agent-choice-restart":
+	case agentChoiceActionID, agentChoiceConfirmID, "agent-choice-attach", "agent-choice-restart", "agent-choice-resume":
 		return p.executeAgentChoice()
 	}
 	return nil
@@ -361,6 +428,35 @@ func (p *Plugin) handleMergeModalMouse(msg tea.MouseMsg) tea.Cmd {
 	return nil
 }
 
+func (p *Plugin) handleRebaseModalMouse(msg tea.MouseMsg) tea.Cmd {
+	p.ensureRebaseModal()
+	if p.rebaseModal == nil {
+		return nil
+	}
+	return p.handleRebaseAction(p.rebaseModal.HandleMouse(msg, p.mouseHandler))
+}
+
+func (p *Plugin) handleJanitorModalMouse(msg tea.MouseMsg) tea.Cmd {
+	p.ensureJanitorModal()
+	if p.janitorModal == nil || p.janitorState == nil {
+		return nil
+	}
+
+	action := p.janitorModal.HandleMouse(msg, p.mouseHandler)
+	s := p.janitorState
+	switch {
+	case action == janitorBranchesID:
+		s.DeleteBranches = !s.DeleteBranches
+	case strings.HasPrefix(action, janitorItemPfx):
+		if i, err := strconv.Atoi(strings.TrimPrefix(action, janitorItemPfx)); err == nil && i < len(s.Candidates) {
+			s.Candidates[i].Selected = !s.Candidates[i].Selected
+		}
+	default:
+		return p.handleJanitorAction(action)
+	}
+	return nil
+}
+
 func (p *Plugin) handleCommitForMergeModalMouse(msg tea.MouseMsg) tea.Cmd {
 	p.ensureCommitForMergeModal()
 	if p.commitForMergeModal == nil {
@@ -487,6 +583,9 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 	case regionWorkspacesPlusButton:
 		// Click on Worktrees [+] button - open new worktree modal directly
 		return p.openCreateModal()
+	case regionRepoName:
+		// Click on repo name in the sidebar header - open repo switcher
+		return p.openRepoSwitcher()
 	case regionSidebar:
 		p.activePane = PaneSidebar
 	case regionPreviewPane:
@@ -551,7 +650,7 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 		}
 	case regionPreviewTab:
 		// Click on preview tab
-		if idx, ok := action.Region.Data.(int); ok && idx >= 0 && idx <= 2 {
+		if idx, ok := action.Region.Data.(int); ok && idx >= 0 && idx < previewTabCount {
 			prevTab := p.previewTab
 			p.previewTab = PreviewTab(idx)
 			p.previewOffset = 0
@@ -567,6 +666,8 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 				return p.loadSelectedDiff()
 			case PreviewTabTask:
 				return p.loadTaskDetailsIfNeeded()
+			case PreviewTabCI:
+				return p.loadCILogIfNeeded()
 			}
 		}
 	case regionKanbanCard:
@@ -579,6 +680,11 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 			p.kanbanRow = data.row
 			p.syncKanbanToList()
 			p.applyKanbanSelectionChange(oldShellSelected, oldShellIdx, oldWorktreeIdx)
+			// Worktree cards can be dragged onto another column
+			if wt := p.getKanbanWorktree(data.col, data.row); wt != nil {
+				p.kanbanDrag = &kanbanDrag{Worktree: wt.Name, FromCol: data.col, ToCol: data.col}
+				p.mouseHandler.StartDrag(action.X, action.Y, regionKanbanCard, data.col)
+			}
 			return p.loadSelectedContent()
 		}
 	case regionKanbanColumn:
@@ -888,7 +994,7 @@ func (p *Plugin) scrollPreview(delta int) tea.Cmd {
 // scrollKanban scrolls within the current Kanban column.
 func (p *Plugin) scrollKanban(delta int) tea.Cmd {
 	columns := p.getKanbanColumns()
-	if p.kanbanCol < 0 || p.kanbanCol >= kanbanColumnCount() {
+	if p.kanbanCol < 0 || p.kanbanCol >= p.kanbanColumnCount() {
 		return nil
 	}
 	count := p.kanbanColumnItemCount(p.kanbanCol, columns)
@@ -929,25 +1035,49 @@ func (p *Plugin) handleMouseDrag(action mouse.MouseAction) tea.Cmd {
 	case regionPaneDivider:
 		// Calculate new sidebar width based on drag
 		startValue := p.mouseHandler.DragStartValue()
-		newWidth := startValue + (action.DragDX * 100 / p.width) // Convert px delta to %
-
-		// Clamp to reasonable bounds (20% - 60%)
-		if newWidth < 20 {
-			newWidth = 20
-		}
-		if newWidth > 60 {
-			newWidth = 60
-		}
-		p.sidebarWidth = newWidth
+		p.setSidebarWidth(startValue + (action.DragDX * 100 / p.width)) // Convert px delta to %
 	case regionPreviewPane:
 		if p.viewMode == ViewModeInteractive && p.interactiveState != nil && p.interactiveState.Active &&
 			!p.interactiveState.MouseReportingEnabled {
 			return p.handleInteractiveSelectionDrag(action)
 		}
+	case regionKanbanCard:
+		// Target the column under the pointer, found via the header hit regions
+		if p.kanbanDrag != nil {
+			if region := p.mouseHandler.HitMap.Test(action.X, 3); region != nil && region.ID == regionKanbanColumn {
+				if col, ok := region.Data.(int); ok {
+					p.kanbanDrag.ToCol = col
+				}
+			}
+		}
 	}
 	return nil
 }
 
+// setSidebarWidth applies a sidebar width percentage, clamped to 20% - 60%.
+func (p *Plugin) setSidebarWidth(percent int) {
+	p.sidebarWidth = min(max(percent, 20), 60)
+}
+
+// CanResizePane implements plugin.PaneResizer.
+func (p *Plugin) CanResizePane() bool {
+	if !p.sidebarVisible || p.viewMode != ViewModeList || p.width == 0 {
+		return false
+	}
+	switch p.FocusContext() {
+	case "workspace-list", "workspace-preview":
+		return true
+	}
+	return false
+}
+
+// ResizePane implements plugin.PaneResizer.
+func (p *Plugin) ResizePane(delta int) (int, tea.Cmd) {
+	p.setSidebarWidth(p.sidebarWidth + delta*plugin.PaneResizeStep(100))
+	_ = state.SetWorkspaceSidebarWidth(p.sidebarWidth)
+	return p.sidebarWidth, p.resizeSelectedPaneCmd()
+}
+
 // handleMouseDragEnd handles the end of a drag operation.
 func (p *Plugin) handleMouseDragEnd() tea.Cmd {
 	// Guard: ignore drag-end when a modal is open (td-f63097).
@@ -959,6 +1089,11 @@ func (p *Plugin) handleMouseDragEnd() tea.Cmd {
 		return p.finishInteractiveSelection()
 	}
 
+	if drag := p.kanbanDrag; drag != nil {
+		p.kanbanDrag = nil
+		return p.dropKanbanCard(*drag)
+	}
+
 	// Persist sidebar width
 	_ = state.SetWorkspaceSidebarWidth(p.sidebarWidth)
 	if p.viewMode == ViewModeInteractive && p.interactiveState != nil && p.interactiveState.Active {
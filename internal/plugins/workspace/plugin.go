@@ -9,6 +9,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/event"
 	"github.com/wilbur182/forge/internal/markdown"
 	"github.com/wilbur182/forge/internal/modal"
 	"github.com/wilbur182/forge/internal/mouse"
@@ -76,6 +77,71 @@ const (
 	mergeCleanUpButtonID   = "merge-cleanup-btn"
 	mergeSkipButtonID      = "merge-skip-btn"
 
+	// Rebase modal element IDs
+	rebaseTargetListID     = "rebase-target-list"
+	rebaseTargetItemPfx    = "rebase-target-"
+	rebaseFileListID       = "rebase-file-list"
+	rebaseFileItemPfx      = "rebase-file-"
+	rebaseStartButtonID    = "rebase-start-btn"
+	rebaseContinueButtonID = "rebase-continue-btn"
+	rebaseAbortButtonID    = "rebase-abort-btn"
+	rebaseCloseButtonID    = "rebase-close-btn"
+
+	// Cleanup modal element IDs
+	janitorItemPfx        = "cleanup-item-"
+	janitorBranchesID     = "cleanup-delete-branches"
+	janitorDeleteButtonID = "cleanup-delete-btn"
+	janitorCancelButtonID = "cleanup-cancel-btn"
+
+	// Repo switcher modal element IDs
+	repoListID     = "repo-list"
+	repoItemPfx    = "repo-item-"
+	regionRepoName = "repo-name"
+
+	// Env editor modal element IDs
+	envTextareaID     = "env-text"
+	envSaveButtonID   = "env-save-btn"
+	envCancelButtonID = "env-cancel-btn"
+
+	// Broadcast modal element IDs
+	broadcastInputID        = "broadcast-input"
+	broadcastItemPfx        = "broadcast-item-"
+	broadcastSendButtonID   = "broadcast-send-btn"
+	broadcastCancelButtonID = "broadcast-cancel-btn"
+
+	// Ports modal element IDs
+	portsListID              = "ports-list"
+	portsItemPfx             = "ports-item-"
+	portsOpenButtonID        = "ports-open-btn"
+	portsKillButtonID        = "ports-kill-btn"
+	portsCloseButtonID       = "ports-close-btn"
+	portsConfirmKillButtonID = "ports-confirm-kill-btn"
+	portsCancelKillButtonID  = "ports-cancel-kill-btn"
+
+	// Discover sessions modal element IDs
+	discoverListID         = "discover-list"
+	discoverItemPfx        = "discover-item-"
+	discoverAttachButtonID = "discover-attach-btn"
+	discoverAdoptButtonID  = "discover-adopt-btn"
+	discoverCloseButtonID  = "discover-close-btn"
+
+	// Remote worktree modal element IDs
+	remoteHostListID     = "remote-host-list"
+	remoteHostItemPfx    = "remote-host-"
+	remoteNameInputID    = "remote-name-input"
+	remoteBaseInputID    = "remote-base-input"
+	remoteCreateButtonID = "remote-create-btn"
+	remoteCancelButtonID = "remote-cancel-btn"
+
+	// Bulk create modal element IDs
+	bulkNameInputID    = "bulk-name-input"
+	bulkTaskItemPfx    = "bulk-task-"
+	bulkAgentListID    = "bulk-agent-list"
+	bulkAgentItemPfx   = "bulk-agent-"
+	bulkStartAgentsID  = "bulk-start-agents"
+	bulkCreateButtonID = "bulk-create-btn"
+	bulkCancelButtonID = "bulk-cancel-btn"
+
 	// Prompt Picker modal regions
 	regionPromptItem   = "prompt-item"
 	regionPromptFilter = "prompt-filter"
@@ -105,6 +171,11 @@ type Plugin struct {
 	width   int
 	height  int
 
+	// Multi-repo state: appCtx is the app's shared context; ctx is a copy
+	// rooted at another repo while the repo switcher has one selected.
+	appCtx       *plugin.Context
+	repoSwitches uint64 // Offsets repo context epochs so each switch drops stale messages
+
 	// Worktree state
 	worktrees []*Worktree
 	agents    map[string]*Agent
@@ -133,8 +204,9 @@ type Plugin struct {
 	interactiveCopyPasteHintShown bool
 
 	// Kanban view state
-	kanbanCol int // Current column index (0=Shells, 1=Active, 2=Thinking, 3=Waiting, 4=Done, 5=Paused)
-	kanbanRow int // Current row within the column
+	kanbanCol  int         // Current column index (0=Shells, then kanbanColumns() in order)
+	kanbanRow  int         // Current row within the column
+	kanbanDrag *kanbanDrag // Card being dragged to another column
 
 	// Agent state
 	attachedSession     string // Name of worktree we're attached to (pauses polling)
@@ -162,6 +234,20 @@ type Plugin struct {
 	diffViewMode  DiffViewMode             // Unified or side-by-side
 	multiFileDiff *gitstatus.MultiFileDiff // Parsed multi-file diff with positions
 
+	// Split preview: live diff shown alongside agent output
+	splitPreview      bool
+	splitDiffLoadedAt time.Time
+
+	// CI tab: latest GitHub Actions run for the selected worktree
+	ciLog           *ciLogState
+	ciLoading       bool
+	ciPollScheduled bool
+	ciShowAllSteps  bool // Expand passing steps instead of folding them
+
+	// Undo: deleted worktrees and shells kept in the trash, newest last
+	undoStack          []*undoEntry
+	undoSweepScheduled bool
+
 	// File picker modal state (gf command)
 	filePickerIdx int // Selected file index in picker
 
@@ -172,6 +258,31 @@ type Plugin struct {
 	// Conflict detection state
 	conflicts []Conflict
 
+	// PR status by worktree name; survives worktree refreshes
+	prStatuses    map[string]*PRStatus
+	prStatusTicks <-chan event.Event // scheduler ticks, nil without a shared scheduler
+
+	// Agent spend by worktree name; survives worktree refreshes
+	agentCosts map[string]*AgentCost
+
+	// Post-create hook runs by worktree name; survive worktree refreshes
+	hookRuns map[string]*HookRun
+
+	// Worktree/task pairs already tried for branch-name task linking
+	autoLinkAttempted map[string]bool
+	taskBranchRe      *regexp.Regexp // Task ID pattern from config
+
+	// Completion marker currently visible in each worktree's output
+	seenMarkers map[string]string
+
+	// Disk usage scans by worktree name; survive worktree refreshes
+	diskUsage    map[string]*DiskUsage
+	diskScanning map[string]bool // scans in flight
+
+	// Listening ports by worktree name, from the periodic port scan
+	ports             map[string][]ListeningPort
+	portScanScheduled bool
+
 	// Create modal state
 	createNameInput       textinput.Model
 	createBaseBranchInput textinput.Model
@@ -232,6 +343,62 @@ type Plugin struct {
 	mergeModalWidth int          // Cached width for rebuild detection
 	mergeModalStep  MergeWorkflowStep // Cached step for rebuild detection
 
+	// Rebase workflow state
+	rebaseState      *RebaseState
+	rebaseModal      *modal.Modal // Modal instance for rebase workflow
+	rebaseModalWidth int          // Cached width for rebuild detection
+	rebaseModalStep  RebaseStep   // Cached step for rebuild detection
+
+	// Cleanup (stale worktree janitor) state
+	janitorState      *JanitorState
+	janitorModal      *modal.Modal // Modal instance for cleanup view
+	janitorModalWidth int          // Cached width for rebuild detection
+	janitorModalKey   string       // Cached content key for rebuild detection
+
+	// Repo switcher state
+	repoEntries    []RepoEntry
+	repoIdx        int
+	repoModal      *modal.Modal // Modal instance for repo switcher
+	repoModalWidth int          // Cached width for rebuild detection
+
+	// Per-worktree env editor state
+	envEditor           *EnvEditorState
+	envEditorModal      *modal.Modal // Modal instance for env editor
+	envEditorModalWidth int          // Cached width for rebuild detection
+
+	// Broadcast prompt state
+	broadcastState      *BroadcastState
+	broadcastModal      *modal.Modal // Modal instance for broadcast
+	broadcastModalWidth int          // Cached width for rebuild detection
+
+	// Listening ports modal state
+	portsState      *PortsState
+	portsModal      *modal.Modal // Modal instance for ports
+	portsModalWidth int          // Cached width for rebuild detection
+	portsModalCount int          // Cached port count for rebuild detection
+
+	// Session recording and replay
+	recorders map[string]*sessionRecorder // Active recordings by worktree name
+	replay    *ReplayState                // Recording shown in the preview pane
+
+	// Discover external tmux sessions modal state
+	discoverState      *DiscoverState
+	discoverModal      *modal.Modal // Modal instance for discover
+	discoverModalWidth int          // Cached width for rebuild detection
+
+	// Remote worktree modal state
+	remoteCreateState      *RemoteCreateState
+	remoteCreateModal      *modal.Modal // Modal instance for remote create
+	remoteCreateModalWidth int          // Cached width for rebuild detection
+
+	// Bulk create from tasks modal state
+	bulkCreateState      *BulkCreateState
+	bulkCreateModal      *modal.Modal // Modal instance for bulk create
+	bulkCreateModalWidth int          // Cached width for rebuild detection
+
+	// Consecutive failed remote polls by worktree name (drives backoff)
+	remoteFailures map[string]int
+
 	// Commit-before-merge state
 	mergeCommitState        *MergeCommitState
 	mergeCommitMessageInput textinput.Model
@@ -240,7 +407,7 @@ type Plugin struct {
 
 	// Agent choice modal state (attach vs restart)
 	agentChoiceWorktree    *Worktree
-	agentChoiceIdx         int          // 0=attach, 1=restart
+	agentChoiceIdx         int          // 0=attach, 1=restart, 2=resume
 	agentChoiceModal       *modal.Modal // Modal instance
 	agentChoiceModalWidth  int          // Cached width for rebuild detection
 
@@ -332,6 +499,15 @@ func New() *Plugin {
 		shells:              make([]*ShellSession, 0),
 		pollGeneration:      make(map[string]int),
 		shellPollGeneration: make(map[string]int),
+		prStatuses:          make(map[string]*PRStatus),
+		agentCosts:          make(map[string]*AgentCost),
+		hookRuns:            make(map[string]*HookRun),
+		autoLinkAttempted:   make(map[string]bool),
+		remoteFailures:      make(map[string]int),
+		seenMarkers:         make(map[string]string),
+		diskUsage:           make(map[string]*DiskUsage),
+		diskScanning:        make(map[string]bool),
+		ports:               make(map[string][]ListeningPort),
 		viewMode:            ViewModeList,
 		activePane:          PaneSidebar,
 		previewTab:          PreviewTabOutput,
@@ -372,10 +548,19 @@ func (p *Plugin) SetFocused(f bool) {
 
 // Init initializes the plugin with context.
 func (p *Plugin) Init(ctx *plugin.Context) error {
+	p.appCtx = ctx
+	return p.initContext(ctx)
+}
+
+// initContext (re)initializes the plugin for ctx's repository.
+func (p *Plugin) initContext(ctx *plugin.Context) error {
 	p.ctx = ctx
 	if ctx.Config != nil && ctx.Config.Plugins.Workspace.TmuxCaptureMaxBytes > 0 {
 		p.tmuxCaptureMaxBytes = ctx.Config.Plugins.Workspace.TmuxCaptureMaxBytes
 	}
+	if ctx.Config != nil {
+		loadAgentCatalog(ctx.Config.Plugins.Workspace.Agents)
+	}
 
 	// Reset agent-related state for clean reinit (important for project switching)
 	// Without this, reconnectAgents() won't run again after switching projects
@@ -388,6 +573,25 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	// Reset poll generation counters (td-83dc22): invalidates any stale timers from previous project
 	p.pollGeneration = make(map[string]int)
 	p.shellPollGeneration = make(map[string]int)
+	p.prStatuses = make(map[string]*PRStatus)
+	p.agentCosts = make(map[string]*AgentCost)
+	p.hookRuns = make(map[string]*HookRun)
+	p.autoLinkAttempted = make(map[string]bool)
+	p.loadTaskBranchPattern()
+	p.ciLog = nil
+	p.ciLoading = false
+	p.ciPollScheduled = false
+	p.undoStack = nil
+	p.undoSweepScheduled = false
+	p.remoteFailures = make(map[string]int)
+	p.seenMarkers = make(map[string]string)
+	p.diskUsage = make(map[string]*DiskUsage)
+	p.diskScanning = make(map[string]bool)
+	p.ports = make(map[string][]ListeningPort)
+	p.portScanScheduled = false
+	p.stopAllRecordings()
+	p.recorders = make(map[string]*sessionRecorder)
+	p.replay = nil
 
 	// Reset shell state before initializing for new project (critical for project switching)
 	p.shells = make([]*ShellSession, 0)
@@ -452,6 +656,7 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	if state.GetWorkspaceDiffMode() == "side-by-side" {
 		p.diffViewMode = DiffViewSideBySide
 	}
+	p.splitPreview = state.GetWorkspaceSplitPreview()
 
 	return nil
 }
@@ -473,6 +678,18 @@ func (p *Plugin) Start() tea.Cmd {
 	// Start shell manifest watcher for cross-instance sync (td-f88fdd)
 	cmds = append(cmds, p.startShellWatcher())
 
+	// Poll GitHub PR status for worktree badges
+	cmds = append(cmds, p.startPRStatus())
+
+	// Scan for dev servers listening in each worktree
+	cmds = append(cmds, p.schedulePortScan(portScanInitialDelay))
+
+	// Attribute agent token spend to worktrees
+	cmds = append(cmds, p.scheduleAgentCosts(agentCostInitialDelay))
+
+	// Pick up deletes that can still be undone from an earlier session
+	cmds = append(cmds, p.loadUndoEntries())
+
 	return tea.Batch(cmds...)
 }
 
@@ -514,6 +731,7 @@ func (p *Plugin) Stop() {
 		p.shellWatcher.Stop()
 		p.shellWatcher = nil
 	}
+	p.stopPRStatus()
 }
 
 // saveSelectionState persists the current selection to disk.
@@ -944,7 +1162,7 @@ func (p *Plugin) ensureVisible() {
 // cyclePreviewTab cycles through preview tabs.
 func (p *Plugin) cyclePreviewTab(delta int) tea.Cmd {
 	prevTab := p.previewTab
-	p.previewTab = PreviewTab((int(p.previewTab) + delta + 3) % 3)
+	p.previewTab = PreviewTab((int(p.previewTab) + delta + previewTabCount) % previewTabCount)
 	p.previewOffset = 0
 	p.autoScrollOutput = true // Reset auto-scroll when switching tabs
 	p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot when switching tabs
@@ -964,6 +1182,10 @@ func (p *Plugin) cyclePreviewTab(delta int) tea.Cmd {
 		if cmd := p.loadTaskDetailsIfNeeded(); cmd != nil {
 			cmds = append(cmds, cmd)
 		}
+	case PreviewTabCI:
+		if cmd := p.loadCILogIfNeeded(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 	}
 	if cmd := p.pollSelectedAgentNowIfVisible(); cmd != nil {
 		cmds = append(cmds, cmd)
@@ -1002,6 +1224,13 @@ func (p *Plugin) loadSelectedContent() tea.Cmd {
 		cmds = append(cmds, cmd)
 	}
 
+	// CI logs are only fetched while their tab is showing
+	if p.previewTab == PreviewTabCI {
+		if cmd := p.loadCILogIfNeeded(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
 	if cmd := p.pollSelectedAgentNowIfVisible(); cmd != nil {
 		cmds = append(cmds, cmd)
 	}
@@ -0,0 +1,442 @@
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/app"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+const (
+	// portScanInterval is how often listening ports are rescanned.
+	portScanInterval = 10 * time.Second
+	// portScanInitialDelay gives the first worktree refresh time to land.
+	portScanInitialDelay = 2 * time.Second
+)
+
+// ListeningPort is a TCP port a process in a worktree is listening on.
+type ListeningPort struct {
+	Port    int
+	PID     int
+	Command string
+	Cwd     string
+}
+
+// URL returns the local address a browser can open for the port.
+func (lp ListeningPort) URL() string {
+	return fmt.Sprintf("http://localhost:%d", lp.Port)
+}
+
+// PortsDetectedMsg delivers the listening ports found for each worktree.
+type PortsDetectedMsg struct {
+	Epoch uint64
+	Ports map[string][]ListeningPort // By worktree name
+	Err   error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m PortsDetectedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// portScanTickMsg triggers a periodic port scan.
+type portScanTickMsg struct {
+	Epoch uint64
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m portScanTickMsg) GetEpoch() uint64 { return m.Epoch }
+
+// PortKilledMsg reports the result of stopping a listening process.
+type PortKilledMsg struct {
+	Port ListeningPort
+	Err  error
+}
+
+// PortsState holds the state for the ports modal.
+type PortsState struct {
+	WorkspaceName string
+	Idx           int
+	ConfirmKill   bool // Asking whether to stop the selected process
+}
+
+// schedulePortScan schedules the next port scan unless one is pending.
+// Returns nil when lsof is not installed and /proc is unavailable.
+func (p *Plugin) schedulePortScan(delay time.Duration) tea.Cmd {
+	if p.portScanScheduled || !portScanSupported() {
+		return nil
+	}
+	p.portScanScheduled = true
+	epoch := p.ctx.Epoch
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return portScanTickMsg{Epoch: epoch}
+	})
+}
+
+// loadPorts scans listening ports and assigns them to worktrees.
+func (p *Plugin) loadPorts() tea.Cmd {
+	epoch := p.ctx.Epoch
+	paths := make(map[string]string, len(p.worktrees))
+	for _, wt := range p.worktrees {
+		if !wt.IsMissing {
+			paths[wt.Name] = wt.Path
+		}
+	}
+	return func() tea.Msg {
+		ports, err := listListeningPorts()
+		if err != nil {
+			return PortsDetectedMsg{Epoch: epoch, Err: err}
+		}
+		return PortsDetectedMsg{Epoch: epoch, Ports: assignPortsToWorktrees(ports, paths)}
+	}
+}
+
+// portScanSupported reports whether listening processes can be detected.
+func portScanSupported() bool {
+	if _, err := exec.LookPath("lsof"); err == nil {
+		return true
+	}
+	_, err := os.Stat("/proc/self/cwd")
+	return err == nil
+}
+
+// listListeningPorts returns every TCP listener visible to the current
+// user, with each process's working directory. Uses lsof when installed and
+// falls back to /proc on Linux.
+func listListeningPorts() ([]ListeningPort, error) {
+	var ports []ListeningPort
+	if _, err := exec.LookPath("lsof"); err == nil {
+		out, err := exec.Command("lsof", "-nP", "-iTCP", "-sTCP:LISTEN", "-Fpcn").Output()
+		if err != nil {
+			// lsof exits 1 when nothing matches
+			if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+				return nil, nil
+			}
+			return nil, err
+		}
+		ports = parseLsofListeners(out)
+	} else {
+		var err error
+		if ports, err = procListeners("/proc"); err != nil {
+			return nil, err
+		}
+	}
+
+	var pids []int
+	for _, lp := range ports {
+		pids = append(pids, lp.PID)
+	}
+	cwds := processCwds(pids)
+	for i := range ports {
+		ports[i].Cwd = cwds[ports[i].PID]
+	}
+	return ports, nil
+}
+
+// parseLsofListeners parses `lsof -F pcn` output into one entry per
+// process and port. A process listening on both IPv4 and IPv6 is reported
+// once.
+func parseLsofListeners(out []byte) []ListeningPort {
+	var ports []ListeningPort
+	seen := make(map[[2]int]bool)
+	var pid int
+	var command string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		value := line[1:]
+		switch line[0] {
+		case 'p':
+			pid, _ = strconv.Atoi(value)
+			command = ""
+		case 'c':
+			command = value
+		case 'n':
+			i := strings.LastIndex(value, ":")
+			if i < 0 {
+				continue
+			}
+			port, err := strconv.Atoi(value[i+1:])
+			if err != nil || pid == 0 {
+				continue
+			}
+			key := [2]int{pid, port}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			ports = append(ports, ListeningPort{Port: port, PID: pid, Command: command})
+		}
+	}
+	return ports
+}
+
+// procListeners finds TCP listeners by matching the socket inodes in
+// /proc/net/tcp{,6} against each process's open file descriptors.
+// Processes owned by other users are skipped since their fds are unreadable.
+func procListeners(procRoot string) ([]ListeningPort, error) {
+	inodePorts := make(map[string]int)
+	for _, name := range []string{"tcp", "tcp6"} {
+		data, err := os.ReadFile(filepath.Join(procRoot, "net", name))
+		if err != nil {
+			continue
+		}
+		for inode, port := range parseProcNetListeners(data) {
+			inodePorts[inode] = port
+		}
+	}
+	if len(inodePorts) == 0 {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return nil, err
+	}
+	var ports []ListeningPort
+	seen := make(map[[2]int]bool)
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join(procRoot, e.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil || !strings.HasPrefix(link, "socket:[") {
+				continue
+			}
+			port, ok := inodePorts[strings.TrimSuffix(strings.TrimPrefix(link, "socket:["), "]")]
+			if !ok || seen[[2]int{pid, port}] {
+				continue
+			}
+			seen[[2]int{pid, port}] = true
+			comm, _ := os.ReadFile(filepath.Join(procRoot, e.Name(), "comm"))
+			ports = append(ports, ListeningPort{Port: port, PID: pid, Command: strings.TrimSpace(string(comm))})
+		}
+	}
+	return ports, nil
+}
+
+// parseProcNetListeners maps socket inode to port for the LISTEN rows of a
+// /proc/net/tcp or tcp6 table.
+func parseProcNetListeners(data []byte) map[string]int {
+	const stateListen = "0A"
+	result := make(map[string]int)
+	for _, line := range strings.Split(string(data), "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 10 || fields[3] != stateListen {
+			continue
+		}
+		i := strings.LastIndex(fields[1], ":")
+		if i < 0 {
+			continue
+		}
+		port, err := strconv.ParseInt(fields[1][i+1:], 16, 32)
+		if err != nil {
+			continue
+		}
+		result[fields[9]] = int(port)
+	}
+	return result
+}
+
+// processCwds returns the working directory of each pid, read from /proc
+// where available and from lsof otherwise.
+func processCwds(pids []int) map[int]string {
+	cwds := make(map[int]string, len(pids))
+	var missing []string
+	for _, pid := range pids {
+		if _, ok := cwds[pid]; ok {
+			continue
+		}
+		if cwd, err := os.Readlink(fmt.Sprintf("/proc/%d/cwd", pid)); err == nil {
+			cwds[pid] = cwd
+			continue
+		}
+		cwds[pid] = ""
+		missing = append(missing, strconv.Itoa(pid))
+	}
+	if len(missing) == 0 {
+		return cwds
+	}
+
+	out, err := exec.Command("lsof", "-a", "-nP", "-d", "cwd", "-p", strings.Join(missing, ","), "-Fn").Output()
+	if err != nil && len(out) == 0 {
+		return cwds
+	}
+	var pid int
+	for _, line := range strings.Split(string(out), "\n") {
+		switch {
+		case strings.HasPrefix(line, "p"):
+			pid, _ = strconv.Atoi(line[1:])
+		case strings.HasPrefix(line, "n"):
+			cwds[pid] = line[1:]
+		}
+	}
+	return cwds
+}
+
+// assignPortsToWorktrees groups ports by the worktree containing each
+// process's working directory. When worktrees are nested (for example
+// inside the main checkout), the deepest one wins.
+func assignPortsToWorktrees(ports []ListeningPort, paths map[string]string) map[string][]ListeningPort {
+	type root struct{ name, path string }
+	roots := make([]root, 0, len(paths))
+	for name, path := range paths {
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			path = resolved
+		}
+		roots = append(roots, root{name, filepath.Clean(path)})
+	}
+	sort.Slice(roots, func(i, j int) bool { return len(roots[i].path) > len(roots[j].path) })
+
+	result := make(map[string][]ListeningPort)
+	for _, lp := range ports {
+		if lp.Cwd == "" {
+			continue
+		}
+		cwd := filepath.Clean(lp.Cwd)
+		for _, r := range roots {
+			if cwd == r.path || strings.HasPrefix(cwd, r.path+string(filepath.Separator)) {
+				result[r.name] = append(result[r.name], lp)
+				break
+			}
+		}
+	}
+	for _, list := range result {
+		sort.Slice(list, func(i, j int) bool { return list[i].Port < list[j].Port })
+	}
+	return result
+}
+
+// portsBadge renders a worktree's ports for the sidebar, e.g. ":3000 :5173".
+func portsBadge(ports []ListeningPort) string {
+	if len(ports) == 0 {
+		return ""
+	}
+	parts := make([]string, len(ports))
+	for i, lp := range ports {
+		parts[i] = ":" + strconv.Itoa(lp.Port)
+	}
+	return strings.Join(parts, " ")
+}
+
+// openPorts opens the ports modal for the selected worktree.
+func (p *Plugin) openPorts() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil || p.shellSelected {
+		return nil
+	}
+	if len(p.ports[wt.Name]) == 0 {
+		if !portScanSupported() {
+			return appmsg.ShowToast("Port detection needs lsof", 2*time.Second)
+		}
+		return tea.Batch(p.loadPorts(), appmsg.ShowToast("No listening ports in "+wt.Name, 2*time.Second))
+	}
+	p.portsState = &PortsState{WorkspaceName: wt.Name}
+	p.clearPortsModal()
+	p.viewMode = ViewModePorts
+	return nil
+}
+
+// closePorts closes the ports modal.
+func (p *Plugin) closePorts() {
+	p.portsState = nil
+	p.clearPortsModal()
+	p.viewMode = ViewModeList
+}
+
+// selectedPort returns the port highlighted in the ports modal.
+func (p *Plugin) selectedPort() (ListeningPort, bool) {
+	s := p.portsState
+	if s == nil {
+		return ListeningPort{}, false
+	}
+	ports := p.ports[s.WorkspaceName]
+	if s.Idx < 0 || s.Idx >= len(ports) {
+		return ListeningPort{}, false
+	}
+	return ports[s.Idx], true
+}
+
+// openSelectedPort opens the highlighted port in the browser.
+func (p *Plugin) openSelectedPort() tea.Cmd {
+	lp, ok := p.selectedPort()
+	if !ok {
+		return nil
+	}
+	return openInBrowser(lp.URL())
+}
+
+// confirmKillPort asks whether to stop the process on the highlighted port.
+func (p *Plugin) confirmKillPort() {
+	if _, ok := p.selectedPort(); !ok {
+		return
+	}
+	p.portsState.ConfirmKill = true
+	p.clearPortsModal()
+}
+
+// cancelKillPort returns from the kill confirmation to the port list.
+func (p *Plugin) cancelKillPort() {
+	p.portsState.ConfirmKill = false
+	p.clearPortsModal()
+}
+
+// killSelectedPort sends SIGTERM to the process listening on the
+// highlighted port, then rescans. The port list can be a scan old, so the
+// process is only signalled if it still listens on the port.
+func (p *Plugin) killSelectedPort() tea.Cmd {
+	lp, ok := p.selectedPort()
+	if !ok {
+		return nil
+	}
+	p.closePorts()
+	return func() tea.Msg {
+		ports, err := listListeningPorts()
+		if err != nil {
+			return PortKilledMsg{Port: lp, Err: err}
+		}
+		if !slices.ContainsFunc(ports, func(cur ListeningPort) bool { return cur.PID == lp.PID && cur.Port == lp.Port }) {
+			return PortKilledMsg{Port: lp, Err: fmt.Errorf("no longer listening on :%d", lp.Port)}
+		}
+		proc, err := os.FindProcess(lp.PID)
+		if err == nil {
+			err = proc.Signal(syscall.SIGTERM)
+		}
+		return PortKilledMsg{Port: lp, Err: err}
+	}
+}
+
+// portKilledToast reports whether the listening process was stopped.
+func portKilledToast(msg PortKilledMsg) tea.Cmd {
+	if msg.Err != nil {
+		return func() tea.Msg {
+			return app.ToastMsg{Message: fmt.Sprintf("Kill %s (pid %d) failed: %v", msg.Port.Command, msg.Port.PID, msg.Err), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	return appmsg.ShowToast(fmt.Sprintf("Stopped %s on :%d", msg.Port.Command, msg.Port.Port), 2*time.Second)
+}
+
+// portLabel renders a port row for the ports modal.
+func portLabel(lp ListeningPort) string {
+	return fmt.Sprintf(":%-5d  %s  %s", lp.Port, lp.Command, dimText("pid "+strconv.Itoa(lp.PID)))
+}
@@ -0,0 +1,127 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestParseLsofListeners(t *testing.T) {
+	out := []byte("p100\ncnode\nf21\nn*:3000\nf22\nn[::]:3000\np200\ncvite\nf9\nn127.0.0.1:5173\np300\ncbad\nnnoport\n")
+	ports := parseLsofListeners(out)
+	if len(ports) != 2 {
+		t.Fatalf("got %d ports, want 2 (IPv4/IPv6 deduped): %+v", len(ports), ports)
+	}
+	if ports[0] != (ListeningPort{Port: 3000, PID: 100, Command: "node"}) {
+		t.Errorf("ports[0] = %+v", ports[0])
+	}
+	if ports[1] != (ListeningPort{Port: 5173, PID: 200, Command: "vite"}) {
+		t.Errorf("ports[1] = %+v", ports[1])
+	}
+}
+
+func TestParseProcNetListeners(t *testing.T) {
+	data := []byte(`  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:0BB8 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 4242 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:D2A0 0100007F:0BB8 01 00000000:00000000 00:00000000 00000000  1000        0 4343 1 0000000000000000 20 4 30 10 -1
+`)
+	got := parseProcNetListeners(data)
+	if len(got) != 1 || got["4242"] != 3000 {
+		t.Errorf("listeners = %v, want only inode 4242 on port 3000", got)
+	}
+}
+
+func TestAssignPortsToWorktrees(t *testing.T) {
+	root := t.TempDir()
+	main := filepath.Join(root, "repo")
+	nested := filepath.Join(main, ".worktrees", "feature")
+	sibling := filepath.Join(root, "repo-other")
+	for _, dir := range []string{nested, sibling} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ports := []ListeningPort{
+		{Port: 8080, PID: 1, Cwd: filepath.Join(main, "web")},
+		{Port: 5173, PID: 2, Cwd: filepath.Join(nested, "app")},
+		{Port: 3000, PID: 3, Cwd: nested},
+		{Port: 4000, PID: 4, Cwd: sibling + "-not"},
+		{Port: 9000, PID: 5},
+	}
+	got := assignPortsToWorktrees(ports, map[string]string{"main": main, "feature": nested, "other": sibling})
+
+	if len(got["main"]) != 1 || got["main"][0].Port != 8080 {
+		t.Errorf("main = %+v", got["main"])
+	}
+	if len(got["feature"]) != 2 || got["feature"][0].Port != 3000 || got["feature"][1].Port != 5173 {
+		t.Errorf("feature = %+v, want 3000 and 5173 sorted", got["feature"])
+	}
+	if len(got["other"]) != 0 {
+		t.Errorf("other = %+v, want a path prefix match to be ignored", got["other"])
+	}
+	if badge := portsBadge(got["feature"]); badge != ":3000 :5173" {
+		t.Errorf("badge = %q", badge)
+	}
+}
+
+func TestPortsModal(t *testing.T) {
+	p := New()
+	p.width, p.height = 100, 40
+	p.worktrees = []*Worktree{{Name: "web", Path: "/tmp/web"}}
+
+	p.ports["web"] = []ListeningPort{{Port: 3000, PID: 1, Command: "node"}, {Port: 5173, PID: 2, Command: "vite"}}
+	p.openPorts()
+	if p.viewMode != ViewModePorts || p.portsState == nil {
+		t.Fatal("expected ports modal to open")
+	}
+
+	if got := p.View(p.width, p.height); got == "" {
+		t.Error("expected modal to render")
+	}
+	p.handlePortsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	if lp, ok := p.selectedPort(); !ok || lp.Port != 5173 {
+		t.Errorf("selected = %+v, want :5173", lp)
+	}
+
+	// Kill asks first; esc backs out to the list without signalling
+	if cmd := p.handlePortsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}); cmd != nil || !p.portsState.ConfirmKill {
+		t.Fatal("x should ask for confirmation before killing")
+	}
+	if got := p.View(p.width, p.height); !strings.Contains(got, "pid 2") {
+		t.Error("confirmation should name the process")
+	}
+	p.handlePortsKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.portsState == nil || p.portsState.ConfirmKill {
+		t.Fatal("esc should cancel the confirmation and keep the modal open")
+	}
+
+	p.handlePortsKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.viewMode != ViewModeList || p.portsState != nil {
+		t.Error("esc should close the ports modal")
+	}
+}
+
+func TestPortScanSchedulesOneLoop(t *testing.T) {
+	if !portScanSupported() {
+		t.Skip("port detection unavailable")
+	}
+	p := New()
+	p.ctx = &plugin.Context{}
+	if cmd := p.schedulePortScan(portScanInterval); cmd == nil {
+		t.Fatal("expected a scan to be scheduled")
+	}
+	// Extra scans, e.g. after killing a port, must not start another loop
+	p.Update(PortsDetectedMsg{})
+	if cmd := p.schedulePortScan(portScanInterval); cmd != nil {
+		t.Error("second scan scheduled while one is pending")
+	}
+	p.Update(portScanTickMsg{})
+	if cmd := p.schedulePortScan(portScanInterval); cmd == nil {
+		t.Error("tick should allow the next scan to be scheduled")
+	}
+}
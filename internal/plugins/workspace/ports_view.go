@@ -0,0 +1,98 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensurePortsModal builds/rebuilds the ports modal.
+func (p *Plugin) ensurePortsModal() {
+	s := p.portsState
+	if s == nil {
+		return
+	}
+	ports := p.ports[s.WorkspaceName]
+
+	modalW := 60
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist, width changed, or a rescan
+	// changed the port count
+	if p.portsModal != nil && p.portsModalWidth == modalW && p.portsModalCount == len(ports) {
+		return
+	}
+	p.portsModalWidth = modalW
+	p.portsModalCount = len(ports)
+	if s.Idx >= len(ports) {
+		s.Idx = max(len(ports)-1, 0)
+	}
+
+	if s.ConfirmKill && s.Idx < len(ports) {
+		lp := ports[s.Idx]
+		m := modal.New("Stop process?",
+			modal.WithWidth(modalW),
+			modal.WithVariant(modal.VariantDanger),
+			modal.WithHints(false),
+		)
+		m.AddSection(modal.Text(fmt.Sprintf("Send SIGTERM to %s (pid %d) listening on :%d?", lp.Command, lp.PID, lp.Port)))
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Buttons(
+			modal.Btn(" Stop ", portsConfirmKillButtonID, modal.BtnDanger()),
+			modal.Btn(" Cancel ", portsCancelKillButtonID),
+		))
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Text(dimText("y: stop   n/Esc: back")))
+		p.portsModal = m
+		return
+	}
+
+	m := modal.New("Ports: "+s.WorkspaceName,
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	)
+	if len(ports) == 0 {
+		m.AddSection(modal.Text("No processes are listening in this worktree."))
+	} else {
+		items := make([]modal.ListItem, len(ports))
+		for i, lp := range ports {
+			items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", portsItemPfx, i), Label: portLabel(lp)}
+		}
+		m.AddSection(modal.List(portsListID, items, &s.Idx, modal.WithMaxVisible(min(len(items), 10))))
+	}
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Buttons(
+		modal.Btn(" Open ", portsOpenButtonID, modal.BtnPrimary()),
+		modal.Btn(" Kill ", portsKillButtonID, modal.BtnDanger()),
+		modal.Btn(" Close ", portsCloseButtonID),
+	))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("↑/↓: select   Enter/o: open in browser   x: kill   Esc: close")))
+	p.portsModal = m
+}
+
+// clearPortsModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearPortsModal() {
+	p.portsModal = nil
+	p.portsModalWidth = 0
+	p.portsModalCount = 0
+}
+
+// renderPortsModal renders the ports modal with dimmed background.
+func (p *Plugin) renderPortsModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensurePortsModal()
+	if p.portsModal == nil {
+		return background
+	}
+
+	modalContent := p.portsModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
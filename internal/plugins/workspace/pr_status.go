@@ -0,0 +1,322 @@
+package workspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/event"
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+const (
+	// defaultPRStatusInterval is used when the config does not set one.
+	defaultPRStatusInterval = 2 * time.Minute
+	// prStatusInitialDelay gives the first worktree refresh time to land.
+	prStatusInitialDelay = 3 * time.Second
+	// prStatusTimer names the scheduler timer that drives periodic refreshes.
+	prStatusTimer = "workspace.pr-status"
+)
+
+// prStatusQuery fetches the newest PR for a head branch with its check
+// rollup, review decision, and mergeability in a single round trip.
+const prStatusQuery = `query($owner: String!, $name: String!, $branch: String!) {
+  repository(owner: $owner, name: $name) {
+    pullRequests(headRefName: $branch, first: 1, orderBy: {field: CREATED_AT, direction: DESC}) {
+      nodes {
+        number
+        url
+        state
+        isDraft
+        reviewDecision
+        mergeable
+        commits(last: 1) { nodes { commit { statusCheckRollup { state } } } }
+      }
+    }
+  }
+}`
+
+// PRStatus is the GitHub state of a worktree's pull request.
+type PRStatus struct {
+	Number    int
+	URL       string
+	State     string // OPEN, CLOSED, MERGED
+	IsDraft   bool
+	Checks    string // SUCCESS, FAILURE, ERROR, PENDING, EXPECTED, or "" when no checks
+	Review    string // APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED, or ""
+	Mergeable string // MERGEABLE, CONFLICTING, UNKNOWN
+}
+
+// PRStatusMsg delivers the PR status for one worktree. A nil Status with a
+// nil Err means the branch has no remote or no PR.
+type PRStatusMsg struct {
+	Epoch         uint64
+	WorkspaceName string
+	Status        *PRStatus
+	Err           error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m PRStatusMsg) GetEpoch() uint64 { return m.Epoch }
+
+// prStatusTickMsg triggers a periodic PR status refresh.
+type prStatusTickMsg struct {
+	Epoch uint64
+	Timer bool // from the shared scheduler rather than tea.Tick
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m prStatusTickMsg) GetEpoch() uint64 { return m.Epoch }
+
+// prStatusInterval returns the configured refresh interval; zero disables polling.
+func (p *Plugin) prStatusInterval() time.Duration {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return defaultPRStatusInterval
+	}
+	return p.ctx.Config.Plugins.Workspace.PRStatusInterval
+}
+
+// schedulePRStatus schedules the next PR status refresh.
+func (p *Plugin) schedulePRStatus(delay time.Duration) tea.Cmd {
+	if p.prStatusInterval() <= 0 {
+		return nil
+	}
+	epoch := p.ctx.Epoch
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return prStatusTickMsg{Epoch: epoch}
+	})
+}
+
+// startPRStatus schedules the first PR status refresh and, when the shared
+// scheduler is available, registers the periodic timer and listens for it.
+// Without a scheduler each refresh schedules the next with tea.Tick.
+func (p *Plugin) startPRStatus() tea.Cmd {
+	interval := p.prStatusInterval()
+	if interval <= 0 || p.ctx.Scheduler == nil || p.ctx.EventBus == nil {
+		return p.schedulePRStatus(prStatusInitialDelay)
+	}
+	p.ctx.Scheduler.Add(prStatusTimer, event.Every(interval))
+	p.prStatusTicks = p.ctx.EventBus.Subscribe(event.TimerTopic(prStatusTimer),
+		event.WithName("workspace PR status"), event.WithBuffer(1))
+	return tea.Batch(p.schedulePRStatus(prStatusInitialDelay), p.listenPRStatus())
+}
+
+// nextPRStatus continues periodic refreshes after msg.
+func (p *Plugin) nextPRStatus(msg prStatusTickMsg) tea.Cmd {
+	switch {
+	case msg.Timer:
+		return p.listenPRStatus()
+	case p.prStatusTicks != nil:
+		return nil // the initial refresh; the timer drives the rest
+	}
+	return p.schedulePRStatus(p.prStatusInterval())
+}
+
+// listenPRStatus waits for the next scheduler tick. Returns nil once the
+// subscription is closed by stopPRStatus.
+func (p *Plugin) listenPRStatus() tea.Cmd {
+	ticks, epoch := p.prStatusTicks, p.ctx.Epoch
+	if ticks == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		if _, ok := <-ticks; !ok {
+			return nil
+		}
+		return prStatusTickMsg{Epoch: epoch, Timer: true}
+	}
+}
+
+// stopPRStatus removes the periodic timer and its subscription.
+func (p *Plugin) stopPRStatus() {
+	if p.prStatusTicks == nil {
+		return
+	}
+	p.ctx.Scheduler.Remove(prStatusTimer)
+	p.ctx.EventBus.Unsubscribe(p.prStatusTicks)
+	p.prStatusTicks = nil
+}
+
+// refreshPRStatuses fetches PR status for every worktree with a directory.
+// Returns nil when gh is not installed.
+func (p *Plugin) refreshPRStatuses() tea.Cmd {
+	if _, err := exec.LookPath("gh"); err != nil {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, wt := range p.worktrees {
+		if wt.IsMissing {
+			continue
+		}
+		cmds = append(cmds, p.fetchPRStatus(wt.Name, wt.Path))
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchPRStatus queries gh api for the PR whose head is the worktree's
+// upstream branch.
+func (p *Plugin) fetchPRStatus(name, wtPath string) tea.Cmd {
+	epoch := p.ctx.Epoch
+	return func() tea.Msg {
+		branch := upstreamBranch(wtPath)
+		if branch == "" {
+			return PRStatusMsg{Epoch: epoch, WorkspaceName: name}
+		}
+
+		cmd := exec.Command("gh", "api", "graphql",
+			"-F", "owner={owner}",
+			"-F", "name={repo}",
+			"-f", "branch="+branch,
+			"-f", "query="+prStatusQuery,
+		)
+		cmd.Dir = wtPath
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			errMsg := strings.TrimSpace(stderr.String())
+			if errMsg == "" {
+				errMsg = err.Error()
+			}
+			return PRStatusMsg{Epoch: epoch, WorkspaceName: name, Err: fmt.Errorf("gh api: %s", errMsg)}
+		}
+
+		status, err := parsePRStatus(output)
+		return PRStatusMsg{Epoch: epoch, WorkspaceName: name, Status: status, Err: err}
+	}
+}
+
+// upstreamBranch returns the remote branch name tracked by the worktree's
+// HEAD (without the remote prefix), or "" if there is none.
+func upstreamBranch(wtPath string) string {
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}")
+	cmd.Dir = wtPath
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	ref := strings.TrimSpace(string(output))
+	if _, branch, ok := strings.Cut(ref, "/"); ok {
+		return branch
+	}
+	return ""
+}
+
+// parsePRStatus decodes a prStatusQuery response. Returns nil when the
+// branch has no PR.
+func parsePRStatus(data []byte) (*PRStatus, error) {
+	var resp struct {
+		Data struct {
+			Repository struct {
+				PullRequests struct {
+					Nodes []struct {
+						Number         int    `json:"number"`
+						URL            string `json:"url"`
+						State          string `json:"state"`
+						IsDraft        bool   `json:"isDraft"`
+						ReviewDecision string `json:"reviewDecision"`
+						Mergeable      string `json:"mergeable"`
+						Commits        struct {
+							Nodes []struct {
+								Commit struct {
+									StatusCheckRollup *struct {
+										State string `json:"state"`
+									} `json:"statusCheckRollup"`
+								} `json:"commit"`
+							} `json:"nodes"`
+						} `json:"commits"`
+					} `json:"nodes"`
+				} `json:"pullRequests"`
+			} `json:"repository"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parse pr status: %w", err)
+	}
+	nodes := resp.Data.Repository.PullRequests.Nodes
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	n := nodes[0]
+	status := &PRStatus{
+		Number:    n.Number,
+		URL:       n.URL,
+		State:     n.State,
+		IsDraft:   n.IsDraft,
+		Review:    n.ReviewDecision,
+		Mergeable: n.Mergeable,
+	}
+	if len(n.Commits.Nodes) > 0 && n.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+		status.Checks = n.Commits.Nodes[0].Commit.StatusCheckRollup.State
+	}
+	return status, nil
+}
+
+// prBadgeParts returns the badge segments for a PR with the style for each.
+func prBadgeParts(s *PRStatus) ([]string, []lipgloss.Style) {
+	accent := lipgloss.NewStyle().Foreground(styles.Current().Secondary)
+	parts := []string{fmt.Sprintf("#%d", s.Number)}
+	partStyles := []lipgloss.Style{accent}
+	add := func(text string, style lipgloss.Style) {
+		parts = append(parts, text)
+		partStyles = append(partStyles, style)
+	}
+
+	switch s.State {
+	case "MERGED":
+		add("merged", accent)
+		return parts, partStyles
+	case "CLOSED":
+		add("closed", styles.Current().Muted)
+		return parts, partStyles
+	}
+	if s.IsDraft {
+		add("draft", styles.Current().Muted)
+	}
+	switch s.Checks {
+	case "SUCCESS":
+		add("✓", styles.Current().StatusCompleted)
+	case "FAILURE", "ERROR":
+		add("✗", styles.Current().StatusDeleted)
+	case "PENDING", "EXPECTED":
+		add("●", styles.Current().StatusModified)
+	}
+	switch s.Review {
+	case "APPROVED":
+		add("approved", styles.Current().StatusCompleted)
+	case "CHANGES_REQUESTED":
+		add("changes", styles.Current().StatusDeleted)
+	case "REVIEW_REQUIRED":
+		add("review", styles.Current().Muted)
+	}
+	if s.Mergeable == "CONFLICTING" {
+		add("conflicts", styles.Current().StatusModified)
+	}
+	return parts, partStyles
+}
+
+// prBadge renders a plain-text PR badge, e.g. "#42 ✓ approved".
+func prBadge(s *PRStatus) string {
+	if s == nil {
+		return ""
+	}
+	parts, _ := prBadgeParts(s)
+	return strings.Join(parts, " ")
+}
+
+// styledPRBadge renders a PR badge with per-segment colors.
+func styledPRBadge(s *PRStatus) string {
+	if s == nil {
+		return ""
+	}
+	parts, partStyles := prBadgeParts(s)
+	for i := range parts {
+		parts[i] = partStyles[i].Render(parts[i])
+	}
+	return strings.Join(parts, " ")
+}
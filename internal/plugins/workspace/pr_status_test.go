@@ -0,0 +1,78 @@
+package workspace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestParsePRStatus(t *testing.T) {
+	data := `{"data":{"repository":{"pullRequests":{"nodes":[{
+		"number":42,"url":"https://github.com/o/r/pull/42","state":"OPEN","isDraft":false,
+		"reviewDecision":"APPROVED","mergeable":"CONFLICTING",
+		"commits":{"nodes":[{"commit":{"statusCheckRollup":{"state":"FAILURE"}}}]}}]}}}}`
+	s, err := parsePRStatus([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Number != 42 || s.Checks != "FAILURE" || s.Review != "APPROVED" || s.Mergeable != "CONFLICTING" {
+		t.Errorf("unexpected status: %+v", s)
+	}
+	if got := prBadge(s); got != "#42 ✗ approved conflicts" {
+		t.Errorf("badge = %q", got)
+	}
+	if got := ansi.Strip(styledPRBadge(s)); got != prBadge(s) {
+		t.Errorf("styled badge text = %q, want %q", got, prBadge(s))
+	}
+
+	noChecks := `{"data":{"repository":{"pullRequests":{"nodes":[{"number":7,"state":"MERGED",
+		"commits":{"nodes":[{"commit":{"statusCheckRollup":null}}]}}]}}}}`
+	s, err = parsePRStatus([]byte(noChecks))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Checks != "" || prBadge(s) != "#7 merged" {
+		t.Errorf("merged badge = %q checks=%q", prBadge(s), s.Checks)
+	}
+
+	s, err = parsePRStatus([]byte(`{"data":{"repository":{"pullRequests":{"nodes":[]}}}}`))
+	if err != nil || s != nil {
+		t.Errorf("no PR: got %+v, %v", s, err)
+	}
+	if _, err := parsePRStatus([]byte("{")); err == nil {
+		t.Error("expected parse error")
+	}
+}
+
+func TestPRStatusMsg_SurvivesRefresh(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{}
+	p.initialReconnectDone = true
+	p.stateRestored = true
+	dir := t.TempDir()
+	p.worktrees = []*Worktree{{Name: "feat", Path: dir}}
+
+	status := &PRStatus{Number: 3, State: "OPEN", Checks: "SUCCESS"}
+	p.Update(PRStatusMsg{WorkspaceName: "feat", Status: status})
+	if p.worktrees[0].PR != status {
+		t.Fatal("status not applied to worktree")
+	}
+
+	// Refresh replaces worktree structs; the cached status is reattached
+	p.Update(RefreshDoneMsg{Worktrees: []*Worktree{{Name: "feat", Path: dir}}})
+	if p.worktrees[0].PR != status {
+		t.Errorf("PR status lost on refresh: %+v", p.worktrees[0].PR)
+	}
+
+	// A failed fetch keeps the last known status; an empty result clears it
+	p.Update(PRStatusMsg{WorkspaceName: "feat", Err: errors.New("offline")})
+	if p.worktrees[0].PR != status {
+		t.Error("transient error should keep badge")
+	}
+	p.Update(PRStatusMsg{WorkspaceName: "feat"})
+	if p.worktrees[0].PR != nil || p.prStatuses["feat"] != nil {
+		t.Error("missing PR should clear badge")
+	}
+}
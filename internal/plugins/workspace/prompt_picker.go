@@ -215,7 +215,7 @@ func (pp *PromptPicker) View() string {
 	sb.WriteString("\n\n")
 
 	// Column headers
-	colStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+	colStyle := lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
 	sb.WriteString(colStyle.Render(fmt.Sprintf("  %-24s %-7s %-10s %s", "Prompt", "Scope", "Ticket", "Preview")))
 	sb.WriteString("\n")
 	sb.WriteString(colStyle.Render(strings.Repeat("─", min(pp.width-6, 70))))
@@ -228,9 +228,9 @@ func (pp *PromptPicker) View() string {
 	}
 	noneLine := fmt.Sprintf("%s%-24s %-7s %-10s %s", nonePrefix, "(none)", "", "", "No prompt template")
 	if pp.selectedIdx == -1 {
-		sb.WriteString(lipgloss.NewStyle().Foreground(styles.Primary).Render(noneLine))
+		sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(noneLine))
 	} else if pp.hoverIdx == -1 {
-		sb.WriteString(lipgloss.NewStyle().Foreground(styles.TextSecondary).Render(noneLine))
+		sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().TextSecondary).Render(noneLine))
 	} else {
 		sb.WriteString(dimText(noneLine))
 	}
@@ -270,9 +270,9 @@ func (pp *PromptPicker) View() string {
 		// Style priority: selected > hover > default
 		switch i {
 		case pp.selectedIdx:
-			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Primary).Render(line))
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(line))
 		case pp.hoverIdx:
-			sb.WriteString(lipgloss.NewStyle().Foreground(styles.TextSecondary).Render(line))
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().TextSecondary).Render(line))
 		default:
 			sb.WriteString(dimText(line))
 		}
@@ -18,10 +18,14 @@ const (
 	promptPickerNoneID     = "prompt-picker-item-none"
 )
 
-var (
-	promptPickerSelectedStyle = lipgloss.NewStyle().Foreground(styles.Primary)
-	promptPickerHoverStyle    = lipgloss.NewStyle().Foreground(styles.TextSecondary)
-)
+// Prompt picker row styles, built per render so theme switches apply.
+func promptPickerSelectedStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Current().Primary)
+}
+
+func promptPickerHoverStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Current().TextSecondary)
+}
 
 func promptPickerItemID(idx int) string {
 	if idx < 0 {
@@ -120,15 +124,15 @@ func (p *Plugin) promptPickerEmptySection() modal.Section {
 		var sb strings.Builder
 
 		sb.WriteString("No prompts configured.\n\n")
-		sb.WriteString(styles.Muted.Render("Add prompts to one of these config files:"))
+		sb.WriteString(styles.Current().Muted.Render("Add prompts to one of these config files:"))
 		sb.WriteString("\n")
-		sb.WriteString(styles.Muted.Render("  Global:  ~/.config/forge/config.json"))
+		sb.WriteString(styles.Current().Muted.Render("  Global:  ~/.config/forge/config.json"))
 		sb.WriteString("\n")
-		sb.WriteString(styles.Muted.Render("  Project: .forge/config.json"))
+		sb.WriteString(styles.Current().Muted.Render("  Project: .forge/config.json"))
 		sb.WriteString("\n\n")
-		sb.WriteString(styles.Muted.Render("See: .claude/skills/create-prompt/SKILL.md"))
+		sb.WriteString(styles.Current().Muted.Render("See: .claude/skills/create-prompt/SKILL.md"))
 		sb.WriteString("\n\n")
-		sb.WriteString(styles.Muted.Render("Press d to add defaults, or Esc/Enter to continue without a prompt."))
+		sb.WriteString(styles.Current().Muted.Render("Press d to add defaults, or Esc/Enter to continue without a prompt."))
 		return modal.RenderedSection{Content: sb.String()}
 	}, nil)
 }
@@ -136,7 +140,7 @@ func (p *Plugin) promptPickerEmptySection() modal.Section {
 func (p *Plugin) promptPickerHeaderSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		header := fmt.Sprintf("  %-24s %-7s %-10s %s", "Prompt", "Scope", "Ticket", "Preview")
-		rendered := styles.Muted.Render(header)
+		rendered := styles.Current().Muted.Render(header)
 		return modal.RenderedSection{Content: ansi.Truncate(rendered, contentWidth, "")}
 	}, nil)
 }
@@ -144,7 +148,7 @@ func (p *Plugin) promptPickerHeaderSection() modal.Section {
 func (p *Plugin) promptPickerSeparatorSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		line := strings.Repeat("─", min(contentWidth, 70))
-		return modal.RenderedSection{Content: styles.Muted.Render(line)}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render(line)}
 	}, nil)
 }
 
@@ -226,7 +230,7 @@ func (p *Plugin) promptPickerMoreSection() modal.Section {
 		}
 
 		line := fmt.Sprintf("  ... and %d more", extra)
-		return modal.RenderedSection{Content: styles.Muted.Render(line)}
+		return modal.RenderedSection{Content: styles.Current().Muted.Render(line)}
 	}, nil)
 }
 
@@ -240,12 +244,12 @@ func (p *Plugin) promptPickerNoneLine(selected, hovered bool, width int) string
 	line = ansi.Truncate(line, width, "")
 
 	if selected {
-		return promptPickerSelectedStyle.Render(line)
+		return promptPickerSelectedStyle().Render(line)
 	}
 	if hovered {
-		return promptPickerHoverStyle.Render(line)
+		return promptPickerHoverStyle().Render(line)
 	}
-	return styles.Muted.Render(line)
+	return styles.Current().Muted.Render(line)
 }
 
 func (p *Plugin) promptPickerPromptLine(prompt Prompt, idx int, selected, hovered bool, width int) string {
@@ -274,10 +278,10 @@ func (p *Plugin) promptPickerPromptLine(prompt Prompt, idx int, selected, hovere
 	line = ansi.Truncate(line, width, "")
 
 	if selected {
-		return promptPickerSelectedStyle.Render(line)
+		return promptPickerSelectedStyle().Render(line)
 	}
 	if hovered {
-		return promptPickerHoverStyle.Render(line)
+		return promptPickerHoverStyle().Render(line)
 	}
-	return styles.Muted.Render(line)
+	return styles.Current().Muted.Render(line)
 }
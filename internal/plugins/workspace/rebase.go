@@ -0,0 +1,317 @@
+package workspace
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/plugins/filebrowser"
+)
+
+// RebaseStep represents the current step in the rebase workflow.
+type RebaseStep int
+
+const (
+	RebaseStepTarget    RebaseStep = iota // Choose the branch to rebase onto
+	RebaseStepPreview                     // Commit counts and predicted conflicts
+	RebaseStepRunning                     // git rebase in progress
+	RebaseStepConflicts                   // Rebase stopped on conflicts
+	RebaseStepDone
+	RebaseStepError
+)
+
+// RebasePreview summarizes what a rebase onto a target would do.
+type RebasePreview struct {
+	Ahead     int      // Commits on the worktree branch to be replayed
+	Behind    int      // Commits on the target not yet in the branch
+	Conflicts []string // Files expected to conflict
+	Exact     bool     // Conflicts come from git merge-tree; false = overlapping edits
+}
+
+// RebaseState holds the state for the rebase workflow modal.
+type RebaseState struct {
+	Worktree  *Worktree
+	Step      RebaseStep
+	Targets   []string // Local branches available as rebase targets
+	TargetIdx int      // Selected index in Targets
+	Target    string   // Chosen target branch
+	Preview   *RebasePreview
+	Conflicts []string // Files with unresolved conflicts in the worktree
+	FileIdx   int      // Selected index in Conflicts
+	Output    string   // Last git output (shown on done, conflicts, and error)
+	Aborted   bool     // Done step reached via abort
+	Err       error
+}
+
+// RebaseTargetsMsg delivers rebase targets, or the state of a rebase that
+// is already in progress in the worktree.
+type RebaseTargetsMsg struct {
+	WorkspaceName string
+	Branches      []string
+	InProgress    bool
+	Conflicts     []string
+	Err           error
+}
+
+// RebasePreviewMsg delivers the preview for a rebase target.
+type RebasePreviewMsg struct {
+	WorkspaceName string
+	Target        string
+	Preview       RebasePreview
+	Err           error
+}
+
+// RebaseResultMsg signals that a rebase start, continue, or abort finished.
+// Conflicts is non-empty when the rebase stopped for resolution.
+type RebaseResultMsg struct {
+	WorkspaceName string
+	Conflicts     []string
+	Output        string
+	Aborted       bool
+	Err           error
+}
+
+// startRebaseWorkflow opens the rebase modal for a worktree.
+func (p *Plugin) startRebaseWorkflow(wt *Worktree) tea.Cmd {
+	if wt == nil {
+		return nil
+	}
+	p.rebaseState = &RebaseState{Worktree: wt, Step: RebaseStepTarget, Target: resolveBaseBranch(wt)}
+	p.clearRebaseModal()
+	p.viewMode = ViewModeRebase
+	return p.loadRebaseTargets(wt)
+}
+
+// cancelRebaseWorkflow closes the rebase modal. A rebase stopped on
+// conflicts stays in progress in the worktree; reopening the modal resumes it.
+func (p *Plugin) cancelRebaseWorkflow() {
+	p.rebaseState = nil
+	p.clearRebaseModal()
+	p.viewMode = ViewModeList
+}
+
+// rebaseContext returns the keymap context of the rebase modal. A rebase
+// stopped on conflicts has its own, so enter opens a conflict there rather
+// than starting a rebase.
+func (p *Plugin) rebaseContext() string {
+	if p.rebaseState != nil && p.rebaseState.Step == RebaseStepConflicts {
+		return "workspace-rebase-conflicts"
+	}
+	return "workspace-rebase"
+}
+
+// loadRebaseTargets lists local branches, or detects a rebase in progress.
+func (p *Plugin) loadRebaseTargets(wt *Worktree) tea.Cmd {
+	return func() tea.Msg {
+		if rebaseInProgress(wt.Path) {
+			return RebaseTargetsMsg{
+				WorkspaceName: wt.Name,
+				InProgress:    true,
+				Conflicts:     conflictedFiles(wt.Path),
+			}
+		}
+		branches, err := listLocalBranches(wt)
+		return RebaseTargetsMsg{WorkspaceName: wt.Name, Branches: branches, Err: err}
+	}
+}
+
+// loadRebasePreview computes commit counts and predicted conflicts.
+func (p *Plugin) loadRebasePreview(wt *Worktree, target string) tea.Cmd {
+	return func() tea.Msg {
+		preview, err := previewRebase(wt.Path, target)
+		return RebasePreviewMsg{WorkspaceName: wt.Name, Target: target, Preview: preview, Err: err}
+	}
+}
+
+// runRebase starts the rebase onto target in the worktree.
+func (p *Plugin) runRebase(wt *Worktree, target string) tea.Cmd {
+	return func() tea.Msg {
+		return rebaseResult(wt, "rebase", target)
+	}
+}
+
+// continueRebase stages conflicted files that no longer contain conflict
+// markers and continues the rebase without opening an editor.
+func (p *Plugin) continueRebase(wt *Worktree, files []string) tea.Cmd {
+	return func() tea.Msg {
+		if err := stageResolvedFiles(wt.Path, files); err != nil {
+			return RebaseResultMsg{WorkspaceName: wt.Name, Conflicts: files, Err: err}
+		}
+		return rebaseResult(wt, "-c", "core.editor=true", "rebase", "--continue")
+	}
+}
+
+// abortRebase abandons the rebase and restores the branch.
+func (p *Plugin) abortRebase(wt *Worktree) tea.Cmd {
+	return func() tea.Msg {
+		cmd := exec.Command("git", "rebase", "--abort")
+		cmd.Dir = wt.Path
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return RebaseResultMsg{
+				WorkspaceName: wt.Name,
+				Err:           fmt.Errorf("git rebase --abort: %s", strings.TrimSpace(string(output))),
+			}
+		}
+		return RebaseResultMsg{WorkspaceName: wt.Name, Aborted: true}
+	}
+}
+
+// openConflictInFileBrowser switches to the worktree and opens the file in
+// the file browser. Sequenced so the switch reinitializes plugins first.
+func (p *Plugin) openConflictInFileBrowser(wt *Worktree, file string) tea.Cmd {
+	return tea.Sequence(
+		app.SwitchWorktree(wt.Path),
+		app.FocusPlugin("file-browser"),
+		func() tea.Msg { return filebrowser.NavigateToFileMsg{Path: file} },
+	)
+}
+
+// rebaseResult runs a git rebase command and classifies the outcome:
+// stopped on conflicts, failed, or finished.
+func rebaseResult(wt *Worktree, args ...string) RebaseResultMsg {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = wt.Path
+	cmd.Env = append(os.Environ(), "GIT_EDITOR=true")
+	output, err := cmd.CombinedOutput()
+	out := strings.TrimSpace(string(output))
+	if err == nil {
+		return RebaseResultMsg{WorkspaceName: wt.Name, Output: out}
+	}
+	if rebaseInProgress(wt.Path) {
+		if files := conflictedFiles(wt.Path); len(files) > 0 {
+			return RebaseResultMsg{WorkspaceName: wt.Name, Conflicts: files, Output: out}
+		}
+	}
+	return RebaseResultMsg{
+		WorkspaceName: wt.Name,
+		Output:        out,
+		Err:           fmt.Errorf("git %s: %w", strings.Join(args, " "), err),
+	}
+}
+
+// listLocalBranches returns local branch names other than the worktree's own.
+func listLocalBranches(wt *Worktree) ([]string, error) {
+	cmd := exec.Command("git", "branch", "--list", "--format=%(refname:short)")
+	cmd.Dir = wt.Path
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var branches []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && line != wt.Branch {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
+// previewRebase counts commits on each side and predicts conflicting files.
+// git merge-tree (git 2.38+) gives the exact set for the combined change;
+// older versions fall back to files edited on both sides since the fork.
+func previewRebase(workdir, target string) (RebasePreview, error) {
+	var preview RebasePreview
+
+	cmd := exec.Command("git", "rev-list", "--left-right", "--count", target+"...HEAD")
+	cmd.Dir = workdir
+	output, err := cmd.Output()
+	if err != nil {
+		return preview, fmt.Errorf("compare with %s: %w", target, err)
+	}
+	if fields := strings.Fields(string(output)); len(fields) == 2 {
+		preview.Behind, _ = strconv.Atoi(fields[0])
+		preview.Ahead, _ = strconv.Atoi(fields[1])
+	}
+	if preview.Behind == 0 || preview.Ahead == 0 {
+		preview.Exact = true
+		return preview, nil // fast-forward or up to date: nothing can conflict
+	}
+
+	cmd = exec.Command("git", "merge-tree", "--write-tree", "--name-only", "--no-messages", target, "HEAD")
+	cmd.Dir = workdir
+	output, err = cmd.Output()
+	if exitErr, ok := err.(*exec.ExitError); err == nil || (ok && exitErr.ExitCode() == 1) {
+		// Exit 1 means conflicts: first line is the tree, then conflicted paths
+		lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+		if len(lines) > 1 {
+			preview.Conflicts = lines[1:]
+		}
+		preview.Exact = true
+		return preview, nil
+	}
+
+	base, err := gitOutput(workdir, "merge-base", target, "HEAD")
+	if err != nil {
+		return preview, fmt.Errorf("merge-base with %s: %w", target, err)
+	}
+	ours, _ := gitOutput(workdir, "diff", "--name-only", base, "HEAD")
+	theirs, _ := gitOutput(workdir, "diff", "--name-only", base, target)
+	preview.Conflicts = intersection(splitLines(ours), splitLines(theirs))
+	return preview, nil
+}
+
+// rebaseInProgress reports whether the worktree is in the middle of a rebase.
+func rebaseInProgress(workdir string) bool {
+	for _, name := range []string{"rebase-merge", "rebase-apply"} {
+		path, err := gitOutput(workdir, "rev-parse", "--git-path", name)
+		if err != nil {
+			return false
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(workdir, path)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// conflictedFiles returns paths with unresolved merge conflicts.
+func conflictedFiles(workdir string) []string {
+	out, err := gitOutput(workdir, "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil
+	}
+	return splitLines(out)
+}
+
+// stageResolvedFiles stages files that no longer contain conflict markers.
+// Files still marked are left for git to report as unresolved.
+func stageResolvedFiles(workdir string, files []string) error {
+	var resolved []string
+	for _, f := range files {
+		data, err := os.ReadFile(filepath.Join(workdir, f))
+		if err != nil && !os.IsNotExist(err) {
+			continue
+		}
+		if !bytes.Contains(data, []byte("<<<<<<< ")) {
+			resolved = append(resolved, f) // deleted files stage as removals
+		}
+	}
+	if len(resolved) == 0 {
+		return nil
+	}
+	cmd := exec.Command("git", append([]string{"add", "-A", "--"}, resolved...)...)
+	cmd.Dir = workdir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git add: %s", strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// gitOutput runs git in workdir and returns trimmed stdout.
+func gitOutput(workdir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = workdir
+	output, err := cmd.Output()
+	return strings.TrimSpace(string(output)), err
+}
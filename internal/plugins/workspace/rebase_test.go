@@ -0,0 +1,123 @@
+package workspace
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// newRebaseTestRepo creates a repo where "feature" and "main" both edit
+// shared.txt (conflict) and each touch a file of their own.
+func newRebaseTestRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-b", "main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test")
+	write("shared.txt", "base\n")
+	run("add", ".")
+	run("commit", "-m", "base")
+
+	run("checkout", "-b", "feature")
+	write("shared.txt", "feature\n")
+	write("feature.txt", "f\n")
+	run("add", ".")
+	run("commit", "-m", "feature")
+
+	run("checkout", "main")
+	write("shared.txt", "main\n")
+	write("main.txt", "m\n")
+	run("add", ".")
+	run("commit", "-m", "main")
+	run("checkout", "feature")
+	return dir
+}
+
+func TestPreviewRebase(t *testing.T) {
+	dir := newRebaseTestRepo(t)
+
+	preview, err := previewRebase(dir, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.Ahead != 1 || preview.Behind != 1 {
+		t.Errorf("ahead/behind = %d/%d, want 1/1", preview.Ahead, preview.Behind)
+	}
+	if !reflect.DeepEqual(preview.Conflicts, []string{"shared.txt"}) {
+		t.Errorf("conflicts = %v, want [shared.txt]", preview.Conflicts)
+	}
+	if rebaseInProgress(dir) {
+		t.Error("preview must not start a rebase")
+	}
+}
+
+func TestRebaseConflictsThenContinue(t *testing.T) {
+	dir := newRebaseTestRepo(t)
+	wt := &Worktree{Name: "feature", Path: dir, Branch: "feature"}
+
+	res := rebaseResult(wt, "rebase", "main")
+	if res.Err != nil || !reflect.DeepEqual(res.Conflicts, []string{"shared.txt"}) {
+		t.Fatalf("rebase result = %+v", res)
+	}
+	if !rebaseInProgress(dir) {
+		t.Fatal("expected rebase in progress")
+	}
+
+	// Unresolved file is not staged; continue reports the conflict again
+	if err := stageResolvedFiles(dir, res.Conflicts); err != nil {
+		t.Fatal(err)
+	}
+	if res = rebaseResult(wt, "-c", "core.editor=true", "rebase", "--continue"); len(res.Conflicts) != 1 {
+		t.Fatalf("continue with markers = %+v", res)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "shared.txt"), []byte("resolved\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := stageResolvedFiles(dir, res.Conflicts); err != nil {
+		t.Fatal(err)
+	}
+	res = rebaseResult(wt, "-c", "core.editor=true", "rebase", "--continue")
+	if res.Err != nil || len(res.Conflicts) != 0 {
+		t.Fatalf("continue = %+v", res)
+	}
+	if rebaseInProgress(dir) {
+		t.Error("rebase should be finished")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "main.txt")); err != nil {
+		t.Error("rebased branch should contain main's commit")
+	}
+}
+
+func TestRebaseTargetsMsg_BaseFirstOrResume(t *testing.T) {
+	p := New()
+	wt := &Worktree{Name: "feature", Branch: "feature", BaseBranch: "main"}
+	p.rebaseState = &RebaseState{Worktree: wt, Step: RebaseStepTarget, Target: "main"}
+
+	p.Update(RebaseTargetsMsg{WorkspaceName: "feature", Branches: []string{"dev", "main"}})
+	if !reflect.DeepEqual(p.rebaseState.Targets, []string{"main", "dev"}) {
+		t.Errorf("targets = %v", p.rebaseState.Targets)
+	}
+
+	p.Update(RebaseTargetsMsg{WorkspaceName: "feature", InProgress: true, Conflicts: []string{"a.go"}})
+	if p.rebaseState.Step != RebaseStepConflicts || p.rebaseState.Target != "" {
+		t.Errorf("resume state = %+v", p.rebaseState)
+	}
+}
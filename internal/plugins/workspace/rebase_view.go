@@ -0,0 +1,212 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// rebasePreviewMaxFiles caps the predicted-conflict list in the preview step.
+const rebasePreviewMaxFiles = 10
+
+// ensureRebaseModal builds/rebuilds the rebase workflow modal.
+func (p *Plugin) ensureRebaseModal() {
+	if p.rebaseState == nil {
+		return
+	}
+
+	modalW := 70
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist, width changed, or step changed
+	if p.rebaseModal != nil && p.rebaseModalWidth == modalW && p.rebaseModalStep == p.rebaseState.Step {
+		return
+	}
+	p.rebaseModalWidth = modalW
+	p.rebaseModalStep = p.rebaseState.Step
+
+	s := p.rebaseState
+	opts := []modal.Option{
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+		modal.WithCloseOnBackdropClick(false),
+	}
+	switch s.Step {
+	case RebaseStepPreview:
+		opts = append(opts, modal.WithPrimaryAction(rebaseStartButtonID))
+	case RebaseStepConflicts:
+		opts = append(opts, modal.WithVariant(modal.VariantWarning))
+	case RebaseStepError:
+		opts = append(opts, modal.WithVariant(modal.VariantDanger))
+	}
+	m := modal.New(fmt.Sprintf("Rebase: %s", s.Worktree.Name), opts...)
+
+	bold := lipgloss.NewStyle().Bold(true)
+	switch s.Step {
+	case RebaseStepTarget:
+		m.AddSection(modal.Text(bold.Render(fmt.Sprintf("Rebase '%s' onto:", s.Worktree.Branch))))
+		m.AddSection(modal.Spacer())
+		if len(s.Targets) > 0 {
+			items := make([]modal.ListItem, len(s.Targets))
+			for i, b := range s.Targets {
+				label := b
+				if b == resolveBaseBranch(s.Worktree) {
+					label = b + " (base)"
+				}
+				items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", rebaseTargetItemPfx, i), Label: label}
+			}
+			m.AddSection(modal.List(rebaseTargetListID, items, &s.TargetIdx, modal.WithMaxVisible(min(len(items), 8))))
+		} else {
+			m.AddSection(modal.Text("Loading branches..."))
+		}
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Text(dimText("↑/↓: select   Enter: preview   Esc: cancel")))
+
+	case RebaseStepPreview:
+		m.AddSection(p.rebasePreviewSection())
+		m.AddSection(modal.Spacer())
+		if s.Preview != nil {
+			m.AddSection(modal.Buttons(
+				modal.Btn(" Rebase ", rebaseStartButtonID),
+				modal.Btn(" Cancel ", rebaseCloseButtonID),
+			))
+			m.AddSection(modal.Spacer())
+		}
+		m.AddSection(modal.Text(dimText("Enter: start rebase   Esc: cancel")))
+
+	case RebaseStepRunning:
+		if s.Target != "" {
+			m.AddSection(modal.Text(fmt.Sprintf("Rebasing '%s' onto '%s'...", s.Worktree.Branch, s.Target)))
+		} else {
+			m.AddSection(modal.Text(fmt.Sprintf("Rebasing '%s'...", s.Worktree.Branch)))
+		}
+
+	case RebaseStepConflicts:
+		warn := lipgloss.NewStyle().Foreground(styles.Current().Warning).Bold(true)
+		m.AddSection(modal.Text(warn.Render(fmt.Sprintf("Rebase stopped: %d conflicted file(s)", len(s.Conflicts)))))
+		m.AddSection(modal.Text(dimText("Resolve each file, then continue. Resolved files are staged for you.")))
+		m.AddSection(modal.Spacer())
+		if len(s.Conflicts) > 0 {
+			items := make([]modal.ListItem, len(s.Conflicts))
+			for i, f := range s.Conflicts {
+				items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", rebaseFileItemPfx, i), Label: f}
+			}
+			m.AddSection(modal.List(rebaseFileListID, items, &s.FileIdx, modal.WithMaxVisible(min(len(items), 8))))
+		} else {
+			m.AddSection(modal.Text("All conflicts resolved. Continue to finish the rebase."))
+		}
+		if s.Output != "" {
+			m.AddSection(modal.Spacer())
+			m.AddSection(modal.Text(dimText(truncateDiff(s.Output, 6))))
+		}
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Buttons(
+			modal.Btn(" Continue ", rebaseContinueButtonID),
+			modal.Btn(" Abort ", rebaseAbortButtonID),
+		))
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Text(dimText("Enter: open in file browser   c: continue   a: abort")))
+		m.AddSection(modal.Text(dimText("Esc: close (rebase stays in progress)")))
+
+	case RebaseStepDone:
+		ok := lipgloss.NewStyle().Foreground(styles.Current().Success).Bold(true)
+		if s.Aborted {
+			m.AddSection(modal.Text(ok.Render("Rebase aborted; branch restored")))
+		} else if s.Target == "" {
+			m.AddSection(modal.Text(ok.Render(fmt.Sprintf("Rebase of '%s' finished", s.Worktree.Branch))))
+		} else {
+			m.AddSection(modal.Text(ok.Render(fmt.Sprintf("Rebased '%s' onto '%s'", s.Worktree.Branch, s.Target))))
+		}
+		if s.Output != "" {
+			m.AddSection(modal.Spacer())
+			m.AddSection(modal.Text(dimText(truncateDiff(s.Output, 6))))
+		}
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Buttons(modal.Btn(" Close ", rebaseCloseButtonID)))
+
+	case RebaseStepError:
+		m.AddSection(modal.Text(lipgloss.NewStyle().Foreground(styles.Current().Error).Bold(true).Render("Rebase failed:")))
+		m.AddSection(modal.Spacer())
+		if s.Err != nil {
+			m.AddSection(modal.Text(s.Err.Error()))
+		}
+		if s.Output != "" {
+			m.AddSection(modal.Text(dimText(truncateDiff(s.Output, 10))))
+		}
+		m.AddSection(modal.Spacer())
+		m.AddSection(modal.Buttons(modal.Btn(" Close ", rebaseCloseButtonID)))
+	}
+
+	p.rebaseModal = m
+}
+
+// clearRebaseModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearRebaseModal() {
+	p.rebaseModal = nil
+	p.rebaseModalWidth = 0
+}
+
+// rebasePreviewSection renders commit counts and predicted conflicts.
+func (p *Plugin) rebasePreviewSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		s := p.rebaseState
+		if s == nil {
+			return modal.RenderedSection{}
+		}
+		if s.Preview == nil {
+			return modal.RenderedSection{Content: dimText(fmt.Sprintf("Checking '%s' for conflicts...", s.Target))}
+		}
+
+		pv := s.Preview
+		var lines []string
+		lines = append(lines, fmt.Sprintf("Target: %s", lipgloss.NewStyle().Bold(true).Render(s.Target)))
+		if pv.Behind == 0 {
+			lines = append(lines, fmt.Sprintf("Already up to date with '%s'", s.Target))
+		} else {
+			lines = append(lines, fmt.Sprintf("%d incoming commit(s); %d local commit(s) to replay", pv.Behind, pv.Ahead))
+		}
+		lines = append(lines, "")
+
+		if len(pv.Conflicts) == 0 {
+			lines = append(lines, lipgloss.NewStyle().Foreground(styles.Current().Success).Render("No conflicts expected"))
+			return modal.RenderedSection{Content: strings.Join(lines, "\n")}
+		}
+
+		verb := "will conflict"
+		if !pv.Exact {
+			verb = "edited on both sides (may conflict)"
+		}
+		warn := lipgloss.NewStyle().Foreground(styles.Current().Warning)
+		lines = append(lines, warn.Render(fmt.Sprintf("%d file(s) %s:", len(pv.Conflicts), verb)))
+		for i, f := range pv.Conflicts {
+			if i == rebasePreviewMaxFiles {
+				lines = append(lines, dimText(fmt.Sprintf("  ... %d more", len(pv.Conflicts)-i)))
+				break
+			}
+			lines = append(lines, "  "+truncateString(f, contentWidth-2))
+		}
+		return modal.RenderedSection{Content: strings.Join(lines, "\n")}
+	}, nil)
+}
+
+// renderRebaseModal renders the rebase workflow modal with dimmed background.
+func (p *Plugin) renderRebaseModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureRebaseModal()
+	if p.rebaseModal == nil {
+		return background
+	}
+
+	modalContent := p.rebaseModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
@@ -0,0 +1,491 @@
+package workspace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/app"
+	"github.com/wilbur182/forge/internal/state"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+const (
+	// recordingExt is the file extension for asciicast v2 recordings.
+	recordingExt = ".cast"
+	// recordingClearScreen prefixes each frame so standard players redraw
+	// the whole screen rather than appending.
+	recordingClearScreen = "\x1b[H\x1b[2J"
+	// replayIdleLimit caps the pause between frames during playback, like
+	// asciinema's idle_time_limit.
+	replayIdleLimit = 2 * time.Second
+	// recordingNameLayout names recordings by start time. Milliseconds keep
+	// names unique and in start order when recordings begin in the same second.
+	recordingNameLayout = "20060102-150405.000"
+	// recordingNameTries bounds retries when a name is already taken.
+	recordingNameTries = 10
+)
+
+// replaySpeeds are the playback speeds cycled with +/-.
+var replaySpeeds = []float64{0.5, 1, 2, 4, 8}
+
+// castHeader is the first line of an asciicast v2 file.
+type castHeader struct {
+	Version   int    `json:"version"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Title     string `json:"title,omitempty"`
+}
+
+// sessionRecorder appends screen snapshots of a worktree's agent pane to an
+// asciicast v2 file. Each event holds a full screen, so the file plays back
+// in asciinema as well as in the built-in viewer. Frames come from agent
+// polling, which pauses while the session is attached, so a recording has a
+// gap for the time spent attached.
+type sessionRecorder struct {
+	path   string
+	file   *os.File
+	start  time.Time
+	height int
+	last   string // Last written screen, to skip duplicate frames
+	frames int
+}
+
+// recordingsDir returns the directory holding recordings for a worktree.
+func recordingsDir(projectRoot, worktreeName string) string {
+	dir := state.Dir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "recordings", sanitizeName(filepath.Base(projectRoot)), sanitizeName(worktreeName))
+}
+
+// startSessionRecorder creates a new recording file in dir.
+func startSessionRecorder(dir, title string, width, height int) (*sessionRecorder, error) {
+	if dir == "" {
+		return nil, errors.New("state directory not initialized")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	var (
+		now  time.Time
+		path string
+		f    *os.File
+		err  error
+	)
+	for range recordingNameTries {
+		now = time.Now()
+		path = filepath.Join(dir, now.Format(recordingNameLayout)+recordingExt)
+		f, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+		if !errors.Is(err, fs.ErrExist) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err != nil {
+		return nil, err
+	}
+	header, _ := json.Marshal(castHeader{
+		Version:   2,
+		Width:     max(width, 1),
+		Height:    max(height, 1),
+		Timestamp: now.Unix(),
+		Title:     title,
+	})
+	if _, err := f.Write(append(header, '\n')); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &sessionRecorder{path: path, file: f, start: now, height: max(height, 1)}, nil
+}
+
+// writeFrame records the visible part of output if it changed since the
+// previous frame.
+func (r *sessionRecorder) writeFrame(output string) error {
+	screen := lastScreen(output, r.height)
+	if screen == r.last {
+		return nil
+	}
+	r.last = screen
+	event, err := json.Marshal([]any{
+		time.Since(r.start).Seconds(),
+		"o",
+		recordingClearScreen + strings.ReplaceAll(screen, "\n", "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := r.file.Write(append(event, '\n')); err != nil {
+		return err
+	}
+	r.frames++
+	return nil
+}
+
+// close finishes the recording.
+func (r *sessionRecorder) close() error {
+	return r.file.Close()
+}
+
+// lastScreen returns the last height lines of output, ignoring trailing
+// blank lines.
+func lastScreen(output string, height int) string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if idx := lastNonEmptyLine(lines); idx >= 0 {
+		lines = lines[:idx+1]
+	}
+	if len(lines) > height {
+		lines = lines[len(lines)-height:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isRecording reports whether the named worktree is being recorded.
+func (p *Plugin) isRecording(name string) bool {
+	return p.recorders[name] != nil
+}
+
+// toggleRecording starts or stops recording the selected worktree's agent pane.
+func (p *Plugin) toggleRecording() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil || p.shellSelected {
+		return nil
+	}
+	if rec := p.recorders[wt.Name]; rec != nil {
+		p.stopRecording(wt.Name)
+		return recordingToast(fmt.Sprintf("Saved %d frames to %s", rec.frames, rec.path), nil)
+	}
+
+	width, height := p.calculatePreviewDimensions()
+	rec, err := startSessionRecorder(recordingsDir(p.ctx.ProjectRoot, wt.Name), wt.Name, width, height)
+	if err != nil {
+		return recordingToast("", fmt.Errorf("start recording: %w", err))
+	}
+	p.recorders[wt.Name] = rec
+	// Seed the first frame from what is already on screen
+	if wt.Agent != nil && wt.Agent.OutputBuf != nil {
+		_ = rec.writeFrame(wt.Agent.OutputBuf.String())
+	}
+	return recordingToast("Recording "+wt.Name, nil)
+}
+
+// recordOutput appends a frame for a worktree that is being recorded.
+// Write errors stop the recording.
+func (p *Plugin) recordOutput(name, output string) tea.Cmd {
+	rec := p.recorders[name]
+	if rec == nil {
+		return nil
+	}
+	if err := rec.writeFrame(output); err != nil {
+		p.stopRecording(name)
+		return recordingToast("", fmt.Errorf("recording stopped: %w", err))
+	}
+	return nil
+}
+
+// stopRecording closes and forgets the recorder for a worktree.
+func (p *Plugin) stopRecording(name string) {
+	if rec := p.recorders[name]; rec != nil {
+		_ = rec.close()
+		delete(p.recorders, name)
+	}
+}
+
+// stopAllRecordings closes every active recorder (project switch).
+func (p *Plugin) stopAllRecordings() {
+	for name := range p.recorders {
+		p.stopRecording(name)
+	}
+}
+
+// recordingToast reports a recording state change or failure.
+func recordingToast(message string, err error) tea.Cmd {
+	return func() tea.Msg {
+		if err != nil {
+			return app.ToastMsg{Message: err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+		return app.ToastMsg{Message: message, Duration: 2 * time.Second}
+	}
+}
+
+// latestRecording returns the newest recording in dir.
+func latestRecording(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.New("no recordings for this worktree")
+		}
+		return "", err
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), recordingExt) {
+			names = append(names, e.Name())
+		}
+	}
+	if len(names) == 0 {
+		return "", errors.New("no recordings for this worktree")
+	}
+	// Names are timestamps, so lexical order is chronological
+	sort.Strings(names)
+	return filepath.Join(dir, names[len(names)-1]), nil
+}
+
+// replayFrame is one screen of a recording.
+type replayFrame struct {
+	At     time.Duration // Offset from the start of the recording
+	Screen string
+}
+
+// loadRecording parses an asciicast v2 file written by sessionRecorder.
+// Only output events are kept; each is treated as a full screen.
+func loadRecording(path string) ([]replayFrame, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		return nil, errors.New("empty recording")
+	}
+	var header castHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil || header.Version != 2 {
+		return nil, errors.New("not an asciicast v2 recording")
+	}
+
+	var frames []replayFrame
+	for scanner.Scan() {
+		var event []any
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || len(event) != 3 {
+			continue
+		}
+		at, ok1 := event[0].(float64)
+		kind, ok2 := event[1].(string)
+		data, ok3 := event[2].(string)
+		if !ok1 || !ok2 || !ok3 || kind != "o" {
+			continue
+		}
+		data = strings.TrimPrefix(data, recordingClearScreen)
+		frames = append(frames, replayFrame{
+			At:     time.Duration(at * float64(time.Second)),
+			Screen: strings.ReplaceAll(data, "\r\n", "\n"),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(frames) == 0 {
+		return nil, errors.New("recording has no frames")
+	}
+	return frames, nil
+}
+
+// ReplayLoadedMsg carries a parsed recording for playback.
+type ReplayLoadedMsg struct {
+	WorkspaceName string
+	Path          string
+	Frames        []replayFrame
+	Err           error
+}
+
+// replayTickMsg advances playback. Gen guards against ticks from an earlier
+// play/pause cycle.
+type replayTickMsg struct {
+	Gen int
+}
+
+// ReplayState holds playback of a recording in the preview pane.
+type ReplayState struct {
+	WorkspaceName string
+	Path          string
+	Frames        []replayFrame
+	Idx           int
+	Playing       bool
+	SpeedIdx      int // Index into replaySpeeds
+	Gen           int
+}
+
+// speed returns the current playback multiplier.
+func (r *ReplayState) speed() float64 {
+	return replaySpeeds[r.SpeedIdx]
+}
+
+// nextDelay returns how long the current frame stays on screen.
+func (r *ReplayState) nextDelay() time.Duration {
+	if r.Idx+1 >= len(r.Frames) {
+		return 0
+	}
+	gap := r.Frames[r.Idx+1].At - r.Frames[r.Idx].At
+	gap = min(gap, replayIdleLimit)
+	return time.Duration(float64(gap) / r.speed())
+}
+
+// openReplay loads the newest recording of the selected worktree.
+func (p *Plugin) openReplay() tea.Cmd {
+	wt := p.selectedWorktree()
+	if wt == nil || p.shellSelected {
+		return nil
+	}
+	dir := recordingsDir(p.ctx.ProjectRoot, wt.Name)
+	name := wt.Name
+	return func() tea.Msg {
+		path, err := latestRecording(dir)
+		if err != nil {
+			return ReplayLoadedMsg{WorkspaceName: name, Err: err}
+		}
+		frames, err := loadRecording(path)
+		return ReplayLoadedMsg{WorkspaceName: name, Path: path, Frames: frames, Err: err}
+	}
+}
+
+// startReplay begins playback of a loaded recording.
+func (p *Plugin) startReplay(msg ReplayLoadedMsg) tea.Cmd {
+	if msg.Err != nil {
+		return recordingToast("", msg.Err)
+	}
+	p.replay = &ReplayState{
+		WorkspaceName: msg.WorkspaceName,
+		Path:          msg.Path,
+		Frames:        msg.Frames,
+		SpeedIdx:      1,
+	}
+	p.previewTab = PreviewTabOutput
+	return p.playReplay()
+}
+
+// playReplay resumes playback from the current frame.
+func (p *Plugin) playReplay() tea.Cmd {
+	r := p.replay
+	if r == nil {
+		return nil
+	}
+	if r.Idx >= len(r.Frames)-1 {
+		r.Idx = 0 // Restart from the beginning when at the end
+	}
+	r.Playing = true
+	r.Gen++
+	return p.scheduleReplayTick()
+}
+
+// scheduleReplayTick waits for the current frame's duration.
+func (p *Plugin) scheduleReplayTick() tea.Cmd {
+	r := p.replay
+	gen := r.Gen
+	return tea.Tick(r.nextDelay(), func(time.Time) tea.Msg {
+		return replayTickMsg{Gen: gen}
+	})
+}
+
+// advanceReplay moves to the next frame on a tick.
+func (p *Plugin) advanceReplay(msg replayTickMsg) tea.Cmd {
+	r := p.replay
+	if r == nil || !r.Playing || msg.Gen != r.Gen {
+		return nil
+	}
+	if r.Idx >= len(r.Frames)-1 {
+		r.Playing = false
+		return nil
+	}
+	r.Idx++
+	return p.scheduleReplayTick()
+}
+
+// replayActive reports whether a replay is shown for the selected worktree.
+func (p *Plugin) replayActive() bool {
+	if p.replay == nil || p.shellSelected {
+		return false
+	}
+	wt := p.selectedWorktree()
+	return wt != nil && wt.Name == p.replay.WorkspaceName
+}
+
+// closeReplay stops playback and returns the preview to live output.
+func (p *Plugin) closeReplay() {
+	p.replay = nil
+}
+
+// handleReplayKeys handles playback controls while a replay is shown. It
+// reports false for keys it doesn't use so list navigation still works.
+func (p *Plugin) handleReplayKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
+	r := p.replay
+	if !p.replayActive() {
+		// Selection moved away; drop the replay
+		p.closeReplay()
+		return nil, false
+	}
+
+	switch msg.String() {
+	case "esc", "q", "W":
+		p.closeReplay()
+	case " ":
+		if r.Playing {
+			r.Playing = false
+			r.Gen++
+			return nil, true
+		}
+		return p.playReplay(), true
+	case "right", "l":
+		r.Playing = false
+		r.Idx = min(r.Idx+1, len(r.Frames)-1)
+	case "left", "h":
+		r.Playing = false
+		r.Idx = max(r.Idx-1, 0)
+	case "g", "home":
+		r.Playing = false
+		r.Idx = 0
+	case "G", "end":
+		r.Playing = false
+		r.Idx = len(r.Frames) - 1
+	case "+", "=":
+		r.SpeedIdx = min(r.SpeedIdx+1, len(replaySpeeds)-1)
+	case "-":
+		r.SpeedIdx = max(r.SpeedIdx-1, 0)
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// renderReplay renders the current replay frame with a status line.
+func (p *Plugin) renderReplay(width, height int) string {
+	r := p.replay
+	frame := r.Frames[r.Idx]
+
+	icon := "⏸"
+	if r.Playing {
+		icon = "▶"
+	}
+	status := fmt.Sprintf("%s REPLAY %d/%d  %s  %gx", icon, r.Idx+1, len(r.Frames), formatReplayOffset(frame.At), r.speed())
+	hint := dimText("space play/pause • ←/→ step • +/- speed • esc exit")
+	lines := []string{lipgloss.NewStyle().Foreground(styles.Current().Error).Bold(true).Render(status) + "  " + hint}
+
+	screen := strings.Split(frame.Screen, "\n")
+	if visible := height - 1; visible > 0 && len(screen) > visible {
+		screen = screen[len(screen)-visible:]
+	}
+	for _, line := range screen {
+		lines = append(lines, p.truncateCache.Truncate(ui.ExpandTabs(line, tabStopWidth), width, ""))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatReplayOffset renders a recording offset as m:ss.
+func formatReplayOffset(d time.Duration) string {
+	secs := int(d.Seconds())
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
@@ -0,0 +1,139 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSessionRecorderRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	rec, err := startSessionRecorder(dir, "feature", 80, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, out := range []string{"a\nb\nc\n\n", "a\nb\nc\n", "b\nc\nd"} {
+		if err := rec.writeFrame(out); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rec.close(); err != nil {
+		t.Fatal(err)
+	}
+	if rec.frames != 2 {
+		t.Errorf("frames = %d, want 2 (duplicate screen skipped)", rec.frames)
+	}
+
+	path, err := latestRecording(dir)
+	if err != nil || path != rec.path {
+		t.Fatalf("latestRecording = %q, %v; want %q", path, err, rec.path)
+	}
+	frames, err := loadRecording(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 2 || frames[0].Screen != "b\nc" || frames[1].Screen != "c\nd" {
+		t.Errorf("frames = %+v, want last 2 lines of each screen", frames)
+	}
+}
+
+func TestLatestRecordingEmpty(t *testing.T) {
+	if _, err := latestRecording(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for a worktree without recordings")
+	}
+}
+
+func TestLoadRecordingRejectsOtherFormats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "v1.cast")
+	if err := os.WriteFile(path, []byte(`{"version": 1, "stdout": []}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadRecording(path); err == nil {
+		t.Error("expected asciicast v1 to be rejected")
+	}
+}
+
+func TestReplayNextDelay(t *testing.T) {
+	r := &ReplayState{
+		Frames:   []replayFrame{{At: 0}, {At: time.Second}, {At: time.Minute}},
+		SpeedIdx: 1,
+	}
+	if d := r.nextDelay(); d != time.Second {
+		t.Errorf("delay = %v, want 1s", d)
+	}
+	r.Idx = 1
+	if d := r.nextDelay(); d != replayIdleLimit {
+		t.Errorf("delay = %v, want idle gaps capped at %v", d, replayIdleLimit)
+	}
+	r.SpeedIdx = 2
+	if d := r.nextDelay(); d != replayIdleLimit/2 {
+		t.Errorf("delay at 2x = %v", d)
+	}
+	r.Idx = 2
+	if d := r.nextDelay(); d != 0 {
+		t.Errorf("delay on last frame = %v, want 0", d)
+	}
+}
+
+func TestReplayPlayback(t *testing.T) {
+	p := New()
+	p.width, p.height = 100, 30
+	p.worktrees = []*Worktree{{Name: "feat", Path: "/tmp/feat"}}
+
+	p.startReplay(ReplayLoadedMsg{
+		WorkspaceName: "feat",
+		Frames:        []replayFrame{{Screen: "one"}, {At: time.Second, Screen: "two"}},
+	})
+	if !p.replayActive() || !p.replay.Playing {
+		t.Fatal("expected replay to start playing")
+	}
+	if got := p.renderOutputContent(80, 10); !strings.Contains(got, "REPLAY 1/2") || !strings.Contains(got, "one") {
+		t.Errorf("render = %q", got)
+	}
+
+	// A tick from an older play cycle is ignored
+	p.advanceReplay(replayTickMsg{Gen: p.replay.Gen - 1})
+	if p.replay.Idx != 0 {
+		t.Fatal("stale tick advanced the replay")
+	}
+	p.advanceReplay(replayTickMsg{Gen: p.replay.Gen})
+	p.advanceReplay(replayTickMsg{Gen: p.replay.Gen})
+	if p.replay.Idx != 1 || p.replay.Playing {
+		t.Errorf("idx=%d playing=%v, want stopped on last frame", p.replay.Idx, p.replay.Playing)
+	}
+
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyLeft})
+	if p.replay.Idx != 0 {
+		t.Errorf("left should step back, idx=%d", p.replay.Idx)
+	}
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
+	if p.replay.speed() != 2 {
+		t.Errorf("speed = %v, want 2", p.replay.speed())
+	}
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.replay != nil {
+		t.Error("esc should close the replay")
+	}
+}
+
+func TestSessionRecorderSameSecond(t *testing.T) {
+	dir := t.TempDir()
+	first, err := startSessionRecorder(dir, "a", 80, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = first.close() }()
+	second, err := startSessionRecorder(dir, "b", 80, 2)
+	if err != nil {
+		t.Fatalf("second recording in the same second: %v", err)
+	}
+	defer func() { _ = second.close() }()
+
+	if latest, _ := latestRecording(dir); latest != second.path {
+		t.Errorf("latestRecording = %q, want the newer %q", latest, second.path)
+	}
+}
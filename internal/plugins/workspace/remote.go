@@ -0,0 +1,711 @@
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/config"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+const (
+	// remoteManifestFile lists remote worktrees under the project's .forge dir;
+	// git worktree list only knows about local ones.
+	remoteManifestFile = "remote-worktrees.json"
+
+	// defaultRemotePollInterval is the minimum delay between output captures
+	// over SSH when the host does not set pollInterval.
+	defaultRemotePollInterval = 3 * time.Second
+
+	// remoteMaxBackoff caps the retry delay while a host is unreachable.
+	remoteMaxBackoff = time.Minute
+
+	// remoteCommandTimeout bounds a single ssh round trip.
+	remoteCommandTimeout = 20 * time.Second
+
+	// remoteCaptureLines is the scrollback captured per poll; smaller than
+	// the local capture to keep each round trip light.
+	remoteCaptureLines = 500
+)
+
+// errRemoteUnreachable marks ssh connection failures, as opposed to errors
+// from the command run on the host.
+var errRemoteUnreachable = errors.New("host unreachable")
+
+// remoteWorktreeRecord is a remote worktree saved in the manifest.
+type remoteWorktreeRecord struct {
+	Name       string    `json:"name"`
+	Host       string    `json:"host"`
+	Path       string    `json:"path"`
+	Branch     string    `json:"branch"`
+	BaseBranch string    `json:"baseBranch,omitempty"`
+	AgentType  AgentType `json:"agentType,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// remoteManifestMu serializes manifest read-modify-write cycles from
+// concurrent commands.
+var remoteManifestMu sync.Mutex
+
+// unknownHostsWarned records manifest hosts already reported as missing
+// from the config, so each is logged once rather than on every refresh.
+var unknownHostsWarned sync.Map
+
+// RemoteCreateDoneMsg reports the result of creating a remote worktree.
+type RemoteCreateDoneMsg struct {
+	Worktree *Worktree
+	Err      error
+}
+
+// RemotePollFailedMsg reports that a remote output capture could not reach
+// the host. Polling backs off and keeps the last output on screen.
+type RemotePollFailedMsg struct {
+	WorkspaceName string
+	Host          string
+	Err           error
+}
+
+// RemoteCreateState holds the state for the remote worktree modal.
+type RemoteCreateState struct {
+	Hosts      []config.RemoteHost
+	HostIdx    int
+	NameInput  textinput.Model
+	BaseInput  textinput.Model
+	Err        string
+	InProgress bool
+}
+
+// remoteManifestPath returns the manifest location for a project.
+func remoteManifestPath(workDir string) string {
+	return filepath.Join(workDir, ".forge", remoteManifestFile)
+}
+
+// loadRemoteRecords reads the manifest. A missing file means no remote
+// worktrees.
+func loadRemoteRecords(manifestPath string) ([]remoteWorktreeRecord, error) {
+	data, err := os.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []remoteWorktreeRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", remoteManifestFile, err)
+	}
+	return records, nil
+}
+
+// saveRemoteRecords writes the manifest, creating the .forge dir if needed.
+func saveRemoteRecords(manifestPath string, records []remoteWorktreeRecord) error {
+	if err := os.MkdirAll(filepath.Dir(manifestPath), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(manifestPath, append(data, '\n'), 0644)
+}
+
+// updateRemoteRecords applies fn to the manifest under the manifest lock.
+func updateRemoteRecords(manifestPath string, fn func([]remoteWorktreeRecord) []remoteWorktreeRecord) error {
+	remoteManifestMu.Lock()
+	defer remoteManifestMu.Unlock()
+	records, err := loadRemoteRecords(manifestPath)
+	if err != nil {
+		return err
+	}
+	return saveRemoteRecords(manifestPath, fn(records))
+}
+
+// remoteWorktreeFromRecord converts a manifest entry to a list entry.
+func remoteWorktreeFromRecord(r remoteWorktreeRecord) *Worktree {
+	return &Worktree{
+		Name:            r.Name,
+		Path:            r.Path,
+		Branch:          r.Branch,
+		BaseBranch:      r.BaseBranch,
+		ChosenAgentType: r.AgentType,
+		CreatedAt:       r.CreatedAt,
+		Host:            r.Host,
+	}
+}
+
+// listRemoteWorktrees returns the project's remote worktrees from the
+// manifest. The manifest is part of the project, so entries on hosts that
+// aren't in plugins.workspace.hosts are skipped rather than passed to ssh.
+func (p *Plugin) listRemoteWorktrees() []*Worktree {
+	remoteManifestMu.Lock()
+	records, err := loadRemoteRecords(remoteManifestPath(p.ctx.WorkDir))
+	remoteManifestMu.Unlock()
+	if err != nil {
+		if p.ctx.Logger != nil {
+			p.ctx.Logger.Warn("failed to load remote worktrees", "error", err)
+		}
+		return nil
+	}
+	worktrees := make([]*Worktree, 0, len(records))
+	for _, r := range records {
+		if p.configuredHost(r.Host) == nil {
+			if _, warned := unknownHostsWarned.LoadOrStore(r.Host, true); !warned && p.ctx.Logger != nil {
+				p.ctx.Logger.Warn("skipping remote worktrees on a host not in plugins.workspace.hosts", "host", r.Host)
+			}
+			continue
+		}
+		worktrees = append(worktrees, remoteWorktreeFromRecord(r))
+	}
+	return worktrees
+}
+
+// remoteHosts returns the configured SSH hosts.
+func (p *Plugin) remoteHosts() []config.RemoteHost {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return nil
+	}
+	return p.ctx.Config.Plugins.Workspace.Hosts
+}
+
+// configuredHost returns the configured host named name, or nil if there
+// is none or its address could be read by ssh as an option.
+func (p *Plugin) configuredHost(name string) *config.RemoteHost {
+	for _, h := range p.remoteHosts() {
+		if h.Name == name {
+			if !validSSHAddress(h.Address) {
+				return nil
+			}
+			return &h
+		}
+	}
+	return nil
+}
+
+// validSSHAddress reports whether addr is usable as an ssh destination.
+func validSSHAddress(addr string) bool {
+	return addr != "" && !strings.HasPrefix(addr, "-")
+}
+
+// hostFor returns the host a remote worktree lives on. A host removed from
+// the config has no address, so ssh commands to it fail.
+func (p *Plugin) hostFor(wt *Worktree) *config.RemoteHost {
+	if h := p.configuredHost(wt.Host); h != nil {
+		return h
+	}
+	return &config.RemoteHost{Name: wt.Host}
+}
+
+// hostPollInterval returns the minimum capture interval for a host.
+func hostPollInterval(host *config.RemoteHost) time.Duration {
+	if host.PollInterval != "" {
+		if d, err := time.ParseDuration(host.PollInterval); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultRemotePollInterval
+}
+
+// remotePollDelay raises delay to the host's poll interval for remote
+// worktrees; local worktrees keep their delay.
+func (p *Plugin) remotePollDelay(worktreeName string, delay time.Duration) time.Duration {
+	wt := p.findWorktree(worktreeName)
+	if wt == nil || wt.Host == "" {
+		return delay
+	}
+	if floor := hostPollInterval(p.hostFor(wt)); delay < floor {
+		return floor
+	}
+	return delay
+}
+
+// remoteBackoff returns the retry delay after n consecutive failed polls.
+func remoteBackoff(base time.Duration, n int) time.Duration {
+	d := base
+	for i := 1; i < n && d < remoteMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > remoteMaxBackoff {
+		d = remoteMaxBackoff
+	}
+	return d
+}
+
+// remoteWorktreePath returns where a worktree named name is created on host.
+func remoteWorktreePath(host *config.RemoteHost, name string) string {
+	dir := host.WorktreeDir
+	if dir == "" {
+		dir = path.Dir(host.RepoPath)
+	}
+	return path.Join(dir, path.Base(host.RepoPath)+"-"+name)
+}
+
+// shellJoin quotes args into a single command line for the remote shell.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, a := range args {
+		quoted[i] = shellQuote(a)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// sshArgs returns ssh arguments running args on host. BatchMode keeps a
+// password prompt from hanging a background command.
+func sshArgs(host *config.RemoteHost, args ...string) []string {
+	return []string{
+		"-o", "BatchMode=yes",
+		"-o", "ConnectTimeout=10",
+		"-o", "ServerAliveInterval=15",
+		"--",
+		host.Address,
+		shellJoin(args),
+	}
+}
+
+// remoteCommand returns a command running args on host over ssh.
+func remoteCommand(ctx context.Context, host *config.RemoteHost, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "ssh", sshArgs(host, args...)...)
+}
+
+// runRemote runs args on host and returns stdout. Connection failures wrap
+// errRemoteUnreachable; command failures carry the remote stderr.
+func runRemote(host *config.RemoteHost, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteCommandTimeout)
+	defer cancel()
+	out, err := remoteCommand(ctx, host, args...).Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("%s: %w: timeout after %s", host.Name, errRemoteUnreachable, remoteCommandTimeout)
+	}
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			stderr := strings.TrimSpace(string(exitErr.Stderr))
+			// ssh exits 255 when it cannot connect or authenticate
+			if exitErr.ExitCode() == 255 {
+				return "", fmt.Errorf("%s: %w: %s", host.Name, errRemoteUnreachable, stderr)
+			}
+			if stderr != "" {
+				return "", errors.New(stderr)
+			}
+		}
+		return "", err
+	}
+	return string(out), nil
+}
+
+// tmuxCmd returns a tmux command for wt's session, run over ssh for remote
+// worktrees.
+func (p *Plugin) tmuxCmd(wt *Worktree, args ...string) *exec.Cmd {
+	if wt.Host == "" {
+		return exec.Command("tmux", args...)
+	}
+	return exec.Command("ssh", sshArgs(p.hostFor(wt), append([]string{"tmux"}, args...)...)...)
+}
+
+// createRemoteWorktree adds a worktree and branch on host and records it in
+// the manifest.
+func (p *Plugin) createRemoteWorktree(host config.RemoteHost, name, base string) tea.Cmd {
+	manifestPath := remoteManifestPath(p.ctx.WorkDir)
+	return func() tea.Msg {
+		wtPath := remoteWorktreePath(&host, name)
+		if _, err := runRemote(&host, "git", "-C", host.RepoPath, "worktree", "add", "-b", name, wtPath, base); err != nil {
+			return RemoteCreateDoneMsg{Err: fmt.Errorf("git worktree add on %s: %w", host.Name, err)}
+		}
+		record := remoteWorktreeRecord{
+			Name:       name,
+			Host:       host.Name,
+			Path:       wtPath,
+			Branch:     name,
+			BaseBranch: base,
+			CreatedAt:  time.Now(),
+		}
+		err := updateRemoteRecords(manifestPath, func(records []remoteWorktreeRecord) []remoteWorktreeRecord {
+			return append(records, record)
+		})
+		if err != nil {
+			return RemoteCreateDoneMsg{Err: fmt.Errorf("save %s: %w", remoteManifestFile, err)}
+		}
+		return RemoteCreateDoneMsg{Worktree: remoteWorktreeFromRecord(record)}
+	}
+}
+
+// saveRemoteAgentType remembers the agent chosen for a remote worktree so a
+// restart offers the same one.
+func saveRemoteAgentType(manifestPath, name string, agentType AgentType) error {
+	return updateRemoteRecords(manifestPath, func(records []remoteWorktreeRecord) []remoteWorktreeRecord {
+		for i := range records {
+			if records[i].Name == name {
+				records[i].AgentType = agentType
+			}
+		}
+		return records
+	})
+}
+
+// startRemoteAgent starts an agent in a tmux session on the worktree's host,
+// or reconnects if the session already exists.
+func (p *Plugin) startRemoteAgent(wt *Worktree, agentType AgentType, resume bool) tea.Cmd {
+	epoch := p.ctx.Epoch
+	manifestPath := remoteManifestPath(p.ctx.WorkDir)
+	name, wtPath, taskID := wt.Name, wt.Path, wt.TaskID
+	return func() tea.Msg {
+		sessionName := tmuxSessionPrefix + sanitizeName(name)
+		if p.tmuxCmd(wt, "has-session", "-t", sessionName).Run() == nil {
+			return AgentStartedMsg{
+				Epoch:         epoch,
+				WorkspaceName: name,
+				SessionName:   sessionName,
+				AgentType:     agentType,
+				Reconnected:   true,
+			}
+		}
+
+		if err := p.tmuxCmd(wt, "new-session", "-d", "-s", sessionName, "-c", wtPath).Run(); err != nil {
+			return AgentStartedMsg{Epoch: epoch, Err: fmt.Errorf("create session on %s: %w", wt.Host, err)}
+		}
+		_ = p.tmuxCmd(wt, "set-option", "-t", sessionName, "history-limit", strconv.Itoa(tmuxHistoryLimit)).Run()
+
+		envCmd := fmt.Sprintf("export TD_SESSION_ID=%s", shellQuote(sessionName))
+		_ = p.tmuxCmd(wt, "send-keys", "-t", sessionName, envCmd, "Enter").Run()
+		if taskID != "" {
+			_ = p.tmuxCmd(wt, "send-keys", "-t", sessionName, "td start "+taskID, "Enter").Run()
+		}
+
+		// Launcher scripts and .forge-env live in local worktrees, so remote
+		// agents start with the plain catalog command.
+		agentCmd := ""
+		if resume {
+			agentCmd = AgentResumeCommands[agentType]
+		}
+		if agentCmd == "" {
+			agentCmd = getAgentCommand(agentType)
+		}
+		if err := p.tmuxCmd(wt, "send-keys", "-t", sessionName, agentCmd, "Enter").Run(); err != nil {
+			_ = p.tmuxCmd(wt, "kill-session", "-t", sessionName).Run()
+			return AgentStartedMsg{Epoch: epoch, Err: fmt.Errorf("start agent on %s: %w", wt.Host, err)}
+		}
+		_ = saveRemoteAgentType(manifestPath, name, agentType)
+
+		return AgentStartedMsg{
+			Epoch:         epoch,
+			WorkspaceName: name,
+			SessionName:   sessionName,
+			AgentType:     agentType,
+		}
+	}
+}
+
+// isTmuxSessionGone reports whether a tmux error means the session or server
+// no longer exists.
+func isTmuxSessionGone(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "can't find") || strings.Contains(msg, "no server")
+}
+
+// pollRemoteAgent captures a remote agent's pane over ssh. Connection
+// failures are reported as RemotePollFailedMsg so polling can back off
+// without dropping the agent.
+func (p *Plugin) pollRemoteAgent(wt *Worktree) tea.Cmd {
+	host := p.hostFor(wt)
+	name := wt.Name
+	sessionName := wt.Agent.TmuxSession
+	agentType := wt.Agent.Type
+	maxBytes := p.tmuxCaptureMaxBytes
+	outputBuf := wt.Agent.OutputBuf
+	currentStatus := wt.Status
+	return func() tea.Msg {
+		output, err := runRemote(host, "tmux", "capture-pane", "-p", "-e", "-J",
+			"-S", fmt.Sprintf("-%d", remoteCaptureLines), "-t", sessionName)
+		if err != nil {
+			if !errors.Is(err, errRemoteUnreachable) && isTmuxSessionGone(err) {
+				return AgentStoppedMsg{WorkspaceName: name}
+			}
+			return RemotePollFailedMsg{WorkspaceName: name, Host: host.Name, Err: err}
+		}
+		output = trimCapturedOutput(output, maxBytes)
+
+		if outputBuf != nil && !outputBuf.Update(output) {
+			return AgentPollUnchangedMsg{
+				WorkspaceName: name,
+				CurrentStatus: currentStatus,
+				WaitingFor:    "",
+			}
+		}
+
+		status := detectStatus(output)
+		if patternStatus, ok := classifyAgentOutput(agentType, output); ok {
+			status = patternStatus
+		}
+		waitingFor := ""
+		if status == StatusWaiting {
+			waitingFor = extractPrompt(output)
+		}
+		return AgentOutputMsg{
+			WorkspaceName: name,
+			Output:        output,
+			Status:        status,
+			WaitingFor:    waitingFor,
+		}
+	}
+}
+
+// handleRemotePollFailed schedules a backed-off retry and tells the user the
+// first time a host drops.
+func (p *Plugin) handleRemotePollFailed(msg RemotePollFailedMsg) tea.Cmd {
+	wt := p.findWorktree(msg.WorkspaceName)
+	if wt == nil || wt.Agent == nil {
+		return nil
+	}
+	p.remoteFailures[msg.WorkspaceName]++
+	n := p.remoteFailures[msg.WorkspaceName]
+	retry := p.scheduleAgentPoll(msg.WorkspaceName, remoteBackoff(hostPollInterval(p.hostFor(wt)), n))
+	if n == 1 {
+		return tea.Batch(retry, appmsg.ShowToast(fmt.Sprintf("Lost contact with %s; retrying", msg.Host), 3*time.Second))
+	}
+	return retry
+}
+
+// clearRemoteFailures resets the backoff after a successful remote poll.
+func (p *Plugin) clearRemoteFailures(worktreeName string) tea.Cmd {
+	n := p.remoteFailures[worktreeName]
+	if n == 0 {
+		return nil
+	}
+	delete(p.remoteFailures, worktreeName)
+	if wt := p.findWorktree(worktreeName); wt != nil && wt.Host != "" {
+		return appmsg.ShowToast("Reconnected to "+wt.Host, 2*time.Second)
+	}
+	return nil
+}
+
+// remoteUnreachable reports whether the last polls of a remote worktree failed.
+func (p *Plugin) remoteUnreachable(worktreeName string) bool {
+	return p.remoteFailures[worktreeName] > 0
+}
+
+// attachRemoteSession attaches to a remote agent's tmux session over ssh -t.
+func (p *Plugin) attachRemoteSession(wt *Worktree) tea.Cmd {
+	host := p.hostFor(wt)
+	name := wt.Name
+	c := exec.Command("ssh", "-t", "--", host.Address, shellJoin([]string{"tmux", "attach-session", "-t", wt.Agent.TmuxSession}))
+	p.attachedSession = name
+	return tea.Sequence(
+		tea.Printf("\nAttaching to %s on %s. Press %s d to return to forge.\n", name, host.Name, getTmuxPrefix()),
+		tea.ExecProcess(c, func(err error) tea.Msg {
+			return TmuxAttachFinishedMsg{WorkspaceName: name, Err: err}
+		}),
+	)
+}
+
+// reconnectRemoteAgents picks up agent sessions already running on hosts.
+// Each host is asked once for its session list.
+func (p *Plugin) reconnectRemoteAgents() tea.Cmd {
+	byHost := make(map[string][]*Worktree)
+	for _, wt := range p.worktrees {
+		if wt.Host != "" && wt.Agent == nil {
+			byHost[wt.Host] = append(byHost[wt.Host], wt)
+		}
+	}
+	if len(byHost) == 0 {
+		return nil
+	}
+	epoch := p.ctx.Epoch
+	var cmds []tea.Cmd
+	for _, worktrees := range byHost {
+		worktrees := worktrees
+		host := p.hostFor(worktrees[0])
+		cmds = append(cmds, func() tea.Msg {
+			out, err := runRemote(host, "tmux", "list-sessions", "-F", "#{session_name}")
+			if err != nil {
+				return nil
+			}
+			running := make(map[string]bool)
+			for _, s := range strings.Split(out, "\n") {
+				running[strings.TrimSpace(s)] = true
+			}
+			var started []tea.Cmd
+			for _, wt := range worktrees {
+				session := tmuxSessionPrefix + sanitizeName(wt.Name)
+				if !running[session] {
+					continue
+				}
+				agentType := wt.ChosenAgentType
+				if agentType == "" || agentType == AgentNone {
+					agentType = AgentClaude
+				}
+				msg := AgentStartedMsg{Epoch: epoch, WorkspaceName: wt.Name, SessionName: session, AgentType: agentType, Reconnected: true}
+				started = append(started, func() tea.Msg { return msg })
+			}
+			if len(started) == 0 {
+				return nil
+			}
+			return tea.BatchMsg(started)
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// stopRemoteAgent interrupts and then kills a remote agent session.
+func (p *Plugin) stopRemoteAgent(wt *Worktree) tea.Cmd {
+	host := p.hostFor(wt)
+	name := wt.Name
+	sessionName := wt.Agent.TmuxSession
+	return func() tea.Msg {
+		_, _ = runRemote(host, "tmux", "send-keys", "-t", sessionName, "C-c")
+		time.Sleep(2 * time.Second)
+		_, _ = runRemote(host, "tmux", "kill-session", "-t", sessionName)
+		return AgentStoppedMsg{WorkspaceName: name}
+	}
+}
+
+// loadRemoteDiff loads a remote worktree's diff over ssh.
+func (p *Plugin) loadRemoteDiff(wt *Worktree) tea.Cmd {
+	epoch := p.ctx.Epoch
+	host := p.hostFor(wt)
+	name, wtPath := wt.Name, wt.Path
+	return func() tea.Msg {
+		raw, err := runRemote(host, "git", "-C", wtPath, "diff", "HEAD")
+		if err != nil {
+			return DiffErrorMsg{WorkspaceName: name, Err: err}
+		}
+		return DiffLoadedMsg{Epoch: epoch, WorkspaceName: name, Content: raw, Raw: raw}
+	}
+}
+
+// deleteRemoteWorktree kills the remote session, removes the worktree and
+// optionally its branch on the host, and drops the manifest entry.
+func (p *Plugin) deleteRemoteWorktree(wt *Worktree, deleteBranch bool) tea.Cmd {
+	host := p.hostFor(wt)
+	manifestPath := remoteManifestPath(p.ctx.WorkDir)
+	name, wtPath, branch := wt.Name, wt.Path, wt.Branch
+	return func() tea.Msg {
+		var warnings []string
+		_, _ = runRemote(host, "tmux", "kill-session", "-t", tmuxSessionPrefix+sanitizeName(name))
+
+		if _, err := runRemote(host, "git", "-C", host.RepoPath, "worktree", "remove", "--force", wtPath); err != nil {
+			if errors.Is(err, errRemoteUnreachable) {
+				return DeleteDoneMsg{Name: name, Err: err}
+			}
+			warnings = append(warnings, fmt.Sprintf("Worktree on %s: %v", host.Name, err))
+		}
+		if deleteBranch {
+			if _, err := runRemote(host, "git", "-C", host.RepoPath, "branch", "-D", branch); err != nil {
+				warnings = append(warnings, fmt.Sprintf("Branch on %s: %v", host.Name, err))
+			}
+		}
+
+		err := updateRemoteRecords(manifestPath, func(records []remoteWorktreeRecord) []remoteWorktreeRecord {
+			kept := records[:0]
+			for _, r := range records {
+				if r.Name != name {
+					kept = append(kept, r)
+				}
+			}
+			return kept
+		})
+		if err != nil {
+			return DeleteDoneMsg{Name: name, Err: fmt.Errorf("update %s: %w", remoteManifestFile, err)}
+		}
+		return DeleteDoneMsg{Name: name, Warnings: warnings}
+	}
+}
+
+// openRemoteCreate opens the modal for creating a worktree on a host.
+func (p *Plugin) openRemoteCreate() tea.Cmd {
+	hosts := p.remoteHosts()
+	if len(hosts) == 0 {
+		return appmsg.ShowToast("No remote hosts configured (plugins.workspace.hosts)", 3*time.Second)
+	}
+
+	nameInput := textinput.New()
+	nameInput.Placeholder = "feature-name"
+	nameInput.Focus()
+
+	baseInput := textinput.New()
+	baseInput.Placeholder = "main"
+	if wt := p.selectedWorktree(); wt != nil && wt.IsMain && wt.Branch != "" {
+		baseInput.SetValue(wt.Branch)
+	}
+
+	p.remoteCreateState = &RemoteCreateState{Hosts: hosts, NameInput: nameInput, BaseInput: baseInput}
+	p.clearRemoteCreateModal()
+	p.viewMode = ViewModeRemoteCreate
+	return textinput.Blink
+}
+
+// closeRemoteCreate closes the remote worktree modal.
+func (p *Plugin) closeRemoteCreate() {
+	p.remoteCreateState = nil
+	p.clearRemoteCreateModal()
+	p.viewMode = ViewModeList
+}
+
+// submitRemoteCreate validates the modal and starts the remote creation.
+func (p *Plugin) submitRemoteCreate() tea.Cmd {
+	s := p.remoteCreateState
+	if s == nil || s.InProgress {
+		return nil
+	}
+	name := strings.TrimSpace(s.NameInput.Value())
+	if name == "" {
+		s.Err = "Enter a workspace name"
+		return nil
+	}
+	if valid, errs, _ := ValidateBranchName(name); !valid {
+		s.Err = "Invalid branch name: " + strings.Join(errs, ", ")
+		return nil
+	}
+	// Names that differ only in characters tmux can't use would share a session
+	if wt := p.findWorktreeBySanitizedName(sanitizeName(name)); wt != nil {
+		s.Err = fmt.Sprintf("Workspace %q already exists", wt.Name)
+		return nil
+	}
+	base := strings.TrimSpace(s.BaseInput.Value())
+	if base == "" {
+		base = "main"
+	}
+	if valid, errs, _ := ValidateBranchName(base); !valid {
+		s.Err = "Invalid base branch: " + strings.Join(errs, ", ")
+		return nil
+	}
+	if s.HostIdx < 0 || s.HostIdx >= len(s.Hosts) {
+		s.HostIdx = 0
+	}
+	if host := s.Hosts[s.HostIdx]; !validSSHAddress(host.Address) {
+		s.Err = fmt.Sprintf("Host %s has no usable address", host.Name)
+		return nil
+	}
+	s.Err = ""
+	s.InProgress = true
+	return p.createRemoteWorktree(s.Hosts[s.HostIdx], name, base)
+}
+
+// applyRemoteCreated handles RemoteCreateDoneMsg.
+func (p *Plugin) applyRemoteCreated(msg RemoteCreateDoneMsg) tea.Cmd {
+	if msg.Err != nil {
+		if p.remoteCreateState != nil {
+			p.remoteCreateState.InProgress = false
+			p.remoteCreateState.Err = msg.Err.Error()
+			return nil
+		}
+		return appmsg.ShowToast(msg.Err.Error(), 4*time.Second)
+	}
+	p.closeRemoteCreate()
+	p.worktrees = append(p.worktrees, msg.Worktree)
+	p.shellSelected = false
+	p.selectedIdx = len(p.worktrees) - 1
+	p.diffContent = ""
+	p.diffRaw = ""
+	return appmsg.ShowToast(fmt.Sprintf("Created %s on %s; press s to start an agent", msg.Worktree.Name, msg.Worktree.Host), 3*time.Second)
+}
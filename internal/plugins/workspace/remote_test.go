@@ -0,0 +1,189 @@
+package workspace
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func newRemoteTestPlugin(t *testing.T) *Plugin {
+	t.Helper()
+	cfg := config.Default()
+	cfg.Plugins.Workspace.Hosts = []config.RemoteHost{
+		{Name: "box", Address: "dev@box", RepoPath: "/src/app", PollInterval: "5s"},
+	}
+	p := New()
+	p.ctx = &plugin.Context{Config: cfg, WorkDir: t.TempDir()}
+	return p
+}
+
+func TestRemoteManifestRoundTrip(t *testing.T) {
+	p := newRemoteTestPlugin(t)
+	manifest := remoteManifestPath(p.ctx.WorkDir)
+
+	add := func(name string) {
+		t.Helper()
+		err := updateRemoteRecords(manifest, func(records []remoteWorktreeRecord) []remoteWorktreeRecord {
+			return append(records, remoteWorktreeRecord{Name: name, Host: "box", Path: "/src/app-" + name, Branch: name})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	add("one")
+	add("two")
+	if err := saveRemoteAgentType(manifest, "two", AgentCodex); err != nil {
+		t.Fatal(err)
+	}
+
+	// A committed manifest can't choose the ssh destination
+	err := updateRemoteRecords(manifest, func(records []remoteWorktreeRecord) []remoteWorktreeRecord {
+		return append(records, remoteWorktreeRecord{Name: "evil", Host: "-oProxyCommand=touch pwned", Path: "/tmp"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wts := p.listRemoteWorktrees()
+	if len(wts) != 2 || wts[0].Name != "one" || wts[1].Host != "box" {
+		t.Fatalf("listRemoteWorktrees = %+v", wts)
+	}
+	if wts[1].ChosenAgentType != AgentCodex {
+		t.Errorf("agent type = %q, want codex", wts[1].ChosenAgentType)
+	}
+}
+
+func TestRemoteWorktreePath(t *testing.T) {
+	host := &config.RemoteHost{RepoPath: "/src/app"}
+	if got := remoteWorktreePath(host, "feat"); got != "/src/app-feat" {
+		t.Errorf("default dir: got %q", got)
+	}
+	host.WorktreeDir = "/scratch/wt"
+	if got := remoteWorktreePath(host, "feat"); got != "/scratch/wt/app-feat" {
+		t.Errorf("worktreeDir: got %q", got)
+	}
+}
+
+func TestRemotePollDelayAndBackoff(t *testing.T) {
+	p := newRemoteTestPlugin(t)
+	p.worktrees = []*Worktree{{Name: "local"}, {Name: "far", Host: "box"}, {Name: "gone", Host: "old"}}
+
+	if d := p.remotePollDelay("local", 500*time.Millisecond); d != 500*time.Millisecond {
+		t.Errorf("local delay = %v, want unchanged", d)
+	}
+	if d := p.remotePollDelay("far", 500*time.Millisecond); d != 5*time.Second {
+		t.Errorf("remote delay = %v, want host pollInterval 5s", d)
+	}
+	if d := p.remotePollDelay("far", 20*time.Second); d != 20*time.Second {
+		t.Errorf("longer delays are kept, got %v", d)
+	}
+	if d := p.remotePollDelay("gone", 0); d != defaultRemotePollInterval {
+		t.Errorf("unconfigured host delay = %v, want default", d)
+	}
+
+	if d := remoteBackoff(5*time.Second, 1); d != 5*time.Second {
+		t.Errorf("first retry = %v", d)
+	}
+	if d := remoteBackoff(5*time.Second, 3); d != 20*time.Second {
+		t.Errorf("third retry = %v", d)
+	}
+	if d := remoteBackoff(5*time.Second, 30); d != remoteMaxBackoff {
+		t.Errorf("backoff should cap at %v, got %v", remoteMaxBackoff, d)
+	}
+}
+
+func TestTmuxCmdRoutesRemoteOverSSH(t *testing.T) {
+	p := newRemoteTestPlugin(t)
+
+	local := p.tmuxCmd(&Worktree{Name: "a"}, "send-keys", "-t", "s", "y")
+	if local.Args[0] != "tmux" {
+		t.Errorf("local command = %v", local.Args)
+	}
+
+	remote := p.tmuxCmd(&Worktree{Name: "b", Host: "box"}, "send-keys", "-l", "-t", "s", "it's done")
+	args := remote.Args
+	if args[0] != "ssh" || args[len(args)-2] != "dev@box" {
+		t.Fatalf("remote command = %v", args)
+	}
+	want := `'tmux' 'send-keys' '-l' '-t' 's' 'it'"'"'s done'`
+	if args[len(args)-1] != want {
+		t.Errorf("remote command line = %s, want %s", args[len(args)-1], want)
+	}
+	if args[len(args)-3] != "--" {
+		t.Errorf("host must follow --: %v", args)
+	}
+	if gone := p.tmuxCmd(&Worktree{Name: "c", Host: "-oProxyCommand=x"}, "ls"); slices.Contains(gone.Args, "-oProxyCommand=x") {
+		t.Errorf("unconfigured host passed to ssh: %v", gone.Args)
+	}
+	if !strings.Contains(strings.Join(args, " "), "BatchMode=yes") {
+		t.Error("background ssh commands must not prompt")
+	}
+}
+
+func TestSubmitRemoteCreateValidates(t *testing.T) {
+	p := newRemoteTestPlugin(t)
+	p.worktrees = []*Worktree{{Name: "taken"}}
+	if cmd := p.openRemoteCreate(); cmd == nil || p.viewMode != ViewModeRemoteCreate {
+		t.Fatal("expected remote create modal to open")
+	}
+
+	if cmd := p.submitRemoteCreate(); cmd != nil || p.remoteCreateState.Err == "" {
+		t.Error("empty name should be rejected")
+	}
+	p.remoteCreateState.NameInput.SetValue("taken")
+	if cmd := p.submitRemoteCreate(); cmd != nil || !strings.Contains(p.remoteCreateState.Err, "already exists") {
+		t.Errorf("duplicate name should be rejected, err = %q", p.remoteCreateState.Err)
+	}
+	p.remoteCreateState.NameInput.SetValue("-rf")
+	if cmd := p.submitRemoteCreate(); cmd != nil || !strings.Contains(p.remoteCreateState.Err, "Invalid branch name") {
+		t.Errorf("invalid branch name should be rejected, err = %q", p.remoteCreateState.Err)
+	}
+	p.worktrees = append(p.worktrees, &Worktree{Name: "a.b"})
+	p.remoteCreateState.NameInput.SetValue("a/b")
+	if cmd := p.submitRemoteCreate(); cmd != nil || !strings.Contains(p.remoteCreateState.Err, "already exists") {
+		t.Errorf("name sharing a tmux session should be rejected, err = %q", p.remoteCreateState.Err)
+	}
+	p.remoteCreateState.NameInput.SetValue("fresh")
+	if cmd := p.submitRemoteCreate(); cmd == nil || !p.remoteCreateState.InProgress {
+		t.Error("valid input should start creation")
+	}
+
+	p.applyRemoteCreated(RemoteCreateDoneMsg{Err: errors.New("boom")})
+	if p.remoteCreateState.InProgress || p.remoteCreateState.Err != "boom" {
+		t.Error("failure should keep the modal open with the error")
+	}
+	p.applyRemoteCreated(RemoteCreateDoneMsg{Worktree: &Worktree{Name: "fresh", Host: "box"}})
+	if p.viewMode != ViewModeList || p.selectedWorktree().Name != "fresh" {
+		t.Error("success should close the modal and select the new worktree")
+	}
+}
+
+func TestOpenRemoteCreateWithoutHosts(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{Config: config.Default()}
+	if cmd := p.openRemoteCreate(); cmd == nil || p.viewMode == ViewModeRemoteCreate {
+		t.Error("expected a toast and no modal without configured hosts")
+	}
+}
+
+func TestRemotePollFailureBackoff(t *testing.T) {
+	p := newRemoteTestPlugin(t)
+	p.worktrees = []*Worktree{{Name: "far", Host: "box", Agent: &Agent{TmuxSession: "s"}}}
+
+	p.handleRemotePollFailed(RemotePollFailedMsg{WorkspaceName: "far", Host: "box", Err: errRemoteUnreachable})
+	p.handleRemotePollFailed(RemotePollFailedMsg{WorkspaceName: "far", Host: "box", Err: errRemoteUnreachable})
+	if !p.remoteUnreachable("far") || p.remoteFailures["far"] != 2 {
+		t.Fatalf("failures = %d", p.remoteFailures["far"])
+	}
+	if cmd := p.clearRemoteFailures("far"); cmd == nil {
+		t.Error("expected a reconnected toast")
+	}
+	if p.remoteUnreachable("far") {
+		t.Error("successful poll should clear the failure count")
+	}
+}
@@ -0,0 +1,95 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureRemoteCreateModal builds/rebuilds the remote worktree modal.
+func (p *Plugin) ensureRemoteCreateModal() {
+	s := p.remoteCreateState
+	if s == nil {
+		return
+	}
+
+	modalW := 70
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	if p.remoteCreateModal != nil && p.remoteCreateModalWidth == modalW {
+		return
+	}
+	p.remoteCreateModalWidth = modalW
+
+	m := modal.New("New Remote Workspace",
+		modal.WithWidth(modalW),
+		modal.WithPrimaryAction(remoteCreateButtonID),
+		modal.WithHints(false),
+	)
+	items := make([]modal.ListItem, len(s.Hosts))
+	for i, h := range s.Hosts {
+		items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", remoteHostItemPfx, i), Label: remoteHostLabel(h.Name, h.Address, h.RepoPath)}
+	}
+	m.AddSection(modal.Text("Host"))
+	m.AddSection(modal.List(remoteHostListID, items, &s.HostIdx, modal.WithMaxVisible(min(len(items), 5))))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.InputWithLabel(remoteNameInputID, "Name", &s.NameInput))
+	m.AddSection(modal.InputWithLabel(remoteBaseInputID, "Base branch", &s.BaseInput))
+	m.AddSection(p.remoteCreateStatusSection())
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Buttons(
+		modal.Btn(" Create ", remoteCreateButtonID, modal.BtnPrimary()),
+		modal.Btn(" Cancel ", remoteCancelButtonID),
+	))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("Tab: next field   ↑/↓: host   Enter: create   Esc: cancel")))
+	p.remoteCreateModal = m
+}
+
+// remoteHostLabel formats a host for the host list.
+func remoteHostLabel(name, address, repoPath string) string {
+	return fmt.Sprintf("%s  %s", name, dimText(address+":"+repoPath))
+}
+
+// clearRemoteCreateModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearRemoteCreateModal() {
+	p.remoteCreateModal = nil
+	p.remoteCreateModalWidth = 0
+}
+
+// remoteCreateStatusSection shows progress or why creation failed.
+func (p *Plugin) remoteCreateStatusSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		s := p.remoteCreateState
+		switch {
+		case s == nil:
+			return modal.RenderedSection{}
+		case s.InProgress:
+			return modal.RenderedSection{Content: dimText("Creating worktree over SSH...")}
+		case s.Err != "":
+			return modal.RenderedSection{Content: lipgloss.NewStyle().Foreground(styles.Current().Error).Width(contentWidth).Render(s.Err)}
+		}
+		return modal.RenderedSection{}
+	}, nil)
+}
+
+// renderRemoteCreateModal renders the remote worktree modal with dimmed background.
+func (p *Plugin) renderRemoteCreateModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureRemoteCreateModal()
+	if p.remoteCreateModal == nil {
+		return background
+	}
+
+	modalContent := p.remoteCreateModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
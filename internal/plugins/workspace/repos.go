@@ -0,0 +1,128 @@
+package workspace
+
+import (
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+// RepoEntry is a repository offered by the repo switcher.
+type RepoEntry struct {
+	Name string
+	Path string
+}
+
+// repoList returns the repositories the workspace plugin can manage: the
+// app's current project first, then plugins.workspace.repos, then
+// projects.list entries, without duplicates.
+func (p *Plugin) repoList() []RepoEntry {
+	if p.appCtx == nil {
+		return nil
+	}
+	var entries []RepoEntry
+	seen := make(map[string]int) // path -> index in entries
+	add := func(name, path string) {
+		if path == "" {
+			return
+		}
+		path = filepath.Clean(path)
+		if i, ok := seen[path]; ok {
+			if name != "" {
+				entries[i].Name = name // projects.list names win over directory names
+			}
+			return
+		}
+		seen[path] = len(entries)
+		if name == "" {
+			name = filepath.Base(path)
+		}
+		entries = append(entries, RepoEntry{Name: name, Path: path})
+	}
+
+	add("", p.appCtx.ProjectRoot)
+	if cfg := p.appCtx.Config; cfg != nil {
+		for _, path := range cfg.Plugins.Workspace.Repos {
+			add("", path)
+		}
+		for _, proj := range cfg.Projects.List {
+			add(proj.Name, proj.Path)
+		}
+	}
+	return entries
+}
+
+// multiRepo reports whether more than one repository is configured, which
+// enables the repo switcher.
+func (p *Plugin) multiRepo() bool {
+	return len(p.repoList()) > 1
+}
+
+// currentRepoName returns the display name of the repository being managed.
+func (p *Plugin) currentRepoName() string {
+	if p.ctx == nil {
+		return ""
+	}
+	current := filepath.Clean(p.ctx.ProjectRoot)
+	for _, e := range p.repoList() {
+		if e.Path == current {
+			return e.Name
+		}
+	}
+	return filepath.Base(current)
+}
+
+// openRepoSwitcher opens the repo switcher modal with the current repo
+// selected.
+func (p *Plugin) openRepoSwitcher() tea.Cmd {
+	p.repoEntries = p.repoList()
+	if len(p.repoEntries) < 2 {
+		return appmsg.ShowToast("Add repos to plugins.workspace.repos to switch repos", 2*time.Second)
+	}
+	p.repoIdx = 0
+	current := filepath.Clean(p.ctx.ProjectRoot)
+	for i, e := range p.repoEntries {
+		if e.Path == current {
+			p.repoIdx = i
+		}
+	}
+	p.clearRepoModal()
+	p.viewMode = ViewModeRepoSwitcher
+	return nil
+}
+
+// closeRepoSwitcher closes the repo switcher modal.
+func (p *Plugin) closeRepoSwitcher() {
+	p.repoEntries = nil
+	p.clearRepoModal()
+	p.viewMode = ViewModeList
+}
+
+// switchRepo reinitializes the workspace plugin for the repo at path,
+// leaving other plugins on the app's project. Returning to the app's
+// project reuses the shared context.
+func (p *Plugin) switchRepo(path string) tea.Cmd {
+	if p.appCtx == nil || filepath.Clean(path) == filepath.Clean(p.ctx.ProjectRoot) {
+		return nil
+	}
+	p.saveSelectionState()
+	p.Stop()
+
+	ctx := p.appCtx
+	if filepath.Clean(path) != filepath.Clean(p.appCtx.ProjectRoot) {
+		p.repoSwitches++
+		repoCtx := *p.appCtx
+		repoCtx.WorkDir = path
+		repoCtx.ProjectRoot = path
+		repoCtx.Epoch = p.appCtx.Epoch + p.repoSwitches
+		ctx = &repoCtx
+	}
+	p.selectedIdx = 0
+	p.scrollOffset = 0
+	p.previewOffset = 0
+	if err := p.initContext(ctx); err != nil {
+		return appmsg.ShowToast("Switch repo failed: "+err.Error(), 3*time.Second)
+	}
+	return tea.Batch(p.Start(), appmsg.ShowToast("Workspaces: "+p.currentRepoName(), 2*time.Second))
+}
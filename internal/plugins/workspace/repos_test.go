@@ -0,0 +1,80 @@
+package workspace
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func newMultiRepoPlugin(t *testing.T) (*Plugin, string, string) {
+	t.Helper()
+	app, other := t.TempDir(), t.TempDir()
+	cfg := config.Default()
+	cfg.Plugins.Workspace.Repos = []string{other, app + "/"}
+	cfg.Projects.List = []config.ProjectConfig{{Name: "Other", Path: other}}
+
+	p := New()
+	p.appCtx = &plugin.Context{WorkDir: app, ProjectRoot: app, Config: cfg, Epoch: 3}
+	p.ctx = p.appCtx
+	return p, app, other
+}
+
+func TestRepoList_DedupesInOrder(t *testing.T) {
+	p, app, other := newMultiRepoPlugin(t)
+
+	repos := p.repoList()
+	if len(repos) != 2 || repos[0].Path != app || repos[1].Path != other {
+		t.Fatalf("repos = %+v", repos)
+	}
+	if !p.multiRepo() {
+		t.Error("expected multi-repo mode")
+	}
+
+	p.appCtx.Config = config.Default()
+	if p.multiRepo() {
+		t.Error("single repo should not enable the switcher")
+	}
+}
+
+func TestSwitchRepo(t *testing.T) {
+	p, app, other := newMultiRepoPlugin(t)
+
+	if cmd := p.switchRepo(other); cmd == nil {
+		t.Fatal("expected start command")
+	}
+	if p.ctx == p.appCtx || p.ctx.WorkDir != other || p.ctx.ProjectRoot != other {
+		t.Fatalf("ctx = %+v, want copy rooted at %s", p.ctx, other)
+	}
+	if p.ctx.Epoch == p.appCtx.Epoch {
+		t.Error("repo context should use a new epoch so stale messages are dropped")
+	}
+	if p.appCtx.WorkDir != app {
+		t.Error("app context must not change")
+	}
+	if p.currentRepoName() != "Other" {
+		t.Errorf("current repo = %q, want configured name", p.currentRepoName())
+	}
+
+	p.switchRepo(app)
+	if p.ctx != p.appCtx {
+		t.Error("switching back should reuse the app context")
+	}
+}
+
+func TestRepoSwitcherKeys(t *testing.T) {
+	p, _, other := newMultiRepoPlugin(t)
+
+	p.openRepoSwitcher()
+	p.width, p.height = 100, 30
+	p.renderRepoModal(p.width, p.height)
+	if p.viewMode != ViewModeRepoSwitcher || p.repoIdx != 0 {
+		t.Fatalf("mode=%v idx=%d", p.viewMode, p.repoIdx)
+	}
+	p.handleRepoSwitcherKeys(tea.KeyMsg{Type: tea.KeyDown})
+	p.handleRepoSwitcherKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if p.viewMode != ViewModeList || p.ctx.ProjectRoot != other {
+		t.Errorf("after enter: mode=%v root=%s", p.viewMode, p.ctx.ProjectRoot)
+	}
+}
@@ -0,0 +1,68 @@
+package workspace
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/wilbur182/forge/internal/modal"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+// ensureRepoModal builds/rebuilds the repo switcher modal.
+func (p *Plugin) ensureRepoModal() {
+	if len(p.repoEntries) == 0 {
+		return
+	}
+
+	modalW := 60
+	if p.width > 0 && modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	// Only rebuild if modal doesn't exist or width changed
+	if p.repoModal != nil && p.repoModalWidth == modalW {
+		return
+	}
+	p.repoModalWidth = modalW
+
+	current := filepath.Clean(p.ctx.ProjectRoot)
+	items := make([]modal.ListItem, len(p.repoEntries))
+	for i, e := range p.repoEntries {
+		label := e.Name + "  " + dimText(e.Path)
+		if e.Path == current {
+			label = e.Name + " (current)  " + dimText(e.Path)
+		}
+		items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", repoItemPfx, i), Label: label}
+	}
+
+	m := modal.New("Switch Repo",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	)
+	m.AddSection(modal.List(repoListID, items, &p.repoIdx, modal.WithMaxVisible(min(len(items), 10))))
+	m.AddSection(modal.Spacer())
+	m.AddSection(modal.Text(dimText("↑/↓: select   Enter: switch   Esc: cancel")))
+	p.repoModal = m
+}
+
+// clearRepoModal invalidates the cached modal so it rebuilds next frame.
+func (p *Plugin) clearRepoModal() {
+	p.repoModal = nil
+	p.repoModalWidth = 0
+}
+
+// renderRepoModal renders the repo switcher modal with dimmed background.
+func (p *Plugin) renderRepoModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureRepoModal()
+	if p.repoModal == nil {
+		return background
+	}
+
+	modalContent := p.repoModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
@@ -22,6 +22,7 @@ var sidecarGitignoreEntries = []string{
 	".forge-pr",
 	".forge-start.sh",
 	".forge-base",
+	".forge-env",
 	".td-root",
 }
 
@@ -199,15 +200,7 @@ func (p *Plugin) runSetupScript(worktreePath, branchName string) error {
 	cmd := exec.Command("bash", scriptPath)
 	cmd.Dir = worktreePath
 
-	// Build isolated environment with overrides applied
-	isolatedEnv := ApplyEnvOverrides(os.Environ(), BuildEnvOverrides(p.ctx.WorkDir))
-
-	// Add worktree-specific variables
-	cmd.Env = append(isolatedEnv,
-		"MAIN_WORKTREE="+p.ctx.WorkDir,
-		"WORKTREE_BRANCH="+branchName,
-		"WORKTREE_PATH="+worktreePath,
-	)
+	cmd.Env = p.setupEnv(worktreePath, branchName)
 
 	// Capture output for logging
 	output, err := cmd.CombinedOutput()
@@ -226,6 +219,18 @@ func (p *Plugin) runSetupScript(worktreePath, branchName string) error {
 	return nil
 }
 
+// setupEnv returns the environment for setup scripts and post-create hooks:
+// the isolated worktree environment plus the main worktree path, branch
+// name, and worktree path.
+func (p *Plugin) setupEnv(worktreePath, branchName string) []string {
+	isolatedEnv := ApplyEnvOverrides(os.Environ(), BuildEnvOverrides(p.ctx.WorkDir))
+	return append(isolatedEnv,
+		"MAIN_WORKTREE="+p.ctx.WorkDir,
+		"WORKTREE_BRANCH="+branchName,
+		"WORKTREE_PATH="+worktreePath,
+	)
+}
+
 // ensureSidecarGitignore ensures sidecar worktree files are in .gitignore.
 // This prevents .forge-agent, .forge-task, and .td-root from being
 // accidentally committed when using the worktree commit workflow.
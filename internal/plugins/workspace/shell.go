@@ -130,6 +130,8 @@ type (
 		Err         error     // Non-nil if creation failed
 		AgentType   AgentType // td-16b2b5: Agent to start (AgentNone if plain shell)
 		SkipPerms   bool      // td-16b2b5: Whether to skip permissions for agent
+		Adopted     bool      // Pre-existing tmux session adopted from the discover view
+		Restored    bool      // Shell brought back by undo; its agent is already running
 	}
 
 	// ShellDetachedMsg signals user detached from shell session
@@ -139,7 +141,8 @@ type (
 
 	// ShellKilledMsg signals shell session was terminated
 	ShellKilledMsg struct {
-		SessionName string // tmux session name that was killed
+		SessionName string     // tmux session name that was killed
+		Undo        *undoEntry // Set when the session was moved to the trash instead
 	}
 
 	// ShellSessionDeadMsg signals shell session was externally terminated
@@ -216,7 +219,7 @@ func (p *Plugin) initShellSessions() {
 	// Process manifest entries (if manifest exists)
 	if p.shellManifest != nil {
 		for _, def := range p.shellManifest.Shells {
-			isRunning := tmuxMap[def.TmuxName]
+			isRunning := tmuxMap[def.TmuxName] || (def.Adopted && sessionExists(def.TmuxName))
 			if !isRunning {
 				// Tmux session is dead - remove stale entry from manifest
 				_ = p.shellManifest.RemoveShell(def.TmuxName)
@@ -253,6 +256,7 @@ func (p *Plugin) shellFromDefinition(def ShellDefinition, isRunning bool) *Shell
 		ChosenAgent: definitionToAgentType(def.AgentType),
 		SkipPerms:   def.SkipPerms,
 		IsOrphaned:  !isRunning,
+		Adopted:     def.Adopted,
 	}
 
 	if isRunning {
@@ -388,7 +392,7 @@ func (p *Plugin) applyManifestSync() {
 	// Process manifest entries - add new or update existing
 	var newShells []*ShellSession
 	for _, def := range p.shellManifest.Shells {
-		isRunning := tmuxMap[def.TmuxName]
+		isRunning := tmuxMap[def.TmuxName] || (def.Adopted && sessionExists(def.TmuxName))
 
 		if existing, ok := currentShells[def.TmuxName]; ok {
 			// Update existing shell
@@ -29,6 +29,7 @@ type ShellDefinition struct {
 	CreatedAt   time.Time `json:"createdAt"`
 	AgentType   string    `json:"agentType,omitempty"`
 	SkipPerms   bool      `json:"skipPerms,omitempty"`
+	Adopted     bool      `json:"adopted,omitempty"`
 }
 
 // manifestVersion is the current manifest format version.
@@ -237,6 +238,7 @@ func shellToDefinition(shell *ShellSession) ShellDefinition {
 		CreatedAt:   shell.CreatedAt,
 		AgentType:   agentType,
 		SkipPerms:   shell.SkipPerms,
+		Adopted:     shell.Adopted,
 	}
 }
 
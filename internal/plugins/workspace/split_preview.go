@@ -0,0 +1,120 @@
+package workspace
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/state"
+	"github.com/wilbur182/forge/internal/styles"
+	"github.com/wilbur182/forge/internal/ui"
+)
+
+const (
+	// splitPreviewMinWidth is the narrowest preview that still shows output and
+	// diff side by side; below it the panes are stacked instead.
+	splitPreviewMinWidth = 100
+
+	// splitDiffRefreshInterval throttles diff reloads driven by agent output.
+	splitDiffRefreshInterval = 2 * time.Second
+)
+
+// splitPreviewActive reports whether the Output tab should render the live
+// diff alongside agent output.
+func (p *Plugin) splitPreviewActive() bool {
+	if !p.splitPreview || p.shellSelected || p.previewTab != PreviewTabOutput {
+		return false
+	}
+	// Interactive mode maps cursor and pane size onto the full preview width.
+	if p.viewMode == ViewModeInteractive {
+		return false
+	}
+	wt := p.selectedWorktree()
+	return wt != nil && !wt.IsMain
+}
+
+// toggleSplitPreview flips the split layout and persists the preference.
+func (p *Plugin) toggleSplitPreview() tea.Cmd {
+	p.splitPreview = !p.splitPreview
+	_ = state.SetWorkspaceSplitPreview(p.splitPreview)
+	p.splitDiffLoadedAt = time.Time{}
+	if !p.splitPreview {
+		return nil
+	}
+	p.splitDiffLoadedAt = time.Now()
+	return p.loadSelectedDiff()
+}
+
+// maybeRefreshSplitDiff reloads the selected worktree's diff while the split
+// preview is showing it, at most once per splitDiffRefreshInterval.
+func (p *Plugin) maybeRefreshSplitDiff(worktreeName string) tea.Cmd {
+	if !p.splitPreviewActive() {
+		return nil
+	}
+	wt := p.selectedWorktree()
+	if wt == nil || wt.Name != worktreeName {
+		return nil
+	}
+	if time.Since(p.splitDiffLoadedAt) < splitDiffRefreshInterval {
+		return nil
+	}
+	p.splitDiffLoadedAt = time.Now()
+	return p.loadWorktreeDiff(wt)
+}
+
+// renderSplitPreview renders agent output and the worktree diff together.
+// Output keeps the left (or top) position so mouse selection coordinates
+// line up with the single-pane layout.
+func (p *Plugin) renderSplitPreview(width, height int) string {
+	dividerStyle := lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
+	if width >= splitPreviewMinWidth {
+		leftW := (width - 1) / 2
+		rightW := width - leftW - 1
+		left := fitPane(p.renderOutputContent(leftW, height), leftW, height)
+		right := fitPane(p.renderSplitDiff(rightW, height), rightW, height)
+		divider := strings.TrimSuffix(strings.Repeat(dividerStyle.Render("│")+"\n", height), "\n")
+		return lipgloss.JoinHorizontal(lipgloss.Top, left, divider, right)
+	}
+
+	topH := (height - 1) / 2
+	bottomH := height - topH - 1
+	if topH < 1 || bottomH < 1 {
+		return p.renderOutputContent(width, height)
+	}
+	top := fitPane(p.renderOutputContent(width, topH), width, topH)
+	bottom := p.renderSplitDiff(width, bottomH)
+	return top + "\n" + dividerStyle.Render(strings.Repeat("─", width)) + "\n" + bottom
+}
+
+// renderSplitDiff renders the diff pane from the top. The preview scroll
+// offset belongs to the output pane in split mode.
+func (p *Plugin) renderSplitDiff(width, height int) string {
+	saved := p.previewOffset
+	p.previewOffset = 0
+	defer func() { p.previewOffset = saved }()
+	return p.renderDiffContent(width, height)
+}
+
+// fitPane clips content to exactly height lines of exactly width cells so
+// panes can be joined without ragged edges.
+func fitPane(content string, width, height int) string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	for i, line := range lines {
+		line = ui.ExpandTabs(line, tabStopWidth)
+		if w := lipgloss.Width(line); w > width {
+			line = ansi.Truncate(line, width, "")
+		} else if w < width {
+			line += strings.Repeat(" ", width-w)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,99 @@
+package workspace
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func newSplitTestPlugin() *Plugin {
+	p := New()
+	p.ctx = &plugin.Context{}
+	p.width, p.height = 160, 30
+	p.worktrees = []*Worktree{{Name: "feat", Path: "/tmp/feat"}}
+	p.splitPreview = true
+	p.diffRaw = "diff --git a/x.go b/x.go\n"
+	p.diffContent = p.diffRaw
+	return p
+}
+
+func TestSplitPreviewActive(t *testing.T) {
+	p := newSplitTestPlugin()
+	if !p.splitPreviewActive() {
+		t.Fatal("expected split preview on the Output tab")
+	}
+	p.previewTab = PreviewTabDiff
+	if p.splitPreviewActive() {
+		t.Error("split preview should only apply to the Output tab")
+	}
+	p.previewTab = PreviewTabOutput
+	p.viewMode = ViewModeInteractive
+	if p.splitPreviewActive() {
+		t.Error("split preview should be off in interactive mode")
+	}
+	p.viewMode = ViewModeList
+	p.worktrees[0].IsMain = true
+	if p.splitPreviewActive() {
+		t.Error("split preview should be off for the main worktree")
+	}
+}
+
+func TestRenderSplitPreviewLayouts(t *testing.T) {
+	p := newSplitTestPlugin()
+
+	wide := p.renderSplitPreview(120, 8)
+	lines := strings.Split(wide, "\n")
+	if len(lines) != 8 {
+		t.Fatalf("side-by-side height = %d, want 8", len(lines))
+	}
+	for i, line := range lines {
+		if w := lipgloss.Width(line); w != 120 {
+			t.Errorf("line %d width = %d, want 120", i, w)
+		}
+	}
+	if !strings.Contains(lines[0], "No agent running") || !strings.Contains(wide, "│") {
+		t.Errorf("side-by-side render = %q", wide)
+	}
+
+	narrow := p.renderSplitPreview(60, 9)
+	if !strings.Contains(narrow, strings.Repeat("─", 60)) {
+		t.Errorf("stacked render missing divider: %q", narrow)
+	}
+	if strings.Index(narrow, "No agent running") > strings.Index(narrow, "─") {
+		t.Error("stacked render should put output above the diff")
+	}
+}
+
+func TestRenderSplitDiffKeepsOutputOffset(t *testing.T) {
+	p := newSplitTestPlugin()
+	p.previewOffset = 5
+	_ = p.renderSplitDiff(60, 5)
+	if p.previewOffset != 5 {
+		t.Errorf("previewOffset = %d, want 5 restored", p.previewOffset)
+	}
+}
+
+func TestMaybeRefreshSplitDiffThrottles(t *testing.T) {
+	p := newSplitTestPlugin()
+	if cmd := p.maybeRefreshSplitDiff("other"); cmd != nil {
+		t.Error("output from an unselected worktree should not reload the diff")
+	}
+	if cmd := p.maybeRefreshSplitDiff("feat"); cmd == nil {
+		t.Fatal("expected a diff reload for the selected worktree")
+	}
+	if cmd := p.maybeRefreshSplitDiff("feat"); cmd != nil {
+		t.Error("expected the second reload to be throttled")
+	}
+	p.splitDiffLoadedAt = time.Now().Add(-splitDiffRefreshInterval)
+	if cmd := p.maybeRefreshSplitDiff("feat"); cmd == nil {
+		t.Error("expected a reload once the interval has passed")
+	}
+	p.splitPreview = false
+	p.splitDiffLoadedAt = time.Time{}
+	if cmd := p.maybeRefreshSplitDiff("feat"); cmd != nil {
+		t.Error("no reload when split preview is off")
+	}
+}
@@ -0,0 +1,155 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+// defaultTaskBranchPattern finds a td task ID as a whole segment of a branch
+// name, e.g. "td-a1b2-login" or "feature/td-a1b2".
+const defaultTaskBranchPattern = `(?:^|[/_-])(td-[0-9a-f]{4,})(?:$|[/_-])`
+
+var defaultTaskBranchRegexp = regexp.MustCompile(defaultTaskBranchPattern)
+
+// TaskMergedMsg reports the td status update for a worktree's linked task
+// after its branch was merged.
+type TaskMergedMsg struct {
+	WorkspaceName string
+	TaskID        string
+	Err           error
+}
+
+// loadTaskBranchPattern compiles the configured task ID pattern once per
+// config load, falling back to the default when it is unset, invalid, or
+// has no capture group.
+func (p *Plugin) loadTaskBranchPattern() {
+	p.taskBranchRe = defaultTaskBranchRegexp
+	if p.ctx == nil || p.ctx.Config == nil {
+		return
+	}
+	pattern := p.ctx.Config.Plugins.Workspace.TaskBranchPattern
+	if pattern == "" {
+		return
+	}
+	re, err := regexp.Compile(pattern)
+	if err == nil && re.NumSubexp() == 0 {
+		err = errors.New("no capture group")
+	}
+	if err != nil {
+		if p.ctx.Logger != nil {
+			p.ctx.Logger.Warn("invalid taskBranchPattern, using default", "pattern", pattern, "error", err)
+		}
+		return
+	}
+	p.taskBranchRe = re
+}
+
+// taskBranchRegexp returns the task ID pattern compiled by
+// loadTaskBranchPattern.
+func (p *Plugin) taskBranchRegexp() *regexp.Regexp {
+	if p.taskBranchRe == nil {
+		return defaultTaskBranchRegexp
+	}
+	return p.taskBranchRe
+}
+
+// autoLinkTasksEnabled reports whether branch-name task linking is on.
+func (p *Plugin) autoLinkTasksEnabled() bool {
+	return p.ctx != nil && p.ctx.Config != nil && p.ctx.Config.Plugins.Workspace.AutoLinkTasks
+}
+
+// taskIDFromBranch extracts a task ID from a branch name, or "" if none.
+func taskIDFromBranch(re *regexp.Regexp, branch string) string {
+	m := re.FindStringSubmatch(branch)
+	if len(m) < 2 {
+		return ""
+	}
+	return m[1]
+}
+
+// taskLinkFileExists reports whether the worktree has a .forge-task file.
+// An empty file records that the user unlinked the task, which keeps the
+// branch name from linking it again.
+func taskLinkFileExists(worktreePath string) bool {
+	_, err := os.Stat(filepath.Join(worktreePath, forgeTaskFile))
+	return err == nil
+}
+
+// autoLinkTask returns a command linking wt to the task named in its branch.
+// Each worktree/task pair is tried once per session so a missing task does
+// not cost a td call on every refresh.
+func (p *Plugin) autoLinkTask(wt *Worktree) tea.Cmd {
+	if !p.autoLinkTasksEnabled() || wt.IsMain || wt.TaskID != "" || wt.Branch == "" {
+		return nil
+	}
+	if taskLinkFileExists(wt.Path) {
+		return nil
+	}
+	taskID := taskIDFromBranch(p.taskBranchRegexp(), wt.Branch)
+	if taskID == "" {
+		return nil
+	}
+	key := wt.Name + "\x00" + taskID
+	if p.autoLinkAttempted[key] {
+		return nil
+	}
+	p.autoLinkAttempted[key] = true
+
+	link := p.linkTask(wt, taskID)
+	return func() tea.Msg {
+		msg := link().(TaskLinkedMsg)
+		msg.Auto = true
+		return msg
+	}
+}
+
+// completeMergedTask returns a command that moves the linked task through
+// review and approves it once the worktree's branch has been merged.
+func (p *Plugin) completeMergedTask(wt *Worktree) tea.Cmd {
+	if wt == nil || wt.TaskID == "" || !p.autoLinkTasksEnabled() {
+		return nil
+	}
+	name, taskID, workDir := wt.Name, wt.TaskID, p.ctx.WorkDir
+	return func() tea.Msg {
+		// Submitting for review fails harmlessly when the task is already
+		// in review; approve is what closes it.
+		review := exec.Command("td", "review", taskID)
+		review.Dir = workDir
+		_ = review.Run()
+
+		approve := exec.Command("td", "approve", taskID)
+		approve.Dir = workDir
+		if out, err := approve.CombinedOutput(); err != nil {
+			detail := strings.TrimSpace(string(out))
+			if detail == "" {
+				detail = err.Error()
+			}
+			return TaskMergedMsg{WorkspaceName: name, TaskID: taskID, Err: fmt.Errorf("td approve %s: %s", taskID, detail)}
+		}
+		return TaskMergedMsg{WorkspaceName: name, TaskID: taskID}
+	}
+}
+
+// autoLinkToast announces a task linked from a branch name.
+func autoLinkToast(taskID, branch string) tea.Cmd {
+	return appmsg.ShowToast(fmt.Sprintf("Linked %s from branch %s", taskID, branch), 3*time.Second)
+}
+
+// taskMergedToast reports the outcome of a TaskMergedMsg.
+func taskMergedToast(msg TaskMergedMsg) tea.Cmd {
+	if msg.Err != nil {
+		return func() tea.Msg {
+			return appmsg.ToastMsg{Message: msg.Err.Error(), Duration: 4 * time.Second, IsError: true}
+		}
+	}
+	return appmsg.ShowToast(fmt.Sprintf("Closed %s after merge", msg.TaskID), 3*time.Second)
+}
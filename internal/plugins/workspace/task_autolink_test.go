@@ -0,0 +1,116 @@
+package workspace
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestTaskIDFromBranch(t *testing.T) {
+	re := regexp.MustCompile(defaultTaskBranchPattern)
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{"td-1234-login", "td-1234"},
+		{"td-a1b2c3", "td-a1b2c3"},
+		{"feature/td-beef-fix-tests", "td-beef"},
+		{"wip_td-018f25", "td-018f25"},
+		{"main", ""},
+		{"td-12", ""},         // too short to be a task ID
+		{"std-1234-x", ""},    // not a whole segment
+		{"td-xyz1-login", ""}, // not hex
+	}
+	for _, tt := range tests {
+		if got := taskIDFromBranch(re, tt.branch); got != tt.want {
+			t.Errorf("taskIDFromBranch(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+}
+
+func TestTaskBranchRegexpConfig(t *testing.T) {
+	cfg := config.Default()
+	p := New()
+	p.ctx = &plugin.Context{Config: cfg}
+
+	cfg.Plugins.Workspace.TaskBranchPattern = `^(PROJ-\d+)`
+	p.loadTaskBranchPattern()
+	if got := taskIDFromBranch(p.taskBranchRegexp(), "PROJ-42-login"); got != "PROJ-42" {
+		t.Errorf("custom pattern: got %q, want PROJ-42", got)
+	}
+
+	// Patterns without a capture group fall back to the default
+	cfg.Plugins.Workspace.TaskBranchPattern = `PROJ-\d+`
+	p.loadTaskBranchPattern()
+	if got := taskIDFromBranch(p.taskBranchRegexp(), "td-1234-x"); got != "td-1234" {
+		t.Errorf("fallback pattern: got %q, want td-1234", got)
+	}
+
+	// An invalid pattern warns when loaded, not on every refresh
+	var logs bytes.Buffer
+	p.ctx.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+	cfg.Plugins.Workspace.TaskBranchPattern = `(td-`
+	p.loadTaskBranchPattern()
+	for range 3 {
+		taskIDFromBranch(p.taskBranchRegexp(), "td-1234-x")
+	}
+	if n := strings.Count(logs.String(), "invalid taskBranchPattern"); n != 1 {
+		t.Errorf("warned %d times, want 1", n)
+	}
+}
+
+func TestAutoLinkTask(t *testing.T) {
+	cfg := config.Default()
+	p := New()
+	p.ctx = &plugin.Context{Config: cfg}
+	dir := t.TempDir()
+	wt := &Worktree{Name: "login", Path: dir, Branch: "td-1234-login"}
+
+	if cmd := p.autoLinkTask(wt); cmd == nil {
+		t.Fatal("expected a link command for a task branch")
+	}
+	if cmd := p.autoLinkTask(wt); cmd != nil {
+		t.Error("expected a single attempt per worktree and task")
+	}
+
+	other := &Worktree{Name: "other", Path: t.TempDir(), Branch: "td-5678-x"}
+	cfg.Plugins.Workspace.AutoLinkTasks = false
+	if cmd := p.autoLinkTask(other); cmd != nil {
+		t.Error("auto-linking disabled in config")
+	}
+	cfg.Plugins.Workspace.AutoLinkTasks = true
+
+	// An emptied .forge-task means the user unlinked the task
+	if err := os.WriteFile(filepath.Join(other.Path, forgeTaskFile), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := p.autoLinkTask(other); cmd != nil {
+		t.Error("unlinked worktree should not be linked again")
+	}
+	if loadTaskLink(other.Path) != "" {
+		t.Error("empty .forge-task should load as no task")
+	}
+
+	linked := &Worktree{Name: "linked", Path: t.TempDir(), Branch: "td-9999-x", TaskID: "td-aaaa"}
+	if cmd := p.autoLinkTask(linked); cmd != nil {
+		t.Error("already linked worktree should be left alone")
+	}
+}
+
+func TestCompleteMergedTaskSkipsUnlinked(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{Config: config.Default()}
+	if cmd := p.completeMergedTask(&Worktree{Name: "x"}); cmd != nil {
+		t.Error("no td update expected without a linked task")
+	}
+	if cmd := p.completeMergedTask(&Worktree{Name: "x", TaskID: "td-1234"}); cmd == nil {
+		t.Error("expected a td update for a linked task")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/wilbur182/forge/internal/icons"
 )
 
 // mouseEscapeRegex matches SGR mouse escape sequences like \x1b[<35;192;47M or \x1b[<0;50;20m
@@ -38,6 +40,15 @@ const (
 	ViewModeFilePicker                     // Diff file picker modal
 	ViewModeInteractive                    // Interactive mode (tmux input passthrough)
 	ViewModeFetchPR                        // Fetch remote PR modal
+	ViewModeRebase                         // Rebase workflow modal
+	ViewModeJanitor                        // Stale worktree cleanup modal
+	ViewModeRepoSwitcher                   // Repo switcher modal
+	ViewModeEnvEditor                      // Per-worktree env editor modal
+	ViewModeBroadcast                      // Broadcast prompt to agents modal
+	ViewModePorts                          // Listening ports modal
+	ViewModeDiscover                       // Discover external tmux sessions modal
+	ViewModeRemoteCreate                   // Create worktree on a remote host modal
+	ViewModeBulkCreate                     // Create worktrees from td tasks modal
 )
 
 // FocusPane represents which pane is active in the split view.
@@ -55,8 +66,12 @@ const (
 	PreviewTabOutput PreviewTab = iota // Agent output
 	PreviewTabDiff                     // Git diff
 	PreviewTabTask                     // TD task info
+	PreviewTabCI                       // GitHub Actions run logs
 )
 
+// previewTabCount is the number of preview tabs.
+const previewTabCount = 4
+
 // DiffViewMode specifies the diff rendering mode.
 type DiffViewMode int
 
@@ -101,17 +116,17 @@ func (s WorktreeStatus) String() string {
 func (s WorktreeStatus) Icon() string {
 	switch s {
 	case StatusPaused:
-		return "⏸"
+		return icons.Get(icons.Paused)
 	case StatusActive:
-		return "●"
+		return icons.Get(icons.Dot)
 	case StatusThinking:
-		return "◐"
+		return icons.Get(icons.Thinking)
 	case StatusWaiting:
-		return "⧗"
+		return icons.Get(icons.Waiting)
 	case StatusDone:
-		return "✓"
+		return icons.Get(icons.Check)
 	case StatusError:
-		return "✗"
+		return icons.Get(icons.Cross)
 	default:
 		return "?"
 	}
@@ -178,6 +193,14 @@ var AgentCommands = map[AgentType]string{
 	AgentPi:       "pi",
 }
 
+// AgentResumeCommands maps agent types to commands that continue the most
+// recent session in the working directory.
+var AgentResumeCommands = map[AgentType]string{
+	AgentClaude:   "claude --continue",
+	AgentCodex:    "codex resume --last",
+	AgentOpenCode: "opencode --continue",
+}
+
 // AgentTypeOrder defines the order of agents in selection UI.
 var AgentTypeOrder = []AgentType{
 	AgentClaude,
@@ -222,15 +245,20 @@ type Worktree struct {
 	TaskID          string         // Linked td task (e.g., "td-a1b2")
 	TaskTitle       string         // Task title (used as fallback if td show fails)
 	PRURL           string         // URL of open PR (if any)
+	PR              *PRStatus      // GitHub PR status (nil until fetched or when no PR)
+	Hooks           *HookRun       // Post-create hook run (nil when none ran this session)
 	ChosenAgentType AgentType      // Agent selected at creation (persists even when agent not running)
 	Agent           *Agent         // nil if no agent running
 	Status          WorktreeStatus // Derived from agent state
 	Stats           *GitStats      // +/- line counts
+	Usage           *DiskUsage     // Disk usage scan (nil until scanned)
+	AgentCost       *AgentCost     // Cumulative agent tokens and spend (nil until computed or when none)
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	IsOrphaned      bool // True if agent file exists but tmux session is gone
 	IsMain          bool // True if this is the primary/main worktree (project root)
 	IsMissing       bool // True if worktree directory no longer exists (detected via os.Stat or git prunable)
+	Host            string // Remote host name from config; empty for local worktrees
 }
 
 // ShellSession represents a tmux shell session (not tied to a git worktree).
@@ -242,6 +270,7 @@ type ShellSession struct {
 	ChosenAgent AgentType // td-317b64: Agent type selected at creation (AgentNone for plain shell)
 	SkipPerms   bool      // td-317b64: Whether skip permissions was enabled
 	IsOrphaned  bool      // td-f88fdd: True if manifest entry exists but tmux session is gone
+	Adopted     bool      // Pre-existing tmux session adopted via discover; not killed on delete
 }
 
 // Agent represents an AI coding agent process.
@@ -0,0 +1,464 @@
+package workspace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	appmsg "github.com/wilbur182/forge/internal/msg"
+)
+
+const (
+	// undoTrashDir holds deleted items under the repository's git directory,
+	// which keeps renames on one filesystem and out of every worktree.
+	undoTrashDir = "forge-trash"
+	undoMetaFile = "meta.json"
+
+	// undoSweepInterval is how often expired trash is purged.
+	undoSweepInterval = 30 * time.Second
+
+	// shellTrashPrefix renames a deleted shell's tmux session so it is no
+	// longer picked up as a live shell while it waits in the trash.
+	shellTrashPrefix = "forge-trash-"
+)
+
+// errUndoUnavailable means the item could not be moved to the trash and
+// should be deleted outright.
+var errUndoUnavailable = errors.New("undo unavailable")
+
+// undoKind identifies what an undo entry restores.
+type undoKind string
+
+const (
+	undoKindWorktree undoKind = "worktree"
+	undoKindShell    undoKind = "shell"
+)
+
+// undoEntry records a deleted worktree or shell while it can still be
+// restored. Entries are stored on disk so undo survives a restart.
+type undoEntry struct {
+	ID        string    `json:"id"`
+	Kind      undoKind  `json:"kind"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// Worktree: original locations and the refs needed to recreate branches
+	Path      string `json:"path,omitempty"`
+	AdminDir  string `json:"adminDir,omitempty"` // .git/worktrees/<id>
+	Branch    string `json:"branch,omitempty"`
+	BranchSHA string `json:"branchSha,omitempty"` // set when the local branch was deleted
+	RemoteSHA string `json:"remoteSha,omitempty"` // set when the remote branch was deleted
+
+	// Shell
+	TmuxName    string    `json:"tmuxName,omitempty"`
+	ChosenAgent AgentType `json:"chosenAgent,omitempty"`
+	SkipPerms   bool      `json:"skipPerms,omitempty"`
+	Adopted     bool      `json:"adopted,omitempty"`
+
+	dir string // trash directory for this entry
+}
+
+// trashSession is the tmux session name a deleted shell waits under.
+func (e *undoEntry) trashSession() string {
+	return shellTrashPrefix + e.TmuxName
+}
+
+// UndoEntriesLoadedMsg delivers trash entries found on disk at startup.
+type UndoEntriesLoadedMsg struct {
+	Epoch   uint64
+	Entries []*undoEntry
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m UndoEntriesLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// undoSweepMsg triggers a purge of expired trash.
+type undoSweepMsg struct {
+	Epoch uint64
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m undoSweepMsg) GetEpoch() uint64 { return m.Epoch }
+
+// UndoDoneMsg reports the result of restoring an undo entry.
+type UndoDoneMsg struct {
+	Entry    *undoEntry
+	Shell    *ShellCreatedMsg // set when a shell was restored
+	Warnings []string
+	Err      error
+}
+
+// undoWindow returns how long deleted items stay restorable; zero disables
+// undo.
+func (p *Plugin) undoWindow() time.Duration {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return 0
+	}
+	return p.ctx.Config.Plugins.Workspace.UndoWindow
+}
+
+// undoTrashRoot returns the trash directory for the repository at workDir.
+func undoTrashRoot(workDir string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--git-common-dir")
+	cmd.Dir = workDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse: %w", err)
+	}
+	dir := strings.TrimSpace(string(out))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(workDir, dir)
+	}
+	return filepath.Join(dir, undoTrashDir), nil
+}
+
+// newUndoEntry creates the trash directory for a new entry.
+func newUndoEntry(workDir string, kind undoKind, name string) (*undoEntry, error) {
+	root, err := undoTrashRoot(workDir)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	e := &undoEntry{
+		ID:        fmt.Sprintf("%d-%s", now.UnixNano(), sanitizeName(name)),
+		Kind:      kind,
+		Name:      name,
+		DeletedAt: now,
+	}
+	e.dir = filepath.Join(root, e.ID)
+	if err := os.MkdirAll(e.dir, 0755); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// save writes the entry's metadata into its trash directory.
+func (e *undoEntry) save() error {
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(e.dir, undoMetaFile), data, 0644)
+}
+
+// worktreeAdminDir returns the .git/worktrees/<id> directory a linked
+// worktree's .git file points to.
+func worktreeAdminDir(path string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(path, ".git"))
+	if err != nil {
+		return "", err
+	}
+	gitdir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return "", fmt.Errorf("%s/.git is not a worktree link", path)
+	}
+	gitdir = strings.TrimSpace(gitdir)
+	if !filepath.IsAbs(gitdir) {
+		gitdir = filepath.Join(path, gitdir)
+	}
+	return gitdir, nil
+}
+
+// revParse resolves ref to a commit, or "" if it does not exist.
+func revParse(workDir, ref string) string {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	cmd.Dir = workDir
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// trashWorktree moves a worktree and its git admin directory into the trash
+// instead of removing them, then deletes the requested branches while
+// recording their commits. Git forgets the worktree while its admin
+// directory is away, so the branch can be deleted as usual. Returns
+// errUndoUnavailable when nothing was moved and the caller should delete
+// the worktree normally.
+func trashWorktree(workDir, path, name, branch string, deleteLocal, deleteRemote bool) (*undoEntry, []string, error) {
+	adminDir, err := worktreeAdminDir(path)
+	if err != nil {
+		return nil, nil, errUndoUnavailable
+	}
+	e, err := newUndoEntry(workDir, undoKindWorktree, name)
+	if err != nil {
+		return nil, nil, errUndoUnavailable
+	}
+	e.Path, e.AdminDir, e.Branch = path, adminDir, branch
+
+	// Renames fail across filesystems; fall back to a plain delete then.
+	if err := os.Rename(path, filepath.Join(e.dir, "tree")); err != nil {
+		_ = os.RemoveAll(e.dir)
+		return nil, nil, errUndoUnavailable
+	}
+	if err := os.Rename(adminDir, filepath.Join(e.dir, "admin")); err != nil {
+		_ = os.Rename(filepath.Join(e.dir, "tree"), path)
+		_ = os.RemoveAll(e.dir)
+		return nil, nil, errUndoUnavailable
+	}
+
+	var warnings []string
+	if deleteLocal {
+		sha := revParse(workDir, "refs/heads/"+branch)
+		if err := deleteBranch(workDir, branch); err != nil {
+			warnings = append(warnings, fmt.Sprintf("Local branch: %v", err))
+		} else {
+			e.BranchSHA = sha
+		}
+	}
+	if deleteRemote {
+		sha := revParse(workDir, "refs/remotes/origin/"+branch)
+		if err := deleteRemoteBranchCmd(workDir, branch); err != nil {
+			warnings = append(warnings, fmt.Sprintf("Remote branch: %v", err))
+		} else {
+			e.RemoteSHA = sha
+		}
+	}
+
+	if err := e.save(); err != nil {
+		warnings = append(warnings, fmt.Sprintf("Undo: %v", err))
+	}
+	return e, warnings, nil
+}
+
+// restoreWorktree moves a trashed worktree back and recreates any deleted
+// branches. Branch failures are returned as warnings.
+func restoreWorktree(workDir string, e *undoEntry) ([]string, error) {
+	if _, err := os.Stat(e.Path); err == nil {
+		return nil, fmt.Errorf("%s already exists", e.Path)
+	}
+	if _, err := os.Stat(e.AdminDir); err == nil {
+		return nil, fmt.Errorf("git already has a worktree named %s", filepath.Base(e.AdminDir))
+	}
+	if err := os.MkdirAll(filepath.Dir(e.AdminDir), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(filepath.Join(e.dir, "admin"), e.AdminDir); err != nil {
+		return nil, fmt.Errorf("restore git metadata: %w", err)
+	}
+	if err := os.Rename(filepath.Join(e.dir, "tree"), e.Path); err != nil {
+		_ = os.Rename(e.AdminDir, filepath.Join(e.dir, "admin"))
+		return nil, fmt.Errorf("restore worktree: %w", err)
+	}
+
+	var warnings []string
+	if e.BranchSHA != "" {
+		cmd := exec.Command("git", "branch", e.Branch, e.BranchSHA)
+		cmd.Dir = workDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			warnings = append(warnings, fmt.Sprintf("Local branch: %s", strings.TrimSpace(string(out))))
+		}
+	}
+	if e.RemoteSHA != "" {
+		cmd := exec.Command("git", "push", "origin", e.RemoteSHA+":refs/heads/"+e.Branch)
+		cmd.Dir = workDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			warnings = append(warnings, fmt.Sprintf("Remote branch: %s", strings.TrimSpace(string(out))))
+		}
+	}
+	_ = os.RemoveAll(e.dir)
+	return warnings, nil
+}
+
+// trashShell keeps a deleted shell's tmux session alive under a trash name
+// so it can be restored. Adopted sessions were never killed, so only the
+// entry is recorded.
+func trashShell(workDir string, shell *ShellSession) (*undoEntry, error) {
+	e, err := newUndoEntry(workDir, undoKindShell, shell.Name)
+	if err != nil {
+		return nil, errUndoUnavailable
+	}
+	e.TmuxName = shell.TmuxName
+	e.ChosenAgent = shell.ChosenAgent
+	e.SkipPerms = shell.SkipPerms
+	e.Adopted = shell.Adopted
+
+	if !shell.Adopted && sessionExists(shell.TmuxName) {
+		if err := exec.Command("tmux", "rename-session", "-t", shell.TmuxName, e.trashSession()).Run(); err != nil {
+			_ = os.RemoveAll(e.dir)
+			return nil, errUndoUnavailable
+		}
+	}
+	if err := e.save(); err != nil {
+		_ = os.RemoveAll(e.dir)
+		if !shell.Adopted {
+			_ = exec.Command("tmux", "rename-session", "-t", e.trashSession(), shell.TmuxName).Run()
+		}
+		return nil, errUndoUnavailable
+	}
+	return e, nil
+}
+
+// restoreShell renames a trashed shell's session back and returns the
+// message that re-adds it to the sidebar.
+func restoreShell(e *undoEntry) (*ShellCreatedMsg, error) {
+	if !e.Adopted {
+		if !sessionExists(e.trashSession()) {
+			_ = os.RemoveAll(e.dir)
+			return nil, fmt.Errorf("shell %s is no longer running", e.Name)
+		}
+		if err := exec.Command("tmux", "rename-session", "-t", e.trashSession(), e.TmuxName).Run(); err != nil {
+			return nil, fmt.Errorf("rename tmux session: %w", err)
+		}
+	} else if !sessionExists(e.TmuxName) {
+		_ = os.RemoveAll(e.dir)
+		return nil, fmt.Errorf("session %s is no longer running", e.TmuxName)
+	}
+	_ = os.RemoveAll(e.dir)
+	return &ShellCreatedMsg{
+		SessionName: e.TmuxName,
+		DisplayName: e.Name,
+		PaneID:      getPaneID(e.TmuxName),
+		AgentType:   e.ChosenAgent,
+		SkipPerms:   e.SkipPerms,
+		Adopted:     e.Adopted,
+		Restored:    true,
+	}, nil
+}
+
+// purgeUndoEntry permanently deletes a trashed item.
+func purgeUndoEntry(e *undoEntry) {
+	if e.Kind == undoKindShell && !e.Adopted {
+		_ = exec.Command("tmux", "kill-session", "-t", e.trashSession()).Run()
+	}
+	_ = os.RemoveAll(e.dir)
+}
+
+// loadUndoEntries returns a command reading trash entries left by earlier
+// sessions.
+func (p *Plugin) loadUndoEntries() tea.Cmd {
+	if p.ctx == nil {
+		return nil
+	}
+	epoch, workDir := p.ctx.Epoch, p.ctx.WorkDir
+	return func() tea.Msg {
+		root, err := undoTrashRoot(workDir)
+		if err != nil {
+			return UndoEntriesLoadedMsg{Epoch: epoch}
+		}
+		dirs, err := os.ReadDir(root)
+		if err != nil {
+			return UndoEntriesLoadedMsg{Epoch: epoch}
+		}
+		var entries []*undoEntry
+		for _, d := range dirs {
+			dir := filepath.Join(root, d.Name())
+			data, err := os.ReadFile(filepath.Join(dir, undoMetaFile))
+			if err != nil {
+				continue
+			}
+			var e undoEntry
+			if json.Unmarshal(data, &e) != nil {
+				continue
+			}
+			e.dir = dir
+			entries = append(entries, &e)
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].DeletedAt.Before(entries[j].DeletedAt) })
+		return UndoEntriesLoadedMsg{Epoch: epoch, Entries: entries}
+	}
+}
+
+// pushUndo records a deleted item and announces how to undo it.
+func (p *Plugin) pushUndo(e *undoEntry) tea.Cmd {
+	p.undoStack = append(p.undoStack, e)
+	what := "workspace"
+	if e.Kind == undoKindShell {
+		what = "shell"
+	}
+	return tea.Batch(
+		appmsg.ShowToast(fmt.Sprintf("Deleted %s %s • press u to undo", what, e.Name), 5*time.Second),
+		p.scheduleUndoSweep(),
+	)
+}
+
+// scheduleUndoSweep schedules the next purge while entries are pending.
+func (p *Plugin) scheduleUndoSweep() tea.Cmd {
+	if p.undoSweepScheduled || len(p.undoStack) == 0 {
+		return nil
+	}
+	p.undoSweepScheduled = true
+	epoch := p.ctx.Epoch
+	return tea.Tick(undoSweepInterval, func(time.Time) tea.Msg {
+		return undoSweepMsg{Epoch: epoch}
+	})
+}
+
+// expireUndo drops entries older than the undo window and purges them in
+// the background.
+func (p *Plugin) expireUndo() tea.Cmd {
+	window := p.undoWindow()
+	var expired []*undoEntry
+	kept := p.undoStack[:0]
+	for _, e := range p.undoStack {
+		if time.Since(e.DeletedAt) >= window {
+			expired = append(expired, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	p.undoStack = kept
+	if len(expired) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		for _, e := range expired {
+			purgeUndoEntry(e)
+		}
+		return nil
+	}
+}
+
+// handleUndoSweep purges expired entries and reschedules while any remain.
+func (p *Plugin) handleUndoSweep() tea.Cmd {
+	p.undoSweepScheduled = false
+	return tea.Batch(p.expireUndo(), p.scheduleUndoSweep())
+}
+
+// undoLastDelete restores the most recently deleted item.
+func (p *Plugin) undoLastDelete() tea.Cmd {
+	expire := p.expireUndo()
+	if len(p.undoStack) == 0 {
+		return tea.Batch(expire, appmsg.ShowToast("Nothing to undo", 2*time.Second))
+	}
+	e := p.undoStack[len(p.undoStack)-1]
+	p.undoStack = p.undoStack[:len(p.undoStack)-1]
+	workDir := p.ctx.WorkDir
+	return tea.Batch(expire, func() tea.Msg {
+		if e.Kind == undoKindShell {
+			shell, err := restoreShell(e)
+			return UndoDoneMsg{Entry: e, Shell: shell, Err: err}
+		}
+		warnings, err := restoreWorktree(workDir, e)
+		return UndoDoneMsg{Entry: e, Warnings: warnings, Err: err}
+	})
+}
+
+// applyUndo reports a restore and reloads what it brought back.
+func (p *Plugin) applyUndo(msg UndoDoneMsg) tea.Cmd {
+	if msg.Err != nil {
+		return func() tea.Msg {
+			return appmsg.ToastMsg{Message: "Undo failed: " + msg.Err.Error(), Duration: 4 * time.Second, IsError: true}
+		}
+	}
+	toast := fmt.Sprintf("Restored %s", msg.Entry.Name)
+	if len(msg.Warnings) > 0 {
+		toast += " (" + strings.Join(msg.Warnings, "; ") + ")"
+	}
+	cmds := []tea.Cmd{appmsg.ShowToast(toast, 3*time.Second)}
+	if msg.Shell != nil {
+		shell := *msg.Shell
+		cmds = append(cmds, func() tea.Msg { return shell })
+	} else {
+		cmds = append(cmds, p.refreshWorktrees())
+	}
+	return tea.Batch(cmds...)
+}
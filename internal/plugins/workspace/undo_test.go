@@ -0,0 +1,107 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/plugin"
+)
+
+func TestTrashAndRestoreWorktree(t *testing.T) {
+	repo, wts := newJanitorTestRepo(t)
+	wt := wts["dirty"]
+	head := revParse(repo, "refs/heads/dirty")
+
+	entry, warnings, err := trashWorktree(repo, wt.Path, wt.Name, wt.Branch, true, false)
+	if err != nil || len(warnings) > 0 {
+		t.Fatalf("trashWorktree: %v %v", err, warnings)
+	}
+	if _, err := os.Stat(wt.Path); !os.IsNotExist(err) {
+		t.Error("worktree directory should be moved away")
+	}
+	if list, _ := gitOutput(repo, "worktree", "list"); strings.Contains(list, wt.Path) {
+		t.Error("git should no longer list the trashed worktree")
+	}
+	if revParse(repo, "refs/heads/dirty") != "" {
+		t.Error("branch should be deleted")
+	}
+	if entry.BranchSHA != head {
+		t.Errorf("recorded branch %q, want %q", entry.BranchSHA, head)
+	}
+
+	warnings, err = restoreWorktree(repo, entry)
+	if err != nil || len(warnings) > 0 {
+		t.Fatalf("restoreWorktree: %v %v", err, warnings)
+	}
+	if revParse(repo, "refs/heads/dirty") != head {
+		t.Error("branch should be recreated at its old commit")
+	}
+	if _, err := os.Stat(filepath.Join(wt.Path, "notes.txt")); err != nil {
+		t.Error("untracked files should come back with the worktree")
+	}
+	if got, _ := gitOutput(wt.Path, "rev-parse", "--abbrev-ref", "HEAD"); got != "dirty" {
+		t.Errorf("restored worktree HEAD = %q, want dirty", got)
+	}
+	if _, err := os.Stat(entry.dir); !os.IsNotExist(err) {
+		t.Error("trash entry should be removed after restore")
+	}
+}
+
+func TestRestoreWorktreeRefusesOccupiedPath(t *testing.T) {
+	repo, wts := newJanitorTestRepo(t)
+	wt := wts["fresh"]
+	entry, _, err := trashWorktree(repo, wt.Path, wt.Name, wt.Branch, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(wt.Path, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := restoreWorktree(repo, entry); err == nil {
+		t.Error("restore should not overwrite a new directory at the old path")
+	}
+}
+
+func TestUndoEntriesPersistAndExpire(t *testing.T) {
+	repo, wts := newJanitorTestRepo(t)
+	wt := wts["merged"]
+	entry, _, err := trashWorktree(repo, wt.Path, wt.Name, wt.Branch, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default()
+	p := New()
+	p.ctx = &plugin.Context{Config: cfg, WorkDir: repo}
+	loaded := p.loadUndoEntries()().(UndoEntriesLoadedMsg)
+	if len(loaded.Entries) != 1 || loaded.Entries[0].Path != wt.Path {
+		t.Fatalf("loaded entries = %+v", loaded.Entries)
+	}
+	p.undoStack = loaded.Entries
+
+	if cmd := p.expireUndo(); cmd != nil || len(p.undoStack) != 1 {
+		t.Fatal("a fresh entry should not expire")
+	}
+
+	p.undoStack[0].DeletedAt = time.Now().Add(-cfg.Plugins.Workspace.UndoWindow)
+	cmd := p.expireUndo()
+	if cmd == nil || len(p.undoStack) != 0 {
+		t.Fatal("an entry past the undo window should expire")
+	}
+	cmd()
+	if _, err := os.Stat(entry.dir); !os.IsNotExist(err) {
+		t.Error("expired trash should be purged")
+	}
+}
+
+func TestUndoLastDeleteEmpty(t *testing.T) {
+	p := New()
+	p.ctx = &plugin.Context{Config: config.Default()}
+	if cmd := p.undoLastDelete(); cmd == nil {
+		t.Error("expected a nothing-to-undo toast")
+	}
+}
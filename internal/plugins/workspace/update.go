@@ -100,18 +100,30 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			}
 			// Load stats, task links, and agent types for each worktree
 			for _, wt := range p.worktrees {
-				if wt.IsMissing {
-					continue // Skip metadata for worktrees with missing directories
+				if wt.IsMissing || wt.Host != "" {
+					continue // Skip metadata for missing and remote worktree directories
 				}
 				cmds = append(cmds, p.loadStats(wt.Path))
-				// Load linked task ID from .forge-task file
+				if !wt.IsMain {
+					// Disk usage and last activity for the list and cleanup view
+					wt.Usage = p.diskUsage[wt.Name]
+					cmds = append(cmds, p.loadDiskUsage(wt))
+				}
+				// Load linked task ID from .forge-task file, or link the
+				// task named in the branch
 				wt.TaskID = loadTaskLink(wt.Path)
+				if cmd := p.autoLinkTask(wt); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
 				// Load chosen agent type from .forge-agent file
 				wt.ChosenAgentType = loadAgentType(wt.Path)
 				// Load PR URL from .forge-pr file
 				wt.PRURL = loadPRURL(wt.Path)
 				// Load base branch from .forge-base file
 				wt.BaseBranch = loadBaseBranch(wt.Path)
+				wt.PR = p.prStatuses[wt.Name]
+				wt.AgentCost = p.agentCosts[wt.Name]
+				wt.Hooks = p.hookRuns[wt.Name]
 			}
 			// Detect conflicts across worktrees
 			cmds = append(cmds, p.loadConflicts())
@@ -122,7 +134,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			// Reconnect to existing tmux sessions after initial worktree load
 			if !p.initialReconnectDone {
 				p.initialReconnectDone = true
-				cmds = append(cmds, p.reconnectAgents())
+				cmds = append(cmds, p.reconnectAgents(), p.reconnectRemoteAgents())
 			}
 		}
 
@@ -131,6 +143,63 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.conflicts = msg.Conflicts
 		}
 
+	case prStatusTickMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		cmds = append(cmds, p.refreshPRStatuses(), p.nextPRStatus(msg))
+
+	case ciLogTickMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		cmds = append(cmds, p.handleCILogTick())
+
+	case CILogMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		cmds = append(cmds, p.applyCILog(msg))
+
+	case CIRerunMsg:
+		cmds = append(cmds, p.applyCIRerun(msg))
+
+	case agentCostTickMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		cmds = append(cmds, p.refreshAgentCosts(), p.scheduleAgentCosts(agentCostInterval))
+
+	case AgentCostsMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.agentCosts = msg.Costs
+		for _, wt := range p.worktrees {
+			if wt.Host == "" {
+				wt.AgentCost = msg.Costs[wt.Name]
+			}
+		}
+
+	case PRStatusMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		// Keep the last known badge when gh fails transiently
+		if msg.Err == nil {
+			if msg.Status == nil {
+				delete(p.prStatuses, msg.WorkspaceName)
+			} else {
+				p.prStatuses[msg.WorkspaceName] = msg.Status
+			}
+			for _, wt := range p.worktrees {
+				if wt.Name == msg.WorkspaceName {
+					wt.PR = msg.Status
+					break
+				}
+			}
+		}
+
 	case StatsLoadedMsg:
 		// Discard stale messages from previous project
 		if plugin.IsStale(p.ctx, msg) {
@@ -191,16 +260,48 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 
 			// Load content for preview pane
 			cmds = append(cmds, p.loadSelectedContent())
+			cmds = append(cmds, app.WorktreesChanged(msg.Worktree.Path, false))
 
-			// Start agent or attach based on selection
-			if msg.AgentType != AgentNone && msg.AgentType != "" {
-				cmds = append(cmds, p.StartAgentWithOptions(msg.Worktree, msg.AgentType, msg.SkipPerms, msg.Prompt))
+			// Post-create hooks run first; the agent starts once they pass
+			if hookCmd := p.startPostCreateHooks(msg.Worktree, msg.AgentType, msg.SkipPerms, msg.Prompt); hookCmd != nil {
+				cmds = append(cmds, hookCmd)
 			} else {
-				// "None" selected - attach to worktree directory
-				cmds = append(cmds, p.AttachToWorktreeDir(msg.Worktree))
+				cmds = append(cmds, p.startCreatedAgent(msg.Worktree, msg.AgentType, msg.SkipPerms, msg.Prompt))
 			}
 		}
 
+	case HookOutputMsg:
+		// Keep draining a stale runner so it can finish
+		if !plugin.IsStale(p.ctx, msg) {
+			if run := p.hookRuns[msg.WorkspaceName]; run != nil {
+				run.Current = msg.Index
+				run.appendLine(msg.Line)
+			}
+		}
+		cmds = append(cmds, listenForHookEvents(msg.Epoch, msg.WorkspaceName, msg.events))
+
+	case HookDoneMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		run := p.hookRuns[msg.WorkspaceName]
+		if run == nil {
+			break
+		}
+		run.Running = false
+		run.Err = msg.Err
+		run.FinishedAt = time.Now()
+		if msg.Err != nil {
+			p.ctx.Logger.Warn("post-create hook failed", "workspace", msg.WorkspaceName, "error", msg.Err)
+			cmds = append(cmds, func() tea.Msg {
+				return app.ToastMsg{Message: "Post-create hook failed", Duration: 3 * time.Second, IsError: true}
+			})
+			break
+		}
+		if wt := p.findWorktree(msg.WorkspaceName); wt != nil && !run.noAgent {
+			cmds = append(cmds, p.startCreatedAgent(wt, run.agentType, run.skipPerms, run.prompt))
+		}
+
 	case PromptSelectedMsg:
 		// Prompt selected from picker
 		p.viewMode = ViewModeCreate
@@ -313,6 +414,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			break
 		}
 		p.removeWorktreeByName(msg.Name)
+		p.stopRecording(msg.Name)
+		delete(p.hookRuns, msg.Name)
+		delete(p.seenMarkers, msg.Name)
 		if p.selectedIdx >= len(p.worktrees) && p.selectedIdx > 0 {
 			p.selectedIdx--
 		}
@@ -325,6 +429,31 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		p.cachedTask = nil
 		// Load diff for newly selected worktree
 		cmds = append(cmds, p.loadSelectedDiff())
+		if msg.Path != "" {
+			cmds = append(cmds, app.WorktreesChanged(msg.Path, true))
+		}
+		if msg.Undo != nil {
+			cmds = append(cmds, p.pushUndo(msg.Undo))
+		}
+
+	case UndoEntriesLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.undoStack = append(msg.Entries, p.undoStack...)
+		cmds = append(cmds, p.expireUndo(), p.scheduleUndoSweep())
+
+	case undoSweepMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		cmds = append(cmds, p.handleUndoSweep())
+
+	case UndoDoneMsg:
+		cmds = append(cmds, p.applyUndo(msg))
+		if msg.Err == nil && msg.Entry.Kind == undoKindWorktree {
+			cmds = append(cmds, app.WorktreesChanged(msg.Entry.Path, false))
+		}
 
 	case RemoteCheckDoneMsg:
 		// Update delete modal with remote branch existence info
@@ -395,11 +524,24 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 	case AgentOutputMsg:
 		// Update state (content already stored by Update() in handlePollAgent)
 		if wt := p.findWorktree(msg.WorkspaceName); wt != nil && wt.Agent != nil {
+			prevStatus := wt.Status
 			wt.Agent.LastOutput = time.Now()
 			wt.Agent.WaitingFor = msg.WaitingFor
 			wt.Status = msg.Status
 			// Track poll time for runaway detection (td-018f25)
 			wt.Agent.RecordPollTime()
+			if cmd := p.checkAgentCompletion(wt, prevStatus, msg.Output); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+		if cmd := p.recordOutput(msg.WorkspaceName, msg.Output); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		if cmd := p.maybeRefreshSplitDiff(msg.WorkspaceName); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		if cmd := p.clearRemoteFailures(msg.WorkspaceName); cmd != nil {
+			cmds = append(cmds, cmd)
 		}
 		// Update bracketed paste mode and cursor position if in interactive mode (td-79ab6163)
 		if p.viewMode == ViewModeInteractive && !p.shellSelected {
@@ -460,14 +602,21 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		return p, tea.Batch(cmds...)
 
 	case AgentPollUnchangedMsg:
+		if cmd := p.clearRemoteFailures(msg.WorkspaceName); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		// Track unchanged poll for throttle reset (td-018f25)
 		if wt := p.findWorktree(msg.WorkspaceName); wt != nil && wt.Agent != nil {
 			wt.Agent.RecordUnchangedPoll()
 			// Update status from session file re-check (td-2fca7d v8).
 			// Session files may change even when tmux output is unchanged
 			// (e.g., agent finishes but terminal output stays the same).
+			prevStatus := wt.Status
 			wt.Status = msg.CurrentStatus
 			wt.Agent.WaitingFor = msg.WaitingFor
+			if cmd := p.checkAgentCompletion(wt, prevStatus, ""); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
 		}
 		// Content unchanged - use longer interval based on current status
 		interval := pollIntervalIdle
@@ -581,6 +730,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				CreatedAt:   time.Now(),
 				ChosenAgent: msg.AgentType, // td-317b64: Track chosen agent
 				SkipPerms:   msg.SkipPerms, // td-317b64: Track skip perms setting
+				Adopted:     msg.Adopted,
 			}
 			p.shells = append(p.shells, shell)
 			p.managedSessions[msg.SessionName] = true
@@ -613,11 +763,27 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			cmds = append(cmds, p.sendResumeCommandToShell(msg.SessionName, resumeCmd))
 			// Enter interactive mode after command is injected
 			cmds = append(cmds, func() tea.Msg { return shellResumeInjectedMsg{TmuxSession: msg.SessionName} })
-		} else if msg.AgentType != AgentNone && msg.AgentType != "" {
+		} else if msg.AgentType != AgentNone && msg.AgentType != "" && !msg.Restored {
 			// td-2ba8a3: Start agent if one was selected (not AgentNone)
 			cmds = append(cmds, p.startAgentInShell(msg.SessionName, msg.AgentType, msg.SkipPerms))
 		}
 
+	case ReplayLoadedMsg:
+		cmds = append(cmds, p.startReplay(msg))
+
+	case replayTickMsg:
+		cmds = append(cmds, p.advanceReplay(msg))
+
+	case TmuxSessionsDiscoveredMsg:
+		cmds = append(cmds, p.applyDiscoveredSessions(msg))
+
+	case TmuxSessionDetachedMsg:
+		// Back from a discovered session - re-enable mouse and rescan
+		cmds = append(cmds, func() tea.Msg { return tea.EnableMouseAllMotion() })
+		if p.discoverState != nil {
+			cmds = append(cmds, p.loadDiscoveredSessions())
+		}
+
 	case ShellDetachedMsg:
 		// User detached from shell session - re-enable mouse and resume polling
 		cmds = append(cmds, func() tea.Msg { return tea.EnableMouseAllMotion() })
@@ -763,6 +929,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				cmds = append(cmds, p.loadSelectedContent())
 			}
 		}
+		if msg.Undo != nil {
+			cmds = append(cmds, p.pushUndo(msg.Undo))
+		}
 
 	case ShellSessionDeadMsg:
 		// Timer leak prevention (td-83dc22): increment generation to invalidate pending timers
@@ -952,9 +1121,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 	case restartAgentMsg:
 		// Start new agent after stop completed
 		if msg.worktree != nil {
-			agentType := msg.worktree.ChosenAgentType
-			if agentType == "" {
-				agentType = AgentClaude
+			agentType := restartAgentType(msg.worktree)
+			if msg.resume {
+				return p, p.ResumeAgent(msg.worktree, agentType)
 			}
 			return p, p.StartAgent(msg.worktree, agentType)
 		}
@@ -1007,9 +1176,27 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				if msg.TaskID != "" {
 					cmds = append(cmds, p.loadTaskDetails(msg.TaskID))
 				}
+				if msg.Auto {
+					cmds = append(cmds, autoLinkToast(msg.TaskID, wt.Branch))
+				}
 			}
 		}
 
+	case TaskMergedMsg:
+		cmds = append(cmds, taskMergedToast(msg))
+
+	case BulkTasksLoadedMsg:
+		p.applyBulkTasks(msg)
+
+	case BulkCreateDoneMsg:
+		cmds = append(cmds, p.applyBulkCreated(msg))
+
+	case RemoteCreateDoneMsg:
+		cmds = append(cmds, p.applyRemoteCreated(msg))
+
+	case RemotePollFailedMsg:
+		cmds = append(cmds, p.handleRemotePollFailed(msg))
+
 	case TaskSearchResultsMsg:
 		p.taskSearchLoading = false
 		if msg.Err == nil {
@@ -1033,6 +1220,137 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.cachedTaskFetched = time.Now()
 		}
 
+	case RebaseTargetsMsg:
+		if s := p.rebaseState; s != nil && s.Worktree.Name == msg.WorkspaceName {
+			switch {
+			case msg.Err != nil:
+				s.Step, s.Err = RebaseStepError, msg.Err
+			case msg.InProgress:
+				// Resume a rebase left stopped on conflicts
+				s.Step, s.Target, s.Conflicts, s.FileIdx = RebaseStepConflicts, "", msg.Conflicts, 0
+			default:
+				// Put resolved base branch first, then others
+				targets := []string{s.Target}
+				for _, b := range msg.Branches {
+					if b != s.Target {
+						targets = append(targets, b)
+					}
+				}
+				s.Targets, s.TargetIdx = targets, 0
+			}
+			p.clearRebaseModal()
+		}
+
+	case RebasePreviewMsg:
+		if s := p.rebaseState; s != nil && s.Worktree.Name == msg.WorkspaceName &&
+			s.Step == RebaseStepPreview && s.Target == msg.Target {
+			if msg.Err != nil {
+				s.Step, s.Err = RebaseStepError, msg.Err
+			} else {
+				s.Preview = &msg.Preview
+			}
+			p.clearRebaseModal()
+		}
+
+	case RebaseResultMsg:
+		if s := p.rebaseState; s != nil && s.Worktree.Name == msg.WorkspaceName {
+			s.Output = msg.Output
+			switch {
+			case msg.Err != nil:
+				s.Step, s.Err = RebaseStepError, msg.Err
+			case len(msg.Conflicts) > 0:
+				s.Step, s.Conflicts = RebaseStepConflicts, msg.Conflicts
+				s.FileIdx = min(s.FileIdx, len(msg.Conflicts)-1)
+			default:
+				s.Step, s.Aborted = RebaseStepDone, msg.Aborted
+			}
+			p.clearRebaseModal()
+		}
+		// Branch history changed: refresh stats and diffs
+		cmds = append(cmds, p.refreshWorktrees())
+
+	case DiskUsageMsg:
+		// Discard stale messages from previous project
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		delete(p.diskScanning, msg.WorkspaceName)
+		if msg.Err == nil {
+			p.setDiskUsage(msg.WorkspaceName, msg.Usage)
+		}
+
+	case JanitorCandidatesMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		for _, c := range msg.Candidates {
+			if c.Worktree.Usage != nil {
+				p.setDiskUsage(c.Worktree.Name, c.Worktree.Usage)
+			}
+		}
+		if s := p.janitorState; s != nil && s.Loading {
+			s.Loading = false
+			s.Candidates = msg.Candidates
+			p.clearJanitorModal()
+		}
+
+	case KanbanPRReadyMsg:
+		if msg.Err != nil {
+			return p, func() tea.Msg {
+				return app.ToastMsg{Message: fmt.Sprintf("Mark %s ready failed: %v", msg.WorkspaceName, msg.Err), Duration: 3 * time.Second, IsError: true}
+			}
+		}
+		return p, tea.Batch(
+			func() tea.Msg {
+				return app.ToastMsg{Message: msg.WorkspaceName + " marked ready for review", Duration: 2 * time.Second}
+			},
+			p.refreshPRStatuses(),
+		)
+
+	case BroadcastSentMsg:
+		return p, broadcastResultToast(msg)
+
+	case portScanTickMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.portScanScheduled = false
+		return p, p.loadPorts()
+
+	case PortsDetectedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		// Keep the last known ports when a scan fails transiently
+		if msg.Err == nil {
+			p.ports = msg.Ports
+		}
+		return p, p.schedulePortScan(portScanInterval)
+
+	case PortKilledMsg:
+		return p, tea.Batch(portKilledToast(msg), p.loadPorts())
+
+	case JanitorDoneMsg:
+		p.cancelJanitor()
+		for _, name := range msg.Deleted {
+			p.removeWorktreeByName(name)
+			delete(p.hookRuns, name)
+			delete(p.seenMarkers, name)
+			delete(p.diskUsage, name)
+		}
+		if p.selectedIdx >= len(p.worktrees) {
+			p.selectedIdx = max(len(p.worktrees)-1, 0)
+		}
+		p.deleteWarnings = msg.Warnings
+		p.diffContent = ""
+		p.diffRaw = ""
+		p.cachedTaskID = ""
+		p.cachedTask = nil
+		cmds = append(cmds, p.loadSelectedDiff())
+		for _, path := range msg.Paths {
+			cmds = append(cmds, app.WorktreesChanged(path, true))
+		}
+
 	case LocalBranchesMsg:
 		if p.mergeState != nil && msg.Err == nil {
 			// Put resolved base branch first, then others
@@ -1048,6 +1366,14 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.mergeModal = nil                  // Force modal rebuild
 		}
 
+	case MergeConflictCheckMsg:
+		if p.mergeState != nil && p.mergeState.Worktree.Name == msg.WorkspaceName &&
+			p.mergeState.ConflictCheckTarget == msg.Target {
+			p.mergeState.ConflictCheckRunning = false
+			p.mergeState.ConflictFiles = msg.Files
+			p.mergeState.ConflictCheckErr = msg.Err
+		}
+
 	case UncommittedChangesCheckMsg:
 		if msg.Err != nil {
 			// Error checking changes - cancel merge and return to list
@@ -1134,6 +1460,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			} else if msg.Merged {
 				// PR was merged! Move to cleanup step
 				p.mergeState.StepStatus[MergeStepWaitingMerge] = "done"
+				cmds = append(cmds, p.completeMergedTask(p.mergeState.Worktree))
 				cmds = append(cmds, p.advanceMergeStep())
 			} else {
 				// Not merged yet, check again later
@@ -1152,6 +1479,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				p.transitionToMergeError(MergeStepDirectMerge, "Direct Merge Failed", msg.Err)
 			} else {
 				// Direct merge succeeded, advance to confirmation
+				cmds = append(cmds, p.completeMergedTask(p.mergeState.Worktree))
 				cmds = append(cmds, p.advanceMergeStep())
 			}
 		}
@@ -1282,6 +1610,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 	case OpenCreateModalWithTaskMsg:
 		return p, p.openCreateModalWithTask(msg.TaskID, msg.TaskTitle)
 
+	case SelectWorktreeMsg:
+		return p, p.selectWorktreeByName(msg.Name)
+
 	case ResumeConversationMsg:
 		// Handle resume from conversations plugin (td-aa4136)
 		return p.handleResumeConversation(msg)
@@ -111,13 +111,13 @@ func (p *Plugin) renderDiffContentBasicWithHeight(width, height int) string {
 		var styledLine string
 		switch {
 		case strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---"):
-			styledLine = styles.DiffHeader.Render(line)
+			styledLine = styles.Current().DiffHeader.Render(line)
 		case strings.HasPrefix(line, "@@"):
-			styledLine = lipgloss.NewStyle().Foreground(styles.Info).Render(line)
+			styledLine = lipgloss.NewStyle().Foreground(styles.Current().Info).Render(line)
 		case strings.HasPrefix(line, "+"):
-			styledLine = styles.DiffAdd.Render(line)
+			styledLine = styles.Current().DiffAdd.Render(line)
 		case strings.HasPrefix(line, "-"):
-			styledLine = styles.DiffRemove.Render(line)
+			styledLine = styles.Current().DiffRemove.Render(line)
 		default:
 			styledLine = line
 		}
@@ -196,14 +196,14 @@ func (p *Plugin) renderFilePickerModal(background string) string {
 
 	// Build modal content
 	var sb strings.Builder
-	sb.WriteString(styles.ModalTitle.Render("Jump to File"))
+	sb.WriteString(styles.Current().ModalTitle.Render("Jump to File"))
 	sb.WriteString("\n\n")
 
 	// List files with selection highlight
 	for i, file := range files {
-		line := file.FileName() + " " + styles.Muted.Render("("+file.ChangeStats()+")")
+		line := file.FileName() + " " + styles.Current().Muted.Render("("+file.ChangeStats()+")")
 		if i == p.filePickerIdx {
-			sb.WriteString(styles.ListItemSelected.Render("▸ " + line))
+			sb.WriteString(styles.Current().ListItemSelected.Render("▸ " + line))
 		} else {
 			sb.WriteString("  " + line)
 		}
@@ -227,7 +227,7 @@ func (p *Plugin) renderFilePickerModal(background string) string {
 	// Style the modal
 	modalStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.Primary).
+		BorderForeground(styles.Current().Primary).
 		Padding(1, 2).
 		Width(modalWidth)
 
@@ -264,9 +264,9 @@ func (p *Plugin) colorStatLine(line string, width int) string {
 	for _, ch := range bar {
 		switch ch {
 		case '+':
-			colored.WriteString(styles.DiffAdd.Render("+"))
+			colored.WriteString(styles.Current().DiffAdd.Render("+"))
 		case '-':
-			colored.WriteString(styles.DiffRemove.Render("-"))
+			colored.WriteString(styles.Current().DiffRemove.Render("-"))
 		default:
 			colored.WriteRune(ch)
 		}
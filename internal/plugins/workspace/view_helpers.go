@@ -43,7 +43,7 @@ func wrapText(text string, width int) string {
 
 // dimText renders dim placeholder text using theme style.
 func dimText(s string) string {
-	return styles.Muted.Render(s)
+	return styles.Current().Muted.Render(s)
 }
 
 // formatRelativeTime formats a time as relative (e.g., "3m", "2h").
@@ -5,12 +5,14 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/wilbur182/forge/internal/icons"
 	"github.com/wilbur182/forge/internal/styles"
 )
 
 // renderKanbanView renders the kanban board view.
 func (p *Plugin) renderKanbanView(width, height int) string {
-	numCols := kanbanColumnCount()
+	columnDefs := p.kanbanColumns()
+	numCols := len(columnDefs) + 1 // Shells column + worktree columns
 	minColWidth := 16
 	minKanbanWidth := (minColWidth * numCols) + (numCols - 1) + 4
 	// Check minimum width - auto-collapse to list view if too narrow
@@ -20,7 +22,7 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 	}
 
 	// Use styled separator characters for theme consistency
-	borderStyle := lipgloss.NewStyle().Foreground(styles.BorderNormal)
+	borderStyle := lipgloss.NewStyle().Foreground(styles.Current().BorderNormal)
 	horizSep := borderStyle.Render("─")
 	vertSep := borderStyle.Render("│")
 
@@ -28,10 +30,10 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 
 	// Header with view mode toggle (account for panel border width)
 	innerWidth := width - 4 // Account for panel borders
-	header := styles.Title.Render("Workspaces")
+	header := styles.Current().Title.Render("Workspaces")
 	listTab := "List"
 	kanbanTab := "[Kanban]"
-	viewToggle := styles.Muted.Render(listTab + "|" + kanbanTab)
+	viewToggle := styles.Current().Muted.Render(listTab + "|" + kanbanTab)
 	headerLine := header + strings.Repeat(" ", max(1, innerWidth-len("Workspaces")-len(listTab)-len(kanbanTab)-1)) + viewToggle
 	lines = append(lines, headerLine)
 	lines = append(lines, strings.Repeat(horizSep, innerWidth))
@@ -43,26 +45,10 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 	p.mouseHandler.HitMap.AddRect(regionViewToggle, toggleX, 1, len(listTab), 1, 0)
 	p.mouseHandler.HitMap.AddRect(regionViewToggle, toggleX+len(listTab)+1, 1, len(kanbanTab), 1, 1)
 
-	// Group worktrees by status
+	// Group worktrees by column
 	columns := p.getKanbanColumns()
 	shellCount := len(p.shells)
 
-	// Column headers and colors
-	columnTitles := map[WorktreeStatus]string{
-		StatusActive:   "● Active",
-		StatusThinking: "◐ Thinking",
-		StatusWaiting:  "⧗ Waiting",
-		StatusDone:     "✓ Ready",
-		StatusPaused:   "⏸ Paused",
-	}
-	columnColors := map[WorktreeStatus]lipgloss.Color{
-		StatusActive:   styles.StatusCompleted.GetForeground().(lipgloss.Color), // Green
-		StatusThinking: styles.Primary,                                            // Purple
-		StatusWaiting:  styles.StatusModified.GetForeground().(lipgloss.Color),  // Yellow
-		StatusDone:     styles.Secondary,                                        // Cyan/Blue
-		StatusPaused:   styles.TextMuted,                                        // Gray
-	}
-
 	// Calculate column widths (account for panel borders)
 	colWidth := (innerWidth - numCols - 1) / numCols // -1 for separators
 	if colWidth < minColWidth {
@@ -77,17 +63,19 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 		var headerStyle lipgloss.Style
 		if colIdx == kanbanShellColumnIndex {
 			title = fmt.Sprintf("Shells (%d)", shellCount)
-			headerStyle = lipgloss.NewStyle().Bold(true).Foreground(styles.Muted.GetForeground().(lipgloss.Color)).Width(colWidth)
+			headerStyle = lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Muted.GetForeground().(lipgloss.Color)).Width(colWidth)
 		} else {
-			status := kanbanColumnOrder[colIdx-1]
-			items := columns[status]
-			title = fmt.Sprintf("%s (%d)", columnTitles[status], len(items))
-			headerStyle = lipgloss.NewStyle().Bold(true).Foreground(columnColors[status]).Width(colWidth)
+			def := columnDefs[colIdx-1]
+			title = fmt.Sprintf("%s (%d)", def.Title, len(columns[colIdx-1]))
+			headerStyle = lipgloss.NewStyle().Bold(true).Foreground(def.Color).Width(colWidth)
 		}
-		// Highlight selected column header
+		// Highlight selected column header, and the drop target while dragging
 		if colIdx == p.kanbanCol {
 			headerStyle = headerStyle.Underline(true)
 		}
+		if p.kanbanDrag != nil && colIdx == p.kanbanDrag.ToCol && colIdx != p.kanbanDrag.FromCol {
+			headerStyle = headerStyle.Reverse(true)
+		}
 		colHeaders = append(colHeaders, headerStyle.Render(title))
 
 		// Register column header hit region (Y=3, after header line, separator line)
@@ -111,9 +99,9 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 	if shellCount > maxInColumn {
 		maxInColumn = shellCount
 	}
-	for _, status := range kanbanColumnOrder {
-		if len(columns[status]) > maxInColumn {
-			maxInColumn = len(columns[status])
+	for _, items := range columns {
+		if len(items) > maxInColumn {
+			maxInColumn = len(items)
 		}
 	}
 	if maxInColumn > maxCards {
@@ -133,9 +121,7 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 					p.mouseHandler.HitMap.AddRect(regionKanbanCard, cardColX, cardY, colWidth-1, cardHeight, kanbanCardData{col: colIdx, row: cardIdx})
 				}
 			} else {
-				status := kanbanColumnOrder[colIdx-1]
-				items := columns[status]
-				if cardIdx < len(items) {
+				if cardIdx < len(columns[colIdx-1]) {
 					p.mouseHandler.HitMap.AddRect(regionKanbanCard, cardColX, cardY, colWidth-1, cardHeight, kanbanCardData{col: colIdx, row: cardIdx})
 				}
 			}
@@ -156,8 +142,7 @@ func (p *Plugin) renderKanbanView(width, height int) string {
 						cellContent = strings.Repeat(" ", colWidth-1)
 					}
 				} else {
-					status := kanbanColumnOrder[colIdx-1]
-					items := columns[status]
+					items := columns[colIdx-1]
 					if cardIdx < len(items) {
 						wt := items[cardIdx]
 						cellContent = p.renderKanbanCardLine(wt, lineIdx, colWidth-1, isSelected)
@@ -195,9 +180,9 @@ func (p *Plugin) renderKanbanShellCardLine(shell *ShellSession, lineIdx, width i
 
 	switch lineIdx {
 	case 0:
-		statusIcon := "○"
+		statusIcon := icons.Get(icons.DotEmpty)
 		if shell.Agent != nil {
-			statusIcon = "●"
+			statusIcon = icons.Get(icons.Dot)
 		}
 		name := shell.Name
 		maxNameLen := width - 3 // Account for icon and space
@@ -225,16 +210,16 @@ func (p *Plugin) renderKanbanShellCardLine(shell *ShellSession, lineIdx, width i
 
 	// Apply styling
 	if isSelected {
-		return styles.ListItemSelected.Width(width).Render(content)
+		return styles.Current().ListItemSelected.Width(width).Render(content)
 	}
 	if lineIdx > 0 {
-		return styles.Muted.Width(width).Render(content)
+		return styles.Current().Muted.Width(width).Render(content)
 	}
 	return lipgloss.NewStyle().Width(width).Render(content)
 }
 
 // renderKanbanCardLine renders a single line of a kanban card.
-// lineIdx: 0=name, 1=agent, 2=task, 3=stats
+// lineIdx: 0=name, 1=agent, PR, and hook badges, 2=task, 3=stats and agent spend
 func (p *Plugin) renderKanbanCardLine(wt *Worktree, lineIdx, width int, isSelected bool) string {
 	var content string
 
@@ -255,7 +240,24 @@ func (p *Plugin) renderKanbanCardLine(wt *Worktree, lineIdx, width int, isSelect
 		} else if wt.ChosenAgentType != "" && wt.ChosenAgentType != AgentNone {
 			agentStr = "  " + string(wt.ChosenAgentType)
 		}
+		if wt.PR != nil {
+			if agentStr == "" {
+				agentStr = "  " + prBadge(wt.PR)
+			} else {
+				agentStr += " · " + prBadge(wt.PR)
+			}
+		}
+		if badge := hookBadge(wt.Hooks); badge != "" {
+			if agentStr == "" {
+				agentStr = "  " + badge
+			} else {
+				agentStr += " · " + badge
+			}
+		}
 		content = agentStr
+		if lipgloss.Width(content) > width {
+			content = truncateString(content, width)
+		}
 	case 2:
 		// Line 2: Task ID (rune-safe for Unicode)
 		if wt.TaskID != "" {
@@ -271,6 +273,16 @@ func (p *Plugin) renderKanbanCardLine(wt *Worktree, lineIdx, width int, isSelect
 		if wt.Stats != nil && (wt.Stats.Additions > 0 || wt.Stats.Deletions > 0) {
 			content = fmt.Sprintf("  +%d -%d", wt.Stats.Additions, wt.Stats.Deletions)
 		}
+		if badge := agentCostBadge(wt.AgentCost); badge != "" {
+			if content == "" {
+				content = "  " + badge
+			} else {
+				content += " · " + badge
+			}
+		}
+		if lipgloss.Width(content) > width {
+			content = truncateString(content, width)
+		}
 	}
 
 	// Pad to width
@@ -281,12 +293,12 @@ func (p *Plugin) renderKanbanCardLine(wt *Worktree, lineIdx, width int, isSelect
 
 	// Apply styling
 	if isSelected {
-		return styles.ListItemSelected.Width(width).Render(content)
+		return styles.Current().ListItemSelected.Width(width).Render(content)
 	}
 
 	// Dim non-name lines
 	if lineIdx > 0 {
-		return styles.Muted.Width(width).Render(content)
+		return styles.Current().Muted.Width(width).Render(content)
 	}
 
 	return lipgloss.NewStyle().Width(width).Render(content)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
+	"github.com/wilbur182/forge/internal/icons"
 	"github.com/wilbur182/forge/internal/styles"
 	"github.com/wilbur182/forge/internal/ui"
 )
@@ -15,21 +16,21 @@ import (
 func modalStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.BorderActive).
+		BorderForeground(styles.Current().BorderActive).
 		Padding(1, 2)
 }
 
 func inputStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
 		Border(lipgloss.NormalBorder()).
-		BorderForeground(styles.BorderNormal).
+		BorderForeground(styles.Current().BorderNormal).
 		Padding(0, 1)
 }
 
 func inputFocusedStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
 		Border(lipgloss.NormalBorder()).
-		BorderForeground(styles.Primary).
+		BorderForeground(styles.Current().Primary).
 		Padding(0, 1)
 }
 
@@ -79,6 +80,24 @@ func (p *Plugin) View(width, height int) string {
 		return p.renderRenameShellModal(width, height)
 	case ViewModeFetchPR:
 		return p.renderFetchPRModal(width, height)
+	case ViewModeRebase:
+		return p.renderRebaseModal(width, height)
+	case ViewModeJanitor:
+		return p.renderJanitorModal(width, height)
+	case ViewModeRepoSwitcher:
+		return p.renderRepoModal(width, height)
+	case ViewModeEnvEditor:
+		return p.renderEnvEditorModal(width, height)
+	case ViewModeBroadcast:
+		return p.renderBroadcastModal(width, height)
+	case ViewModeBulkCreate:
+		return p.renderBulkCreateModal(width, height)
+	case ViewModePorts:
+		return p.renderPortsModal(width, height)
+	case ViewModeDiscover:
+		return p.renderDiscoverModal(width, height)
+	case ViewModeRemoteCreate:
+		return p.renderRemoteCreateModal(width, height)
 	case ViewModeFilePicker:
 		background := p.renderListView(width, height)
 		return p.renderFilePickerModal(background)
@@ -114,7 +133,7 @@ func (p *Plugin) renderListView(width, height int) string {
 		// Shell has no tabs - it shows primer/output directly
 		if !p.shellSelected {
 			// X starts at panelOverhead/2 (1 for border + 1 for panel padding)
-			tabWidths := []int{10, 8, 8} // " Output " + padding, " Diff " + padding, " Task " + padding
+			tabWidths := []int{10, 8, 8, 6} // " Output " + padding, " Diff " + padding, " Task " + padding, " CI " + padding
 			tabX := panelOverhead / 2
 			for i, tabWidth := range tabWidths {
 				p.mouseHandler.HitMap.AddRect(regionPreviewTab, tabX, 1, tabWidth, 1, i)
@@ -171,9 +190,9 @@ func (p *Plugin) renderListView(width, height int) string {
 		// Tabs are rendered at Y=1 (first line inside panel border)
 		// X starts at sidebarW + dividerWidth + panelOverhead/2 (border + padding on left side)
 		previewPaneX := sidebarW + dividerWidth + panelOverhead/2
-		// Tab widths: text is " Output " (8), " Diff " (6), " Task " (6)
-		// Plus BarChip Padding(0,1) adds 2 chars = 10, 8, 8 visual width
-		tabWidths := []int{10, 8, 8}
+		// Tab widths: text is " Output " (8), " Diff " (6), " Task " (6), " CI " (4)
+		// Plus BarChip Padding(0,1) adds 2 chars = 10, 8, 8, 6 visual width
+		tabWidths := []int{10, 8, 8, 6}
 		tabX := previewPaneX
 		for i, tabWidth := range tabWidths {
 			p.mouseHandler.HitMap.AddRect(regionPreviewTab, tabX, 1, tabWidth, 1, i)
@@ -215,9 +234,14 @@ func (p *Plugin) renderSidebarContent(width, height int) string {
 	// Header with [New] button
 	titleText := "Workspaces"
 	buttonText := "New"
-	buttonStyle := styles.Button
+	multiRepo := p.multiRepo()
+	if multiRepo {
+		// Show which repo is managed; the name opens the repo switcher
+		titleText = truncateString("Workspaces · "+p.currentRepoName()+" ▾", max(width-6, 10))
+	}
+	buttonStyle := styles.Current().Button
 	if p.hoverNewButton {
-		buttonStyle = styles.ButtonHover
+		buttonStyle = styles.Current().ButtonHover
 	}
 	styledButton := styles.RenderPillWithStyle(buttonText, buttonStyle, "")
 	buttonWidth := lipgloss.Width(styledButton)
@@ -229,17 +253,20 @@ func (p *Plugin) renderSidebarContent(width, height int) string {
 		spacing = 1
 	}
 
-	header := styles.Title.Render(titleText) + strings.Repeat(" ", spacing) + styledButton
+	header := styles.Current().Title.Render(titleText) + strings.Repeat(" ", spacing) + styledButton
 	lines = append(lines, header)
 
 	// Register hit region for the button (X position = 2 for panel padding + spacing + titleWidth)
 	// The button is at the right edge of the sidebar content
 	buttonX := 2 + titleWidth + spacing // 2 for left border+padding
 	p.mouseHandler.HitMap.AddRect(regionCreateWorktreeButton, buttonX, 1, buttonWidth, 1, nil)
+	if multiRepo {
+		p.mouseHandler.HitMap.AddRect(regionRepoName, 2, 1, titleWidth, 1, nil)
+	}
 
 	// Show warnings from delete operation if any
 	if len(p.deleteWarnings) > 0 {
-		warningStyle := lipgloss.NewStyle().Foreground(styles.Warning)
+		warningStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning)
 		for _, w := range p.deleteWarnings {
 			// Truncate warning to fit width
 			if len(w) > width-2 {
@@ -251,7 +278,7 @@ func (p *Plugin) renderSidebarContent(width, height int) string {
 
 	// Show toast message if active (td-a1c8456f: session disconnect notification)
 	if p.toastMessage != "" && !p.toastTime.IsZero() && time.Since(p.toastTime) < flashDuration {
-		toastStyle := lipgloss.NewStyle().Foreground(styles.Warning).Bold(true)
+		toastStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning).Bold(true)
 		msg := p.toastMessage
 		if len(msg) > width-4 {
 			msg = msg[:width-7] + "..."
@@ -271,11 +298,11 @@ func (p *Plugin) renderSidebarContent(width, height int) string {
 	// === Render shells section ===
 	if len(p.shells) > 0 {
 		// Shells subheader with [+] button (right-aligned)
-		shellsTitle := styles.Muted.Render("Shells")
+		shellsTitle := styles.Current().Muted.Render("Shells")
 		shellsTitleWidth := lipgloss.Width(shellsTitle)
-		shellsPlusStyle := styles.Button
+		shellsPlusStyle := styles.Current().Button
 		if p.hoverShellsPlusButton {
-			shellsPlusStyle = styles.ButtonHover
+			shellsPlusStyle = styles.Current().ButtonHover
 		}
 		shellsPlusBtn := styles.RenderPillWithStyle("+", shellsPlusStyle, "")
 		shellsPlusBtnWidth := lipgloss.Width(shellsPlusBtn)
@@ -303,7 +330,7 @@ func (p *Plugin) renderSidebarContent(width, height int) string {
 		}
 
 		// Add separator line after shells
-		lines = append(lines, styles.Muted.Render(strings.Repeat("─", width)))
+		lines = append(lines, styles.Current().Muted.Render(strings.Repeat("─", width)))
 		currentY++
 	}
 
@@ -344,18 +371,18 @@ func (p *Plugin) renderSidebarContent(width, height int) string {
 				pad2 = 0
 			}
 
-			lines = append(lines, styles.Muted.Render(strings.Repeat(" ", pad1)+msg1))
-			lines = append(lines, styles.Muted.Render(strings.Repeat(" ", pad2)+msg2))
+			lines = append(lines, styles.Current().Muted.Render(strings.Repeat(" ", pad1)+msg1))
+			lines = append(lines, styles.Current().Muted.Render(strings.Repeat(" ", pad2)+msg2))
 		}
 		// When shell is selected and no worktrees, just show the shell entries (already rendered above)
 	} else {
 		// Worktrees subheader with [+] button (right-aligned, only if we have shells above)
 		if len(p.shells) > 0 {
-			workspacesTitle := styles.Muted.Render("Workspaces")
+			workspacesTitle := styles.Current().Muted.Render("Workspaces")
 			workspacesTitleWidth := lipgloss.Width(workspacesTitle)
-			workspacesPlusStyle := styles.Button
+			workspacesPlusStyle := styles.Current().Button
 			if p.hoverWorkspacesPlusButton {
-				workspacesPlusStyle = styles.ButtonHover
+				workspacesPlusStyle = styles.Current().ButtonHover
 			}
 			workspacesPlusBtn := styles.RenderPillWithStyle("+", workspacesPlusStyle, "")
 			workspacesPlusBtnWidth := lipgloss.Width(workspacesPlusBtn)
@@ -445,7 +472,7 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 
 	// Name and time
 	name := wt.Name
-	timeStr := formatRelativeTime(wt.UpdatedAt)
+	timeStr := formatRelativeTime(wt.lastActivity())
 
 	// Calculate max name width to prevent wrapping
 	// Line structure: " [icon] [name][prIcon][conflictIcon][orphanedIcon]  [time]"
@@ -503,6 +530,31 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 	if statsStr != "" {
 		parts = append(parts, statsStr)
 	}
+	if badge := agentCostBadge(wt.AgentCost); badge != "" {
+		parts = append(parts, badge)
+	}
+	if wt.Usage != nil {
+		parts = append(parts, formatBytes(wt.Usage.Bytes))
+	}
+	if wt.PR != nil {
+		parts = append(parts, prBadge(wt.PR))
+	}
+	if badge := hookBadge(wt.Hooks); badge != "" {
+		parts = append(parts, badge)
+	}
+	if badge := portsBadge(p.ports[wt.Name]); badge != "" {
+		parts = append(parts, badge)
+	}
+	if p.isRecording(wt.Name) {
+		parts = append(parts, "⏺ rec")
+	}
+	if wt.Host != "" {
+		if p.remoteUnreachable(wt.Name) {
+			parts = append(parts, "⚠ @"+wt.Host+" offline")
+		} else {
+			parts = append(parts, "@"+wt.Host)
+		}
+	}
 	if hasConflict {
 		conflictFiles := p.getConflictingFiles(wt.Name, p.conflicts)
 		if len(conflictFiles) > 0 {
@@ -542,12 +594,12 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 
 		// Use bright style when sidebar is focused, dimmed when preview is focused
 		if isActiveFocus {
-			return styles.ListItemSelected.Width(width).Render(content)
+			return styles.Current().ListItemSelected.Width(width).Render(content)
 		}
 		// Dimmed selection style (when preview pane is active)
 		dimmedSelectedStyle := lipgloss.NewStyle().
-			Background(styles.BgSecondary).
-			Foreground(styles.TextSecondary).
+			Background(styles.Current().BgSecondary).
+			Foreground(styles.Current().TextSecondary).
 			Width(width)
 		return dimmedSelectedStyle.Render(content)
 	}
@@ -556,19 +608,19 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 	var statusStyle lipgloss.Style
 	if wt.IsMain {
 		// Primary/cyan color for main worktree to stand out
-		statusStyle = lipgloss.NewStyle().Foreground(styles.Primary)
+		statusStyle = lipgloss.NewStyle().Foreground(styles.Current().Primary)
 	} else {
 		switch wt.Status {
 		case StatusActive:
-			statusStyle = styles.StatusCompleted // Green
+			statusStyle = styles.Current().StatusCompleted // Green
 		case StatusWaiting:
-			statusStyle = styles.StatusModified // Yellow/orange (warning)
+			statusStyle = styles.Current().StatusModified // Yellow/orange (warning)
 		case StatusDone:
-			statusStyle = styles.StatusCompleted // Green
+			statusStyle = styles.Current().StatusCompleted // Green
 		case StatusError:
-			statusStyle = styles.StatusDeleted // Red
+			statusStyle = styles.Current().StatusDeleted // Red
 		default:
-			statusStyle = styles.Muted // Gray for paused
+			statusStyle = styles.Current().Muted // Gray for paused
 		}
 	}
 	icon := statusStyle.Render(statusIcon)
@@ -576,19 +628,19 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 	// Apply conflict style
 	styledConflictIcon := ""
 	if hasConflict {
-		styledConflictIcon = styles.StatusModified.Render(" ⚠")
+		styledConflictIcon = styles.Current().StatusModified.Render(" ⚠")
 	}
 
 	// Apply orphaned style (session ended)
 	styledOrphanedIcon := ""
 	if wt.IsOrphaned {
-		styledOrphanedIcon = styles.StatusModified.Render(" ⚠")
+		styledOrphanedIcon = styles.Current().StatusModified.Render(" ⚠")
 	}
 
 	// Apply PR style
 	styledPRIcon := ""
 	if hasPR {
-		styledPRIcon = lipgloss.NewStyle().Foreground(styles.Secondary).Render(" PR")
+		styledPRIcon = lipgloss.NewStyle().Foreground(styles.Current().Secondary).Render(" PR")
 	}
 
 	// For non-selected, style parts individually
@@ -609,17 +661,42 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 	if statsStr != "" {
 		styledParts = append(styledParts, statsStr)
 	}
+	if badge := agentCostBadge(wt.AgentCost); badge != "" {
+		styledParts = append(styledParts, agentCostStyle().Render(badge))
+	}
+	if wt.Usage != nil {
+		styledParts = append(styledParts, dimText(formatBytes(wt.Usage.Bytes)))
+	}
+	if wt.PR != nil {
+		styledParts = append(styledParts, styledPRBadge(wt.PR))
+	}
+	if badge := styledHookBadge(wt.Hooks); badge != "" {
+		styledParts = append(styledParts, badge)
+	}
+	if badge := portsBadge(p.ports[wt.Name]); badge != "" {
+		styledParts = append(styledParts, badge)
+	}
+	if p.isRecording(wt.Name) {
+		styledParts = append(styledParts, styles.Current().StatusDeleted.Render("⏺ rec"))
+	}
+	if wt.Host != "" {
+		if p.remoteUnreachable(wt.Name) {
+			styledParts = append(styledParts, styles.Current().StatusModified.Render("⚠ @"+wt.Host+" offline"))
+		} else {
+			styledParts = append(styledParts, dimText("@"+wt.Host))
+		}
+	}
 	if hasConflict {
 		conflictFiles := p.getConflictingFiles(wt.Name, p.conflicts)
 		if len(conflictFiles) > 0 {
-			styledParts = append(styledParts, styles.StatusModified.Render(fmt.Sprintf("⚠ %d conflicts", len(conflictFiles))))
+			styledParts = append(styledParts, styles.Current().StatusModified.Render(fmt.Sprintf("⚠ %d conflicts", len(conflictFiles))))
 		}
 	}
 	if wt.IsOrphaned {
-		styledParts = append(styledParts, styles.StatusModified.Render("⚠ session ended"))
+		styledParts = append(styledParts, styles.Current().StatusModified.Render("⚠ session ended"))
 	}
 	if wt.IsMissing {
-		styledParts = append(styledParts, styles.StatusModified.Render("✗ folder missing"))
+		styledParts = append(styledParts, styles.Current().StatusModified.Render("✗ folder missing"))
 	}
 
 	// Build lines with styled elements
@@ -635,7 +712,7 @@ func (p *Plugin) renderWorktreeItem(wt *Worktree, selected bool, width int) stri
 	}
 
 	content := line1 + "\n" + line2
-	return styles.ListItemNormal.Width(width).Render(content)
+	return styles.Current().ListItemNormal.Width(width).Render(content)
 }
 
 // renderShellEntryForSession renders a shell entry for a specific shell session.
@@ -648,40 +725,40 @@ func (p *Plugin) renderShellEntryForSession(shell *ShellSession, selected bool,
 
 	// td-f88fdd: Handle orphaned shells (manifest entry exists but tmux session is gone)
 	if shell.IsOrphaned {
-		statusIcon = "◌" // Empty circle for orphaned
-		statusStyle = styles.Muted
+		statusIcon = icons.Get(icons.Orphaned)
+		statusStyle = styles.Current().Muted
 	} else if shell.ChosenAgent != AgentNone && shell.ChosenAgent != "" {
 		// td-a29b76: Show agent-specific status when an AI agent is running
 		// Shell has an AI agent - show agent status
 		if shell.Agent != nil {
 			switch shell.Agent.Status {
 			case AgentStatusRunning:
-				statusIcon = "●"
-				statusStyle = styles.StatusCompleted // Green - active
+				statusIcon = icons.Get(icons.Dot)
+				statusStyle = styles.Current().StatusCompleted // Green - active
 			case AgentStatusWaiting:
-				statusIcon = "○"
-				statusStyle = styles.StatusModified // Yellow - waiting for input
+				statusIcon = icons.Get(icons.DotEmpty)
+				statusStyle = styles.Current().StatusModified // Yellow - waiting for input
 			case AgentStatusDone:
-				statusIcon = "✓"
-				statusStyle = styles.StatusCompleted // Green/blue - done
+				statusIcon = icons.Get(icons.Check)
+				statusStyle = styles.Current().StatusCompleted // Green/blue - done
 			case AgentStatusError:
-				statusIcon = "✗"
-				statusStyle = styles.StatusDeleted // Red - error
+				statusIcon = icons.Get(icons.Cross)
+				statusStyle = styles.Current().StatusDeleted // Red - error
 			default:
-				statusIcon = "○"
-				statusStyle = styles.Muted // Gray - idle/paused
+				statusIcon = icons.Get(icons.DotEmpty)
+				statusStyle = styles.Current().Muted // Gray - idle/paused
 			}
 		} else {
-			statusIcon = "○"
-			statusStyle = styles.Muted
+			statusIcon = icons.Get(icons.DotEmpty)
+			statusStyle = styles.Current().Muted
 		}
 	} else if shell.Agent != nil {
 		// Plain shell (no AI agent)
-		statusIcon = "●"
-		statusStyle = styles.StatusCompleted // Green
+		statusIcon = icons.Get(icons.Dot)
+		statusStyle = styles.Current().StatusCompleted // Green
 	} else {
-		statusIcon = "○"
-		statusStyle = styles.Muted
+		statusIcon = icons.Get(icons.DotEmpty)
+		statusStyle = styles.Current().Muted
 	}
 
 	// Use shell display name
@@ -747,12 +824,12 @@ func (p *Plugin) renderShellEntryForSession(shell *ShellSession, selected bool,
 		content := line1 + "\n" + line2
 
 		if isActiveFocus {
-			return styles.ListItemSelected.Width(width).Render(content)
+			return styles.Current().ListItemSelected.Width(width).Render(content)
 		}
 		// Dimmed selection style
 		dimmedSelectedStyle := lipgloss.NewStyle().
-			Background(styles.BgSecondary).
-			Foreground(styles.TextSecondary).
+			Background(styles.Current().BgSecondary).
+			Foreground(styles.Current().TextSecondary).
 			Width(width)
 		return dimmedSelectedStyle.Render(content)
 	}
@@ -766,5 +843,5 @@ func (p *Plugin) renderShellEntryForSession(shell *ShellSession, selected bool,
 		line2 = ansi.Truncate(line2, width-1, "…")
 	}
 	content := line1 + "\n" + line2
-	return styles.ListItemNormal.Width(width).Render(content)
+	return styles.Current().ListItemNormal.Width(width).Render(content)
 }
@@ -88,7 +88,7 @@ func (p *Plugin) renderTaskLinkModal(width, height int) string {
 			line := fmt.Sprintf("%s%s  %s", prefix, task.ID, taskTitle)
 			sb.WriteString("\n")
 			if i == p.taskSearchIdx {
-				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Primary).Render(line))
+				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(line))
 			} else {
 				sb.WriteString(dimText(line))
 			}
@@ -125,7 +125,7 @@ func (p *Plugin) renderTaskLinkModal(width, height int) string {
 			line := fmt.Sprintf("%s%s  %s", prefix, task.ID, taskTitle)
 			sb.WriteString("\n")
 			if i == p.taskSearchIdx {
-				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Primary).Render(line))
+				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(line))
 			} else {
 				sb.WriteString(dimText(line))
 			}
@@ -249,7 +249,7 @@ func (p *Plugin) deleteConfirmInfoSection() modal.Section {
 
 func (p *Plugin) deleteConfirmWarningSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		warningStyle := lipgloss.NewStyle().Foreground(styles.Warning)
+		warningStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning)
 
 		var sb strings.Builder
 		if p.deleteConfirmWorktree != nil && p.deleteConfirmWorktree.IsMissing {
@@ -374,11 +374,18 @@ func (p *Plugin) deleteShellInfoSection() modal.Section {
 
 func (p *Plugin) deleteShellWarningSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		warningStyle := lipgloss.NewStyle().Foreground(styles.Warning)
+		warningStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning)
 
 		var sb strings.Builder
 		sb.WriteString(warningStyle.Render("This will:"))
 		sb.WriteString("\n")
+		if p.deleteConfirmShell != nil && p.deleteConfirmShell.Adopted {
+			// Adopted sessions existed before forge, so they are only released
+			sb.WriteString(dimText("  • Remove the shell from the sidebar"))
+			sb.WriteString("\n")
+			sb.WriteString(dimText("  • The tmux session keeps running"))
+			return modal.RenderedSection{Content: sb.String()}
+		}
 		sb.WriteString(dimText("  • Terminate the tmux session"))
 		sb.WriteString("\n")
 		sb.WriteString(dimText("  • Any running processes will be killed"))
@@ -453,7 +460,7 @@ func (p *Plugin) renameShellErrorSection() modal.Section {
 			return modal.RenderedSection{}
 		}
 
-		errStyle := lipgloss.NewStyle().Foreground(styles.Error)
+		errStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 		content := errStyle.Render("Error: " + p.renameShellError)
 
 		return modal.RenderedSection{Content: content}
@@ -512,6 +519,9 @@ func (p *Plugin) ensureAgentChoiceModal() {
 		{ID: "agent-choice-attach", Label: "Attach to session"},
 		{ID: "agent-choice-restart", Label: "Restart agent"},
 	}
+	if AgentResumeCommands[restartAgentType(p.agentChoiceWorktree)] != "" {
+		items = append(items, modal.ListItem{ID: "agent-choice-resume", Label: "Resume last session"})
+	}
 
 	title := fmt.Sprintf("Agent Running: %s", p.agentChoiceWorktree.Name)
 
@@ -522,7 +532,7 @@ func (p *Plugin) ensureAgentChoiceModal() {
 	).
 		AddSection(modal.Text("An agent is already running on this worktree.\nWhat would you like to do?")).
 		AddSection(modal.Spacer()).
-		AddSection(modal.List(agentChoiceListID, items, &p.agentChoiceIdx, modal.WithMaxVisible(2))).
+		AddSection(modal.List(agentChoiceListID, items, &p.agentChoiceIdx, modal.WithMaxVisible(len(items)))).
 		AddSection(modal.Spacer()).
 		AddSection(modal.Buttons(
 			modal.Btn(" Confirm ", agentChoiceConfirmID),
@@ -608,6 +618,8 @@ func (p *Plugin) ensureMergeModal() {
 	case MergeStepReviewDiff:
 		m.AddSection(p.mergeReviewDiffSection())
 		m.AddSection(modal.Spacer())
+		m.AddSection(p.mergeConflictCheckSection())
+		m.AddSection(modal.Spacer())
 		m.AddSection(modal.Text(dimText("Press Enter to continue, Esc to cancel")))
 
 	case MergeStepTargetBranch:
@@ -644,6 +656,8 @@ func (p *Plugin) ensureMergeModal() {
 		m.AddSection(modal.Spacer())
 		m.AddSection(p.mergeMethodHintsSection())
 		m.AddSection(modal.Spacer())
+		m.AddSection(p.mergeConflictCheckSection())
+		m.AddSection(modal.Spacer())
 		m.AddSection(modal.Text(dimText("↑/↓: select   Enter: continue   Esc: cancel")))
 
 	case MergeStepDirectMerge:
@@ -713,7 +727,7 @@ func (p *Plugin) ensureMergeModal() {
 			return modal.RenderedSection{Content: strings.Repeat("─", min(contentWidth, 60))}
 		}, nil))
 		m.AddSection(modal.Spacer())
-		m.AddSection(modal.Text(lipgloss.NewStyle().Foreground(styles.Error).Bold(true).Render("Error Output:")))
+		m.AddSection(modal.Text(lipgloss.NewStyle().Foreground(styles.Current().Error).Bold(true).Render("Error Output:")))
 		m.AddSection(modal.Spacer())
 		m.AddSection(modal.Text(p.mergeState.ErrorDetail))
 		m.AddSection(modal.Spacer())
@@ -760,21 +774,21 @@ func (p *Plugin) mergeProgressSection() modal.Section {
 		for i, step := range steps {
 			status := p.mergeState.StepStatus[step]
 			icon := "○" // pending
-			color := styles.TextMuted
+			color := styles.Current().TextMuted
 
 			switch status {
 			case "running":
 				icon = "●"
-				color = styles.Warning
+				color = styles.Current().Warning
 			case "done":
 				icon = "✓"
-				color = styles.Success
+				color = styles.Current().Success
 			case "error":
 				icon = "✗"
-				color = styles.Error
+				color = styles.Current().Error
 			case "skipped":
 				icon = "○"
-				color = styles.TextMuted
+				color = styles.Current().TextMuted
 			}
 
 			stepName := step.String()
@@ -828,6 +842,48 @@ func (p *Plugin) mergeReviewDiffSection() modal.Section {
 	}, nil)
 }
 
+// mergeConflictCheckSection renders the merge-tree dry run result so conflicts
+// are visible before anything is pushed or merged.
+func (p *Plugin) mergeConflictCheckSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		s := p.mergeState
+		if s == nil || s.ConflictCheckTarget == "" {
+			return modal.RenderedSection{}
+		}
+
+		var sb strings.Builder
+		switch {
+		case s.ConflictCheckRunning:
+			sb.WriteString(dimText(fmt.Sprintf("Checking for conflicts with '%s'...", s.ConflictCheckTarget)))
+		case s.ConflictCheckErr != nil:
+			sb.WriteString(dimText(fmt.Sprintf("Conflict pre-check unavailable: %s", s.ConflictCheckErr.Error())))
+		case len(s.ConflictFiles) == 0:
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Success).Render(
+				fmt.Sprintf("✓ No conflicts expected with '%s'", s.ConflictCheckTarget)))
+		default:
+			sb.WriteString(lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Warning).Render(
+				fmt.Sprintf("⚠ %d file(s) will conflict with '%s':", len(s.ConflictFiles), s.ConflictCheckTarget)))
+			sb.WriteString("\n")
+			files := s.ConflictFiles
+			maxLines := 8
+			if len(files) > maxLines {
+				files = files[:maxLines]
+			}
+			for _, f := range files {
+				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Error).Render("  " + truncateString(f, contentWidth-2)))
+				sb.WriteString("\n")
+			}
+			if len(s.ConflictFiles) > maxLines {
+				sb.WriteString(dimText(fmt.Sprintf("  ... (%d more files)", len(s.ConflictFiles)-maxLines)))
+				sb.WriteString("\n")
+			}
+			sb.WriteString(dimText("Esc to back out and resolve first"))
+		}
+
+		return modal.RenderedSection{Content: sb.String()}
+	}, nil)
+}
+
 // mergeMethodHintsSection renders hints for the merge method options.
 func (p *Plugin) mergeMethodHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
@@ -842,7 +898,7 @@ func (p *Plugin) mergeMethodHintsSection() modal.Section {
 		} else {
 			sb.WriteString(dimText(fmt.Sprintf("Merge directly to '%s' without PR", p.mergeState.TargetBranch)))
 			sb.WriteString("\n")
-			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Warning).Render("Warning: Bypasses code review"))
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Warning).Render("Warning: Bypasses code review"))
 		}
 
 		return modal.RenderedSection{Content: sb.String()}
@@ -858,7 +914,7 @@ func (p *Plugin) mergeWaitingSection() modal.Section {
 
 		var sb strings.Builder
 		if p.mergeState.ExistingPR {
-			sb.WriteString(lipgloss.NewStyle().Bold(true).Foreground(styles.Warning).Render("Using Existing Pull Request"))
+			sb.WriteString(lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Warning).Render("Using Existing Pull Request"))
 		} else {
 			sb.WriteString(lipgloss.NewStyle().Bold(true).Render("Pull Request Created"))
 		}
@@ -880,13 +936,13 @@ func (p *Plugin) mergeWaitingSection() modal.Section {
 
 		// Radio options
 		if p.mergeState.DeleteAfterMerge {
-			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Primary).Render(" ● Delete worktree after merge"))
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(" ● Delete worktree after merge"))
 		} else {
 			sb.WriteString(dimText(" ○ Delete worktree after merge"))
 		}
 		sb.WriteString("\n")
 		if !p.mergeState.DeleteAfterMerge {
-			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Primary).Render(" ● Keep worktree"))
+			sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Primary).Render(" ● Keep worktree"))
 		} else {
 			sb.WriteString(dimText(" ○ Keep worktree"))
 		}
@@ -904,7 +960,7 @@ func (p *Plugin) mergePostMergeHeaderSection() modal.Section {
 		if p.mergeState != nil && p.mergeState.UseDirectMerge {
 			mergeMethod = "Direct Merge Complete"
 		}
-		header := lipgloss.NewStyle().Bold(true).Foreground(styles.Success).Render(mergeMethod + "!")
+		header := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Success).Render(mergeMethod + "!")
 		separator := strings.Repeat("─", min(contentWidth, 60))
 		return modal.RenderedSection{Content: header + "\n\n" + separator}
 	}, nil)
@@ -918,14 +974,14 @@ func (p *Plugin) mergeDoneSection() modal.Section {
 		}
 
 		var sb strings.Builder
-		sb.WriteString(lipgloss.NewStyle().Bold(true).Foreground(styles.Success).Render("Merge workflow complete!"))
+		sb.WriteString(lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Success).Render("Merge workflow complete!"))
 		sb.WriteString("\n\n")
 
 		if p.mergeState.CleanupResults != nil {
 			results := p.mergeState.CleanupResults
 			sb.WriteString("Summary:\n")
 
-			successStyle := lipgloss.NewStyle().Foreground(styles.Success)
+			successStyle := lipgloss.NewStyle().Foreground(styles.Current().Success)
 			if results.LocalWorktreeDeleted {
 				sb.WriteString(successStyle.Render("  ✓ Local worktree deleted"))
 				sb.WriteString("\n")
@@ -943,8 +999,8 @@ func (p *Plugin) mergeDoneSection() modal.Section {
 					sb.WriteString(successStyle.Render("  ✓ Pulled latest changes"))
 					sb.WriteString("\n")
 				} else if results.PullError != nil {
-					warnStyle := lipgloss.NewStyle().Foreground(styles.Warning)
-					errorStyle := lipgloss.NewStyle().Foreground(styles.Error)
+					warnStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning)
+					errorStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 
 					sb.WriteString(warnStyle.Render("  ⚠ Pull failed: "))
 					sb.WriteString(errorStyle.Render(results.PullErrorSummary))
@@ -999,7 +1055,7 @@ func (p *Plugin) mergeDoneSection() modal.Section {
 
 			if len(results.Errors) > 0 {
 				sb.WriteString("\n")
-				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Warning).Render("Warnings:"))
+				sb.WriteString(lipgloss.NewStyle().Foreground(styles.Current().Warning).Render("Warnings:"))
 				sb.WriteString("\n")
 				for _, err := range results.Errors {
 					sb.WriteString(dimText("  • " + err))
@@ -1131,7 +1187,7 @@ func (p *Plugin) commitForMergeErrorSection() modal.Section {
 			return modal.RenderedSection{}
 		}
 
-		errStyle := lipgloss.NewStyle().Foreground(styles.Error)
+		errStyle := lipgloss.NewStyle().Foreground(styles.Current().Error)
 		content := errStyle.Render("Error: " + p.mergeCommitState.Error)
 
 		return modal.RenderedSection{Content: content}
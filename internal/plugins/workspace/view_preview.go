@@ -53,6 +53,10 @@ func (p *Plugin) renderPreviewContent(width, height int) string {
 	var content string
 	switch p.previewTab {
 	case PreviewTabOutput:
+		if p.splitPreviewActive() {
+			content = p.renderSplitPreview(width, contentHeight)
+			break
+		}
 		content = p.renderOutputContent(width, contentHeight)
 		if interactive {
 			p.interactiveState.ContentRowOffset += 2
@@ -64,6 +68,8 @@ func (p *Plugin) renderPreviewContent(width, height int) string {
 		content = p.renderDiffContent(width, contentHeight)
 	case PreviewTabTask:
 		content = p.renderTaskContent(width, contentHeight)
+	case PreviewTabCI:
+		content = p.renderCIContent(width, contentHeight)
 	}
 
 	lines = append(lines, content)
@@ -92,8 +98,8 @@ func (p *Plugin) renderWelcomeGuide(width, height int) string {
 	var lines []string
 
 	// Section Style
-	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Primary)
-	warningStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Warning)
+	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Primary)
+	warningStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Warning)
 
 	// Check if tmux is installed
 	if !isTmuxInstalled() {
@@ -189,14 +195,17 @@ func (p *Plugin) truncateAllLines(content string, maxWidth int) string {
 
 // renderTabs renders the preview pane tab header.
 func (p *Plugin) renderTabs(width int) string {
-	tabs := []string{"Output", "Diff", "Task"}
+	tabs := []string{"Output", "Diff", "Task", "CI"}
+	if p.splitPreviewActive() {
+		tabs[PreviewTabOutput] = "Output+Diff"
+	}
 	var rendered []string
 
 	for i, tab := range tabs {
 		if PreviewTab(i) == p.previewTab {
-			rendered = append(rendered, styles.RenderPillWithStyle(tab, styles.BarChipActive, ""))
+			rendered = append(rendered, styles.RenderPillWithStyle(tab, styles.Current().BarChipActive, ""))
 		} else {
-			rendered = append(rendered, styles.RenderPillWithStyle(tab, styles.BarChip, ""))
+			rendered = append(rendered, styles.RenderPillWithStyle(tab, styles.Current().BarChip, ""))
 		}
 	}
 
@@ -215,6 +224,14 @@ func (p *Plugin) renderOutputContent(width, height int) string {
 		return p.renderOrphanedMessage(wt.ChosenAgentType)
 	}
 
+	if p.replay != nil && p.replay.WorkspaceName == wt.Name {
+		return p.renderReplay(width, height)
+	}
+
+	if wt.Agent == nil && wt.Hooks.Blocking() {
+		return renderHookOutput(wt.Hooks, width, height)
+	}
+
 	if wt.Agent == nil {
 		return dimText("No agent running\nPress 's' to start an agent")
 	}
@@ -408,7 +425,7 @@ func (p *Plugin) renderOrphanedMessage(agentType AgentType) string {
 
 	// Action prompt
 	actionStyle := lipgloss.NewStyle().
-		Foreground(styles.Primary)
+		Foreground(styles.Current().Primary)
 	lines = append(lines, actionStyle.Render("Press Enter to start a new session"))
 
 	return strings.Join(lines, "\n")
@@ -607,8 +624,8 @@ func (p *Plugin) renderShellPrimer(width, height int) string {
 	var lines []string
 
 	// Section style
-	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Primary)
-	warningStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Warning)
+	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Primary)
+	warningStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Warning)
 
 	// Check if tmux is installed
 	if !isTmuxInstalled() {
@@ -669,14 +686,14 @@ func (p *Plugin) renderCommitStatusHeader(width int) string {
 	// Box style for header
 	headerStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.Primary).
+		BorderForeground(styles.Current().Primary).
 		Padding(0, 1).
 		Width(width - 2)
 
-	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Primary)
-	hashStyle := lipgloss.NewStyle().Foreground(styles.Warning)
-	pushedStyle := lipgloss.NewStyle().Foreground(styles.Success)
-	localStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Primary)
+	hashStyle := lipgloss.NewStyle().Foreground(styles.Current().Warning)
+	pushedStyle := lipgloss.NewStyle().Foreground(styles.Current().Success)
+	localStyle := lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
 
 	var sb strings.Builder
 	sb.WriteString(titleStyle.Render(fmt.Sprintf("Commits (%d)", len(p.commitStatusList))))
@@ -745,9 +762,9 @@ func (p *Plugin) renderMainWorktreeView(width, height int) string {
 	}
 
 	// Styles
-	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Primary)
-	treeStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
-	hintStyle := lipgloss.NewStyle().Foreground(styles.Success)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Current().Primary)
+	treeStyle := lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
+	hintStyle := lipgloss.NewStyle().Foreground(styles.Current().Success)
 
 	// Center the tree
 	for _, line := range tree {
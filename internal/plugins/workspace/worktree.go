@@ -152,7 +152,7 @@ func (p *Plugin) listWorktrees() ([]*Worktree, error) {
 		_ = doWorktreePrune(p.ctx.WorkDir)
 	}
 
-	return filtered, nil
+	return append(filtered, p.listRemoteWorktrees()...), nil
 }
 
 // parseWorktreeList parses porcelain format output.
@@ -660,13 +660,15 @@ func (p *Plugin) linkTask(wt *Worktree, taskID string) tea.Cmd {
 }
 
 // unlinkTask returns a command to unlink a td task from a worktree.
+// The .forge-task file is emptied rather than removed so the branch name
+// does not auto-link the task again.
 func (p *Plugin) unlinkTask(wt *Worktree) tea.Cmd {
 	return func() tea.Msg {
 		taskPath := filepath.Join(wt.Path, forgeTaskFile)
-		if err := os.Remove(taskPath); err != nil && !os.IsNotExist(err) {
+		if err := os.WriteFile(taskPath, nil, 0644); err != nil {
 			return TaskLinkedMsg{
 				WorkspaceName: wt.Name,
-				Err:           fmt.Errorf("remove .forge-task: %w", err),
+				Err:           fmt.Errorf("clear .forge-task: %w", err),
 			}
 		}
 
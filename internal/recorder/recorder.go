@@ -0,0 +1,189 @@
+// Package recorder captures user interactions (keys, mouse clicks, resizes,
+// and plugin/context navigation) to a JSON-lines script and replays them
+// against a headless program to reproduce UI bugs. Typed text is masked so
+// scripts never contain user content.
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Entry kinds.
+const (
+	KindKey    = "key"
+	KindMouse  = "mouse"
+	KindResize = "resize"
+	KindNav    = "nav" // informational: active plugin or context changed
+)
+
+// maskedKey replaces printable keys typed into text inputs.
+const maskedKey = "<text>"
+
+// Entry is one recorded interaction.
+type Entry struct {
+	At      time.Duration `json:"at"` // offset from the start of recording
+	Kind    string        `json:"kind"`
+	Key     string        `json:"key,omitempty"` // key name, or mouse event description
+	Button  int           `json:"button,omitempty"`
+	Action  int           `json:"action,omitempty"`
+	X       int           `json:"x,omitempty"`
+	Y       int           `json:"y,omitempty"`
+	Width   int           `json:"width,omitempty"`
+	Height  int           `json:"height,omitempty"`
+	Plugin  string        `json:"plugin,omitempty"`
+	Context string        `json:"context,omitempty"`
+}
+
+// Inspector exposes the navigation state of the recorded model. Models that
+// don't implement it are recorded without nav entries or text masking.
+type Inspector interface {
+	ActivePluginID() string
+	ActiveContext() string
+	TextInputFocused() bool
+}
+
+// Recorder appends entries to a script. Safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	w     io.WriteCloser
+	enc   *json.Encoder
+	start time.Time
+	err   error
+}
+
+// Create starts a recording at path, truncating any existing file.
+func Create(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("create recording: %w", err)
+	}
+	return New(f), nil
+}
+
+// New records to w.
+func New(w io.WriteCloser) *Recorder {
+	return &Recorder{w: w, enc: json.NewEncoder(w), start: time.Now()}
+}
+
+// Record appends an entry, stamping its offset. The first write error is
+// kept and reported by Close.
+func (r *Recorder) Record(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	e.At = time.Since(r.start).Round(time.Millisecond)
+	r.err = r.enc.Encode(e)
+}
+
+// Close finishes the recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.w.Close(); r.err == nil {
+		r.err = err
+	}
+	return r.err
+}
+
+// Load reads a recorded script.
+func Load(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+// entryFor converts a message to an entry. Returns false for messages that
+// aren't user interactions (mouse motion included, to keep scripts small).
+func entryFor(msg tea.Msg, textInput bool) (Entry, bool) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		key := msg.String()
+		if textInput && msg.Type == tea.KeyRunes && !msg.Alt {
+			key = maskedKey
+		}
+		return Entry{Kind: KindKey, Key: key}, true
+	case tea.MouseMsg:
+		if msg.Action == tea.MouseActionMotion && msg.Button == tea.MouseButtonNone {
+			return Entry{}, false
+		}
+		return Entry{
+			Kind:   KindMouse,
+			Key:    tea.MouseEvent(msg).String(),
+			Button: int(msg.Button),
+			Action: int(msg.Action),
+			X:      msg.X,
+			Y:      msg.Y,
+		}, true
+	case tea.WindowSizeMsg:
+		return Entry{Kind: KindResize, Width: msg.Width, Height: msg.Height}, true
+	}
+	return Entry{}, false
+}
+
+// Model wraps a tea.Model, recording each interaction before passing it on.
+type Model struct {
+	inner      tea.Model
+	rec        *Recorder
+	lastPlugin string
+	lastCtx    string
+}
+
+// Wrap records interactions with m to rec.
+func Wrap(m tea.Model, rec *Recorder) Model {
+	return Model{inner: m, rec: rec}
+}
+
+// Unwrap returns the wrapped model.
+func (m Model) Unwrap() tea.Model { return m.inner }
+
+// Init implements tea.Model.
+func (m Model) Init() tea.Cmd { return m.inner.Init() }
+
+// View implements tea.Model.
+func (m Model) View() string { return m.inner.View() }
+
+// Update implements tea.Model.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	insp, _ := m.inner.(Inspector)
+	if e, ok := entryFor(msg, insp != nil && insp.TextInputFocused()); ok {
+		m.rec.Record(e)
+	}
+
+	var cmd tea.Cmd
+	m.inner, cmd = m.inner.Update(msg)
+
+	if insp, ok := m.inner.(Inspector); ok {
+		pluginID, ctx := insp.ActivePluginID(), insp.ActiveContext()
+		if pluginID != m.lastPlugin || ctx != m.lastCtx {
+			m.rec.Record(Entry{Kind: KindNav, Plugin: pluginID, Context: ctx})
+			m.lastPlugin, m.lastCtx = pluginID, ctx
+		}
+	}
+	return m, cmd
+}
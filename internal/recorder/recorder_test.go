@@ -0,0 +1,131 @@
+package recorder
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stubModel counts keys and reports a context and text-input state.
+type stubModel struct {
+	keys   []string
+	width  int
+	typing bool
+}
+
+func (m stubModel) Init() tea.Cmd { return nil }
+func (m stubModel) View() string  { return strings.Join(m.keys, ",") }
+
+func (m stubModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		m.keys = append(m.keys, msg.String())
+		if msg.String() == "/" {
+			m.typing = true
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m stubModel) ActivePluginID() string { return "stub" }
+func (m stubModel) TextInputFocused() bool { return m.typing }
+
+func (m stubModel) ActiveContext() string {
+	if m.typing {
+		return "search"
+	}
+	return "list"
+}
+
+func TestRecordAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "script.jsonl")
+	rec, err := Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m tea.Model = Wrap(stubModel{}, rec)
+	for _, msg := range []tea.Msg{
+		tea.WindowSizeMsg{Width: 80, Height: 24},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}, // typed text: masked
+		tea.KeyMsg{Type: tea.KeyEnter},
+		tea.MouseMsg{X: 3, Y: 4, Button: tea.MouseButtonNone, Action: tea.MouseActionMotion}, // skipped
+		tea.MouseMsg{X: 3, Y: 4, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress},
+	} {
+		m, _ = m.Update(msg)
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range entries {
+		switch e.Kind {
+		case KindNav:
+			got = append(got, "nav:"+e.Context)
+		case KindResize:
+			got = append(got, "resize")
+		default:
+			got = append(got, e.Key)
+		}
+	}
+	want := "resize nav:list j / nav:search <text> enter left press"
+	if strings.Join(got, " ") != want {
+		t.Errorf("entries = %q, want %q", strings.Join(got, " "), want)
+	}
+}
+
+func TestEntryMsgRoundTrip(t *testing.T) {
+	for _, k := range []tea.KeyMsg{
+		{Type: tea.KeyCtrlC},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyShiftTab},
+		{Type: tea.KeyRunes, Runes: []rune("x"), Alt: true},
+		{Type: tea.KeyUp, Alt: true},
+		{Type: tea.KeySpace, Runes: []rune(" ")},
+		{Type: tea.KeyRunes, Runes: []rune("G")},
+	} {
+		e, _ := entryFor(k, false)
+		msg, ok := e.Msg()
+		if !ok || msg.(tea.KeyMsg).String() != k.String() {
+			t.Errorf("key %q replayed as %v", k.String(), msg)
+		}
+	}
+
+	click := tea.MouseMsg{X: 10, Y: 2, Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress}
+	e, _ := entryFor(click, false)
+	if msg, _ := e.Msg(); msg != click {
+		t.Errorf("mouse replayed as %+v", msg)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	entries := []Entry{
+		{Kind: KindKey, Key: "j"},
+		{Kind: KindNav, Plugin: "stub"},
+		{Kind: KindKey, Key: maskedKey},
+		{Kind: KindKey, Key: "ctrl+d"},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	final, err := Replay(ctx, stubModel{}, entries, ReplayOptions{Settle: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := final.(stubModel)
+	if m.View() != "j,x,ctrl+d" || m.width != defaultReplayWidth {
+		t.Errorf("final view %q width %d", m.View(), m.width)
+	}
+}
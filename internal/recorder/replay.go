@@ -0,0 +1,122 @@
+package recorder
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Default replay terminal size, used when a script has no resize entry.
+const (
+	defaultReplayWidth  = 120
+	defaultReplayHeight = 40
+)
+
+var (
+	// keyTypes inverts bubbletea's key names so recorded strings round-trip.
+	keyTypes     = make(map[string]tea.KeyType)
+	maskedReplay = []rune("x")
+)
+
+func init() {
+	for kt := tea.KeyType(-256); kt <= tea.KeyBackspace; kt++ {
+		if name := kt.String(); name != "" && kt != tea.KeyRunes {
+			if _, dup := keyTypes[name]; !dup {
+				keyTypes[name] = kt
+			}
+		}
+	}
+}
+
+// parseKey converts a tea.KeyMsg string back into a key message. Masked
+// text replays as a single "x" so keystroke counts are preserved.
+func parseKey(s string) tea.KeyMsg {
+	if s == maskedKey {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: maskedReplay}
+	}
+	var alt bool
+	if rest, ok := strings.CutPrefix(s, "alt+"); ok && rest != "" {
+		alt, s = true, rest
+	}
+	if kt, ok := keyTypes[s]; ok {
+		return tea.KeyMsg{Type: kt, Alt: alt}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s), Alt: alt}
+}
+
+// Msg returns the message that replays the entry. Nav entries have none.
+func (e Entry) Msg() (tea.Msg, bool) {
+	switch e.Kind {
+	case KindKey:
+		return parseKey(e.Key), true
+	case KindMouse:
+		return tea.MouseMsg{
+			X:      e.X,
+			Y:      e.Y,
+			Button: tea.MouseButton(e.Button),
+			Action: tea.MouseAction(e.Action),
+		}, true
+	case KindResize:
+		return tea.WindowSizeMsg{Width: e.Width, Height: e.Height}, true
+	}
+	return nil, false
+}
+
+// ReplayOptions controls a replay.
+type ReplayOptions struct {
+	// Speed scales recorded timing (1 = real time); 0 sends entries back to back.
+	Speed float64
+	// Settle is how long to let async work finish after the last entry.
+	Settle time.Duration
+}
+
+// Replay runs m in a headless program (no terminal input or output),
+// feeds the script's interactions in order, and returns the final model.
+// The script drives the program exactly as a user would, so it can be run
+// against fixture data to reproduce a recorded UI bug.
+func Replay(ctx context.Context, m tea.Model, entries []Entry, opts ReplayOptions) (tea.Model, error) {
+	p := tea.NewProgram(m,
+		tea.WithContext(ctx),
+		tea.WithInput(nil),
+		tea.WithOutput(io.Discard),
+		tea.WithoutSignalHandler(),
+	)
+
+	go func() {
+		if len(entries) == 0 || entries[0].Kind != KindResize {
+			p.Send(tea.WindowSizeMsg{Width: defaultReplayWidth, Height: defaultReplayHeight})
+		}
+		var prev time.Duration
+		for _, e := range entries {
+			msg, ok := e.Msg()
+			if !ok {
+				continue
+			}
+			if opts.Speed > 0 && e.At > prev {
+				sleep(ctx, time.Duration(float64(e.At-prev)/opts.Speed))
+			}
+			prev = e.At
+			p.Send(msg)
+		}
+		sleep(ctx, opts.Settle)
+		p.Quit()
+	}()
+
+	return p.Run()
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
@@ -13,6 +13,7 @@ type State struct {
 	WorkspaceDiffMode string `json:"workspaceDiffMode,omitempty"` // "unified" or "side-by-side"
 	GitGraphEnabled   bool   `json:"gitGraphEnabled,omitempty"`   // Show commit graph in sidebar
 	LineWrapEnabled   bool   `json:"lineWrapEnabled,omitempty"`   // Wrap long lines instead of truncating
+	WorkspaceSplit    bool   `json:"workspaceSplit,omitempty"`    // Show output and diff together in workspace preview
 
 	// Pane width preferences (percentage of total width, 0 = use default)
 	FileBrowserTreeWidth   int `json:"fileBrowserTreeWidth,omitempty"`
@@ -28,6 +29,15 @@ type State struct {
 
 	// Worktree state: maps main repo path -> last active worktree path
 	LastWorktreePath map[string]string `json:"lastWorktreePath,omitempty"`
+
+	// Conversation titles set by the user: "adapterID/sessionID" -> title
+	SessionTitles map[string]string `json:"sessionTitles,omitempty"`
+
+	// Models pinned in the conversations model comparison view
+	PinnedModels []string `json:"pinnedModels,omitempty"`
+
+	// Recorded key macros: register -> key names, e.g. "a" -> ["enter", "s"]
+	Macros map[string][]string `json:"macros,omitempty"`
 }
 
 // FileBrowserTabState holds persistent tab state for the file browser.
@@ -129,6 +139,17 @@ func Save() error {
 	return os.WriteFile(path, data, 0644)
 }
 
+// Dir returns the directory holding the state file, or "" before Init.
+// Plugins store larger per-user data (e.g. recordings) beneath it.
+func Dir() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if path == "" {
+		return ""
+	}
+	return filepath.Dir(path)
+}
+
 // GetGitDiffMode returns the saved diff mode.
 func GetGitDiffMode() string {
 	mu.RLock()
@@ -171,6 +192,27 @@ func SetWorkspaceDiffMode(mode string) error {
 	return Save()
 }
 
+// GetWorkspaceSplitPreview returns whether the workspace split preview is enabled.
+func GetWorkspaceSplitPreview() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil {
+		return false
+	}
+	return current.WorkspaceSplit
+}
+
+// SetWorkspaceSplitPreview saves the workspace split preview preference.
+func SetWorkspaceSplitPreview(enabled bool) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	current.WorkspaceSplit = enabled
+	mu.Unlock()
+	return Save()
+}
+
 // GetGitGraphEnabled returns whether the commit graph is enabled.
 func GetGitGraphEnabled() bool {
 	mu.RLock()
@@ -449,3 +491,88 @@ func SetNotesListWidth(width int) error {
 	mu.Unlock()
 	return Save()
 }
+
+// sessionTitleKey returns the SessionTitles key for a conversation session.
+func sessionTitleKey(adapterID, sessionID string) string {
+	return adapterID + "/" + sessionID
+}
+
+// GetSessionTitle returns the user-set title for a conversation session.
+func GetSessionTitle(adapterID, sessionID string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil || current.SessionTitles == nil {
+		return ""
+	}
+	return current.SessionTitles[sessionTitleKey(adapterID, sessionID)]
+}
+
+// SetSessionTitle saves a user-set title for a conversation session.
+// An empty title removes the override.
+func SetSessionTitle(adapterID, sessionID, title string) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	key := sessionTitleKey(adapterID, sessionID)
+	if title == "" {
+		delete(current.SessionTitles, key)
+	} else {
+		if current.SessionTitles == nil {
+			current.SessionTitles = make(map[string]string)
+		}
+		current.SessionTitles[key] = title
+	}
+	mu.Unlock()
+	return Save()
+}
+
+// GetPinnedModels returns the models pinned for comparison.
+func GetPinnedModels() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil {
+		return nil
+	}
+	return append([]string(nil), current.PinnedModels...)
+}
+
+// SetPinnedModels saves the models pinned for comparison.
+func SetPinnedModels(models []string) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	current.PinnedModels = append([]string(nil), models...)
+	mu.Unlock()
+	return Save()
+}
+
+// GetMacro returns the keys recorded in a macro register.
+func GetMacro(register string) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil {
+		return nil
+	}
+	return append([]string(nil), current.Macros[register]...)
+}
+
+// SetMacro saves the keys recorded in a macro register. No keys clears
+// the register.
+func SetMacro(register string, keys []string) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	if len(keys) == 0 {
+		delete(current.Macros, register)
+	} else {
+		if current.Macros == nil {
+			current.Macros = make(map[string][]string)
+		}
+		current.Macros[register] = append([]string(nil), keys...)
+	}
+	mu.Unlock()
+	return Save()
+}
@@ -754,3 +754,78 @@ func TestSetLineWrapEnabled_InitializesNilState(t *testing.T) {
 		t.Errorf("LineWrapEnabled = %v, want true", current.LineWrapEnabled)
 	}
 }
+
+func TestSessionTitle_SetAndClear(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalPath := path
+	originalCurrent := current
+	defer func() {
+		path = originalPath
+		current = originalCurrent
+	}()
+
+	stateFile := filepath.Join(tmpDir, "state.json")
+	path = stateFile
+	current = nil
+
+	if err := SetSessionTitle("claude-code", "abc", "Fix login flow"); err != nil {
+		t.Fatalf("SetSessionTitle() failed: %v", err)
+	}
+	if got := GetSessionTitle("claude-code", "abc"); got != "Fix login flow" {
+		t.Errorf("GetSessionTitle() = %q, want %q", got, "Fix login flow")
+	}
+	if got := GetSessionTitle("codex", "abc"); got != "" {
+		t.Errorf("title should be scoped by adapter, got %q", got)
+	}
+
+	data, _ := os.ReadFile(stateFile)
+	var loaded State
+	_ = json.Unmarshal(data, &loaded)
+	if loaded.SessionTitles["claude-code/abc"] != "Fix login flow" {
+		t.Errorf("saved SessionTitles = %v", loaded.SessionTitles)
+	}
+
+	if err := SetSessionTitle("claude-code", "abc", ""); err != nil {
+		t.Fatalf("SetSessionTitle() clear failed: %v", err)
+	}
+	if got := GetSessionTitle("claude-code", "abc"); got != "" {
+		t.Errorf("GetSessionTitle() after clear = %q, want empty", got)
+	}
+}
+
+func TestMacro_SetAndClear(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalPath := path
+	originalCurrent := current
+	defer func() {
+		path = originalPath
+		current = originalCurrent
+	}()
+
+	stateFile := filepath.Join(tmpDir, "state.json")
+	path = stateFile
+	current = nil
+
+	keys := []string{"enter", "s", "j"}
+	if err := SetMacro("a", keys); err != nil {
+		t.Fatalf("SetMacro() failed: %v", err)
+	}
+	keys[0] = "x" // the saved macro is a copy
+	if got := GetMacro("a"); len(got) != 3 || got[0] != "enter" {
+		t.Errorf("GetMacro() = %v", got)
+	}
+
+	data, _ := os.ReadFile(stateFile)
+	var loaded State
+	_ = json.Unmarshal(data, &loaded)
+	if len(loaded.Macros["a"]) != 3 {
+		t.Errorf("saved Macros = %v", loaded.Macros)
+	}
+
+	if err := SetMacro("a", nil); err != nil {
+		t.Fatalf("SetMacro() clear failed: %v", err)
+	}
+	if got := GetMacro("a"); got != nil {
+		t.Errorf("GetMacro() after clear = %v, want nil", got)
+	}
+}
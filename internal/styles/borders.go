@@ -1,20 +1,86 @@
 package styles
 
 import (
+	"math"
 	"strings"
+	"sync/atomic"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Border characters for rounded borders (matching lipgloss.RoundedBorder)
-const (
-	borderCornerTL   = "╭"
-	borderCornerTR   = "╮"
-	borderCornerBL   = "╰"
-	borderCornerBR   = "╯"
-	borderHorizontal = "─"
-	borderVertical   = "│"
-)
+// borderChars is the set of characters used to draw a panel border.
+type borderChars struct {
+	cornerTL, cornerTR, cornerBL, cornerBR string
+	horizontal, vertical                   string
+}
+
+// roundedBorder matches lipgloss.RoundedBorder.
+var roundedBorder = borderChars{"╭", "╮", "╰", "╯", "─", "│"}
+
+// doubleBorder matches lipgloss.DoubleBorder; used for the focused panel
+// when HighContrastFocus is enabled.
+var doubleBorder = borderChars{"╔", "╗", "╚", "╝", "═", "║"}
+
+// PanelGradient colors a panel border. Sides with their own gradient are
+// colored along their length (left to right, top to bottom); the others
+// use Base at its angle. Corners belong to the top and bottom sides.
+type PanelGradient struct {
+	Base                     Gradient
+	Top, Right, Bottom, Left *Gradient
+}
+
+// colorAt returns the border color at cell (x, y) of a width x height box.
+func (p PanelGradient) colorAt(x, y, width, height int) RGB {
+	switch {
+	case y == 0 && p.Top != nil:
+		return p.Top.ColorAt(along(x, width))
+	case y == height-1 && p.Bottom != nil:
+		return p.Bottom.ColorAt(along(x, width))
+	case x == 0 && p.Left != nil:
+		return p.Left.ColorAt(along(y, height))
+	case x == width-1 && p.Right != nil:
+		return p.Right.ColorAt(along(y, height))
+	}
+	return p.Base.ColorAt(p.Base.PositionAt(x, y, width, height))
+}
+
+// RotateHue returns a copy of p with every gradient's hue shifted by deg.
+func (p PanelGradient) RotateHue(deg float64) PanelGradient {
+	rotated := PanelGradient{Base: p.Base.RotateHue(deg)}
+	for _, side := range []struct{ src, dst **Gradient }{
+		{&p.Top, &rotated.Top}, {&p.Right, &rotated.Right},
+		{&p.Bottom, &rotated.Bottom}, {&p.Left, &rotated.Left},
+	} {
+		if *side.src != nil {
+			g := (*side.src).RotateHue(deg)
+			*side.dst = &g
+		}
+	}
+	return rotated
+}
+
+// along returns the position (0-1) of cell i along a side of n cells.
+func along(i, n int) float64 {
+	if n <= 1 {
+		return 0.5
+	}
+	return float64(i) / float64(n-1)
+}
+
+// activeHueShift is the hue rotation, in degrees, applied to the active
+// panel border. Stored as float64 bits; see SetActiveHueShift.
+var activeHueShift atomic.Uint64
+
+// SetActiveHueShift sets the hue rotation applied to the active panel's
+// border. The app advances it while the animated_borders feature is on.
+func SetActiveHueShift(deg float64) {
+	activeHueShift.Store(math.Float64bits(math.Mod(deg, 360)))
+}
+
+// ActiveHueShift returns the current active border hue rotation in degrees.
+func ActiveHueShift() float64 {
+	return math.Float64frombits(activeHueShift.Load())
+}
 
 // colorChar wraps a character with ANSI foreground color.
 func colorChar(char string, color RGB) string {
@@ -25,6 +91,16 @@ func colorChar(char string, color RGB) string {
 // The gradient flows at the specified angle (typically 30 degrees).
 // width and height are the outer dimensions including borders.
 func RenderGradientBorder(content string, width, height int, gradient Gradient, padding int) string {
+	return renderGradientBorder(content, width, height, PanelGradient{Base: gradient}, padding, roundedBorder)
+}
+
+// RenderPanelGradientBorder is RenderGradientBorder with per-side gradients.
+func RenderPanelGradientBorder(content string, width, height int, gradient PanelGradient, padding int) string {
+	return renderGradientBorder(content, width, height, gradient, padding, roundedBorder)
+}
+
+// renderGradientBorder draws the border with the given characters.
+func renderGradientBorder(content string, width, height int, gradient PanelGradient, padding int, chars borderChars) string {
 	if width < 3 || height < 3 {
 		return content
 	}
@@ -68,70 +144,46 @@ func RenderGradientBorder(content string, width, height int, gradient Gradient,
 	var result strings.Builder
 
 	// Render top border
-	result.WriteString(renderGradientBorderTop(width, height, gradient))
+	result.WriteString(renderGradientBorderTop(width, height, gradient, chars))
 	result.WriteString("\n")
 
 	// Render content lines with side borders
 	for y, line := range paddedLines {
 		// Left border (y+1 because top border is y=0)
-		leftPos := gradient.PositionAt(0, y+1, width, height)
-		result.WriteString(colorChar(borderVertical, gradient.ColorAt(leftPos)))
+		result.WriteString(colorChar(chars.vertical, gradient.colorAt(0, y+1, width, height)))
 
 		// Content
 		result.WriteString(line)
 
 		// Right border
-		rightPos := gradient.PositionAt(width-1, y+1, width, height)
-		result.WriteString(colorChar(borderVertical, gradient.ColorAt(rightPos)))
+		result.WriteString(colorChar(chars.vertical, gradient.colorAt(width-1, y+1, width, height)))
 		result.WriteString("\n")
 	}
 
 	// Render bottom border
-	result.WriteString(renderGradientBorderBottom(width, height, gradient))
+	result.WriteString(renderGradientBorderBottom(width, height, gradient, chars))
 
 	return result.String()
 }
 
 // renderGradientBorderTop renders the top border line with gradient colors.
-func renderGradientBorderTop(width, height int, g Gradient) string {
-	var sb strings.Builder
-
-	// Top-left corner (position 0, 0)
-	pos := g.PositionAt(0, 0, width, height)
-	sb.WriteString(colorChar(borderCornerTL, g.ColorAt(pos)))
-
-	// Horizontal line
-	for x := 1; x < width-1; x++ {
-		pos := g.PositionAt(x, 0, width, height)
-		sb.WriteString(colorChar(borderHorizontal, g.ColorAt(pos)))
-	}
-
-	// Top-right corner
-	pos = g.PositionAt(width-1, 0, width, height)
-	sb.WriteString(colorChar(borderCornerTR, g.ColorAt(pos)))
-
-	return sb.String()
+func renderGradientBorderTop(width, height int, g PanelGradient, chars borderChars) string {
+	return renderGradientBorderRow(0, width, height, g, chars.cornerTL, chars.horizontal, chars.cornerTR)
 }
 
 // renderGradientBorderBottom renders the bottom border line with gradient colors.
-func renderGradientBorderBottom(width, height int, g Gradient) string {
-	var sb strings.Builder
-	y := height - 1
-
-	// Bottom-left corner
-	pos := g.PositionAt(0, y, width, height)
-	sb.WriteString(colorChar(borderCornerBL, g.ColorAt(pos)))
+func renderGradientBorderBottom(width, height int, g PanelGradient, chars borderChars) string {
+	return renderGradientBorderRow(height-1, width, height, g, chars.cornerBL, chars.horizontal, chars.cornerBR)
+}
 
-	// Horizontal line
+// renderGradientBorderRow renders a horizontal border row at y.
+func renderGradientBorderRow(y, width, height int, g PanelGradient, left, horizontal, right string) string {
+	var sb strings.Builder
+	sb.WriteString(colorChar(left, g.colorAt(0, y, width, height)))
 	for x := 1; x < width-1; x++ {
-		pos := g.PositionAt(x, y, width, height)
-		sb.WriteString(colorChar(borderHorizontal, g.ColorAt(pos)))
+		sb.WriteString(colorChar(horizontal, g.colorAt(x, y, width, height)))
 	}
-
-	// Bottom-right corner
-	pos = g.PositionAt(width-1, y, width, height)
-	sb.WriteString(colorChar(borderCornerBR, g.ColorAt(pos)))
-
+	sb.WriteString(colorChar(right, g.colorAt(width-1, y, width, height)))
 	return sb.String()
 }
 
@@ -250,6 +302,31 @@ func GetActiveGradient() Gradient {
 	return NewGradient(colors, angle)
 }
 
+// GetActivePanelGradient returns the active panel gradient, including any
+// per-side gradients, with the current hue shift applied.
+func GetActivePanelGradient() PanelGradient {
+	c := GetCurrentTheme().Colors
+	pg := PanelGradient{Base: GetActiveGradient()}
+	for _, side := range []struct {
+		colors []string
+		dst    **Gradient
+	}{
+		{c.GradientBorderActiveTop, &pg.Top},
+		{c.GradientBorderActiveRight, &pg.Right},
+		{c.GradientBorderActiveBottom, &pg.Bottom},
+		{c.GradientBorderActiveLeft, &pg.Left},
+	} {
+		if len(side.colors) > 0 {
+			g := NewGradient(side.colors, 0)
+			*side.dst = &g
+		}
+	}
+	if shift := ActiveHueShift(); shift != 0 {
+		pg = pg.RotateHue(shift)
+	}
+	return pg
+}
+
 // GetNormalGradient returns the gradient for inactive panels from current theme.
 func GetNormalGradient() Gradient {
 	theme := GetCurrentTheme()
@@ -291,15 +368,22 @@ func GetInteractiveGradient() Gradient {
 // active determines whether to use active (focused) or normal gradient.
 // width and height are the outer dimensions including borders.
 func RenderPanel(content string, width, height int, active bool) string {
-	var gradient Gradient
+	var gradient PanelGradient
+	chars := roundedBorder
 	if active {
-		gradient = GetActiveGradient()
+		gradient = GetActivePanelGradient()
+		if HighContrastFocus {
+			chars = doubleBorder
+		}
 	} else {
-		gradient = GetNormalGradient()
+		gradient = PanelGradient{Base: GetNormalGradient()}
+		if DimInactivePanes {
+			content = DimContent(content)
+		}
 	}
 
 	// Use padding of 1 to match lipgloss panel padding
-	return RenderGradientBorder(content, width, height, gradient, 1)
+	return renderGradientBorder(content, width, height, gradient, 1, chars)
 }
 
 // RenderPanelWithGradient renders content in a panel with a custom gradient.
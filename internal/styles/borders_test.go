@@ -1,6 +1,9 @@
 package styles
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestTruncateString(t *testing.T) {
 	tests := []struct {
@@ -186,3 +189,100 @@ func TestRuneWidth(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderPanel_HighContrastFocus(t *testing.T) {
+	defer SetHighContrastFocus(false)
+
+	SetHighContrastFocus(false)
+	if got := RenderPanel("x", 6, 3, true); !strings.Contains(got, "╭") {
+		t.Errorf("default active panel should use rounded border:\n%s", got)
+	}
+
+	SetHighContrastFocus(true)
+	if got := RenderPanel("x", 6, 3, true); !strings.Contains(got, "╔") || !strings.Contains(got, "║") {
+		t.Errorf("high-contrast active panel should use double border:\n%s", got)
+	}
+	if got := RenderPanel("x", 6, 3, false); !strings.Contains(got, "╭") {
+		t.Errorf("inactive panel should keep rounded border:\n%s", got)
+	}
+	if !Current().ListItemSelected.GetReverse() || !Current().ButtonFocused.GetReverse() {
+		t.Error("selected row and focused button should use inverse video")
+	}
+}
+
+func TestNewGradient_PositionedStops(t *testing.T) {
+	g := NewGradient([]string{"#000000", "#FF0000 20%", "#00FF00", "#0000FF 10%"}, 0)
+	want := []float64{0, 0.2, 0.2, 0.2}
+	for i, s := range g.Stops {
+		if s.Position != want[i] {
+			t.Errorf("stop %d position = %v, want %v", i, s.Position, want[i])
+		}
+	}
+
+	g = NewGradient([]string{"#000000", "#808080", "#FFFFFF 50%", "#FFFFFF"}, 0)
+	if g.Stops[1].Position != 0.25 || g.Stops[3].Position != 1 {
+		t.Errorf("unpositioned stops not spread: %+v", g.Stops)
+	}
+	if got := g.ColorAt(0.75); got != (RGB{255, 255, 255}) {
+		t.Errorf("ColorAt(0.75) = %v, want white", got)
+	}
+}
+
+func TestColorAt_HoldsBeforeFirstStop(t *testing.T) {
+	g := NewGradient([]string{"#FF0000 40%", "#0000FF 60%"}, 0)
+	if got := g.ColorAt(0.1); got != (RGB{255, 0, 0}) {
+		t.Errorf("ColorAt(0.1) = %v, want red", got)
+	}
+	if got := g.ColorAt(0.9); got != (RGB{0, 0, 255}) {
+		t.Errorf("ColorAt(0.9) = %v, want blue", got)
+	}
+}
+
+func TestIsValidGradientStop(t *testing.T) {
+	for s, want := range map[string]bool{
+		"#7C3AED":       true,
+		"#7C3AED 25%":   true,
+		"#7C3AED 12.5%": true,
+		"#7C3AED 101%":  false,
+		"#7C3AED 25":    false,
+		"7C3AED 25%":    false,
+	} {
+		if got := IsValidGradientStop(s); got != want {
+			t.Errorf("IsValidGradientStop(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestRotateHue(t *testing.T) {
+	red := RGB{255, 0, 0}
+	if got := red.RotateHue(120); RGBToHex(got) != "#00ff00" {
+		t.Errorf("red rotated 120° = %s, want #00ff00", RGBToHex(got))
+	}
+	if got := red.RotateHue(-120); RGBToHex(got) != "#0000ff" {
+		t.Errorf("red rotated -120° = %s, want #0000ff", RGBToHex(got))
+	}
+	gray := RGB{128, 128, 128}
+	if got := gray.RotateHue(90); got != gray {
+		t.Errorf("gray should not change, got %v", got)
+	}
+}
+
+func TestPanelGradient_PerSide(t *testing.T) {
+	top := NewGradient([]string{"#FF0000", "#FF0000"}, 0)
+	pg := PanelGradient{Base: NewGradient([]string{"#0000FF", "#0000FF"}, 30), Top: &top}
+
+	if got := pg.colorAt(0, 0, 10, 5); got != (RGB{255, 0, 0}) {
+		t.Errorf("top corner = %v, want top gradient color", got)
+	}
+	if got := pg.colorAt(0, 2, 10, 5); got != (RGB{0, 0, 255}) {
+		t.Errorf("left side = %v, want base gradient color", got)
+	}
+
+	rotated := pg.RotateHue(120)
+	if rotated.Top == nil || RGBToHex(rotated.Top.ColorAt(0)) != "#00ff00" {
+		t.Error("RotateHue should rotate side gradients")
+	}
+	if pg.Top.ColorAt(0) != (RGB{255, 0, 0}) {
+		t.Error("RotateHue should not modify the original")
+	}
+}
@@ -0,0 +1,140 @@
+package styles
+
+import "github.com/wilbur182/forge/internal/icons"
+
+// Colorblind modes for accessibility.colorblindMode.
+const (
+	ColorblindDeuteranopia = "deuteranopia"
+	ColorblindProtanopia   = "protanopia"
+	ColorblindTritanopia   = "tritanopia"
+)
+
+// ColorblindMode remaps status colors in every theme to ones that stay
+// distinct under the named color vision deficiency, and adds non-color cues
+// (underline, italics, glyphs) to error and warning states. Set via
+// SetColorblindMode; it takes effect on the next theme apply.
+var ColorblindMode = ""
+
+// colorblindColors are the palette entries a colorblind mode replaces.
+type colorblindColors struct {
+	Success, Warning, Error   string
+	DiffAddBg, DiffRemoveBg   string
+	DangerBright, DangerHover string
+	BlameAges                 [5]string
+}
+
+// colorblindPalettes are based on the Okabe-Ito palette: red/green confusion
+// (deuteranopia, protanopia) is avoided with blue vs. orange, blue/yellow
+// confusion (tritanopia) with teal vs. red.
+var colorblindPalettes = map[string]colorblindColors{
+	ColorblindDeuteranopia: {
+		Success:      "#56B4E9", // Sky blue
+		Warning:      "#F0E442", // Yellow
+		Error:        "#D55E00", // Vermillion
+		DiffAddBg:    "#0A2540",
+		DiffRemoveBg: "#3A1F00",
+		DangerBright: "#D55E00",
+		DangerHover:  "#A84A00",
+		BlameAges:    [5]string{"#56B4E9", "#7FC4EC", "#C6D07A", "#F0E442", "#9CA3AF"},
+	},
+	ColorblindProtanopia: {
+		Success: "#56B4E9", // Sky blue
+		Warning: "#F0E442", // Yellow
+		// Reds look dark to protanopes, so errors use a lighter orange
+		Error:        "#FF8C1A",
+		DiffAddBg:    "#0A2540",
+		DiffRemoveBg: "#3A2400",
+		DangerBright: "#E67300",
+		DangerHover:  "#B35900",
+		BlameAges:    [5]string{"#56B4E9", "#7FC4EC", "#C6D07A", "#F0E442", "#9CA3AF"},
+	},
+	ColorblindTritanopia: {
+		Success:      "#2EC4B6", // Teal
+		Warning:      "#F7A1C4", // Pink
+		Error:        "#E8384F", // Red
+		DiffAddBg:    "#0B2E2C",
+		DiffRemoveBg: "#3A1118",
+		DangerBright: "#E8384F",
+		DangerHover:  "#B82A3D",
+		BlameAges:    [5]string{"#2EC4B6", "#6FCFC4", "#C9A9B8", "#F7A1C4", "#9CA3AF"},
+	},
+}
+
+// Colorblind variants of the default theme
+var (
+	DeuteranopiaTheme = colorblindTheme(DefaultTheme, ColorblindDeuteranopia, "Default Dark (Deuteranopia)")
+	ProtanopiaTheme   = colorblindTheme(DefaultTheme, ColorblindProtanopia, "Default Dark (Protanopia)")
+	TritanopiaTheme   = colorblindTheme(DefaultTheme, ColorblindTritanopia, "Default Dark (Tritanopia)")
+)
+
+// IsValidColorblindMode reports whether mode is empty or a known mode.
+func IsValidColorblindMode(mode string) bool {
+	_, ok := colorblindPalettes[mode]
+	return ok || mode == ""
+}
+
+// SetColorblindMode sets the active colorblind mode. Unknown modes disable
+// the remap. Call it before applying a theme.
+func SetColorblindMode(mode string) {
+	if !IsValidColorblindMode(mode) {
+		mode = ""
+	}
+	ColorblindMode = mode
+}
+
+// colorblindTheme returns base with its status colors remapped for mode.
+func colorblindTheme(base Theme, mode, displayName string) Theme {
+	return Theme{
+		Name:        base.Name + "-" + mode,
+		DisplayName: displayName,
+		Colors:      remapColorblind(base.Colors, mode),
+	}
+}
+
+// remapColorblind replaces the status, diff, danger, and blame colors
+// of c with the safe alternatives for mode.
+func remapColorblind(c ColorPalette, mode string) ColorPalette {
+	cb, ok := colorblindPalettes[mode]
+	if !ok {
+		return c
+	}
+	c.Success = cb.Success
+	c.Warning = cb.Warning
+	c.Error = cb.Error
+	c.DiffAddFg = cb.Success
+	c.DiffAddBg = cb.DiffAddBg
+	c.DiffRemoveFg = cb.Error
+	c.DiffRemoveBg = cb.DiffRemoveBg
+	// Derive word-level backgrounds from the remapped colors
+	c.DiffAddWordBg = ""
+	c.DiffRemoveWordBg = ""
+	c.DangerBright = cb.DangerBright
+	c.DangerHover = cb.DangerHover
+	c.BlameAge1, c.BlameAge2, c.BlameAge3, c.BlameAge4, c.BlameAge5 =
+		cb.BlameAges[0], cb.BlameAges[1], cb.BlameAges[2], cb.BlameAges[3], cb.BlameAges[4]
+	return c
+}
+
+// applyColorblindCues marks error and warning styles with text attributes
+// so they don't rely on color alone.
+func (s *Snapshot) applyColorblindCues() {
+	s.StatusModified = s.StatusModified.Italic(true)
+	s.StatusDeleted = s.StatusDeleted.Underline(true)
+	s.StatusBlocked = s.StatusBlocked.Underline(true)
+	s.StatusDeletedNote = s.StatusDeletedNote.Underline(true)
+	s.DiffRemove = s.DiffRemove.Underline(true)
+	s.DiffRemoveWord = s.DiffRemoveWord.Underline(true)
+	s.ToastError = s.ToastError.Underline(true)
+}
+
+// StatusGlyph prefixes a success or error message with a check or cross
+// when a colorblind mode is active.
+func StatusGlyph(msg string, isError bool) string {
+	if ColorblindMode == "" {
+		return msg
+	}
+	if isError {
+		return icons.Get(icons.Cross) + " " + msg
+	}
+	return icons.Get(icons.Check) + " " + msg
+}
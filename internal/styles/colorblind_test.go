@@ -0,0 +1,66 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestColorblindVariantsRegistered(t *testing.T) {
+	for _, name := range []string{"default-deuteranopia", "default-protanopia", "default-tritanopia"} {
+		if !IsBuiltinTheme(name) {
+			t.Errorf("%s is not a built-in theme", name)
+		}
+		if err := ValidatePalette(GetTheme(name).Colors); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestColorblindModeRemapsStatusColors(t *testing.T) {
+	prev := ColorblindMode
+	t.Cleanup(func() {
+		ColorblindMode = prev
+		ApplyTheme("default")
+	})
+
+	SetColorblindMode(ColorblindDeuteranopia)
+	ApplyTheme("dracula")
+
+	cb := colorblindPalettes[ColorblindDeuteranopia]
+	if Current().Success != lipgloss.Color(cb.Success) || Current().Error != lipgloss.Color(cb.Error) || Current().Warning != lipgloss.Color(cb.Warning) {
+		t.Errorf("status colors = %s/%s/%s, want %s/%s/%s", Current().Success, Current().Warning, Current().Error, cb.Success, cb.Warning, cb.Error)
+	}
+	if got := GetCurrentTheme().Colors.DiffRemoveFg; got != cb.Error {
+		t.Errorf("current theme DiffRemoveFg = %s, want %s", got, cb.Error)
+	}
+	if !Current().StatusBlocked.GetUnderline() || !Current().DiffRemove.GetUnderline() {
+		t.Error("error styles should be underlined in colorblind mode")
+	}
+	if got := StatusGlyph("Saved", false); !strings.HasPrefix(got, "✓ ") {
+		t.Errorf("StatusGlyph = %q, want check prefix", got)
+	}
+
+	SetColorblindMode("")
+	ApplyTheme("dracula")
+	if Current().Success != lipgloss.Color(DraculaTheme.Colors.Success) {
+		t.Errorf("Success = %s after disabling, want theme color", Current().Success)
+	}
+	if Current().StatusBlocked.GetUnderline() {
+		t.Error("underline cue should be removed when mode is off")
+	}
+	if got := StatusGlyph("Saved", false); got != "Saved" {
+		t.Errorf("StatusGlyph = %q, want unchanged", got)
+	}
+}
+
+func TestSetColorblindModeRejectsUnknown(t *testing.T) {
+	prev := ColorblindMode
+	t.Cleanup(func() { ColorblindMode = prev })
+
+	SetColorblindMode("monochrome")
+	if ColorblindMode != "" {
+		t.Errorf("ColorblindMode = %q, want empty for unknown mode", ColorblindMode)
+	}
+}
@@ -0,0 +1,127 @@
+package styles
+
+import (
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Terminals without truecolor get palette colors pre-mapped to the nearest
+// ANSI color. Matching is done in CIELAB so hues survive: termenv's own
+// fallback picks by RGB distance, which turns tinted dark backgrounds gray
+// and muddies saturated accents.
+
+// colorProfile reports the terminal's color capability. Tests replace it.
+var colorProfile = lipgloss.ColorProfile
+
+// ansiKey identifies a cached nearest-color lookup.
+type ansiKey struct {
+	profile termenv.Profile
+	r, g, b uint8
+}
+
+var (
+	ansiMu    sync.Mutex
+	ansiCache = make(map[ansiKey]int)
+)
+
+// ansi16 holds the xterm default values of the 16 basic colors.
+var ansi16 = [16]RGB{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube (indices 16-231).
+var cubeLevels = [6]float64{0, 95, 135, 175, 215, 255}
+
+// ThemeColor returns hex as a lipgloss color. When the terminal lacks
+// truecolor, the color is replaced by the nearest ANSI palette index.
+// Values that are not hex colors pass through unchanged.
+func ThemeColor(hex string) lipgloss.Color {
+	return lipgloss.Color(degradeHex(hex))
+}
+
+// degradeHex maps a hex color to an ANSI index string for 256- and
+// 16-color terminals.
+func degradeHex(hex string) string {
+	p := colorProfile()
+	if (p != termenv.ANSI256 && p != termenv.ANSI) || !IsValidHexColor(hex) {
+		return hex
+	}
+	return strconv.Itoa(nearestANSI(p, HexToRGB(hex[:7])))
+}
+
+// nearestANSI returns the palette index closest to c. 256-color terminals
+// use the fixed cube and gray ramp (16-255) since the basic 16 vary with the
+// user's terminal scheme.
+func nearestANSI(p termenv.Profile, c RGB) int {
+	key := ansiKey{profile: p, r: clampByte(c.R), g: clampByte(c.G), b: clampByte(c.B)}
+	ansiMu.Lock()
+	defer ansiMu.Unlock()
+	if idx, ok := ansiCache[key]; ok {
+		return idx
+	}
+
+	lo, hi := 16, 255
+	if p == termenv.ANSI {
+		lo, hi = 0, 15
+	}
+	target := rgbToLab(c)
+	best, bestDist := lo, math.MaxFloat64
+	for i := lo; i <= hi; i++ {
+		if d := labDistance(target, rgbToLab(ansiRGB(i))); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	ansiCache[key] = best
+	return best
+}
+
+// ansiRGB returns the xterm RGB value of a 256-color palette index.
+func ansiRGB(i int) RGB {
+	switch {
+	case i < 16:
+		return ansi16[max(i, 0)]
+	case i < 232:
+		i -= 16
+		return RGB{cubeLevels[i/36], cubeLevels[(i/6)%6], cubeLevels[i%6]}
+	default:
+		v := float64(8 + (min(i, 255)-232)*10)
+		return RGB{v, v, v}
+	}
+}
+
+// lab is a color in CIELAB space (D65 white point).
+type lab struct{ L, A, B float64 }
+
+// rgbToLab converts an sRGB color to CIELAB.
+func rgbToLab(c RGB) lab {
+	r := linearize(c.R / 255.0)
+	g := linearize(c.G / 255.0)
+	b := linearize(c.B / 255.0)
+
+	x := (0.4124*r + 0.3576*g + 0.1805*b) / 0.95047
+	y := 0.2126*r + 0.7152*g + 0.0722*b
+	z := (0.0193*r + 0.1192*g + 0.9505*b) / 1.08883
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return lab{L: 116*fy - 16, A: 500 * (fx - fy), B: 200 * (fy - fz)}
+}
+
+func labF(t float64) float64 {
+	if t > 216.0/24389.0 {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27.0*t + 16) / 116
+}
+
+// labDistance returns the squared CIE76 difference between two colors.
+func labDistance(a, b lab) float64 {
+	dl, da, db := a.L-b.L, a.A-b.A, a.B-b.B
+	return dl*dl + da*da + db*db
+}
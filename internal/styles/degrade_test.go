@@ -0,0 +1,81 @@
+package styles
+
+import (
+	"testing"
+
+	"github.com/muesli/termenv"
+)
+
+func withColorProfile(t *testing.T, p termenv.Profile) {
+	t.Helper()
+	prev := colorProfile
+	colorProfile = func() termenv.Profile { return p }
+	t.Cleanup(func() { colorProfile = prev })
+}
+
+func TestThemeColorTrueColorPassesThrough(t *testing.T) {
+	withColorProfile(t, termenv.TrueColor)
+	if got := ThemeColor("#7C3AED"); string(got) != "#7C3AED" {
+		t.Errorf("ThemeColor = %q, want hex unchanged", got)
+	}
+}
+
+func TestThemeColorDegrades(t *testing.T) {
+	tests := []struct {
+		profile termenv.Profile
+		hex     string
+		want    string
+	}{
+		{termenv.ANSI256, "#ff0000", "196"},
+		{termenv.ANSI256, "#000000", "16"},
+		{termenv.ANSI256, "#eeeeee", "255"},
+		{termenv.ANSI256, "#5f87af", "67"},
+		{termenv.ANSI256, "#ff000080", "196"}, // alpha ignored
+		{termenv.ANSI, "#ff0000", "9"},
+		{termenv.ANSI, "#000000", "0"},
+		{termenv.ANSI, "#22C55E", "2"},
+		{termenv.ANSI, "#ffffff", "15"},
+		{termenv.ANSI, "red", "red"}, // not hex
+	}
+	for _, tt := range tests {
+		withColorProfile(t, tt.profile)
+		if got := ThemeColor(tt.hex); string(got) != tt.want {
+			t.Errorf("profile %v ThemeColor(%q) = %q, want %q", tt.profile, tt.hex, got, tt.want)
+		}
+	}
+}
+
+func TestDegradedTabBackgroundKeepsHue(t *testing.T) {
+	// A dark blue-tinted background must not collapse onto the gray ramp
+	withColorProfile(t, termenv.ANSI256)
+	got := nearestANSI(termenv.ANSI256, HexToRGB("#1e1b4b"))
+	if got >= 232 {
+		t.Errorf("nearestANSI(#1e1b4b) = %d, want a cube color", got)
+	}
+}
+
+func TestToANSIDegrades(t *testing.T) {
+	red := RGB{255, 0, 0}
+
+	withColorProfile(t, termenv.ANSI256)
+	if got := red.ToANSI(); got != "\x1b[38;5;196m" {
+		t.Errorf("ANSI256 ToANSI = %q", got)
+	}
+	withColorProfile(t, termenv.ANSI)
+	if got := red.ToANSI(); got != "\x1b[91m" {
+		t.Errorf("ANSI ToANSI = %q", got)
+	}
+	withColorProfile(t, termenv.TrueColor)
+	if got := red.ToANSI(); got != "\x1b[38;2;255;0;0m" {
+		t.Errorf("TrueColor ToANSI = %q", got)
+	}
+}
+
+func TestColorToRGBReadsANSIIndex(t *testing.T) {
+	if got := colorToRGB("196"); got != (RGB{255, 0, 0}) {
+		t.Errorf("colorToRGB(196) = %v", got)
+	}
+	if got := colorToRGB("244"); got != (RGB{128, 128, 128}) {
+		t.Errorf("colorToRGB(244) = %v", got)
+	}
+}
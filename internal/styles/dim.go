@@ -0,0 +1,143 @@
+package styles
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/muesli/termenv"
+)
+
+// DimInactivePanes renders unfocused panels with a desaturated, dimmed
+// variant of the theme colors so the focused panel stands out.
+var DimInactivePanes = false
+
+const (
+	dimSaturation = 0.4  // fraction of the original saturation kept
+	dimStrength   = 0.35 // how far colors move toward the background
+)
+
+// sgrRegex matches SGR (color and attribute) escape sequences.
+var sgrRegex = regexp.MustCompile(`\x1b\[([0-9;]*)m`)
+
+// DimColor returns the inactive-pane variant of c: desaturated toward its
+// own luminance, then blended toward the panel background bg.
+func DimColor(c, bg RGB) RGB {
+	y := 0.299*c.R + 0.587*c.G + 0.114*c.B
+	c = LerpRGB(RGB{y, y, y}, c, dimSaturation)
+	return LerpRGB(c, bg, dimStrength)
+}
+
+// DimContent rewrites the colors in rendered content to their dimmed
+// variants. Text in the terminal's default color gets the dimmed primary
+// text color. Terminals without color support get content unchanged.
+func DimContent(content string) string {
+	if colorProfile() == termenv.Ascii || content == "" {
+		return content
+	}
+	snap := Current()
+	bg := colorToRGB(snap.BgPrimary)
+	defaultFg := DimColor(colorToRGB(snap.TextPrimary), bg).ToANSI()
+
+	d := dimmer{bg: bg, cache: make(map[RGB]RGB)}
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		line = sgrRegex.ReplaceAllStringFunc(line, func(seq string) string {
+			return d.rewrite(seq, defaultFg)
+		})
+		lines[i] = defaultFg + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// dimmer rewrites SGR sequences for one DimContent call.
+type dimmer struct {
+	bg    RGB
+	cache map[RGB]RGB
+}
+
+func (d *dimmer) dim(c RGB) RGB {
+	if v, ok := d.cache[c]; ok {
+		return v
+	}
+	v := DimColor(c, d.bg)
+	d.cache[c] = v
+	return v
+}
+
+// rewrite returns seq with its colors dimmed. Resets and default-foreground
+// codes are followed by defaultFg so unstyled text is dimmed too.
+func (d *dimmer) rewrite(seq, defaultFg string) string {
+	params := sgrRegex.FindStringSubmatch(seq)[1]
+	if params == "" || params == "0" {
+		return seq + defaultFg
+	}
+
+	parts := strings.Split(params, ";")
+	out := make([]string, 0, len(parts))
+	resetFg := false
+	for i := 0; i < len(parts); i++ {
+		code, err := strconv.Atoi(parts[i])
+		if err != nil {
+			out = append(out, parts[i])
+			continue
+		}
+		switch {
+		case code == 0 || code == 39:
+			out = append(out, parts[i])
+			resetFg = true
+		case (code == 38 || code == 48) && i+4 < len(parts) && parts[i+1] == "2":
+			c, ok := parseRGBParams(parts[i+2 : i+5])
+			if !ok {
+				out = append(out, parts[i:i+5]...)
+			} else {
+				c = d.dim(c)
+				out = append(out, parts[i], "2", itoa(int(clampByte(c.R))), itoa(int(clampByte(c.G))), itoa(int(clampByte(c.B))))
+			}
+			i += 4
+		case (code == 38 || code == 48) && i+2 < len(parts) && parts[i+1] == "5":
+			idx, err := strconv.Atoi(parts[i+2])
+			if err != nil || idx < 0 || idx > 255 {
+				out = append(out, parts[i:i+3]...)
+			} else {
+				out = append(out, parts[i], "5", itoa(nearestANSI(termenv.ANSI256, d.dim(ansiRGB(idx)))))
+			}
+			i += 2
+		case code >= 30 && code <= 37, code >= 40 && code <= 47:
+			out = append(out, strconv.Itoa(d.basic(code-code%10, code%10)))
+		case code >= 90 && code <= 97, code >= 100 && code <= 107:
+			out = append(out, strconv.Itoa(d.basic(code-code%10-60, code%10+8)))
+		default:
+			out = append(out, parts[i])
+		}
+	}
+
+	seq = "\x1b[" + strings.Join(out, ";") + "m"
+	if resetFg {
+		seq += defaultFg
+	}
+	return seq
+}
+
+// basic dims basic color idx (0-15) and returns the SGR code of the
+// nearest basic color. base is 30 for foreground or 40 for background.
+func (d *dimmer) basic(base, idx int) int {
+	n := nearestANSI(termenv.ANSI, d.dim(ansi16[idx]))
+	if n >= 8 {
+		return base + 60 + n - 8 // bright colors are 90-97 and 100-107
+	}
+	return base + n
+}
+
+// parseRGBParams parses three SGR parameters as color channels.
+func parseRGBParams(parts []string) (RGB, bool) {
+	var ch [3]float64
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 || v > 255 {
+			return RGB{}, false
+		}
+		ch[i] = float64(v)
+	}
+	return RGB{ch[0], ch[1], ch[2]}, true
+}
@@ -0,0 +1,75 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/muesli/termenv"
+)
+
+func TestDimColor(t *testing.T) {
+	bg := RGB{0, 0, 0}
+	red := RGB{255, 0, 0}
+	got := DimColor(red, bg)
+
+	if got.R >= red.R {
+		t.Errorf("DimColor red R = %v, want dimmer than %v", got.R, red.R)
+	}
+	// Desaturation pulls the other channels toward gray
+	if got.G <= 0 || got.B <= 0 {
+		t.Errorf("DimColor red = %+v, want desaturated", got)
+	}
+	if got := DimColor(bg, bg); got != bg {
+		t.Errorf("DimColor(bg, bg) = %+v, want %+v", got, bg)
+	}
+}
+
+func TestDimContent(t *testing.T) {
+	withColorProfile(t, termenv.TrueColor)
+	bg := colorToRGB(Current().BgPrimary)
+	want := DimColor(RGB{255, 0, 0}, bg)
+	wantSeq := "38;2;" + itoa(int(clampByte(want.R))) + ";" + itoa(int(clampByte(want.G))) + ";" + itoa(int(clampByte(want.B))) + "m"
+	defaultFg := DimColor(colorToRGB(Current().TextPrimary), bg).ToANSI()
+
+	got := DimContent("\x1b[1;38;2;255;0;0mred\x1b[0m plain\nnext")
+	if !strings.Contains(got, "\x1b[1;"+wantSeq+"red") {
+		t.Errorf("truecolor foreground not dimmed: %q", got)
+	}
+	if !strings.Contains(got, "\x1b[0m"+defaultFg+" plain") {
+		t.Errorf("reset not followed by dimmed default color: %q", got)
+	}
+	if !strings.HasPrefix(got, defaultFg) || !strings.Contains(got, "\n"+defaultFg+"next") {
+		t.Errorf("lines should start with dimmed default color: %q", got)
+	}
+}
+
+func TestDimContentPaletteColors(t *testing.T) {
+	withColorProfile(t, termenv.ANSI256)
+	got := DimContent("\x1b[38;5;196mx\x1b[91my")
+	if strings.Contains(got, "38;5;196m") {
+		t.Errorf("256-color foreground not dimmed: %q", got)
+	}
+	if strings.Contains(got, "[91m") {
+		t.Errorf("bright basic color not dimmed: %q", got)
+	}
+
+	withColorProfile(t, termenv.Ascii)
+	if got := DimContent("plain"); got != "plain" {
+		t.Errorf("DimContent without color = %q, want unchanged", got)
+	}
+}
+
+func TestRenderPanelDimsInactive(t *testing.T) {
+	withColorProfile(t, termenv.TrueColor)
+	prev := DimInactivePanes
+	t.Cleanup(func() { DimInactivePanes = prev })
+
+	content := "\x1b[38;2;255;0;0mred\x1b[0m"
+	DimInactivePanes = true
+	if got := RenderPanel(content, 12, 3, true); !strings.Contains(got, "38;2;255;0;0m") {
+		t.Error("active panel content should not be dimmed")
+	}
+	if got := RenderPanel(content, 12, 3, false); strings.Contains(got, "38;2;255;0;0m") {
+		t.Error("inactive panel content should be dimmed")
+	}
+}
@@ -2,7 +2,10 @@ package styles
 
 import (
 	"math"
+	"strconv"
 	"strings"
+
+	"github.com/muesli/termenv"
 )
 
 // RGB represents a color in RGB space for interpolation.
@@ -95,8 +98,20 @@ func clampByte(v float64) uint8 {
 	return uint8(v)
 }
 
-// ToANSI returns raw ANSI escape code for foreground color.
+// ToANSI returns raw ANSI escape code for foreground color. Terminals
+// without truecolor get the nearest palette color.
 func (c RGB) ToANSI() string {
+	switch p := colorProfile(); p {
+	case termenv.ANSI256:
+		return "\x1b[38;5;" + itoa(nearestANSI(p, c)) + "m"
+	case termenv.ANSI:
+		idx := nearestANSI(p, c)
+		if idx < 8 {
+			return "\x1b[" + itoa(30+idx) + "m"
+		}
+		return "\x1b[" + itoa(90+idx-8) + "m"
+	}
+
 	r := clampByte(c.R)
 	g := clampByte(c.G)
 	b := clampByte(c.B)
@@ -132,25 +147,57 @@ func LerpRGB(c1, c2 RGB, t float64) RGB {
 	}
 }
 
-// NewGradient creates a gradient from a slice of hex color strings.
-// Colors are evenly distributed from position 0.0 to 1.0.
+// NewGradient creates a gradient from a slice of color stops. A stop is a
+// hex color optionally followed by its position, as in CSS: "#7C3AED 25%".
+// Stops without a position are spread evenly between their neighbors, with
+// the first and last defaulting to 0% and 100%. A position before the
+// previous stop's is moved up to it, which makes a hard color change.
 func NewGradient(hexColors []string, angle float64) Gradient {
 	if len(hexColors) == 0 {
 		return Gradient{Angle: angle}
 	}
 
 	stops := make([]GradientStop, len(hexColors))
-	for i, hex := range hexColors {
-		var pos float64
-		if len(hexColors) == 1 {
-			pos = 0.5
-		} else {
-			pos = float64(i) / float64(len(hexColors)-1)
+	positioned := make([]bool, len(hexColors))
+	for i, s := range hexColors {
+		stops[i].Color, stops[i].Position, positioned[i] = parseGradientStop(s)
+	}
+	if len(stops) == 1 && !positioned[0] {
+		stops[0].Position = 0.5
+		return Gradient{Stops: stops, Angle: angle}
+	}
+
+	if !positioned[0] {
+		stops[0].Position, positioned[0] = 0, true
+	}
+	last := len(stops) - 1
+	if !positioned[last] {
+		stops[last].Position, positioned[last] = 1, true
+	}
+
+	// Clamp positioned stops so they never run backwards
+	prev := stops[0].Position
+	for i := range stops {
+		if positioned[i] {
+			stops[i].Position = max(stops[i].Position, prev)
+			prev = stops[i].Position
 		}
-		stops[i] = GradientStop{
-			Position: pos,
-			Color:    HexToRGB(hex),
+	}
+
+	// Spread each run of unpositioned stops between its neighbors
+	for i := 1; i < last; i++ {
+		if positioned[i] {
+			continue
+		}
+		j := i
+		for !positioned[j] {
+			j++
+		}
+		from, to := stops[i-1].Position, stops[j].Position
+		for k := i; k < j; k++ {
+			stops[k].Position = from + (to-from)*float64(k-i+1)/float64(j-i+1)
 		}
+		i = j
 	}
 
 	return Gradient{
@@ -159,6 +206,44 @@ func NewGradient(hexColors []string, angle float64) Gradient {
 	}
 }
 
+// parseGradientStop splits a stop into its color and position (0-1).
+// ok is false when the stop has no valid position.
+func parseGradientStop(s string) (c RGB, pos float64, ok bool) {
+	hex, rest, _ := strings.Cut(strings.TrimSpace(s), " ")
+	if len(hex) > 7 {
+		hex = hex[:7] // Drop alpha
+	}
+	pos, ok = parseStopPosition(strings.TrimSpace(rest))
+	return HexToRGB(hex), pos, ok
+}
+
+// parseStopPosition parses a percentage such as "25%" into 0.25.
+func parseStopPosition(s string) (float64, bool) {
+	pct, found := strings.CutSuffix(s, "%")
+	if !found {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(pct, 64)
+	if err != nil || v < 0 || v > 100 {
+		return 0, false
+	}
+	return v / 100, true
+}
+
+// IsValidGradientStop reports whether s is a hex color, optionally followed
+// by a position between 0% and 100%.
+func IsValidGradientStop(s string) bool {
+	hex, rest, hasPos := strings.Cut(s, " ")
+	if !IsValidHexColor(hex) {
+		return false
+	}
+	if !hasPos {
+		return true
+	}
+	_, ok := parseStopPosition(strings.TrimSpace(rest))
+	return ok
+}
+
 // ColorAt returns the interpolated color at position t (0.0 to 1.0).
 func (g *Gradient) ColorAt(t float64) RGB {
 	if len(g.Stops) == 0 {
@@ -168,19 +253,17 @@ func (g *Gradient) ColorAt(t float64) RGB {
 		return g.Stops[0].Color
 	}
 
-	// Clamp t to [0, 1]
-	if t <= 0 {
-		return g.Stops[0].Color
+	// Before the first stop or past the last, hold the end color
+	first, last := g.Stops[0], g.Stops[len(g.Stops)-1]
+	if t <= 0 || t <= first.Position {
+		return first.Color
 	}
-	if t >= 1 {
-		return g.Stops[len(g.Stops)-1].Color
+	if t >= 1 || t >= last.Position {
+		return last.Color
 	}
 
 	// Find the two stops to interpolate between
-	var lower, upper GradientStop
-	lower = g.Stops[0]
-	upper = g.Stops[len(g.Stops)-1]
-
+	lower, upper := first, last
 	for i := 0; i < len(g.Stops)-1; i++ {
 		if t >= g.Stops[i].Position && t <= g.Stops[i+1].Position {
 			lower = g.Stops[i]
@@ -199,6 +282,64 @@ func (g *Gradient) ColorAt(t float64) RGB {
 	return LerpRGB(lower.Color, upper.Color, localT)
 }
 
+// RotateHue returns a copy of the gradient with every stop's hue shifted
+// by deg degrees.
+func (g Gradient) RotateHue(deg float64) Gradient {
+	stops := make([]GradientStop, len(g.Stops))
+	for i, s := range g.Stops {
+		stops[i] = GradientStop{Position: s.Position, Color: s.Color.RotateHue(deg)}
+	}
+	return Gradient{Stops: stops, Angle: g.Angle}
+}
+
+// RotateHue returns c with its hue shifted by deg degrees, keeping
+// saturation and lightness.
+func (c RGB) RotateHue(deg float64) RGB {
+	r, g, b := c.R/255, c.G/255, c.B/255
+	hi, lo := max(r, g, b), min(r, g, b)
+	l := (hi + lo) / 2
+	if hi == lo {
+		return c // Gray has no hue
+	}
+
+	d := hi - lo
+	sat := d / (1 - math.Abs(2*l-1))
+	var h float64
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h = math.Mod(h*60+deg, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	// HSL back to RGB
+	chroma := (1 - math.Abs(2*l-1)) * sat
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - chroma/2
+	var r1, g1, b1 float64
+	switch {
+	case h < 60:
+		r1, g1 = chroma, x
+	case h < 120:
+		r1, g1 = x, chroma
+	case h < 180:
+		g1, b1 = chroma, x
+	case h < 240:
+		g1, b1 = x, chroma
+	case h < 300:
+		r1, b1 = x, chroma
+	default:
+		r1, b1 = chroma, x
+	}
+	return RGB{(r1 + m) * 255, (g1 + m) * 255, (b1 + m) * 255}
+}
+
 // PositionAt calculates the gradient position for a coordinate given the angle.
 // For a 30-degree angle, the gradient flows diagonally from top-left to bottom-right.
 // Returns a value in range [0, 1].
@@ -0,0 +1,114 @@
+package styles
+
+import (
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// StyleBuilder derives a style from a palette. Builders are rerun whenever
+// the theme or focus settings change, so they should read colors from p
+// (via ThemeColor) rather than from the package color variables.
+type StyleBuilder func(p ColorPalette) lipgloss.Style
+
+// PluginStyle is a named style owned by a plugin. Its palette includes the
+// plugin's entries from ui.theme.pluginOverrides.
+type PluginStyle struct {
+	pluginID string
+	name     string
+	build    StyleBuilder
+	style    lipgloss.Style
+}
+
+var (
+	pluginStylesMu  sync.Mutex
+	pluginStyles    []*PluginStyle
+	pluginOverrides map[string]map[string]interface{}
+)
+
+// RegisterPluginStyle registers a named style for a plugin and builds it
+// from the current theme. Plugins typically call it from a package-level
+// var so the style is rebuilt with every theme change.
+func RegisterPluginStyle(pluginID, name string, build StyleBuilder) *PluginStyle {
+	s := &PluginStyle{pluginID: pluginID, name: name, build: build}
+
+	pluginStylesMu.Lock()
+	defer pluginStylesMu.Unlock()
+	s.style = build(pluginPaletteLocked(pluginID))
+	pluginStyles = append(pluginStyles, s)
+	return s
+}
+
+// Name returns the style's registered name.
+func (s *PluginStyle) Name() string { return s.name }
+
+// Style returns the style built from the current theme.
+func (s *PluginStyle) Style() lipgloss.Style {
+	pluginStylesMu.Lock()
+	defer pluginStylesMu.Unlock()
+	return s.style
+}
+
+// Render renders strs with the style.
+func (s *PluginStyle) Render(strs ...string) string {
+	return s.Style().Render(strs...)
+}
+
+// SetPluginOverrides sets the palette overrides applied to each plugin's
+// styles, keyed by plugin ID. Like SetColorblindMode, it takes effect on the
+// next theme apply.
+func SetPluginOverrides(overrides map[string]map[string]interface{}) {
+	pluginStylesMu.Lock()
+	defer pluginStylesMu.Unlock()
+	pluginOverrides = overrides
+}
+
+// PluginPalette returns the current palette with a plugin's overrides applied.
+func PluginPalette(pluginID string) ColorPalette {
+	pluginStylesMu.Lock()
+	defer pluginStylesMu.Unlock()
+	return pluginPaletteLocked(pluginID)
+}
+
+// pluginPaletteLocked is PluginPalette for callers holding pluginStylesMu.
+func pluginPaletteLocked(pluginID string) ColorPalette {
+	p := GetCurrentTheme().Colors
+	if overrides := pluginOverrides[pluginID]; len(overrides) > 0 {
+		applyGenericOverrides(&p, overrides)
+		p = remapColorblind(p, ColorblindMode)
+	}
+	return p
+}
+
+// rebuildPluginStyles rebuilds every registered plugin style from the
+// current palette. Called from rebuildStyles.
+func rebuildPluginStyles() {
+	pluginStylesMu.Lock()
+	defer pluginStylesMu.Unlock()
+
+	palettes := make(map[string]ColorPalette)
+	for _, s := range pluginStyles {
+		p, ok := palettes[s.pluginID]
+		if !ok {
+			p = pluginPaletteLocked(s.pluginID)
+			palettes[s.pluginID] = p
+		}
+		s.style = s.build(p)
+	}
+}
+
+// SelectedItemStyle builds the selected list row style (ListItemSelected)
+// from p, for plugins that let the selection color be overridden.
+func SelectedItemStyle(p ColorPalette) lipgloss.Style {
+	fg := p.TextSelection
+	if fg == "" {
+		fg = p.TextPrimary
+	}
+	s := lipgloss.NewStyle().
+		Foreground(ThemeColor(fg)).
+		Background(ThemeColor(p.BgTertiary))
+	if HighContrastFocus {
+		s = s.Reverse(true).Bold(true)
+	}
+	return s
+}
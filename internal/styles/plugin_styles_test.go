@@ -0,0 +1,44 @@
+package styles
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestPluginStyleOverrides(t *testing.T) {
+	t.Cleanup(func() {
+		SetPluginOverrides(nil)
+		ApplyTheme("default")
+	})
+
+	build := func(p ColorPalette) lipgloss.Style {
+		return lipgloss.NewStyle().Foreground(ThemeColor(p.TextSelection))
+	}
+	files := RegisterPluginStyle("test-files", "selected", build)
+	convs := RegisterPluginStyle("test-convs", "selected", build)
+	if files.Name() != "selected" {
+		t.Errorf("Name() = %q", files.Name())
+	}
+
+	SetPluginOverrides(map[string]map[string]interface{}{
+		"test-files": {"textSelection": "#123456"},
+	})
+	ApplyTheme("dracula")
+
+	if got := files.Style().GetForeground(); got != lipgloss.Color("#123456") {
+		t.Errorf("overridden plugin foreground = %v, want #123456", got)
+	}
+	if got, want := convs.Style().GetForeground(), lipgloss.Color(DraculaTheme.Colors.TextSelection); got != want {
+		t.Errorf("other plugin foreground = %v, want theme color %v", got, want)
+	}
+	if got := GetCurrentTheme().Colors.TextSelection; got != DraculaTheme.Colors.TextSelection {
+		t.Errorf("plugin overrides leaked into the global palette: %s", got)
+	}
+
+	// Theme changes rebuild registered styles
+	ApplyTheme("nord")
+	if got, want := convs.Style().GetForeground(), lipgloss.Color(NordTheme.Colors.TextSelection); got != want {
+		t.Errorf("after theme change foreground = %v, want %v", got, want)
+	}
+}
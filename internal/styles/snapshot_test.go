@@ -0,0 +1,58 @@
+package styles
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestApplyThemeConcurrentReads(t *testing.T) {
+	t.Cleanup(func() { ApplyTheme("default") })
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				s := Current()
+				_ = s.Title.Render("title") + s.Muted.Render("muted")
+				_ = RenderTab("tab", 0, 2, true, false)
+			}
+		}()
+	}
+
+	for i := range 50 {
+		if i%2 == 0 {
+			ApplyTheme("dracula")
+		} else {
+			ApplyTheme("nord")
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestSnapshotImmutableAfterApply(t *testing.T) {
+	t.Cleanup(func() { ApplyTheme("default") })
+
+	ApplyTheme("dracula")
+	before := Current()
+	primary := before.Primary
+
+	ApplyTheme("nord")
+	if before.Primary != primary {
+		t.Error("applying a theme modified a published snapshot")
+	}
+	if Current() == before {
+		t.Error("applying a theme should publish a new snapshot")
+	}
+	if Current().Primary != ThemeColor(NordTheme.Colors.Primary) {
+		t.Errorf("Primary = %v, want nord primary", Current().Primary)
+	}
+}
@@ -1,401 +1,223 @@
 package styles
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"strconv"
+	"sync/atomic"
 
-// Color palette - default dark theme
-var (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PillTabsEnabled enables rounded pill-style tabs (requires Nerd Font)
+var PillTabsEnabled = false
+
+// HighContrastFocus adds focus cues that don't rely on color alone: double
+// borders on the active panel and inverse video on selected rows and focused
+// buttons. Set via SetHighContrastFocus so dependent styles are rebuilt.
+var HighContrastFocus = false
+
+// Snapshot holds the colors and styles built from one theme. A snapshot is
+// never modified after it is published: applying a theme builds a new one
+// and swaps it in, so views and background commands can keep rendering
+// while the theme changes. Read it through Current.
+type Snapshot struct {
 	// Primary colors
-	Primary   = lipgloss.Color("#7C3AED") // Purple
-	Secondary = lipgloss.Color("#3B82F6") // Blue
-	Accent    = lipgloss.Color("#F59E0B") // Amber
+	Primary   lipgloss.Color // Purple
+	Secondary lipgloss.Color // Blue
+	Accent    lipgloss.Color // Amber
 
 	// Status colors
-	Success = lipgloss.Color("#10B981") // Green
-	Warning = lipgloss.Color("#F59E0B") // Amber
-	Error   = lipgloss.Color("#EF4444") // Red
-	Info    = lipgloss.Color("#3B82F6") // Blue
+	Success lipgloss.Color // Green
+	Warning lipgloss.Color // Amber
+	Error   lipgloss.Color // Red
+	Info    lipgloss.Color // Blue
 
 	// Text colors
-	TextPrimary        = lipgloss.Color("#F9FAFB")
-	TextSecondary      = lipgloss.Color("#9CA3AF")
-	TextMuted          = lipgloss.Color("#6B7280")
-	TextSubtle         = lipgloss.Color("#4B5563")
-	TextSelectionColor = lipgloss.Color("#F9FAFB") // Text on selection backgrounds (BgTertiary)
+	TextPrimary        lipgloss.Color
+	TextSecondary      lipgloss.Color
+	TextMuted          lipgloss.Color
+	TextSubtle         lipgloss.Color
+	TextSelectionColor lipgloss.Color // Text on selection backgrounds (BgTertiary)
 
 	// Background colors
-	BgPrimary   = lipgloss.Color("#111827")
-	BgSecondary = lipgloss.Color("#1F2937")
-	BgTertiary  = lipgloss.Color("#374151")
-	BgOverlay   = lipgloss.Color("#00000080")
+	BgPrimary   lipgloss.Color
+	BgSecondary lipgloss.Color
+	BgTertiary  lipgloss.Color
+	BgOverlay   lipgloss.Color
 
 	// Border colors
-	BorderNormal = lipgloss.Color("#374151")
-	BorderActive = lipgloss.Color("#7C3AED")
-	BorderMuted  = lipgloss.Color("#1F2937")
+	BorderNormal lipgloss.Color
+	BorderActive lipgloss.Color
+	BorderMuted  lipgloss.Color
 
-	// Diff foreground colors (also updated by ApplyTheme)
-	DiffAddFg    = lipgloss.Color("#10B981")
-	DiffRemoveFg = lipgloss.Color("#EF4444")
+	// Diff colors
+	DiffAddFg    lipgloss.Color
+	DiffRemoveFg lipgloss.Color
+	DiffAddBg    lipgloss.Color // Very subtle dark green
+	DiffRemoveBg lipgloss.Color // Very subtle dark red
+
+	// Word-level diff backgrounds for changed tokens within a line
+	DiffAddWordBg    lipgloss.Color
+	DiffRemoveWordBg lipgloss.Color
 
 	// Additional themeable colors
-	TextHighlight         = lipgloss.Color("#E5E7EB") // For subtitle, special text
-	ButtonHoverColor      = lipgloss.Color("#9D174D") // Button hover background
-	TabTextInactiveColor  = lipgloss.Color("#1a1a1a") // Inactive tab text
-	LinkColor             = lipgloss.Color("#60A5FA") // Hyperlink color
-	ToastSuccessTextColor = lipgloss.Color("#000000") // Toast success foreground
-	ToastErrorTextColor   = lipgloss.Color("#FFFFFF") // Toast error foreground
+	TextHighlight         lipgloss.Color // For subtitle, special text
+	ButtonHoverColor      lipgloss.Color // Button hover background
+	TabTextInactiveColor  lipgloss.Color // Inactive tab text
+	LinkColor             lipgloss.Color // Hyperlink color
+	ToastSuccessTextColor lipgloss.Color // Toast success foreground
+	ToastErrorTextColor   lipgloss.Color // Toast error foreground
 
 	// Danger button colors
-	DangerLight  = lipgloss.Color("#FCA5A5") // Light red text
-	DangerDark   = lipgloss.Color("#7F1D1D") // Dark red background
-	DangerBright = lipgloss.Color("#DC2626") // Bright red focused bg
-	DangerHover  = lipgloss.Color("#B91C1C") // Darker red hover bg
-	TextInverse  = lipgloss.Color("#FFFFFF") // Inverse/contrast text
+	DangerLight  lipgloss.Color // Light red text
+	DangerDark   lipgloss.Color // Dark red background
+	DangerBright lipgloss.Color // Bright red focused bg
+	DangerHover  lipgloss.Color // Darker red hover bg
+	TextInverse  lipgloss.Color // Inverse/contrast text
 
 	// Scrollbar colors (default to TextSubtle/TextMuted)
-	ScrollbarTrackColor = lipgloss.Color("#4B5563") // Same as TextSubtle
-	ScrollbarThumbColor = lipgloss.Color("#6B7280") // Same as TextMuted
+	ScrollbarTrackColor lipgloss.Color
+	ScrollbarThumbColor lipgloss.Color
 
 	// Blame age gradient colors
-	BlameAge1 = lipgloss.Color("#34D399") // < 1 week
-	BlameAge2 = lipgloss.Color("#84CC16") // < 1 month
-	BlameAge3 = lipgloss.Color("#FBBF24") // < 3 months
-	BlameAge4 = lipgloss.Color("#F97316") // < 6 months
-	BlameAge5 = lipgloss.Color("#9CA3AF") // < 1 year
-
-	// Third-party theme names (updated by ApplyTheme)
-	CurrentSyntaxTheme   = "monokai"
-	CurrentMarkdownTheme = "dark"
-)
-
-// Tab theme state (updated by ApplyTheme)
-var (
-	CurrentTabStyle  = "rainbow"
-	CurrentTabColors = []RGB{{220, 60, 60}, {60, 220, 60}, {60, 60, 220}, {156, 60, 220}} // Default rainbow
-)
-
-// PillTabsEnabled enables rounded pill-style tabs (requires Nerd Font)
-var PillTabsEnabled = false
-
-// Panel styles
-var (
-	// Active panel with highlighted border
-	PanelActive = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(BorderActive).
-			Padding(0, 1)
-
-	// Inactive panel with subtle border
-	PanelInactive = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(BorderNormal).
-			Padding(0, 1)
-
-	// Panel header
-	PanelHeader = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(TextPrimary).
-			MarginBottom(1)
-
-	// Panel with no border
-	PanelNoBorder = lipgloss.NewStyle().
-			Padding(0, 1)
-)
-
-// Text styles
-var (
-	Title = lipgloss.NewStyle().
-		Bold(true).
-		Foreground(TextPrimary)
-
-	Subtitle = lipgloss.NewStyle().
-			Foreground(TextHighlight)
-
-	// WorktreeIndicator shows the current worktree branch in the header
-	WorktreeIndicator = lipgloss.NewStyle().
-				Foreground(Warning).
-				Bold(true)
-
-	Body = lipgloss.NewStyle().
-		Foreground(TextPrimary)
-
-	Muted = lipgloss.NewStyle().
-		Foreground(TextMuted)
-
-	Subtle = lipgloss.NewStyle().
-		Foreground(TextSubtle)
-
-	Code = lipgloss.NewStyle().
-		Foreground(Accent)
-
-	Link = lipgloss.NewStyle().
-		Foreground(LinkColor).
-		Underline(true)
-
-	KeyHint = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgTertiary).
-		Padding(0, 1)
-
-	Logo = lipgloss.NewStyle().
-		Foreground(Primary).
-		Bold(true)
-)
-
-// Status indicator styles
-var (
-	StatusStaged = lipgloss.NewStyle().
-			Foreground(Success).
-			Bold(true)
-
-	StatusModified = lipgloss.NewStyle().
-			Foreground(Warning).
-			Bold(true)
-
-	// Toast styles for status messages
-	ToastSuccess = lipgloss.NewStyle().
-			Background(Success).
-			Foreground(ToastSuccessTextColor).
-			Bold(true).
-			Padding(0, 1)
-
-	ToastError = lipgloss.NewStyle().
-			Background(Error).
-			Foreground(ToastErrorTextColor).
-			Bold(true).
-			Padding(0, 1)
-
-	StatusUntracked = lipgloss.NewStyle().
-			Foreground(TextMuted)
-
-	StatusDeleted = lipgloss.NewStyle().
-			Foreground(Error).
-			Bold(true)
-
-	StatusInProgress = lipgloss.NewStyle().
-				Foreground(Info).
-				Bold(true)
-
-	StatusCompleted = lipgloss.NewStyle().
-			Foreground(Success)
-
-	StatusBlocked = lipgloss.NewStyle().
-			Foreground(Error)
-
-	StatusPending = lipgloss.NewStyle().
-			Foreground(TextMuted)
-
-	// Note status indicator styles
-	StatusArchived = lipgloss.NewStyle().
-			Foreground(Info)
-
-	StatusDeletedNote = lipgloss.NewStyle().
-				Foreground(Error)
-)
-
-// List item styles
-var (
-	ListItemNormal = lipgloss.NewStyle().
-			Foreground(TextPrimary)
-
-	ListItemSelected = lipgloss.NewStyle().
-				Foreground(TextSelectionColor).
-				Background(BgTertiary)
-
-	ListItemFocused = lipgloss.NewStyle().
-			Foreground(TextPrimary).
-			Background(Primary)
-
-	ListCursor = lipgloss.NewStyle().
-			Foreground(Primary).
-			Bold(true)
-)
-
-// Bar element styles (shared by header/footer)
-var (
-	BarTitle = lipgloss.NewStyle().
-			Foreground(TextPrimary).
-			Bold(true)
-
-	BarText = lipgloss.NewStyle().
-		Foreground(TextMuted)
-
-	BarChip = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgTertiary).
-		Padding(0, 1)
-
-	BarChipActive = lipgloss.NewStyle().
-			Foreground(TextPrimary).
-			Background(Primary).
-			Padding(0, 1).
-			Bold(true)
-)
-
-// TabTextActive is the text color for active tabs
-var TabTextActive = lipgloss.NewStyle().
-	Foreground(TextPrimary).
-	Bold(true)
-
-// TabTextInactive is the text color for inactive tabs
-var TabTextInactive = lipgloss.NewStyle().
-	Foreground(TabTextInactiveColor)
-
-// Diff line styles
-var (
-	DiffAdd = lipgloss.NewStyle().
-		Foreground(Success)
-
-	DiffRemove = lipgloss.NewStyle().
-			Foreground(Error)
-
-	DiffContext = lipgloss.NewStyle().
-			Foreground(TextMuted)
-
-	DiffHeader = lipgloss.NewStyle().
-			Foreground(Info).
-			Bold(true)
-
-	// Subtle diff backgrounds for syntax-highlighted lines
-	DiffAddBg    = lipgloss.Color("#0D2818") // Very subtle dark green
-	DiffRemoveBg = lipgloss.Color("#2D1A1A") // Very subtle dark red
-)
-
-// File browser styles
-var (
-	// Directory names - bold blue
-	FileBrowserDir = lipgloss.NewStyle().
-			Foreground(Secondary).
-			Bold(true)
-
-	// Regular file names
-	FileBrowserFile = lipgloss.NewStyle().
-			Foreground(TextPrimary)
-
-	// Gitignored files - muted/dimmed
-	FileBrowserIgnored = lipgloss.NewStyle().
-				Foreground(TextSubtle)
-
-	// Line numbers in preview
-	FileBrowserLineNumber = lipgloss.NewStyle().
-				Foreground(TextMuted).
-				Width(5).
-				AlignHorizontal(lipgloss.Right)
-
-	// Tree icons (>, +)
-	FileBrowserIcon = lipgloss.NewStyle().
-			Foreground(TextMuted)
-
-	// Content search match highlighting
-	SearchMatch = lipgloss.NewStyle().
-			Background(Warning) // Yellow background for all matches
-
-	SearchMatchCurrent = lipgloss.NewStyle().
-				Background(Primary). // Purple background for current match
-				Foreground(TextPrimary)
-
-	// Fuzzy match character highlighting (bold in result list)
-	FuzzyMatchChar = lipgloss.NewStyle().
-			Foreground(Primary).
-			Bold(true)
-
-	// Quick open result row (normal)
-	QuickOpenItem = lipgloss.NewStyle().
-			Foreground(TextPrimary)
-
-	// Quick open result row (selected)
-	QuickOpenItemSelected = lipgloss.NewStyle().
-				Foreground(TextSelectionColor).
-				Background(BgTertiary)
-
-	// Palette entry styles (reusable for modals)
-	PaletteEntry = lipgloss.NewStyle().
-			Foreground(TextPrimary)
-
-	PaletteEntrySelected = lipgloss.NewStyle().
-				Foreground(TextSelectionColor).
-				Background(BgTertiary)
-
-	PaletteKey = lipgloss.NewStyle().
-			Foreground(TextMuted).
-			Background(BgTertiary).
-			Padding(0, 1)
-
-	// Text selection for preview pane drag selection
-	TextSelection = lipgloss.NewStyle().
-			Background(BgTertiary).
-			Foreground(TextSelectionColor)
-)
+	BlameAge1 lipgloss.Color // < 1 week
+	BlameAge2 lipgloss.Color // < 1 month
+	BlameAge3 lipgloss.Color // < 3 months
+	BlameAge4 lipgloss.Color // < 6 months
+	BlameAge5 lipgloss.Color // < 1 year
+
+	// Third-party theme names
+	SyntaxTheme   string
+	MarkdownTheme string
+
+	// Tab theme
+	TabStyle     string
+	TabColors    []RGB
+	TabOverrides map[string]RGB // Explicit tab colors keyed by plugin ID
+
+	// Panel styles
+	PanelActive   lipgloss.Style // Active panel with highlighted border
+	PanelInactive lipgloss.Style // Inactive panel with subtle border
+	PanelHeader   lipgloss.Style
+	PanelNoBorder lipgloss.Style
+
+	// Text styles
+	Title             lipgloss.Style
+	Subtitle          lipgloss.Style
+	WorktreeIndicator lipgloss.Style // Current worktree branch in the header
+	Body              lipgloss.Style
+	Muted             lipgloss.Style
+	Subtle            lipgloss.Style
+	Code              lipgloss.Style
+	Link              lipgloss.Style
+	KeyHint           lipgloss.Style
+	Logo              lipgloss.Style
+
+	// Status indicator styles
+	StatusStaged      lipgloss.Style
+	StatusModified    lipgloss.Style
+	ToastSuccess      lipgloss.Style
+	ToastError        lipgloss.Style
+	StatusUntracked   lipgloss.Style
+	StatusDeleted     lipgloss.Style
+	StatusInProgress  lipgloss.Style
+	StatusCompleted   lipgloss.Style
+	StatusBlocked     lipgloss.Style
+	StatusPending     lipgloss.Style
+	StatusArchived    lipgloss.Style // Note status
+	StatusDeletedNote lipgloss.Style // Note status
+
+	// List item styles
+	ListItemNormal   lipgloss.Style
+	ListItemSelected lipgloss.Style
+	ListItemFocused  lipgloss.Style
+	ListCursor       lipgloss.Style
+
+	// Bar element styles (shared by header/footer)
+	BarTitle      lipgloss.Style
+	BarText       lipgloss.Style
+	BarChip       lipgloss.Style
+	BarChipActive lipgloss.Style
+
+	// Tab text styles
+	TabTextActive   lipgloss.Style
+	TabTextInactive lipgloss.Style
+
+	// Diff line styles
+	DiffAdd     lipgloss.Style
+	DiffRemove  lipgloss.Style
+	DiffContext lipgloss.Style
+	DiffHeader  lipgloss.Style
+
+	// Word-level diff styles for changed tokens within an add/remove line
+	DiffAddWord    lipgloss.Style
+	DiffRemoveWord lipgloss.Style
+
+	// File browser styles
+	FileBrowserDir        lipgloss.Style // Directory names
+	FileBrowserFile       lipgloss.Style // Regular file names
+	FileBrowserIgnored    lipgloss.Style // Gitignored files
+	FileBrowserLineNumber lipgloss.Style // Line numbers in preview
+	FileBrowserIcon       lipgloss.Style // Tree icons (>, +)
+	SearchMatch           lipgloss.Style // Content search matches
+	SearchMatchCurrent    lipgloss.Style // Current content search match
+	FuzzyMatchChar        lipgloss.Style // Fuzzy match characters in result lists
+	QuickOpenItem         lipgloss.Style
+	QuickOpenItemSelected lipgloss.Style
+	PaletteEntry          lipgloss.Style // Reusable for modals
+	PaletteEntrySelected  lipgloss.Style
+	PaletteKey            lipgloss.Style
+	TextSelection         lipgloss.Style // Preview pane drag selection
+
+	// Footer and header
+	Footer lipgloss.Style
+	Header lipgloss.Style
+
+	// Modal styles
+	ModalOverlay lipgloss.Style
+	ModalBox     lipgloss.Style
+	ModalTitle   lipgloss.Style
+
+	// Button styles
+	Button              lipgloss.Style
+	ButtonFocused       lipgloss.Style
+	ButtonHover         lipgloss.Style
+	ButtonDanger        lipgloss.Style // Destructive actions like delete
+	ButtonDangerFocused lipgloss.Style
+	ButtonDangerHover   lipgloss.Style
+}
 
-// Footer and header
-var (
-	Footer = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgSecondary)
+// current is the published snapshot.
+var current atomic.Pointer[Snapshot]
 
-	Header = lipgloss.NewStyle().
-		Background(BgSecondary)
-)
-
-// Modal styles
-var (
-	ModalOverlay = lipgloss.NewStyle().
-			Background(BgOverlay)
-
-	ModalBox = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(Primary).
-			Background(BgSecondary).
-			Padding(1, 2)
-
-	ModalTitle = lipgloss.NewStyle().
-			Foreground(TextPrimary).
-			Bold(true).
-			MarginBottom(1)
-)
+func init() {
+	current.Store(newSnapshot(DefaultTheme.Colors))
+}
 
-// Button styles
-var (
-	Button = lipgloss.NewStyle().
-		Foreground(TextSecondary).
-		Background(BgTertiary).
-		Padding(0, 2)
-
-	ButtonFocused = lipgloss.NewStyle().
-			Foreground(TextPrimary).
-			Background(Primary).
-			Padding(0, 2).
-			Bold(true)
-
-	ButtonHover = lipgloss.NewStyle().
-			Foreground(TextPrimary).
-			Background(ButtonHoverColor).
-			Padding(0, 2)
-
-	// Danger button styles (for destructive actions like delete)
-	ButtonDanger = lipgloss.NewStyle().
-			Foreground(DangerLight).
-			Background(DangerDark).
-			Padding(0, 2)
-
-	ButtonDangerFocused = lipgloss.NewStyle().
-				Foreground(TextInverse).
-				Background(DangerBright).
-				Padding(0, 2).
-				Bold(true)
-
-	ButtonDangerHover = lipgloss.NewStyle().
-				Foreground(TextInverse).
-				Background(DangerHover).
-				Padding(0, 2)
-)
+// Current returns the active style snapshot. It is safe to call from any
+// goroutine. Read it once per render rather than caching it, or theme
+// changes will be missed.
+func Current() *Snapshot {
+	return current.Load()
+}
 
 // RenderTab renders a tab label using the current tab theme.
 // tabIndex is the 0-based index of this tab, totalTabs is the total count.
 // If isPreview is true, the label is rendered in italic to indicate an ephemeral preview tab.
 func RenderTab(label string, tabIndex, totalTabs int, isActive bool, isPreview bool) string {
-	style := CurrentTabStyle
-	colors := CurrentTabColors
+	return renderTab("", label, tabIndex, totalTabs, isActive, isPreview)
+}
+
+// RenderPluginTab renders a plugin's header tab. A color assigned to the
+// plugin in ui.theme.tabOverrides takes precedence over the tab theme.
+func RenderPluginTab(pluginID, label string, tabIndex, totalTabs int, isActive bool) string {
+	return renderTab(pluginID, label, tabIndex, totalTabs, isActive, false)
+}
+
+// renderTab renders a tab for RenderTab and RenderPluginTab.
+func renderTab(pluginID, label string, tabIndex, totalTabs int, isActive bool, isPreview bool) string {
+	snap := Current()
+	style := snap.TabStyle
+	colors := snap.TabColors
 
 	// Check if style is a preset name
 	if preset := GetTabPreset(style); preset != nil {
@@ -405,11 +227,32 @@ func RenderTab(label string, tabIndex, totalTabs int, isActive bool, isPreview b
 		}
 	}
 
+	// Explicit per-plugin colors, then the built-in plugin colors for the
+	// per-plugin style; other tabs fall back to the gradient
+	color, explicit := snap.TabOverrides[pluginID]
+	if !explicit && style == "per-plugin" {
+		if hex, ok := pluginTabColors[pluginID]; ok {
+			color, explicit = HexToRGB(hex), true
+		} else {
+			style = "gradient"
+		}
+	}
+	if explicit {
+		if style == "underline" || style == "minimal" {
+			return renderUnderlineTab(label, color, isActive, isPreview)
+		}
+		return renderPerTabColor(label, 0, isActive, isPreview, []RGB{color})
+	}
+
 	switch style {
 	case "gradient":
 		return renderGradientTab(label, tabIndex, totalTabs, isActive, isPreview, colors)
 	case "per-tab":
 		return renderPerTabColor(label, tabIndex, isActive, isPreview, colors)
+	case "block":
+		return renderPerTabColor(label, 0, isActive, isPreview, []RGB{tabCenterColor(tabIndex, totalTabs, colors)})
+	case "underline":
+		return renderUnderlineTab(label, tabCenterColor(tabIndex, totalTabs, colors), isActive, isPreview)
 	case "solid":
 		return renderSolidTab(label, isActive, isPreview)
 	case "minimal":
@@ -423,15 +266,16 @@ func RenderTab(label string, tabIndex, totalTabs int, isActive bool, isPreview b
 // RenderGradientTab renders a tab label with a gradient background.
 // Kept for backwards compatibility - delegates to RenderTab.
 func RenderGradientTab(label string, tabIndex, totalTabs int, isActive bool) string {
-	return renderGradientTab(label, tabIndex, totalTabs, isActive, false, CurrentTabColors)
+	return renderGradientTab(label, tabIndex, totalTabs, isActive, false, Current().TabColors)
 }
 
 func tabTextColor(isActive bool, backgrounds []RGB) lipgloss.Color {
 	minTarget := 3.5
-	candidates := []lipgloss.Color{TextSecondary, TextPrimary, TextMuted}
+	s := Current()
+	candidates := []lipgloss.Color{s.TextSecondary, s.TextPrimary, s.TextMuted}
 	if isActive {
 		minTarget = 4.5
-		candidates = []lipgloss.Color{TextPrimary, TextSecondary, TextMuted}
+		candidates = []lipgloss.Color{s.TextPrimary, s.TextSecondary, s.TextMuted}
 	}
 
 	best := candidates[0]
@@ -456,6 +300,10 @@ func tabTextColor(isActive bool, backgrounds []RGB) lipgloss.Color {
 }
 
 func colorToRGB(c lipgloss.Color) RGB {
+	// Degraded palette colors are ANSI indices rather than hex
+	if i, err := strconv.Atoi(string(c)); err == nil && i >= 0 && i <= 255 {
+		return ansiRGB(i)
+	}
 	return HexToRGB(string(c))
 }
 
@@ -470,7 +318,7 @@ const (
 // If outerBg is empty, defaults to BgSecondary.
 func RenderPill(text string, fg, bg, outerBg lipgloss.Color) string {
 	if outerBg == "" {
-		outerBg = BgSecondary
+		outerBg = Current().BgSecondary
 	}
 
 	style := lipgloss.NewStyle().Foreground(fg).Background(bg).Padding(0, 1)
@@ -495,13 +343,13 @@ func RenderPill(text string, fg, bg, outerBg lipgloss.Color) string {
 // outerBg is the surrounding background; if empty, defaults to BgSecondary.
 func RenderPillWithStyle(text string, style lipgloss.Style, outerBg lipgloss.Color) string {
 	if outerBg == "" {
-		outerBg = BgSecondary
+		outerBg = Current().BgSecondary
 	}
 
 	// Get background from style for pill caps
 	bg, _ := style.GetBackground().(lipgloss.Color)
 	if bg == "" {
-		bg = BgTertiary // fallback
+		bg = Current().BgTertiary // fallback
 	}
 
 	content := style.Render(text)
@@ -562,14 +410,14 @@ func renderGradientTab(label string, tabIndex, totalTabs int, isActive bool, isP
 
 	// Left pill cap: foreground = first char's bg, background = header bg
 	if PillTabsEnabled {
-		leftBg := lipgloss.Color(RGBToHex(backgrounds[0]))
-		leftCap := lipgloss.NewStyle().Foreground(leftBg).Background(BgSecondary).Render(pillLeftCap)
+		leftBg := ThemeColor(RGBToHex(backgrounds[0]))
+		leftCap := lipgloss.NewStyle().Foreground(leftBg).Background(Current().BgSecondary).Render(pillLeftCap)
 		result += leftCap
 	}
 
 	textColor := tabTextColor(isActive, backgrounds)
 	for i, ch := range chars {
-		bg := lipgloss.Color(RGBToHex(backgrounds[i]))
+		bg := ThemeColor(RGBToHex(backgrounds[i]))
 		var style lipgloss.Style
 		if isActive {
 			style = lipgloss.NewStyle().Background(bg).Foreground(textColor).Bold(true)
@@ -584,8 +432,8 @@ func renderGradientTab(label string, tabIndex, totalTabs int, isActive bool, isP
 
 	// Right pill cap: foreground = last char's bg, background = header bg
 	if PillTabsEnabled {
-		rightBg := lipgloss.Color(RGBToHex(backgrounds[len(backgrounds)-1]))
-		rightCap := lipgloss.NewStyle().Foreground(rightBg).Background(BgSecondary).Render(pillRightCap)
+		rightBg := ThemeColor(RGBToHex(backgrounds[len(backgrounds)-1]))
+		rightCap := lipgloss.NewStyle().Foreground(rightBg).Background(Current().BgSecondary).Render(pillRightCap)
 		result += rightCap
 	}
 
@@ -616,7 +464,7 @@ func renderPerTabColor(label string, tabIndex int, isActive bool, isPreview bool
 
 	bgColor := RGB{float64(r), float64(g), float64(b)}
 	textColor := tabTextColor(isActive, []RGB{bgColor})
-	bg := lipgloss.Color(RGBToHex(bgColor))
+	bg := ThemeColor(RGBToHex(bgColor))
 
 	padded := " " + label + " "
 	if !PillTabsEnabled {
@@ -634,8 +482,8 @@ func renderPerTabColor(label string, tabIndex int, isActive bool, isPreview bool
 	}
 
 	if PillTabsEnabled {
-		leftCap := lipgloss.NewStyle().Foreground(bg).Background(BgSecondary).Render(pillLeftCap)
-		rightCap := lipgloss.NewStyle().Foreground(bg).Background(BgSecondary).Render(pillRightCap)
+		leftCap := lipgloss.NewStyle().Foreground(bg).Background(Current().BgSecondary).Render(pillLeftCap)
+		rightCap := lipgloss.NewStyle().Foreground(bg).Background(Current().BgSecondary).Render(pillRightCap)
 		return leftCap + style.Render(padded) + rightCap
 	}
 	return style.Render(padded)
@@ -650,9 +498,9 @@ func renderSolidTab(label string, isActive bool, isPreview bool) string {
 
 	var bg lipgloss.Color
 	if isActive {
-		bg = Primary
+		bg = Current().Primary
 	} else {
-		bg = BgTertiary
+		bg = Current().BgTertiary
 	}
 
 	textColor := tabTextColor(isActive, []RGB{colorToRGB(bg)})
@@ -665,8 +513,8 @@ func renderSolidTab(label string, isActive bool, isPreview bool) string {
 	}
 
 	if PillTabsEnabled {
-		leftCap := lipgloss.NewStyle().Foreground(bg).Background(BgSecondary).Render(pillLeftCap)
-		rightCap := lipgloss.NewStyle().Foreground(bg).Background(BgSecondary).Render(pillRightCap)
+		leftCap := lipgloss.NewStyle().Foreground(bg).Background(Current().BgSecondary).Render(pillLeftCap)
+		rightCap := lipgloss.NewStyle().Foreground(bg).Background(Current().BgSecondary).Render(pillRightCap)
 		return leftCap + style.Render(padded) + rightCap
 	}
 	return style.Render(padded)
@@ -678,9 +526,29 @@ func renderMinimalTab(label string, isActive bool, isPreview bool) string {
 
 	var style lipgloss.Style
 	if isActive {
-		style = lipgloss.NewStyle().Foreground(Primary).Bold(true).Underline(true)
+		style = lipgloss.NewStyle().Foreground(Current().Primary).Bold(true).Underline(true)
+	} else {
+		style = lipgloss.NewStyle().Foreground(Current().TextMuted)
+	}
+	if isPreview {
+		style = style.Italic(true)
+	}
+
+	return style.Render(padded)
+}
+
+// renderUnderlineTab renders a tab with no background and its label in
+// color. The active tab is bold and underlined.
+func renderUnderlineTab(label string, color RGB, isActive bool, isPreview bool) string {
+	padded := "  " + label + "  "
+
+	var style lipgloss.Style
+	if isActive {
+		style = lipgloss.NewStyle().Foreground(ThemeColor(RGBToHex(color))).Bold(true).Underline(true)
 	} else {
-		style = lipgloss.NewStyle().Foreground(TextMuted)
+		// Fade inactive labels halfway into the header background
+		faded := LerpRGB(color, colorToRGB(Current().BgSecondary), 0.5)
+		style = lipgloss.NewStyle().Foreground(ThemeColor(RGBToHex(faded)))
 	}
 	if isPreview {
 		style = style.Italic(true)
@@ -689,6 +557,15 @@ func renderMinimalTab(label string, isActive bool, isPreview bool) string {
 	return style.Render(padded)
 }
 
+// tabCenterColor samples the tab colors at the middle of a tab.
+func tabCenterColor(tabIndex, totalTabs int, colors []RGB) RGB {
+	if totalTabs <= 0 {
+		totalTabs = 1
+	}
+	r, g, b := interpolateColors((float64(max(tabIndex, 0))+0.5)/float64(totalTabs), colors)
+	return RGB{float64(r), float64(g), float64(b)}
+}
+
 // interpolateColors returns RGB for a position 0.0-1.0 across the color array
 func interpolateColors(pos float64, colors []RGB) (uint8, uint8, uint8) {
 	if len(colors) < 2 {
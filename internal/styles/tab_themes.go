@@ -4,7 +4,7 @@ package styles
 type TabThemePreset struct {
 	Name        string   // Internal name (e.g., "sunset")
 	DisplayName string   // Display name (e.g., "Sunset")
-	Style       string   // "gradient", "per-tab", "solid", "minimal"
+	Style       string   // "gradient", "per-tab", "block", "underline", "per-plugin", "solid", "minimal"
 	Colors      []string // Hex colors for gradient stops or per-tab colors
 }
 
@@ -80,6 +80,29 @@ var TabThemePresets = map[string]TabThemePreset{
 		Colors:      []string{"#FF5555", "#50FA7B", "#8BE9FD", "#F1FA8C"}, // red, green, cyan, yellow
 	},
 
+	// Block and underline themes - one color per tab, sampled from the
+	// theme's tab colors
+	"blocks": {
+		Name:        "blocks",
+		DisplayName: "Blocks",
+		Style:       "block",
+		Colors:      []string{}, // Uses theme tab colors
+	},
+	"lines": {
+		Name:        "lines",
+		DisplayName: "Lines",
+		Style:       "underline",
+		Colors:      []string{}, // Colored labels, underline active tab
+	},
+
+	// Per-plugin theme - each plugin keeps its own color wherever its tab is
+	"plugin": {
+		Name:        "plugin",
+		DisplayName: "Plugin Colors",
+		Style:       "per-plugin",
+		Colors:      []string{}, // Uses pluginTabColors
+	},
+
 	// Solid themes - use theme primary/accent colors
 	"mono": {
 		Name:        "mono",
@@ -109,6 +132,43 @@ var TabThemePresets = map[string]TabThemePreset{
 	},
 }
 
+// pluginTabColors are the built-in tab colors for the per-plugin style,
+// keyed by plugin ID. Plugins not listed fall back to the gradient.
+var pluginTabColors = map[string]string{
+	"git-status":        "#F05033", // Git orange
+	"conversations":     "#8B5CF6", // Violet
+	"file-browser":      "#3B82F6", // Blue
+	"td-monitor":        "#10B981", // Green
+	"workspace-manager": "#F59E0B", // Amber
+	"notes":             "#EAB308", // Yellow
+	"chat":              "#EC4899", // Pink
+}
+
+// tabOverrides holds ui.theme.tabOverrides, explicit tab colors keyed by
+// plugin ID. Set via SetTabOverrides.
+var tabOverrides map[string]string
+
+// SetTabOverrides sets explicit tab colors keyed by plugin ID. Invalid
+// colors are ignored. Like SetPluginOverrides, it takes effect on the next
+// theme apply.
+func SetTabOverrides(overrides map[string]string) {
+	tabOverrides = overrides
+}
+
+// parseTabOverrides converts tab overrides to RGB, dropping invalid colors.
+func parseTabOverrides(overrides map[string]string) map[string]RGB {
+	if len(overrides) == 0 {
+		return nil
+	}
+	parsed := make(map[string]RGB, len(overrides))
+	for id, hex := range overrides {
+		if IsValidHexColor(hex) {
+			parsed[id] = HexToRGB(hex[:7])
+		}
+	}
+	return parsed
+}
+
 // GetTabPreset returns a tab theme preset by name, or nil if not found
 func GetTabPreset(name string) *TabThemePreset {
 	if preset, ok := TabThemePresets[name]; ok {
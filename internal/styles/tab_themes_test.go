@@ -0,0 +1,70 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// withTrueColorOutput makes lipgloss emit truecolor escapes.
+func withTrueColorOutput(t *testing.T) {
+	t.Helper()
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	withColorProfile(t, termenv.TrueColor)
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prev)
+		SetTabOverrides(nil)
+		ApplyTheme("default")
+	})
+}
+
+func TestRenderPluginTab_Overrides(t *testing.T) {
+	withTrueColorOutput(t)
+	SetTabOverrides(map[string]string{"git-status": "#FF0000", "notes": "bogus"})
+	ApplyTheme("default")
+
+	if got := RenderPluginTab("git-status", "Git", 0, 3, true); !strings.Contains(got, "48;2;255;0;0") {
+		t.Errorf("overridden tab should use its color as background: %q", got)
+	}
+	// Invalid colors and unlisted plugins fall back to the tab theme
+	if got, want := RenderPluginTab("notes", "Notes", 1, 3, true), RenderTab("Notes", 1, 3, true, false); got != want {
+		t.Errorf("invalid override should fall back:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestRenderPluginTab_PerPluginStyle(t *testing.T) {
+	withTrueColorOutput(t)
+	ApplyThemeWithGenericOverrides("default", map[string]interface{}{"tabStyle": "plugin"})
+
+	if got := RenderPluginTab("file-browser", "Files", 0, 2, true); !strings.Contains(got, "48;2;59;130;246") {
+		t.Errorf("file browser tab should use its built-in color: %q", got)
+	}
+	want := renderGradientTab("Other", 1, 2, true, false, Current().TabColors)
+	if got := RenderPluginTab("unknown", "Other", 1, 2, true); got != want {
+		t.Errorf("unknown plugin should use the gradient:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestRenderTab_UnderlineAndBlock(t *testing.T) {
+	withTrueColorOutput(t)
+	ApplyThemeWithGenericOverrides("default", map[string]interface{}{
+		"tabStyle":  "lines",
+		"tabColors": []interface{}{"#FF0000", "#0000FF"},
+	})
+
+	got := RenderTab("Tab", 0, 1, true, false)
+	if strings.Contains(got, "48;2;") || !strings.Contains(got, "38;2;127;0;127") {
+		t.Errorf("underline tab should color the label with the tab's center color: %q", got)
+	}
+
+	ApplyThemeWithGenericOverrides("default", map[string]interface{}{
+		"tabStyle":  "blocks",
+		"tabColors": []interface{}{"#FF0000", "#0000FF"},
+	})
+	if got := RenderTab("Tab", 1, 2, true, false); !strings.Contains(got, "48;2;63;0;191") {
+		t.Errorf("block tab should be a single color sampled at its center: %q", got)
+	}
+}
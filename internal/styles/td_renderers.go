@@ -8,6 +8,9 @@ import (
 // Maps td monitor PanelState values to appropriate gradients from the current theme.
 func CreateTDPanelRenderer() monitor.PanelRenderer {
 	return func(content string, width, height int, state monitor.PanelState) string {
+		if state == monitor.PanelStateActive {
+			return RenderPanelGradientBorder(content, width, height, GetActivePanelGradient(), 1)
+		}
 		gradient := getTDPanelGradient(state)
 		return RenderGradientBorder(content, width, height, gradient, 1)
 	}
@@ -1,8 +1,13 @@
 package styles
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/charmbracelet/lipgloss"
@@ -50,8 +55,16 @@ type ColorPalette struct {
 	GradientBorderNormal []string `json:"gradientBorderNormal"` // Colors for inactive panel gradient
 	GradientBorderAngle  float64  `json:"gradientBorderAngle"`  // Angle in degrees (default: 30)
 
+	// Optional per-side gradients for the active panel. Each runs along its
+	// side (left to right, top to bottom); unset sides use the angled
+	// GradientBorderActive gradient.
+	GradientBorderActiveTop    []string `json:"gradientBorderActiveTop,omitempty"`
+	GradientBorderActiveRight  []string `json:"gradientBorderActiveRight,omitempty"`
+	GradientBorderActiveBottom []string `json:"gradientBorderActiveBottom,omitempty"`
+	GradientBorderActiveLeft   []string `json:"gradientBorderActiveLeft,omitempty"`
+
 	// Tab theme configuration
-	TabStyle  string   `json:"tabStyle"`  // "gradient", "per-tab", "solid", "minimal", or preset name
+	TabStyle  string   `json:"tabStyle"`  // "gradient", "per-tab", "block", "underline", "per-plugin", "solid", "minimal", or preset name
 	TabColors []string `json:"tabColors"` // Color stops for gradient OR per-tab colors
 
 	// Diff colors
@@ -60,6 +73,12 @@ type ColorPalette struct {
 	DiffRemoveFg string `json:"diffRemoveFg"`
 	DiffRemoveBg string `json:"diffRemoveBg"`
 
+	// Word-level diff backgrounds for changed tokens within a line. When
+	// empty, they are derived by blending the diff foreground into the line
+	// background.
+	DiffAddWordBg    string `json:"diffAddWordBg,omitempty"`
+	DiffRemoveWordBg string `json:"diffRemoveWordBg,omitempty"`
+
 	// Additional UI colors
 	TextHighlight    string `json:"textHighlight"`    // For subtitle, special text
 	ButtonHover      string `json:"buttonHover"`      // Button hover state
@@ -523,16 +542,23 @@ var (
 	}
 )
 
-// themeRegistry holds all available themes
-var themeRegistry = map[string]Theme{
+// builtinThemes holds the themes shipped with forge
+var builtinThemes = map[string]Theme{
 	"default":        DefaultTheme,
 	"dracula":        DraculaTheme,
 	"molokai":        MolokaiTheme,
 	"nord":           NordTheme,
 	"solarized-dark": SolarizedDarkTheme,
 	"tokyo-night":    TokyoNightTheme,
+
+	"default-deuteranopia": DeuteranopiaTheme,
+	"default-protanopia":   ProtanopiaTheme,
+	"default-tritanopia":   TritanopiaTheme,
 }
 
+// themeRegistry holds all available themes: built-ins plus registered ones
+var themeRegistry = maps.Clone(builtinThemes)
+
 // currentTheme tracks the active theme name
 var currentTheme = "default"
 var currentThemeValue = DefaultTheme
@@ -542,6 +568,53 @@ func IsValidHexColor(hex string) bool {
 	return hexColorRegex.MatchString(hex)
 }
 
+// paletteNameFields are ColorPalette keys that hold names rather than colors.
+var paletteNameFields = map[string]bool{"syntaxTheme": true, "markdownTheme": true, "tabStyle": true}
+
+// ValidatePalette reports every color in the palette that is not a valid
+// hex code, keyed by its JSON name. Empty fields are allowed.
+func ValidatePalette(c ColorPalette) error {
+	var errs []error
+	v := reflect.ValueOf(c)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if paletteNameFields[key] {
+			continue
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.String:
+			if s := f.String(); s != "" && !IsValidHexColor(s) {
+				errs = append(errs, fmt.Errorf("%s: invalid color %q", key, s))
+			}
+		case reflect.Slice:
+			valid := IsValidHexColor
+			if isGradientKey(key) {
+				valid = IsValidGradientStop
+			}
+			for j := 0; j < f.Len(); j++ {
+				if s := f.Index(j).String(); !valid(s) {
+					errs = append(errs, fmt.Errorf("%s[%d]: invalid color %q", key, j, s))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isGradientKey reports whether a palette key holds gradient stops, which
+// may carry positions ("#7C3AED 25%").
+func isGradientKey(key string) bool {
+	return strings.HasPrefix(key, "gradientBorder")
+}
+
+// IsBuiltinTheme reports whether name is one of the themes shipped with forge.
+func IsBuiltinTheme(name string) bool {
+	_, ok := builtinThemes[name]
+	return ok
+}
+
 // IsValidTheme checks if a theme name exists in the registry
 func IsValidTheme(name string) bool {
 	themeMu.RLock()
@@ -723,6 +796,10 @@ func applySingleOverride(palette *ColorPalette, key, value string) {
 		palette.DiffRemoveFg = value
 	case "diffRemoveBg":
 		palette.DiffRemoveBg = value
+	case "diffAddWordBg":
+		palette.DiffAddWordBg = value
+	case "diffRemoveWordBg":
+		palette.DiffRemoveWordBg = value
 	case "textHighlight":
 		palette.TextHighlight = value
 	case "buttonHover":
@@ -772,8 +849,12 @@ func applySingleOverride(palette *ColorPalette, key, value string) {
 // All colors must be valid hex colors. The entire array is rejected if any color is invalid.
 func applyArrayOverride(palette *ColorPalette, key string, colors []string) {
 	// Validate all colors in the array
+	valid := IsValidHexColor
+	if isGradientKey(key) {
+		valid = IsValidGradientStop
+	}
 	for _, c := range colors {
-		if !IsValidHexColor(c) {
+		if !valid(c) {
 			return // Reject entire array if any color is invalid
 		}
 	}
@@ -783,6 +864,14 @@ func applyArrayOverride(palette *ColorPalette, key string, colors []string) {
 		palette.GradientBorderActive = colors
 	case "gradientBorderNormal":
 		palette.GradientBorderNormal = colors
+	case "gradientBorderActiveTop":
+		palette.GradientBorderActiveTop = colors
+	case "gradientBorderActiveRight":
+		palette.GradientBorderActiveRight = colors
+	case "gradientBorderActiveBottom":
+		palette.GradientBorderActiveBottom = colors
+	case "gradientBorderActiveLeft":
+		palette.GradientBorderActiveLeft = colors
 	case "tabColors":
 		palette.TabColors = colors
 	}
@@ -796,392 +885,469 @@ func applyFloatOverride(palette *ColorPalette, key string, value float64) {
 	}
 }
 
-// ApplyThemeColors updates all style package variables from a theme.
-//
-// IMPORTANT: This function is NOT thread-safe for concurrent reads.
-// It must only be called during initialization, before the TUI starts.
-// The TUI's single-threaded Bubble Tea model ensures safe access after init.
+// ApplyThemeColors builds the colors and styles for a theme and publishes
+// them as the current snapshot. It is safe to call at runtime: renders in
+// progress keep the snapshot they started with.
 func ApplyThemeColors(theme Theme) {
-	c := theme.Colors
-
-	// Update color variables
-	Primary = lipgloss.Color(c.Primary)
-	Secondary = lipgloss.Color(c.Secondary)
-	Accent = lipgloss.Color(c.Accent)
-
-	Success = lipgloss.Color(c.Success)
-	Warning = lipgloss.Color(c.Warning)
-	Error = lipgloss.Color(c.Error)
-	Info = lipgloss.Color(c.Info)
-
-	TextPrimary = lipgloss.Color(c.TextPrimary)
-	TextSecondary = lipgloss.Color(c.TextSecondary)
-	TextMuted = lipgloss.Color(c.TextMuted)
-	TextSubtle = lipgloss.Color(c.TextSubtle)
+	theme.Colors = remapColorblind(theme.Colors, ColorblindMode)
+	s := newSnapshot(theme.Colors)
+
+	themeMu.Lock()
+	currentThemeValue = theme
+	themeMu.Unlock()
+
+	current.Store(s)
+	rebuildPluginStyles()
+}
+
+// newSnapshot builds a snapshot from a palette. Optional colors the palette
+// leaves empty keep their values from the current snapshot.
+func newSnapshot(c ColorPalette) *Snapshot {
+	s := &Snapshot{}
+	if prev := Current(); prev != nil {
+		*s = *prev
+	}
+	s.setColors(c)
+	s.buildStyles()
+	return s
+}
+
+// setColors sets the snapshot's colors from a palette.
+func (s *Snapshot) setColors(c ColorPalette) {
+	s.Primary = ThemeColor(c.Primary)
+	s.Secondary = ThemeColor(c.Secondary)
+	s.Accent = ThemeColor(c.Accent)
+
+	s.Success = ThemeColor(c.Success)
+	s.Warning = ThemeColor(c.Warning)
+	s.Error = ThemeColor(c.Error)
+	s.Info = ThemeColor(c.Info)
+
+	s.TextPrimary = ThemeColor(c.TextPrimary)
+	s.TextSecondary = ThemeColor(c.TextSecondary)
+	s.TextMuted = ThemeColor(c.TextMuted)
+	s.TextSubtle = ThemeColor(c.TextSubtle)
 	// TextSelectionColor with fallback to TextPrimary
 	if c.TextSelection != "" {
-		TextSelectionColor = lipgloss.Color(c.TextSelection)
+		s.TextSelectionColor = ThemeColor(c.TextSelection)
 	} else {
-		TextSelectionColor = lipgloss.Color(c.TextPrimary)
+		s.TextSelectionColor = ThemeColor(c.TextPrimary)
 	}
 
-	BgPrimary = lipgloss.Color(c.BgPrimary)
-	BgSecondary = lipgloss.Color(c.BgSecondary)
-	BgTertiary = lipgloss.Color(c.BgTertiary)
-	BgOverlay = lipgloss.Color(c.BgOverlay)
-
-	BorderNormal = lipgloss.Color(c.BorderNormal)
-	BorderActive = lipgloss.Color(c.BorderActive)
-	BorderMuted = lipgloss.Color(c.BorderMuted)
-
-	DiffAddFg = lipgloss.Color(c.DiffAddFg)
-	DiffAddBg = lipgloss.Color(c.DiffAddBg)
-	DiffRemoveFg = lipgloss.Color(c.DiffRemoveFg)
-	DiffRemoveBg = lipgloss.Color(c.DiffRemoveBg)
-
-	TextHighlight = lipgloss.Color(c.TextHighlight)
-	ButtonHoverColor = lipgloss.Color(c.ButtonHover)
-	TabTextInactiveColor = lipgloss.Color(c.TabTextInactive)
-	LinkColor = lipgloss.Color(c.Link)
-	ToastSuccessTextColor = lipgloss.Color(c.ToastSuccessText)
-	ToastErrorTextColor = lipgloss.Color(c.ToastErrorText)
+	s.BgPrimary = ThemeColor(c.BgPrimary)
+	s.BgSecondary = ThemeColor(c.BgSecondary)
+	s.BgTertiary = ThemeColor(c.BgTertiary)
+	s.BgOverlay = ThemeColor(c.BgOverlay)
+
+	s.BorderNormal = ThemeColor(c.BorderNormal)
+	s.BorderActive = ThemeColor(c.BorderActive)
+	s.BorderMuted = ThemeColor(c.BorderMuted)
+
+	s.DiffAddFg = ThemeColor(c.DiffAddFg)
+	s.DiffAddBg = ThemeColor(c.DiffAddBg)
+	s.DiffRemoveFg = ThemeColor(c.DiffRemoveFg)
+	s.DiffRemoveBg = ThemeColor(c.DiffRemoveBg)
+	s.DiffAddWordBg = ThemeColor(wordDiffBg(c.DiffAddWordBg, c.DiffAddFg, c.DiffAddBg))
+	s.DiffRemoveWordBg = ThemeColor(wordDiffBg(c.DiffRemoveWordBg, c.DiffRemoveFg, c.DiffRemoveBg))
+
+	s.TextHighlight = ThemeColor(c.TextHighlight)
+	s.ButtonHoverColor = ThemeColor(c.ButtonHover)
+	s.TabTextInactiveColor = ThemeColor(c.TabTextInactive)
+	s.LinkColor = ThemeColor(c.Link)
+	s.ToastSuccessTextColor = ThemeColor(c.ToastSuccessText)
+	s.ToastErrorTextColor = ThemeColor(c.ToastErrorText)
 
 	// Danger button colors (with defaults)
 	if c.DangerLight != "" {
-		DangerLight = lipgloss.Color(c.DangerLight)
+		s.DangerLight = ThemeColor(c.DangerLight)
 	}
 	if c.DangerDark != "" {
-		DangerDark = lipgloss.Color(c.DangerDark)
+		s.DangerDark = ThemeColor(c.DangerDark)
 	}
 	if c.DangerBright != "" {
-		DangerBright = lipgloss.Color(c.DangerBright)
+		s.DangerBright = ThemeColor(c.DangerBright)
 	}
 	if c.DangerHover != "" {
-		DangerHover = lipgloss.Color(c.DangerHover)
+		s.DangerHover = ThemeColor(c.DangerHover)
 	}
 	if c.TextInverse != "" {
-		TextInverse = lipgloss.Color(c.TextInverse)
+		s.TextInverse = ThemeColor(c.TextInverse)
 	}
 
 	// Blame age gradient colors (with defaults)
 	if c.BlameAge1 != "" {
-		BlameAge1 = lipgloss.Color(c.BlameAge1)
+		s.BlameAge1 = ThemeColor(c.BlameAge1)
 	}
 	if c.BlameAge2 != "" {
-		BlameAge2 = lipgloss.Color(c.BlameAge2)
+		s.BlameAge2 = ThemeColor(c.BlameAge2)
 	}
 	if c.BlameAge3 != "" {
-		BlameAge3 = lipgloss.Color(c.BlameAge3)
+		s.BlameAge3 = ThemeColor(c.BlameAge3)
 	}
 	if c.BlameAge4 != "" {
-		BlameAge4 = lipgloss.Color(c.BlameAge4)
+		s.BlameAge4 = ThemeColor(c.BlameAge4)
 	}
 	if c.BlameAge5 != "" {
-		BlameAge5 = lipgloss.Color(c.BlameAge5)
+		s.BlameAge5 = ThemeColor(c.BlameAge5)
 	}
 
 	// Scrollbar colors (with fallback to TextSubtle/TextMuted)
 	if c.ScrollbarTrack != "" {
-		ScrollbarTrackColor = lipgloss.Color(c.ScrollbarTrack)
+		s.ScrollbarTrackColor = ThemeColor(c.ScrollbarTrack)
 	} else {
-		ScrollbarTrackColor = TextSubtle
+		s.ScrollbarTrackColor = s.TextSubtle
 	}
 	if c.ScrollbarThumb != "" {
-		ScrollbarThumbColor = lipgloss.Color(c.ScrollbarThumb)
+		s.ScrollbarThumbColor = ThemeColor(c.ScrollbarThumb)
 	} else {
-		ScrollbarThumbColor = TextMuted
+		s.ScrollbarThumbColor = s.TextMuted
 	}
 
-	// Store syntax/markdown theme names for external use
-	CurrentSyntaxTheme = c.SyntaxTheme
-	CurrentMarkdownTheme = c.MarkdownTheme
-
-	// Update tab theme state
-	CurrentTabStyle = c.TabStyle
-	CurrentTabColors = parseTabColors(c.TabColors)
+	// Third-party theme names
+	s.SyntaxTheme = c.SyntaxTheme
+	s.MarkdownTheme = c.MarkdownTheme
 
-	themeMu.Lock()
-	currentThemeValue = theme
-	themeMu.Unlock()
+	// Tab theme
+	s.TabStyle = c.TabStyle
+	s.TabColors = parseTabColors(c.TabColors)
+	s.TabOverrides = parseTabOverrides(tabOverrides)
+}
 
-	// Rebuild all styles that depend on these colors
-	rebuildStyles()
+// wordDiffBg returns the word-level diff background: the explicit color if
+// set, otherwise the line background with a third of the foreground mixed in.
+func wordDiffBg(explicit, fg, lineBg string) string {
+	if explicit != "" {
+		return explicit
+	}
+	if !IsValidHexColor(fg) || !IsValidHexColor(lineBg) {
+		return lineBg
+	}
+	return RGBToHex(LerpRGB(HexToRGB(lineBg[:7]), HexToRGB(fg[:7]), 0.35))
 }
 
-// rebuildStyles recreates all lipgloss styles with current colors
+// rebuildStyles republishes the current snapshot with its styles rebuilt,
+// for settings such as HighContrastFocus that change styles but not colors.
 func rebuildStyles() {
+	s := *Current()
+	s.buildStyles()
+	current.Store(&s)
+	rebuildPluginStyles()
+}
+
+// buildStyles recreates all lipgloss styles from the snapshot's colors.
+func (s *Snapshot) buildStyles() {
 	// Panel styles
-	PanelActive = lipgloss.NewStyle().
+	s.PanelActive = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(BorderActive).
+		BorderForeground(s.BorderActive).
 		Padding(0, 1)
 
-	PanelInactive = lipgloss.NewStyle().
+	s.PanelInactive = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(BorderNormal).
+		BorderForeground(s.BorderNormal).
 		Padding(0, 1)
 
-	PanelHeader = lipgloss.NewStyle().
+	s.PanelHeader = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(TextPrimary).
+		Foreground(s.TextPrimary).
 		MarginBottom(1)
 
-	PanelNoBorder = lipgloss.NewStyle().
+	s.PanelNoBorder = lipgloss.NewStyle().
 		Padding(0, 1)
 
 	// Text styles
-	Title = lipgloss.NewStyle().
+	s.Title = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(TextPrimary)
+		Foreground(s.TextPrimary)
 
-	Subtitle = lipgloss.NewStyle().
-		Foreground(TextHighlight)
+	s.Subtitle = lipgloss.NewStyle().
+		Foreground(s.TextHighlight)
 
 	// WorktreeIndicator shows the current worktree branch in the header
-	WorktreeIndicator = lipgloss.NewStyle().
-		Foreground(Warning).
+	s.WorktreeIndicator = lipgloss.NewStyle().
+		Foreground(s.Warning).
 		Bold(true)
 
-	Body = lipgloss.NewStyle().
-		Foreground(TextPrimary)
+	s.Body = lipgloss.NewStyle().
+		Foreground(s.TextPrimary)
 
-	Muted = lipgloss.NewStyle().
-		Foreground(TextMuted)
+	s.Muted = lipgloss.NewStyle().
+		Foreground(s.TextMuted)
 
-	Subtle = lipgloss.NewStyle().
-		Foreground(TextSubtle)
+	s.Subtle = lipgloss.NewStyle().
+		Foreground(s.TextSubtle)
 
-	Code = lipgloss.NewStyle().
-		Foreground(Accent)
+	s.Code = lipgloss.NewStyle().
+		Foreground(s.Accent)
 
-	Link = lipgloss.NewStyle().
-		Foreground(LinkColor).
+	s.Link = lipgloss.NewStyle().
+		Foreground(s.LinkColor).
 		Underline(true)
 
-	KeyHint = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgTertiary).
+	s.KeyHint = lipgloss.NewStyle().
+		Foreground(s.TextMuted).
+		Background(s.BgTertiary).
 		Padding(0, 1)
 
-	Logo = lipgloss.NewStyle().
-		Foreground(Primary).
+	s.Logo = lipgloss.NewStyle().
+		Foreground(s.Primary).
 		Bold(true)
 
 	// Status indicator styles
-	StatusStaged = lipgloss.NewStyle().
-		Foreground(Success).
+	s.StatusStaged = lipgloss.NewStyle().
+		Foreground(s.Success).
 		Bold(true)
 
-	StatusModified = lipgloss.NewStyle().
-		Foreground(Warning).
+	s.StatusModified = lipgloss.NewStyle().
+		Foreground(s.Warning).
 		Bold(true)
 
-	ToastSuccess = lipgloss.NewStyle().
-		Background(Success).
-		Foreground(ToastSuccessTextColor).
+	s.ToastSuccess = lipgloss.NewStyle().
+		Background(s.Success).
+		Foreground(s.ToastSuccessTextColor).
 		Bold(true).
 		Padding(0, 1)
 
-	ToastError = lipgloss.NewStyle().
-		Background(Error).
-		Foreground(ToastErrorTextColor).
+	s.ToastError = lipgloss.NewStyle().
+		Background(s.Error).
+		Foreground(s.ToastErrorTextColor).
 		Bold(true).
 		Padding(0, 1)
 
-	StatusUntracked = lipgloss.NewStyle().
-		Foreground(TextMuted)
+	s.StatusUntracked = lipgloss.NewStyle().
+		Foreground(s.TextMuted)
 
-	StatusDeleted = lipgloss.NewStyle().
-		Foreground(Error).
+	s.StatusDeleted = lipgloss.NewStyle().
+		Foreground(s.Error).
 		Bold(true)
 
-	StatusInProgress = lipgloss.NewStyle().
-		Foreground(Info).
+	s.StatusInProgress = lipgloss.NewStyle().
+		Foreground(s.Info).
 		Bold(true)
 
-	StatusCompleted = lipgloss.NewStyle().
-		Foreground(Success)
+	s.StatusCompleted = lipgloss.NewStyle().
+		Foreground(s.Success)
 
-	StatusBlocked = lipgloss.NewStyle().
-		Foreground(Error)
+	s.StatusBlocked = lipgloss.NewStyle().
+		Foreground(s.Error)
 
-	StatusPending = lipgloss.NewStyle().
-		Foreground(TextMuted)
+	s.StatusPending = lipgloss.NewStyle().
+		Foreground(s.TextMuted)
 
 	// List item styles
-	ListItemNormal = lipgloss.NewStyle().
-		Foreground(TextPrimary)
+	s.ListItemNormal = lipgloss.NewStyle().
+		Foreground(s.TextPrimary)
 
-	ListItemSelected = lipgloss.NewStyle().
-		Foreground(TextSelectionColor).
-		Background(BgTertiary)
+	s.ListItemSelected = lipgloss.NewStyle().
+		Foreground(s.TextSelectionColor).
+		Background(s.BgTertiary)
 
-	ListItemFocused = lipgloss.NewStyle().
-		Foreground(TextPrimary).
-		Background(Primary)
+	s.ListItemFocused = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
+		Background(s.Primary)
 
-	ListCursor = lipgloss.NewStyle().
-		Foreground(Primary).
+	s.ListCursor = lipgloss.NewStyle().
+		Foreground(s.Primary).
 		Bold(true)
 
 	// Bar element styles
-	BarTitle = lipgloss.NewStyle().
-		Foreground(TextPrimary).
+	s.BarTitle = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
 		Bold(true)
 
-	BarText = lipgloss.NewStyle().
-		Foreground(TextMuted)
+	s.BarText = lipgloss.NewStyle().
+		Foreground(s.TextMuted)
 
-	BarChip = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgTertiary).
+	s.BarChip = lipgloss.NewStyle().
+		Foreground(s.TextMuted).
+		Background(s.BgTertiary).
 		Padding(0, 1)
 
-	BarChipActive = lipgloss.NewStyle().
-		Foreground(TextPrimary).
-		Background(Primary).
+	s.BarChipActive = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
+		Background(s.Primary).
 		Padding(0, 1).
 		Bold(true)
 
 	// Tab styles
-	TabTextActive = lipgloss.NewStyle().
-		Foreground(TextPrimary).
+	s.TabTextActive = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
 		Bold(true)
 
-	TabTextInactive = lipgloss.NewStyle().
-		Foreground(TabTextInactiveColor)
+	s.TabTextInactive = lipgloss.NewStyle().
+		Foreground(s.TabTextInactiveColor)
 
 	// Diff line styles
-	DiffAdd = lipgloss.NewStyle().
-		Foreground(Success)
+	s.DiffAdd = lipgloss.NewStyle().
+		Foreground(s.Success)
 
-	DiffRemove = lipgloss.NewStyle().
-		Foreground(Error)
+	s.DiffRemove = lipgloss.NewStyle().
+		Foreground(s.Error)
 
-	DiffContext = lipgloss.NewStyle().
-		Foreground(TextMuted)
+	s.DiffContext = lipgloss.NewStyle().
+		Foreground(s.TextMuted)
 
-	DiffHeader = lipgloss.NewStyle().
-		Foreground(Info).
+	s.DiffHeader = lipgloss.NewStyle().
+		Foreground(s.Info).
+		Bold(true)
+
+	s.DiffAddWord = lipgloss.NewStyle().
+		Foreground(s.Success).
+		Background(s.DiffAddWordBg).
+		Bold(true)
+
+	s.DiffRemoveWord = lipgloss.NewStyle().
+		Foreground(s.Error).
+		Background(s.DiffRemoveWordBg).
 		Bold(true)
 
 	// File browser styles
-	FileBrowserDir = lipgloss.NewStyle().
-		Foreground(Secondary).
+	s.FileBrowserDir = lipgloss.NewStyle().
+		Foreground(s.Secondary).
 		Bold(true)
 
-	FileBrowserFile = lipgloss.NewStyle().
-		Foreground(TextPrimary)
+	s.FileBrowserFile = lipgloss.NewStyle().
+		Foreground(s.TextPrimary)
 
-	FileBrowserIgnored = lipgloss.NewStyle().
-		Foreground(TextSubtle)
+	s.FileBrowserIgnored = lipgloss.NewStyle().
+		Foreground(s.TextSubtle)
 
-	FileBrowserLineNumber = lipgloss.NewStyle().
-		Foreground(TextMuted).
+	s.FileBrowserLineNumber = lipgloss.NewStyle().
+		Foreground(s.TextMuted).
 		Width(5).
 		AlignHorizontal(lipgloss.Right)
 
-	FileBrowserIcon = lipgloss.NewStyle().
-		Foreground(TextMuted)
+	s.FileBrowserIcon = lipgloss.NewStyle().
+		Foreground(s.TextMuted)
 
-	SearchMatch = lipgloss.NewStyle().
-		Background(Warning)
+	s.SearchMatch = lipgloss.NewStyle().
+		Background(s.Warning)
 
-	SearchMatchCurrent = lipgloss.NewStyle().
-		Background(Primary).
-		Foreground(TextPrimary)
+	s.SearchMatchCurrent = lipgloss.NewStyle().
+		Background(s.Primary).
+		Foreground(s.TextPrimary)
 
-	FuzzyMatchChar = lipgloss.NewStyle().
-		Foreground(Primary).
+	s.FuzzyMatchChar = lipgloss.NewStyle().
+		Foreground(s.Primary).
 		Bold(true)
 
-	QuickOpenItem = lipgloss.NewStyle().
-		Foreground(TextPrimary)
+	s.QuickOpenItem = lipgloss.NewStyle().
+		Foreground(s.TextPrimary)
 
-	QuickOpenItemSelected = lipgloss.NewStyle().
-		Foreground(TextSelectionColor).
-		Background(BgTertiary)
+	s.QuickOpenItemSelected = lipgloss.NewStyle().
+		Foreground(s.TextSelectionColor).
+		Background(s.BgTertiary)
 
-	PaletteEntry = lipgloss.NewStyle().
-		Foreground(TextPrimary)
+	s.PaletteEntry = lipgloss.NewStyle().
+		Foreground(s.TextPrimary)
 
-	PaletteEntrySelected = lipgloss.NewStyle().
-		Foreground(TextSelectionColor).
-		Background(BgTertiary)
+	s.PaletteEntrySelected = lipgloss.NewStyle().
+		Foreground(s.TextSelectionColor).
+		Background(s.BgTertiary)
 
-	PaletteKey = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgTertiary).
+	s.PaletteKey = lipgloss.NewStyle().
+		Foreground(s.TextMuted).
+		Background(s.BgTertiary).
 		Padding(0, 1)
 
-	TextSelection = lipgloss.NewStyle().
-		Background(BgTertiary).
-		Foreground(TextSelectionColor)
+	s.TextSelection = lipgloss.NewStyle().
+		Background(s.BgTertiary).
+		Foreground(s.TextSelectionColor)
 
 	// Footer and header
-	Footer = lipgloss.NewStyle().
-		Foreground(TextMuted).
-		Background(BgSecondary)
+	s.Footer = lipgloss.NewStyle().
+		Foreground(s.TextMuted).
+		Background(s.BgSecondary)
 
-	Header = lipgloss.NewStyle().
-		Background(BgSecondary)
+	s.Header = lipgloss.NewStyle().
+		Background(s.BgSecondary)
 
 	// Modal styles
-	ModalOverlay = lipgloss.NewStyle().
-		Background(BgOverlay)
+	s.ModalOverlay = lipgloss.NewStyle().
+		Background(s.BgOverlay)
 
-	ModalBox = lipgloss.NewStyle().
+	s.ModalBox = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(Primary).
-		Background(BgSecondary).
+		BorderForeground(s.Primary).
+		Background(s.BgSecondary).
 		Padding(1, 2)
 
-	ModalTitle = lipgloss.NewStyle().
-		Foreground(TextPrimary).
+	s.ModalTitle = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
 		Bold(true).
 		MarginBottom(1)
 
 	// Button styles
-	Button = lipgloss.NewStyle().
-		Foreground(TextSecondary).
-		Background(BgTertiary).
+	s.Button = lipgloss.NewStyle().
+		Foreground(s.TextSecondary).
+		Background(s.BgTertiary).
 		Padding(0, 2)
 
-	ButtonFocused = lipgloss.NewStyle().
-		Foreground(TextPrimary).
-		Background(Primary).
+	s.ButtonFocused = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
+		Background(s.Primary).
 		Padding(0, 2).
 		Bold(true)
 
-	ButtonHover = lipgloss.NewStyle().
-		Foreground(TextPrimary).
-		Background(ButtonHoverColor).
+	s.ButtonHover = lipgloss.NewStyle().
+		Foreground(s.TextPrimary).
+		Background(s.ButtonHoverColor).
 		Padding(0, 2)
 
 	// Danger button styles
-	ButtonDanger = lipgloss.NewStyle().
-		Foreground(DangerLight).
-		Background(DangerDark).
+	s.ButtonDanger = lipgloss.NewStyle().
+		Foreground(s.DangerLight).
+		Background(s.DangerDark).
 		Padding(0, 2)
 
-	ButtonDangerFocused = lipgloss.NewStyle().
-		Foreground(TextInverse).
-		Background(DangerBright).
+	s.ButtonDangerFocused = lipgloss.NewStyle().
+		Foreground(s.TextInverse).
+		Background(s.DangerBright).
 		Padding(0, 2).
 		Bold(true)
 
-	ButtonDangerHover = lipgloss.NewStyle().
-		Foreground(TextInverse).
-		Background(DangerHover).
+	s.ButtonDangerHover = lipgloss.NewStyle().
+		Foreground(s.TextInverse).
+		Background(s.DangerHover).
 		Padding(0, 2)
+
+	s.StatusArchived = lipgloss.NewStyle().
+		Foreground(s.Info)
+
+	s.StatusDeletedNote = lipgloss.NewStyle().
+		Foreground(s.Error)
+
+	if HighContrastFocus {
+		s.applyHighContrastFocus()
+	}
+	if ColorblindMode != "" {
+		s.applyColorblindCues()
+	}
+}
+
+// SetHighContrastFocus enables or disables high-contrast focus indicators
+// and rebuilds the affected styles. Like ApplyThemeColors, it must only be
+// called before the TUI starts or from the Bubble Tea update loop.
+func SetHighContrastFocus(enabled bool) {
+	HighContrastFocus = enabled
+	rebuildStyles()
+}
+
+// applyHighContrastFocus layers the high-contrast cues onto the focus styles.
+func (s *Snapshot) applyHighContrastFocus() {
+	s.PanelActive = s.PanelActive.Border(lipgloss.DoubleBorder())
+	s.ListItemSelected = s.ListItemSelected.Reverse(true).Bold(true)
+	s.ListItemFocused = s.ListItemFocused.Reverse(true).Bold(true)
+	s.ButtonFocused = s.ButtonFocused.Reverse(true).Underline(true)
+	s.ButtonDangerFocused = s.ButtonDangerFocused.Reverse(true).Underline(true)
 }
 
 // GetSyntaxTheme returns the current syntax highlighting theme name
 func GetSyntaxTheme() string {
-	return CurrentSyntaxTheme
+	return Current().SyntaxTheme
 }
 
 // GetMarkdownTheme returns the current markdown rendering theme name
 func GetMarkdownTheme() string {
-	return CurrentMarkdownTheme
+	return Current().MarkdownTheme
 }
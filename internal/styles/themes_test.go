@@ -1,6 +1,11 @@
 package styles
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 func TestIsValidHexColor(t *testing.T) {
 	tests := []struct {
@@ -63,3 +68,47 @@ func TestGetTheme(t *testing.T) {
 		t.Errorf("GetTheme(\"nonexistent\") = %q, want default theme %q", fallback.Name, DefaultTheme.Name)
 	}
 }
+
+func TestValidatePalette(t *testing.T) {
+	if err := ValidatePalette(DefaultTheme.Colors); err != nil {
+		t.Errorf("default palette should validate: %v", err)
+	}
+
+	p := DefaultTheme.Colors
+	p.Primary = "purple"
+	p.TabColors = []string{"#FF0000", "#GG0000"}
+	p.SyntaxTheme = "not-a-color"
+	p.TextMuted = ""
+	err := ValidatePalette(p)
+	if err == nil {
+		t.Fatal("expected errors for invalid colors")
+	}
+	msg := err.Error()
+	for _, want := range []string{`primary: invalid color "purple"`, `tabColors[1]: invalid color "#GG0000"`} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q missing %q", msg, want)
+		}
+	}
+	if strings.Contains(msg, "syntaxTheme") || strings.Contains(msg, "textMuted") {
+		t.Errorf("names and empty fields should not be reported: %q", msg)
+	}
+}
+
+func TestWordDiffBg(t *testing.T) {
+	t.Cleanup(func() { ApplyTheme("default") })
+
+	ApplyTheme("default")
+	c := DefaultTheme.Colors
+	want := RGBToHex(LerpRGB(HexToRGB(c.DiffAddBg), HexToRGB(c.DiffAddFg), 0.35))
+	if got := Current().DiffAddWordBg; !strings.EqualFold(string(got), want) {
+		t.Errorf("derived DiffAddWordBg = %s, want %s", got, want)
+	}
+	if Current().DiffAddWordBg == Current().DiffAddBg {
+		t.Error("word background should stand out from the line background")
+	}
+
+	ApplyThemeWithGenericOverrides("default", map[string]interface{}{"diffRemoveWordBg": "#550000"})
+	if got := Current().DiffRemoveWord.GetBackground(); got != lipgloss.Color("#550000") {
+		t.Errorf("DiffRemoveWord background = %v, want override", got)
+	}
+}
@@ -0,0 +1,244 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/wilbur182/forge/internal/config"
+	"github.com/wilbur182/forge/internal/styles"
+	"gopkg.in/yaml.v3"
+)
+
+// customMu protects customErrors.
+var customMu sync.Mutex
+
+// customErrors holds the errors from the most recent LoadCustomThemes call.
+var customErrors []error
+
+// FileError reports a custom theme file that could not be loaded.
+type FileError struct {
+	Path string
+	Err  error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s: %v", filepath.Base(e.Path), e.Err)
+}
+
+func (e *FileError) Unwrap() error { return e.Err }
+
+// CustomThemesDir returns the directory custom theme files are loaded from,
+// ~/.config/forge/themes.
+func CustomThemesDir() string {
+	path := config.ConfigPath()
+	if path == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(path), "themes")
+}
+
+// IsThemeFile reports whether path has an extension LoadCustomThemes reads.
+func IsThemeFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// LoadCustomThemes registers every JSON or YAML theme file in dir with the
+// styles registry. It returns the registered theme names and one error per
+// file that failed to parse or validate; a missing directory is not an error.
+// Colors a file leaves out are taken from the theme named by its "extends"
+// key, which may be a built-in or another file in dir, or from the default
+// theme.
+func LoadCustomThemes(dir string) ([]string, []error) {
+	var names []string
+	var errs []error
+	defer func() {
+		customMu.Lock()
+		customErrors = errs
+		customMu.Unlock()
+	}()
+
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		errs = append(errs, &FileError{Path: dir, Err: err})
+		return nil, errs
+	}
+
+	// Read every file first so a theme can extend one defined after it
+	var files []*themeFile
+	byName := make(map[string]*themeFile)
+	for _, entry := range entries {
+		if entry.IsDir() || !IsThemeFile(entry.Name()) {
+			continue
+		}
+		f := readThemeFile(filepath.Join(dir, entry.Name()))
+		if f.err == nil {
+			if styles.IsBuiltinTheme(f.name) {
+				f.err = fmt.Errorf("name %q is taken by a built-in theme", f.name)
+			} else if prev, dup := byName[f.name]; dup {
+				f.err = fmt.Errorf("name %q is already defined in %s", f.name, filepath.Base(prev.path))
+			} else {
+				byName[f.name] = f
+			}
+		}
+		files = append(files, f)
+	}
+
+	for _, f := range files {
+		if f.err == nil {
+			resolveThemeFile(f, byName, nil)
+		}
+		if f.err != nil {
+			errs = append(errs, &FileError{Path: f.path, Err: f.err})
+			continue
+		}
+		styles.RegisterTheme(f.theme)
+		names = append(names, f.name)
+	}
+	return names, errs
+}
+
+// LoadErrors returns the errors from the most recent LoadCustomThemes call.
+func LoadErrors() []error {
+	customMu.Lock()
+	defer customMu.Unlock()
+	return slices.Clone(customErrors)
+}
+
+// errExtendsCycle reports theme files that extend each other.
+var errExtendsCycle = errors.New("extends cycle")
+
+// themeFile is a custom theme file on its way to being registered.
+type themeFile struct {
+	path    string
+	data    []byte // JSON, converted from YAML if needed
+	name    string
+	extends string
+	theme   styles.Theme
+	done    bool
+	err     error
+}
+
+// readThemeFile reads a theme file and its name and parent. The theme name
+// defaults to the file name without its extension.
+func readThemeFile(path string) *themeFile {
+	f := &themeFile{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		f.err = err
+		return f
+	}
+
+	// YAML is converted to JSON so both formats share the palette's JSON keys
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		var doc any
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			f.err = fmt.Errorf("parse yaml: %w", err)
+			return f
+		}
+		if data, err = json.Marshal(doc); err != nil {
+			f.err = fmt.Errorf("parse yaml: %w", err)
+			return f
+		}
+	}
+	f.data = data
+
+	var header struct {
+		Name    string `json:"name"`
+		Extends string `json:"extends"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		f.err = fmt.Errorf("parse: %w", err)
+		return f
+	}
+	f.name = strings.TrimSpace(header.Name)
+	if f.name == "" {
+		f.name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	f.extends = strings.TrimSpace(header.Extends)
+	return f
+}
+
+// resolveThemeFile builds f's theme on top of its parent's palette,
+// resolving parent files first. chain holds the files being resolved, to
+// detect cycles.
+func resolveThemeFile(f *themeFile, byName map[string]*themeFile, chain []string) error {
+	if f.done {
+		return f.err
+	}
+	if slices.Contains(chain, f.name) {
+		return fmt.Errorf("%w: %s", errExtendsCycle, strings.Join(append(chain, f.name), " -> "))
+	}
+
+	base := styles.DefaultTheme.Colors
+	if f.extends != "" {
+		if parent, ok := byName[f.extends]; ok {
+			if err := resolveThemeFile(parent, byName, append(chain, f.name)); err != nil {
+				if !errors.Is(err, errExtendsCycle) {
+					err = fmt.Errorf("parent theme %q failed to load", f.extends)
+				}
+				return f.finish(styles.Theme{}, err)
+			}
+			base = parent.theme.Colors
+		} else if styles.IsValidTheme(f.extends) {
+			base = styles.GetTheme(f.extends).Colors
+		} else {
+			return f.finish(styles.Theme{}, fmt.Errorf("extends unknown theme %q", f.extends))
+		}
+	}
+	return f.finish(decodeThemeFile(f, base))
+}
+
+// finish records the outcome of resolving f.
+func (f *themeFile) finish(t styles.Theme, err error) error {
+	f.theme, f.err, f.done = t, err, true
+	return err
+}
+
+// decodeThemeFile decodes f over a copy of the base palette and validates
+// the result.
+func decodeThemeFile(f *themeFile, base styles.ColorPalette) (styles.Theme, error) {
+	// Slices are cloned because decoding into a slice reuses its backing array
+	t := struct {
+		styles.Theme
+		Extends string `json:"extends"`
+	}{Theme: styles.Theme{Colors: base}}
+	t.Colors.GradientBorderActive = slices.Clone(t.Colors.GradientBorderActive)
+	t.Colors.GradientBorderNormal = slices.Clone(t.Colors.GradientBorderNormal)
+	t.Colors.GradientBorderActiveTop = slices.Clone(t.Colors.GradientBorderActiveTop)
+	t.Colors.GradientBorderActiveRight = slices.Clone(t.Colors.GradientBorderActiveRight)
+	t.Colors.GradientBorderActiveBottom = slices.Clone(t.Colors.GradientBorderActiveBottom)
+	t.Colors.GradientBorderActiveLeft = slices.Clone(t.Colors.GradientBorderActiveLeft)
+	t.Colors.TabColors = slices.Clone(t.Colors.TabColors)
+
+	dec := json.NewDecoder(bytes.NewReader(f.data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&t); err != nil {
+		return styles.Theme{}, fmt.Errorf("parse: %w", err)
+	}
+
+	t.Name = f.name
+	if t.DisplayName == "" {
+		t.DisplayName = t.Name
+	}
+	if err := styles.ValidatePalette(t.Colors); err != nil {
+		return styles.Theme{}, err
+	}
+	return t.Theme, nil
+}
@@ -0,0 +1,110 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+func writeThemeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadCustomThemes(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "ocean.json", `{"name": "test-ocean", "displayName": "Ocean", "colors": {"primary": "#0EA5E9", "gradientBorderActive": ["#0EA5E9", "#22D3EE"]}}`)
+	writeThemeFile(t, dir, "test-forest.yaml", "colors:\n  primary: \"#16A34A\"\n  syntaxTheme: monokai\n")
+	writeThemeFile(t, dir, "bad-color.json", `{"name": "test-bad", "colors": {"primary": "green"}}`)
+	writeThemeFile(t, dir, "typo.json", `{"name": "test-typo", "colors": {"primray": "#000000"}}`)
+	writeThemeFile(t, dir, "shadow.yml", "name: dracula\n")
+	writeThemeFile(t, dir, "notes.txt", "not a theme")
+
+	names, errs := LoadCustomThemes(dir)
+	slices.Sort(names)
+	if want := []string{"test-forest", "test-ocean"}; !slices.Equal(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+	if len(errs) != 3 {
+		t.Fatalf("errs = %v, want 3", errs)
+	}
+	for i, want := range []string{`bad-color.json: primary: invalid color "green"`, `shadow.yml: name "dracula" is taken`, `typo.json: parse: json: unknown field "primray"`} {
+		if !strings.HasPrefix(errs[i].Error(), want) {
+			t.Errorf("errs[%d] = %q, want prefix %q", i, errs[i], want)
+		}
+	}
+	if got := LoadErrors(); len(got) != 3 {
+		t.Errorf("LoadErrors() = %v", got)
+	}
+
+	ocean := styles.GetTheme("test-ocean")
+	if ocean.DisplayName != "Ocean" || ocean.Colors.Primary != "#0EA5E9" {
+		t.Errorf("ocean = %+v", ocean)
+	}
+	if ocean.Colors.TextPrimary != styles.DefaultTheme.Colors.TextPrimary {
+		t.Error("omitted colors should come from the default theme")
+	}
+	if !slices.Equal(styles.DefaultTheme.Colors.GradientBorderActive, []string{"#7C3AED", "#3B82F6"}) {
+		t.Error("loading a theme must not modify the default palette")
+	}
+	forest := styles.GetTheme("test-forest")
+	if forest.DisplayName != "test-forest" || forest.Colors.SyntaxTheme != "monokai" {
+		t.Errorf("forest = %+v", forest)
+	}
+}
+
+func TestLoadCustomThemes_MissingDir(t *testing.T) {
+	names, errs := LoadCustomThemes(filepath.Join(t.TempDir(), "none"))
+	if names != nil || errs != nil {
+		t.Errorf("missing dir: names=%v errs=%v", names, errs)
+	}
+}
+
+func TestLoadCustomThemes_Extends(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "a-child.yaml", "name: test-child\nextends: test-parent\ncolors:\n  accent: \"#111111\"\n")
+	writeThemeFile(t, dir, "b-parent.json", `{"name": "test-parent", "extends": "dracula", "colors": {"primary": "#222222"}}`)
+	writeThemeFile(t, dir, "c-orphan.json", `{"name": "test-orphan", "extends": "no-such-theme"}`)
+	writeThemeFile(t, dir, "d-loop1.json", `{"name": "test-loop1", "extends": "test-loop2"}`)
+	writeThemeFile(t, dir, "e-loop2.json", `{"name": "test-loop2", "extends": "test-loop1"}`)
+	writeThemeFile(t, dir, "f-bad.json", `{"name": "test-bad", "colors": {"primary": "nope"}}`)
+	writeThemeFile(t, dir, "g-badchild.json", `{"name": "test-badchild", "extends": "test-bad"}`)
+
+	names, errs := LoadCustomThemes(dir)
+	if want := []string{"test-child", "test-parent"}; !slices.Equal(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+	wantErrs := []string{
+		`c-orphan.json: extends unknown theme "no-such-theme"`,
+		`d-loop1.json: extends cycle: test-loop1 -> test-loop2 -> test-loop1`,
+		`e-loop2.json: extends cycle: test-loop1 -> test-loop2 -> test-loop1`,
+		`f-bad.json: primary: invalid color "nope"`,
+		`g-badchild.json: parent theme "test-bad" failed to load`,
+	}
+	if len(errs) != len(wantErrs) {
+		t.Fatalf("errs = %v, want %d", errs, len(wantErrs))
+	}
+	for i, want := range wantErrs {
+		if !strings.HasPrefix(errs[i].Error(), want) {
+			t.Errorf("errs[%d] = %q, want prefix %q", i, errs[i], want)
+		}
+	}
+
+	child := styles.GetTheme("test-child")
+	dracula := styles.DraculaTheme.Colors
+	if child.Colors.Accent != "#111111" || child.Colors.Primary != "#222222" {
+		t.Errorf("child accent/primary = %s/%s, want own accent and parent primary", child.Colors.Accent, child.Colors.Primary)
+	}
+	if child.Colors.BgPrimary != dracula.BgPrimary || child.Colors.SyntaxTheme != dracula.SyntaxTheme {
+		t.Error("child should inherit the rest of the palette from dracula")
+	}
+	if child.DisplayName != "test-child" {
+		t.Errorf("DisplayName = %q, want own name rather than the parent's", child.DisplayName)
+	}
+}
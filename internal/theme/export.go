@@ -0,0 +1,49 @@
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+// Export encodes the active theme, with all overrides applied, as a custom
+// theme file named name. Every palette key is written, so the file loads
+// the same colors without an "extends" base.
+func Export(name string) ([]byte, error) {
+	if name == "" {
+		return nil, fmt.Errorf("export theme: name is required")
+	}
+	if styles.IsBuiltinTheme(name) {
+		return nil, fmt.Errorf("export theme: name %q is taken by a built-in theme", name)
+	}
+
+	t := styles.GetCurrentTheme()
+	t.Name = name
+	t.DisplayName = ""
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("export theme: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// ExportFile writes the active theme to a JSON file at path. The theme is
+// named after the file, as LoadCustomThemes would name it.
+func ExportFile(path string) error {
+	if strings.ToLower(filepath.Ext(path)) != ".json" {
+		return fmt.Errorf("export theme: %s: must be a .json file", path)
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	data, err := Export(name)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("export theme: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package theme
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wilbur182/forge/internal/styles"
+)
+
+func TestExportFile_RoundTrip(t *testing.T) {
+	t.Cleanup(func() { styles.ApplyTheme("default") })
+	styles.ApplyThemeWithGenericOverrides("dracula", map[string]interface{}{
+		"primary":              "#123456",
+		"gradientBorderActive": []interface{}{"#111111", "#222222 40%", "#333333"},
+	})
+	want := styles.GetCurrentTheme().Colors
+
+	dir := t.TempDir()
+	if err := ExportFile(filepath.Join(dir, "test-snapshot.json")); err != nil {
+		t.Fatal(err)
+	}
+	names, errs := LoadCustomThemes(dir)
+	if len(errs) > 0 || len(names) != 1 || names[0] != "test-snapshot" {
+		t.Fatalf("LoadCustomThemes = %v, %v", names, errs)
+	}
+
+	got := styles.GetTheme("test-snapshot")
+	if !reflect.DeepEqual(got.Colors, want) {
+		t.Errorf("round-tripped palette differs:\n got %+v\nwant %+v", got.Colors, want)
+	}
+	if got.DisplayName != "test-snapshot" {
+		t.Errorf("DisplayName = %q, want file name", got.DisplayName)
+	}
+}
+
+func TestExportFile_Errors(t *testing.T) {
+	dir := t.TempDir()
+	if err := ExportFile(filepath.Join(dir, "dracula.json")); err == nil || !strings.Contains(err.Error(), "built-in") {
+		t.Errorf("exporting over a built-in name: err = %v", err)
+	}
+	if err := ExportFile(filepath.Join(dir, "mine.yaml")); err == nil || !strings.Contains(err.Error(), ".json") {
+		t.Errorf("exporting to YAML: err = %v", err)
+	}
+}
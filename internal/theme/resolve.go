@@ -11,15 +11,21 @@ type ResolvedTheme struct {
 	BaseName      string
 	CommunityName string
 	Overrides     map[string]interface{}
+	// PluginOverrides are palette overrides scoped to one plugin's styles
+	PluginOverrides map[string]map[string]interface{}
+	// TabOverrides are explicit header tab colors keyed by plugin ID
+	TabOverrides map[string]string
 }
 
 // ResolveTheme determines the effective theme for a project path.
 // Priority: project.Theme > global UI.Theme > "default".
 func ResolveTheme(cfg *config.Config, projectPath string) ResolvedTheme {
 	resolved := ResolvedTheme{
-		BaseName:      cfg.UI.Theme.Name,
-		CommunityName: cfg.UI.Theme.Community,
-		Overrides:     cfg.UI.Theme.Overrides,
+		BaseName:        cfg.UI.Theme.Name,
+		CommunityName:   cfg.UI.Theme.Community,
+		Overrides:       cfg.UI.Theme.Overrides,
+		PluginOverrides: cfg.UI.Theme.PluginOverrides,
+		TabOverrides:    cfg.UI.Theme.TabOverrides,
 	}
 
 	for _, proj := range cfg.Projects.List {
@@ -27,6 +33,8 @@ func ResolveTheme(cfg *config.Config, projectPath string) ResolvedTheme {
 			resolved.BaseName = proj.Theme.Name
 			resolved.CommunityName = proj.Theme.Community
 			resolved.Overrides = proj.Theme.Overrides
+			resolved.PluginOverrides = proj.Theme.PluginOverrides
+			resolved.TabOverrides = proj.Theme.TabOverrides
 			break
 		}
 	}
@@ -40,6 +48,9 @@ func ResolveTheme(cfg *config.Config, projectPath string) ResolvedTheme {
 
 // ApplyResolved applies a resolved theme to the styles system.
 func ApplyResolved(r ResolvedTheme) {
+	// Set before the theme so the rebuild picks them up
+	styles.SetPluginOverrides(r.PluginOverrides)
+	styles.SetTabOverrides(r.TabOverrides)
 	if r.CommunityName != "" {
 		scheme := community.GetScheme(r.CommunityName)
 		if scheme != nil {
@@ -0,0 +1,138 @@
+package theme
+
+import (
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDebounce coalesces the burst of events an editor save produces.
+const watchDebounce = 200 * time.Millisecond
+
+// Watcher reports changes to the config file and custom theme files.
+type Watcher struct {
+	fsWatcher  *fsnotify.Watcher
+	configPath string
+	themesDir  string
+	events     chan struct{}
+	stop       chan struct{}
+	mu         sync.Mutex
+	stopped    bool
+}
+
+// NewWatcher watches configPath and the theme files in themesDir. Parent
+// directories are watched rather than the files themselves so saves that
+// replace the file (write to temp, then rename) are still seen. A missing
+// themes directory is skipped.
+func NewWatcher(configPath, themesDir string) (*Watcher, error) {
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		fsWatcher:  fsWatcher,
+		configPath: filepath.Clean(configPath),
+		themesDir:  filepath.Clean(themesDir),
+		events:     make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+	}
+
+	if err := fsWatcher.Add(filepath.Dir(w.configPath)); err != nil {
+		_ = fsWatcher.Close()
+		return nil, err
+	}
+	if themesDir != "" {
+		if err := fsWatcher.Add(w.themesDir); err != nil {
+			slog.Debug("theme watcher: add themes dir", "err", err)
+		}
+	}
+
+	go w.run()
+
+	return w, nil
+}
+
+// Events returns the channel that receives change notifications.
+func (w *Watcher) Events() <-chan struct{} {
+	return w.events
+}
+
+// Stop stops the watcher. The events channel is closed when run() exits.
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+
+	close(w.stop)
+	_ = w.fsWatcher.Close()
+}
+
+// relevant reports whether a changed path affects the active theme.
+func (w *Watcher) relevant(path string) bool {
+	path = filepath.Clean(path)
+	if path == w.configPath {
+		return true
+	}
+	if path == w.themesDir {
+		// Themes dir created after startup: start watching it
+		if err := w.fsWatcher.Add(path); err != nil {
+			slog.Debug("theme watcher: add themes dir", "err", err)
+		}
+		return true
+	}
+	return filepath.Dir(path) == w.themesDir && IsThemeFile(path)
+}
+
+// run processes file system events. Notifications are sent from this
+// goroutine only, so none can be sent after events is closed.
+func (w *Watcher) run() {
+	defer close(w.events)
+
+	var debounce *time.Timer
+	var debounced <-chan time.Time
+	for {
+		select {
+		case <-w.stop:
+			if debounce != nil {
+				debounce.Stop()
+			}
+			return
+
+		case <-debounced:
+			debounced = nil
+			select {
+			case w.events <- struct{}{}:
+			default:
+				// Change already pending
+			}
+
+		case event, ok := <-w.fsWatcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op == fsnotify.Chmod || !w.relevant(event.Name) {
+				continue
+			}
+
+			if debounce != nil {
+				debounce.Stop()
+			}
+			debounce = time.NewTimer(watchDebounce)
+			debounced = debounce.C
+
+		case err, ok := <-w.fsWatcher.Errors:
+			if !ok {
+				return
+			}
+			slog.Debug("theme watcher", "err", err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func waitForEvent(t *testing.T, w *Watcher) bool {
+	t.Helper()
+	select {
+	case <-w.Events():
+		return true
+	case <-time.After(2 * time.Second):
+		return false
+	}
+}
+
+func TestWatcherReportsConfigAndThemeChanges(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	themesDir := filepath.Join(dir, "themes")
+	if err := os.WriteFile(configPath, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(themesDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewWatcher(configPath, themesDir)
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	defer w.Stop()
+
+	if err := os.WriteFile(configPath, []byte(`{"ui":{}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !waitForEvent(t, w) {
+		t.Fatal("no event for config file write")
+	}
+
+	if err := os.WriteFile(filepath.Join(themesDir, "mine.yaml"), []byte("name: mine\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !waitForEvent(t, w) {
+		t.Fatal("no event for theme file write")
+	}
+}
+
+func TestWatcherIgnoresUnrelatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+
+	w, err := NewWatcher(configPath, filepath.Join(dir, "themes"))
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	defer w.Stop()
+
+	if err := os.WriteFile(filepath.Join(dir, "state.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-w.Events():
+		t.Fatal("unexpected event for unrelated file")
+	case <-time.After(3 * watchDebounce):
+	}
+}
+
+func TestWatcherStopClosesEvents(t *testing.T) {
+	w, err := NewWatcher(filepath.Join(t.TempDir(), "config.json"), "")
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	w.Stop()
+	w.Stop() // idempotent
+
+	select {
+	case _, ok := <-w.Events():
+		if ok {
+			t.Fatal("expected closed events channel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("events channel not closed after Stop")
+	}
+}
+
+func TestWatcherStopDuringDebounce(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	w, err := NewWatcher(configPath, "")
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(watchDebounce / 2)
+	w.Stop()
+
+	// A pending notification must not be sent on the closed channel
+	time.Sleep(2 * watchDebounce)
+	for range w.Events() {
+	}
+}
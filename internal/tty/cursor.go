@@ -18,8 +18,8 @@ func CursorStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
 		Reverse(true).
 		Bold(true).
-		Background(styles.Primary).
-		Foreground(styles.BgPrimary)
+		Background(styles.Current().Primary).
+		Foreground(styles.Current().BgPrimary)
 }
 
 // RenderWithCursor overlays the cursor on content at the specified position.
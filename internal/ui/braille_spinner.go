@@ -63,7 +63,7 @@ func (b BrailleSpinner) View() string {
 		return ""
 	}
 	frame := brailleFrames[b.frame%len(brailleFrames)]
-	return lipgloss.NewStyle().Foreground(styles.TextMuted).Render(frame)
+	return lipgloss.NewStyle().Foreground(styles.Current().TextMuted).Render(frame)
 }
 
 // ViewFill renders the spinner centered in the given width, with a label.
@@ -75,11 +75,11 @@ func (b BrailleSpinner) ViewFill(width int, label string) string {
 
 	var sb strings.Builder
 	if label != "" {
-		labelStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+		labelStyle := lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
 		sb.WriteString(labelStyle.Render(label))
 		sb.WriteString("\n")
 	}
-	dotStyle := lipgloss.NewStyle().Foreground(styles.Accent)
+	dotStyle := lipgloss.NewStyle().Foreground(styles.Current().Accent)
 	line := dotStyle.Render(frame)
 	lineLen := len(frame)
 	pad := (width - lineLen) / 2
@@ -13,12 +13,12 @@ import (
 // btnIdx: the index of the button being styled
 func ResolveButtonStyle(focusIdx, hoverIdx, btnIdx int) lipgloss.Style {
 	if focusIdx == btnIdx {
-		return styles.ButtonFocused
+		return styles.Current().ButtonFocused
 	}
 	if hoverIdx == btnIdx {
-		return styles.ButtonHover
+		return styles.Current().ButtonHover
 	}
-	return styles.Button
+	return styles.Current().Button
 }
 
 // RenderButtonPair renders a confirm/cancel button pair with proper spacing.
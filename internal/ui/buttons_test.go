@@ -9,7 +9,7 @@ import (
 
 func TestResolveButtonStyle_FocusMatch(t *testing.T) {
 	style := ResolveButtonStyle(1, -1, 1)
-	if style.GetBold() != styles.ButtonFocused.GetBold() {
+	if style.GetBold() != styles.Current().ButtonFocused.GetBold() {
 		t.Error("expected ButtonFocused when focusIdx matches btnIdx")
 	}
 }
@@ -17,14 +17,14 @@ func TestResolveButtonStyle_FocusMatch(t *testing.T) {
 func TestResolveButtonStyle_HoverMatch(t *testing.T) {
 	style := ResolveButtonStyle(-1, 2, 2)
 	// ButtonHover should be returned when no focus but hover matches
-	if style.GetBackground() != styles.ButtonHover.GetBackground() {
+	if style.GetBackground() != styles.Current().ButtonHover.GetBackground() {
 		t.Error("expected ButtonHover when hoverIdx matches btnIdx (no focus)")
 	}
 }
 
 func TestResolveButtonStyle_NoMatch(t *testing.T) {
 	style := ResolveButtonStyle(-1, -1, 1)
-	if style.GetBackground() != styles.Button.GetBackground() {
+	if style.GetBackground() != styles.Current().Button.GetBackground() {
 		t.Error("expected Button when neither matches")
 	}
 }
@@ -32,7 +32,7 @@ func TestResolveButtonStyle_NoMatch(t *testing.T) {
 func TestResolveButtonStyle_FocusPrecedence(t *testing.T) {
 	// Focus takes precedence over hover when both match
 	style := ResolveButtonStyle(1, 1, 1)
-	if style.GetBold() != styles.ButtonFocused.GetBold() {
+	if style.GetBold() != styles.Current().ButtonFocused.GetBold() {
 		t.Error("focus should take precedence over hover")
 	}
 }
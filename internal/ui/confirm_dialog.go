@@ -23,7 +23,7 @@ func NewConfirmDialog(title, message string) *ConfirmDialog {
 		Message:      message,
 		ConfirmLabel: " Confirm ",
 		CancelLabel:  " Cancel ",
-		BorderColor:  styles.Primary,
+		BorderColor:  styles.Current().Primary,
 		Width:        ModalWidthMedium,
 	}
 }
@@ -32,11 +32,11 @@ func NewConfirmDialog(title, message string) *ConfirmDialog {
 func (d *ConfirmDialog) ToModal() *modal.Modal {
 	variant := modal.VariantDefault
 	switch d.BorderColor {
-	case styles.Error:
+	case styles.Current().Error:
 		variant = modal.VariantDanger
-	case styles.Warning:
+	case styles.Current().Warning:
 		variant = modal.VariantWarning
-	case styles.Info:
+	case styles.Current().Info:
 		variant = modal.VariantInfo
 	}
 
@@ -14,7 +14,7 @@ import (
 // to stop above the bottom border.
 func RenderDivider(height int) string {
 	dividerStyle := lipgloss.NewStyle().
-		Foreground(styles.BorderNormal).
+		Foreground(styles.Current().BorderNormal).
 		MarginTop(1)
 
 	// Build vertical bar (height-2 to stop above bottom border)
@@ -11,8 +11,11 @@ import (
 
 // DimStyle applies a dim gray color to background content behind modals.
 // We strip existing ANSI codes and apply gray because SGR 2 (faint) doesn't
-// reliably combine with existing color codes in most terminals.
-var DimStyle = lipgloss.NewStyle().Foreground(styles.TextMuted)
+// reliably combine with existing color codes in most terminals. Built per
+// call so theme switches apply.
+func DimStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
+}
 
 // DimSequence and ResetSequence are the raw ANSI codes used by DimStyle.
 // Exported for testing.
@@ -35,7 +38,7 @@ func maxLineWidth(lines []string) int {
 
 // dimLine strips ANSI codes and applies dim gray styling.
 func dimLine(s string) string {
-	return DimStyle.Render(ansi.Strip(s))
+	return DimStyle().Render(ansi.Strip(s))
 }
 
 // compositeRow overlays modalLine onto bgLine at position modalStartX.
@@ -52,7 +55,7 @@ func compositeRow(bgLine, modalLine string, modalStartX, modalWidth, totalWidth
 		// Use ansi.Truncate to get visual-width-based substring
 		leftSeg := ansi.Truncate(stripped, modalStartX, "")
 		leftWidth := ansi.StringWidth(leftSeg)
-		result.WriteString(DimStyle.Render(leftSeg))
+		result.WriteString(DimStyle().Render(leftSeg))
 		// Pad if background is shorter than modal position
 		if leftWidth < modalStartX {
 			result.WriteString(strings.Repeat(" ", modalStartX-leftWidth))
@@ -67,7 +70,7 @@ func compositeRow(bgLine, modalLine string, modalStartX, modalWidth, totalWidth
 	if rightStartX < totalWidth && bgWidth > rightStartX {
 		// Use ansi.Cut to get visual-width-based substring from position
 		rightSeg := ansi.Cut(stripped, rightStartX, bgWidth)
-		result.WriteString(DimStyle.Render(rightSeg))
+		result.WriteString(DimStyle().Render(rightSeg))
 	}
 
 	return result.String()
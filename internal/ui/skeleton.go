@@ -94,8 +94,8 @@ func (s Skeleton) View(width int) string {
 	shimmerStart := s.frame % cycleLen
 
 	// Styles for dim and bright states
-	dimStyle := lipgloss.NewStyle().Foreground(styles.TextSubtle)
-	brightStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Current().TextSubtle)
+	brightStyle := lipgloss.NewStyle().Foreground(styles.Current().TextMuted)
 
 	for row := range s.Rows {
 		// Get row width from pattern (cycle if needed)
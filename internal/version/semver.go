@@ -44,3 +44,12 @@ func isNewer(latest, current string) bool {
 
 	return false // equal
 }
+
+// AtLeast reports whether current is target or newer. Development builds
+// have no comparable version and always report false.
+func AtLeast(current, target string) bool {
+	if isDevelopmentVersion(current) {
+		return false
+	}
+	return !isNewer(target, current)
+}